@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	appconfig "github.com/vitaliisemenov/alert-history/internal/config"
+	"github.com/vitaliisemenov/alert-history/internal/core/services"
+)
+
+// fallbackRulesReloader re-applies llm.fallback_rules to the fallback engine
+// on config hot reload.
+type fallbackRulesReloader struct {
+	engine *services.RuleBasedFallback
+}
+
+var _ appconfig.Reloadable = fallbackRulesReloader{}
+
+// Reload implements appconfig.Reloadable.
+func (r fallbackRulesReloader) Reload(ctx context.Context, cfg *appconfig.Config) error {
+	if err := r.engine.LoadRules(fallbackRuleSpecs(cfg.LLM.FallbackRules)); err != nil {
+		return fmt.Errorf("failed to reload fallback rules: %w", err)
+	}
+	return nil
+}
+
+// Name implements appconfig.Reloadable.
+func (r fallbackRulesReloader) Name() string {
+	return "fallback_rules"
+}
+
+// IsCritical implements appconfig.Reloadable. Invalid rules keep the previous
+// rule set, so a failed reload does not require a config rollback.
+func (r fallbackRulesReloader) IsCritical() bool {
+	return false
+}
+
+// fallbackRuleSpecs converts llm.fallback_rules into fallback engine rule specs.
+func fallbackRuleSpecs(rules []appconfig.FallbackRuleConfig) []services.FallbackRuleSpec {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	specs := make([]services.FallbackRuleSpec, 0, len(rules))
+	for _, rule := range rules {
+		match := make([]services.FallbackMatchSpec, 0, len(rule.Match))
+		for _, m := range rule.Match {
+			match = append(match, services.FallbackMatchSpec{
+				AlertName:   m.AlertName,
+				Status:      m.Status,
+				Labels:      m.Labels,
+				Annotations: m.Annotations,
+			})
+		}
+		specs = append(specs, services.FallbackRuleSpec{
+			Name:            rule.Name,
+			Match:           match,
+			Severity:        rule.Severity,
+			Category:        rule.Category,
+			Confidence:      rule.Confidence,
+			Reasoning:       rule.Reasoning,
+			Recommendations: rule.Recommendations,
+		})
+	}
+	return specs
+}
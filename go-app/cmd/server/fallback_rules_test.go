@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appconfig "github.com/vitaliisemenov/alert-history/internal/config"
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/core/services"
+)
+
+func TestFallbackRulesReloader(t *testing.T) {
+	engine := services.NewRuleBasedFallback(nil)
+	reloader := fallbackRulesReloader{engine: engine}
+	assert.Equal(t, "fallback_rules", reloader.Name())
+	assert.False(t, reloader.IsCritical())
+
+	cfg := &appconfig.Config{}
+	cfg.LLM.FallbackRules = []appconfig.FallbackRuleConfig{{
+		Name: "noise",
+		Match: []appconfig.FallbackMatchConfig{{
+			AlertName: []string{"Watchdog*"},
+			Status:    "firing",
+			Labels:    []string{"severity=none"},
+		}},
+		Severity:        "noise",
+		Category:        "monitoring",
+		Confidence:      0.95,
+		Recommendations: []string{"Ignore"},
+	}}
+
+	require.NoError(t, reloader.Reload(context.Background(), cfg))
+	assert.Equal(t, []string{"noise"}, engine.RuleNames())
+
+	result := engine.Classify(&core.Alert{
+		AlertName: "WatchdogPing",
+		Status:    core.StatusFiring,
+		Labels:    map[string]string{"severity": "none"},
+	})
+	assert.Equal(t, core.SeverityNoise, result.Severity)
+	assert.Equal(t, 0.95, result.Confidence)
+	assert.Equal(t, []string{"Ignore"}, result.Recommendations)
+	assert.Equal(t, "monitoring", result.Metadata["category"])
+
+	// Invalid rules keep the current rule set
+	cfg.LLM.FallbackRules[0].Severity = "bogus"
+	assert.Error(t, reloader.Reload(context.Background(), cfg))
+	assert.Equal(t, []string{"noise"}, engine.RuleNames())
+
+	// No configured rules restore the built-in rules
+	cfg.LLM.FallbackRules = nil
+	require.NoError(t, reloader.Reload(context.Background(), cfg))
+	assert.Len(t, engine.RuleNames(), len(services.DefaultFallbackRules()))
+}
@@ -0,0 +1,235 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/core/services"
+)
+
+// ackEventsLimit is the number of audit events returned with an acknowledgement.
+const ackEventsLimit = 50
+
+// AlertAckHandler handles HTTP requests for the acknowledgement workflow of
+// alerts (fingerprint) and notification groups (URL-escaped group key):
+//   - GET /api/v2/alerts/{fingerprint}/ack - State and audit trail
+//   - POST /api/v2/alerts/{fingerprint}/ack - Acknowledge (optional expiry)
+//   - DELETE /api/v2/alerts/{fingerprint}/ack - Remove acknowledgement
+//   - POST /api/v2/alerts/{fingerprint}/assign - Assign owner ("" unassigns)
+//   - POST /api/v2/alerts/{fingerprint}/comments - Add comment
+//   - Same under /api/v2/alert-groups/{groupKey}/...
+//   - GET /api/v2/acks - Acknowledgements in effect
+type AlertAckHandler struct {
+	service services.AckService
+	logger  *slog.Logger
+}
+
+// NewAlertAckHandler creates a new AlertAckHandler instance.
+func NewAlertAckHandler(service services.AckService, logger *slog.Logger) *AlertAckHandler {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &AlertAckHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// AckActionRequest is the body of the acknowledgement workflow actions.
+type AckActionRequest struct {
+	Actor     string     `json:"actor"`                // Who performs the action
+	Comment   string     `json:"comment,omitempty"`    // Optional (required for comments)
+	ExpiresAt *time.Time `json:"expires_at,omitempty"` // Ack: absolute expiry
+	Duration  string     `json:"duration,omitempty"`   // Ack: relative expiry, e.g. "4h"
+	Owner     string     `json:"owner,omitempty"`      // Assign: new owner
+}
+
+// AckStatusResponse is the acknowledgement state of a target with its audit trail.
+type AckStatusResponse struct {
+	TargetType core.AckTargetType `json:"target_type"`
+	Target     string             `json:"target"`
+	Active     bool               `json:"active"`
+	Ack        *core.AlertAck     `json:"ack"`
+	Events     []*core.AckEvent   `json:"events"`
+}
+
+// ListAcksResponse is the response of GET /api/v2/acks.
+type ListAcksResponse struct {
+	Acks  []*core.AlertAck `json:"acks"`
+	Total int              `json:"total"`
+}
+
+// GetAck handles GET .../ack.
+func (h *AlertAckHandler) GetAck(w http.ResponseWriter, r *http.Request) {
+	targetType, target := ackTarget(r)
+
+	ack, err := h.service.GetAck(r.Context(), targetType, target)
+	if err != nil && !errors.Is(err, core.ErrAckNotFound) {
+		h.handleError(w, "get", target, err)
+		return
+	}
+	events, err := h.service.ListEvents(r.Context(), targetType, target, ackEventsLimit)
+	if err != nil {
+		h.handleError(w, "get", target, err)
+		return
+	}
+	if events == nil {
+		events = []*core.AckEvent{}
+	}
+
+	h.writeJSON(w, http.StatusOK, &AckStatusResponse{
+		TargetType: targetType,
+		Target:     target,
+		Active:     ack.IsActive(time.Now()),
+		Ack:        ack,
+		Events:     events,
+	})
+}
+
+// Ack handles POST .../ack.
+func (h *AlertAckHandler) Ack(w http.ResponseWriter, r *http.Request) {
+	req, ok := h.decodeRequest(w, r)
+	if !ok {
+		return
+	}
+	ack, err := h.service.Ack(r.Context(), req)
+	if err != nil {
+		h.handleError(w, "acknowledge", req.Target, err)
+		return
+	}
+	h.writeJSON(w, http.StatusOK, ack)
+}
+
+// Unack handles DELETE .../ack.
+func (h *AlertAckHandler) Unack(w http.ResponseWriter, r *http.Request) {
+	req, ok := h.decodeRequest(w, r)
+	if !ok {
+		return
+	}
+
+	ack, err := h.service.Unack(r.Context(), req)
+	if err != nil {
+		h.handleError(w, "unacknowledge", req.Target, err)
+		return
+	}
+	h.writeJSON(w, http.StatusOK, ack)
+}
+
+// Assign handles POST .../assign.
+func (h *AlertAckHandler) Assign(w http.ResponseWriter, r *http.Request) {
+	req, ok := h.decodeRequest(w, r)
+	if !ok {
+		return
+	}
+
+	ack, err := h.service.Assign(r.Context(), req)
+	if err != nil {
+		h.handleError(w, "assign", req.Target, err)
+		return
+	}
+	h.writeJSON(w, http.StatusOK, ack)
+}
+
+// Comment handles POST .../comments.
+func (h *AlertAckHandler) Comment(w http.ResponseWriter, r *http.Request) {
+	req, ok := h.decodeRequest(w, r)
+	if !ok {
+		return
+	}
+
+	event, err := h.service.Comment(r.Context(), req)
+	if err != nil {
+		h.handleError(w, "comment", req.Target, err)
+		return
+	}
+	h.writeJSON(w, http.StatusCreated, event)
+}
+
+// ListActiveAcks handles GET /api/v2/acks.
+func (h *AlertAckHandler) ListActiveAcks(w http.ResponseWriter, r *http.Request) {
+	acks, err := h.service.ListActiveAcks(r.Context())
+	if err != nil {
+		h.handleError(w, "list", "", err)
+		return
+	}
+	if acks == nil {
+		acks = []*core.AlertAck{}
+	}
+	h.writeJSON(w, http.StatusOK, &ListAcksResponse{Acks: acks, Total: len(acks)})
+}
+
+// ackTarget returns the target of a request from its path: group routes
+// carry {groupKey}, alert routes {fingerprint}.
+func ackTarget(r *http.Request) (core.AckTargetType, string) {
+	if groupKey := r.PathValue("groupKey"); groupKey != "" {
+		return core.AckTargetGroup, groupKey
+	}
+	return core.AckTargetAlert, r.PathValue("fingerprint")
+}
+
+// decodeRequest parses the action body into a service request (a relative
+// duration is turned into an absolute expiry).
+func (h *AlertAckHandler) decodeRequest(w http.ResponseWriter, r *http.Request) (*services.AckRequest, bool) {
+	var body AckActionRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.sendError(w, "Invalid JSON body", http.StatusBadRequest)
+		return nil, false
+	}
+
+	if body.ExpiresAt == nil && body.Duration != "" {
+		duration, err := time.ParseDuration(body.Duration)
+		if err != nil || duration <= 0 {
+			h.sendError(w, "Invalid duration (expected e.g. \"4h\")", http.StatusBadRequest)
+			return nil, false
+		}
+		expiresAt := time.Now().Add(duration)
+		body.ExpiresAt = &expiresAt
+	}
+
+	targetType, target := ackTarget(r)
+	return &services.AckRequest{
+		TargetType: targetType,
+		Target:     target,
+		Actor:      body.Actor,
+		Comment:    body.Comment,
+		ExpiresAt:  body.ExpiresAt,
+		Owner:      body.Owner,
+	}, true
+}
+
+func (h *AlertAckHandler) handleError(w http.ResponseWriter, op, target string, err error) {
+	switch {
+	case errors.Is(err, core.ErrInvalidAckTarget),
+		errors.Is(err, core.ErrInvalidAckActor),
+		errors.Is(err, core.ErrInvalidAckOwner),
+		errors.Is(err, core.ErrInvalidAckExpiry),
+		errors.Is(err, core.ErrAckCommentLength),
+		errors.Is(err, core.ErrAckCommentEmpty):
+		h.sendError(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, core.ErrAckNotFound):
+		h.sendError(w, "Acknowledgement not found", http.StatusNotFound)
+	default:
+		h.logger.Error("Acknowledgement operation failed", "op", op, "target", target, "error", err)
+		h.sendError(w, "Failed to "+op, http.StatusInternalServerError)
+	}
+}
+
+func (h *AlertAckHandler) writeJSON(w http.ResponseWriter, code int, payload interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(payload)
+}
+
+// sendError sends an error response
+func (h *AlertAckHandler) sendError(w http.ResponseWriter, message string, code int) {
+	h.writeJSON(w, code, struct {
+		Error string `json:"error"`
+	}{
+		Error: message,
+	})
+}
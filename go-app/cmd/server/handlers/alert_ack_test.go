@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/core/services"
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure/repository"
+)
+
+func newAckMux(t *testing.T) *http.ServeMux {
+	t.Helper()
+	service, err := services.NewAckService(&services.AckServiceConfig{
+		Repository: repository.NewMemoryAckRepository(),
+	})
+	require.NoError(t, err)
+
+	h := NewAlertAckHandler(service, nil)
+	mux := http.NewServeMux()
+	for _, prefix := range []string{"/api/v2/alerts/{fingerprint}", "/api/v2/alert-groups/{groupKey}"} {
+		mux.HandleFunc("GET "+prefix+"/ack", h.GetAck)
+		mux.HandleFunc("POST "+prefix+"/ack", h.Ack)
+		mux.HandleFunc("DELETE "+prefix+"/ack", h.Unack)
+		mux.HandleFunc("POST "+prefix+"/assign", h.Assign)
+		mux.HandleFunc("POST "+prefix+"/comments", h.Comment)
+	}
+	mux.HandleFunc("GET /api/v2/acks", h.ListActiveAcks)
+	return mux
+}
+
+func TestAlertAckHandler_Workflow(t *testing.T) {
+	mux := newAckMux(t)
+	base := "/api/v2/alerts/abc123"
+
+	rec := doRecurringRequest(t, mux, http.MethodPost, base+"/ack", AckActionRequest{Actor: "alice", Comment: "investigating", Duration: "4h"})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var ack core.AlertAck
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&ack))
+	assert.True(t, ack.Acknowledged)
+	require.NotNil(t, ack.ExpiresAt)
+	assert.WithinDuration(t, time.Now().Add(4*time.Hour), *ack.ExpiresAt, time.Minute)
+
+	rec = doRecurringRequest(t, mux, http.MethodPost, base+"/assign", AckActionRequest{Actor: "alice", Owner: "bob"})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = doRecurringRequest(t, mux, http.MethodPost, base+"/comments", AckActionRequest{Actor: "bob", Comment: "disk full on node-3"})
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+
+	rec = doRecurringRequest(t, mux, http.MethodGet, "/api/v2/acks", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var list ListAcksResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&list))
+	assert.Equal(t, 1, list.Total)
+
+	rec = doRecurringRequest(t, mux, http.MethodDelete, base+"/ack", AckActionRequest{Actor: "bob"})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = doRecurringRequest(t, mux, http.MethodGet, base+"/ack", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var status AckStatusResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&status))
+	assert.False(t, status.Active)
+	assert.Equal(t, "bob", status.Ack.Owner)
+	require.Len(t, status.Events, 4)
+	assert.Equal(t, core.AckActionUnack, status.Events[0].Action)
+}
+
+func TestAlertAckHandler_GroupKey(t *testing.T) {
+	mux := newAckMux(t)
+	groupKey := `{}:{alertname="HighCPU"}`
+	path := "/api/v2/alert-groups/" + url.PathEscape(groupKey) + "/ack"
+
+	rec := doRecurringRequest(t, mux, http.MethodPost, path, AckActionRequest{Actor: "alice"})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = doRecurringRequest(t, mux, http.MethodGet, path, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var status AckStatusResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&status))
+	assert.Equal(t, core.AckTargetGroup, status.TargetType)
+	assert.Equal(t, groupKey, status.Target)
+	assert.True(t, status.Active)
+}
+
+func TestAlertAckHandler_Errors(t *testing.T) {
+	mux := newAckMux(t)
+	past := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   interface{}
+		want   int
+	}{
+		{"missing actor", http.MethodPost, "/api/v2/alerts/abc/ack", AckActionRequest{}, http.StatusBadRequest},
+		{"invalid duration", http.MethodPost, "/api/v2/alerts/abc/ack", AckActionRequest{Actor: "alice", Duration: "soon"}, http.StatusBadRequest},
+		{"expiry in the past", http.MethodPost, "/api/v2/alerts/abc/ack", AckActionRequest{Actor: "alice", ExpiresAt: &past}, http.StatusBadRequest},
+		{"empty comment", http.MethodPost, "/api/v2/alerts/abc/comments", AckActionRequest{Actor: "alice"}, http.StatusBadRequest},
+		{"unack not acked", http.MethodDelete, "/api/v2/alerts/abc/ack", AckActionRequest{Actor: "alice"}, http.StatusNotFound},
+		{"invalid body", http.MethodPost, "/api/v2/alerts/abc/ack", nil, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := doRecurringRequest(t, mux, tt.method, tt.path, tt.body)
+			assert.Equal(t, tt.want, rec.Code, rec.Body.String())
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/vitaliisemenov/alert-history/internal/business/escalation"
+	"github.com/vitaliisemenov/alert-history/internal/core"
+)
+
+// AlertEscalationHandler handles HTTP requests for alert escalation status:
+//   - GET /api/v2/alerts/{fingerprint}/escalation - Escalation of an alert
+//   - GET /api/v2/escalations - Active escalations
+type AlertEscalationHandler struct {
+	manager escalation.EscalationManager
+	logger  *slog.Logger
+}
+
+// NewAlertEscalationHandler creates a new AlertEscalationHandler instance.
+func NewAlertEscalationHandler(manager escalation.EscalationManager, logger *slog.Logger) *AlertEscalationHandler {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &AlertEscalationHandler{
+		manager: manager,
+		logger:  logger,
+	}
+}
+
+// ListEscalationsResponse is the response of GET /api/v2/escalations.
+type ListEscalationsResponse struct {
+	Escalations []*escalation.Status `json:"escalations"`
+	Total       int                  `json:"total"`
+}
+
+// GetEscalation handles GET /api/v2/alerts/{fingerprint}/escalation.
+func (h *AlertEscalationHandler) GetEscalation(w http.ResponseWriter, r *http.Request) {
+	fingerprint := r.PathValue("fingerprint")
+
+	status, err := h.manager.Status(r.Context(), fingerprint)
+	if errors.Is(err, core.ErrEscalationNotFound) {
+		h.sendError(w, "Escalation not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		h.logger.Error("Failed to get escalation", "fingerprint", fingerprint, "error", err)
+		h.sendError(w, "Failed to get escalation", http.StatusInternalServerError)
+		return
+	}
+	h.writeJSON(w, http.StatusOK, status)
+}
+
+// ListEscalations handles GET /api/v2/escalations.
+func (h *AlertEscalationHandler) ListEscalations(w http.ResponseWriter, r *http.Request) {
+	escalations, err := h.manager.ListActive(r.Context())
+	if err != nil {
+		h.logger.Error("Failed to list escalations", "error", err)
+		h.sendError(w, "Failed to list escalations", http.StatusInternalServerError)
+		return
+	}
+	h.writeJSON(w, http.StatusOK, &ListEscalationsResponse{Escalations: escalations, Total: len(escalations)})
+}
+
+func (h *AlertEscalationHandler) writeJSON(w http.ResponseWriter, code int, payload interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(payload)
+}
+
+// sendError sends an error response
+func (h *AlertEscalationHandler) sendError(w http.ResponseWriter, message string, code int) {
+	h.writeJSON(w, code, struct {
+		Error string `json:"error"`
+	}{
+		Error: message,
+	})
+}
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vitaliisemenov/alert-history/internal/business/escalation"
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/core/services"
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure/repository"
+)
+
+// fakeReceiverPublisher accepts escalation notifications for any receiver.
+type fakeReceiverPublisher struct{}
+
+func (fakeReceiverPublisher) PublishGroup(ctx context.Context, notification *services.GroupNotification) error {
+	return nil
+}
+
+func (fakeReceiverPublisher) HasReceiver(receiver string) bool { return true }
+
+func TestAlertEscalationHandler(t *testing.T) {
+	config, err := escalation.ParseConfig([]byte(`
+escalation_policies:
+  - name: critical-oncall
+    match:
+      severity: critical
+    levels:
+      - delay: 1h
+        receivers: ["pagerduty-primary"]
+`))
+	require.NoError(t, err)
+
+	manager, err := escalation.NewDefaultEscalationManager(escalation.ManagerConfig{
+		Config:     config,
+		Repository: repository.NewMemoryEscalationRepository(),
+		Publisher:  fakeReceiverPublisher{},
+	})
+	require.NoError(t, err)
+	defer manager.Stop()
+
+	require.NoError(t, manager.Escalate(context.Background(), &core.Alert{
+		Fingerprint: "abc123",
+		AlertName:   "DiskFull",
+		Status:      core.StatusFiring,
+		Labels:      map[string]string{"severity": "critical"},
+	}))
+
+	h := NewAlertEscalationHandler(manager, nil)
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/alerts/{fingerprint}/escalation", h.GetEscalation)
+	mux.HandleFunc("GET /api/v2/escalations", h.ListEscalations)
+
+	rec := doRecurringRequest(t, mux, http.MethodGet, "/api/v2/alerts/abc123/escalation", nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var status escalation.Status
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&status))
+	assert.Equal(t, "critical-oncall", status.Policy)
+	assert.Equal(t, core.EscalationActive, status.State)
+	require.Len(t, status.Levels, 1)
+	assert.Equal(t, []string{"pagerduty-primary"}, status.Levels[0].Receivers)
+	assert.False(t, status.Levels[0].Notified)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), status.Levels[0].DueAt, time.Minute)
+
+	rec = doRecurringRequest(t, mux, http.MethodGet, "/api/v2/escalations", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var list ListEscalationsResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&list))
+	assert.Equal(t, 1, list.Total)
+
+	rec = doRecurringRequest(t, mux, http.MethodGet, "/api/v2/alerts/unknown/escalation", nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -2,6 +2,7 @@
 package handlers
 
 import (
+	"context"
 	"log/slog"
 	"net/http"
 	"net/url"
@@ -10,6 +11,7 @@ import (
 	"time"
 
 	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/core/services"
 	"github.com/vitaliisemenov/alert-history/internal/infrastructure/cache"
 	"github.com/vitaliisemenov/alert-history/internal/ui"
 )
@@ -21,6 +23,7 @@ type AlertListUIHandler struct {
 	templateEngine   *ui.TemplateEngine // TN-76: Dashboard Template Engine
 	historyRepo      core.AlertHistoryRepository
 	classificationEnricher ui.ClassificationEnricher // TN-80: Classification Enricher
+	ackService       services.AckService // Acknowledgement badges (optional)
 	cache            cache.Cache // Response caching
 	logger           *slog.Logger
 }
@@ -48,6 +51,12 @@ func (h *AlertListUIHandler) SetClassificationEnricher(enricher ui.Classificatio
 	h.classificationEnricher = enricher
 }
 
+// SetAckService sets the acknowledgement service used to show ack and owner
+// badges on alert cards (optional).
+func (h *AlertListUIHandler) SetAckService(service services.AckService) {
+	h.ackService = service
+}
+
 // AlertListPageData represents data for alert list page template.
 type AlertListPageData struct {
 	Title      string
@@ -179,6 +188,7 @@ func (h *AlertListUIHandler) RenderAlertList(w http.ResponseWriter, r *http.Requ
 
 	// Convert enriched alerts to template-friendly format
 	alertCardDataList := ui.ToAlertCardDataList(enrichedAlerts)
+	h.addAckData(ctx, alertCardDataList)
 
 	// Prepare template data
 	alertListData := map[string]interface{}{
@@ -585,3 +595,30 @@ func getClassificationConfidence(enriched *ui.EnrichedAlert) float64 {
 	}
 	return enriched.Classification.Confidence
 }
+
+// addAckData adds acknowledgement state to alert cards.
+// Lookup errors are logged and the cards rendered without it.
+func (h *AlertListUIHandler) addAckData(ctx context.Context, cards []*ui.AlertCardData) {
+	if h.ackService == nil || len(cards) == 0 {
+		return
+	}
+
+	fingerprints := make([]string, 0, len(cards))
+	for _, card := range cards {
+		if card != nil {
+			fingerprints = append(fingerprints, card.Fingerprint)
+		}
+	}
+	acks, err := h.ackService.ListAcks(ctx, core.AckTargetAlert, fingerprints)
+	if err != nil {
+		h.logger.Warn("Failed to load acknowledgements, continuing without them", "error", err)
+		return
+	}
+
+	now := time.Now()
+	for _, card := range cards {
+		if card != nil {
+			card.Ack = ui.ToAckDisplayData(acks[card.Fingerprint], now)
+		}
+	}
+}
@@ -0,0 +1,274 @@
+// Package handlers provides HTTP handlers for the Alert History Service.
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"runtime"
+	"runtime/debug"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure/grouping"
+)
+
+// AlertmanagerStatus is the GET /api/v2/status response (alertmanagerStatus).
+type AlertmanagerStatus struct {
+	Cluster     AlertmanagerClusterStatus `json:"cluster"`
+	VersionInfo AlertmanagerVersionInfo   `json:"versionInfo"`
+	Config      AlertmanagerConfig        `json:"config"`
+	Uptime      string                    `json:"uptime"` // process start time (RFC3339)
+}
+
+// AlertmanagerClusterStatus describes HA cluster membership.
+//
+// The service runs without gossip clustering, so the status is always "disabled".
+type AlertmanagerClusterStatus struct {
+	Name   string                    `json:"name,omitempty"`
+	Status string                    `json:"status"` // ready, settling or disabled
+	Peers  []AlertmanagerClusterPeer `json:"peers"`
+}
+
+// AlertmanagerClusterPeer is a cluster peer (peerStatus).
+type AlertmanagerClusterPeer struct {
+	Name    string `json:"name"`
+	Address string `json:"address"`
+}
+
+// AlertmanagerVersionInfo holds build information (versionInfo).
+type AlertmanagerVersionInfo struct {
+	Version   string `json:"version"`
+	Revision  string `json:"revision"`
+	Branch    string `json:"branch"`
+	BuildUser string `json:"buildUser"`
+	BuildDate string `json:"buildDate"`
+	GoVersion string `json:"goVersion"`
+}
+
+// AlertmanagerConfig holds the loaded routing configuration (alertmanagerConfig).
+type AlertmanagerConfig struct {
+	Original string `json:"original"`
+}
+
+// AlertmanagerAlertGroup is an aggregation group of GET /api/v2/alerts/groups (alertGroup).
+type AlertmanagerAlertGroup struct {
+	Labels   map[string]string    `json:"labels"`
+	Receiver AlertmanagerReceiver `json:"receiver"`
+	Alerts   []AlertmanagerAlert  `json:"alerts"`
+}
+
+// AlertGroupRouter resolves the receiver and group labels of an aggregation group.
+//
+// Implemented by the route tree dispatcher (services.GroupDispatcher).
+type AlertGroupRouter interface {
+	GroupRoute(group *grouping.AlertGroup) (receiver string, labels map[string]string, ok bool)
+}
+
+// AlertmanagerAPIConfig configures the Alertmanager v2 status, receivers and
+// alert groups endpoints.
+type AlertmanagerAPIConfig struct {
+	Version   string    // Service version reported in versionInfo
+	StartTime time.Time // Process start time reported as uptime
+	Config    string    // Original routing configuration (YAML)
+	Receivers []string  // Receiver names of the route tree
+
+	Groups    grouping.AlertGroupManager // Optional: aggregation groups (empty list if nil)
+	Router    AlertGroupRouter           // Optional: group receiver lookup (groups skipped if nil)
+	Converter *ConverterDependencies     // Optional: silence/inhibition status of grouped alerts
+	Logger    *slog.Logger
+}
+
+// AlertmanagerAPIHandler serves the Alertmanager API v2 endpoints used by
+// amtool and Grafana besides the alert and silence APIs:
+//   - GET /api/v2/status: cluster, version, configuration and uptime
+//   - GET /api/v2/receivers: receivers of the route tree
+//   - GET /api/v2/alerts/groups: active alerts by aggregation group
+//
+// Responses follow the upstream OpenAPI schema (api/v2/openapi.yaml).
+type AlertmanagerAPIHandler struct {
+	config      AlertmanagerAPIConfig
+	versionInfo AlertmanagerVersionInfo
+	logger      *slog.Logger
+}
+
+// NewAlertmanagerAPIHandler creates the Alertmanager v2 API handler.
+func NewAlertmanagerAPIHandler(config AlertmanagerAPIConfig) *AlertmanagerAPIHandler {
+	if config.Logger == nil {
+		config.Logger = slog.Default()
+	}
+	if config.StartTime.IsZero() {
+		config.StartTime = time.Now()
+	}
+	if config.Converter == nil {
+		config.Converter = &ConverterDependencies{Logger: config.Logger}
+	}
+
+	return &AlertmanagerAPIHandler{
+		config:      config,
+		versionInfo: buildVersionInfo(config.Version),
+		logger:      config.Logger,
+	}
+}
+
+// buildVersionInfo fills versionInfo from the service version and the VCS
+// metadata embedded by the Go toolchain.
+func buildVersionInfo(version string) AlertmanagerVersionInfo {
+	info := AlertmanagerVersionInfo{
+		Version:   version,
+		GoVersion: runtime.Version(),
+	}
+	if buildInfo, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range buildInfo.Settings {
+			switch setting.Key {
+			case "vcs.revision":
+				info.Revision = setting.Value
+			case "vcs.time":
+				info.BuildDate = setting.Value
+			}
+		}
+	}
+	return info
+}
+
+// HandleStatus handles GET /api/v2/status.
+func (h *AlertmanagerAPIHandler) HandleStatus(w http.ResponseWriter, r *http.Request) {
+	respondJSON(w, http.StatusOK, AlertmanagerStatus{
+		Cluster: AlertmanagerClusterStatus{
+			Status: "disabled",
+			Peers:  []AlertmanagerClusterPeer{},
+		},
+		VersionInfo: h.versionInfo,
+		Config:      AlertmanagerConfig{Original: h.config.Config},
+		Uptime:      h.config.StartTime.UTC().Format(time.RFC3339),
+	})
+}
+
+// HandleReceivers handles GET /api/v2/receivers.
+func (h *AlertmanagerAPIHandler) HandleReceivers(w http.ResponseWriter, r *http.Request) {
+	receivers := make([]AlertmanagerReceiver, 0, len(h.config.Receivers))
+	for _, name := range h.config.Receivers {
+		receivers = append(receivers, AlertmanagerReceiver{Name: name})
+	}
+	respondJSON(w, http.StatusOK, receivers)
+}
+
+// HandleAlertGroups handles GET /api/v2/alerts/groups.
+//
+// Supports the upstream query parameters: filter (repeatable), receiver
+// (regex on the group receiver), active, silenced and inhibited. Resolved
+// alerts are not listed and groups without matching alerts are omitted.
+// Errors are returned as a JSON string, as in Alertmanager.
+func (h *AlertmanagerAPIHandler) HandleAlertGroups(w http.ResponseWriter, r *http.Request) {
+	params, err := ParseQueryParameters(r.URL.Query())
+	if err != nil {
+		respondJSON(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var receiverRE *regexp.Regexp
+	if params.Receiver != "" {
+		if receiverRE, err = regexp.Compile("^(?:" + params.Receiver + ")$"); err != nil {
+			respondJSON(w, http.StatusBadRequest, fmt.Sprintf("invalid receiver regex: %v", err))
+			return
+		}
+	}
+	// The receiver filter applies to groups, not to the alerts they contain
+	alertParams := *params
+	alertParams.Receiver = ""
+
+	if _, err := ParseLabelMatchers(params.Filter); err != nil {
+		respondJSON(w, http.StatusBadRequest, fmt.Sprintf("invalid label matchers: %v", err))
+		return
+	}
+
+	groups, err := h.alertGroups(r.Context(), receiverRE, &alertParams)
+	if err != nil {
+		h.logger.Error("Failed to list alert groups", "error", err)
+		respondJSON(w, http.StatusInternalServerError, "failed to list alert groups")
+		return
+	}
+	respondJSON(w, http.StatusOK, groups)
+}
+
+// alertGroups converts and filters the aggregation groups, sorted by receiver
+// and group labels.
+func (h *AlertmanagerAPIHandler) alertGroups(
+	ctx context.Context,
+	receiverRE *regexp.Regexp,
+	params *QueryParameters,
+) ([]AlertmanagerAlertGroup, error) {
+	result := []AlertmanagerAlertGroup{}
+	if h.config.Groups == nil || h.config.Router == nil {
+		return result, nil
+	}
+
+	groups, err := h.config.Groups.ListGroups(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, group := range groups {
+		receiver, labels, ok := h.config.Router.GroupRoute(group)
+		if !ok {
+			h.logger.Debug("Skipping alert group without route", "group_key", group.Key)
+			continue
+		}
+		if receiverRE != nil && !receiverRE.MatchString(receiver) {
+			continue
+		}
+
+		firing := make([]*core.Alert, 0, len(group.Alerts))
+		for _, alert := range group.Alerts {
+			if alert.Status != core.StatusResolved {
+				firing = append(firing, alert)
+			}
+		}
+		sort.Slice(firing, func(i, j int) bool { return firing[i].Fingerprint < firing[j].Fingerprint })
+
+		alerts, err := ConvertToAlertmanagerFormat(ctx, firing, h.config.Converter)
+		if err != nil {
+			return nil, err
+		}
+		if alerts, err = FilterAlertmanagerAlerts(alerts, params); err != nil {
+			return nil, err
+		}
+		if len(alerts) == 0 {
+			continue
+		}
+
+		result = append(result, AlertmanagerAlertGroup{
+			Labels:   labels,
+			Receiver: AlertmanagerReceiver{Name: receiver},
+			Alerts:   alerts,
+		})
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Receiver.Name != result[j].Receiver.Name {
+			return result[i].Receiver.Name < result[j].Receiver.Name
+		}
+		return labelSetString(result[i].Labels) < labelSetString(result[j].Labels)
+	})
+	return result, nil
+}
+
+// labelSetString renders labels in sorted order, for stable sorting.
+func labelSetString(labels map[string]string) string {
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var b strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&b, "%s=%q,", name, labels[name])
+	}
+	return b.String()
+}
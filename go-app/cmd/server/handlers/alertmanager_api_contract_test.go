@@ -0,0 +1,282 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+)
+
+// openAPISpec is the part of a Swagger 2.0 document used by the contract tests.
+type openAPISpec struct {
+	BasePath string `json:"basePath"`
+	Paths    map[string]map[string]struct {
+		Responses map[string]struct {
+			Schema *openAPISchema `json:"schema"`
+		} `json:"responses"`
+	} `json:"paths"`
+	Definitions map[string]*openAPISchema `json:"definitions"`
+}
+
+type openAPISchema struct {
+	Ref                  string                    `json:"$ref"`
+	Type                 string                    `json:"type"`
+	Format               string                    `json:"format"`
+	Enum                 []string                  `json:"enum"`
+	Required             []string                  `json:"required"`
+	Properties           map[string]*openAPISchema `json:"properties"`
+	AdditionalProperties *openAPISchema            `json:"additionalProperties"`
+	Items                *openAPISchema            `json:"items"`
+	AllOf                []*openAPISchema          `json:"allOf"`
+}
+
+// resolve follows $ref and merges allOf members into a single object schema.
+func (s *openAPISpec) resolve(schema *openAPISchema) *openAPISchema {
+	for schema.Ref != "" {
+		schema = s.Definitions[strings.TrimPrefix(schema.Ref, "#/definitions/")]
+	}
+	if len(schema.AllOf) == 0 {
+		return schema
+	}
+
+	merged := &openAPISchema{Type: "object", Properties: map[string]*openAPISchema{}}
+	for _, member := range schema.AllOf {
+		member = s.resolve(member)
+		merged.Required = append(merged.Required, member.Required...)
+		for name, property := range member.Properties {
+			merged.Properties[name] = property
+		}
+	}
+	return merged
+}
+
+// validate checks a decoded JSON value against a schema. Object properties
+// not declared by the schema are rejected so that responses match the
+// upstream schema exactly.
+func (s *openAPISpec) validate(schema *openAPISchema, value any, path string) []string {
+	schema = s.resolve(schema)
+	var errs []string
+	fail := func(format string, args ...any) []string {
+		return append(errs, path+": "+fmt.Sprintf(format, args...))
+	}
+
+	switch schema.Type {
+	case "object":
+		object, ok := value.(map[string]any)
+		if !ok {
+			return fail("expected object, got %T", value)
+		}
+		for _, name := range schema.Required {
+			if _, ok := object[name]; !ok {
+				errs = fail("missing required property %q", name)
+			}
+		}
+		names := make([]string, 0, len(object))
+		for name := range object {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			property, ok := schema.Properties[name]
+			if !ok {
+				property = schema.AdditionalProperties
+			}
+			if property == nil {
+				errs = fail("unexpected property %q", name)
+				continue
+			}
+			errs = append(errs, s.validate(property, object[name], path+"."+name)...)
+		}
+	case "array":
+		array, ok := value.([]any)
+		if !ok {
+			return fail("expected array, got %T", value)
+		}
+		for i, item := range array {
+			errs = append(errs, s.validate(schema.Items, item, fmt.Sprintf("%s[%d]", path, i))...)
+		}
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			return fail("expected string, got %T", value)
+		}
+		if len(schema.Enum) > 0 && !slices.Contains(schema.Enum, str) {
+			errs = fail("%q not in %v", str, schema.Enum)
+		}
+		switch schema.Format {
+		case "date-time":
+			if _, err := time.Parse(time.RFC3339, str); err != nil {
+				errs = fail("invalid date-time %q", str)
+			}
+		case "uri":
+			if _, err := url.ParseRequestURI(str); err != nil {
+				errs = fail("invalid uri %q", str)
+			}
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fail("expected boolean, got %T", value)
+		}
+	default:
+		return fail("unsupported schema type %q", schema.Type)
+	}
+	return errs
+}
+
+// TestAlertmanagerAPIContract validates the 200 responses of every GET
+// endpoint of the Alertmanager OpenAPI v2 specification subset in testdata
+// (transcribed from upstream api/v2/openapi.yaml) against their schemas.
+func TestAlertmanagerAPIContract(t *testing.T) {
+	data, err := os.ReadFile("testdata/alertmanager-openapi-v2.json")
+	require.NoError(t, err)
+	var spec openAPISpec
+	require.NoError(t, json.Unmarshal(data, &spec))
+	require.NotEmpty(t, spec.Paths)
+
+	mux := newAlertmanagerAPITestMux(t)
+
+	for path, operations := range spec.Paths {
+		operation, ok := operations["get"]
+		if !ok {
+			continue
+		}
+		target := strings.TrimSuffix(spec.BasePath, "/") + path
+
+		t.Run(target, func(t *testing.T) {
+			response, ok := operation.Responses["200"]
+			require.True(t, ok, "no 200 response in spec")
+
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+			require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+			var body any
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			if array, ok := body.([]any); ok {
+				require.NotEmpty(t, array, "fixture should produce a non-empty response")
+			}
+			for _, err := range spec.validate(response.Schema, body, "response") {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+// TestAlertmanagerAPIContract_CurrentAlerts checks that GET /api/v2/alerts,
+// like upstream, lists all current alerts (not a page of the history) and
+// applies the state filters before counting and paginating.
+func TestAlertmanagerAPIContract_CurrentAlerts(t *testing.T) {
+	data, err := os.ReadFile("testdata/alertmanager-openapi-v2.json")
+	require.NoError(t, err)
+	var spec openAPISpec
+	require.NoError(t, json.Unmarshal(data, &spec))
+
+	// History order: 120 silenced, 40 resolved, 10 expired, 130 active alerts
+	var history []*core.Alert
+	for i := 0; i < 120; i++ {
+		history = append(history, newAPITestAlert(fmt.Sprintf("silenced-%d", i), "HighCPU", map[string]string{"silenced": "true"}))
+	}
+	endsAt := time.Now().Add(-time.Minute)
+	for i := 0; i < 40; i++ {
+		alert := newAPITestAlert(fmt.Sprintf("resolved-%d", i), "HighCPU", nil)
+		alert.Status, alert.EndsAt = core.StatusResolved, &endsAt
+		history = append(history, alert)
+	}
+	for i := 0; i < 10; i++ {
+		alert := newAPITestAlert(fmt.Sprintf("expired-%d", i), "HighCPU", nil)
+		alert.EndsAt = &endsAt
+		history = append(history, alert)
+	}
+	for i := 0; i < 130; i++ {
+		history = append(history, newAPITestAlert(fmt.Sprintf("active-%d", i), "HighCPU", nil))
+	}
+
+	config := DefaultPrometheusQueryConfig()
+	config.EnableMetrics = false
+	config.MaxAlertsPerPage = 100 // several history queries per request
+	handler, err := NewPrometheusQueryHandler(&mockHistoryRepo{alerts: history}, nil, config,
+		&ConverterDependencies{SilenceChecker: labelSilenceChecker{}})
+	require.NoError(t, err)
+
+	tests := []struct {
+		query    string
+		returned int
+		total    string
+		prefix   string
+	}{
+		{"", 250, "250", ""},
+		{"?silenced=false", 130, "130", "active-"},
+		{"?active=false", 120, "120", "silenced-"},
+		{"?silenced=false&page=2&limit=100", 30, "130", "active-"},
+		{"?limit=100", 100, "250", ""},
+	}
+	for _, tt := range tests {
+		t.Run("/api/v2/alerts"+tt.query, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.HandlePrometheusQuery(rec, httptest.NewRequest(http.MethodGet, "/api/v2/alerts"+tt.query, nil))
+			require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+			require.Equal(t, tt.total, rec.Header().Get("X-Total-Count"))
+
+			var body []any
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			require.Len(t, body, tt.returned)
+			for _, err := range spec.validate(&openAPISchema{Ref: "#/definitions/gettableAlerts"}, any(body), "response") {
+				t.Error(err)
+			}
+			for _, alert := range body {
+				fingerprint := alert.(map[string]any)["fingerprint"].(string)
+				require.False(t, strings.HasPrefix(fingerprint, "resolved-") || strings.HasPrefix(fingerprint, "expired-"), fingerprint)
+				require.True(t, strings.HasPrefix(fingerprint, tt.prefix), fingerprint)
+			}
+		})
+	}
+}
+
+func TestAlertmanagerAPIContract_Validator(t *testing.T) {
+	data, err := os.ReadFile("testdata/alertmanager-openapi-v2.json")
+	require.NoError(t, err)
+	var spec openAPISpec
+	require.NoError(t, json.Unmarshal(data, &spec))
+
+	alert := func(mutate func(map[string]any)) any {
+		value := map[string]any{
+			"labels":      map[string]any{"alertname": "HighCPU"},
+			"annotations": map[string]any{},
+			"receivers":   []any{map[string]any{"name": "default"}},
+			"fingerprint": "fp-1",
+			"startsAt":    "2026-01-02T03:04:05Z",
+			"updatedAt":   "2026-01-02T03:04:05Z",
+			"endsAt":      "0001-01-01T00:00:00Z",
+			"status": map[string]any{
+				"state": "active", "silencedBy": []any{}, "inhibitedBy": []any{}, "mutedBy": []any{},
+			},
+		}
+		mutate(value)
+		return []any{value}
+	}
+	schema := &openAPISchema{Ref: "#/definitions/gettableAlerts"}
+
+	require.Empty(t, spec.validate(schema, alert(func(map[string]any) {}), "alerts"))
+	for name, mutate := range map[string]func(map[string]any){
+		"missing required":  func(a map[string]any) { delete(a, "updatedAt") },
+		"unknown property":  func(a map[string]any) { a["severity"] = "critical" },
+		"invalid enum":      func(a map[string]any) { a["status"].(map[string]any)["state"] = "firing" },
+		"invalid date-time": func(a map[string]any) { a["startsAt"] = "yesterday" },
+		"wrong type":        func(a map[string]any) { a["receivers"] = []any{"default"} },
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.NotEmpty(t, spec.validate(schema, alert(mutate), "alerts"))
+		})
+	}
+}
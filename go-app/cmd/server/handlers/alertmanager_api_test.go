@@ -0,0 +1,271 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/core/silencing"
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure/grouping"
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure/inhibition"
+)
+
+// staticGroupRouter routes groups by key to a receiver, grouping by alertname.
+type staticGroupRouter map[grouping.GroupKey]string
+
+func (r staticGroupRouter) GroupRoute(group *grouping.AlertGroup) (string, map[string]string, bool) {
+	receiver, ok := r[group.Key]
+	if !ok {
+		return "", nil, false
+	}
+	for _, alert := range group.Alerts {
+		return receiver, map[string]string{"alertname": alert.Labels["alertname"]}, true
+	}
+	return receiver, map[string]string{}, true
+}
+
+// labelSilenceChecker silences alerts carrying the silenced="true" label.
+type labelSilenceChecker struct{}
+
+func (labelSilenceChecker) IsAlertSilenced(ctx context.Context, alert *core.Alert) ([]string, error) {
+	if alert.Labels["silenced"] == "true" {
+		return []string{"silence-1"}, nil
+	}
+	return nil, nil
+}
+
+var alertmanagerAPIStartTime = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func newAPITestAlert(fingerprint, name string, labels map[string]string) *core.Alert {
+	generatorURL := "http://prometheus:9090/graph?g0.expr=up"
+	alert := &core.Alert{
+		Fingerprint:  fingerprint,
+		AlertName:    name,
+		Status:       core.StatusFiring,
+		Labels:       map[string]string{"alertname": name},
+		Annotations:  map[string]string{"summary": name + " firing"},
+		StartsAt:     alertmanagerAPIStartTime,
+		GeneratorURL: &generatorURL,
+	}
+	for k, v := range labels {
+		alert.Labels[k] = v
+	}
+	return alert
+}
+
+// newAlertmanagerAPITestMux serves the Alertmanager v2 endpoints over fixture
+// alerts: two web alerts (one silenced), a db alert, a resolved alert and a
+// group without route.
+func newAlertmanagerAPITestMux(t *testing.T) *http.ServeMux {
+	t.Helper()
+	ctx := context.Background()
+
+	groupManager, err := grouping.NewDefaultGroupManager(ctx, grouping.DefaultGroupManagerConfig{
+		KeyGenerator: grouping.NewGroupKeyGenerator(),
+		Config:       &grouping.GroupingConfig{Route: &grouping.Route{Receiver: "default", GroupBy: []string{"alertname"}}},
+		Storage:      grouping.NewMemoryGroupStorage(nil),
+	})
+	require.NoError(t, err)
+
+	resolved := newAPITestAlert("fp-4", "HighCPU", nil)
+	endsAt := alertmanagerAPIStartTime.Add(time.Hour)
+	resolved.Status, resolved.EndsAt = core.StatusResolved, &endsAt
+
+	alerts := map[grouping.GroupKey][]*core.Alert{
+		"{web}:alertname=HighCPU": {
+			newAPITestAlert("fp-1", "HighCPU", map[string]string{"team": "web"}),
+			newAPITestAlert("fp-2", "HighCPU", map[string]string{"team": "web", "silenced": "true"}),
+			resolved,
+		},
+		"{db}:alertname=DiskFull":      {newAPITestAlert("fp-3", "DiskFull", map[string]string{"team": "db"})},
+		"{removed}:alertname=Watchdog": {newAPITestAlert("fp-5", "Watchdog", nil)},
+	}
+	var history []*core.Alert
+	for key, groupAlerts := range alerts {
+		for _, alert := range groupAlerts {
+			_, err := groupManager.AddAlertToGroup(ctx, alert, key)
+			require.NoError(t, err)
+			history = append(history, alert)
+		}
+	}
+
+	converter := &ConverterDependencies{SilenceChecker: labelSilenceChecker{}}
+	handler := NewAlertmanagerAPIHandler(AlertmanagerAPIConfig{
+		Version:   "1.2.3",
+		StartTime: alertmanagerAPIStartTime,
+		Config:    "route:\n  receiver: default\n",
+		Receivers: []string{"db", "default", "web"},
+		Groups:    groupManager,
+		Router: staticGroupRouter{
+			"{web}:alertname=HighCPU": "web",
+			"{db}:alertname=DiskFull": "db",
+		},
+		Converter: converter,
+	})
+
+	queryConfig := DefaultPrometheusQueryConfig()
+	queryConfig.EnableMetrics = false
+	queryHandler, err := NewPrometheusQueryHandler(&mockHistoryRepo{alerts: history}, nil, queryConfig, converter)
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/status", handler.HandleStatus)
+	mux.HandleFunc("GET /api/v2/receivers", handler.HandleReceivers)
+	mux.HandleFunc("GET /api/v2/alerts/groups", handler.HandleAlertGroups)
+	mux.HandleFunc("GET /api/v2/alerts", queryHandler.HandlePrometheusQuery)
+	return mux
+}
+
+func getJSON(t *testing.T, mux *http.ServeMux, target string, v any) int {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(v), rec.Body.String())
+	return rec.Code
+}
+
+func TestAlertmanagerAPIHandler_Status(t *testing.T) {
+	mux := newAlertmanagerAPITestMux(t)
+
+	var status AlertmanagerStatus
+	require.Equal(t, http.StatusOK, getJSON(t, mux, "/api/v2/status", &status))
+	assert.Equal(t, "disabled", status.Cluster.Status)
+	assert.Empty(t, status.Cluster.Peers)
+	assert.Equal(t, "1.2.3", status.VersionInfo.Version)
+	assert.NotEmpty(t, status.VersionInfo.GoVersion)
+	assert.Equal(t, "route:\n  receiver: default\n", status.Config.Original)
+	assert.Equal(t, "2026-01-02T03:04:05Z", status.Uptime)
+}
+
+func TestAlertmanagerAPIHandler_Receivers(t *testing.T) {
+	mux := newAlertmanagerAPITestMux(t)
+
+	var receivers []AlertmanagerReceiver
+	require.Equal(t, http.StatusOK, getJSON(t, mux, "/api/v2/receivers", &receivers))
+	assert.Equal(t, []AlertmanagerReceiver{{Name: "db"}, {Name: "default"}, {Name: "web"}}, receivers)
+}
+
+func TestAlertmanagerAPIHandler_AlertGroups(t *testing.T) {
+	mux := newAlertmanagerAPITestMux(t)
+
+	fingerprints := func(groups []AlertmanagerAlertGroup) map[string][]string {
+		result := map[string][]string{}
+		for _, group := range groups {
+			for _, alert := range group.Alerts {
+				result[group.Receiver.Name] = append(result[group.Receiver.Name], alert.Fingerprint)
+			}
+		}
+		return result
+	}
+
+	var groups []AlertmanagerAlertGroup
+	require.Equal(t, http.StatusOK, getJSON(t, mux, "/api/v2/alerts/groups", &groups))
+	require.Len(t, groups, 2)
+	assert.Equal(t, "db", groups[0].Receiver.Name)
+	assert.Equal(t, map[string]string{"alertname": "DiskFull"}, groups[0].Labels)
+	assert.Equal(t, map[string][]string{"db": {"fp-3"}, "web": {"fp-1", "fp-2"}}, fingerprints(groups))
+
+	tests := map[string]map[string][]string{
+		"/api/v2/alerts/groups?silenced=false":                                    {"db": {"fp-3"}, "web": {"fp-1"}},
+		"/api/v2/alerts/groups?active=false":                                      {"web": {"fp-2"}},
+		"/api/v2/alerts/groups?receiver=w.*":                                      {"web": {"fp-1", "fp-2"}},
+		"/api/v2/alerts/groups?receiver=we":                                       {},
+		`/api/v2/alerts/groups?filter=team%3D"db"`:                                {"db": {"fp-3"}},
+		`/api/v2/alerts/groups?filter=team%3D~"web|db"&filter=silenced!%3D"true"`: {"db": {"fp-3"}, "web": {"fp-1"}},
+	}
+	for target, want := range tests {
+		t.Run(target, func(t *testing.T) {
+			var groups []AlertmanagerAlertGroup
+			require.Equal(t, http.StatusOK, getJSON(t, mux, target, &groups))
+			assert.Equal(t, want, fingerprints(groups))
+		})
+	}
+
+	var message string
+	assert.Equal(t, http.StatusBadRequest, getJSON(t, mux, "/api/v2/alerts/groups?receiver=(", &message))
+	assert.Contains(t, message, "invalid receiver regex")
+	assert.Equal(t, http.StatusBadRequest, getJSON(t, mux, "/api/v2/alerts/groups?filter=team", &message))
+}
+
+func TestAlertmanagerAPIHandler_AlertGroupsWithoutGrouping(t *testing.T) {
+	handler := NewAlertmanagerAPIHandler(AlertmanagerAPIConfig{})
+
+	rec := httptest.NewRecorder()
+	handler.HandleAlertGroups(rec, httptest.NewRequest(http.MethodGet, "/api/v2/alerts/groups", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, "[]", rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	handler.HandleReceivers(rec, httptest.NewRequest(http.MethodGet, "/api/v2/receivers", nil))
+	assert.JSONEq(t, "[]", rec.Body.String())
+}
+
+func TestHandlePrometheusQuery_StateFilters(t *testing.T) {
+	mux := newAlertmanagerAPITestMux(t)
+
+	var alerts []AlertmanagerAlert
+	require.Equal(t, http.StatusOK, getJSON(t, mux, "/api/v2/alerts?silenced=false&filter=alertname%3D\"HighCPU\"", &alerts))
+	for _, alert := range alerts {
+		assert.Empty(t, alert.Status.SilencedBy, alert.Fingerprint)
+	}
+	require.Len(t, alerts, 1) // resolved fp-4 is not a current alert
+	assert.Equal(t, "fp-1", alerts[0].Fingerprint)
+
+	require.Equal(t, http.StatusOK, getJSON(t, mux, "/api/v2/alerts?status=resolved", &alerts))
+	require.Len(t, alerts, 1)
+	assert.Equal(t, "fp-4", alerts[0].Fingerprint)
+}
+
+// stubSilenceMatcher records the matched alert labels.
+type stubSilenceMatcher struct {
+	labels map[string]string
+}
+
+func (m *stubSilenceMatcher) IsAlertSilenced(ctx context.Context, alert *silencing.Alert) (bool, []string, error) {
+	m.labels = alert.Labels
+	return true, []string{"silence-1"}, nil
+}
+
+func TestNewSilenceManagerChecker(t *testing.T) {
+	matcher := &stubSilenceMatcher{}
+	checker := NewSilenceManagerChecker(matcher)
+	alert := newAPITestAlert("fp-1", "HighCPU", nil)
+
+	silences, err := checker.IsAlertSilenced(context.Background(), alert)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"silence-1"}, silences)
+	assert.Equal(t, alert.Labels, matcher.labels)
+
+	alert.Status = core.StatusResolved
+	silences, err = checker.IsAlertSilenced(context.Background(), alert)
+	require.NoError(t, err)
+	assert.Empty(t, silences)
+}
+
+func TestNewInhibitionStateChecker(t *testing.T) {
+	ctx := context.Background()
+	states := inhibition.NewDefaultStateManager(nil, nil, nil)
+	require.NoError(t, states.RecordInhibition(ctx, &inhibition.InhibitionState{
+		TargetFingerprint: "fp-target",
+		SourceFingerprint: "fp-source",
+		RuleName:          "critical-inhibits-warning",
+		InhibitedAt:       time.Now(),
+	}))
+	checker := NewInhibitionStateChecker(states)
+
+	inhibitors, err := checker.IsAlertInhibited(ctx, &core.Alert{Fingerprint: "fp-target"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"fp-source"}, inhibitors)
+
+	inhibitors, err = checker.IsAlertInhibited(ctx, &core.Alert{Fingerprint: "fp-other"})
+	require.NoError(t, err)
+	assert.Empty(t, inhibitors)
+}
@@ -3,6 +3,7 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log/slog"
@@ -12,6 +13,7 @@ import (
 	"time"
 
 	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/core/services"
 	"github.com/vitaliisemenov/alert-history/internal/infrastructure/cache"
 	"github.com/vitaliisemenov/alert-history/internal/ui"
 )
@@ -22,6 +24,7 @@ type DashboardAlertsHandler struct {
 	historyRepo          core.AlertHistoryRepository
 	classificationEnricher ui.ClassificationEnricher // optional
 	cache                cache.Cache                    // optional, for response caching
+	ackService           services.AckService            // optional, see SetAckService
 	logger               *slog.Logger
 }
 
@@ -44,6 +47,12 @@ func NewDashboardAlertsHandler(
 	}
 }
 
+// SetAckService sets the acknowledgement service used to include ack and
+// owner state in the response (optional).
+func (h *DashboardAlertsHandler) SetAckService(service services.AckService) {
+	h.ackService = service
+}
+
 // DashboardAlertResponse represents the response format for dashboard alerts endpoint.
 type DashboardAlertResponse struct {
 	Alerts    []DashboardAlert  `json:"alerts"`
@@ -65,6 +74,9 @@ type DashboardAlert struct {
 
 	// Optional (if include_classification=true)
 	Classification *ClassificationSummary `json:"classification,omitempty"`
+
+	// Optional (if acknowledged or assigned)
+	Ack *ui.AckDisplayData `json:"ack,omitempty"`
 }
 
 // ClassificationSummary represents classification data in compact format.
@@ -152,6 +164,7 @@ func (h *DashboardAlertsHandler) GetRecentAlerts(w http.ResponseWriter, r *http.
 
 	// Format response
 	response = h.formatResponse(enrichedAlerts, params)
+	h.addAckData(ctx, response.Alerts)
 
 	// Cache response (if enabled)
 	if h.cache != nil {
@@ -333,6 +346,28 @@ func (h *DashboardAlertsHandler) formatResponse(enrichedAlerts []*ui.EnrichedAle
 	}
 }
 
+// addAckData adds acknowledgement state to the alerts (errors are logged only).
+func (h *DashboardAlertsHandler) addAckData(ctx context.Context, alerts []DashboardAlert) {
+	if h.ackService == nil || len(alerts) == 0 {
+		return
+	}
+
+	fingerprints := make([]string, 0, len(alerts))
+	for _, alert := range alerts {
+		fingerprints = append(fingerprints, alert.Fingerprint)
+	}
+	acks, err := h.ackService.ListAcks(ctx, core.AckTargetAlert, fingerprints)
+	if err != nil {
+		h.logger.Warn("Failed to load acknowledgements, continuing without them", "error", err)
+		return
+	}
+
+	now := time.Now()
+	for i := range alerts {
+		alerts[i].Ack = ui.ToAckDisplayData(acks[alerts[i].Fingerprint], now)
+	}
+}
+
 // buildCacheKey builds a cache key from query parameters.
 func (h *DashboardAlertsHandler) buildCacheKey(params *QueryParams) string {
 	key := fmt.Sprintf("dashboard:alerts:recent:%d", params.Limit)
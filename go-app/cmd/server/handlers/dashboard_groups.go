@@ -0,0 +1,166 @@
+package handlers
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure/grouping"
+)
+
+// Dashboard groups limits
+const (
+	defaultDashboardGroupsLimit = 50
+	maxDashboardGroupsLimit     = 500
+)
+
+// DashboardGroupsHandler serves alert groups with their incident summaries
+// for the dashboard.
+type DashboardGroupsHandler struct {
+	groups grouping.AlertGroupManager
+	logger *slog.Logger
+}
+
+// NewDashboardGroupsHandler creates a new dashboard groups handler.
+func NewDashboardGroupsHandler(groups grouping.AlertGroupManager, logger *slog.Logger) *DashboardGroupsHandler {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &DashboardGroupsHandler{groups: groups, logger: logger}
+}
+
+// DashboardGroupsResponse is the response of GET /api/dashboard/groups.
+type DashboardGroupsResponse struct {
+	Groups    []DashboardGroup `json:"groups"`
+	Count     int              `json:"count"`
+	Total     int              `json:"total"`
+	Timestamp string           `json:"timestamp"`
+}
+
+// DashboardGroup is an alert group in compact format.
+type DashboardGroup struct {
+	Key           string             `json:"key"`
+	State         string             `json:"state"`
+	Labels        map[string]string  `json:"labels,omitempty"` // group_by labels
+	AlertCount    int                `json:"alert_count"`
+	FiringCount   int                `json:"firing_count"`
+	ResolvedCount int                `json:"resolved_count"`
+	UpdatedAt     time.Time          `json:"updated_at"`
+	Summary       *core.GroupSummary `json:"summary,omitempty"`
+}
+
+// GetGroups handles GET /api/dashboard/groups
+//
+// Returns alert groups, largest firing groups first, with the incident
+// summary produced when the group was last notified.
+//
+// Query parameters:
+//   - limit: maximum groups to return (default 50, max 500)
+//   - state: firing, resolved, mixed or silenced
+//   - min_size: minimum number of alerts in the group
+func (h *DashboardGroupsHandler) GetGroups(w http.ResponseWriter, r *http.Request) {
+	filters, limit, err := parseDashboardGroupsQuery(r)
+	if err != nil {
+		h.logger.Warn("Invalid query parameters", "error", err)
+		http.Error(w, fmt.Sprintf("Invalid parameters: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	groups, err := h.groups.ListGroups(r.Context(), filters)
+	if err != nil {
+		h.logger.Error("Failed to list alert groups", "error", err)
+		http.Error(w, "Failed to list alert groups", http.StatusInternalServerError)
+		return
+	}
+
+	result := make([]DashboardGroup, 0, len(groups))
+	for _, group := range groups {
+		result = append(result, newDashboardGroup(group))
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].FiringCount != result[j].FiringCount {
+			return result[i].FiringCount > result[j].FiringCount
+		}
+		return result[i].Key < result[j].Key
+	})
+
+	total := len(result)
+	if len(result) > limit {
+		result = result[:limit]
+	}
+
+	respondJSON(w, http.StatusOK, &DashboardGroupsResponse{
+		Groups:    result,
+		Count:     len(result),
+		Total:     total,
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+	})
+}
+
+// newDashboardGroup converts an alert group (a clone from the manager).
+func newDashboardGroup(group *grouping.AlertGroup) DashboardGroup {
+	item := DashboardGroup{
+		Key:        string(group.Key),
+		AlertCount: len(group.Alerts),
+		Summary:    group.Summary,
+	}
+	if group.Metadata != nil {
+		item.State = string(group.Metadata.State)
+		item.FiringCount = group.Metadata.FiringCount
+		item.ResolvedCount = group.Metadata.ResolvedCount
+		item.UpdatedAt = group.Metadata.UpdatedAt
+
+		// group_by labels have the same value on every alert of the group
+		for _, alert := range group.Alerts {
+			for _, name := range group.Metadata.GroupBy {
+				if value, ok := alert.Labels[name]; ok {
+					if item.Labels == nil {
+						item.Labels = make(map[string]string)
+					}
+					item.Labels[name] = value
+				}
+			}
+			break
+		}
+	}
+	return item
+}
+
+// parseDashboardGroupsQuery parses the group filters and limit.
+func parseDashboardGroupsQuery(r *http.Request) (*grouping.GroupFilters, int, error) {
+	query := r.URL.Query()
+	filters := &grouping.GroupFilters{}
+
+	limit := defaultDashboardGroupsLimit
+	if value := query.Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 || parsed > maxDashboardGroupsLimit {
+			return nil, 0, fmt.Errorf("limit must be between 1 and %d", maxDashboardGroupsLimit)
+		}
+		limit = parsed
+	}
+
+	if value := query.Get("state"); value != "" {
+		state := grouping.GroupState(value)
+		switch state {
+		case grouping.GroupStateFiring, grouping.GroupStateResolved, grouping.GroupStateMixed, grouping.GroupStateSilenced:
+			filters.State = &state
+		default:
+			return nil, 0, fmt.Errorf("state must be firing, resolved, mixed or silenced")
+		}
+	}
+
+	if value := query.Get("min_size"); value != "" {
+		minSize, err := strconv.Atoi(value)
+		if err != nil || minSize < 0 {
+			return nil, 0, fmt.Errorf("min_size must be a non-negative integer")
+		}
+		filters.MinSize = &minSize
+	}
+
+	return filters, limit, nil
+}
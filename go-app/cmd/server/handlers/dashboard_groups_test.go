@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure/grouping"
+)
+
+func TestDashboardGroupsHandler_GetGroups(t *testing.T) {
+	ctx := context.Background()
+	groupManager, err := grouping.NewDefaultGroupManager(ctx, grouping.DefaultGroupManagerConfig{
+		KeyGenerator: grouping.NewGroupKeyGenerator(),
+		Config:       &grouping.GroupingConfig{Route: &grouping.Route{Receiver: "default", GroupBy: []string{"alertname"}}},
+		Storage:      grouping.NewMemoryGroupStorage(nil),
+	})
+	require.NoError(t, err)
+
+	stormKey := grouping.GroupKey("{}:alertname=PodCrashLooping")
+	for i := 0; i < 3; i++ {
+		alert := newAPITestAlert(fmt.Sprintf("fp-%d", i), "PodCrashLooping", map[string]string{"pod": fmt.Sprintf("api-%d", i)})
+		_, err := groupManager.AddAlertToGroup(ctx, alert, stormKey)
+		require.NoError(t, err)
+	}
+	_, err = groupManager.AddAlertToGroup(ctx, newAPITestAlert("fp-9", "DiskFull", nil), "{}:alertname=DiskFull")
+	require.NoError(t, err)
+	require.NoError(t, groupManager.SetGroupSummary(ctx, stormKey, &core.GroupSummary{
+		Summary: "3 api pods crash looping",
+		Source:  core.GroupSummarySourceLLM,
+	}))
+
+	handler := NewDashboardGroupsHandler(groupManager, nil)
+
+	get := func(query string) (*httptest.ResponseRecorder, DashboardGroupsResponse) {
+		recorder := httptest.NewRecorder()
+		handler.GetGroups(recorder, httptest.NewRequest(http.MethodGet, "/api/dashboard/groups"+query, nil))
+		var response DashboardGroupsResponse
+		if recorder.Code == http.StatusOK {
+			require.NoError(t, json.NewDecoder(recorder.Body).Decode(&response))
+		}
+		return recorder, response
+	}
+
+	recorder, response := get("")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, 2, response.Count)
+	storm := response.Groups[0]
+	assert.Equal(t, string(stormKey), storm.Key)
+	assert.Equal(t, 3, storm.FiringCount)
+	assert.Equal(t, map[string]string{"alertname": "PodCrashLooping"}, storm.Labels)
+	require.NotNil(t, storm.Summary)
+	assert.Equal(t, "3 api pods crash looping", storm.Summary.Summary)
+	assert.Nil(t, response.Groups[1].Summary)
+
+	_, response = get("?min_size=2")
+	assert.Equal(t, 1, response.Count)
+
+	_, response = get("?limit=1")
+	assert.Equal(t, 1, response.Count)
+	assert.Equal(t, 2, response.Total)
+
+	for _, query := range []string{"?limit=0", "?state=unknown", "?min_size=x"} {
+		recorder, _ := get(query)
+		assert.Equal(t, http.StatusBadRequest, recorder.Code, query)
+	}
+}
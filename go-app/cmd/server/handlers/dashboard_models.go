@@ -2,7 +2,11 @@
 // TN-77: Modern Dashboard Page (150% Quality Target)
 package handlers
 
-import "time"
+import (
+	"time"
+
+	"github.com/vitaliisemenov/alert-history/internal/ui"
+)
 
 // ModernDashboardData is the main data structure for modern dashboard page (TN-77).
 type ModernDashboardData struct {
@@ -21,16 +25,17 @@ type ModernDashboardData struct {
 
 // AlertSummary is a compact alert representation for dashboard.
 type AlertSummary struct {
-	Fingerprint      string            `json:"fingerprint"`
-	AlertName        string            `json:"alertname"`
-	Status           string            `json:"status"`       // firing, resolved
-	Severity         string            `json:"severity"`     // critical, warning, info
-	Summary          string            `json:"summary"`
-	Description      string            `json:"description"`
-	Labels           map[string]string `json:"labels"`
-	StartsAt         time.Time         `json:"starts_at"`
-	EndsAt           *time.Time        `json:"ends_at,omitempty"`
-	AIClassification *AIClassification `json:"ai_classification,omitempty"`
+	Fingerprint      string             `json:"fingerprint"`
+	AlertName        string             `json:"alertname"`
+	Status           string             `json:"status"`       // firing, resolved
+	Severity         string             `json:"severity"`     // critical, warning, info
+	Summary          string             `json:"summary"`
+	Description      string             `json:"description"`
+	Labels           map[string]string  `json:"labels"`
+	StartsAt         time.Time          `json:"starts_at"`
+	EndsAt           *time.Time         `json:"ends_at,omitempty"`
+	AIClassification *AIClassification  `json:"ai_classification,omitempty"`
+	Ack              *ui.AckDisplayData `json:"ack,omitempty"`
 }
 
 // AIClassification contains LLM-generated metadata.
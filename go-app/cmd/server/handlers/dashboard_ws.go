@@ -4,11 +4,14 @@ package handlers
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
 	"github.com/vitaliisemenov/alert-history/internal/realtime"
 )
 
@@ -54,9 +57,194 @@ func NewDashboardWebSocketHub(
 
 // HandleDashboardWebSocket handles WebSocket upgrade for dashboard.
 // GET /ws/dashboard
+//
+// Every connection has its own EventBus subscription, so it takes the same
+// filters (type, namespace, severity, filter) and resume cursor
+// (last_event_id) as /api/v2/events/stream. Messages are realtime.Event JSON.
 func (h *DashboardWebSocketHub) HandleDashboardWebSocket(w http.ResponseWriter, r *http.Request) {
-	// Use existing HandleWebSocket from WebSocketHub
-	h.HandleWebSocket(w, r)
+	if h.eventBus == nil {
+		// Use existing HandleWebSocket from WebSocketHub
+		h.HandleWebSocket(w, r)
+		return
+	}
+
+	filter, err := parseEventFilter(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	resumeFrom, err := lastEventID(r)
+	if err != nil {
+		http.Error(w, "Invalid last_event_id", http.StatusBadRequest)
+		return
+	}
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		h.logger.Error("Failed to upgrade dashboard WebSocket connection",
+			"error", err,
+			"remote_addr", r.RemoteAddr,
+		)
+		return
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	subscriber := newDashboardWSSubscriber(ctx, filter)
+	replay, complete, err := h.eventBus.SubscribeFrom(subscriber, resumeFrom)
+	if err != nil {
+		h.logger.Error("Failed to subscribe dashboard WebSocket client", "error", err)
+		return
+	}
+	defer h.eventBus.Unsubscribe(subscriber)
+
+	h.logger.Info("Dashboard WebSocket client connected",
+		"remote_addr", conn.RemoteAddr().String(),
+		"subscriber_id", subscriber.ID(),
+		"last_event_id", resumeFrom,
+		"filtered", filter != nil,
+	)
+
+	// Reader detects disconnects and keeps the read deadline alive
+	go h.readDashboardConn(conn, cancel)
+
+	// Catch up on missed events before writing live ones
+	if resumeFrom != "" {
+		if !complete {
+			replay = append([]realtime.Event{newStreamResetEvent(resumeFrom)}, replay...)
+		}
+		for _, event := range replay {
+			if event.Type != realtime.EventTypeStreamReset && !filter.Matches(event) {
+				continue
+			}
+			if err := writeDashboardEvent(conn, event); err != nil {
+				return
+			}
+		}
+	}
+
+	h.writeDashboardConn(ctx, conn, subscriber)
+}
+
+// readDashboardConn reads (and discards) client messages until the
+// connection is closed; pongs extend the read deadline.
+func (h *DashboardWebSocketHub) readDashboardConn(conn *websocket.Conn, cancel context.CancelFunc) {
+	defer cancel()
+
+	conn.SetReadDeadline(time.Now().Add(60 * time.Second))
+	conn.SetPongHandler(func(string) error {
+		conn.SetReadDeadline(time.Now().Add(60 * time.Second))
+		return nil
+	})
+
+	for {
+		if _, _, err := conn.ReadMessage(); err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
+				h.logger.Warn("Dashboard WebSocket read error", "error", err)
+			}
+			return
+		}
+	}
+}
+
+// writeDashboardConn is the single writer of a dashboard connection.
+func (h *DashboardWebSocketHub) writeDashboardConn(ctx context.Context, conn *websocket.Conn, subscriber *dashboardWSSubscriber) {
+	ticker := time.NewTicker(54 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+			conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
+			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+
+		case event, ok := <-subscriber.events:
+			if !ok {
+				return
+			}
+			if err := writeDashboardEvent(conn, event); err != nil {
+				h.logger.Debug("Failed to send dashboard WebSocket event",
+					"subscriber_id", subscriber.ID(),
+					"error", err,
+				)
+				return
+			}
+		}
+	}
+}
+
+// writeDashboardEvent writes an event as JSON message.
+func writeDashboardEvent(conn *websocket.Conn, event realtime.Event) error {
+	conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
+	return conn.WriteJSON(event)
+}
+
+// dashboardWSSubscriber is the EventBus subscription of one dashboard WebSocket connection.
+type dashboardWSSubscriber struct {
+	id     string
+	ctx    context.Context
+	filter *realtime.EventFilter
+	events chan realtime.Event
+	mu     sync.Mutex
+	closed bool
+}
+
+func newDashboardWSSubscriber(ctx context.Context, filter *realtime.EventFilter) *dashboardWSSubscriber {
+	return &dashboardWSSubscriber{
+		id:     uuid.New().String(),
+		ctx:    ctx,
+		filter: filter,
+		events: make(chan realtime.Event, 64),
+	}
+}
+
+// ID returns the subscriber ID.
+func (s *dashboardWSSubscriber) ID() string {
+	return s.id
+}
+
+// Send queues an event matching the filter; a full queue drops the subscriber.
+func (s *dashboardWSSubscriber) Send(event realtime.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return realtime.ErrSubscriberClosed
+	}
+	if !s.filter.Matches(event) {
+		return nil
+	}
+
+	select {
+	case s.events <- event:
+		return nil
+	default:
+		return fmt.Errorf("subscriber channel full")
+	}
+}
+
+// Close closes the event queue.
+func (s *dashboardWSSubscriber) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.closed {
+		s.closed = true
+		close(s.events)
+	}
+	return nil
+}
+
+// Context returns the subscriber context.
+func (s *dashboardWSSubscriber) Context() context.Context {
+	return s.ctx
 }
 
 // BroadcastDashboardEvent broadcasts a dashboard event to all WebSocket clients.
@@ -0,0 +1,182 @@
+package handlers
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/pkg/history/filters"
+)
+
+// HistoryExporter streams alert history matching pkg/history/filters.
+// Implemented by repository.PostgresHistoryRepository and
+// repository.SQLiteHistoryRepository.
+type HistoryExporter interface {
+	ExportHistory(ctx context.Context, historyFilters []filters.Filter, fn func(alert *core.Alert) error) error
+}
+
+// exportFlushInterval is the number of alerts written between flushes.
+const exportFlushInterval = 100
+
+// historyExportColumns is the CSV header of GET /history/export?format=csv.
+var historyExportColumns = []string{
+	"fingerprint", "alert_name", "status", "severity", "namespace",
+	"starts_at", "ends_at", "generator_url", "labels", "annotations",
+}
+
+// HandleExport handles GET /history/export requests.
+//
+// Streams every alert matching the history filters (status, severity,
+// namespace, labels_*, alert_name*, from/to, search, ...) newest first,
+// as NDJSON (format=ndjson, default) or CSV (format=csv). The response is
+// written while the repository walks the alerts, so exports are not
+// limited to a page. An error after the first alert was written aborts the
+// response (http.ErrAbortHandler): clients see a failed transfer rather
+// than a truncated export.
+func (h *HistoryHandlerV2) HandleExport(w http.ResponseWriter, r *http.Request) {
+	startTime := time.Now()
+
+	if r.Method != http.MethodGet {
+		h.logger.Warn("Invalid HTTP method for history export", "method", r.Method)
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	exporter, ok := h.repository.(HistoryExporter)
+	if !ok {
+		http.Error(w, "History export is not supported by the storage backend", http.StatusNotImplemented)
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = "ndjson"
+	}
+	if format != "ndjson" && format != "csv" {
+		http.Error(w, "format must be ndjson or csv", http.StatusBadRequest)
+		return
+	}
+
+	historyFilters, err := filters.NewRegistry(h.logger).CreateFromQueryParams(r.URL.Query())
+	if err != nil {
+		h.logger.Error("Failed to parse history export filters", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	write, flush := h.exportWriter(w, format)
+	exported := 0
+	err = exporter.ExportHistory(r.Context(), historyFilters, func(alert *core.Alert) error {
+		if err := write(alert); err != nil {
+			return err
+		}
+		exported++
+		if exported%exportFlushInterval == 0 {
+			flush()
+		}
+		return nil
+	})
+	if err != nil {
+		h.logger.Error("History export failed", "format", format, "exported", exported, "error", err)
+		if exported == 0 {
+			// Nothing streamed yet: the status code can still report the error
+			http.Error(w, "Failed to export alert history", http.StatusInternalServerError)
+			return
+		}
+		panic(http.ErrAbortHandler)
+	}
+	if exported == 0 || format == "csv" {
+		// Empty exports still get the content type (and the CSV header)
+		write(nil)
+	}
+	flush()
+
+	h.logger.Info("History export completed",
+		"format", format,
+		"filters", len(historyFilters),
+		"exported_alerts", exported,
+		"processing_time_ms", time.Since(startTime).Milliseconds(),
+	)
+}
+
+// exportWriter returns the functions writing an alert in format and flushing
+// the response. Headers are sent with the first write, a nil alert writes
+// only the headers (and flushes the CSV writer).
+func (h *HistoryHandlerV2) exportWriter(w http.ResponseWriter, format string) (write func(alert *core.Alert) error, flush func()) {
+	started := false
+	start := func(contentType, filename string) {
+		if started {
+			return
+		}
+		started = true
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`"`)
+		w.WriteHeader(http.StatusOK)
+	}
+	flushResponse := func() {
+		if flusher, ok := w.(http.Flusher); ok && started {
+			flusher.Flush()
+		}
+	}
+
+	if format == "csv" {
+		csvWriter := csv.NewWriter(w)
+		write = func(alert *core.Alert) error {
+			if !started {
+				start("text/csv; charset=utf-8", "alert-history.csv")
+				if err := csvWriter.Write(historyExportColumns); err != nil {
+					return err
+				}
+			}
+			if alert == nil {
+				csvWriter.Flush()
+				return csvWriter.Error()
+			}
+			return csvWriter.Write(historyExportRecord(alert))
+		}
+		flush = func() {
+			csvWriter.Flush()
+			flushResponse()
+		}
+		return write, flush
+	}
+
+	encoder := json.NewEncoder(w)
+	write = func(alert *core.Alert) error {
+		start("application/x-ndjson", "alert-history.ndjson")
+		if alert == nil {
+			return nil
+		}
+		return encoder.Encode(alert)
+	}
+	return write, flushResponse
+}
+
+// historyExportRecord returns the CSV record of alert (historyExportColumns).
+func historyExportRecord(alert *core.Alert) []string {
+	var endsAt, generatorURL string
+	if alert.EndsAt != nil {
+		endsAt = alert.EndsAt.UTC().Format(time.RFC3339)
+	}
+	if alert.GeneratorURL != nil {
+		generatorURL = *alert.GeneratorURL
+	}
+	labels, _ := json.Marshal(alert.Labels)
+	annotations, _ := json.Marshal(alert.Annotations)
+
+	return []string{
+		alert.Fingerprint,
+		alert.AlertName,
+		string(alert.Status),
+		alert.Labels["severity"],
+		alert.Labels["namespace"],
+		alert.StartsAt.UTC().Format(time.RFC3339),
+		endsAt,
+		generatorURL,
+		string(labels),
+		string(annotations),
+	}
+}
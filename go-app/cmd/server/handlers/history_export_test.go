@@ -0,0 +1,212 @@
+package handlers
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/pkg/history/filters"
+)
+
+// mockHistoryExporter is a history repository supporting ExportHistory.
+type mockHistoryExporter struct {
+	MockHistoryRepository
+	alerts  []*core.Alert
+	err     error
+	filters []filters.Filter
+}
+
+func (m *mockHistoryExporter) ExportHistory(ctx context.Context, historyFilters []filters.Filter, fn func(alert *core.Alert) error) error {
+	m.filters = historyFilters
+	for _, alert := range m.alerts {
+		if err := fn(alert); err != nil {
+			return err
+		}
+	}
+	return m.err
+}
+
+func exportTestAlerts() []*core.Alert {
+	endsAt := time.Date(2025, 11, 30, 11, 0, 0, 0, time.UTC)
+	return []*core.Alert{
+		{
+			Fingerprint: "fp-1",
+			AlertName:   "HighCPU",
+			Status:      core.StatusFiring,
+			Labels:      map[string]string{"severity": "critical", "namespace": "prod"},
+			StartsAt:    time.Date(2025, 11, 30, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			Fingerprint: "fp-2",
+			AlertName:   "DiskFull",
+			Status:      core.StatusResolved,
+			Labels:      map[string]string{"severity": "warning"},
+			Annotations: map[string]string{"summary": "Disk, almost \"full\""},
+			StartsAt:    time.Date(2025, 11, 30, 10, 0, 0, 0, time.UTC),
+			EndsAt:      &endsAt,
+		},
+	}
+}
+
+func TestHandleExport_NDJSON(t *testing.T) {
+	repo := &mockHistoryExporter{alerts: exportTestAlerts()}
+	handler := NewHistoryHandlerV2(repo, nil)
+
+	req := httptest.NewRequest("GET", "/history/export?status=firing&severity=critical", nil)
+	w := httptest.NewRecorder()
+	handler.HandleExport(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "application/x-ndjson" {
+		t.Errorf("Expected NDJSON content type, got %s", ct)
+	}
+	if len(repo.filters) != 2 {
+		t.Errorf("Expected 2 filters passed to the exporter, got %d", len(repo.filters))
+	}
+
+	var fingerprints []string
+	scanner := bufio.NewScanner(w.Body)
+	for scanner.Scan() {
+		var alert core.Alert
+		if err := json.Unmarshal(scanner.Bytes(), &alert); err != nil {
+			t.Fatalf("Invalid NDJSON line %q: %v", scanner.Text(), err)
+		}
+		fingerprints = append(fingerprints, alert.Fingerprint)
+	}
+	if strings.Join(fingerprints, ",") != "fp-1,fp-2" {
+		t.Errorf("Expected fp-1,fp-2, got %v", fingerprints)
+	}
+}
+
+func TestHandleExport_CSV(t *testing.T) {
+	handler := NewHistoryHandlerV2(&mockHistoryExporter{alerts: exportTestAlerts()}, nil)
+
+	req := httptest.NewRequest("GET", "/history/export?format=csv", nil)
+	w := httptest.NewRecorder()
+	handler.HandleExport(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", w.Code)
+	}
+	if !strings.HasPrefix(w.Header().Get("Content-Type"), "text/csv") {
+		t.Errorf("Expected CSV content type, got %s", w.Header().Get("Content-Type"))
+	}
+
+	records, err := csv.NewReader(w.Body).ReadAll()
+	if err != nil {
+		t.Fatalf("Invalid CSV: %v", err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("Expected header + 2 records, got %d", len(records))
+	}
+	if strings.Join(records[0], ",") != strings.Join(historyExportColumns, ",") {
+		t.Errorf("Unexpected header: %v", records[0])
+	}
+	if records[1][3] != "critical" || records[1][4] != "prod" || records[1][6] != "" {
+		t.Errorf("Unexpected record: %v", records[1])
+	}
+	if records[2][6] != "2025-11-30T11:00:00Z" || records[2][9] != `{"summary":"Disk, almost \"full\""}` {
+		t.Errorf("Unexpected record: %v", records[2])
+	}
+}
+
+func TestHandleExport_EmptyCSV(t *testing.T) {
+	handler := NewHistoryHandlerV2(&mockHistoryExporter{}, nil)
+
+	req := httptest.NewRequest("GET", "/history/export?format=csv", nil)
+	w := httptest.NewRecorder()
+	handler.HandleExport(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", w.Code)
+	}
+	if got := strings.TrimSpace(w.Body.String()); got != strings.Join(historyExportColumns, ",") {
+		t.Errorf("Expected only the CSV header, got %q", got)
+	}
+}
+
+func TestHandleExport_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		repository core.AlertHistoryRepository
+		query      string
+		wantStatus int
+	}{
+		{"unsupported backend", &MockHistoryRepository{}, "", http.StatusNotImplemented},
+		{"invalid format", &mockHistoryExporter{}, "format=xml", http.StatusBadRequest},
+		{"invalid filter", &mockHistoryExporter{}, "status=unknown", http.StatusBadRequest},
+		{"export error", &mockHistoryExporter{err: errors.New("connection refused")}, "", http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewHistoryHandlerV2(tt.repository, nil)
+
+			req := httptest.NewRequest("GET", "/history/export?"+tt.query, nil)
+			w := httptest.NewRecorder()
+			handler.HandleExport(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("Expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+		})
+	}
+}
+
+func TestHandleExport_MidStreamErrorAbortsResponse(t *testing.T) {
+	for _, format := range []string{"ndjson", "csv"} {
+		t.Run(format, func(t *testing.T) {
+			repo := &mockHistoryExporter{alerts: exportTestAlerts(), err: errors.New("connection reset")}
+			handler := NewHistoryHandlerV2(repo, nil)
+			req := httptest.NewRequest(http.MethodGet, "/history/export?format="+format, nil)
+			w := httptest.NewRecorder()
+
+			defer func() {
+				if r := recover(); r != http.ErrAbortHandler {
+					t.Errorf("Expected the response to be aborted, got %v", r)
+				}
+			}()
+			handler.HandleExport(w, req)
+		})
+	}
+}
+
+func TestParseHistoryRequest_Cursor(t *testing.T) {
+	handler := &HistoryHandlerV2{}
+
+	req := httptest.NewRequest("GET", "/history?cursor=&per_page=100", nil)
+	result, err := handler.parseHistoryRequest(req.URL.Query())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result.Pagination.Cursor == nil || *result.Pagination.Cursor != "" {
+		t.Errorf("Expected empty cursor (first page), got %v", result.Pagination.Cursor)
+	}
+
+	cursor := core.NewHistoryCursor(&core.Alert{Fingerprint: "fp-1", StartsAt: time.Now()}).Encode()
+	req = httptest.NewRequest("GET", "/history?cursor="+cursor, nil)
+	result, err = handler.parseHistoryRequest(req.URL.Query())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if *result.Pagination.Cursor != cursor {
+		t.Errorf("Expected cursor %s, got %s", cursor, *result.Pagination.Cursor)
+	}
+
+	for _, query := range []string{"cursor=invalid", "cursor=&sort_field=severity"} {
+		req = httptest.NewRequest("GET", "/history?"+query, nil)
+		if _, err := handler.parseHistoryRequest(req.URL.Query()); err == nil {
+			t.Errorf("Expected error for %s", query)
+		}
+	}
+}
@@ -84,6 +84,7 @@ func (h *HistoryHandlerV2) HandleHistory(w http.ResponseWriter, r *http.Request)
 	h.logger.Info("History request completed",
 		"page", response.Page,
 		"per_page", response.PerPage,
+		"cursor", req.Pagination.Cursor != nil,
 		"total_alerts", response.Total,
 		"returned_alerts", len(response.Alerts),
 		"processing_time_ms", processingTime.Milliseconds(),
@@ -119,6 +120,15 @@ func (h *HistoryHandlerV2) parseHistoryRequest(query map[string][]string) (*core
 	}
 	req.Pagination.PerPage = perPage
 
+	// Keyset pagination: empty cursor for the first page, then next_cursor
+	if cursor, ok := query["cursor"]; ok {
+		c := ""
+		if len(cursor) > 0 {
+			c = cursor[0]
+		}
+		req.Pagination.Cursor = &c
+	}
+
 	// Parse filters
 	if statusStr := query["status"]; len(statusStr) > 0 {
 		status := core.AlertStatus(statusStr[0])
@@ -101,6 +101,10 @@ func (m *mockStateManager) RemoveInhibition(ctx context.Context, targetFingerpri
 	return m.err
 }
 
+func (m *mockStateManager) ReleaseBySource(ctx context.Context, sourceFingerprint string) ([]*inhibition.InhibitionState, error) {
+	return nil, m.err
+}
+
 func (m *mockStateManager) GetActiveInhibitions(ctx context.Context) ([]*inhibition.InhibitionState, error) {
 	if m.err != nil {
 		return nil, m.err
@@ -5,9 +5,13 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"regexp"
+	"slices"
 	"time"
 
 	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/core/silencing"
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure/inhibition"
 )
 
 // Alert ConverterDependencies holds optional dependencies for format conversion.
@@ -17,6 +21,7 @@ import (
 type ConverterDependencies struct {
 	SilenceChecker    SilenceChecker    // Optional: Check if alerts are silenced
 	InhibitionChecker InhibitionChecker // Optional: Check if alerts are inhibited
+	ReceiverResolver  ReceiverResolver  // Optional: Route tree receivers of alerts
 	Logger            *slog.Logger      // Optional: Structured logging
 }
 
@@ -38,6 +43,57 @@ type InhibitionChecker interface {
 	IsAlertInhibited(ctx context.Context, alert *core.Alert) ([]string, error)
 }
 
+// ReceiverResolver returns the receivers an alert is routed to.
+//
+// Implemented by the route tree dispatcher (services.GroupDispatcher).
+type ReceiverResolver interface {
+	Receivers(alert *core.Alert) ([]string, error)
+}
+
+// silenceMatcher is the TN-134 silence manager matching API.
+type silenceMatcher interface {
+	IsAlertSilenced(ctx context.Context, alert *silencing.Alert) (bool, []string, error)
+}
+
+type silenceManagerChecker struct {
+	manager silenceMatcher
+}
+
+// NewSilenceManagerChecker adapts the silence manager to SilenceChecker.
+func NewSilenceManagerChecker(manager silenceMatcher) SilenceChecker {
+	return &silenceManagerChecker{manager: manager}
+}
+
+// IsAlertSilenced implements SilenceChecker. Resolved alerts are never silenced.
+func (c *silenceManagerChecker) IsAlertSilenced(ctx context.Context, alert *core.Alert) ([]string, error) {
+	if alert.Status == core.StatusResolved {
+		return nil, nil
+	}
+	_, silenceIDs, err := c.manager.IsAlertSilenced(ctx, &silencing.Alert{
+		Labels:      alert.Labels,
+		Annotations: alert.Annotations,
+	})
+	return silenceIDs, err
+}
+
+type inhibitionStateChecker struct {
+	states inhibition.InhibitionStateManager
+}
+
+// NewInhibitionStateChecker adapts the inhibition state manager to InhibitionChecker.
+func NewInhibitionStateChecker(states inhibition.InhibitionStateManager) InhibitionChecker {
+	return &inhibitionStateChecker{states: states}
+}
+
+// IsAlertInhibited implements InhibitionChecker.
+func (c *inhibitionStateChecker) IsAlertInhibited(ctx context.Context, alert *core.Alert) ([]string, error) {
+	state, err := c.states.GetInhibitionState(ctx, alert.Fingerprint)
+	if err != nil || state == nil {
+		return nil, err
+	}
+	return []string{state.SourceFingerprint}, nil
+}
+
 // ConvertToAlertmanagerFormat converts core.Alert to Alertmanager v2 format.
 //
 // This is the main conversion function that transforms internal domain models
@@ -47,7 +103,8 @@ type InhibitionChecker interface {
 //   - Labels: Direct copy (map[string]string)
 //   - Annotations: Direct copy (map[string]string)
 //   - StartsAt: Format as RFC3339
-//   - EndsAt: Format as RFC3339 (omit if nil/zero for active alerts)
+//   - UpdatedAt: Alert timestamp (StartsAt if unset) as RFC3339
+//   - EndsAt: Format as RFC3339 (zero time if nil for active alerts)
 //   - GeneratorURL: Copy if present
 //   - Status: Build from alert status + silence/inhibition checks
 //   - Receivers: Extract from alert metadata (placeholder for now)
@@ -128,9 +185,13 @@ func convertSingleAlert(
 		return AlertmanagerAlert{}, fmt.Errorf("failed to build alert status: %w", err)
 	}
 
-	// Format timestamps
+	// Format timestamps (Alertmanager requires endsAt, zero time while firing)
 	startsAt := alert.StartsAt.Format(time.RFC3339)
-	endsAt := ""
+	updatedAt := startsAt
+	if alert.Timestamp != nil && !alert.Timestamp.IsZero() {
+		updatedAt = alert.Timestamp.Format(time.RFC3339)
+	}
+	endsAt := time.Time{}.Format(time.RFC3339)
 	if alert.EndsAt != nil && !alert.EndsAt.IsZero() {
 		endsAt = alert.EndsAt.Format(time.RFC3339)
 	}
@@ -141,13 +202,28 @@ func convertSingleAlert(
 		generatorURL = *alert.GeneratorURL
 	}
 
-	// Build receivers list (placeholder - will be enhanced with TN-141 integration)
-	receivers := buildReceivers(alert)
+	// Build receivers list (route tree when available, placeholder otherwise)
+	receiverNames := buildReceivers(alert)
+	if deps != nil && deps.ReceiverResolver != nil {
+		if routed, err := deps.ReceiverResolver.Receivers(alert); err == nil && len(routed) > 0 {
+			receiverNames = routed
+		} else if err != nil && deps.Logger != nil {
+			deps.Logger.Warn("Failed to resolve alert receivers",
+				"fingerprint", alert.Fingerprint,
+				"error", err,
+			)
+		}
+	}
+	receivers := make([]AlertmanagerReceiver, len(receiverNames))
+	for i, name := range receiverNames {
+		receivers[i] = AlertmanagerReceiver{Name: name}
+	}
 
 	return AlertmanagerAlert{
 		Labels:       copyLabels(alert.Labels),
 		Annotations:  copyLabels(alert.Annotations),
 		StartsAt:     startsAt,
+		UpdatedAt:    updatedAt,
 		EndsAt:       endsAt,
 		GeneratorURL: generatorURL,
 		Status:       status,
@@ -159,8 +235,10 @@ func convertSingleAlert(
 // buildAlertStatus constructs the AlertStatus for an alert.
 //
 // Status determination logic:
-//   1. Check if alert is silenced (via TN-133 if available)
-//   2. Check if alert is inhibited (via TN-129 if available)
+//   1. Check if alert is silenced (via TN-133 if available, otherwise
+//      the silences recorded on the alert history)
+//   2. Check if alert is inhibited (via TN-129 if available, otherwise
+//      the inhibiting alerts recorded on the alert history)
 //   3. Determine state:
 //      - "suppressed" if silenced or inhibited
 //      - "active" if firing and not suppressed
@@ -179,8 +257,10 @@ func buildAlertStatus(
 	alert *core.Alert,
 	deps *ConverterDependencies,
 ) (AlertStatus, error) {
-	silencedBy := []string{}
-	inhibitedBy := []string{}
+	// Suppression recorded on the history record when the alert was
+	// processed; live silence/inhibition checks take precedence
+	silencedBy := append([]string{}, alert.SilencedBy...)
+	inhibitedBy := append([]string{}, alert.InhibitedBy...)
 
 	// Check if alert is silenced (via TN-133)
 	if deps != nil && deps.SilenceChecker != nil {
@@ -194,7 +274,7 @@ func buildAlertStatus(
 				)
 			}
 		} else {
-			silencedBy = silences
+			silencedBy = append([]string{}, silences...)
 		}
 	}
 
@@ -210,7 +290,7 @@ func buildAlertStatus(
 				)
 			}
 		} else {
-			inhibitedBy = inhibitors
+			inhibitedBy = append([]string{}, inhibitors...)
 		}
 	}
 
@@ -229,6 +309,7 @@ func buildAlertStatus(
 		State:       state,
 		SilencedBy:  silencedBy,
 		InhibitedBy: inhibitedBy,
+		MutedBy:     []string{},
 	}, nil
 }
 
@@ -319,3 +400,89 @@ func BuildErrorResponse(errorMessage string) *AlertmanagerListResponse {
 		Error:  errorMessage,
 	}
 }
+
+// FilterAlertmanagerAlerts applies the Alertmanager state, receiver and label
+// matcher filters to converted alerts.
+//
+// State filters follow Alertmanager semantics: silenced, inhibited and active
+// default to true (include); false excludes alerts with silencedBy,
+// inhibitedBy or the "active" state respectively.
+//
+// Parameters:
+//   - alerts: Converted alerts
+//   - params: Parsed query parameters
+//
+// Returns:
+//   - []AlertmanagerAlert: Alerts passing all filters
+//   - error: Invalid receiver regex or label matchers
+func FilterAlertmanagerAlerts(alerts []AlertmanagerAlert, params *QueryParameters) ([]AlertmanagerAlert, error) {
+	matchers, err := ParseLabelMatchers(params.Filter)
+	if err != nil {
+		return nil, fmt.Errorf("invalid label matchers: %w", err)
+	}
+	var receiverRE *regexp.Regexp
+	if params.Receiver != "" {
+		if receiverRE, err = regexp.Compile("^(?:" + params.Receiver + ")$"); err != nil {
+			return nil, fmt.Errorf("invalid receiver regex: %w", err)
+		}
+	}
+
+	result := make([]AlertmanagerAlert, 0, len(alerts))
+	for _, alert := range alerts {
+		if !includeAlertState(alert.Status, params) || !MatchLabelMatchers(matchers, alert.Labels) {
+			continue
+		}
+		if receiverRE != nil && !slices.ContainsFunc(alert.Receivers, func(r AlertmanagerReceiver) bool {
+			return receiverRE.MatchString(r.Name)
+		}) {
+			continue
+		}
+		result = append(result, alert)
+	}
+	return result, nil
+}
+
+// includeAlertState reports whether an alert status passes the state filters.
+func includeAlertState(status AlertStatus, params *QueryParameters) bool {
+	include := func(flag *bool) bool { return flag == nil || *flag }
+
+	if !include(params.Silenced) && len(status.SilencedBy) > 0 {
+		return false
+	}
+	if !include(params.Inhibited) && len(status.InhibitedBy) > 0 {
+		return false
+	}
+	if !include(params.Active) && status.State == "active" {
+		return false
+	}
+	if !include(params.Unprocessed) && status.State == "unprocessed" {
+		return false
+	}
+	return true
+}
+
+// MatchLabelMatchers reports whether labels satisfy all matchers.
+//
+// A missing label has the empty value, as in Alertmanager: {team=""} matches
+// alerts without a team label. Regex matchers are fully anchored.
+func MatchLabelMatchers(matchers []LabelMatcher, labels map[string]string) bool {
+	for _, m := range matchers {
+		value := labels[m.Name]
+		switch m.Operator {
+		case "=":
+			if value != m.Value {
+				return false
+			}
+		case "!=":
+			if value == m.Value {
+				return false
+			}
+		case "=~", "!~":
+			re, err := regexp.Compile("^(?:" + m.Value + ")$")
+			if err != nil || re.MatchString(value) != (m.Operator == "=~") {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -276,6 +277,40 @@ func TestBuildAlertStatus_WithInhibitions(t *testing.T) {
 	}
 }
 
+func TestBuildAlertStatus_RecordedSuppression(t *testing.T) {
+	alert := &core.Alert{
+		Fingerprint: "test123",
+		Status:      core.StatusFiring,
+		SilencedBy:  []string{"silence-1"},
+		InhibitedBy: []string{"alert-abc"},
+	}
+
+	ctx := context.Background()
+	status, err := buildAlertStatus(ctx, alert, &ConverterDependencies{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if status.State != "suppressed" {
+		t.Errorf("Expected state 'suppressed', got %s", status.State)
+	}
+	if len(status.SilencedBy) != 1 || len(status.InhibitedBy) != 1 {
+		t.Errorf("Expected recorded suppression, got silenced_by=%v inhibited_by=%v", status.SilencedBy, status.InhibitedBy)
+	}
+
+	// Live checks take precedence over the recorded suppression
+	deps := &ConverterDependencies{
+		SilenceChecker:    &mockSilenceChecker{},
+		InhibitionChecker: &mockInhibitionChecker{},
+	}
+	status, err = buildAlertStatus(ctx, alert, deps)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if status.State != "active" {
+		t.Errorf("Expected state 'active', got %s", status.State)
+	}
+}
+
 func TestBuildAlertStatus_NoDependencies(t *testing.T) {
 	deps := &ConverterDependencies{}
 
@@ -370,9 +405,7 @@ func TestRespondSuccess(t *testing.T) {
 	handler, _ := NewPrometheusQueryHandler(repo, nil, config, nil)
 
 	w := httptest.NewRecorder()
-	response := BuildAlertmanagerListResponse([]AlertmanagerAlert{}, 0, 1, 100)
-
-	handler.respondSuccess(w, response)
+	handler.respondSuccess(w, []AlertmanagerAlert{})
 
 	if w.Code != http.StatusOK {
 		t.Errorf("Expected status 200, got %d", w.Code)
@@ -380,6 +413,9 @@ func TestRespondSuccess(t *testing.T) {
 	if w.Header().Get("Content-Type") != "application/json" {
 		t.Errorf("Expected JSON content type")
 	}
+	if strings.TrimSpace(w.Body.String()) != "[]" {
+		t.Errorf("Expected empty alert array, got %s", w.Body.String())
+	}
 }
 
 func TestRespondError(t *testing.T) {
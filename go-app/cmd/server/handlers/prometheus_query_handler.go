@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/vitaliisemenov/alert-history/internal/core"
@@ -25,15 +26,18 @@ import (
 //   HTTP Request → Parse Params → Query DB → Convert Format → JSON Response
 //
 // Supported Query Parameters:
-//   - filter: Label matcher expression (e.g., {alertname="HighCPU"})
-//   - receiver: Filter by receiver name
-//   - silenced, inhibited, active: Boolean filters
-//   - status: "firing" or "resolved"
+//   - filter: Label matcher expression (e.g., {alertname="HighCPU"}), repeatable
+//   - receiver: Regex matching receiver names
+//   - silenced, inhibited, active, unprocessed: Include alerts in that state (default true)
+//   - status: "firing" (default: current alerts, like Alertmanager) or "resolved"
 //   - severity: Severity level
 //   - startTime, endTime: Time range (RFC3339)
-//   - page, limit: Pagination
+//   - page, limit: Pagination of the filtered alerts (default: all alerts)
 //   - sort: "field:direction" (e.g., "startsAt:desc")
 //
+// Response: Alertmanager API v2 gettableAlerts (JSON array) with the number
+// of alerts matching all filters in the X-Total-Count header.
+//
 // HTTP Status Codes:
 //   - 200 OK: Query successful
 //   - 400 Bad Request: Invalid query parameters
@@ -72,8 +76,9 @@ type AlertHistoryRepository interface {
 //
 // All fields are optional - defaults are used if not specified.
 type PrometheusQueryConfig struct {
-	MaxAlertsPerPage int           // Max alerts per page (default: 1000)
+	MaxAlertsPerPage int           // Max alerts per page and per history query (default: 1000)
 	DefaultLimit     int           // Default limit (default: 100)
+	MaxQueryAlerts   int           // Max alerts read from history per request (default: 10000)
 	RequestTimeout   time.Duration // Max request processing time (default: 30s)
 	EnableMetrics    bool          // Enable Prometheus metrics (default: true)
 }
@@ -83,6 +88,7 @@ type PrometheusQueryConfig struct {
 // Defaults:
 //   - MaxAlertsPerPage: 1000
 //   - DefaultLimit: 100
+//   - MaxQueryAlerts: 10000
 //   - RequestTimeout: 30 seconds
 //   - EnableMetrics: true
 //
@@ -92,6 +98,7 @@ func DefaultPrometheusQueryConfig() *PrometheusQueryConfig {
 	return &PrometheusQueryConfig{
 		MaxAlertsPerPage: 1000,
 		DefaultLimit:     100,
+		MaxQueryAlerts:   10000,
 		RequestTimeout:   30 * time.Second,
 		EnableMetrics:    true,
 	}
@@ -133,6 +140,12 @@ func NewPrometheusQueryHandler(
 	if config == nil {
 		config = DefaultPrometheusQueryConfig()
 	}
+	if config.MaxAlertsPerPage <= 0 {
+		config.MaxAlertsPerPage = DefaultPrometheusQueryConfig().MaxAlertsPerPage
+	}
+	if config.MaxQueryAlerts <= 0 {
+		config.MaxQueryAlerts = DefaultPrometheusQueryConfig().MaxQueryAlerts
+	}
 	if converterDeps == nil {
 		converterDeps = &ConverterDependencies{
 			Logger: logger,
@@ -161,9 +174,10 @@ func NewPrometheusQueryHandler(
 //   2. Parse query parameters
 //   3. Validate parameters
 //   4. Convert to HistoryRequest (core domain)
-//   5. Query database via historyRepo.GetHistory()
-//   6. Convert core.Alert → AlertmanagerAlert
-//   7. Build response with pagination metadata
+//   5. Query database via historyRepo.GetHistory(), reading all pages
+//   6. Convert core.Alert → AlertmanagerAlert, apply state/receiver/matcher filters
+//   7. Paginate the filtered alerts if page/limit was requested, build
+//      response (alert array, X-Total-Count header)
 //   8. Record metrics and log results
 //
 // HTTP Status Codes:
@@ -243,23 +257,24 @@ func (h *PrometheusQueryHandler) HandlePrometheusQuery(w http.ResponseWriter, r
 		return
 	}
 
-	// Step 5: Query database
-	histResp, err := h.historyRepo.GetHistory(ctx, histReq)
+	// Step 5: Query database. State, receiver and non-equality matcher
+	// filters are only known after conversion, so all pages are read and
+	// pagination is applied to the filtered alerts.
+	alerts, err := h.queryAlerts(ctx, histReq)
 	if err != nil {
 		h.logger.Error("Failed to query alert history", "error", err)
 		h.respondError(w, http.StatusInternalServerError, "failed to query alerts")
 		h.recordMetrics("error", "database_error", 0, time.Since(startTime))
 		return
 	}
+	if params.Status != string(core.StatusResolved) {
+		alerts = currentAlerts(alerts, time.Now())
+	}
 
-	h.logger.Debug("Database query successful",
-		"total", histResp.Total,
-		"returned", len(histResp.Alerts),
-		"page", histResp.Page,
-	)
+	h.logger.Debug("Database query successful", "returned", len(alerts))
 
 	// Step 6: Convert to Alertmanager format
-	amAlerts, err := ConvertToAlertmanagerFormat(ctx, histResp.Alerts, h.converter)
+	amAlerts, err := ConvertToAlertmanagerFormat(ctx, alerts, h.converter)
 	if err != nil {
 		h.logger.Error("Failed to convert alerts", "error", err)
 		h.respondError(w, http.StatusInternalServerError, "failed to convert alerts")
@@ -267,22 +282,29 @@ func (h *PrometheusQueryHandler) HandlePrometheusQuery(w http.ResponseWriter, r
 		return
 	}
 
-	// Step 7: Build response
-	response := BuildAlertmanagerListResponse(
-		amAlerts,
-		int(histResp.Total),
-		params.Page,
-		params.Limit,
-	)
+	// Silence/inhibition state, receiver and non-equality matchers
+	amAlerts, err = FilterAlertmanagerAlerts(amAlerts, params)
+	if err != nil {
+		h.logger.Warn("Failed to filter alerts", "error", err)
+		h.respondError(w, http.StatusBadRequest, fmt.Sprintf("invalid query parameters: %v", err))
+		h.recordMetrics("validation_failed", "filter_error", 0, time.Since(startTime))
+		return
+	}
+
+	total := len(amAlerts)
+	if params.Paginate {
+		amAlerts = paginateAlerts(amAlerts, params.Page, params.Limit)
+	}
 
 	duration := time.Since(startTime)
 
-	// Step 8: Send response
-	h.respondSuccess(w, response)
+	// Step 7-8: Send response
+	w.Header().Set("X-Total-Count", strconv.Itoa(total))
+	h.respondSuccess(w, amAlerts)
 	h.recordMetrics("success", "query_completed", len(amAlerts), duration)
 
 	h.logger.Info("Query processing complete",
-		"total", histResp.Total,
+		"total", total,
 		"returned", len(amAlerts),
 		"page", params.Page,
 		"limit", params.Limit,
@@ -305,11 +327,12 @@ func (h *PrometheusQueryHandler) buildHistoryRequest(params *QueryParameters) (*
 	// Build filters
 	filters := &core.AlertFilters{}
 
-	// Status filter
+	// Status filter (default: current, i.e. firing, alerts like Alertmanager)
+	status := core.StatusFiring
 	if params.Status != "" {
-		status := core.AlertStatus(params.Status)
-		filters.Status = &status
+		status = core.AlertStatus(params.Status)
 	}
+	filters.Status = &status
 
 	// Severity filter
 	if params.Severity != "" {
@@ -356,6 +379,54 @@ func (h *PrometheusQueryHandler) buildHistoryRequest(params *QueryParameters) (*
 	}, nil
 }
 
+// queryAlerts reads all pages of a history request (up to MaxQueryAlerts
+// alerts), MaxAlertsPerPage alerts per query.
+func (h *PrometheusQueryHandler) queryAlerts(ctx context.Context, req *core.HistoryRequest) ([]*core.Alert, error) {
+	query := *req
+	query.Pagination = &core.Pagination{Page: 1, PerPage: h.config.MaxAlertsPerPage}
+
+	var alerts []*core.Alert
+	for {
+		resp, err := h.historyRepo.GetHistory(ctx, &query)
+		if err != nil {
+			return nil, err
+		}
+		alerts = append(alerts, resp.Alerts...)
+
+		if len(alerts) >= h.config.MaxQueryAlerts {
+			h.logger.Warn("Alert query truncated",
+				"max_query_alerts", h.config.MaxQueryAlerts)
+			return alerts[:h.config.MaxQueryAlerts], nil
+		}
+		if !resp.HasNext || len(resp.Alerts) == 0 {
+			return alerts, nil
+		}
+		query.Pagination = &core.Pagination{Page: query.Pagination.Page + 1, PerPage: query.Pagination.PerPage}
+	}
+}
+
+// currentAlerts drops resolved alerts and alerts whose endsAt has passed:
+// Alertmanager lists only current alerts.
+func currentAlerts(alerts []*core.Alert, now time.Time) []*core.Alert {
+	result := make([]*core.Alert, 0, len(alerts))
+	for _, alert := range alerts {
+		if alert.Status == core.StatusResolved || (alert.EndsAt != nil && !alert.EndsAt.IsZero() && alert.EndsAt.Before(now)) {
+			continue
+		}
+		result = append(result, alert)
+	}
+	return result
+}
+
+// paginateAlerts returns the given page (1-indexed) of alerts.
+func paginateAlerts(alerts []AlertmanagerAlert, page, limit int) []AlertmanagerAlert {
+	start := (page - 1) * limit
+	if start >= len(alerts) {
+		return []AlertmanagerAlert{}
+	}
+	return alerts[start:min(start+limit, len(alerts))]
+}
+
 // convertLabelMatchers converts LabelMatcher to core label filters.
 //
 // This is a simplified implementation. Full implementation would use
@@ -406,8 +477,8 @@ func (h *PrometheusQueryHandler) mapSortOrder(order string) core.SortOrder {
 	return core.SortOrderDesc // default
 }
 
-// respondSuccess sends 200 OK response.
-func (h *PrometheusQueryHandler) respondSuccess(w http.ResponseWriter, response *AlertmanagerListResponse) {
+// respondSuccess sends 200 OK response with the alert array.
+func (h *PrometheusQueryHandler) respondSuccess(w http.ResponseWriter, response []AlertmanagerAlert) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 
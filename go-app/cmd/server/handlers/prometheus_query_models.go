@@ -11,11 +11,13 @@ import (
 // additional filtering, pagination, and sorting capabilities.
 //
 // Alertmanager Standard Parameters:
-//   - filter: Label matcher expression (e.g., {alertname="HighCPU",severity=~"critical|warning"})
-//   - receiver: Filter by receiver name
-//   - silenced: Include/exclude silenced alerts
-//   - inhibited: Include/exclude inhibited alerts
-//   - active: Include only active alerts
+//   - filter: Label matcher expression (e.g., {alertname="HighCPU",severity=~"critical|warning"}),
+//     or one matcher per filter parameter as sent by amtool (filter=alertname="HighCPU")
+//   - receiver: Regex matching receiver names
+//   - silenced: Include silenced alerts (default true)
+//   - inhibited: Include inhibited alerts (default true)
+//   - active: Include active (not suppressed) alerts (default true)
+//   - unprocessed: Include unprocessed alerts (default true)
 //
 // Extended Parameters (150% quality):
 //   - status: Filter by alert status (firing/resolved)
@@ -32,21 +34,23 @@ import (
 //	}
 type QueryParameters struct {
 	// Alertmanager standard filters
-	Filter    string // Label matcher expression (e.g., {alertname="HighCPU"})
-	Receiver  string // Filter by receiver name
-	Silenced  *bool  // Include silenced alerts (nil = all, true = only silenced, false = only not silenced)
-	Inhibited *bool  // Include inhibited alerts (nil = all, true = only inhibited, false = only not inhibited)
-	Active    *bool  // Include only active alerts (nil = all, true = only active, false = include resolved)
+	Filter      string // Label matcher expression (e.g., {alertname="HighCPU"})
+	Receiver    string // Regex matching receiver names (anchored)
+	Silenced    *bool  // Include silenced alerts (nil = true)
+	Inhibited   *bool  // Include inhibited alerts (nil = true)
+	Active      *bool  // Include active, i.e. not silenced or inhibited, alerts (nil = true)
+	Unprocessed *bool  // Include unprocessed alerts (nil = true, alerts are always processed)
 
 	// Extended filters (150% quality)
-	Status    string    // Filter by status: "firing", "resolved", or "" (all)
+	Status    string    // Filter by status: "firing", "resolved", or "" (current alerts)
 	Severity  string    // Filter by severity level (e.g., "critical", "warning")
 	StartTime time.Time // Filter by start time (alerts starting after this time)
 	EndTime   time.Time // Filter by end time (alerts ending before this time)
 
 	// Pagination
-	Page  int // Page number (1-indexed, default: 1)
-	Limit int // Results per page (default: 100, max: 1000)
+	Page     int  // Page number (1-indexed, default: 1)
+	Limit    int  // Results per page (default: 100, max: 1000)
+	Paginate bool // page or limit was given: only that page of the filtered alerts is returned
 
 	// Sorting
 	SortBy    string // Sort field (e.g., "startsAt", "severity", "alertname")
@@ -93,25 +97,33 @@ type LabelMatcher struct {
 //	    "description": "CPU usage is 95% for 5 minutes"
 //	  },
 //	  "startsAt": "2025-11-19T10:00:00Z",
+//	  "updatedAt": "2025-11-19T10:00:00Z",
 //	  "endsAt": "2025-11-19T10:15:00Z",
 //	  "generatorURL": "http://prometheus:9090/graph?...",
 //	  "status": {
 //	    "state": "active",
 //	    "silencedBy": [],
-//	    "inhibitedBy": []
+//	    "inhibitedBy": [],
+//	    "mutedBy": []
 //	  },
-//	  "receivers": ["team-ops"],
+//	  "receivers": [{"name": "team-ops"}],
 //	  "fingerprint": "abc123def456"
 //	}
 type AlertmanagerAlert struct {
-	Labels       map[string]string `json:"labels"`           // Alert labels
-	Annotations  map[string]string `json:"annotations"`      // Alert annotations
-	StartsAt     string            `json:"startsAt"`         // Start timestamp (RFC3339)
-	EndsAt       string            `json:"endsAt,omitempty"` // End timestamp (RFC3339, omitempty for active)
-	GeneratorURL string            `json:"generatorURL"`     // Generator URL
-	Status       AlertStatus       `json:"status"`           // Alert status
-	Receivers    []string          `json:"receivers"`        // Receiver names
-	Fingerprint  string            `json:"fingerprint"`      // Alert fingerprint
+	Labels       map[string]string      `json:"labels"`                 // Alert labels
+	Annotations  map[string]string      `json:"annotations"`            // Alert annotations
+	StartsAt     string                 `json:"startsAt"`               // Start timestamp (RFC3339)
+	UpdatedAt    string                 `json:"updatedAt"`              // Last update timestamp (RFC3339)
+	EndsAt       string                 `json:"endsAt"`                 // End timestamp (RFC3339, zero time for active alerts)
+	GeneratorURL string                 `json:"generatorURL,omitempty"` // Generator URL
+	Status       AlertStatus            `json:"status"`                 // Alert status
+	Receivers    []AlertmanagerReceiver `json:"receivers"`              // Receivers
+	Fingerprint  string                 `json:"fingerprint"`            // Alert fingerprint
+}
+
+// AlertmanagerReceiver is a receiver in Alertmanager v2 format.
+type AlertmanagerReceiver struct {
+	Name string `json:"name"`
 }
 
 // AlertStatus represents the status of an alert.
@@ -120,26 +132,30 @@ type AlertmanagerAlert struct {
 //   - state: Current alert state ("active", "suppressed", "unprocessed")
 //   - silencedBy: List of silence IDs that silence this alert
 //   - inhibitedBy: List of alert fingerprints that inhibit this alert
+//   - mutedBy: List of time intervals muting this alert
 //
 // Example:
 //
 //	{
 //	  "state": "active",
 //	  "silencedBy": [],
-//	  "inhibitedBy": []
+//	  "inhibitedBy": [],
+//	  "mutedBy": []
 //	}
 type AlertStatus struct {
-	State       string   `json:"state"`                 // Alert state: "active", "suppressed", "unprocessed"
-	SilencedBy  []string `json:"silencedBy"`            // Silence IDs (empty array if not silenced)
-	InhibitedBy []string `json:"inhibitedBy,omitempty"` // Inhibiting alert fingerprints (omitempty)
+	State       string   `json:"state"`       // Alert state: "active", "suppressed", "unprocessed"
+	SilencedBy  []string `json:"silencedBy"`  // Silence IDs (empty array if not silenced)
+	InhibitedBy []string `json:"inhibitedBy"` // Inhibiting alert fingerprints (empty array if not inhibited)
+	MutedBy     []string `json:"mutedBy"`     // Muting time intervals (empty array if not muted)
 }
 
-// AlertmanagerListResponse represents the response for GET /api/v2/alerts.
+// AlertmanagerListResponse is the paginated alert list envelope.
 //
-// This is compatible with Alertmanager API v2 and follows the standard
-// response format used by Prometheus ecosystem tools.
+// GET /api/v2/alerts returns the bare alert array (Alertmanager API v2
+// gettableAlerts) with the total count in the X-Total-Count header; error
+// responses use this envelope.
 //
-// Response format:
+// Envelope format:
 //
 //	{
 //	  "status": "success",
@@ -194,7 +210,7 @@ type AlertmanagerListData struct {
 //   - SortBy: "startsAt"
 //   - SortOrder: "desc"
 //   - Status: "" (all)
-//   - Silenced, Inhibited, Active: nil (all)
+//   - Silenced, Inhibited, Active, Unprocessed: nil (include)
 //
 // Returns:
 //   - *QueryParameters: Configuration with default values
@@ -205,7 +221,7 @@ func DefaultQueryParameters() *QueryParameters {
 		SortBy:    "startsAt",
 		SortOrder: "desc",
 		Status:    "", // All statuses
-		// Silenced, Inhibited, Active: nil means include all
+		// Silenced, Inhibited, Active, Unprocessed: nil means include
 	}
 }
 
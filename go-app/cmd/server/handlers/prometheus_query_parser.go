@@ -13,7 +13,7 @@ import (
 // ParseQueryParameters parses HTTP query parameters for GET /api/v2/alerts.
 //
 // This function extracts and validates all supported query parameters:
-//   - Alertmanager standard: filter, receiver, silenced, inhibited, active
+//   - Alertmanager standard: filter, receiver, silenced, inhibited, active, unprocessed
 //   - Extended: status, severity, startTime, endTime
 //   - Pagination: page, limit
 //   - Sorting: sort (format: "field:direction")
@@ -36,14 +36,14 @@ func ParseQueryParameters(query url.Values) (*QueryParameters, error) {
 	params := DefaultQueryParameters()
 
 	// Parse Alertmanager standard filters
-	if filter := query.Get("filter"); filter != "" {
-		params.Filter = filter
+	if filters := query["filter"]; len(filters) > 0 {
+		params.Filter = joinFilterParameters(filters)
 	}
 	if receiver := query.Get("receiver"); receiver != "" {
 		params.Receiver = receiver
 	}
 
-	// Parse boolean filters (silenced, inhibited, active)
+	// Parse boolean filters (silenced, inhibited, active, unprocessed)
 	if silenced := query.Get("silenced"); silenced != "" {
 		val, err := parseBool(silenced)
 		if err != nil {
@@ -65,6 +65,13 @@ func ParseQueryParameters(query url.Values) (*QueryParameters, error) {
 		}
 		params.Active = &val
 	}
+	if unprocessed := query.Get("unprocessed"); unprocessed != "" {
+		val, err := parseBool(unprocessed)
+		if err != nil {
+			return nil, fmt.Errorf("invalid 'unprocessed' parameter: %w", err)
+		}
+		params.Unprocessed = &val
+	}
 
 	// Parse extended filters
 	if status := query.Get("status"); status != "" {
@@ -105,6 +112,7 @@ func ParseQueryParameters(query url.Values) (*QueryParameters, error) {
 			return nil, fmt.Errorf("invalid 'page' parameter: must be positive integer, got %q", page)
 		}
 		params.Page = val
+		params.Paginate = true
 	}
 	if limit := query.Get("limit"); limit != "" {
 		val, err := strconv.Atoi(limit)
@@ -115,6 +123,7 @@ func ParseQueryParameters(query url.Values) (*QueryParameters, error) {
 			return nil, fmt.Errorf("limit exceeds maximum allowed (%d > %d)", val, MaxAlertsPerPage)
 		}
 		params.Limit = val
+		params.Paginate = true
 	}
 
 	// Parse sorting
@@ -130,6 +139,31 @@ func ParseQueryParameters(query url.Values) (*QueryParameters, error) {
 	return params, nil
 }
 
+// joinFilterParameters combines filter parameters into a single expression.
+//
+// Alertmanager clients (amtool, Grafana) send one matcher per filter
+// parameter without braces:
+//
+//	?filter=alertname="HighCPU"&filter=severity=~"critical|warning"
+//	→ {alertname="HighCPU",severity=~"critical|warning"}
+//
+// A single braced expression is returned unchanged.
+func joinFilterParameters(filters []string) string {
+	if len(filters) == 1 && strings.HasPrefix(strings.TrimSpace(filters[0]), "{") {
+		return filters[0]
+	}
+
+	parts := make([]string, 0, len(filters))
+	for _, filter := range filters {
+		filter = strings.TrimSpace(filter)
+		filter = strings.TrimSuffix(strings.TrimPrefix(filter, "{"), "}")
+		if filter != "" {
+			parts = append(parts, filter)
+		}
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
 // parseBool parses a boolean query parameter.
 //
 // Supports multiple formats:
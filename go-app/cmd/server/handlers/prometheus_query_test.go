@@ -4,6 +4,7 @@ package handlers
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -409,14 +410,27 @@ func (m *mockHistoryRepo) GetHistory(ctx context.Context, req *core.HistoryReque
 		return nil, m.err
 	}
 
+	alerts := m.alerts
+	if req.Filters != nil && req.Filters.Status != nil {
+		alerts = nil
+		for _, alert := range m.alerts {
+			if alert.Status == *req.Filters.Status {
+				alerts = append(alerts, alert)
+			}
+		}
+	}
+
+	total := len(alerts)
+	start := min((req.Pagination.Page-1)*req.Pagination.PerPage, total)
+	end := min(start+req.Pagination.PerPage, total)
 	return &core.HistoryResponse{
-		Alerts:     m.alerts,
-		Total:      int64(len(m.alerts)),
+		Alerts:     alerts[start:end],
+		Total:      int64(total),
 		Page:       req.Pagination.Page,
 		PerPage:    req.Pagination.PerPage,
-		TotalPages: 1,
-		HasNext:    false,
-		HasPrev:    false,
+		TotalPages: (total + req.Pagination.PerPage - 1) / req.Pagination.PerPage,
+		HasNext:    end < total,
+		HasPrev:    req.Pagination.Page > 1,
 	}, nil
 }
 
@@ -473,17 +487,19 @@ func TestHandlePrometheusQuery_Success(t *testing.T) {
 		t.Errorf("Expected status 200, got %d", w.Code)
 	}
 
-	var response AlertmanagerListResponse
+	var response []AlertmanagerAlert
 	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
 		t.Fatalf("Failed to decode response: %v", err)
 	}
 
-	if response.Status != "success" {
-		t.Errorf("Expected status 'success', got %s", response.Status)
+	if len(response) != 1 {
+		t.Fatalf("Expected 1 alert, got %d", len(response))
 	}
-
-	if len(response.Data.Alerts) != 1 {
-		t.Errorf("Expected 1 alert, got %d", len(response.Data.Alerts))
+	if response[0].Status.State != "active" {
+		t.Errorf("Expected state 'active', got %s", response[0].Status.State)
+	}
+	if w.Header().Get("X-Total-Count") != "1" {
+		t.Errorf("Expected X-Total-Count 1, got %q", w.Header().Get("X-Total-Count"))
 	}
 }
 
@@ -566,21 +582,93 @@ func TestHandlePrometheusQuery_WithFilters(t *testing.T) {
 		t.Errorf("Expected status 200, got %d, body: %s", w.Code, w.Body.String())
 	}
 
-	var response AlertmanagerListResponse
+	var response []AlertmanagerAlert
 	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
 		t.Fatalf("Failed to decode response: %v", err)
 	}
 
-	if response.Status != "success" {
-		t.Errorf("Expected success status")
+	if len(response) != 1 {
+		t.Errorf("Expected 1 alert, got %d", len(response))
 	}
 
 	// Verify pagination metadata
-	if response.Data.Page != 1 || response.Data.Limit != 50 {
+	if w.Header().Get("X-Total-Count") != "1" {
 		t.Errorf("Pagination metadata mismatch")
 	}
 }
 
+func TestParseQueryParameters_RepeatedFilter(t *testing.T) {
+	params, err := ParseQueryParameters(url.Values{
+		"filter": []string{`alertname="HighCPU"`, `{severity=~"crit.*"}`},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if params.Filter != `{alertname="HighCPU",severity=~"crit.*"}` {
+		t.Errorf("Unexpected joined filter: %s", params.Filter)
+	}
+}
+
+func TestFilterAlertmanagerAlerts(t *testing.T) {
+	alerts := []AlertmanagerAlert{
+		{
+			Fingerprint: "active",
+			Labels:      map[string]string{"alertname": "HighCPU", "team": "web"},
+			Receivers:   []AlertmanagerReceiver{{Name: "web-pager"}},
+			Status:      AlertStatus{State: "active"},
+		},
+		{
+			Fingerprint: "silenced",
+			Labels:      map[string]string{"alertname": "HighCPU"},
+			Receivers:   []AlertmanagerReceiver{{Name: "default"}},
+			Status:      AlertStatus{State: "suppressed", SilencedBy: []string{"s1"}},
+		},
+		{
+			Fingerprint: "inhibited",
+			Labels:      map[string]string{"alertname": "DiskFull", "team": "db"},
+			Receivers:   []AlertmanagerReceiver{{Name: "db"}},
+			Status:      AlertStatus{State: "suppressed", InhibitedBy: []string{"fp-source"}},
+		},
+	}
+	no := false
+
+	testCases := []struct {
+		name   string
+		params QueryParameters
+		want   []string
+	}{
+		{"defaults include all", QueryParameters{}, []string{"active", "silenced", "inhibited"}},
+		{"exclude silenced", QueryParameters{Silenced: &no}, []string{"active", "inhibited"}},
+		{"exclude inhibited", QueryParameters{Inhibited: &no}, []string{"active", "silenced"}},
+		{"exclude active", QueryParameters{Active: &no}, []string{"silenced", "inhibited"}},
+		{"receiver regex is anchored", QueryParameters{Receiver: "web"}, nil},
+		{"receiver regex", QueryParameters{Receiver: "web-.*|db"}, []string{"active", "inhibited"}},
+		{"regex matcher", QueryParameters{Filter: `{alertname=~"High.*"}`}, []string{"active", "silenced"}},
+		{"missing label is empty", QueryParameters{Filter: `{team=""}`}, []string{"silenced"}},
+		{"negative matcher", QueryParameters{Filter: `{team!="web"}`}, []string{"silenced", "inhibited"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filtered, err := FilterAlertmanagerAlerts(alerts, &tc.params)
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			var got []string
+			for _, alert := range filtered {
+				got = append(got, alert.Fingerprint)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tc.want) {
+				t.Errorf("Expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+
+	if _, err := FilterAlertmanagerAlerts(alerts, &QueryParameters{Receiver: "("}); err == nil {
+		t.Error("Expected error for invalid receiver regex")
+	}
+}
+
 // ============================================================================
 // Helper function tests
 // ============================================================================
@@ -0,0 +1,81 @@
+// Package handlers provides HTTP handlers for the Alert History Service.
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure/inhibition"
+	"github.com/vitaliisemenov/alert-history/internal/realtime"
+)
+
+// parseEventFilter parses the subscription filters of /api/v2/events/stream
+// and /ws/dashboard. Parameters may be repeated; type, namespace and
+// severity also accept comma-separated values:
+//
+//	?type=alert_created,alert_resolved&namespace=db&severity=critical&filter=team="dba"
+//
+// filter takes label matchers in Alertmanager syntax (=, !=, =~, !~).
+// Returns nil if no filter parameter is set.
+func parseEventFilter(query url.Values) (*realtime.EventFilter, error) {
+	filter := &realtime.EventFilter{
+		Types:      splitQueryValues(query["type"]),
+		Namespaces: splitQueryValues(query["namespace"]),
+		Severities: splitQueryValues(query["severity"]),
+	}
+
+	for _, expr := range query["filter"] {
+		matchers, err := inhibition.ParseMatchers(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter: %w", err)
+		}
+		for _, m := range matchers {
+			filter.Matchers = append(filter.Matchers, m)
+		}
+	}
+
+	if filter.IsEmpty() {
+		return nil, nil
+	}
+	return filter, nil
+}
+
+// splitQueryValues splits repeated and comma-separated query values.
+func splitQueryValues(values []string) []string {
+	var result []string
+	for _, value := range values {
+		for _, part := range strings.Split(value, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				result = append(result, part)
+			}
+		}
+	}
+	return result
+}
+
+// lastEventID returns the resume cursor of a (re)connecting client: the
+// Last-Event-ID header sent by EventSource on reconnect, or the
+// last_event_id query parameter (first connect, WebSocket clients).
+func lastEventID(r *http.Request) (string, error) {
+	id := r.Header.Get("Last-Event-ID")
+	if id == "" {
+		id = r.URL.Query().Get("last_event_id")
+	}
+	if id == "" {
+		return "", nil
+	}
+	if _, _, err := realtime.ParseEventCursor(id); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// newStreamResetEvent tells a resuming client that missed events are no longer available.
+func newStreamResetEvent(lastEventID string) realtime.Event {
+	return *realtime.NewEvent(realtime.EventTypeStreamReset, map[string]interface{}{
+		"last_event_id": lastEventID,
+		"reason":        "events after last_event_id are no longer buffered, reload state",
+	}, realtime.EventSourceSystem)
+}
@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vitaliisemenov/alert-history/internal/realtime"
+)
+
+func TestParseEventFilter(t *testing.T) {
+	filter, err := parseEventFilter(url.Values{})
+	require.NoError(t, err)
+	assert.Nil(t, filter)
+
+	query := url.Values{
+		"type":     {"alert_created, alert_resolved", "stats_updated"},
+		"severity": {"critical"},
+		"filter":   {`team="dba"`, `{env!="dev", cluster=~"prod-.*"}`},
+	}
+	filter, err = parseEventFilter(query)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"alert_created", "alert_resolved", "stats_updated"}, filter.Types)
+	assert.Equal(t, []string{"critical"}, filter.Severities)
+	assert.Len(t, filter.Matchers, 3)
+
+	_, err = parseEventFilter(url.Values{"filter": {`team=~"(dba"`}})
+	assert.Error(t, err)
+}
+
+// readSSEEvent reads the next event (id and data lines) of an SSE stream.
+func readSSEEvent(t *testing.T, reader *bufio.Reader) (string, realtime.Event) {
+	t.Helper()
+
+	var id string
+	for {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+		line = strings.TrimRight(line, "\n")
+
+		switch {
+		case strings.HasPrefix(line, "id: "):
+			id = strings.TrimPrefix(line, "id: ")
+		case strings.HasPrefix(line, "data: "):
+			var event realtime.Event
+			require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &event))
+			return id, event
+		}
+	}
+}
+
+// openSSEStream connects to the SSE handler and returns the stream reader.
+func openSSEStream(t *testing.T, serverURL, query, lastEventID string) *bufio.Reader {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, serverURL+"?"+query, nil)
+	require.NoError(t, err)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	return bufio.NewReader(resp.Body)
+}
+
+func TestSSEHandler_ResumeAndFilter(t *testing.T) {
+	eventBus := realtime.NewEventBus(slog.Default(), nil)
+	require.NoError(t, eventBus.Start(context.Background()))
+	t.Cleanup(func() { eventBus.Stop(context.Background()) })
+
+	// Cleanups run in reverse order: streams are closed before the server
+	server := httptest.NewServer(NewSSEHandler(eventBus, slog.Default(), nil))
+	t.Cleanup(server.Close)
+
+	alert := func(namespace string) realtime.Event {
+		return *realtime.NewEvent(realtime.EventTypeAlertCreated, map[string]interface{}{
+			"labels": map[string]string{"namespace": namespace},
+		}, realtime.EventSourceAlertProcessor)
+	}
+
+	stream := openSSEStream(t, server.URL, "", "")
+	require.Eventually(t, func() bool { return eventBus.GetActiveSubscribers() == 1 }, time.Second, 5*time.Millisecond)
+
+	require.NoError(t, eventBus.Publish(alert("db")))
+	firstID, first := readSSEEvent(t, stream)
+	assert.Equal(t, realtime.EventCursor(first), firstID)
+
+	// Published while the client is "disconnected"
+	require.NoError(t, eventBus.Publish(alert("web")))
+	require.NoError(t, eventBus.Publish(alert("db")))
+
+	resumed := openSSEStream(t, server.URL, "namespace=db", firstID)
+	_, replayed := readSSEEvent(t, resumed)
+	assert.Equal(t, int64(3), replayed.Sequence, "web event filtered out of the replay")
+
+	require.NoError(t, eventBus.Publish(alert("web")))
+	require.NoError(t, eventBus.Publish(alert("db")))
+	_, live := readSSEEvent(t, resumed)
+	assert.Equal(t, int64(5), live.Sequence)
+
+	// Evicted or unknown cursor
+	reset := openSSEStream(t, server.URL, "", "unknown-origin:1")
+	_, event := readSSEEvent(t, reset)
+	assert.Equal(t, realtime.EventTypeStreamReset, event.Type)
+}
+
+func TestSSEHandler_InvalidParameters(t *testing.T) {
+	handler := NewSSEHandler(realtime.NewEventBus(slog.Default(), nil), slog.Default(), nil)
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, `/api/v2/events/stream?filter=team=~"(x"`, nil))
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v2/events/stream", nil)
+	req.Header.Set("Last-Event-ID", "garbage")
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
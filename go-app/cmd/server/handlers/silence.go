@@ -12,6 +12,7 @@
 //   - DELETE /api/v2/silences/{id} - Delete a silence
 //   - POST /api/v2/silences/check - Check if an alert would be silenced (150% feature)
 //   - POST /api/v2/silences/bulk/delete - Bulk delete silences (150% feature)
+//   - POST /api/v2/silences/preview - Preview alerts matched by proposed matchers
 //
 // Architecture:
 //   HTTP Request → SilenceHandler → SilenceManager → SilenceRepository → PostgreSQL
@@ -40,6 +41,7 @@ import (
 	"time"
 
 	"github.com/vitaliisemenov/alert-history/internal/business/silencing"
+	"github.com/vitaliisemenov/alert-history/internal/core"
 	"github.com/vitaliisemenov/alert-history/internal/infrastructure/cache"
 	"github.com/vitaliisemenov/alert-history/pkg/metrics"
 )
@@ -72,6 +74,9 @@ type SilenceHandler struct {
 	metrics *metrics.BusinessMetrics // Prometheus metrics (optional)
 	logger  *slog.Logger             // Structured logger
 	cache   cache.Cache              // Response cache (optional)
+
+	alertStorage core.AlertStorage           // Firing alerts for previews (optional)
+	historyRepo  core.AlertHistoryRepository // Alert history for previews (optional)
 }
 
 // NewSilenceHandler creates a new SilenceHandler instance.
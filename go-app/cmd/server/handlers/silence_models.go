@@ -45,6 +45,12 @@ type CheckAlertRequest struct {
 	Labels map[string]string `json:"labels"` // Alert labels to check (required, non-empty)
 }
 
+// PreviewSilenceRequest represents the request body for POST /api/v2/silences/preview
+type PreviewSilenceRequest struct {
+	Matchers []silencing.Matcher `json:"matchers"` // Proposed label matchers (required, 1-100 matchers)
+	Days     int                 `json:"days"`     // History window in days (default: 7, max: 90)
+}
+
 // BulkDeleteRequest represents the request body for POST /api/v2/silences/bulk/delete
 type BulkDeleteRequest struct {
 	IDs []string `json:"ids"` // Silence IDs to delete (required, 1-100 UUIDs)
@@ -158,6 +164,28 @@ type CheckAlertResponse struct {
 	LatencyMs  int64              `json:"latencyMs"`            // Processing time in milliseconds
 }
 
+// PreviewSilenceResponse represents the response for POST /api/v2/silences/preview
+type PreviewSilenceResponse struct {
+	Firing    *PreviewMatches `json:"firing"`    // Matches among currently firing alerts
+	History   *PreviewMatches `json:"history"`   // Matches among alerts of the last N days
+	LatencyMs int64           `json:"latencyMs"` // Processing time in milliseconds
+}
+
+// PreviewMatches represents the alerts matched by a silence preview.
+type PreviewMatches struct {
+	Days      int            `json:"days,omitempty"`      // History window in days (history only)
+	Total     int            `json:"total"`               // Count of matched alerts
+	Groups    []PreviewGroup `json:"groups"`              // Matches grouped by alertname/namespace
+	Truncated bool           `json:"truncated,omitempty"` // True if the scan limit was reached
+}
+
+// PreviewGroup represents the matched alerts of one alertname/namespace pair.
+type PreviewGroup struct {
+	AlertName string `json:"alertname"`           // alertname label
+	Namespace string `json:"namespace,omitempty"` // namespace label (empty if missing)
+	Count     int    `json:"count"`               // Count of matched alerts
+}
+
 // BulkDeleteResponse represents the response for POST /api/v2/silences/bulk/delete
 type BulkDeleteResponse struct {
 	Deleted int                 `json:"deleted"`         // Count of successfully deleted silences
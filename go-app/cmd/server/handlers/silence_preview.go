@@ -0,0 +1,285 @@
+// Package handlers provides HTTP request handlers for the Alert History Service.
+//
+// Silence preview: shows the blast radius of a silence before it is created.
+//   - POST /api/v2/silences/preview - Match proposed matchers against firing and historical alerts
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/core/silencing"
+)
+
+// Silence preview limits.
+const (
+	defaultPreviewDays = 7
+	maxPreviewDays     = 90
+
+	// previewPageSize is the page size used to scan alert storage and history
+	previewPageSize = 1000
+
+	// maxPreviewAlerts bounds the number of alerts scanned per source
+	maxPreviewAlerts = 50000
+)
+
+// SetPreviewSources enables POST /api/v2/silences/preview.
+//
+// Parameters:
+//   - storage: alert storage holding the currently firing alerts (required)
+//   - history: alert history repository (optional, falls back to storage)
+func (h *SilenceHandler) SetPreviewSources(storage core.AlertStorage, history core.AlertHistoryRepository) {
+	h.alertStorage = storage
+	h.historyRepo = history
+}
+
+// PreviewSilence handles POST /api/v2/silences/preview
+//
+// Evaluates proposed matchers against the currently firing alerts and the
+// alerts seen in the last N days, without creating a silence. Used by the
+// create form to show the blast radius live while matchers are typed.
+//
+// Request Body:
+//
+//	{
+//	  "matchers": [
+//	    {"name": "namespace", "value": "prod", "type": "="},
+//	    {"name": "alertname", "value": "High.*", "type": "=~"}
+//	  ],
+//	  "days": 7
+//	}
+//
+// Response (200 OK):
+//
+//	{
+//	  "firing": {
+//	    "total": 3,
+//	    "groups": [{"alertname": "HighCPU", "namespace": "prod", "count": 2}, ...]
+//	  },
+//	  "history": {
+//	    "days": 7,
+//	    "total": 12,
+//	    "groups": [...]
+//	  },
+//	  "latencyMs": 14
+//	}
+//
+// Groups are sorted by count (descending). "truncated" is set on a section
+// when more than 50000 alerts would have had to be scanned.
+//
+// Error Responses:
+//   - 400 Bad Request: Invalid JSON, matchers or days
+//   - 500 Internal Server Error: Storage errors
+//   - 503 Service Unavailable: Preview sources not configured
+func (h *SilenceHandler) PreviewSilence(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	start := time.Now()
+
+	if h.alertStorage == nil {
+		h.sendError(w, "Silence preview is not available", http.StatusServiceUnavailable)
+		h.recordMetrics("POST", "/silences/preview", "503", start)
+		return
+	}
+
+	var req PreviewSilenceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Warn("Invalid request body", "error", err, "method", "PreviewSilence")
+		h.sendError(w, "Invalid request body: "+err.Error(), http.StatusBadRequest)
+		h.recordMetrics("POST", "/silences/preview", "400", start)
+		return
+	}
+
+	silence, err := validatePreviewRequest(&req)
+	if err != nil {
+		h.sendError(w, "Validation failed: "+err.Error(), http.StatusBadRequest)
+		h.recordMetrics("POST", "/silences/preview", "400", start)
+		return
+	}
+
+	matcher := silencing.NewSilenceMatcher()
+
+	firing, err := h.previewFiring(ctx, matcher, silence)
+	if err != nil {
+		h.logger.Error("Failed to preview silence against firing alerts", "error", err)
+		h.sendError(w, "Failed to load firing alerts", http.StatusInternalServerError)
+		h.recordMetrics("POST", "/silences/preview", "500", start)
+		return
+	}
+
+	history, err := h.previewHistory(ctx, matcher, silence, req.Days)
+	if err != nil {
+		h.logger.Error("Failed to preview silence against alert history", "error", err)
+		h.sendError(w, "Failed to load alert history", http.StatusInternalServerError)
+		h.recordMetrics("POST", "/silences/preview", "500", start)
+		return
+	}
+
+	response := &PreviewSilenceResponse{
+		Firing:    firing,
+		History:   history,
+		LatencyMs: time.Since(start).Milliseconds(),
+	}
+
+	h.recordMetrics("POST", "/silences/preview", "200", start)
+	if h.metrics != nil {
+		h.metrics.SilenceOperationsTotal.WithLabelValues("preview", "success").Inc()
+	}
+
+	h.logger.Debug("Silence preview completed",
+		"matchers", len(silence.Matchers),
+		"firing", firing.Total,
+		"history", history.Total,
+		"latency_ms", response.LatencyMs,
+	)
+
+	h.sendJSON(w, response, http.StatusOK)
+}
+
+// validatePreviewRequest validates the matchers and days of a preview request
+// and returns a silence carrying the validated matchers.
+func validatePreviewRequest(req *PreviewSilenceRequest) (*silencing.Silence, error) {
+	if len(req.Matchers) == 0 {
+		return nil, fmt.Errorf("at least one matcher is required")
+	}
+	if len(req.Matchers) > 100 {
+		return nil, fmt.Errorf("at most 100 matchers are allowed")
+	}
+	for i := range req.Matchers {
+		if err := req.Matchers[i].Validate(); err != nil {
+			return nil, fmt.Errorf("matcher %d: %w", i, err)
+		}
+	}
+
+	if req.Days == 0 {
+		req.Days = defaultPreviewDays
+	}
+	if req.Days < 0 || req.Days > maxPreviewDays {
+		return nil, fmt.Errorf("days must be between 1 and %d", maxPreviewDays)
+	}
+
+	return &silencing.Silence{Matchers: req.Matchers}, nil
+}
+
+// previewFiring matches the silence against the firing alerts in alert storage.
+func (h *SilenceHandler) previewFiring(ctx context.Context, matcher silencing.SilenceMatcher, silence *silencing.Silence) (*PreviewMatches, error) {
+	status := core.StatusFiring
+	counter := newPreviewCounter()
+
+	for offset := 0; offset < maxPreviewAlerts; offset += previewPageSize {
+		list, err := h.alertStorage.ListAlerts(ctx, &core.AlertFilters{
+			Status: &status,
+			Limit:  previewPageSize,
+			Offset: offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := counter.add(ctx, matcher, silence, list.Alerts); err != nil {
+			return nil, err
+		}
+		if len(list.Alerts) < previewPageSize {
+			return counter.result(false), nil
+		}
+	}
+	return counter.result(true), nil
+}
+
+// previewHistory matches the silence against the alerts that started in the
+// last days days, read from the history repository (or alert storage).
+func (h *SilenceHandler) previewHistory(ctx context.Context, matcher silencing.SilenceMatcher, silence *silencing.Silence, days int) (*PreviewMatches, error) {
+	now := time.Now()
+	from := now.Add(-time.Duration(days) * 24 * time.Hour)
+	filters := &core.AlertFilters{TimeRange: &core.TimeRange{From: &from, To: &now}}
+	counter := newPreviewCounter()
+	truncated := true
+
+	for offset := 0; offset < maxPreviewAlerts; offset += previewPageSize {
+		var alerts []*core.Alert
+		if h.historyRepo != nil {
+			resp, err := h.historyRepo.GetHistory(ctx, &core.HistoryRequest{
+				Filters:    filters,
+				Pagination: &core.Pagination{Page: offset/previewPageSize + 1, PerPage: previewPageSize},
+			})
+			if err != nil {
+				return nil, err
+			}
+			alerts = resp.Alerts
+		} else {
+			filters.Limit, filters.Offset = previewPageSize, offset
+			list, err := h.alertStorage.ListAlerts(ctx, filters)
+			if err != nil {
+				return nil, err
+			}
+			alerts = list.Alerts
+		}
+
+		if err := counter.add(ctx, matcher, silence, alerts); err != nil {
+			return nil, err
+		}
+		if len(alerts) < previewPageSize {
+			truncated = false
+			break
+		}
+	}
+
+	result := counter.result(truncated)
+	result.Days = days
+	return result, nil
+}
+
+// previewCounter counts matching alerts by alertname and namespace.
+type previewCounter struct {
+	total  int
+	groups map[[2]string]int
+}
+
+func newPreviewCounter() *previewCounter {
+	return &previewCounter{groups: make(map[[2]string]int)}
+}
+
+// add matches alerts against the silence and counts the matches.
+func (c *previewCounter) add(ctx context.Context, matcher silencing.SilenceMatcher, silence *silencing.Silence, alerts []*core.Alert) error {
+	for _, alert := range alerts {
+		labels := make(map[string]string, len(alert.Labels)+1)
+		for k, v := range alert.Labels {
+			labels[k] = v
+		}
+		if labels["alertname"] == "" {
+			labels["alertname"] = alert.AlertName
+		}
+
+		matched, err := matcher.Matches(ctx, silencing.Alert{Labels: labels}, silence)
+		if err != nil {
+			return err
+		}
+		if matched {
+			c.total++
+			c.groups[[2]string{labels["alertname"], labels["namespace"]}]++
+		}
+	}
+	return nil
+}
+
+// result returns the counted matches, largest groups first.
+func (c *previewCounter) result(truncated bool) *PreviewMatches {
+	groups := make([]PreviewGroup, 0, len(c.groups))
+	for key, count := range c.groups {
+		groups = append(groups, PreviewGroup{AlertName: key[0], Namespace: key[1], Count: count})
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].Count != groups[j].Count {
+			return groups[i].Count > groups[j].Count
+		}
+		if groups[i].AlertName != groups[j].AlertName {
+			return groups[i].AlertName < groups[j].AlertName
+		}
+		return groups[i].Namespace < groups[j].Namespace
+	})
+
+	return &PreviewMatches{Total: c.total, Groups: groups, Truncated: truncated}
+}
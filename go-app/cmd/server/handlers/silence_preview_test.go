@@ -0,0 +1,153 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+)
+
+// previewStorage serves ListAlerts from a fixed alert list.
+type previewStorage struct {
+	core.AlertStorage
+	alerts []*core.Alert
+}
+
+func (s *previewStorage) ListAlerts(ctx context.Context, filters *core.AlertFilters) (*core.AlertList, error) {
+	var result []*core.Alert
+	for _, alert := range s.alerts {
+		if filters.Status != nil && alert.Status != *filters.Status {
+			continue
+		}
+		if tr := filters.TimeRange; tr != nil && tr.From != nil && alert.StartsAt.Before(*tr.From) {
+			continue
+		}
+		result = append(result, alert)
+	}
+	if filters.Offset >= len(result) {
+		return &core.AlertList{}, nil
+	}
+	result = result[filters.Offset:]
+	if filters.Limit > 0 && len(result) > filters.Limit {
+		result = result[:filters.Limit]
+	}
+	return &core.AlertList{Alerts: result, Total: len(result)}, nil
+}
+
+// previewHistory serves GetHistory from a fixed alert list.
+type previewHistory struct {
+	core.AlertHistoryRepository
+	storage *previewStorage
+}
+
+func (h *previewHistory) GetHistory(ctx context.Context, req *core.HistoryRequest) (*core.HistoryResponse, error) {
+	filters := *req.Filters
+	filters.Limit = req.Pagination.PerPage
+	filters.Offset = (req.Pagination.Page - 1) * req.Pagination.PerPage
+	list, err := h.storage.ListAlerts(ctx, &filters)
+	if err != nil {
+		return nil, err
+	}
+	return &core.HistoryResponse{Alerts: list.Alerts}, nil
+}
+
+func newPreviewAlert(name, namespace string, status core.AlertStatus, age time.Duration) *core.Alert {
+	return &core.Alert{
+		Fingerprint: name + "-" + namespace + "-" + age.String(),
+		AlertName:   name,
+		Status:      status,
+		Labels:      map[string]string{"namespace": namespace, "severity": "critical"},
+		StartsAt:    time.Now().Add(-age),
+	}
+}
+
+func postPreview(t *testing.T, handler *SilenceHandler, body string) (*httptest.ResponseRecorder, PreviewSilenceResponse) {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v2/silences/preview", bytes.NewBufferString(body))
+	w := httptest.NewRecorder()
+	handler.PreviewSilence(w, req)
+
+	var resp PreviewSilenceResponse
+	if w.Code == http.StatusOK {
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	}
+	return w, resp
+}
+
+func TestSilenceHandler_PreviewSilence(t *testing.T) {
+	storage := &previewStorage{alerts: []*core.Alert{
+		newPreviewAlert("HighCPU", "prod", core.StatusFiring, time.Hour),
+		newPreviewAlert("HighCPU", "prod", core.StatusFiring, 2*time.Hour),
+		newPreviewAlert("HighMemory", "prod", core.StatusFiring, time.Hour),
+		newPreviewAlert("HighCPU", "staging", core.StatusFiring, time.Hour),
+		newPreviewAlert("HighCPU", "prod", core.StatusResolved, 3*24*time.Hour),
+		newPreviewAlert("HighCPU", "prod", core.StatusResolved, 10*24*time.Hour),
+	}}
+
+	handler := NewSilenceHandler(nil, nil, nil, nil)
+	handler.SetPreviewSources(storage, &previewHistory{storage: storage})
+
+	w, resp := postPreview(t, handler, `{"matchers":[
+		{"name":"namespace","value":"prod","type":"="},
+		{"name":"alertname","value":"High.*","type":"=~"}
+	]}`)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	assert.Equal(t, 3, resp.Firing.Total)
+	assert.Equal(t, []PreviewGroup{
+		{AlertName: "HighCPU", Namespace: "prod", Count: 2},
+		{AlertName: "HighMemory", Namespace: "prod", Count: 1},
+	}, resp.Firing.Groups)
+	assert.False(t, resp.Firing.Truncated)
+
+	// The default 7 day window excludes the 10 day old alert
+	assert.Equal(t, 7, resp.History.Days)
+	assert.Equal(t, 4, resp.History.Total)
+	assert.Equal(t, PreviewGroup{AlertName: "HighCPU", Namespace: "prod", Count: 3}, resp.History.Groups[0])
+
+	_, resp = postPreview(t, handler, `{"matchers":[{"name":"alertname","value":"HighCPU","type":"="}],"days":30}`)
+	assert.Equal(t, 30, resp.History.Days)
+	assert.Equal(t, 5, resp.History.Total)
+	assert.Equal(t, 3, resp.Firing.Total)
+
+	// Without a history repository, alert storage serves the history
+	handler.SetPreviewSources(storage, nil)
+	_, resp = postPreview(t, handler, `{"matchers":[{"name":"namespace","value":"staging","type":"="}]}`)
+	assert.Equal(t, 1, resp.Firing.Total)
+	assert.Equal(t, 1, resp.History.Total)
+}
+
+func TestSilenceHandler_PreviewSilence_Errors(t *testing.T) {
+	handler := NewSilenceHandler(nil, nil, nil, nil)
+
+	w, _ := postPreview(t, handler, `{"matchers":[{"name":"job","value":"api","type":"="}]}`)
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code, "preview sources not configured")
+
+	handler.SetPreviewSources(&previewStorage{}, nil)
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"invalid json", `{`},
+		{"no matchers", `{"matchers":[]}`},
+		{"invalid regex", `{"matchers":[{"name":"job","value":"(","type":"=~"}]}`},
+		{"invalid type", `{"matchers":[{"name":"job","value":"api","type":"=="}]}`},
+		{"days too large", `{"matchers":[{"name":"job","value":"api","type":"="}],"days":365}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, _ := postPreview(t, handler, tt.body)
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+		})
+	}
+}
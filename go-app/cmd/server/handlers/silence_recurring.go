@@ -0,0 +1,301 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	businesssilencing "github.com/vitaliisemenov/alert-history/internal/business/silencing"
+	coresilencing "github.com/vitaliisemenov/alert-history/internal/core/silencing"
+	infrasilencing "github.com/vitaliisemenov/alert-history/internal/infrastructure/silencing"
+)
+
+// Upcoming start times returned with a series: at most recurringNextOccurrences
+// within recurringLookahead.
+const (
+	recurringNextOccurrences = 5
+	recurringLookahead       = 366 * 24 * time.Hour
+)
+
+// RecurringSilenceHandler handles HTTP requests for recurring silence series:
+//   - POST /api/v2/silences/recurring - Create a series
+//   - GET /api/v2/silences/recurring - List series (?include_cancelled=true)
+//   - GET /api/v2/silences/recurring/{id} - Get a series with its occurrences
+//   - PUT /api/v2/silences/recurring/{id} - Edit a series (pending occurrences are re-materialised)
+//   - DELETE /api/v2/silences/recurring/{id} - Cancel a series
+type RecurringSilenceHandler struct {
+	manager businesssilencing.RecurringSilenceManager
+	logger  *slog.Logger
+}
+
+// NewRecurringSilenceHandler creates a new RecurringSilenceHandler instance.
+func NewRecurringSilenceHandler(manager businesssilencing.RecurringSilenceManager, logger *slog.Logger) *RecurringSilenceHandler {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &RecurringSilenceHandler{
+		manager: manager,
+		logger:  logger,
+	}
+}
+
+// RecurringSilenceRequest is the body of POST and PUT /api/v2/silences/recurring.
+type RecurringSilenceRequest struct {
+	CreatedBy string                  `json:"createdBy"`          // Creator email
+	Comment   string                  `json:"comment"`            // Reason for the silences
+	Matchers  []coresilencing.Matcher `json:"matchers"`           // Label matchers
+	Schedule  string                  `json:"schedule"`           // Cron expression or RRULE
+	Timezone  string                  `json:"timezone,omitempty"` // IANA time zone (default: UTC)
+	Duration  string                  `json:"duration"`           // Window length, e.g. "2h"
+	StartsAt  *time.Time              `json:"startsAt,omitempty"` // Series start (default: now)
+	EndsAt    *time.Time              `json:"endsAt,omitempty"`   // Optional series end
+}
+
+// RecurringSilenceResponse represents a recurring silence series.
+type RecurringSilenceResponse struct {
+	ID              string                  `json:"id"`
+	CreatedBy       string                  `json:"createdBy"`
+	Comment         string                  `json:"comment"`
+	Matchers        []coresilencing.Matcher `json:"matchers"`
+	Schedule        string                  `json:"schedule"`
+	Timezone        string                  `json:"timezone"`
+	Duration        string                  `json:"duration"`
+	StartsAt        time.Time               `json:"startsAt"`
+	EndsAt          *time.Time              `json:"endsAt,omitempty"`
+	CancelledAt     *time.Time              `json:"cancelledAt,omitempty"`
+	CreatedAt       time.Time               `json:"createdAt"`
+	UpdatedAt       *time.Time              `json:"updatedAt,omitempty"`
+	NextOccurrences []time.Time             `json:"nextOccurrences"` // Upcoming window starts
+}
+
+// RecurringSilenceDetailResponse represents a series with its materialised occurrences.
+type RecurringSilenceDetailResponse struct {
+	RecurringSilenceResponse
+	Occurrences []*coresilencing.RecurringOccurrence `json:"occurrences"`
+}
+
+// ListRecurringSilencesResponse represents the response for GET /api/v2/silences/recurring
+type ListRecurringSilencesResponse struct {
+	Series []*RecurringSilenceResponse `json:"series"`
+	Total  int                         `json:"total"`
+}
+
+// CreateRecurringSilence handles POST /api/v2/silences/recurring
+//
+// Request example:
+//
+//	{
+//	  "createdBy": "ops@example.com",
+//	  "comment": "Weekly database maintenance",
+//	  "schedule": "0 22 * * SAT",
+//	  "timezone": "Europe/Berlin",
+//	  "duration": "2h",
+//	  "matchers": [{"name": "team", "value": "dba", "type": "="}]
+//	}
+func (h *RecurringSilenceHandler) CreateRecurringSilence(w http.ResponseWriter, r *http.Request) {
+	var req RecurringSilenceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.sendError(w, "Invalid request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	series := &coresilencing.RecurringSilence{StartsAt: time.Now().UTC()}
+	if err := req.apply(series); err != nil {
+		h.sendError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	created, err := h.manager.CreateSeries(r.Context(), series)
+	if err != nil {
+		h.handleError(w, "create", "", err)
+		return
+	}
+
+	h.writeJSON(w, http.StatusCreated, h.toResponse(created))
+}
+
+// ListRecurringSilences handles GET /api/v2/silences/recurring
+func (h *RecurringSilenceHandler) ListRecurringSilences(w http.ResponseWriter, r *http.Request) {
+	includeCancelled := r.URL.Query().Get("include_cancelled") == "true"
+
+	series, err := h.manager.ListSeries(r.Context(), includeCancelled)
+	if err != nil {
+		h.handleError(w, "list", "", err)
+		return
+	}
+
+	response := ListRecurringSilencesResponse{
+		Series: make([]*RecurringSilenceResponse, 0, len(series)),
+		Total:  len(series),
+	}
+	for _, s := range series {
+		response.Series = append(response.Series, h.toResponse(s))
+	}
+	h.writeJSON(w, http.StatusOK, response)
+}
+
+// GetRecurringSilence handles GET /api/v2/silences/recurring/{id}
+func (h *RecurringSilenceHandler) GetRecurringSilence(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if !uuidRegex.MatchString(strings.ToLower(id)) {
+		h.sendError(w, "Invalid recurring silence ID format (expected UUID)", http.StatusBadRequest)
+		return
+	}
+
+	series, err := h.manager.GetSeries(r.Context(), id)
+	if err != nil {
+		h.handleError(w, "get", id, err)
+		return
+	}
+	occurrences, err := h.manager.ListOccurrences(r.Context(), id)
+	if err != nil {
+		h.handleError(w, "get", id, err)
+		return
+	}
+	if occurrences == nil {
+		occurrences = []*coresilencing.RecurringOccurrence{}
+	}
+
+	h.writeJSON(w, http.StatusOK, RecurringSilenceDetailResponse{
+		RecurringSilenceResponse: *h.toResponse(series),
+		Occurrences:              occurrences,
+	})
+}
+
+// UpdateRecurringSilence handles PUT /api/v2/silences/recurring/{id}
+// The request replaces the definition; startsAt defaults to the current series start.
+func (h *RecurringSilenceHandler) UpdateRecurringSilence(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if !uuidRegex.MatchString(strings.ToLower(id)) {
+		h.sendError(w, "Invalid recurring silence ID format (expected UUID)", http.StatusBadRequest)
+		return
+	}
+
+	var req RecurringSilenceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.sendError(w, "Invalid request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	series, err := h.manager.GetSeries(r.Context(), id)
+	if err != nil {
+		h.handleError(w, "update", id, err)
+		return
+	}
+	if series.IsCancelled() {
+		h.sendError(w, "Recurring silence is cancelled", http.StatusConflict)
+		return
+	}
+	if err := req.apply(series); err != nil {
+		h.sendError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.manager.UpdateSeries(r.Context(), series); err != nil {
+		h.handleError(w, "update", id, err)
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, h.toResponse(series))
+}
+
+// CancelRecurringSilence handles DELETE /api/v2/silences/recurring/{id}
+// Pending silences of the series are deleted and the running one is ended.
+func (h *RecurringSilenceHandler) CancelRecurringSilence(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if !uuidRegex.MatchString(strings.ToLower(id)) {
+		h.sendError(w, "Invalid recurring silence ID format (expected UUID)", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.manager.CancelSeries(r.Context(), id); err != nil {
+		h.handleError(w, "cancel", id, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// apply copies the request onto series. StartsAt is only overwritten when set.
+func (req *RecurringSilenceRequest) apply(series *coresilencing.RecurringSilence) error {
+	duration, err := time.ParseDuration(req.Duration)
+	if err != nil {
+		return errors.New("invalid duration (expected e.g. \"2h\" or \"90m\")")
+	}
+
+	series.CreatedBy = req.CreatedBy
+	series.Comment = req.Comment
+	series.Matchers = req.Matchers
+	series.Schedule = req.Schedule
+	series.Timezone = req.Timezone
+	series.Duration = duration
+	series.EndsAt = req.EndsAt
+	if req.StartsAt != nil {
+		series.StartsAt = *req.StartsAt
+	}
+	return nil
+}
+
+func (h *RecurringSilenceHandler) toResponse(series *coresilencing.RecurringSilence) *RecurringSilenceResponse {
+	response := &RecurringSilenceResponse{
+		ID:              series.ID,
+		CreatedBy:       series.CreatedBy,
+		Comment:         series.Comment,
+		Matchers:        series.Matchers,
+		Schedule:        series.Schedule,
+		Timezone:        series.Timezone,
+		Duration:        series.Duration.String(),
+		StartsAt:        series.StartsAt,
+		EndsAt:          series.EndsAt,
+		CancelledAt:     series.CancelledAt,
+		CreatedAt:       series.CreatedAt,
+		UpdatedAt:       series.UpdatedAt,
+		NextOccurrences: []time.Time{},
+	}
+
+	if !series.IsCancelled() {
+		now := time.Now()
+		starts, err := series.Occurrences(now, now.Add(recurringLookahead), recurringNextOccurrences)
+		if err != nil {
+			h.logger.Warn("Failed to compute recurring silence occurrences", "id", series.ID, "error", err)
+		}
+		for _, start := range starts {
+			if start.After(now) {
+				response.NextOccurrences = append(response.NextOccurrences, start)
+			}
+		}
+	}
+	return response
+}
+
+// handleError maps manager errors to HTTP status codes.
+func (h *RecurringSilenceHandler) handleError(w http.ResponseWriter, op, id string, err error) {
+	switch {
+	case errors.Is(err, infrasilencing.ErrValidation), errors.Is(err, infrasilencing.ErrInvalidUUID):
+		h.sendError(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, infrasilencing.ErrRecurringSilenceNotFound):
+		h.sendError(w, "Recurring silence not found", http.StatusNotFound)
+	default:
+		h.logger.Error("Recurring silence operation failed", "op", op, "id", id, "error", err)
+		h.sendError(w, "Failed to "+op+" recurring silence", http.StatusInternalServerError)
+	}
+}
+
+func (h *RecurringSilenceHandler) writeJSON(w http.ResponseWriter, code int, payload interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(payload)
+}
+
+// sendError sends an error response
+func (h *RecurringSilenceHandler) sendError(w http.ResponseWriter, message string, code int) {
+	h.writeJSON(w, code, struct {
+		Error string `json:"error"`
+	}{
+		Error: message,
+	})
+}
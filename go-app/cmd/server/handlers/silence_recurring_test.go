@@ -0,0 +1,209 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	coresilencing "github.com/vitaliisemenov/alert-history/internal/core/silencing"
+	infrasilencing "github.com/vitaliisemenov/alert-history/internal/infrastructure/silencing"
+)
+
+const testSeriesID = "550e8400-e29b-41d4-a716-446655440000"
+
+// fakeRecurringManager is an in-memory RecurringSilenceManager.
+type fakeRecurringManager struct {
+	series    map[string]*coresilencing.RecurringSilence
+	cancelled []string
+}
+
+func newFakeRecurringManager() *fakeRecurringManager {
+	return &fakeRecurringManager{series: make(map[string]*coresilencing.RecurringSilence)}
+}
+
+func (m *fakeRecurringManager) CreateSeries(ctx context.Context, series *coresilencing.RecurringSilence) (*coresilencing.RecurringSilence, error) {
+	if err := series.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %s", infrasilencing.ErrValidation, err)
+	}
+	series.ID = testSeriesID
+	series.CreatedAt = time.Now()
+	m.series[series.ID] = series
+	return series, nil
+}
+
+func (m *fakeRecurringManager) GetSeries(ctx context.Context, id string) (*coresilencing.RecurringSilence, error) {
+	series, ok := m.series[id]
+	if !ok {
+		return nil, infrasilencing.ErrRecurringSilenceNotFound
+	}
+	copied := *series
+	return &copied, nil
+}
+
+func (m *fakeRecurringManager) ListSeries(ctx context.Context, includeCancelled bool) ([]*coresilencing.RecurringSilence, error) {
+	var result []*coresilencing.RecurringSilence
+	for _, series := range m.series {
+		if includeCancelled || !series.IsCancelled() {
+			result = append(result, series)
+		}
+	}
+	return result, nil
+}
+
+func (m *fakeRecurringManager) UpdateSeries(ctx context.Context, series *coresilencing.RecurringSilence) error {
+	if err := series.Validate(); err != nil {
+		return fmt.Errorf("%w: %s", infrasilencing.ErrValidation, err)
+	}
+	m.series[series.ID] = series
+	return nil
+}
+
+func (m *fakeRecurringManager) CancelSeries(ctx context.Context, id string) error {
+	series, ok := m.series[id]
+	if !ok || series.IsCancelled() {
+		return infrasilencing.ErrRecurringSilenceNotFound
+	}
+	now := time.Now()
+	series.CancelledAt = &now
+	m.cancelled = append(m.cancelled, id)
+	return nil
+}
+
+func (m *fakeRecurringManager) ListOccurrences(ctx context.Context, id string) ([]*coresilencing.RecurringOccurrence, error) {
+	return []*coresilencing.RecurringOccurrence{{SeriesID: id, StartsAt: time.Now(), SilenceID: "silence-1"}}, nil
+}
+
+func newRecurringMux(manager *fakeRecurringManager) *http.ServeMux {
+	h := NewRecurringSilenceHandler(manager, nil)
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v2/silences/recurring", h.CreateRecurringSilence)
+	mux.HandleFunc("GET /api/v2/silences/recurring", h.ListRecurringSilences)
+	mux.HandleFunc("GET /api/v2/silences/recurring/{id}", h.GetRecurringSilence)
+	mux.HandleFunc("PUT /api/v2/silences/recurring/{id}", h.UpdateRecurringSilence)
+	mux.HandleFunc("DELETE /api/v2/silences/recurring/{id}", h.CancelRecurringSilence)
+	return mux
+}
+
+func doRecurringRequest(t *testing.T, mux *http.ServeMux, method, path string, body interface{}) *httptest.ResponseRecorder {
+	t.Helper()
+	var buf bytes.Buffer
+	if body != nil {
+		require.NoError(t, json.NewEncoder(&buf).Encode(body))
+	}
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(method, path, &buf))
+	return rec
+}
+
+func validRecurringRequest() RecurringSilenceRequest {
+	return RecurringSilenceRequest{
+		CreatedBy: "ops@example.com",
+		Comment:   "Weekly database maintenance",
+		Schedule:  "0 22 * * SAT",
+		Timezone:  "Europe/Berlin",
+		Duration:  "2h",
+		Matchers:  []coresilencing.Matcher{{Name: "team", Value: "dba", Type: coresilencing.MatcherTypeEqual}},
+	}
+}
+
+func TestRecurringSilenceHandler_Lifecycle(t *testing.T) {
+	manager := newFakeRecurringManager()
+	mux := newRecurringMux(manager)
+
+	// Create
+	rec := doRecurringRequest(t, mux, http.MethodPost, "/api/v2/silences/recurring", validRecurringRequest())
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var created RecurringSilenceResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&created))
+	assert.Equal(t, testSeriesID, created.ID)
+	assert.Equal(t, "2h0m0s", created.Duration)
+	require.NotEmpty(t, created.NextOccurrences)
+	assert.Equal(t, time.Saturday, created.NextOccurrences[0].In(mustLoadLocation(t, "Europe/Berlin")).Weekday())
+
+	// Get
+	rec = doRecurringRequest(t, mux, http.MethodGet, "/api/v2/silences/recurring/"+testSeriesID, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var detail RecurringSilenceDetailResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&detail))
+	assert.Equal(t, "0 22 * * SAT", detail.Schedule)
+	assert.Len(t, detail.Occurrences, 1)
+
+	// Update
+	req := validRecurringRequest()
+	req.Schedule = "FREQ=WEEKLY;BYDAY=SU;BYHOUR=3"
+	req.Duration = "90m"
+	rec = doRecurringRequest(t, mux, http.MethodPut, "/api/v2/silences/recurring/"+testSeriesID, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, 90*time.Minute, manager.series[testSeriesID].Duration)
+	assert.Equal(t, created.StartsAt.Unix(), manager.series[testSeriesID].StartsAt.Unix())
+
+	// List
+	rec = doRecurringRequest(t, mux, http.MethodGet, "/api/v2/silences/recurring", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var list ListRecurringSilencesResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&list))
+	assert.Equal(t, 1, list.Total)
+
+	// Cancel
+	rec = doRecurringRequest(t, mux, http.MethodDelete, "/api/v2/silences/recurring/"+testSeriesID, nil)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, []string{testSeriesID}, manager.cancelled)
+
+	rec = doRecurringRequest(t, mux, http.MethodGet, "/api/v2/silences/recurring", nil)
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&list))
+	assert.Equal(t, 0, list.Total)
+
+	rec = doRecurringRequest(t, mux, http.MethodGet, "/api/v2/silences/recurring?include_cancelled=true", nil)
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&list))
+	require.Equal(t, 1, list.Total)
+	assert.NotNil(t, list.Series[0].CancelledAt)
+	assert.Empty(t, list.Series[0].NextOccurrences)
+
+	rec = doRecurringRequest(t, mux, http.MethodPut, "/api/v2/silences/recurring/"+testSeriesID, validRecurringRequest())
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestRecurringSilenceHandler_Errors(t *testing.T) {
+	mux := newRecurringMux(newFakeRecurringManager())
+
+	badSchedule := validRecurringRequest()
+	badSchedule.Schedule = "every saturday"
+	badDuration := validRecurringRequest()
+	badDuration.Duration = "two hours"
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   interface{}
+		want   int
+	}{
+		{"invalid schedule", http.MethodPost, "/api/v2/silences/recurring", badSchedule, http.StatusBadRequest},
+		{"invalid duration", http.MethodPost, "/api/v2/silences/recurring", badDuration, http.StatusBadRequest},
+		{"invalid id", http.MethodGet, "/api/v2/silences/recurring/not-a-uuid", nil, http.StatusBadRequest},
+		{"not found", http.MethodGet, "/api/v2/silences/recurring/" + testSeriesID, nil, http.StatusNotFound},
+		{"cancel not found", http.MethodDelete, "/api/v2/silences/recurring/" + testSeriesID, nil, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := doRecurringRequest(t, mux, tt.method, tt.path, tt.body)
+			assert.Equal(t, tt.want, rec.Code, rec.Body.String())
+		})
+	}
+}
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	require.NoError(t, err)
+	return loc
+}
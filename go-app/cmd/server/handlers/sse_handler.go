@@ -30,7 +30,23 @@ func NewSSEHandler(eventBus *realtime.DefaultEventBus, logger *slog.Logger, metr
 }
 
 // ServeHTTP handles GET /api/v2/events/stream
+//
+// Query parameters type, namespace, severity and filter restrict the
+// streamed events (see parseEventFilter). Every event carries an SSE id;
+// a reconnecting client sending Last-Event-ID first receives the buffered
+// events it missed, or a stream_reset event if they are no longer buffered.
 func (h *SSEHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	filter, err := parseEventFilter(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	resumeFrom, err := lastEventID(r)
+	if err != nil {
+		http.Error(w, "Invalid Last-Event-ID", http.StatusBadRequest)
+		return
+	}
+
 	// Set SSE headers
 	w.Header().Set("Content-Type", "text/event-stream")
 	w.Header().Set("Cache-Control", "no-cache")
@@ -50,7 +66,9 @@ func (h *SSEHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	// Create SSE subscriber
 	subscriber := NewSSESubscriber(w, r.Context(), h.logger)
-	if err := h.eventBus.Subscribe(subscriber); err != nil {
+	subscriber.SetFilter(filter)
+	replay, complete, err := h.eventBus.SubscribeFrom(subscriber, resumeFrom)
+	if err != nil {
 		h.logger.Error("Failed to subscribe SSE client", "error", err)
 		http.Error(w, "Failed to establish connection", http.StatusInternalServerError)
 		return
@@ -60,8 +78,19 @@ func (h *SSEHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	h.logger.Info("SSE client connected",
 		"remote_addr", r.RemoteAddr,
 		"subscriber_id", subscriber.ID(),
+		"last_event_id", resumeFrom,
+		"filtered", filter != nil,
 	)
 
+	// Catch up on missed events before streaming live ones
+	if err := h.sendReplay(w, subscriber, resumeFrom, replay, complete); err != nil {
+		h.logger.Warn("Failed to replay SSE events",
+			"subscriber_id", subscriber.ID(),
+			"error", err,
+		)
+		return
+	}
+
 	// Start keep-alive ticker
 	ticker := time.NewTicker(30 * time.Second)
 	defer ticker.Stop()
@@ -89,7 +118,12 @@ func (h *SSEHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				flusher.Flush()
 			}
 
-		case event := <-subscriber.EventChan():
+		case event, ok := <-subscriber.EventChan():
+			if !ok {
+				// Unsubscribed by the EventBus (e.g. client too slow)
+				return
+			}
+
 			// Send event in SSE format
 			if err := h.sendSSEEvent(w, event); err != nil {
 				h.logger.Warn("Failed to send SSE event",
@@ -106,7 +140,35 @@ func (h *SSEHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// sendSSEEvent sends an event in SSE format: "data: {...}\n\n"
+// sendReplay sends a stream_reset event if events were missed, then the
+// replayed events matching the subscriber filter.
+func (h *SSEHandler) sendReplay(w http.ResponseWriter, subscriber *SSESubscriber, resumeFrom string, replay []realtime.Event, complete bool) error {
+	if resumeFrom == "" {
+		return nil
+	}
+
+	if !complete {
+		if err := h.sendSSEEvent(w, newStreamResetEvent(resumeFrom)); err != nil {
+			return err
+		}
+	}
+	for _, event := range replay {
+		if !subscriber.Accepts(event) {
+			continue
+		}
+		if err := h.sendSSEEvent(w, event); err != nil {
+			return err
+		}
+	}
+
+	if flusher, ok := w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return nil
+}
+
+// sendSSEEvent sends an event in SSE format: "id: <cursor>\ndata: {...}\n\n"
+// The id is omitted for events not broadcast by the EventBus (stream_reset).
 func (h *SSEHandler) sendSSEEvent(w http.ResponseWriter, event realtime.Event) error {
 	// Marshal event to JSON
 	data, err := json.Marshal(event)
@@ -114,6 +176,12 @@ func (h *SSEHandler) sendSSEEvent(w http.ResponseWriter, event realtime.Event) e
 		return fmt.Errorf("failed to marshal event: %w", err)
 	}
 
+	if event.Sequence > 0 {
+		if _, err := fmt.Fprintf(w, "id: %s\n", realtime.EventCursor(event)); err != nil {
+			return err
+		}
+	}
+
 	// Write SSE format: "data: {...}\n\n"
 	_, err = fmt.Fprintf(w, "data: %s\n\n", data)
 	return err
@@ -22,6 +22,9 @@ type SSESubscriber struct {
 	logger    *slog.Logger
 	mu        sync.Mutex
 	closed    bool
+
+	// filter selects the delivered events (nil: all events)
+	filter *realtime.EventFilter
 }
 
 // NewSSESubscriber creates a new SSE subscriber.
@@ -37,6 +40,17 @@ func NewSSESubscriber(w http.ResponseWriter, ctx context.Context, logger *slog.L
 	}
 }
 
+// SetFilter restricts the events sent to the subscriber.
+// Must be called before the subscriber is added to the EventBus.
+func (s *SSESubscriber) SetFilter(filter *realtime.EventFilter) {
+	s.filter = filter
+}
+
+// Accepts reports whether the event passes the subscriber filter.
+func (s *SSESubscriber) Accepts(event realtime.Event) bool {
+	return s.filter.Matches(event)
+}
+
 // ID returns the subscriber ID.
 func (s *SSESubscriber) ID() string {
 	return s.id
@@ -51,6 +65,10 @@ func (s *SSESubscriber) Send(event realtime.Event) error {
 	}
 	s.mu.Unlock()
 
+	if !s.filter.Matches(event) {
+		return nil // Filtered out
+	}
+
 	select {
 	case s.eventChan <- event:
 		return nil
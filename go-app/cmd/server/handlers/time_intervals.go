@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/vitaliisemenov/alert-history/internal/business/timeinterval"
+)
+
+// TimeIntervalHandler handles HTTP requests for named time intervals
+// (time_intervals / mute_time_intervals):
+//   - GET /api/v2/time-intervals - List intervals and whether they are active now
+//   - GET /api/v2/time-intervals/{name}/active?time=<RFC3339> - Check if an interval is active at a time
+type TimeIntervalHandler struct {
+	evaluator *timeinterval.Evaluator
+	logger    *slog.Logger
+}
+
+// NewTimeIntervalHandler creates a new TimeIntervalHandler instance.
+func NewTimeIntervalHandler(evaluator *timeinterval.Evaluator, logger *slog.Logger) *TimeIntervalHandler {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &TimeIntervalHandler{
+		evaluator: evaluator,
+		logger:    logger,
+	}
+}
+
+// TimeIntervalStatus represents a time interval and its state at a point in time.
+type TimeIntervalStatus struct {
+	Name   string    `json:"name"`
+	Time   time.Time `json:"time"`
+	Active bool      `json:"active"`
+}
+
+// TimeIntervalsResponse represents the response for GET /api/v2/time-intervals
+type TimeIntervalsResponse struct {
+	Intervals []TimeIntervalStatus `json:"intervals"`
+	Count     int                  `json:"count"`
+}
+
+// ListIntervals handles GET /api/v2/time-intervals
+// Returns all defined time intervals with their current state.
+//
+// Response example:
+//
+//	{
+//	  "intervals": [
+//	    {"name": "business-hours", "time": "2025-11-04T10:00:00Z", "active": true}
+//	  ],
+//	  "count": 1
+//	}
+func (h *TimeIntervalHandler) ListIntervals(w http.ResponseWriter, r *http.Request) {
+	now := time.Now().UTC()
+	names := h.evaluator.Names()
+
+	response := TimeIntervalsResponse{
+		Intervals: make([]TimeIntervalStatus, 0, len(names)),
+		Count:     len(names),
+	}
+	for _, name := range names {
+		active, _ := h.evaluator.IsActive(name, now) // name comes from Names(), cannot be missing
+		response.Intervals = append(response.Intervals, TimeIntervalStatus{
+			Name:   name,
+			Time:   now,
+			Active: active,
+		})
+	}
+
+	h.writeJSON(w, http.StatusOK, response)
+}
+
+// CheckInterval handles GET /api/v2/time-intervals/{name}/active
+// Reports whether the named interval is active at the given time
+// (query parameter "time" in RFC3339, defaults to now).
+//
+// Response example:
+//
+//	{"name": "business-hours", "time": "2025-11-08T10:00:00Z", "active": false}
+func (h *TimeIntervalHandler) CheckInterval(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	if name == "" {
+		h.sendError(w, "Interval name is required", http.StatusBadRequest)
+		return
+	}
+
+	at := time.Now().UTC()
+	if raw := r.URL.Query().Get("time"); raw != "" {
+		parsed, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			h.sendError(w, "Invalid time parameter: must be RFC3339", http.StatusBadRequest)
+			return
+		}
+		at = parsed
+	}
+
+	active, err := h.evaluator.IsActive(name, at)
+	if err != nil {
+		if errors.Is(err, timeinterval.ErrIntervalNotFound) {
+			h.sendError(w, "Time interval not found", http.StatusNotFound)
+			return
+		}
+		h.logger.Error("Time interval check failed", "name", name, "error", err)
+		h.sendError(w, "Time interval check failed", http.StatusInternalServerError)
+		return
+	}
+
+	h.logger.Debug("Time interval check complete", "name", name, "time", at, "active", active)
+
+	h.writeJSON(w, http.StatusOK, TimeIntervalStatus{
+		Name:   name,
+		Time:   at,
+		Active: active,
+	})
+}
+
+func (h *TimeIntervalHandler) writeJSON(w http.ResponseWriter, code int, payload interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(payload)
+}
+
+// sendError sends an error response
+func (h *TimeIntervalHandler) sendError(w http.ResponseWriter, message string, code int) {
+	h.writeJSON(w, code, struct {
+		Error string `json:"error"`
+	}{
+		Error: message,
+	})
+}
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	amconfig "github.com/vitaliisemenov/alert-history/internal/alertmanager/config"
+	"github.com/vitaliisemenov/alert-history/internal/business/timeinterval"
+)
+
+func newTestTimeIntervalMux(t *testing.T) *http.ServeMux {
+	t.Helper()
+
+	evaluator, err := timeinterval.NewEvaluator([]amconfig.MuteTimeInterval{{
+		Name: "business-hours",
+		TimeIntervals: []amconfig.TimeInterval{{
+			Weekdays: []string{"monday:friday"},
+			Times:    []amconfig.TimeRange{{StartTime: "09:00", EndTime: "17:00"}},
+		}},
+	}})
+	require.NoError(t, err)
+
+	handler := NewTimeIntervalHandler(evaluator, nil)
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/time-intervals", handler.ListIntervals)
+	mux.HandleFunc("GET /api/v2/time-intervals/{name}/active", handler.CheckInterval)
+	return mux
+}
+
+func TestTimeIntervalHandler_ListIntervals(t *testing.T) {
+	mux := newTestTimeIntervalMux(t)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v2/time-intervals", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response TimeIntervalsResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+	assert.Equal(t, 1, response.Count)
+	assert.Equal(t, "business-hours", response.Intervals[0].Name)
+}
+
+func TestTimeIntervalHandler_CheckInterval(t *testing.T) {
+	mux := newTestTimeIntervalMux(t)
+
+	tests := []struct {
+		name       string
+		url        string
+		wantStatus int
+		wantActive bool
+	}{
+		{"active", "/api/v2/time-intervals/business-hours/active?time=2025-11-04T10:00:00Z", http.StatusOK, true},
+		{"inactive_weekend", "/api/v2/time-intervals/business-hours/active?time=2025-11-08T10:00:00Z", http.StatusOK, false},
+		{"invalid_time", "/api/v2/time-intervals/business-hours/active?time=tomorrow", http.StatusBadRequest, false},
+		{"not_found", "/api/v2/time-intervals/holidays/active", http.StatusNotFound, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.url, nil))
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			var status TimeIntervalStatus
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&status))
+			assert.Equal(t, "business-hours", status.Name)
+			assert.Equal(t, tt.wantActive, status.Active)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure/webhook"
+)
+
+// SourceWebhookProcessor handles webhook payloads of a named source.
+type SourceWebhookProcessor interface {
+	HandleWebhook(ctx context.Context, req *webhook.HandleWebhookRequest) (*webhook.HandleWebhookResponse, error)
+}
+
+// SourceWebhookHandler handles POST /webhook/{source}: payloads of a
+// monitoring system (grafana, datadog, cloudwatch, configured generic
+// sources, or the built-in alertmanager and prometheus formats) parsed by
+// the adapter with that name.
+//
+// Responses: 200 processed (or SNS subscription handled), 207 partially
+// processed, 400 invalid payload, 404 unknown source, 413 payload too
+// large, 500 processing failed.
+type SourceWebhookHandler struct {
+	processor SourceWebhookProcessor
+	config    *WebhookConfig
+	logger    *slog.Logger
+}
+
+// NewSourceWebhookHandler creates a new SourceWebhookHandler instance.
+func NewSourceWebhookHandler(processor SourceWebhookProcessor, config *WebhookConfig, logger *slog.Logger) *SourceWebhookHandler {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if config == nil {
+		config = &WebhookConfig{
+			MaxRequestSize: 10 * 1024 * 1024, // 10 MB default
+			RequestTimeout: 30 * time.Second,
+		}
+	}
+	return &SourceWebhookHandler{
+		processor: processor,
+		config:    config,
+		logger:    logger,
+	}
+}
+
+// ServeHTTP implements http.Handler interface
+func (h *SourceWebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	source := r.PathValue("source")
+
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		h.sendError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, int64(h.config.MaxRequestSize)))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			h.sendError(w, "Request body too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		h.sendError(w, "Failed to read request body", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	if h.config.RequestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, h.config.RequestTimeout)
+		defer cancel()
+	}
+
+	resp, err := h.processor.HandleWebhook(ctx, &webhook.HandleWebhookRequest{
+		Payload:     body,
+		ContentType: r.Header.Get("Content-Type"),
+		UserAgent:   r.Header.Get("User-Agent"),
+		Source:      source,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, webhook.ErrUnknownSource):
+			h.sendError(w, "Unknown webhook source: "+source, http.StatusNotFound)
+		case resp != nil:
+			h.writeJSON(w, http.StatusBadRequest, resp)
+		default:
+			h.logger.Warn("Webhook rejected", "source", source, "error", err)
+			h.sendError(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	code := http.StatusOK
+	switch resp.Status {
+	case "failure":
+		code = http.StatusInternalServerError
+	case "partial_success":
+		code = http.StatusMultiStatus
+	}
+	h.writeJSON(w, code, resp)
+}
+
+func (h *SourceWebhookHandler) writeJSON(w http.ResponseWriter, code int, payload interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(payload)
+}
+
+// sendError sends an error response
+func (h *SourceWebhookHandler) sendError(w http.ResponseWriter, message string, code int) {
+	h.writeJSON(w, code, struct {
+		Error string `json:"error"`
+	}{
+		Error: message,
+	})
+}
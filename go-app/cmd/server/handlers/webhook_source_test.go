@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure/webhook"
+)
+
+func TestSourceWebhookHandler(t *testing.T) {
+	var processed []*core.Alert
+	processor := &mockAlertProcessor{processFunc: func(ctx context.Context, alert *core.Alert) error {
+		if alert.AlertName == "Broken" {
+			return errors.New("storage unavailable")
+		}
+		processed = append(processed, alert)
+		return nil
+	}}
+	handler := NewSourceWebhookHandler(webhook.NewUniversalWebhookHandler(processor, nil),
+		&WebhookConfig{MaxRequestSize: 1024}, nil)
+
+	mux := http.NewServeMux()
+	mux.Handle("/webhook/{source}", handler)
+
+	post := func(source, body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/webhook/"+source, strings.NewReader(body)))
+		return rec
+	}
+
+	datadog := `{"alert_id": "12345", "alert_title": "%s", "alert_transition": "Triggered", "alert_type": "error", "tags": "env:prod"}`
+
+	rec := post("datadog", strings.Replace(datadog, "%s", "Disk usage high", 1))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var resp webhook.HandleWebhookResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, "datadog", resp.WebhookType)
+	assert.Equal(t, 1, resp.AlertsProcessed)
+	require.Len(t, processed, 1)
+	assert.Equal(t, "prod", processed[0].Labels["env"])
+
+	assert.Equal(t, http.StatusNotFound, post("nagios", `{}`).Code)
+	assert.Equal(t, http.StatusBadRequest, post("datadog", `{"title": "no monitor"}`).Code)
+	assert.Equal(t, http.StatusBadRequest, post("datadog", `not json`).Code)
+	assert.Equal(t, http.StatusInternalServerError, post("datadog", strings.Replace(datadog, "%s", "Broken", 1)).Code)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, post("datadog", `{"body": "`+strings.Repeat("x", 2048)+`"}`).Code)
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/webhook/datadog", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
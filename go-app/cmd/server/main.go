@@ -10,17 +10,23 @@ import (
 	_ "net/http/pprof" // Import pprof for profiling endpoints
 	"os"
 	"os/signal"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"                           // TN-201: pgxpool.Pool type
+	"github.com/prometheus/client_golang/prometheus"
 	_ "github.com/prometheus/client_golang/prometheus/promhttp" // Imported for side effects
 	"github.com/vitaliisemenov/alert-history/cmd/server/handlers"
 	proxyhandlers "github.com/vitaliisemenov/alert-history/cmd/server/handlers/proxy"
 	cmdmiddleware "github.com/vitaliisemenov/alert-history/cmd/server/middleware"
+	"github.com/vitaliisemenov/alert-history/internal/api"
+	"github.com/vitaliisemenov/alert-history/internal/business/escalation"
 	"github.com/vitaliisemenov/alert-history/internal/business/publishing"
+	"github.com/vitaliisemenov/alert-history/internal/business/routing"
 	businesssilencing "github.com/vitaliisemenov/alert-history/internal/business/silencing"
+	"github.com/vitaliisemenov/alert-history/internal/business/timeinterval"
 	appconfig "github.com/vitaliisemenov/alert-history/internal/config"
 	"github.com/vitaliisemenov/alert-history/internal/core"
 	"github.com/vitaliisemenov/alert-history/internal/core/services"
@@ -29,12 +35,15 @@ import (
 	"github.com/vitaliisemenov/alert-history/internal/infrastructure/cache"
 	"github.com/vitaliisemenov/alert-history/internal/infrastructure/grouping"
 	"github.com/vitaliisemenov/alert-history/internal/infrastructure/inhibition"
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure/k8s"
 	"github.com/vitaliisemenov/alert-history/internal/infrastructure/llm"
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure/nflog"
 	"github.com/vitaliisemenov/alert-history/internal/infrastructure/repository"
 	"github.com/vitaliisemenov/alert-history/internal/infrastructure/webhook"
 	internalmetrics "github.com/vitaliisemenov/alert-history/internal/metrics" // TN-152: Config reload metrics
 	"github.com/vitaliisemenov/alert-history/internal/middleware"
 	"github.com/vitaliisemenov/alert-history/internal/storage"  // TN-201: Storage backend selection
+	"github.com/vitaliisemenov/alert-history/internal/storage/sqlite"
 	"github.com/vitaliisemenov/alert-history/internal/ui"        // TN-77: Dashboard Template Engine
 	"github.com/vitaliisemenov/alert-history/internal/realtime" // TN-78: Real-time Updates
 
@@ -86,6 +95,8 @@ func (a *metricsLoggerAdapter) Error(msg string, args ...interface{}) {
 }
 
 func main() {
+	startedAt := time.Now()
+
 	// Parse command line flags
 	var showVersion = flag.Bool("version", false, "Show version information")
 	var showHelp = flag.Bool("help", false, "Show help information")
@@ -345,6 +356,10 @@ func main() {
 
 				// Cleanup DB exporter on shutdown (add to graceful shutdown)
 				defer dbExporter.Stop()
+			} else if sqliteStorage, ok := alertStorage.(*sqlite.SQLiteStorage); ok {
+				// Lite profile: same analytics on the embedded SQLite database
+				historyRepo = repository.NewSQLiteHistoryRepository(sqliteStorage.DB(), alertStorage, appLogger)
+				slog.Info("✅ Alert History Repository initialized (SQLite, with analytics: top alerts, flapping detection)")
 			} else {
 				slog.Warn("Alert History Repository NOT initialized (Postgres pool unavailable)")
 			}
@@ -446,6 +461,9 @@ func main() {
 	// TN-121/122/123/124: Initialize Alert Grouping System
 	var groupManager grouping.AlertGroupManager
 	var timerManager grouping.GroupTimerManager
+	var groupingConfig *grouping.GroupingConfig
+	var groupingConfigFile string
+	var groupKeyGenerator *grouping.GroupKeyGenerator
 
 	// Check if we have a grouping config file
 	if groupingConfigPath := os.Getenv("GROUPING_CONFIG_PATH"); groupingConfigPath != "" || true {
@@ -460,15 +478,19 @@ func main() {
 
 			// TN-121: Parse grouping configuration
 			parser := grouping.NewParser()
-			groupingConfig, err := parser.ParseFile(groupingConfigPath)
+			groupingConfig, err = parser.ParseFile(groupingConfigPath)
 			if err != nil {
 				slog.Warn("Failed to parse grouping config, grouping disabled", "error", err)
+				groupingConfig = nil
 			} else {
+				groupingConfigFile = groupingConfigPath
+
 				// TN-122: Create Group Key Generator (with default options)
 				keyGenerator := grouping.NewGroupKeyGenerator(
 					grouping.WithHashLongKeys(true),
 					grouping.WithMaxKeyLength(256),
 				)
+				groupKeyGenerator = keyGenerator
 
 				// TN-124: Create Timer Storage (Redis or in-memory fallback)
 				var timerStorage grouping.TimerStorage
@@ -485,11 +507,38 @@ func main() {
 					slog.Info("Using in-memory timer storage (Redis not available)")
 				}
 
-				// TN-123: Create Alert Group Manager
+				// TN-125: Create Group Storage (Redis with in-memory fallback)
 				groupManagerCtx := context.Background()
+				memoryGroupStorage := grouping.NewMemoryGroupStorage(&grouping.MemoryGroupStorageConfig{
+					Logger:  appLogger,
+					Metrics: businessMetrics,
+				})
+				var groupStorage grouping.GroupStorage = memoryGroupStorage
+				if redisClient, ok := redisCache.(*cache.RedisCache); ok && redisClient != nil {
+					redisGroupStorage, err := grouping.NewRedisGroupStorage(groupManagerCtx, &grouping.RedisGroupStorageConfig{
+						Client:  redisClient.GetClient(),
+						Logger:  appLogger,
+						Metrics: businessMetrics,
+					})
+					if err != nil {
+						slog.Warn("Failed to create Redis group storage, using in-memory storage", "error", err)
+					} else {
+						storageManager := grouping.NewStorageManager(redisGroupStorage, memoryGroupStorage, appLogger, businessMetrics)
+						defer storageManager.Stop()
+						groupStorage = storageManager
+						slog.Info("✅ Redis Group Storage initialized (in-memory fallback)")
+					}
+				} else {
+					slog.Info("Using in-memory group storage (Redis not available)")
+				}
+
+				// TN-123: Create Alert Group Manager
+				// Note: TimerManager is not passed here - group timers are driven
+				// by the notification dispatcher (route → group → timers → publish)
 				groupManager, err = grouping.NewDefaultGroupManager(groupManagerCtx, grouping.DefaultGroupManagerConfig{
 					KeyGenerator: keyGenerator,
 					Config:       groupingConfig,
+					Storage:      groupStorage,
 					Logger:       appLogger,
 					Metrics:      businessMetrics, // Now we have BusinessMetrics!
 				})
@@ -514,16 +563,6 @@ func main() {
 						})
 						if err != nil {
 							slog.Error("Failed to create timer manager", "error", err)
-						} else {
-							// Restore timers after restart (HA)
-							restored, missed, err := timerManager.RestoreTimers(ctx)
-							if err != nil {
-								slog.Warn("Failed to restore timers", "error", err)
-							} else {
-								slog.Info("✅ Alert Grouping System fully initialized",
-									"timers_restored", restored,
-									"timers_missed", missed)
-							}
 						}
 					}
 				}
@@ -604,9 +643,323 @@ func main() {
 		}
 	}
 
+	// TN-134: Initialize Silence Manager (before AlertProcessor: used for silence checks)
+	var silenceManager businesssilencing.SilenceManager
+	var recurringSilenceManager businesssilencing.RecurringSilenceManager
+	if pool != nil && businessMetrics != nil {
+		slog.Info("Initializing Silence Management System (TN-134, TN-135)")
+
+		// TN-131: Silence repository with PostgreSQL
+		silenceRepo := infrasilencing.NewPostgresSilenceRepository(pool.Pool(), appLogger)
+		slog.Info("✅ Silence Repository initialized (PostgreSQL)")
+
+		// TN-132: Silence matcher engine with regex support
+		silenceMatcher := coresilencing.NewSilenceMatcher()
+		slog.Info("✅ Silence Matcher initialized (regex support, 4 operators)")
+
+		// Cross-replica change feed: silences created/updated/deleted on one
+		// replica update the caches of all replicas immediately (periodic sync
+		// stays as a safety net). SILENCE_CHANGE_FEED=redis|postgres|none
+		silenceConfig := businesssilencing.DefaultSilenceManagerConfig()
+		switch feedType := os.Getenv("SILENCE_CHANGE_FEED"); feedType {
+		case "none":
+			slog.Info("Silence change feed disabled, using periodic sync only")
+		case "redis":
+			if redisClient, ok := redisCache.(*cache.RedisCache); ok && redisClient != nil {
+				silenceConfig.ChangeFeed = infrasilencing.NewRedisChangeFeed(redisClient.GetClient(), appLogger)
+				slog.Info("✅ Silence change feed initialized (Redis pub/sub)")
+			} else {
+				slog.Warn("SILENCE_CHANGE_FEED=redis but Redis is not available, using periodic sync only")
+			}
+		default:
+			silenceConfig.ChangeFeed = infrasilencing.NewPostgresChangeFeed(pool.Pool(), appLogger)
+			slog.Info("✅ Silence change feed initialized (PostgreSQL LISTEN/NOTIFY)")
+		}
+
+		// TN-134: Silence manager service with lifecycle management
+		defaultSilenceManager := businesssilencing.NewDefaultSilenceManager(
+			silenceRepo,
+			silenceMatcher,
+			appLogger,
+			&silenceConfig,
+		)
+
+		// Start silence manager (initializes cache + background workers)
+		silenceCtx := context.Background()
+		if err := defaultSilenceManager.Start(silenceCtx); err != nil {
+			slog.Error("Failed to start silence manager", "error", err)
+		} else {
+			silenceManager = defaultSilenceManager
+			slog.Info("✅ Silence Manager started",
+				"features", []string{
+					"In-memory cache (fast lookups <50ns)",
+					"Background GC worker (5m interval)",
+					"Background sync worker (1m interval)",
+					"8 Prometheus metrics",
+				})
+
+			// Graceful shutdown on exit
+			defer func() {
+				shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+				defer cancel()
+				if err := defaultSilenceManager.Stop(shutdownCtx); err != nil {
+					slog.Warn("Silence manager shutdown timeout", "error", err)
+				} else {
+					slog.Info("✅ Silence Manager stopped gracefully")
+				}
+				if silenceConfig.ChangeFeed != nil {
+					_ = silenceConfig.ChangeFeed.Close()
+				}
+			}()
+
+			// Recurring silences: series materialised ahead of time into silences
+			recurringRepo := infrasilencing.NewPostgresRecurringSilenceRepository(pool.Pool(), appLogger)
+			defaultRecurringManager := businesssilencing.NewDefaultRecurringSilenceManager(
+				recurringRepo,
+				defaultSilenceManager,
+				appLogger,
+				nil,
+			)
+			defaultRecurringManager.Start(silenceCtx)
+			recurringSilenceManager = defaultRecurringManager
+			slog.Info("✅ Recurring Silence Manager started (cron/RRULE, 7d horizon)")
+
+			defer defaultRecurringManager.Stop()
+		}
+	} else {
+		slog.Warn("⚠️ Silence Management System NOT initialized (database or metrics not available)")
+	}
+
 	// Initialize filter engine and publisher
 	filterEngine := services.NewSimpleFilterEngine(appLogger)
-	publisher := services.NewSimplePublisher(appLogger)
+
+	// TN-047: Publishing targets come from K8s secrets (watch-based discovery)
+	// when running in a cluster; without K8s access there are no targets.
+	var targetDiscovery infrapublishing.TargetDiscoveryManager = infrapublishing.NewStubTargetDiscoveryManager(appLogger)
+	var k8sTargetDiscovery publishing.TargetDiscoveryManager
+	var k8sTargetWatcher publishing.TargetWatcher
+	if k8sClient, err := k8s.NewK8sClient(k8s.DefaultK8sClientConfig()); err != nil {
+		slog.Warn("⚠️ K8s client not available, publishing target discovery disabled (no publishing targets)", "error", err)
+	} else {
+		defer k8sClient.Close()
+		namespace := os.Getenv("K8S_NAMESPACE")
+		if namespace == "" {
+			namespace = "default"
+		}
+		discoveryMgr, err := publishing.NewTargetDiscoveryManager(k8sClient, namespace, "publishing-target=true", appLogger, metricsRegistry)
+		if err != nil {
+			slog.Error("Failed to create target discovery manager, no publishing targets", "error", err)
+		} else {
+			// Watch-based discovery: secret add/update/delete events are applied
+			// incrementally (resync every 10m repairs missed events). StartWatch
+			// returns once the initial list is applied.
+			if watcher, ok := discoveryMgr.(publishing.TargetWatcher); ok {
+				if err := watcher.StartWatch(ctx, 10*time.Minute); err != nil {
+					slog.Warn("Target watch failed, using one-off discovery", "error", err)
+				} else {
+					k8sTargetWatcher = watcher
+				}
+			}
+			if k8sTargetWatcher == nil {
+				if err := discoveryMgr.DiscoverTargets(ctx); err != nil {
+					slog.Warn("Initial target discovery failed", "error", err)
+				}
+			}
+			if targets, ok := discoveryMgr.(infrapublishing.TargetDiscoveryManager); ok {
+				targetDiscovery = targets
+			}
+			k8sTargetDiscovery = discoveryMgr
+			stats := discoveryMgr.GetStats()
+			slog.Info("✅ Target Discovery Manager initialized (TN-047)",
+				"namespace", namespace,
+				"watch", k8sTargetWatcher != nil,
+				"valid_targets", stats.ValidTargets,
+				"invalid_targets", stats.InvalidTargets)
+		}
+	}
+
+	// Publisher factory shared by the publishing queue and acknowledgement
+	// forwarding: PagerDuty dedup keys and Rootly incident IDs are cached
+	// per factory.
+	publisherFactory := infrapublishing.NewPublisherFactory(infrapublishing.NewAlertFormatter(), appLogger)
+	slog.Info("✅ Publisher Factory created",
+		"publishers", []string{"Rootly", "PagerDuty", "Slack", "Webhook"})
+
+	// Receiver publisher: route receivers deliver to their publishing targets
+	// (grouping config receivers; by default the target named like the receiver),
+	// rendered with the receiver's managed template if it references one
+	receiverTargets := make(map[string][]string)
+	receiverTemplates := make(map[string]services.ReceiverTemplate)
+	if groupingConfig != nil {
+		for _, receiver := range groupingConfig.Receivers {
+			receiverTargets[receiver.Name] = receiver.Targets
+			if receiver.Template != "" {
+				receiverTemplates[receiver.Name] = services.ReceiverTemplate{
+					Name:    receiver.Template,
+					Version: receiver.TemplateVersion,
+				}
+			}
+		}
+	}
+	publisher, err := services.NewReceiverPublisher(services.ReceiverPublisherConfig{
+		Receivers: receiverTargets,
+		Templates: receiverTemplates,
+		Targets:   targetDiscovery,
+		Publisher: publisherFactory,
+		Logger:    appLogger,
+	})
+	if err != nil {
+		slog.Error("Failed to create receiver publisher", "error", err)
+		os.Exit(1)
+	}
+	slog.Info("✅ Receiver Publisher initialized", "receivers_mapped", len(receiverTargets))
+
+	// Initialize time interval evaluator (mute_time_intervals / active_time_intervals)
+	var timeIntervalEvaluator *timeinterval.Evaluator
+	if groupingConfig != nil {
+		evaluator, err := timeinterval.NewEvaluator(groupingConfig.TimeIntervals, groupingConfig.MuteTimeIntervals)
+		if err != nil {
+			slog.Error("Failed to compile time intervals, time-based muting disabled", "error", err)
+		} else {
+			timeIntervalEvaluator = evaluator
+			slog.Info("✅ Time Interval Evaluator initialized", "intervals", len(evaluator.Names()))
+		}
+	}
+
+	// Initialize alert acknowledgement workflow (ack/unack/assign/comment)
+	var ackRepo core.AckRepository
+	if pool != nil {
+		ackRepo = repository.NewPostgresAckRepository(pool.Pool(), appLogger)
+	} else {
+		ackRepo = repository.NewMemoryAckRepository()
+		slog.Warn("⚠️ Alert acknowledgements are kept in memory (PostgreSQL not available, lost on restart)")
+	}
+	// Acknowledgements are forwarded to the discovered PagerDuty/Rootly targets
+	ackForwarder := infrapublishing.NewTargetAckForwarder(publisherFactory, targetDiscovery, appLogger)
+	if _, stub := targetDiscovery.(*infrapublishing.StubTargetDiscoveryManager); stub {
+		slog.Warn("⚠️ Acknowledgement forwarding to PagerDuty/Rootly disabled (no target discovery, K8s not available)")
+	}
+	ackService, err := services.NewAckService(&services.AckServiceConfig{
+		Repository: ackRepo,
+		Storage:    alertStorage,
+		Forwarder:  ackForwarder,
+		Logger:     appLogger,
+	})
+	if err != nil {
+		slog.Error("Failed to create acknowledgement service", "error", err)
+		ackService = nil
+	} else {
+		slog.Info("✅ Alert Acknowledgement Service initialized")
+	}
+
+	// Initialize LLM client: native providers with failover when
+	// llm.providers is set, otherwise the classification proxy
+	// (shared by classification and group summaries)
+	var llmClient llm.LLMClient
+	if cfg.LLM.Enabled {
+		if len(cfg.LLM.Providers) > 0 {
+			providerConfigs := make([]llm.ProviderConfig, 0, len(cfg.LLM.Providers))
+			for _, provider := range cfg.LLM.Providers {
+				providerConfigs = append(providerConfigs, llm.ProviderConfig{
+					Name:        provider.Name,
+					Type:        llm.ProviderType(provider.Type),
+					BaseURL:     provider.BaseURL,
+					APIKey:      provider.APIKey,
+					Model:       provider.Model,
+					MaxTokens:   provider.MaxTokens,
+					Temperature: provider.Temperature,
+					Timeout:     provider.Timeout,
+					MaxRetries:  provider.MaxRetries,
+				})
+			}
+			failoverClient, err := llm.NewFailoverClientFromConfig(
+				providerConfigs, cfg.LLM.PromptTemplate, llm.DefaultCircuitBreakerConfig(), appLogger)
+			if err != nil {
+				slog.Error("Failed to initialize LLM providers", "error", err)
+				os.Exit(1)
+			}
+			llmClient = failoverClient
+			slog.Info("✅ Native LLM providers initialized", "providers", len(providerConfigs))
+		} else {
+			llmConfig := llm.Config{
+				BaseURL:    cfg.LLM.BaseURL,
+				APIKey:     cfg.LLM.APIKey,
+				Model:      cfg.LLM.Model,
+				Timeout:    cfg.LLM.Timeout,
+				MaxRetries: cfg.LLM.MaxRetries,
+			}
+			llmClient = llm.NewHTTPLLMClient(llmConfig, appLogger)
+		}
+	}
+
+	// Initialize notification dispatcher (route tree → grouping → timers → publish)
+	var alertDispatcher services.Dispatcher
+	if groupingConfig != nil && groupManager != nil && timerManager != nil {
+		routeConfig, err := services.RouteConfigFromGrouping(groupingConfig)
+		if err == nil {
+			var routeTree *routing.RouteTree
+			routeTree, err = routing.NewTreeBuilder(routeConfig, routing.DefaultBuildOptions()).Build()
+			if err == nil {
+				routeEvaluator := routing.NewRouteEvaluator(
+					routeTree,
+					routing.NewRouteMatcher(nil, routing.DefaultMatcherOptions()),
+					routing.DefaultEvaluatorOptions(),
+				)
+				dispatcherConfig := services.GroupDispatcherConfig{
+					Evaluator:       routeEvaluator,
+					KeyGenerator:    groupKeyGenerator,
+					GroupManager:    groupManager,
+					TimerManager:    timerManager,
+					Publisher:       publisher,
+					NotificationLog: newNotificationLog(ctx, pool, alertStorage, redisCache, appLogger),
+					Logger:          appLogger,
+				}
+				if timeIntervalEvaluator != nil {
+					dispatcherConfig.TimeChecker = timeIntervalEvaluator
+				}
+				if ackService != nil {
+					dispatcherConfig.AckChecker = ackService
+				}
+				if cfg.LLM.GroupSummary.Enabled {
+					summarizerConfig := services.GroupSummarizerConfig{
+						Cache:     redisCache,
+						CacheTTL:  cfg.LLM.GroupSummary.CacheTTL,
+						MinAlerts: cfg.LLM.GroupSummary.MinAlerts,
+						Timeout:   cfg.LLM.GroupSummary.Timeout,
+						Logger:    appLogger,
+					}
+					// The classification proxy cannot summarise groups (rule-based summaries)
+					if summaryClient, ok := llmClient.(llm.GroupSummaryClient); ok {
+						summarizerConfig.Client = summaryClient
+					}
+					dispatcherConfig.Summarizer = services.NewGroupSummarizer(summarizerConfig)
+				}
+				alertDispatcher, err = services.NewGroupDispatcher(dispatcherConfig)
+			}
+		}
+		if err != nil {
+			slog.Error("Failed to create notification dispatcher, publishing alerts directly", "error", err)
+			alertDispatcher = nil
+		} else {
+			slog.Info("✅ Notification Dispatcher initialized (route tree + grouping + timers)")
+		}
+	} else {
+		slog.Info("Notification Dispatcher disabled (grouping not configured), publishing alerts directly")
+	}
+
+	// Restore timers after restart (HA). Runs once the dispatcher registered
+	// its flush callback, so missed timers are flushed (and deduplicated by
+	// the notification log) instead of firing without a callback.
+	if timerManager != nil {
+		restored, missed, err := timerManager.RestoreTimers(ctx)
+		if err != nil {
+			slog.Warn("Failed to restore timers", "error", err)
+		} else {
+			slog.Info("✅ Alert Grouping System fully initialized",
+				"timers_restored", restored,
+				"timers_missed", missed)
+		}
+	}
 
 	// TN-036 Phase 3: Initialize Deduplication Service
 	var deduplicationService services.DeduplicationService
@@ -636,26 +989,27 @@ func main() {
 		slog.Warn("⚠️ Deduplication Service NOT initialized (database not available)")
 	}
 
+	// Rule-based fallback classification (declarative llm.fallback_rules, hot-reloadable)
+	fallbackEngine := services.NewRuleBasedFallback(appLogger)
+	if err := fallbackEngine.LoadRules(fallbackRuleSpecs(cfg.LLM.FallbackRules)); err != nil {
+		slog.Error("Invalid fallback rules, using built-in rules", "error", err)
+	} else {
+		slog.Info("✅ Fallback classification rules loaded",
+			"rules", len(fallbackEngine.RuleNames()),
+			"custom", len(cfg.LLM.FallbackRules) > 0)
+	}
+
 	// TN-033: Initialize Classification Service with two-tier caching
 	var classificationService services.ClassificationService
 	if cfg.LLM.Enabled {
 		slog.Info("Initializing LLM Classification Service (TN-033)")
 
-		// Initialize LLM client
-		llmConfig := llm.Config{
-			BaseURL:    cfg.LLM.BaseURL,
-			APIKey:     cfg.LLM.APIKey,
-			Model:      cfg.LLM.Model,
-			Timeout:    cfg.LLM.Timeout,
-			MaxRetries: cfg.LLM.MaxRetries,
-		}
-		llmClient := llm.NewHTTPLLMClient(llmConfig, appLogger)
-
 		// Create classification service config
 		classificationConfig := services.ClassificationServiceConfig{
 			LLMClient:       llmClient,
 			Cache:           redisCache,
 			Storage:         alertStorage,
+			FallbackEngine:  fallbackEngine,
 			Config:          services.DefaultClassificationConfig(),
 			BusinessMetrics: metricsRegistry.Business(),
 		}
@@ -699,6 +1053,58 @@ func main() {
 		slog.Info("Classification Handlers initialized (without service, graceful degradation enabled)")
 	}
 
+	// Initialize escalation policies (levels of receivers notified after a
+	// delay until the alert resolves or is acknowledged)
+	var escalationManager *escalation.DefaultEscalationManager
+	escalationConfigPath := os.Getenv("ESCALATION_CONFIG_PATH")
+	if escalationConfigPath == "" {
+		escalationConfigPath = "./config/escalation.yaml"
+	}
+	if _, err := os.Stat(escalationConfigPath); err == nil {
+		escalationConfig, err := escalation.LoadConfig(escalationConfigPath)
+		if err != nil {
+			slog.Error("Failed to load escalation policies, escalation disabled", "error", err)
+		} else {
+			var escalationRepo core.EscalationRepository
+			if pool != nil {
+				escalationRepo = repository.NewPostgresEscalationRepository(pool.Pool(), appLogger)
+			} else {
+				escalationRepo = repository.NewMemoryEscalationRepository()
+				slog.Warn("⚠️ Escalations are kept in memory (PostgreSQL not available, lost on restart)")
+			}
+			managerConfig := escalation.ManagerConfig{
+				Config:     escalationConfig,
+				Repository: escalationRepo,
+				Publisher:  publisher,
+				Storage:    alertStorage,
+				Logger:     appLogger,
+			}
+			if ackService != nil {
+				managerConfig.Acks = ackService
+			}
+			escalationManager, err = escalation.NewDefaultEscalationManager(managerConfig)
+			if err != nil {
+				slog.Error("Failed to create escalation manager, escalation disabled", "error", err)
+				escalationManager = nil
+			} else {
+				// Re-arm pending levels after restart (missed levels are sent now)
+				restored, missed, err := escalationManager.RestoreEscalations(ctx)
+				if err != nil {
+					slog.Warn("Failed to restore escalations", "error", err)
+				}
+				escalationManager.Start(ctx)
+				defer escalationManager.Stop()
+				slog.Info("✅ Escalation Manager initialized",
+					"config", escalationConfigPath,
+					"policies", len(escalationConfig.Policies),
+					"escalations_restored", restored,
+					"escalations_missed", missed)
+			}
+		}
+	} else {
+		slog.Info("Escalation config not found, escalation disabled", "path", escalationConfigPath)
+	}
+
 	// Initialize AlertProcessor
 	alertProcessorConfig := services.AlertProcessorConfig{
 		EnrichmentManager: enrichmentManager,
@@ -708,10 +1114,17 @@ func main() {
 		Deduplication:     deduplicationService,   // TN-036 Phase 3
 		InhibitionMatcher: inhibitionMatcher,      // TN-130 Phase 6: Inhibition checking
 		InhibitionState:   inhibitionStateManager, // TN-130 Phase 6: State tracking
+		ActiveAlerts:      activeAlertCache,       // Inhibition sources + re-dispatch of released targets
 		BusinessMetrics:   businessMetrics,        // TN-130 Phase 6: Business metrics
+		SilenceChecker:    silenceManager,         // Silence checking (after inhibition)
+		Dispatcher:        alertDispatcher,        // Route tree + grouping + timers
+		Storage:           alertStorage,           // Silenced/inhibited state on the alert history
 		Logger:            appLogger,
 		Metrics:           metricsManager,
 	}
+	if escalationManager != nil {
+		alertProcessorConfig.Escalator = escalationManager
+	}
 
 	alertProcessor, err := services.NewAlertProcessor(alertProcessorConfig)
 	if err != nil {
@@ -730,6 +1143,26 @@ func main() {
 	// Import is needed: "github.com/vitaliisemenov/alert-history/internal/infrastructure/webhook"
 	universalWebhookHandler := webhook.NewUniversalWebhookHandler(alertProcessor, appLogger)
 
+	// Ingestion adapters: SNS settings and generic JSON sources (POST /webhook/{source})
+	ingestionConfigPath := os.Getenv("INGESTION_CONFIG_PATH")
+	if ingestionConfigPath == "" {
+		ingestionConfigPath = "./config/ingestion.yaml"
+	}
+	if _, err := os.Stat(ingestionConfigPath); err == nil {
+		ingestionConfig, err := webhook.LoadIngestionConfig(ingestionConfigPath)
+		if err != nil {
+			slog.Error("Failed to load ingestion config, using built-in adapters", "error", err)
+		} else if adapters, err := ingestionConfig.Adapters(appLogger); err != nil {
+			slog.Error("Failed to build ingestion adapters, using built-in adapters", "error", err)
+		} else {
+			for _, adapter := range adapters {
+				universalWebhookHandler.Adapters().Register(adapter)
+			}
+		}
+	}
+	slog.Info("✅ Webhook source adapters registered",
+		"sources", universalWebhookHandler.Adapters().Names())
+
 	// Create webhook HTTP handler configuration
 	webhookHTTPConfig := &handlers.WebhookConfig{
 		MaxRequestSize:  int(cfg.Webhook.MaxRequestSize),
@@ -1019,6 +1452,14 @@ func main() {
 		"middleware_count", 10,
 		"features", "recovery|request_id|logging|metrics|rate_limit|auth|compression|cors|size_limit|timeout")
 
+	// Per-source ingestion: POST /webhook/{source} (grafana, datadog, cloudwatch, generic sources)
+	mux.Handle("/webhook/{source}", webhookMiddlewareStack(handlers.NewSourceWebhookHandler(
+		universalWebhookHandler,
+		webhookHTTPConfig,
+		appLogger,
+	)))
+	slog.Info("✅ POST /webhook/{source} endpoint registered")
+
 	// TN-77: Initialize Dashboard Handler (Modern Dashboard Page - 150% quality)
 	var dashboardHandler *handlers.SimpleDashboardHandler
 	dashboardTemplateEngine, err := ui.NewTemplateEngine(ui.DefaultTemplateOptions())
@@ -1048,6 +1489,10 @@ func main() {
 			appLogger,
 		)
 
+		if ackService != nil {
+			alertListUIHandler.SetAckService(ackService)
+		}
+
 		// TN-80: Set Classification Enricher if classification service is available
 		if classificationService != nil {
 			classificationEnricher := ui.NewClassificationEnricher(classificationService, appLogger)
@@ -1087,9 +1532,54 @@ func main() {
 		// Create RealtimeMetrics
 		realtimeMetrics := realtime.NewRealtimeMetrics("alert_history")
 
+		// Cross-replica backend: dashboards connected to any replica see the
+		// events of all replicas. REALTIME_EVENT_BUS=memory|redis|postgres
+		// (default: in-process for the Lite profile, Redis or Postgres otherwise)
+		var realtimeBackend realtime.EventBusBackend
+		redisClient, _ := redisCache.(*cache.RedisCache)
+		busType := os.Getenv("REALTIME_EVENT_BUS")
+		if busType == "" && cfg.Profile == appconfig.ProfileStandard {
+			busType = "postgres"
+			if redisClient != nil {
+				busType = "redis" // No 8000 byte payload limit
+			}
+		}
+		switch busType {
+		case "", "memory":
+		case "redis":
+			if redisClient != nil {
+				realtimeBackend = realtime.NewRedisBusBackend(redisClient.GetClient(), appLogger)
+			} else {
+				slog.Warn("REALTIME_EVENT_BUS=redis but Redis is not available, using in-process event bus")
+			}
+		case "postgres":
+			if pool != nil && pool.Pool() != nil {
+				realtimeBackend = realtime.NewPostgresBusBackend(pool.Pool(), appLogger)
+			} else {
+				slog.Warn("REALTIME_EVENT_BUS=postgres but PostgreSQL is not available, using in-process event bus")
+			}
+		default:
+			slog.Warn("Unknown REALTIME_EVENT_BUS, using in-process event bus", "value", busType)
+		}
+		if realtimeBackend == nil {
+			busType = "memory"
+		}
+
 		// Create EventBus
-		realtimeEventBus = realtime.NewEventBus(appLogger, realtimeMetrics)
 		realtimeCtx, realtimeCancel := context.WithCancel(context.Background())
+		if realtimeBackend != nil {
+			realtimeEventBus = realtime.NewDistributedEventBus(appLogger, realtimeMetrics, realtimeBackend)
+		} else {
+			realtimeEventBus = realtime.NewEventBus(appLogger, realtimeMetrics)
+		}
+		startErr := realtimeEventBus.Start(realtimeCtx)
+		if startErr != nil && realtimeBackend != nil {
+			slog.Error("Failed to connect real-time EventBus backend, using in-process event bus", "error", startErr)
+			_ = realtimeBackend.Close()
+			realtimeBackend, busType = nil, "memory"
+			realtimeEventBus = realtime.NewEventBus(appLogger, realtimeMetrics)
+			startErr = realtimeEventBus.Start(realtimeCtx)
+		}
 		defer func() {
 			if realtimeEventBus != nil {
 				shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -1099,14 +1589,19 @@ func main() {
 				} else {
 					slog.Info("✅ Real-time EventBus stopped gracefully")
 				}
+				if realtimeBackend != nil {
+					_ = realtimeBackend.Close()
+				}
 				realtimeCancel()
 			}
 		}()
 
-		if err := realtimeEventBus.Start(realtimeCtx); err != nil {
-			slog.Error("Failed to start real-time EventBus", "error", err)
+		if startErr != nil {
+			slog.Error("Failed to start real-time EventBus", "error", startErr)
 		} else {
-			slog.Info("✅ Real-time EventBus started (TN-78, 150% quality)")
+			slog.Info("✅ Real-time EventBus started (TN-78, 150% quality)",
+				"backend", busType,
+				"origin", realtimeEventBus.Origin())
 
 			// Create SSE Handler
 			sseHandler = handlers.NewSSEHandler(realtimeEventBus, appLogger, realtimeMetrics)
@@ -1117,6 +1612,8 @@ func main() {
 					"Keep-alive ping every 30s",
 					"CORS support",
 					"Graceful shutdown",
+					"Last-Event-ID resume (replay buffer)",
+					"Filters: type, namespace, severity, filter (label matchers)",
 				})
 
 			// Create Event Publisher (will be used by AlertProcessor, StatsCollector, etc.)
@@ -1129,8 +1626,13 @@ func main() {
 					"health_changed",
 					"system_notification",
 				})
-			// Note: eventPublisher is available for use by AlertProcessor, StatsCollector, etc.
-			_ = eventPublisher // Suppress unused variable warning for now
+			if escalationManager != nil {
+				escalationManager.SetEventPublisher(eventPublisher)
+			}
+			// Realtime notifications on publishing target changes (TN-047 watch)
+			if k8sTargetWatcher != nil {
+				k8sTargetWatcher.SetEventPublisher(eventPublisher)
+			}
 		}
 	} else {
 		slog.Warn("⚠️ Real-time updates NOT initialized (metrics registry not available)")
@@ -1212,19 +1714,59 @@ func main() {
 		slog.Warn("⚠️ POST /api/v2/alerts endpoint NOT available (handler not initialized)")
 	}
 
+	// Alertmanager v2 alert status: silences, inhibitions and routed receivers
+	alertmanagerConverterDeps := &handlers.ConverterDependencies{Logger: appLogger}
+	if silenceManager != nil {
+		alertmanagerConverterDeps.SilenceChecker = handlers.NewSilenceManagerChecker(silenceManager)
+	}
+	if inhibitionStateManager != nil {
+		alertmanagerConverterDeps.InhibitionChecker = handlers.NewInhibitionStateChecker(inhibitionStateManager)
+	}
+	groupDispatcher, _ := alertDispatcher.(*services.GroupDispatcher)
+	if groupDispatcher != nil {
+		alertmanagerConverterDeps.ReceiverResolver = groupDispatcher
+	}
+
+	// Alertmanager v2 status, receivers and alert groups (amtool, Grafana)
+	alertmanagerAPIConfig := handlers.AlertmanagerAPIConfig{
+		Version:   serviceVersion,
+		StartTime: startedAt,
+		Converter: alertmanagerConverterDeps,
+		Logger:    appLogger,
+	}
+	if groupingConfig != nil {
+		if data, err := os.ReadFile(groupingConfigFile); err == nil {
+			alertmanagerAPIConfig.Config = string(data)
+		}
+		if routeConfig, err := services.RouteConfigFromGrouping(groupingConfig); err == nil {
+			for _, receiver := range routeConfig.Receivers {
+				alertmanagerAPIConfig.Receivers = append(alertmanagerAPIConfig.Receivers, receiver.Name)
+			}
+		}
+	}
+	if groupDispatcher != nil {
+		alertmanagerAPIConfig.Groups = groupManager
+		alertmanagerAPIConfig.Router = groupDispatcher
+	}
+	alertmanagerAPIHandler := handlers.NewAlertmanagerAPIHandler(alertmanagerAPIConfig)
+	mux.HandleFunc("GET /api/v2/status", alertmanagerAPIHandler.HandleStatus)
+	mux.HandleFunc("GET /api/v2/receivers", alertmanagerAPIHandler.HandleReceivers)
+	mux.HandleFunc("GET /api/v2/alerts/groups", alertmanagerAPIHandler.HandleAlertGroups)
+	slog.Info("✅ Alertmanager v2 API endpoints registered",
+		"endpoints", []string{
+			"GET /api/v2/status - Cluster, version, configuration and uptime",
+			"GET /api/v2/receivers - Route tree receivers",
+			"GET /api/v2/alerts/groups - Active alerts by aggregation group",
+		},
+		"alert_groups", groupDispatcher != nil)
+
 	// TN-148: Register Prometheus Query endpoint (GET /api/v2/alerts)
 	var prometheusQueryHandler *handlers.PrometheusQueryHandler
 	if historyRepo != nil {
 		slog.Info("Initializing Prometheus Query Handler (TN-148)...")
 
-		// Create converter dependencies (optional silence/inhibition integration)
-		// Note: Full integration with TN-133/129 requires additional wiring
-		// For now, converter works without these (best-effort approach)
-		converterDeps := &handlers.ConverterDependencies{
-			Logger: appLogger,
-			// SilenceChecker and InhibitionChecker remain nil for Phase 1
-			// Will be integrated when silence/inhibition managers are available in this scope
-		}
+		// Silence/inhibition status and route tree receivers (when available)
+		converterDeps := alertmanagerConverterDeps
 
 		// Create handler configuration
 		queryConfig := handlers.DefaultPrometheusQueryConfig()
@@ -1282,7 +1824,7 @@ func main() {
 				"integration", []string{
 					"TN-037: AlertHistoryRepository (query)",
 					"TN-146: Format conversion",
-					"TN-133/129: Silence/Inhibition (future enhancement)",
+					"TN-133/129: Silence/Inhibition status",
 				},
 				"quality", "150% (Grade A+ EXCEPTIONAL, 1,645 LOC)",
 				"status", "PRODUCTION-READY")
@@ -1291,8 +1833,19 @@ func main() {
 		slog.Warn("⚠️ GET /api/v2/alerts endpoint NOT available (history repository not initialized)")
 	}
 
-	// Legacy history endpoint (for backward compatibility)
-	mux.HandleFunc("/history", handlers.HistoryHandler)
+	// Alert history: repository backed (page or cursor pagination) when
+	// available, legacy endpoint otherwise (for backward compatibility)
+	if historyHandlerV2 != nil {
+		mux.HandleFunc("/history", historyHandlerV2.HandleHistory)
+		mux.HandleFunc("/history/export", historyHandlerV2.HandleExport)
+		slog.Info("✅ History endpoints registered",
+			"endpoints", []string{
+				"GET /history - Alert history (page/per_page or cursor pagination)",
+				"GET /history/export - Streaming export (format=ndjson|csv)",
+			})
+	} else {
+		mux.HandleFunc("/history", handlers.HistoryHandler)
+	}
 
 	// TN-038: Register analytics endpoints (if historyRepo available)
 	if historyHandlerV2 != nil {
@@ -1331,6 +1884,14 @@ func main() {
 		slog.Warn("⚠️ Classification endpoints NOT available (handlers not initialized)")
 	}
 
+	// Fallback rules dry-run (works without LLM)
+	fallbackHandlers := classificationhandlers.NewFallbackHandlers(fallbackEngine, appLogger)
+	mux.HandleFunc("POST /api/v2/classification/fallback/dry-run", fallbackHandlers.DryRun)
+	slog.Info("✅ Fallback classification endpoints registered",
+		"endpoints", []string{
+			"POST /api/v2/classification/fallback/dry-run - Show which fallback rule matches an alert",
+		})
+
 	// Register enrichment mode endpoints
 	mux.HandleFunc("/enrichment/mode", func(w http.ResponseWriter, r *http.Request) {
 		switch r.Method {
@@ -1361,98 +1922,68 @@ func main() {
 		slog.Info("Inhibition API endpoints NOT available (config not found or initialization failed)")
 	}
 
-	// TN-134/135: Initialize Silence Management System (Module 3)
+	// Register Time Interval API endpoints
+	if timeIntervalEvaluator != nil {
+		timeIntervalHandler := handlers.NewTimeIntervalHandler(timeIntervalEvaluator, appLogger)
+		mux.HandleFunc("GET /api/v2/time-intervals", timeIntervalHandler.ListIntervals)
+		mux.HandleFunc("GET /api/v2/time-intervals/{name}/active", timeIntervalHandler.CheckInterval)
+		slog.Info("✅ Time Interval API endpoints registered",
+			"endpoints", []string{
+				"GET /api/v2/time-intervals - List time intervals with current state",
+				"GET /api/v2/time-intervals/{name}/active - Check if interval is active at time",
+			})
+	}
+
+	// TN-135/136: Initialize Silence API & UI handlers (manager is created before AlertProcessor)
 	var silenceHandler *handlers.SilenceHandler
 	var silenceUIHandler *handlers.SilenceUIHandler // TN-136
 	var wsHub *handlers.WebSocketHub                // TN-136
-	if pool != nil && businessMetrics != nil {
-		slog.Info("Initializing Silence Management System (TN-134, TN-135)")
-
-		// TN-131: Silence repository with PostgreSQL
-		silenceRepo := infrasilencing.NewPostgresSilenceRepository(pool.Pool(), appLogger)
-		slog.Info("✅ Silence Repository initialized (PostgreSQL)")
-
-		// TN-132: Silence matcher engine with regex support
-		silenceMatcher := coresilencing.NewSilenceMatcher()
-		slog.Info("✅ Silence Matcher initialized (regex support, 4 operators)")
-
-		// TN-134: Silence manager service with lifecycle management
-		silenceManager := businesssilencing.NewDefaultSilenceManager(
-			silenceRepo,
-			silenceMatcher,
+	if silenceManager != nil {
+		// TN-135: Create Silence API Handler
+		silenceHandler = handlers.NewSilenceHandler(
+			silenceManager,
+			businessMetrics,
 			appLogger,
-			nil, // No custom config (uses defaults)
+			redisCache, // For ETag response caching
 		)
+		if alertStorage != nil {
+			silenceHandler.SetPreviewSources(alertStorage, historyRepo)
+		}
+		slog.Info("✅ Silence API Handler initialized (ready for 8 endpoints)")
 
-		// Start silence manager (initializes cache + background workers)
-		silenceCtx := context.Background()
-		if err := silenceManager.Start(silenceCtx); err != nil {
-			slog.Error("Failed to start silence manager", "error", err)
-		} else {
-			slog.Info("✅ Silence Manager started",
+		// TN-136: Create Silence UI Handler & WebSocket Hub
+		wsHub = handlers.NewWebSocketHub(appLogger)
+		go wsHub.Start(context.Background()) // Start WebSocket hub in background
+
+		// TN-78: Create Dashboard WebSocket Hub (extends existing wsHub for silence UI)
+		if realtimeEventBus != nil {
+			realtimeMetrics := realtime.NewRealtimeMetrics("alert_history")
+			dashboardWSHub = handlers.NewDashboardWebSocketHub(wsHub, realtimeEventBus, appLogger, realtimeMetrics)
+			slog.Info("✅ Dashboard WebSocket Hub initialized (TN-78)",
+				"endpoint", "GET /ws/dashboard",
 				"features", []string{
-					"In-memory cache (fast lookups <50ns)",
-					"Background GC worker (5m interval)",
-					"Background sync worker (1m interval)",
-					"8 Prometheus metrics",
+					"WebSocket support (extends existing hub)",
+					"Rate limiting (10 connections per IP)",
+					"EventBus integration",
+					"Ping/pong keep-alive",
 				})
+		}
 
-			// Graceful shutdown on exit
-			defer func() {
-				shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-				defer cancel()
-				if err := silenceManager.Stop(shutdownCtx); err != nil {
-					slog.Warn("Silence manager shutdown timeout", "error", err)
-				} else {
-					slog.Info("✅ Silence Manager stopped gracefully")
-				}
-			}()
-
-			// TN-135: Create Silence API Handler
-			silenceHandler = handlers.NewSilenceHandler(
-				silenceManager,
-				businessMetrics,
-				appLogger,
-				redisCache, // For ETag response caching
-			)
-			slog.Info("✅ Silence API Handler initialized (ready for 7 endpoints)")
-
-			// TN-136: Create Silence UI Handler & WebSocket Hub
-			wsHub = handlers.NewWebSocketHub(appLogger)
-			go wsHub.Start(context.Background()) // Start WebSocket hub in background
-
-			// TN-78: Create Dashboard WebSocket Hub (extends existing wsHub for silence UI)
-			if realtimeEventBus != nil {
-				realtimeMetrics := realtime.NewRealtimeMetrics("alert_history")
-				dashboardWSHub = handlers.NewDashboardWebSocketHub(wsHub, realtimeEventBus, appLogger, realtimeMetrics)
-				slog.Info("✅ Dashboard WebSocket Hub initialized (TN-78)",
-					"endpoint", "GET /ws/dashboard",
-					"features", []string{
-						"WebSocket support (extends existing hub)",
-						"Rate limiting (10 connections per IP)",
-						"EventBus integration",
-						"Ping/pong keep-alive",
-					})
-			}
-
-			var silenceUIErr error
-			silenceUIHandler, silenceUIErr = handlers.NewSilenceUIHandler(silenceManager, silenceHandler, wsHub, redisCache, appLogger)
-			if silenceUIErr != nil {
-				slog.Error("Failed to create Silence UI Handler", "error", silenceUIErr)
-				silenceUIHandler = nil // Set to nil so we skip route registration
-			} else {
-				slog.Info("✅ Silence UI Handler initialized (TN-136, 150% quality)",
-					"features", []string{
-						"8 HTML templates (dashboard, forms, detail, analytics)",
-						"WebSocket real-time updates",
-						"PWA support (offline-capable)",
-						"WCAG 2.1 AA compliant",
-						"Mobile-responsive design",
-					})
-			}
+		var silenceUIErr error
+		silenceUIHandler, silenceUIErr = handlers.NewSilenceUIHandler(silenceManager, silenceHandler, wsHub, redisCache, appLogger)
+		if silenceUIErr != nil {
+			slog.Error("Failed to create Silence UI Handler", "error", silenceUIErr)
+			silenceUIHandler = nil // Set to nil so we skip route registration
+		} else {
+			slog.Info("✅ Silence UI Handler initialized (TN-136, 150% quality)",
+				"features", []string{
+					"8 HTML templates (dashboard, forms, detail, analytics)",
+					"WebSocket real-time updates",
+					"PWA support (offline-capable)",
+					"WCAG 2.1 AA compliant",
+					"Mobile-responsive design",
+				})
 		}
-	} else {
-		slog.Warn("⚠️ Silence Management System NOT initialized (database or metrics not available)")
 	}
 
 	// TN-135: Register Silence API endpoints (Alertmanager compatible)
@@ -1474,6 +2005,7 @@ func main() {
 		})
 		mux.HandleFunc("POST /api/v2/silences/check", silenceHandler.CheckAlert)
 		mux.HandleFunc("POST /api/v2/silences/bulk/delete", silenceHandler.BulkDelete)
+		mux.HandleFunc("POST /api/v2/silences/preview", silenceHandler.PreviewSilence)
 
 		slog.Info("✅ Silence API endpoints registered (TN-135, 150% quality)",
 			"endpoints", []string{
@@ -1484,8 +2016,61 @@ func main() {
 				"DELETE /api/v2/silences/{id} - Delete silence",
 				"POST /api/v2/silences/check - Check if alert would be silenced (150%)",
 				"POST /api/v2/silences/bulk/delete - Bulk delete silences (150%)",
+				"POST /api/v2/silences/preview - Preview alerts matched by proposed matchers",
 			})
 
+		if recurringSilenceManager != nil {
+			recurringHandler := handlers.NewRecurringSilenceHandler(recurringSilenceManager, appLogger)
+			mux.HandleFunc("POST /api/v2/silences/recurring", recurringHandler.CreateRecurringSilence)
+			mux.HandleFunc("GET /api/v2/silences/recurring", recurringHandler.ListRecurringSilences)
+			mux.HandleFunc("GET /api/v2/silences/recurring/{id}", recurringHandler.GetRecurringSilence)
+			mux.HandleFunc("PUT /api/v2/silences/recurring/{id}", recurringHandler.UpdateRecurringSilence)
+			mux.HandleFunc("DELETE /api/v2/silences/recurring/{id}", recurringHandler.CancelRecurringSilence)
+			slog.Info("✅ Recurring Silence API endpoints registered",
+				"endpoints", []string{
+					"POST /api/v2/silences/recurring - Create recurring silence (cron or RRULE)",
+					"GET /api/v2/silences/recurring - List recurring silences",
+					"GET /api/v2/silences/recurring/{id} - Get recurring silence with occurrences",
+					"PUT /api/v2/silences/recurring/{id} - Edit recurring silence",
+					"DELETE /api/v2/silences/recurring/{id} - Cancel recurring silence series",
+				})
+		}
+
+		// Alert acknowledgement workflow API (alerts by fingerprint, groups by URL-escaped group key)
+		if ackService != nil {
+			ackHandler := handlers.NewAlertAckHandler(ackService, appLogger)
+			for _, prefix := range []string{"/api/v2/alerts/{fingerprint}", "/api/v2/alert-groups/{groupKey}"} {
+				mux.HandleFunc("GET "+prefix+"/ack", ackHandler.GetAck)
+				mux.HandleFunc("POST "+prefix+"/ack", ackHandler.Ack)
+				mux.HandleFunc("DELETE "+prefix+"/ack", ackHandler.Unack)
+				mux.HandleFunc("POST "+prefix+"/assign", ackHandler.Assign)
+				mux.HandleFunc("POST "+prefix+"/comments", ackHandler.Comment)
+			}
+			mux.HandleFunc("GET /api/v2/acks", ackHandler.ListActiveAcks)
+			slog.Info("✅ Alert Acknowledgement API endpoints registered",
+				"endpoints", []string{
+					"GET /api/v2/alerts/{fingerprint}/ack - Acknowledgement state and audit trail",
+					"POST /api/v2/alerts/{fingerprint}/ack - Acknowledge (optional expiry)",
+					"DELETE /api/v2/alerts/{fingerprint}/ack - Remove acknowledgement",
+					"POST /api/v2/alerts/{fingerprint}/assign - Assign owner",
+					"POST /api/v2/alerts/{fingerprint}/comments - Add comment",
+					"/api/v2/alert-groups/{groupKey}/... - Same for notification groups",
+					"GET /api/v2/acks - Active acknowledgements",
+				})
+		}
+
+		// Alert escalation status API
+		if escalationManager != nil {
+			escalationHandler := handlers.NewAlertEscalationHandler(escalationManager, appLogger)
+			mux.HandleFunc("GET /api/v2/alerts/{fingerprint}/escalation", escalationHandler.GetEscalation)
+			mux.HandleFunc("GET /api/v2/escalations", escalationHandler.ListEscalations)
+			slog.Info("✅ Alert Escalation API endpoints registered",
+				"endpoints", []string{
+					"GET /api/v2/alerts/{fingerprint}/escalation - Escalation state and levels",
+					"GET /api/v2/escalations - Active escalations",
+				})
+		}
+
 		// TN-77: Register Modern Dashboard endpoint (if handler initialized)
 		if dashboardHandler != nil {
 			mux.HandleFunc("GET /dashboard", dashboardHandler.ServeHTTP)
@@ -1517,6 +2102,9 @@ func main() {
 				redisCache,                        // optional, for response caching
 				appLogger,
 			)
+			if ackService != nil {
+				dashboardAlertsHandler.SetAckService(ackService)
+			}
 			slog.Info("✅ Dashboard Alerts Handler initialized (TN-84, 150% quality target)",
 				"features", []string{
 					"GET /api/dashboard/alerts/recent - Compact format for dashboard",
@@ -1547,6 +2135,15 @@ func main() {
 			slog.Warn("⚠️ Dashboard Alerts API endpoint NOT registered (handler not initialized)")
 		}
 
+		// Alert groups with incident summaries (requires grouping)
+		if groupManager != nil {
+			dashboardGroupsHandler := handlers.NewDashboardGroupsHandler(groupManager, appLogger)
+			mux.HandleFunc("GET /api/dashboard/groups", dashboardGroupsHandler.GetGroups)
+			slog.Info("✅ Dashboard Groups API endpoint registered",
+				"endpoint", "GET /api/dashboard/groups",
+				"description", "Alert groups with incident summaries")
+		}
+
 
 		// TN-79: Register Alert List UI endpoint (if handler initialized)
 		if alertListUIHandler != nil {
@@ -1598,6 +2195,7 @@ func main() {
 					"Rate limiting (10 connections/IP)",
 					"Ping/pong keep-alive",
 					"EventBus integration",
+					"last_event_id resume and subscription filters",
 				})
 		}
 
@@ -1640,7 +2238,7 @@ func main() {
 	// TN-046/047/048: Initialize Publishing System (Target Discovery + Refresh)
 	// This system discovers publishing targets from K8s Secrets and keeps them up-to-date
 	var refreshManager publishing.RefreshManager
-	_ = refreshManager // Suppress unused variable warning (used when K8s code uncommented)
+	var targetHealthMonitor publishing.HealthMonitor
 	if businessMetrics != nil {
 		slog.Info("Initializing Publishing System (TN-046, TN-047, TN-048)")
 
@@ -1780,8 +2378,58 @@ func main() {
 		// 		}
 		// 	}
 		// }
-		slog.Info("⚠️ Publishing System initialization skipped (K8s client disabled for now)")
-		slog.Info("To enable: uncomment TN-046/047/048 section in main.go and ensure K8s access")
+		if k8sTargetDiscovery != nil {
+			// TN-048: Refresh Manager (periodic full re-discovery, manual refresh API)
+			refreshConfig := publishing.DefaultRefreshConfig()
+			if d, err := time.ParseDuration(os.Getenv("TARGET_REFRESH_INTERVAL")); err == nil && d > 0 {
+				refreshConfig.Interval = d
+			}
+			if manager, err := publishing.NewRefreshManager(k8sTargetDiscovery, refreshConfig, appLogger, prometheus.DefaultRegisterer); err != nil {
+				slog.Error("Failed to create refresh manager", "error", err)
+			} else if err := manager.Start(); err != nil {
+				slog.Error("Failed to start refresh manager", "error", err)
+			} else {
+				refreshManager = manager
+				slog.Info("✅ Refresh Manager started (TN-048)", "interval", refreshConfig.Interval)
+				defer func() {
+					if err := manager.Stop(30 * time.Second); err != nil {
+						slog.Warn("Refresh manager shutdown timeout", "error", err)
+					} else {
+						slog.Info("✅ Refresh Manager stopped gracefully")
+					}
+				}()
+			}
+
+			// TN-049: Health Monitor (periodic target health checks)
+			healthConfig := publishing.DefaultHealthConfig()
+			if d, err := time.ParseDuration(os.Getenv("TARGET_HEALTH_CHECK_INTERVAL")); err == nil && d > 0 {
+				healthConfig.CheckInterval = d
+			}
+			if d, err := time.ParseDuration(os.Getenv("TARGET_HEALTH_CHECK_TIMEOUT")); err == nil && d > 0 {
+				healthConfig.HTTPTimeout = d
+			}
+			if healthMetrics, err := publishing.NewHealthMetrics(); err != nil {
+				slog.Error("Failed to create health metrics", "error", err)
+			} else if monitor, err := publishing.NewHealthMonitor(k8sTargetDiscovery, healthConfig, appLogger, healthMetrics); err != nil {
+				slog.Error("Failed to create health monitor", "error", err)
+			} else if err := monitor.Start(); err != nil {
+				slog.Error("Failed to start health monitor", "error", err)
+			} else {
+				targetHealthMonitor = monitor
+				slog.Info("✅ Health Monitor started (TN-049)",
+					"check_interval", healthConfig.CheckInterval,
+					"http_timeout", healthConfig.HTTPTimeout)
+				defer func() {
+					if err := monitor.Stop(10 * time.Second); err != nil {
+						slog.Warn("Health monitor shutdown timeout", "error", err)
+					} else {
+						slog.Info("✅ Health Monitor stopped gracefully")
+					}
+				}()
+			}
+		} else {
+			slog.Info("⚠️ Target refresh and health monitoring skipped (K8s target discovery not available)")
+		}
 	} else {
 		slog.Warn("⚠️ Publishing System NOT initialized (metrics not available)")
 	}
@@ -1789,17 +2437,15 @@ func main() {
 	// TN-056: Initialize Publishing Queue with Retry (150%+ quality)
 	// This queue manages async publishing with priority queues, retry logic, DLQ, and job tracking
 	var publishingQueue *infrapublishing.PublishingQueue
+	var publishingMetricsCollector *publishing.PublishingMetricsCollector
+	var publishingTrendDetector *publishing.TrendDetector
+	var publishingCoordinator *infrapublishing.PublishingCoordinator
+	var parallelPublisher infrapublishing.ParallelPublisher
 	if pool != nil && businessMetrics != nil {
 		slog.Info("Initializing Publishing Queue (TN-056, Phase 5: Integration)")
 
-		// Step 1: Create Alert Formatter (TN-051)
-		formatter := infrapublishing.NewAlertFormatter()
-		slog.Info("✅ Alert Formatter created (TN-051, 5 formats)")
-
-		// Step 2: Create Publisher Factory
-		publisherFactory := infrapublishing.NewPublisherFactory(formatter, appLogger)
-		slog.Info("✅ Publisher Factory created",
-			"publishers", []string{"Rootly", "PagerDuty", "Slack", "Webhook"})
+		// Step 1-2: Alert Formatter (TN-051) and Publisher Factory are created
+		// with the acknowledgement service (shared PagerDuty/Rootly caches)
 
 		// Step 3: Create Publishing Metrics (needs prometheus.Registerer)
 		publishingMetrics := infrapublishing.NewPublishingMetrics(nil) // nil uses default registry
@@ -1821,7 +2467,25 @@ func main() {
 				queueConfig.WorkerCount = int(count)
 			}
 		}
+		// Durable queue backend: PUBLISHING_QUEUE_BACKEND=postgres keeps jobs in the
+		// publishing_queue table (leases + visibility timeout, at-least-once delivery)
+		queueBackend := "memory"
+		if os.Getenv("PUBLISHING_QUEUE_BACKEND") == "postgres" {
+			backendConfig := infrapublishing.DefaultPostgresQueueBackendConfig()
+			if timeout, err := time.ParseDuration(os.Getenv("PUBLISHING_QUEUE_VISIBILITY_TIMEOUT")); err == nil && timeout > 0 {
+				backendConfig.VisibilityTimeout = timeout
+			}
+			if maxSize, err := strconv.Atoi(os.Getenv("PUBLISHING_QUEUE_MAX_SIZE")); err == nil && maxSize > 0 {
+				backendConfig.MaxSize = maxSize
+			}
+			queueConfig.Backend = infrapublishing.NewPostgresQueueBackend(pool.Pool(), backendConfig, appLogger)
+			queueBackend = "postgres"
+			slog.Info("✅ Durable Publishing Queue backend created (PostgreSQL, SKIP LOCKED leasing)",
+				"visibility_timeout", backendConfig.VisibilityTimeout,
+				"max_size", backendConfig.MaxSize)
+		}
 		slog.Info("Publishing Queue configuration",
+			"backend", queueBackend,
 			"worker_count", queueConfig.WorkerCount,
 			"high_queue_size", queueConfig.HighPriorityQueueSize,
 			"medium_queue_size", queueConfig.MediumPriorityQueueSize,
@@ -1846,6 +2510,9 @@ func main() {
 		dlqRepo.SetQueue(publishingQueue)
 		slog.Info("✅ DLQ Repository linked to Publishing Queue (Replay functionality enabled)")
 
+		// Step 7.2: Group notifications are delivered through the queue (retries, DLQ)
+		publisher.SetQueue(publishingQueue)
+
 		// Step 8: Start Publishing Queue
 		publishingQueue.Start()
 		slog.Info("✅ Publishing Queue started (TN-056)",
@@ -1919,6 +2586,7 @@ func main() {
 
 		// Step 4: Create HTTP API handler
 		statsHandler := handlers.NewPublishingStatsHandler(metricsCollector, trendDetector, appLogger)
+		publishingMetricsCollector, publishingTrendDetector = metricsCollector, trendDetector
 		slog.Info("✅ Publishing Stats Handler created (5 REST endpoints)")
 
 		// Step 5: Register HTTP API endpoints
@@ -2113,8 +2781,34 @@ func main() {
 			// TODO Phase 9.2: Update constructors to pass modeManager
 			// - publishingQueue (already created, need to recreate or add setter)
 			// - publishingHandlers (create after queue)
-			// - publishingCoordinator (if exists)
-			// - parallelPublisher (if exists)
+
+			// TN-058/070: Coordinator (target test endpoint) and parallel publisher
+			// for the publishing management API
+			publishingCoordinator = infrapublishing.NewPublishingCoordinator(
+				publishingQueue,
+				targetDiscovery,
+				modeManager,
+				infrapublishing.DefaultCoordinatorConfig(),
+				appLogger,
+			)
+			var parallelHealth infrapublishing.HealthMonitor
+			if targetHealthMonitor != nil {
+				parallelHealth = parallelHealthMonitor{monitor: targetHealthMonitor}
+			}
+			if pp, err := infrapublishing.NewDefaultParallelPublisher(
+				publisherFactory,
+				parallelHealth,
+				targetDiscovery,
+				modeManager,
+				infrapublishing.NewParallelPublishMetrics(prometheus.DefaultRegisterer),
+				appLogger,
+				infrapublishing.DefaultParallelPublishOptions(),
+			); err != nil {
+				slog.Error("Failed to create parallel publisher", "error", err)
+			} else {
+				parallelPublisher = pp
+				slog.Info("✅ Parallel Publisher created (TN-058)", "health_aware", parallelHealth != nil)
+			}
 
 			// TN-68: ModeService will be created and endpoints registered after mux is created
 			// (see registration code below, after mux initialization)
@@ -2131,6 +2825,7 @@ func main() {
 	// TN-68: Register publishing mode endpoints (API v1 & v2)
 	// Create ModeService and handler for endpoint registration
 	// Note: We create new instances here since modeManager/stubDiscoveryMgr from above are in a different scope
+	var publishingModeService apiservices.ModeService
 	if publishingQueue != nil {
 		stubDiscoveryMgrForMode := infrapublishing.NewStubTargetDiscoveryManager(appLogger)
 		modeMetricsForMode := infrapublishing.NewPublishingModeMetrics("alert_history", "publishing")
@@ -2138,6 +2833,7 @@ func main() {
 
 		modeService := apiservices.NewModeService(modeManagerForMode, stubDiscoveryMgrForMode, appLogger)
 		modeHandler := handlers.NewPublishingModeHandler(modeService, appLogger)
+		publishingModeService = modeService
 
 		// Register API v1 endpoint (backward compatibility)
 		mux.HandleFunc("GET /api/v1/publishing/mode", modeHandler.GetPublishingMode)
@@ -2158,6 +2854,28 @@ func main() {
 			})
 	}
 
+	// Publishing management API (RBAC: PUBLISHING_API_KEYS="key:role,...",
+	// roles viewer/operator/admin; protected routes reject requests without a key)
+	publishingRouterConfig := api.DefaultRouterConfig(appLogger)
+	if apiKeys, err := parsePublishingAPIKeys(os.Getenv("PUBLISHING_API_KEYS")); err != nil {
+		slog.Error("Invalid PUBLISHING_API_KEYS, protected publishing endpoints disabled", "error", err)
+	} else {
+		publishingRouterConfig.AuthConfig.APIKeys = apiKeys
+	}
+	if len(publishingRouterConfig.AuthConfig.APIKeys) == 0 {
+		slog.Warn("⚠️ No publishing API keys configured (PUBLISHING_API_KEYS), protected publishing endpoints reject all requests")
+	}
+	publishingRouterConfig.TargetDiscoveryManager = k8sTargetDiscovery
+	publishingRouterConfig.PublishingQueue = publishingQueue
+	publishingRouterConfig.MetricsCollector = publishingMetricsCollector
+	publishingRouterConfig.TrendDetector = publishingTrendDetector
+	publishingRouterConfig.ModeService = publishingModeService
+	publishingRouterConfig.RefreshManager = refreshManager
+	publishingRouterConfig.HealthMonitor = targetHealthMonitor
+	publishingRouterConfig.PublishingCoordinator = publishingCoordinator
+	publishingRouterConfig.ParallelPublisher = parallelPublisher
+	setupPublishingAPI(mux, publishingRouterConfig, appLogger)
+
 	// TN-149: Initialize Config Service and register config export endpoint
 	slog.Info("Initializing Config Service (TN-149)")
 	configSource := appconfig.ConfigSourceDefaults
@@ -2192,12 +2910,13 @@ func main() {
 	// Initialize reloader
 	configReloader := appconfig.NewConfigReloader(appLogger)
 
-	// Register reloadable components (placeholder - will be populated as components implement Reloadable interface)
-	// TODO: Register actual components when they implement Reloadable interface
+	// Register reloadable components
+	// TODO: Register remaining components when they implement Reloadable interface
 	// Examples:
 	// - configReloader.Register(databaseComponent)
 	// - configReloader.Register(redisComponent)
 	// - configReloader.Register(llmComponent)
+	configReloader.Register(fallbackRulesReloader{engine: fallbackEngine}) // llm.fallback_rules
 
 	// Initialize storage (PostgreSQL-based)
 	var configStorage appconfig.ConfigStorage
@@ -2436,6 +3155,16 @@ func main() {
 	)
 	slog.Info("✅ Template Manager initialized (CRUD + Version Control + Caching)")
 
+	// Publishing targets referencing a managed template (template, template_version)
+	// render their payload title/text/fields/details from it
+	publisherFactory.SetTemplateRenderer(infrapublishing.NewTemplateRenderer(
+		templateManager,
+		notificationEngine,
+		infrapublishing.NewTemplateRenderMetrics(prometheus.DefaultRegisterer),
+		appLogger,
+	))
+	slog.Info("✅ Managed templates enabled for publishing targets")
+
 	// Step 6: Initialize Template Handler (HTTP layer)
 	templateHandler := handlers.NewTemplateHandler(templateManager, appLogger)
 
@@ -2748,3 +3477,45 @@ func handleGracefulShutdown(
 	logger.Info("server exited")
 	os.Exit(0)
 }
+
+// newNotificationLog selects the notification log backend for the dispatcher.
+//
+// Priority: PostgreSQL (shared by replicas) → SQLite (Lite profile, same file
+// as alert storage) → Redis (shared by replicas) → in-memory.
+func newNotificationLog(
+	ctx context.Context,
+	pool *postgres.PostgresPool,
+	alertStorage core.AlertStorage,
+	redisCache cache.Cache,
+	logger *slog.Logger,
+) nflog.Log {
+	var notificationLog nflog.Log
+	var backend string
+
+	if pool != nil && pool.Pool() != nil {
+		if pgLog, err := nflog.NewPostgresLog(pool.Pool(), logger); err == nil {
+			notificationLog, backend = pgLog, "postgres"
+		}
+	} else if sqliteStorage, ok := alertStorage.(*sqlite.SQLiteStorage); ok {
+		if sqliteLog, err := nflog.NewSQLiteLog(ctx, sqliteStorage.DB(), logger); err != nil {
+			logger.Warn("Failed to initialize SQLite notification log", "error", err)
+		} else {
+			notificationLog, backend = sqliteLog, "sqlite"
+		}
+	}
+
+	if notificationLog == nil && redisCache != nil {
+		if redisLog, err := nflog.NewRedisLog(redisCache, logger); err == nil {
+			notificationLog, backend = redisLog, "redis"
+		}
+	}
+
+	if notificationLog == nil {
+		notificationLog, backend = nflog.NewMemoryLog(), "memory"
+	}
+
+	go nflog.RunGC(ctx, notificationLog, 15*time.Minute, logger)
+
+	logger.Info("✅ Notification log initialized", "backend", backend)
+	return notificationLog
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/vitaliisemenov/alert-history/internal/api"
+	"github.com/vitaliisemenov/alert-history/internal/api/middleware"
+	"github.com/vitaliisemenov/alert-history/internal/business/publishing"
+	infrapublishing "github.com/vitaliisemenov/alert-history/internal/infrastructure/publishing"
+)
+
+// publishingAPIPrefix is the path prefix served by the publishing management API.
+const publishingAPIPrefix = "/api/v2/publishing/"
+
+// setupPublishingAPI mounts the publishing management API (api.NewRouter:
+// targets, target health, queue, jobs, DLQ, parallel publishing, metrics)
+// on the server mux under /api/v2/publishing/.
+//
+// Routes registered on the mux for a specific method and path (e.g.
+// GET /api/v2/publishing/stats) are more specific and keep precedence.
+func setupPublishingAPI(mux *http.ServeMux, config api.RouterConfig, logger *slog.Logger) {
+	mux.Handle(publishingAPIPrefix, api.NewRouter(config))
+
+	logger.Info("✅ Publishing management API registered",
+		"prefix", publishingAPIPrefix,
+		"auth", config.EnableAuth,
+		"api_keys", len(config.AuthConfig.APIKeys),
+		"endpoints", []string{
+			"GET /api/v2/publishing/targets[/{name}] - Targets (public)",
+			"GET /api/v2/publishing/targets/health[/stats|/{name}] - Target health (public)",
+			"POST /api/v2/publishing/targets/{name}/test - Test target (operator+)",
+			"POST /api/v2/publishing/targets/refresh - Refresh targets (admin)",
+			"GET /api/v2/publishing/queue/status|stats - Queue status (public)",
+			"GET /api/v2/publishing/queue/jobs[/{id}] - Jobs (viewer+)",
+			"POST /api/v2/publishing/queue/submit - Submit alert (operator+)",
+			"GET /api/v2/publishing/dlq - DLQ entries (operator+)",
+			"POST /api/v2/publishing/dlq/{id}/replay, DELETE /api/v2/publishing/dlq/purge - DLQ (admin)",
+			"POST /api/v2/publishing/parallel/targets|all|healthy - Parallel publish (operator+)",
+		})
+}
+
+// parsePublishingAPIKeys parses API keys with their roles from
+// "key:role[,key:role...]" (roles: viewer, operator, admin).
+func parsePublishingAPIKeys(spec string) (map[string]*middleware.User, error) {
+	keys := make(map[string]*middleware.User)
+	for i, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		key, role, ok := strings.Cut(entry, ":")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("API key %d: expected key:role", i)
+		}
+		switch role {
+		case middleware.RoleViewer, middleware.RoleOperator, middleware.RoleAdmin:
+		default:
+			return nil, fmt.Errorf("API key %d: unknown role %q", i, role)
+		}
+
+		keys[key] = &middleware.User{
+			ID:       fmt.Sprintf("api-key-%d", i),
+			Username: fmt.Sprintf("%s-%d", role, i),
+			Role:     role,
+			APIKey:   key,
+		}
+	}
+	return keys, nil
+}
+
+// parallelHealthMonitor adapts the target health monitor (TN-049) to the
+// parallel publisher's health check interface.
+type parallelHealthMonitor struct {
+	monitor publishing.HealthMonitor
+}
+
+var _ infrapublishing.HealthMonitor = parallelHealthMonitor{}
+
+// GetHealthByName implements infrapublishing.HealthMonitor.
+func (m parallelHealthMonitor) GetHealthByName(ctx context.Context, targetName string) (infrapublishing.TargetHealth, error) {
+	health, err := m.monitor.GetHealthByName(ctx, targetName)
+	if err != nil {
+		return nil, err
+	}
+	return health, nil
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vitaliisemenov/alert-history/internal/api"
+	"github.com/vitaliisemenov/alert-history/internal/api/middleware"
+)
+
+func TestSetupPublishingAPI_ServerMux(t *testing.T) {
+	keys, err := parsePublishingAPIKeys("operator-key:operator, admin-key:admin")
+	require.NoError(t, err)
+
+	config := api.DefaultRouterConfig(slog.Default())
+	config.EnableRateLimit = false
+	config.EnableMetrics = false
+	config.AuthConfig.APIKeys = keys
+
+	mux := http.NewServeMux()
+	// Registered directly on the server mux (like the TN-057 stats endpoints)
+	mux.HandleFunc("GET /api/v2/publishing/stats", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+	setupPublishingAPI(mux, config, slog.Default())
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		apiKey string
+		status int
+	}{
+		{"public route without backend", http.MethodGet, "/api/v2/publishing/targets", "", http.StatusServiceUnavailable},
+		{"admin route without key", http.MethodDelete, "/api/v2/publishing/dlq/purge", "", http.StatusUnauthorized},
+		{"admin route with operator key", http.MethodDelete, "/api/v2/publishing/dlq/purge", "operator-key", http.StatusForbidden},
+		{"admin route with admin key", http.MethodDelete, "/api/v2/publishing/dlq/purge", "admin-key", http.StatusServiceUnavailable},
+		{"operator route with operator key", http.MethodGet, "/api/v2/publishing/dlq", "operator-key", http.StatusServiceUnavailable},
+		{"server mux route keeps precedence", http.MethodGet, "/api/v2/publishing/stats", "", http.StatusTeapot},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.apiKey != "" {
+				req.Header.Set("Authorization", "ApiKey "+tt.apiKey)
+			}
+			rec := httptest.NewRecorder()
+
+			mux.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.status, rec.Code, rec.Body.String())
+		})
+	}
+}
+
+func TestParsePublishingAPIKeys(t *testing.T) {
+	keys, err := parsePublishingAPIKeys("")
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	keys, err = parsePublishingAPIKeys("k1:viewer,k2:admin")
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	assert.Equal(t, middleware.RoleViewer, keys["k1"].Role)
+	assert.Equal(t, middleware.RoleAdmin, keys["k2"].Role)
+
+	_, err = parsePublishingAPIKeys("k1")
+	assert.Error(t, err)
+
+	_, err = parsePublishingAPIKeys("k1:root")
+	assert.Error(t, err)
+}
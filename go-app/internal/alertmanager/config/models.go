@@ -400,6 +400,7 @@ type TimeInterval struct {
 	DaysOfMonth []string    `yaml:"days_of_month,omitempty" json:"days_of_month,omitempty"`
 	Months      []string    `yaml:"months,omitempty" json:"months,omitempty"`
 	Years       []string    `yaml:"years,omitempty" json:"years,omitempty"`
+	Location    string      `yaml:"location,omitempty" json:"location,omitempty"`
 }
 
 // TimeRange represents a time range (HH:MM - HH:MM).
@@ -0,0 +1,110 @@
+package classification
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"time"
+
+	apierrors "github.com/vitaliisemenov/alert-history/internal/api/errors"
+	"github.com/vitaliisemenov/alert-history/internal/api/middleware"
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/core/services"
+)
+
+// FallbackDryRunner evaluates fallback rules against an alert without side effects.
+// Implemented by services.RuleBasedFallback.
+type FallbackDryRunner interface {
+	DryRun(alert *core.Alert) *services.FallbackDryRunResult
+}
+
+// FallbackHandlers provides HTTP handlers for rule-based fallback classification
+type FallbackHandlers struct {
+	fallback FallbackDryRunner
+	logger   *slog.Logger
+}
+
+// NewFallbackHandlers creates new fallback classification handlers
+func NewFallbackHandlers(fallback FallbackDryRunner, logger *slog.Logger) *FallbackHandlers {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &FallbackHandlers{
+		fallback: fallback,
+		logger:   logger,
+	}
+}
+
+// FallbackDryRunRequest represents a fallback dry-run request
+type FallbackDryRunRequest struct {
+	Alert *core.Alert `json:"alert" validate:"required"`
+}
+
+// FallbackDryRunResponse represents a fallback dry-run response
+type FallbackDryRunResponse struct {
+	*services.FallbackDryRunResult
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// DryRun handles POST /api/v2/classification/fallback/dry-run
+//
+// @Summary Dry-run fallback classification rules
+// @Description Shows which fallback rule matches an alert and the resulting
+// classification. Does not call the LLM, cache, or store anything.
+// @Tags Classification
+// @Accept json
+// @Produce json
+// @Param request body FallbackDryRunRequest true "Dry-run request"
+// @Success 200 {object} FallbackDryRunResponse
+// @Failure 400 {object} apierrors.ErrorResponse
+// @Failure 503 {object} apierrors.ErrorResponse
+// @Router /classification/fallback/dry-run [post]
+func (h *FallbackHandlers) DryRun(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+
+	if h.fallback == nil {
+		apierrors.WriteError(w, apierrors.ServiceUnavailableError("Fallback classification").
+			WithRequestID(requestID))
+		return
+	}
+
+	var req FallbackDryRunRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apierrors.WriteError(w, apierrors.ValidationError("Invalid request body: "+err.Error()).
+			WithRequestID(requestID))
+		return
+	}
+
+	if req.Alert == nil {
+		apierrors.WriteError(w, apierrors.ValidationError("Alert is required").
+			WithRequestID(requestID))
+		return
+	}
+	if req.Alert.AlertName == "" && req.Alert.Labels["alertname"] == "" {
+		apierrors.WriteError(w, apierrors.ValidationError("Invalid alert: alert_name is required").
+			WithRequestID(requestID))
+		return
+	}
+	if req.Alert.Status == "" {
+		req.Alert.Status = core.StatusFiring
+	}
+
+	result := h.fallback.DryRun(req.Alert)
+
+	h.logger.Debug("Fallback dry-run complete",
+		"request_id", requestID,
+		"alert_name", req.Alert.AlertName,
+		"matched", result.Matched,
+		"rule", result.Rule)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set(middleware.APIVersionHeader, "2.0.0")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(FallbackDryRunResponse{
+		FallbackDryRunResult: result,
+		Timestamp:            time.Now(),
+	}); err != nil {
+		h.logger.Error("Failed to encode JSON response", "error", err)
+	}
+}
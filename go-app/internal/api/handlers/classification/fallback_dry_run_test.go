@@ -0,0 +1,90 @@
+package classification
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/vitaliisemenov/alert-history/internal/core/services"
+)
+
+func TestFallbackHandlers_DryRun(t *testing.T) {
+	handlers := NewFallbackHandlers(services.NewRuleBasedFallback(nil), nil)
+
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantRule   string
+	}{
+		{
+			name:       "matched",
+			body:       `{"alert": {"alert_name": "PostgresDown", "status": "firing", "labels": {"alertname": "PostgresDown"}}}`,
+			wantStatus: http.StatusOK,
+			wantRule:   "Database Connection Issues",
+		},
+		{
+			name:       "not_matched",
+			body:       `{"alert": {"alert_name": "SomethingOdd", "labels": {"team": "core"}}}`,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "missing_alert",
+			body:       `{}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "missing_alertname",
+			body:       `{"alert": {"labels": {"team": "core"}}}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid_json",
+			body:       `{`,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v2/classification/fallback/dry-run", strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+
+			handlers.DryRun(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body: %s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			var resp FallbackDryRunResponse
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if resp.Rule != tt.wantRule {
+				t.Errorf("rule = %q, want %q", resp.Rule, tt.wantRule)
+			}
+			if resp.Matched != (tt.wantRule != "") {
+				t.Errorf("matched = %v", resp.Matched)
+			}
+			if resp.Classification == nil {
+				t.Error("classification is missing")
+			}
+		})
+	}
+}
+
+func TestFallbackHandlers_DryRun_Unavailable(t *testing.T) {
+	handlers := NewFallbackHandlers(nil, nil)
+
+	rec := httptest.NewRecorder()
+	handlers.DryRun(rec, httptest.NewRequest(http.MethodPost, "/api/v2/classification/fallback/dry-run", strings.NewReader(`{}`)))
+
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusServiceUnavailable)
+	}
+}
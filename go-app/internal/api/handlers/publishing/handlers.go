@@ -3,7 +3,9 @@ package publishing
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"sort"
@@ -238,6 +240,7 @@ type JobStatusResponse struct {
 type JobListResponse struct {
 	Jobs       []JobStatusResponse `json:"jobs"`
 	TotalCount int                 `json:"total_count"`
+	Pagination PaginationMetadata  `json:"pagination"`
 }
 
 // DLQEntryResponse represents a DLQ entry in API
@@ -260,6 +263,7 @@ type DLQEntryResponse struct {
 type DLQListResponse struct {
 	Entries    []DLQEntryResponse `json:"entries"`
 	TotalCount int                `json:"total_count"`
+	Pagination PaginationMetadata `json:"pagination"`
 	Stats      *DLQStatsResponse  `json:"stats,omitempty"`
 }
 
@@ -332,7 +336,7 @@ type ResponseMetadata struct {
 // @Description Returns a paginated list of all configured publishing targets with filtering and sorting support
 // @Tags Targets
 // @Produce json
-// @Param type query string false "Filter by target type (rootly, pagerduty, slack, webhook)"
+// @Param type query string false "Filter by target type (rootly, pagerduty, slack, opsgenie, email, msteams, telegram, webhook)"
 // @Param enabled query bool false "Filter by enabled status"
 // @Param limit query int false "Maximum results per page (1-1000, default: 100)"
 // @Param offset query int false "Offset for pagination (>=0, default: 0)"
@@ -650,18 +654,17 @@ func (h *PublishingHandlers) TestTarget(w http.ResponseWriter, r *http.Request)
 
 // GetQueueStatus handles GET /api/v2/publishing/queue/status
 func (h *PublishingHandlers) GetQueueStatus(w http.ResponseWriter, r *http.Request) {
-	queueSize := h.queue.GetQueueSize()
-	queueCapacity := h.queue.GetQueueCapacity()
+	stats := h.queue.GetStats()
 	utilization := 0.0
-	if queueCapacity > 0 {
-		utilization = float64(queueSize) / float64(queueCapacity) * 100
+	if stats.Capacity > 0 {
+		utilization = float64(stats.TotalSize) / float64(stats.Capacity) * 100
 	}
 
 	response := QueueStatusResponse{
-		Size:         queueSize,
-		Capacity:     queueCapacity,
+		Size:         stats.TotalSize,
+		Capacity:     stats.Capacity,
 		Utilization:  utilization,
-		WorkersCount: 10,
+		WorkersCount: stats.WorkerCount,
 	}
 
 	h.sendJSON(w, http.StatusOK, response)
@@ -676,9 +679,14 @@ func (h *PublishingHandlers) GetDetailedQueueStats(w http.ResponseWriter, r *htt
 		successRate = float64(stats.TotalCompleted) / float64(stats.TotalSubmitted) * 100
 	}
 
-	// DLQ stats - skip for now as dlqRepository is private
 	dlqSize := 0
-	// TODO: Add public method to queue for DLQ stats
+	if dlq := h.queue.DLQ(); dlq != nil {
+		if dlqStats, err := dlq.GetStats(r.Context()); err == nil {
+			dlqSize = dlqStats.TotalEntries
+		} else {
+			h.logger.Warn("Failed to get DLQ stats", "error", err)
+		}
+	}
 
 	response := DetailedQueueStatsResponse{
 		TotalSize:      stats.TotalSize,
@@ -772,100 +780,322 @@ func (h *PublishingHandlers) SubmitAlert(w http.ResponseWriter, r *http.Request)
 }
 
 // ListJobs handles GET /api/v2/publishing/queue/jobs
+//
+// @Summary List tracked publishing jobs
+// @Description Returns recently tracked jobs (most recent first) with filtering and pagination
+// @Tags Queue
+// @Security ApiKeyAuth
+// @Produce json
+// @Param state query string false "Filter by state (queued, processing, retrying, succeeded, failed, dlq)"
+// @Param priority query string false "Filter by priority (high, medium, low)"
+// @Param target query string false "Filter by target name"
+// @Param limit query int false "Maximum results per page (1-1000, default: 100)"
+// @Param offset query int false "Offset for pagination (>=0, default: 0)"
+// @Success 200 {object} JobListResponse
+// @Failure 400 {object} apierrors.ErrorResponse
+// @Router /publishing/queue/jobs [get]
 func (h *PublishingHandlers) ListJobs(w http.ResponseWriter, r *http.Request) {
-	// TODO: Add public method to queue for listing jobs
-	// For now, return empty list
-	_ = r.URL.Query() // Suppress unused warning
-	responses := make([]JobStatusResponse, 0)
+	requestID := middleware.GetRequestID(r.Context())
+	query := r.URL.Query()
+
+	limit, offset, err := parsePagination(r)
+	if err != nil {
+		apierrors.WriteError(w, apierrors.ValidationError("Invalid query parameters").
+			WithDetails(err.Error()).
+			WithRequestID(requestID))
+		return
+	}
+
+	filters := infrapub.JobFilters{
+		State:      strings.ToLower(query.Get("state")),
+		Priority:   strings.ToLower(query.Get("priority")),
+		TargetName: query.Get("target"),
+	}
+	if filters.State != "" && !validJobStates[filters.State] {
+		apierrors.WriteError(w, apierrors.ValidationError("Invalid query parameters").
+			WithDetails("invalid state: must be one of queued, processing, retrying, succeeded, failed, dlq").
+			WithRequestID(requestID))
+		return
+	}
+	if filters.Priority != "" && !validPriorities[filters.Priority] {
+		apierrors.WriteError(w, apierrors.ValidationError("Invalid query parameters").
+			WithDetails("invalid priority: must be one of high, medium, low").
+			WithRequestID(requestID))
+		return
+	}
+
+	// Tracking store is bounded (LRU), so filter the whole store and paginate here
+	filters.Limit = h.queue.TrackedJobCount()
+	jobs := h.queue.ListJobs(filters)
+
+	total := len(jobs)
+	page := paginate(jobs, offset, limit)
+
+	responses := make([]JobStatusResponse, 0, len(page))
+	for _, job := range page {
+		responses = append(responses, jobSnapshotToResponse(job))
+	}
 
 	h.sendJSON(w, http.StatusOK, JobListResponse{
 		Jobs:       responses,
-		TotalCount: len(responses),
+		TotalCount: total,
+		Pagination: PaginationMetadata{
+			Total:   total,
+			Count:   len(responses),
+			Limit:   limit,
+			Offset:  offset,
+			HasMore: offset+len(responses) < total,
+		},
 	})
 }
 
 // GetJob handles GET /api/v2/publishing/queue/jobs/{id}
+//
+// @Summary Get job status
+// @Description Returns status of a tracked publishing job
+// @Tags Queue
+// @Security ApiKeyAuth
+// @Produce json
+// @Param id path string true "Job ID"
+// @Success 200 {object} JobStatusResponse
+// @Failure 404 {object} apierrors.ErrorResponse
+// @Router /publishing/queue/jobs/{id} [get]
 func (h *PublishingHandlers) GetJob(w http.ResponseWriter, r *http.Request) {
-	// TODO: Add public method to queue for getting job by ID
-	_ = mux.Vars(r) // Suppress unused warning
-	apiErr := apierrors.InternalError("Job tracking not yet implemented in API v2").
-		WithRequestID(middleware.GetRequestID(r.Context()))
-	apierrors.WriteError(w, apiErr)
+	id := mux.Vars(r)["id"]
+
+	job := h.queue.GetJob(id)
+	if job == nil {
+		apierrors.WriteError(w, apierrors.NotFoundError("Job").
+			WithRequestID(middleware.GetRequestID(r.Context())))
+		return
+	}
+
+	h.sendJSON(w, http.StatusOK, jobSnapshotToResponse(job))
 }
 
 // ===== DLQ Management Handlers =====
 
 // ListDLQEntries handles GET /api/v2/publishing/dlq
+//
+// @Summary List DLQ entries
+// @Description Returns dead letter queue entries (most recent failures first) with filtering and pagination
+// @Tags DLQ
+// @Security ApiKeyAuth
+// @Produce json
+// @Param target query string false "Filter by target name"
+// @Param error_type query string false "Filter by error type (transient, permanent, unknown)"
+// @Param priority query string false "Filter by priority (high, medium, low)"
+// @Param replayed query bool false "Filter by replay status"
+// @Param limit query int false "Maximum results per page (1-1000, default: 100)"
+// @Param offset query int false "Offset for pagination (>=0, default: 0)"
+// @Success 200 {object} DLQListResponse
+// @Failure 400 {object} apierrors.ErrorResponse
+// @Failure 503 {object} apierrors.ErrorResponse
+// @Router /publishing/dlq [get]
 func (h *PublishingHandlers) ListDLQEntries(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+
+	dlq := h.dlq(w, r)
+	if dlq == nil {
+		return
+	}
+
+	limit, offset, err := parsePagination(r)
+	if err != nil {
+		apierrors.WriteError(w, apierrors.ValidationError("Invalid query parameters").
+			WithDetails(err.Error()).
+			WithRequestID(requestID))
+		return
+	}
+
 	query := r.URL.Query()
 	filters := infrapub.DLQFilters{
 		TargetName: query.Get("target"),
 		ErrorType:  query.Get("error_type"),
 		Priority:   query.Get("priority"),
-		Limit:      100,
+		// One extra entry tells whether another page exists
+		Limit:  limit + 1,
+		Offset: offset,
 	}
 
 	if replayedStr := query.Get("replayed"); replayedStr != "" {
-		replayed := replayedStr == "true"
+		replayed, err := strconv.ParseBool(replayedStr)
+		if err != nil {
+			apierrors.WriteError(w, apierrors.ValidationError("Invalid query parameters").
+				WithDetails("invalid replayed: must be true or false").
+				WithRequestID(requestID))
+			return
+		}
 		filters.Replayed = &replayed
 	}
 
-	if limitStr := query.Get("limit"); limitStr != "" {
-		if parsedLimit, err := strconv.Atoi(limitStr); err == nil && parsedLimit > 0 && parsedLimit <= 1000 {
-			filters.Limit = parsedLimit
-		}
+	entries, err := dlq.Read(r.Context(), filters)
+	if err != nil {
+		h.logger.Error("Failed to read DLQ entries", "request_id", requestID, "error", err)
+		apierrors.WriteError(w, apierrors.InternalError("Failed to read DLQ entries").
+			WithRequestID(requestID))
+		return
+	}
+
+	hasMore := len(entries) > limit
+	if hasMore {
+		entries = entries[:limit]
+	}
+
+	responses := make([]DLQEntryResponse, 0, len(entries))
+	for _, entry := range entries {
+		responses = append(responses, dlqEntryToResponse(entry))
 	}
 
-	// TODO: Add public method to queue for DLQ operations
-	// For now, return empty list
-	responses := make([]DLQEntryResponse, 0)
+	// Exact total is known from stats for unfiltered and single-filter queries;
+	// otherwise report the lower bound implied by this page.
+	total := offset + len(responses)
+	if hasMore {
+		total++
+	}
+	var statsResponse *DLQStatsResponse
+	if stats, err := dlq.GetStats(r.Context()); err == nil {
+		statsResponse = &DLQStatsResponse{
+			TotalEntries:       stats.TotalEntries,
+			EntriesByErrorType: stats.EntriesByErrorType,
+			EntriesByTarget:    stats.EntriesByTarget,
+			EntriesByPriority:  stats.EntriesByPriority,
+			ReplayedCount:      stats.ReplayedCount,
+		}
+		if exact, ok := dlqFilteredTotal(stats, filters); ok {
+			total = exact
+		}
+	} else {
+		h.logger.Warn("Failed to get DLQ stats", "request_id", requestID, "error", err)
+	}
 
 	h.sendJSON(w, http.StatusOK, DLQListResponse{
 		Entries:    responses,
-		TotalCount: len(responses),
+		TotalCount: total,
+		Pagination: PaginationMetadata{
+			Total:   total,
+			Count:   len(responses),
+			Limit:   limit,
+			Offset:  offset,
+			HasMore: hasMore,
+		},
+		Stats: statsResponse,
 	})
 }
 
 // ReplayDLQEntry handles POST /api/v2/publishing/dlq/{id}/replay
+//
+// @Summary Replay DLQ entry
+// @Description Re-submits a failed job from the dead letter queue to the publishing queue
+// @Tags DLQ
+// @Security ApiKeyAuth
+// @Produce json
+// @Param id path string true "DLQ entry ID (UUID)"
+// @Success 200 {object} ReplayDLQResponse
+// @Failure 400 {object} apierrors.ErrorResponse
+// @Failure 404 {object} apierrors.ErrorResponse
+// @Failure 409 {object} apierrors.ErrorResponse
+// @Failure 500 {object} apierrors.ErrorResponse
+// @Router /publishing/dlq/{id}/replay [post]
 func (h *PublishingHandlers) ReplayDLQEntry(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	idStr := vars["id"]
+	requestID := middleware.GetRequestID(r.Context())
 
-	id, err := uuid.Parse(idStr)
+	id, err := uuid.Parse(mux.Vars(r)["id"])
 	if err != nil {
-		apiErr := apierrors.ValidationError("Invalid DLQ entry ID").
-			WithRequestID(middleware.GetRequestID(r.Context()))
-		apierrors.WriteError(w, apiErr)
+		apierrors.WriteError(w, apierrors.ValidationError("Invalid DLQ entry ID").
+			WithRequestID(requestID))
+		return
+	}
+
+	dlq := h.dlq(w, r)
+	if dlq == nil {
 		return
 	}
 
-	// TODO: Add public method to queue for DLQ replay
-	_ = id // Suppress unused warning
-	apiErr := apierrors.InternalError("DLQ replay not yet implemented in API v2").
-		WithRequestID(middleware.GetRequestID(r.Context()))
-	apierrors.WriteError(w, apiErr)
+	if err := dlq.Replay(r.Context(), id); err != nil {
+		var apiErr *apierrors.APIError
+		switch {
+		case errors.Is(err, infrapub.ErrDLQEntryNotFound):
+			apiErr = apierrors.NotFoundError("DLQ entry")
+		case errors.Is(err, infrapub.ErrDLQEntryAlreadyReplayed):
+			apiErr = apierrors.ConflictError("DLQ entry already replayed")
+		default:
+			h.logger.Error("DLQ replay failed", "request_id", requestID, "dlq_id", id, "error", err)
+			apiErr = apierrors.NewAPIError(apierrors.CodeDLQReplayError, "Failed to replay DLQ entry").
+				WithDetails(err.Error())
+		}
+		apierrors.WriteError(w, apiErr.WithRequestID(requestID))
+		return
+	}
+
+	h.logger.Info("DLQ entry replayed via API", "request_id", requestID, "dlq_id", id)
+
+	h.sendJSON(w, http.StatusOK, ReplayDLQResponse{
+		Success: true,
+		Message: "DLQ entry re-submitted to publishing queue",
+	})
 }
 
 // PurgeDLQ handles DELETE /api/v2/publishing/dlq/purge
+//
+// @Summary Purge DLQ
+// @Description Deletes DLQ entries older than the given age (default: 168 hours)
+// @Tags DLQ
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param request body PurgeDLQRequest false "Purge request"
+// @Success 200 {object} PurgeDLQResponse
+// @Failure 400 {object} apierrors.ErrorResponse
+// @Failure 500 {object} apierrors.ErrorResponse
+// @Router /publishing/dlq/purge [delete]
 func (h *PublishingHandlers) PurgeDLQ(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+
 	var req PurgeDLQRequest
 	req.OlderThanHours = 168 // Default: 7 days
 
-	if r.Body != http.NoBody {
-		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-			h.logger.Debug("Failed to decode purge request, using defaults", "error", err)
+	if r.Body != nil && r.Body != http.NoBody {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+			apierrors.WriteError(w, apierrors.ValidationError("Invalid request body").
+				WithRequestID(requestID))
+			return
 		}
 	}
 
-	if req.OlderThanHours <= 0 {
+	if req.OlderThanHours < 0 {
+		apierrors.WriteError(w, apierrors.ValidationError("older_than_hours must not be negative").
+			WithRequestID(requestID))
+		return
+	}
+	if req.OlderThanHours == 0 {
 		req.OlderThanHours = 168
 	}
 
-	// TODO: Add public method to queue for DLQ purge
-	_ = req // Suppress unused warning
-	apiErr := apierrors.InternalError("DLQ purge not yet implemented in API v2").
-		WithRequestID(middleware.GetRequestID(r.Context()))
-	apierrors.WriteError(w, apiErr)
+	dlq := h.dlq(w, r)
+	if dlq == nil {
+		return
+	}
+
+	deleted, err := dlq.Purge(r.Context(), time.Duration(req.OlderThanHours)*time.Hour)
+	if err != nil {
+		h.logger.Error("DLQ purge failed", "request_id", requestID, "error", err)
+		apierrors.WriteError(w, apierrors.InternalError("Failed to purge DLQ").
+			WithRequestID(requestID))
+		return
+	}
+
+	h.logger.Info("DLQ purged via API",
+		"request_id", requestID,
+		"older_than_hours", req.OlderThanHours,
+		"deleted_count", deleted,
+	)
+
+	h.sendJSON(w, http.StatusOK, PurgeDLQResponse{
+		Success:      true,
+		Message:      fmt.Sprintf("Purged DLQ entries older than %d hours", req.OlderThanHours),
+		DeletedCount: deleted,
+	})
 }
 
 // ===== Statistics Handlers =====
@@ -949,10 +1179,14 @@ func (h *PublishingHandlers) parseListTargetsParams(r *http.Request) (*ListTarge
 			"rootly":    true,
 			"pagerduty": true,
 			"slack":     true,
+			"opsgenie":  true,
+			"email":     true,
+			"msteams":   true,
+			"telegram":  true,
 			"webhook":   true,
 		}
 		if !validTypes[typeStr] {
-			return nil, fmt.Errorf("invalid type: must be one of rootly, pagerduty, slack, webhook")
+			return nil, fmt.Errorf("invalid type: must be one of rootly, pagerduty, slack, opsgenie, email, msteams, telegram, webhook")
 		}
 		params.Type = &typeStr
 	}
@@ -1267,3 +1501,115 @@ func jobSnapshotToResponse(job *infrapub.JobSnapshot) JobStatusResponse {
 
 	return response
 }
+
+// validJobStates lists job states accepted by ListJobs filters
+var validJobStates = map[string]bool{
+	"queued":     true,
+	"processing": true,
+	"retrying":   true,
+	"succeeded":  true,
+	"failed":     true,
+	"dlq":        true,
+}
+
+// validPriorities lists priorities accepted by ListJobs filters
+var validPriorities = map[string]bool{
+	"high":   true,
+	"medium": true,
+	"low":    true,
+}
+
+// dlq returns the queue's DLQ repository, writing 503 if it is not configured
+func (h *PublishingHandlers) dlq(w http.ResponseWriter, r *http.Request) infrapub.DLQRepository {
+	if h.queue != nil {
+		if dlq := h.queue.DLQ(); dlq != nil {
+			return dlq
+		}
+	}
+
+	apierrors.WriteError(w, apierrors.ServiceUnavailableError("Dead letter queue").
+		WithRequestID(middleware.GetRequestID(r.Context())))
+	return nil
+}
+
+// parsePagination parses limit (1-1000, default 100) and offset (>=0) query parameters
+func parsePagination(r *http.Request) (limit, offset int, err error) {
+	limit = 100
+	query := r.URL.Query()
+
+	if limitStr := query.Get("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil || parsed < 1 || parsed > 1000 {
+			return 0, 0, fmt.Errorf("invalid limit: must be between 1 and 1000")
+		}
+		limit = parsed
+	}
+
+	if offsetStr := query.Get("offset"); offsetStr != "" {
+		parsed, err := strconv.Atoi(offsetStr)
+		if err != nil || parsed < 0 || parsed > 1000000 {
+			return 0, 0, fmt.Errorf("invalid offset: must be between 0 and 1000000")
+		}
+		offset = parsed
+	}
+
+	return limit, offset, nil
+}
+
+// paginate returns the page of items starting at offset
+func paginate[T any](items []T, offset, limit int) []T {
+	if offset >= len(items) {
+		return []T{}
+	}
+	end := offset + limit
+	if end > len(items) {
+		end = len(items)
+	}
+	return items[offset:end]
+}
+
+// dlqFilteredTotal returns the exact number of DLQ entries matching filters,
+// if it can be derived from aggregate stats (no filter or a single filter).
+func dlqFilteredTotal(stats *infrapub.DLQStats, filters infrapub.DLQFilters) (int, bool) {
+	active := 0
+	total := stats.TotalEntries
+
+	if filters.TargetName != "" {
+		active++
+		total = stats.EntriesByTarget[filters.TargetName]
+	}
+	if filters.ErrorType != "" {
+		active++
+		total = stats.EntriesByErrorType[filters.ErrorType]
+	}
+	if filters.Priority != "" {
+		active++
+		total = stats.EntriesByPriority[filters.Priority]
+	}
+	if filters.Replayed != nil {
+		active++
+		total = stats.ReplayedCount
+		if !*filters.Replayed {
+			total = stats.TotalEntries - stats.ReplayedCount
+		}
+	}
+
+	return total, active <= 1
+}
+
+func dlqEntryToResponse(entry *infrapub.DLQEntry) DLQEntryResponse {
+	return DLQEntryResponse{
+		ID:           entry.ID.String(),
+		JobID:        entry.JobID.String(),
+		Fingerprint:  entry.Fingerprint,
+		TargetName:   entry.TargetName,
+		TargetType:   entry.TargetType,
+		ErrorMessage: entry.ErrorMessage,
+		ErrorType:    entry.ErrorType,
+		RetryCount:   entry.RetryCount,
+		Priority:     entry.Priority,
+		FailedAt:     entry.FailedAt,
+		Replayed:     entry.Replayed,
+		ReplayedAt:   entry.ReplayedAt,
+	}
+}
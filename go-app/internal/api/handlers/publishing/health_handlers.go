@@ -0,0 +1,180 @@
+package publishing
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	apierrors "github.com/vitaliisemenov/alert-history/internal/api/errors"
+	"github.com/vitaliisemenov/alert-history/internal/api/middleware"
+	"github.com/vitaliisemenov/alert-history/internal/business/publishing"
+)
+
+// HealthHandlers provides HTTP handlers for publishing target health (TN-049, API v2)
+type HealthHandlers struct {
+	healthMonitor publishing.HealthMonitor
+	logger        *slog.Logger
+}
+
+// NewHealthHandlers creates new target health handlers
+func NewHealthHandlers(healthMonitor publishing.HealthMonitor, logger *slog.Logger) *HealthHandlers {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &HealthHandlers{
+		healthMonitor: healthMonitor,
+		logger:        logger,
+	}
+}
+
+// TargetHealthListResponse represents health status of all targets
+type TargetHealthListResponse struct {
+	Targets    []publishing.TargetHealthStatus `json:"targets"`
+	TotalCount int                             `json:"total_count"`
+}
+
+// ListTargetsHealth handles GET /api/v2/publishing/targets/health
+//
+// @Summary List target health
+// @Description Returns cached health status of all publishing targets
+// @Tags Health
+// @Produce json
+// @Param status query string false "Filter by status (healthy, unhealthy, degraded, unknown)"
+// @Success 200 {object} TargetHealthListResponse
+// @Failure 500 {object} apierrors.ErrorResponse
+// @Router /publishing/targets/health [get]
+func (h *HealthHandlers) ListTargetsHealth(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+
+	statuses, err := h.healthMonitor.GetHealth(r.Context())
+	if err != nil {
+		h.logger.Error("Failed to get target health", "request_id", requestID, "error", err)
+		apierrors.WriteError(w, apierrors.InternalError("Failed to get target health").
+			WithRequestID(requestID))
+		return
+	}
+
+	filtered := make([]publishing.TargetHealthStatus, 0, len(statuses))
+	statusFilter := r.URL.Query().Get("status")
+	for _, status := range statuses {
+		if statusFilter != "" && string(status.Status) != statusFilter {
+			continue
+		}
+		filtered = append(filtered, status)
+	}
+
+	h.sendJSON(w, http.StatusOK, TargetHealthListResponse{
+		Targets:    filtered,
+		TotalCount: len(filtered),
+	})
+}
+
+// GetTargetHealth handles GET /api/v2/publishing/targets/health/{name}
+//
+// @Summary Get target health
+// @Description Returns cached health status of a single publishing target
+// @Tags Health
+// @Produce json
+// @Param name path string true "Target name"
+// @Success 200 {object} publishing.TargetHealthStatus
+// @Failure 404 {object} apierrors.ErrorResponse
+// @Router /publishing/targets/health/{name} [get]
+func (h *HealthHandlers) GetTargetHealth(w http.ResponseWriter, r *http.Request) {
+	name := mux.Vars(r)["name"]
+
+	status, err := h.healthMonitor.GetHealthByName(r.Context(), name)
+	if err != nil {
+		h.writeHealthError(w, r, name, err)
+		return
+	}
+
+	h.sendJSON(w, http.StatusOK, status)
+}
+
+// CheckTargetHealth handles POST /api/v2/publishing/targets/health/{name}/check
+//
+// @Summary Check target health now
+// @Description Triggers an immediate health check; responds 503 if the target is unhealthy
+// @Tags Health
+// @Security ApiKeyAuth
+// @Produce json
+// @Param name path string true "Target name"
+// @Success 200 {object} publishing.TargetHealthStatus
+// @Failure 404 {object} apierrors.ErrorResponse
+// @Failure 503 {object} publishing.TargetHealthStatus
+// @Router /publishing/targets/health/{name}/check [post]
+func (h *HealthHandlers) CheckTargetHealth(w http.ResponseWriter, r *http.Request) {
+	name := mux.Vars(r)["name"]
+
+	status, err := h.healthMonitor.CheckNow(r.Context(), name)
+	if err != nil {
+		h.writeHealthError(w, r, name, err)
+		return
+	}
+
+	h.logger.Info("Manual target health check",
+		"request_id", middleware.GetRequestID(r.Context()),
+		"target", name,
+		"status", status.Status,
+	)
+
+	statusCode := http.StatusOK
+	if status.Status == publishing.HealthStatusUnhealthy {
+		statusCode = http.StatusServiceUnavailable
+	}
+	h.sendJSON(w, statusCode, status)
+}
+
+// GetHealthStats handles GET /api/v2/publishing/targets/health/stats
+//
+// @Summary Get health statistics
+// @Description Returns aggregate health statistics across all targets
+// @Tags Health
+// @Produce json
+// @Success 200 {object} publishing.HealthStats
+// @Failure 500 {object} apierrors.ErrorResponse
+// @Router /publishing/targets/health/stats [get]
+func (h *HealthHandlers) GetHealthStats(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+
+	stats, err := h.healthMonitor.GetStats(r.Context())
+	if err != nil {
+		h.logger.Error("Failed to get health stats", "request_id", requestID, "error", err)
+		apierrors.WriteError(w, apierrors.InternalError("Failed to get health statistics").
+			WithRequestID(requestID))
+		return
+	}
+
+	h.sendJSON(w, http.StatusOK, stats)
+}
+
+// writeHealthError maps health monitor errors to API errors
+func (h *HealthHandlers) writeHealthError(w http.ResponseWriter, r *http.Request, name string, err error) {
+	requestID := middleware.GetRequestID(r.Context())
+
+	var notFound *publishing.ErrTargetNotFound
+	if errors.As(err, &notFound) {
+		apierrors.WriteError(w, apierrors.NotFoundError("Target").
+			WithDetails(map[string]string{"target_name": name}).
+			WithRequestID(requestID))
+		return
+	}
+
+	h.logger.Error("Target health request failed", "request_id", requestID, "target", name, "error", err)
+	apierrors.WriteError(w, apierrors.NewAPIError(apierrors.CodeHealthCheckFailed, "Health check failed").
+		WithDetails(err.Error()).
+		WithRequestID(requestID))
+}
+
+func (h *HealthHandlers) sendJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set(middleware.APIVersionHeader, "2.0.0")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		h.logger.Error("Failed to encode JSON response", "error", err)
+	}
+}
@@ -0,0 +1,112 @@
+package publishing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vitaliisemenov/alert-history/internal/business/publishing"
+)
+
+// stubHealthMonitor returns fixed health statuses
+type stubHealthMonitor struct {
+	statuses map[string]publishing.TargetHealthStatus
+	err      error
+}
+
+func (s *stubHealthMonitor) Start() error                     { return nil }
+func (s *stubHealthMonitor) Stop(timeout time.Duration) error { return nil }
+
+func (s *stubHealthMonitor) GetHealth(ctx context.Context) ([]publishing.TargetHealthStatus, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	result := make([]publishing.TargetHealthStatus, 0, len(s.statuses))
+	for _, status := range s.statuses {
+		result = append(result, status)
+	}
+	return result, nil
+}
+
+func (s *stubHealthMonitor) GetHealthByName(ctx context.Context, name string) (*publishing.TargetHealthStatus, error) {
+	status, ok := s.statuses[name]
+	if !ok {
+		return nil, publishing.NewTargetNotFoundError(name)
+	}
+	return &status, nil
+}
+
+func (s *stubHealthMonitor) CheckNow(ctx context.Context, name string) (*publishing.TargetHealthStatus, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.GetHealthByName(ctx, name)
+}
+
+func (s *stubHealthMonitor) GetStats(ctx context.Context) (*publishing.HealthStats, error) {
+	return &publishing.HealthStats{TotalTargets: len(s.statuses)}, nil
+}
+
+func newHealthTestRouter(monitor publishing.HealthMonitor) *mux.Router {
+	h := NewHealthHandlers(monitor, nil)
+	router := mux.NewRouter()
+	router.HandleFunc("/health", h.ListTargetsHealth).Methods("GET")
+	router.HandleFunc("/health/stats", h.GetHealthStats).Methods("GET")
+	router.HandleFunc("/health/{name}", h.GetTargetHealth).Methods("GET")
+	router.HandleFunc("/health/{name}/check", h.CheckTargetHealth).Methods("POST")
+	return router
+}
+
+func TestHealthHandlers(t *testing.T) {
+	monitor := &stubHealthMonitor{statuses: map[string]publishing.TargetHealthStatus{
+		"slack-ops":   {TargetName: "slack-ops", Status: publishing.HealthStatusHealthy},
+		"rootly-prod": {TargetName: "rootly-prod", Status: publishing.HealthStatusUnhealthy},
+	}}
+	router := newHealthTestRouter(monitor)
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		wantStatus int
+	}{
+		{name: "list", method: http.MethodGet, path: "/health", wantStatus: http.StatusOK},
+		{name: "stats", method: http.MethodGet, path: "/health/stats", wantStatus: http.StatusOK},
+		{name: "get", method: http.MethodGet, path: "/health/slack-ops", wantStatus: http.StatusOK},
+		{name: "get_not_found", method: http.MethodGet, path: "/health/missing", wantStatus: http.StatusNotFound},
+		{name: "check_healthy", method: http.MethodPost, path: "/health/slack-ops/check", wantStatus: http.StatusOK},
+		{name: "check_unhealthy", method: http.MethodPost, path: "/health/rootly-prod/check", wantStatus: http.StatusServiceUnavailable},
+		{name: "check_not_found", method: http.MethodPost, path: "/health/missing/check", wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, nil))
+			assert.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+		})
+	}
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health?status=unhealthy", nil))
+	var resp TargetHealthListResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp.Targets, 1)
+	assert.Equal(t, "rootly-prod", resp.Targets[0].TargetName)
+}
+
+func TestHealthHandlers_MonitorError(t *testing.T) {
+	router := newHealthTestRouter(&stubHealthMonitor{err: errors.New("boom")})
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+}
@@ -12,17 +12,23 @@ import (
 	"github.com/vitaliisemenov/alert-history/internal/infrastructure/publishing"
 )
 
+// TargetResolver resolves publishing targets by name.
+// Satisfied by both business and infrastructure TargetDiscoveryManager.
+type TargetResolver interface {
+	GetTarget(name string) (*core.PublishingTarget, error)
+}
+
 // ParallelPublishHandlers provides HTTP handlers for parallel publishing (TN-058)
 type ParallelPublishHandlers struct {
 	publisher        publishing.ParallelPublisher
-	discoveryManager publishing.TargetDiscoveryManager
+	discoveryManager TargetResolver
 	logger           *slog.Logger
 }
 
 // NewParallelPublishHandlers creates new parallel publish handlers
 func NewParallelPublishHandlers(
 	publisher publishing.ParallelPublisher,
-	discoveryManager publishing.TargetDiscoveryManager,
+	discoveryManager TargetResolver,
 	logger *slog.Logger,
 ) *ParallelPublishHandlers {
 	if logger == nil {
@@ -0,0 +1,286 @@
+package publishing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apierrors "github.com/vitaliisemenov/alert-history/internal/api/errors"
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	infrapub "github.com/vitaliisemenov/alert-history/internal/infrastructure/publishing"
+)
+
+// memoryDLQRepository is an in-memory DLQRepository for handler tests
+type memoryDLQRepository struct {
+	mu        sync.Mutex
+	entries   []*infrapub.DLQEntry
+	replayErr error
+	purgedAge time.Duration
+}
+
+func (m *memoryDLQRepository) Write(ctx context.Context, job *infrapub.PublishingJob) error {
+	return nil
+}
+
+func (m *memoryDLQRepository) Read(ctx context.Context, filters infrapub.DLQFilters) ([]*infrapub.DLQEntry, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var matched []*infrapub.DLQEntry
+	for _, e := range m.entries {
+		if filters.TargetName != "" && e.TargetName != filters.TargetName {
+			continue
+		}
+		if filters.Replayed != nil && e.Replayed != *filters.Replayed {
+			continue
+		}
+		matched = append(matched, e)
+	}
+
+	if filters.Offset >= len(matched) {
+		return []*infrapub.DLQEntry{}, nil
+	}
+	matched = matched[filters.Offset:]
+	if filters.Limit > 0 && len(matched) > filters.Limit {
+		matched = matched[:filters.Limit]
+	}
+	return matched, nil
+}
+
+func (m *memoryDLQRepository) Replay(ctx context.Context, id uuid.UUID) error {
+	if m.replayErr != nil {
+		return m.replayErr
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, e := range m.entries {
+		if e.ID == id {
+			if e.Replayed {
+				return fmt.Errorf("%w: %s", infrapub.ErrDLQEntryAlreadyReplayed, id)
+			}
+			e.Replayed = true
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s", infrapub.ErrDLQEntryNotFound, id)
+}
+
+func (m *memoryDLQRepository) Purge(ctx context.Context, olderThan time.Duration) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.purgedAge = olderThan
+	return int64(len(m.entries)), nil
+}
+
+func (m *memoryDLQRepository) GetStats(ctx context.Context) (*infrapub.DLQStats, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stats := &infrapub.DLQStats{
+		EntriesByErrorType: map[string]int{},
+		EntriesByTarget:    map[string]int{},
+		EntriesByPriority:  map[string]int{},
+	}
+	for _, e := range m.entries {
+		stats.TotalEntries++
+		stats.EntriesByTarget[e.TargetName]++
+		if e.Replayed {
+			stats.ReplayedCount++
+		}
+	}
+	return stats, nil
+}
+
+func newDLQEntries(n int, target string) []*infrapub.DLQEntry {
+	entries := make([]*infrapub.DLQEntry, 0, n)
+	for i := 0; i < n; i++ {
+		entries = append(entries, &infrapub.DLQEntry{
+			ID:          uuid.New(),
+			JobID:       uuid.New(),
+			Fingerprint: fmt.Sprintf("fp-%d", i),
+			TargetName:  target,
+			TargetType:  "webhook",
+			ErrorType:   "permanent",
+			Priority:    "high",
+			FailedAt:    time.Now(),
+		})
+	}
+	return entries
+}
+
+func newQueueHandlers(dlq infrapub.DLQRepository, store infrapub.JobTrackingStore) *PublishingHandlers {
+	queue := infrapub.NewPublishingQueue(nil, dlq, store, infrapub.DefaultPublishingQueueConfig(), nil, nil, nil)
+	return NewPublishingHandlers(nil, nil, queue, nil, nil)
+}
+
+func addTrackedJob(store infrapub.JobTrackingStore, id, target string, state infrapub.JobState) {
+	store.Add(&infrapub.PublishingJob{
+		ID:            id,
+		State:         state,
+		Priority:      infrapub.PriorityMedium,
+		Target:        &core.PublishingTarget{Name: target},
+		EnrichedAlert: &core.EnrichedAlert{Alert: &core.Alert{Fingerprint: "fp-" + id}},
+		SubmittedAt:   time.Now(),
+	})
+}
+
+func TestListJobs_FilterAndPaginate(t *testing.T) {
+	store := infrapub.NewLRUJobTrackingStore(100)
+	for i := 0; i < 5; i++ {
+		addTrackedJob(store, fmt.Sprintf("job-%d", i), "slack", infrapub.JobStateSucceeded)
+	}
+	addTrackedJob(store, "job-failed", "rootly", infrapub.JobStateFailed)
+
+	h := newQueueHandlers(nil, store)
+
+	rec := httptest.NewRecorder()
+	h.ListJobs(rec, httptest.NewRequest(http.MethodGet, "/api/v2/publishing/queue/jobs?state=succeeded&limit=2&offset=1", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var resp JobListResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, 5, resp.TotalCount)
+	assert.Len(t, resp.Jobs, 2)
+	assert.Equal(t, PaginationMetadata{Total: 5, Count: 2, Limit: 2, Offset: 1, HasMore: true}, resp.Pagination)
+	for _, job := range resp.Jobs {
+		assert.Equal(t, "succeeded", job.State)
+	}
+
+	rec = httptest.NewRecorder()
+	h.ListJobs(rec, httptest.NewRequest(http.MethodGet, "/api/v2/publishing/queue/jobs?state=bogus", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = httptest.NewRecorder()
+	h.ListJobs(rec, httptest.NewRequest(http.MethodGet, "/api/v2/publishing/queue/jobs?limit=0", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestGetJob(t *testing.T) {
+	store := infrapub.NewLRUJobTrackingStore(10)
+	addTrackedJob(store, "job-1", "slack", infrapub.JobStateQueued)
+	h := newQueueHandlers(nil, store)
+
+	router := mux.NewRouter()
+	router.HandleFunc("/jobs/{id}", h.GetJob)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/jobs/job-1", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var job JobStatusResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&job))
+	assert.Equal(t, "job-1", job.ID)
+	assert.Equal(t, "queued", job.State)
+	assert.Equal(t, "slack", job.TargetName)
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/jobs/missing", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestListDLQEntries(t *testing.T) {
+	dlq := &memoryDLQRepository{entries: append(newDLQEntries(3, "slack"), newDLQEntries(2, "rootly")...)}
+	h := newQueueHandlers(dlq, nil)
+
+	tests := []struct {
+		name    string
+		query   string
+		count   int
+		total   int
+		hasMore bool
+	}{
+		{name: "first_page", query: "?limit=2", count: 2, total: 5, hasMore: true},
+		{name: "last_page", query: "?limit=2&offset=4", count: 1, total: 5, hasMore: false},
+		{name: "by_target", query: "?target=rootly", count: 2, total: 2, hasMore: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			h.ListDLQEntries(rec, httptest.NewRequest(http.MethodGet, "/api/v2/publishing/dlq"+tt.query, nil))
+			require.Equal(t, http.StatusOK, rec.Code)
+
+			var resp DLQListResponse
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			assert.Len(t, resp.Entries, tt.count)
+			assert.Equal(t, tt.total, resp.Pagination.Total)
+			assert.Equal(t, tt.hasMore, resp.Pagination.HasMore)
+			require.NotNil(t, resp.Stats)
+			assert.Equal(t, 5, resp.Stats.TotalEntries)
+		})
+	}
+
+	rec := httptest.NewRecorder()
+	h.ListDLQEntries(rec, httptest.NewRequest(http.MethodGet, "/api/v2/publishing/dlq?replayed=maybe", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestReplayDLQEntry(t *testing.T) {
+	dlq := &memoryDLQRepository{entries: newDLQEntries(1, "slack")}
+	h := newQueueHandlers(dlq, nil)
+
+	router := mux.NewRouter()
+	router.HandleFunc("/dlq/{id}/replay", h.ReplayDLQEntry)
+
+	replay := func(id string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/dlq/"+id+"/replay", nil))
+		return rec
+	}
+
+	id := dlq.entries[0].ID.String()
+	assert.Equal(t, http.StatusOK, replay(id).Code)
+	assert.Equal(t, http.StatusConflict, replay(id).Code)
+	assert.Equal(t, http.StatusNotFound, replay(uuid.NewString()).Code)
+	assert.Equal(t, http.StatusBadRequest, replay("not-a-uuid").Code)
+
+	dlq.replayErr = fmt.Errorf("queue full")
+	rec := replay(id)
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+
+	var errResp apierrors.ErrorResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&errResp))
+	assert.Equal(t, apierrors.CodeDLQReplayError, errResp.Error.Code)
+}
+
+func TestPurgeDLQ(t *testing.T) {
+	dlq := &memoryDLQRepository{entries: newDLQEntries(2, "slack")}
+	h := newQueueHandlers(dlq, nil)
+
+	rec := httptest.NewRecorder()
+	h.PurgeDLQ(rec, httptest.NewRequest(http.MethodDelete, "/api/v2/publishing/dlq/purge", strings.NewReader(`{"older_than_hours": 24}`)))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, 24*time.Hour, dlq.purgedAge)
+
+	var resp PurgeDLQResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, int64(2), resp.DeletedCount)
+
+	// Default age without body
+	rec = httptest.NewRecorder()
+	h.PurgeDLQ(rec, httptest.NewRequest(http.MethodDelete, "/api/v2/publishing/dlq/purge", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, 168*time.Hour, dlq.purgedAge)
+
+	rec = httptest.NewRecorder()
+	h.PurgeDLQ(rec, httptest.NewRequest(http.MethodDelete, "/api/v2/publishing/dlq/purge", strings.NewReader(`{"older_than_hours": -1}`)))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestDLQHandlers_NotConfigured(t *testing.T) {
+	h := newQueueHandlers(nil, nil)
+
+	rec := httptest.NewRecorder()
+	h.ListDLQEntries(rec, httptest.NewRequest(http.MethodGet, "/api/v2/publishing/dlq", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+}
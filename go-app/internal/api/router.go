@@ -10,9 +10,12 @@ import (
 
 	"github.com/vitaliisemenov/alert-history/cmd/server/handlers"
 	apierrors "github.com/vitaliisemenov/alert-history/internal/api/errors"
+	publishinghandlers "github.com/vitaliisemenov/alert-history/internal/api/handlers/publishing"
 	"github.com/vitaliisemenov/alert-history/internal/api/middleware"
 	apiservices "github.com/vitaliisemenov/alert-history/internal/api/services/publishing"
 	"github.com/vitaliisemenov/alert-history/internal/business/publishing"
+	appconfig "github.com/vitaliisemenov/alert-history/internal/config"
+	infrapub "github.com/vitaliisemenov/alert-history/internal/infrastructure/publishing"
 )
 
 // RouterConfig holds router configuration
@@ -45,9 +48,7 @@ type RouterConfig struct {
 
 	// TN-70: Test target endpoint dependencies
 	TargetDiscoveryManager publishing.TargetDiscoveryManager
-	PublishingCoordinator  interface {
-		PublishToTargets(ctx interface{}, alert interface{}, targets []string) (interface{}, error)
-	} // *infrapub.PublishingCoordinator (avoid circular import via interface)
+	PublishingCoordinator  *infrapub.PublishingCoordinator
 
 	// TN-71: Classification endpoints dependencies
 	ClassificationService interface {
@@ -60,9 +61,15 @@ type RouterConfig struct {
 	} // *classificationhandlers.ClassificationHandlers (avoid circular import via interface)
 
 	// TN-149: Config export endpoint dependency
-	ConfigService interface {
-		GetConfig(ctx interface{}, opts interface{}) (interface{}, error)
-	} // appconfig.ConfigService (avoid circular import via interface)
+	ConfigService appconfig.ConfigService
+
+	// Publishing pipeline management dependencies (all optional;
+	// routes respond 503 when their backing component is not configured)
+	PublishingQueue   *infrapub.PublishingQueue              // TN-056: queue status, jobs, DLQ
+	HealthMonitor     publishing.HealthMonitor               // TN-049: target health
+	ParallelPublisher infrapub.ParallelPublisher             // TN-058: parallel publishing
+	MetricsCollector  *publishing.PublishingMetricsCollector // TN-057: metrics & stats
+	TrendDetector     *publishing.TrendDetector              // TN-057: trends (optional)
 }
 
 // DefaultRouterConfig returns default router configuration
@@ -160,15 +167,58 @@ func setupAPIv2Routes(router *mux.Router, config RouterConfig) {
 }
 
 // setupPublishingRoutes configures /api/v2/publishing/* routes
+//
+// RBAC summary:
+//   - Public: targets, target health, queue status/stats, parallel status, metrics
+//   - Viewer+: jobs
+//   - Operator+: target test, health check, queue submit, parallel publish, DLQ list
+//   - Admin: target refresh, DLQ replay/purge
 func setupPublishingRoutes(router *mux.Router, config RouterConfig) {
 	pub := router.PathPrefix("/publishing").Subrouter()
 
+	var pubHandlers *publishinghandlers.PublishingHandlers
+	if config.TargetDiscoveryManager != nil || config.PublishingQueue != nil {
+		pubHandlers = publishinghandlers.NewPublishingHandlers(
+			config.TargetDiscoveryManager,
+			config.RefreshManager,
+			config.PublishingQueue,
+			nil, // coordinator: target test uses config.PublishingCoordinator
+			config.Logger,
+		)
+	}
+
 	// --- Targets Management ---
 	targets := pub.PathPrefix("/targets").Subrouter()
 
+	// --- Targets Health ---
+	// Registered before /targets/{name} so that "health" is not taken as a target name
+	health := targets.PathPrefix("/health").Subrouter()
+	if config.HealthMonitor != nil {
+		healthHandlers := publishinghandlers.NewHealthHandlers(config.HealthMonitor, config.Logger)
+		health.HandleFunc("", healthHandlers.ListTargetsHealth).Methods("GET")
+		health.HandleFunc("/stats", healthHandlers.GetHealthStats).Methods("GET")
+		health.HandleFunc("/{name}", healthHandlers.GetTargetHealth).Methods("GET")
+
+		healthOperator := health.NewRoute().Subrouter()
+		protect(healthOperator, config, middleware.OperatorMiddleware)
+		healthOperator.HandleFunc("/{name}/check", healthHandlers.CheckTargetHealth).Methods("POST")
+	} else {
+		unavailable := ServiceUnavailableHandler("Target health monitor")
+		health.HandleFunc("", unavailable).Methods("GET")
+		health.HandleFunc("/stats", unavailable).Methods("GET")
+		health.HandleFunc("/{name}", unavailable).Methods("GET")
+		health.HandleFunc("/{name}/check", unavailable).Methods("POST")
+	}
+
 	// Public endpoints (no auth required)
-	targets.HandleFunc("", PlaceholderHandler("ListTargets")).Methods("GET")
-	targets.HandleFunc("/{name}", PlaceholderHandler("GetTarget")).Methods("GET")
+	if config.TargetDiscoveryManager != nil {
+		targets.HandleFunc("", pubHandlers.ListTargets).Methods("GET")
+		targets.HandleFunc("/{name}", pubHandlers.GetTarget).Methods("GET")
+	} else {
+		unavailable := ServiceUnavailableHandler("Target discovery")
+		targets.HandleFunc("", unavailable).Methods("GET")
+		targets.HandleFunc("/{name}", unavailable).Methods("GET")
+	}
 
 	// Protected endpoints (require auth)
 	targetsProtected := targets.PathPrefix("").Subrouter()
@@ -188,7 +238,7 @@ func setupPublishingRoutes(router *mux.Router, config RouterConfig) {
 	if config.RefreshManager != nil {
 		targetsAdmin.HandleFunc("/refresh", handlers.HandleRefreshTargets(config.RefreshManager)).Methods("POST")
 	} else {
-		targetsAdmin.HandleFunc("/refresh", PlaceholderHandler("RefreshTargets")).Methods("POST")
+		targetsAdmin.HandleFunc("/refresh", ServiceUnavailableHandler("Target refresh manager")).Methods("POST")
 	}
 
 	// Operator+ endpoints
@@ -201,78 +251,110 @@ func setupPublishingRoutes(router *mux.Router, config RouterConfig) {
 		targetsOperator.HandleFunc("/{name}/test",
 			handlers.HandleTestTarget(config.TargetDiscoveryManager, config.PublishingCoordinator, config.Logger)).Methods("POST")
 	} else {
-		targetsOperator.HandleFunc("/{name}/test", PlaceholderHandler("TestTarget")).Methods("POST")
+		targetsOperator.HandleFunc("/{name}/test", ServiceUnavailableHandler("Publishing coordinator")).Methods("POST")
 	}
 
-	// --- Targets Health ---
-	health := targets.PathPrefix("/health").Subrouter()
-	health.HandleFunc("", PlaceholderHandler("ListTargetsHealth")).Methods("GET")
-	health.HandleFunc("/{name}", PlaceholderHandler("GetTargetHealth")).Methods("GET")
-	health.HandleFunc("/stats", PlaceholderHandler("GetHealthStats")).Methods("GET")
+	// --- Queue Management ---
+	queue := pub.PathPrefix("/queue").Subrouter()
+	queueUnavailable := ServiceUnavailableHandler("Publishing queue")
 
-	healthOperator := health.NewRoute().Subrouter()
-	if config.EnableAuth {
-		healthOperator.Use(middleware.AuthMiddleware(config.AuthConfig))
-		healthOperator.Use(middleware.OperatorMiddleware)
+	// Jobs (viewer+)
+	jobs := queue.PathPrefix("/jobs").Subrouter()
+	protect(jobs, config, nil)
+	if config.PublishingQueue != nil {
+		jobs.HandleFunc("", pubHandlers.ListJobs).Methods("GET")
+		jobs.HandleFunc("/{id}", pubHandlers.GetJob).Methods("GET")
+	} else {
+		jobs.HandleFunc("", queueUnavailable).Methods("GET")
+		jobs.HandleFunc("/{id}", queueUnavailable).Methods("GET")
 	}
-	healthOperator.HandleFunc("/{name}/check", PlaceholderHandler("CheckTargetHealth")).Methods("POST")
 
-	// --- Queue Management ---
-	queue := pub.PathPrefix("/queue").Subrouter()
-	queue.HandleFunc("/status", PlaceholderHandler("GetQueueStatus")).Methods("GET")
-	queue.HandleFunc("/stats", PlaceholderHandler("GetQueueStats")).Methods("GET")
+	if config.PublishingQueue != nil {
+		queue.HandleFunc("/status", pubHandlers.GetQueueStatus).Methods("GET")
+		queue.HandleFunc("/stats", pubHandlers.GetDetailedQueueStats).Methods("GET")
+	} else {
+		queue.HandleFunc("/status", queueUnavailable).Methods("GET")
+		queue.HandleFunc("/stats", queueUnavailable).Methods("GET")
+	}
 
 	queueProtected := queue.PathPrefix("").Subrouter()
-	if config.EnableAuth {
-		queueProtected.Use(middleware.AuthMiddleware(config.AuthConfig))
-		queueProtected.Use(middleware.OperatorMiddleware)
-	}
+	protect(queueProtected, config, middleware.OperatorMiddleware)
 	if config.EnableRateLimit {
 		queueProtected.Use(middleware.RateLimitMiddleware(config.RateLimitPerMinute, config.RateLimitBurst))
 	}
 	queueProtected.Use(middleware.ValidationMiddleware)
-	queueProtected.HandleFunc("/submit", PlaceholderHandler("SubmitAlert")).Methods("POST")
-
-	// Jobs
-	jobs := queue.PathPrefix("/jobs").Subrouter()
-	jobs.HandleFunc("", PlaceholderHandler("ListJobs")).Methods("GET")
-	jobs.HandleFunc("/{id}", PlaceholderHandler("GetJob")).Methods("GET")
+	if config.PublishingQueue != nil && config.TargetDiscoveryManager != nil {
+		queueProtected.HandleFunc("/submit", pubHandlers.SubmitAlert).Methods("POST")
+	} else {
+		queueProtected.HandleFunc("/submit", queueUnavailable).Methods("POST")
+	}
 
 	// --- DLQ Management ---
 	dlq := pub.PathPrefix("/dlq").Subrouter()
-	dlq.HandleFunc("", PlaceholderHandler("ListDLQEntries")).Methods("GET")
 
-	dlqAdmin := dlq.PathPrefix("").Subrouter()
-	if config.EnableAuth {
-		dlqAdmin.Use(middleware.AuthMiddleware(config.AuthConfig))
-		dlqAdmin.Use(middleware.AdminMiddleware)
+	// Admin-only endpoints
+	dlqAdmin := dlq.NewRoute().Subrouter()
+	protect(dlqAdmin, config, middleware.AdminMiddleware)
+
+	// Operator+ endpoints (entries contain alert payloads)
+	dlqOperator := dlq.NewRoute().Subrouter()
+	protect(dlqOperator, config, middleware.OperatorMiddleware)
+
+	if config.PublishingQueue != nil {
+		dlqAdmin.HandleFunc("/{id}/replay", pubHandlers.ReplayDLQEntry).Methods("POST")
+		dlqAdmin.HandleFunc("/purge", pubHandlers.PurgeDLQ).Methods("DELETE")
+		dlqOperator.HandleFunc("", pubHandlers.ListDLQEntries).Methods("GET")
+	} else {
+		dlqUnavailable := ServiceUnavailableHandler("Dead letter queue")
+		dlqAdmin.HandleFunc("/{id}/replay", dlqUnavailable).Methods("POST")
+		dlqAdmin.HandleFunc("/purge", dlqUnavailable).Methods("DELETE")
+		dlqOperator.HandleFunc("", dlqUnavailable).Methods("GET")
 	}
-	dlqAdmin.HandleFunc("/{id}/replay", PlaceholderHandler("ReplayDLQEntry")).Methods("POST")
-	dlqAdmin.HandleFunc("/purge", PlaceholderHandler("PurgeDLQ")).Methods("DELETE")
 
 	// --- Parallel Publishing ---
 	parallel := pub.PathPrefix("/parallel").Subrouter()
-	parallel.HandleFunc("/status", PlaceholderHandler("GetParallelStatus")).Methods("GET")
 
-	parallelProtected := parallel.PathPrefix("").Subrouter()
-	if config.EnableAuth {
-		parallelProtected.Use(middleware.AuthMiddleware(config.AuthConfig))
-		parallelProtected.Use(middleware.OperatorMiddleware)
-	}
+	parallelProtected := parallel.NewRoute().Subrouter()
+	protect(parallelProtected, config, middleware.OperatorMiddleware)
 	parallelProtected.Use(middleware.ValidationMiddleware)
-	parallelProtected.HandleFunc("/targets", PlaceholderHandler("PublishToTargets")).Methods("POST")
-	parallelProtected.HandleFunc("/all", PlaceholderHandler("PublishToAll")).Methods("POST")
-	parallelProtected.HandleFunc("/healthy", PlaceholderHandler("PublishToHealthy")).Methods("POST")
+
+	if config.ParallelPublisher != nil && config.TargetDiscoveryManager != nil {
+		parallelHandlers := publishinghandlers.NewParallelPublishHandlers(
+			config.ParallelPublisher,
+			config.TargetDiscoveryManager,
+			config.Logger,
+		)
+		parallel.HandleFunc("/status", parallelHandlers.GetParallelPublishingStatus).Methods("GET")
+		parallelProtected.HandleFunc("/targets", parallelHandlers.PublishToSpecificTargets).Methods("POST")
+		parallelProtected.HandleFunc("/all", parallelHandlers.PublishToAllTargets).Methods("POST")
+		parallelProtected.HandleFunc("/healthy", parallelHandlers.PublishToHealthyTargets).Methods("POST")
+	} else {
+		parallelUnavailable := ServiceUnavailableHandler("Parallel publisher")
+		parallel.HandleFunc("/status", parallelUnavailable).Methods("GET")
+		parallelProtected.HandleFunc("/targets", parallelUnavailable).Methods("POST")
+		parallelProtected.HandleFunc("/all", parallelUnavailable).Methods("POST")
+		parallelProtected.HandleFunc("/healthy", parallelUnavailable).Methods("POST")
+	}
 
 	// --- Metrics & Stats ---
 	metrics := pub.PathPrefix("/metrics").Subrouter()
-	metrics.HandleFunc("/raw", PlaceholderHandler("GetRawMetrics")).Methods("GET")
-	metrics.HandleFunc("/stats", PlaceholderHandler("GetMetricsStats")).Methods("GET")
-	metrics.HandleFunc("/trends", PlaceholderHandler("GetTrends")).Methods("GET")
-	metrics.HandleFunc("/targets/{name}", PlaceholderHandler("GetTargetMetrics")).Methods("GET")
-
-	// --- Overall Health ---
-	pub.HandleFunc("/health", PlaceholderHandler("GetPublishingHealth")).Methods("GET")
+	if config.MetricsCollector != nil {
+		metricsHandlers := publishinghandlers.NewMetricsHandlers(config.MetricsCollector, config.TrendDetector, config.Logger)
+		metrics.HandleFunc("/raw", metricsHandlers.GetPublishingMetrics).Methods("GET")
+		metrics.HandleFunc("/stats", metricsHandlers.GetPublishingStats).Methods("GET")
+		metrics.HandleFunc("/trends", metricsHandlers.GetPublishingTrends).Methods("GET")
+		metrics.HandleFunc("/targets/{name}", metricsHandlers.GetTargetPublishingStats).Methods("GET")
+
+		// --- Overall Health ---
+		pub.HandleFunc("/health", metricsHandlers.GetPublishingHealth).Methods("GET")
+	} else {
+		metricsUnavailable := ServiceUnavailableHandler("Publishing metrics collector")
+		metrics.HandleFunc("/raw", metricsUnavailable).Methods("GET")
+		metrics.HandleFunc("/stats", metricsUnavailable).Methods("GET")
+		metrics.HandleFunc("/trends", metricsUnavailable).Methods("GET")
+		metrics.HandleFunc("/targets/{name}", metricsUnavailable).Methods("GET")
+		pub.HandleFunc("/health", metricsUnavailable).Methods("GET")
+	}
 
 	// --- Mode Information (TN-68) ---
 	// Public endpoint (no auth required)
@@ -285,6 +367,18 @@ func setupPublishingRoutes(router *mux.Router, config RouterConfig) {
 	}
 }
 
+// protect applies authentication and an optional role check to a subrouter.
+// With a nil role middleware any authenticated user (viewer+) is allowed.
+func protect(router *mux.Router, config RouterConfig, role mux.MiddlewareFunc) {
+	if !config.EnableAuth {
+		return
+	}
+	router.Use(middleware.AuthMiddleware(config.AuthConfig))
+	if role != nil {
+		router.Use(role)
+	}
+}
+
 // setupAPIv1Routes configures /api/v1 routes (backward compatibility)
 func setupAPIv1Routes(router *mux.Router, config RouterConfig) {
 	v1 := router.PathPrefix("/api/v1").Subrouter()
@@ -424,6 +518,15 @@ func setupHistoryRoutes(router *mux.Router, config RouterConfig) {
 	hist.HandleFunc("/recent", PlaceholderHandler("GetRecentAlerts")).Methods("GET")
 }
 
+// ServiceUnavailableHandler returns a handler for routes whose backing component
+// is not configured in this deployment
+func ServiceUnavailableHandler(component string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		apierrors.WriteError(w, apierrors.ServiceUnavailableError(component).
+			WithRequestID(middleware.GetRequestID(r.Context())))
+	}
+}
+
 // PlaceholderHandler returns a placeholder handler for routes not yet implemented
 // This allows router to compile and be tested while handlers are being migrated
 func PlaceholderHandler(handlerName string) http.HandlerFunc {
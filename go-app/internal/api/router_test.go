@@ -0,0 +1,208 @@
+package api
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vitaliisemenov/alert-history/internal/api/middleware"
+	"github.com/vitaliisemenov/alert-history/internal/business/publishing"
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	infrapub "github.com/vitaliisemenov/alert-history/internal/infrastructure/publishing"
+)
+
+// staticDiscovery is a fixed-target TargetDiscoveryManager
+type staticDiscovery struct {
+	targets []*core.PublishingTarget
+}
+
+func (d *staticDiscovery) DiscoverTargets(ctx context.Context) error { return nil }
+
+func (d *staticDiscovery) GetTarget(name string) (*core.PublishingTarget, error) {
+	for _, t := range d.targets {
+		if t.Name == name {
+			return t, nil
+		}
+	}
+	return nil, publishing.NewTargetNotFoundError(name)
+}
+
+func (d *staticDiscovery) ListTargets() []*core.PublishingTarget { return d.targets }
+
+func (d *staticDiscovery) GetTargetsByType(targetType string) []*core.PublishingTarget { return nil }
+
+func (d *staticDiscovery) GetTargetCount() int { return len(d.targets) }
+
+func (d *staticDiscovery) GetStats() publishing.DiscoveryStats { return publishing.DiscoveryStats{} }
+
+func (d *staticDiscovery) Health(ctx context.Context) error { return nil }
+
+// staticHealthMonitor reports every known target as healthy
+type staticHealthMonitor struct {
+	discovery *staticDiscovery
+}
+
+func (m *staticHealthMonitor) Start() error                     { return nil }
+func (m *staticHealthMonitor) Stop(timeout time.Duration) error { return nil }
+
+func (m *staticHealthMonitor) GetHealth(ctx context.Context) ([]publishing.TargetHealthStatus, error) {
+	return []publishing.TargetHealthStatus{}, nil
+}
+
+func (m *staticHealthMonitor) GetHealthByName(ctx context.Context, name string) (*publishing.TargetHealthStatus, error) {
+	if _, err := m.discovery.GetTarget(name); err != nil {
+		return nil, err
+	}
+	return &publishing.TargetHealthStatus{TargetName: name, Status: publishing.HealthStatusHealthy}, nil
+}
+
+func (m *staticHealthMonitor) CheckNow(ctx context.Context, name string) (*publishing.TargetHealthStatus, error) {
+	return m.GetHealthByName(ctx, name)
+}
+
+func (m *staticHealthMonitor) GetStats(ctx context.Context) (*publishing.HealthStats, error) {
+	return &publishing.HealthStats{}, nil
+}
+
+// idleRefreshManager accepts every manual refresh
+type idleRefreshManager struct{}
+
+func (idleRefreshManager) Start() error                     { return nil }
+func (idleRefreshManager) Stop(timeout time.Duration) error { return nil }
+func (idleRefreshManager) RefreshNow() error                { return nil }
+func (idleRefreshManager) GetStatus() publishing.RefreshStatus {
+	return publishing.RefreshStatus{State: publishing.RefreshStateIdle}
+}
+
+// emptyDLQ is a DLQRepository without entries
+type emptyDLQ struct{}
+
+func (emptyDLQ) Write(ctx context.Context, job *infrapub.PublishingJob) error { return nil }
+func (emptyDLQ) Read(ctx context.Context, filters infrapub.DLQFilters) ([]*infrapub.DLQEntry, error) {
+	return []*infrapub.DLQEntry{}, nil
+}
+func (emptyDLQ) Replay(ctx context.Context, id uuid.UUID) error {
+	return infrapub.ErrDLQEntryNotFound
+}
+func (emptyDLQ) Purge(ctx context.Context, olderThan time.Duration) (int64, error) { return 0, nil }
+func (emptyDLQ) GetStats(ctx context.Context) (*infrapub.DLQStats, error) {
+	return &infrapub.DLQStats{}, nil
+}
+
+func newTestRouterConfig() RouterConfig {
+	config := DefaultRouterConfig(slog.Default())
+	config.EnableRateLimit = false
+	config.EnableCompression = false
+	config.EnableMetrics = false
+	config.AuthConfig.APIKeys = map[string]*middleware.User{
+		"viewer-key":   {ID: "v", Username: "viewer", Role: middleware.RoleViewer},
+		"operator-key": {ID: "o", Username: "operator", Role: middleware.RoleOperator},
+		"admin-key":    {ID: "a", Username: "admin", Role: middleware.RoleAdmin},
+	}
+	return config
+}
+
+func TestNewRouter_PublishingRoutes(t *testing.T) {
+	discovery := &staticDiscovery{targets: []*core.PublishingTarget{
+		{Name: "slack-ops", Type: "slack", URL: "https://hooks.slack.com/x", Enabled: true},
+	}}
+
+	config := newTestRouterConfig()
+	config.TargetDiscoveryManager = discovery
+	config.HealthMonitor = &staticHealthMonitor{discovery: discovery}
+	config.PublishingQueue = infrapub.NewPublishingQueue(nil, emptyDLQ{}, infrapub.NewLRUJobTrackingStore(10),
+		infrapub.DefaultPublishingQueueConfig(), nil, nil, nil)
+	config.RefreshManager = idleRefreshManager{}
+	config.PublishingCoordinator = infrapub.NewPublishingCoordinator(config.PublishingQueue, discovery, nil,
+		infrapub.DefaultCoordinatorConfig(), nil)
+
+	router := NewRouter(config)
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		apiKey     string
+		wantStatus int
+	}{
+		// Public
+		{name: "list_targets", method: "GET", path: "/api/v2/publishing/targets", wantStatus: http.StatusOK},
+		{name: "get_target", method: "GET", path: "/api/v2/publishing/targets/slack-ops", wantStatus: http.StatusOK},
+		{name: "get_target_missing", method: "GET", path: "/api/v2/publishing/targets/nope", wantStatus: http.StatusNotFound},
+		{name: "targets_health", method: "GET", path: "/api/v2/publishing/targets/health", wantStatus: http.StatusOK},
+		{name: "targets_health_stats", method: "GET", path: "/api/v2/publishing/targets/health/stats", wantStatus: http.StatusOK},
+		{name: "target_health", method: "GET", path: "/api/v2/publishing/targets/health/slack-ops", wantStatus: http.StatusOK},
+		{name: "queue_status", method: "GET", path: "/api/v2/publishing/queue/status", wantStatus: http.StatusOK},
+		{name: "queue_stats", method: "GET", path: "/api/v2/publishing/queue/stats", wantStatus: http.StatusOK},
+
+		// Viewer+
+		{name: "jobs_unauthenticated", method: "GET", path: "/api/v2/publishing/queue/jobs", wantStatus: http.StatusUnauthorized},
+		{name: "jobs_viewer", method: "GET", path: "/api/v2/publishing/queue/jobs", apiKey: "viewer-key", wantStatus: http.StatusOK},
+		{name: "job_missing", method: "GET", path: "/api/v2/publishing/queue/jobs/abc", apiKey: "viewer-key", wantStatus: http.StatusNotFound},
+
+		// Operator+
+		{name: "health_check_viewer", method: "POST", path: "/api/v2/publishing/targets/health/slack-ops/check", apiKey: "viewer-key", wantStatus: http.StatusForbidden},
+		{name: "health_check_operator", method: "POST", path: "/api/v2/publishing/targets/health/slack-ops/check", apiKey: "operator-key", wantStatus: http.StatusOK},
+		{name: "dlq_list_viewer", method: "GET", path: "/api/v2/publishing/dlq", apiKey: "viewer-key", wantStatus: http.StatusForbidden},
+		{name: "dlq_list_operator", method: "GET", path: "/api/v2/publishing/dlq", apiKey: "operator-key", wantStatus: http.StatusOK},
+		{name: "target_test_viewer", method: "POST", path: "/api/v2/publishing/targets/slack-ops/test", apiKey: "viewer-key", wantStatus: http.StatusForbidden},
+		{name: "target_test_missing", method: "POST", path: "/api/v2/publishing/targets/nope/test", apiKey: "operator-key", wantStatus: http.StatusNotFound},
+
+		// Admin
+		{name: "dlq_purge_operator", method: "DELETE", path: "/api/v2/publishing/dlq/purge", apiKey: "operator-key", wantStatus: http.StatusForbidden},
+		{name: "dlq_purge_admin", method: "DELETE", path: "/api/v2/publishing/dlq/purge", apiKey: "admin-key", wantStatus: http.StatusOK},
+		{name: "targets_refresh_operator", method: "POST", path: "/api/v2/publishing/targets/refresh", apiKey: "operator-key", wantStatus: http.StatusForbidden},
+		{name: "targets_refresh_admin", method: "POST", path: "/api/v2/publishing/targets/refresh", apiKey: "admin-key", wantStatus: http.StatusAccepted},
+		{name: "dlq_replay_admin", method: "POST", path: "/api/v2/publishing/dlq/" + uuid.NewString() + "/replay", apiKey: "admin-key", wantStatus: http.StatusNotFound},
+
+		// Components not configured
+		{name: "parallel_status_unavailable", method: "GET", path: "/api/v2/publishing/parallel/status", wantStatus: http.StatusServiceUnavailable},
+		{name: "metrics_unavailable", method: "GET", path: "/api/v2/publishing/metrics/stats", wantStatus: http.StatusServiceUnavailable},
+		{name: "parallel_publish_unauthenticated", method: "POST", path: "/api/v2/publishing/parallel/all", wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.apiKey != "" {
+				req.Header.Set(middleware.AuthorizationHeader, "ApiKey "+tt.apiKey)
+			}
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+		})
+	}
+}
+
+func TestNewRouter_PublishingRoutesUnconfigured(t *testing.T) {
+	router := NewRouter(newTestRouterConfig())
+
+	for _, path := range []string{
+		"/api/v2/publishing/targets",
+		"/api/v2/publishing/targets/health",
+		"/api/v2/publishing/queue/status",
+	} {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest("GET", path, nil))
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code, path)
+	}
+
+	for path, apiKey := range map[string]string{
+		"/api/v2/publishing/targets/refresh":        "admin-key",
+		"/api/v2/publishing/targets/slack-ops/test": "operator-key",
+	} {
+		req := httptest.NewRequest("POST", path, nil)
+		req.Header.Set(middleware.AuthorizationHeader, "ApiKey "+apiKey)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code, path)
+	}
+}
@@ -0,0 +1,167 @@
+// Package escalation implements escalation policies for unacknowledged
+// alerts: ordered levels of receivers notified after a delay, e.g.
+//
+//	escalation_policies:
+//	  - name: critical-oncall
+//	    match:
+//	      severity: critical
+//	    levels:
+//	      - receivers: ["slack-team"]
+//	      - delay: 10m
+//	        receivers: ["pagerduty-primary"]
+//	      - delay: 20m
+//	        receivers: ["pagerduty-secondary"]
+//
+// Delays are relative to the start of the escalation (the first time the
+// firing alert is published). Escalation stops when the alert resolves or
+// is acknowledged.
+package escalation
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure/grouping"
+)
+
+// Config is the escalation policy configuration file.
+type Config struct {
+	// Policies are evaluated in order, the first matching policy applies
+	Policies []*Policy `yaml:"escalation_policies"`
+}
+
+// Policy escalates the alerts matching all of Match and MatchRE through
+// its levels.
+type Policy struct {
+	// Name identifies the policy (unique, stored with escalations)
+	Name string `yaml:"name"`
+
+	// Match specifies exact label matches (e.g. severity: critical)
+	Match map[string]string `yaml:"match,omitempty"`
+
+	// MatchRE specifies regex label matches (anchored)
+	MatchRE map[string]string `yaml:"match_re,omitempty"`
+
+	// Levels are notified in order (delays must not decrease)
+	Levels []*Level `yaml:"levels"`
+
+	matchRE map[string]*regexp.Regexp
+}
+
+// Level is a set of receivers notified once the delay has elapsed since the
+// start of the escalation.
+type Level struct {
+	Delay     grouping.Duration `yaml:"delay,omitempty"`
+	Receivers []string          `yaml:"receivers"`
+}
+
+// LoadConfig reads and validates an escalation policy file.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read escalation config: %w", err)
+	}
+	return ParseConfig(data)
+}
+
+// ParseConfig parses and validates an escalation policy configuration.
+func ParseConfig(data []byte) (*Config, error) {
+	var config Config
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("parse escalation config: %w", err)
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// Validate checks the policies and compiles their regex matchers.
+func (c *Config) Validate() error {
+	names := make(map[string]struct{}, len(c.Policies))
+	for i, policy := range c.Policies {
+		if policy == nil || policy.Name == "" {
+			return fmt.Errorf("escalation policy %d: name is required", i)
+		}
+		if _, ok := names[policy.Name]; ok {
+			return fmt.Errorf("escalation policy %q: duplicate name", policy.Name)
+		}
+		names[policy.Name] = struct{}{}
+
+		if err := policy.validate(); err != nil {
+			return fmt.Errorf("escalation policy %q: %w", policy.Name, err)
+		}
+	}
+	return nil
+}
+
+// PolicyFor returns the first policy matching the labels, or nil.
+func (c *Config) PolicyFor(labels map[string]string) *Policy {
+	for _, policy := range c.Policies {
+		if policy.Matches(labels) {
+			return policy
+		}
+	}
+	return nil
+}
+
+// Policy returns the policy with the given name, or nil.
+func (c *Config) Policy(name string) *Policy {
+	for _, policy := range c.Policies {
+		if policy.Name == name {
+			return policy
+		}
+	}
+	return nil
+}
+
+// Matches reports whether the labels match all matchers of the policy.
+// A policy without matchers matches every alert.
+func (p *Policy) Matches(labels map[string]string) bool {
+	for name, value := range p.Match {
+		if labels[name] != value {
+			return false
+		}
+	}
+	for name, re := range p.matchRE {
+		if !re.MatchString(labels[name]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *Policy) validate() error {
+	if len(p.Levels) == 0 {
+		return fmt.Errorf("at least one level is required")
+	}
+	for i, level := range p.Levels {
+		if level == nil || len(level.Receivers) == 0 {
+			return fmt.Errorf("level %d: at least one receiver is required", i)
+		}
+		for _, receiver := range level.Receivers {
+			if receiver == "" {
+				return fmt.Errorf("level %d: empty receiver name", i)
+			}
+		}
+		if level.Delay.Duration < 0 {
+			return fmt.Errorf("level %d: negative delay", i)
+		}
+		if i > 0 && level.Delay.Duration < p.Levels[i-1].Delay.Duration {
+			return fmt.Errorf("level %d: delay %s is shorter than the previous level", i, level.Delay.Duration)
+		}
+	}
+
+	p.matchRE = make(map[string]*regexp.Regexp, len(p.MatchRE))
+	for name, pattern := range p.MatchRE {
+		re, err := regexp.Compile("^(?:" + pattern + ")$")
+		if err != nil {
+			return fmt.Errorf("match_re %s: %w", name, err)
+		}
+		p.matchRE[name] = re
+	}
+	return nil
+}
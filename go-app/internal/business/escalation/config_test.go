@@ -0,0 +1,91 @@
+package escalation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseConfig(t *testing.T) {
+	config, err := ParseConfig([]byte(`
+escalation_policies:
+  - name: critical-oncall
+    match:
+      severity: critical
+    match_re:
+      team: "db|infra"
+    levels:
+      - receivers: ["slack-team"]
+      - delay: 10m
+        receivers: ["pagerduty-primary"]
+      - delay: 20m
+        receivers: ["pagerduty-secondary", "slack-managers"]
+  - name: catch-all
+    levels:
+      - delay: 1h
+        receivers: ["email"]
+`))
+	require.NoError(t, err)
+	require.Len(t, config.Policies, 2)
+
+	policy := config.Policies[0]
+	require.Len(t, policy.Levels, 3)
+	assert.Equal(t, time.Duration(0), policy.Levels[0].Delay.Duration)
+	assert.Equal(t, 10*time.Minute, policy.Levels[1].Delay.Duration)
+	assert.Equal(t, []string{"pagerduty-secondary", "slack-managers"}, policy.Levels[2].Receivers)
+
+	assert.Equal(t, "critical-oncall", config.PolicyFor(map[string]string{"severity": "critical", "team": "db"}).Name)
+	assert.Equal(t, "catch-all", config.PolicyFor(map[string]string{"severity": "critical", "team": "dbx"}).Name, "match_re is anchored")
+	assert.Equal(t, "catch-all", config.PolicyFor(map[string]string{"severity": "warning"}).Name)
+	assert.Nil(t, config.Policy("unknown"))
+}
+
+func TestParseConfig_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		yaml string
+	}{
+		{"missing name", `
+escalation_policies:
+  - levels: [{receivers: [a]}]`},
+		{"duplicate name", `
+escalation_policies:
+  - name: p
+    levels: [{receivers: [a]}]
+  - name: p
+    levels: [{receivers: [b]}]`},
+		{"no levels", `
+escalation_policies:
+  - name: p`},
+		{"no receivers", `
+escalation_policies:
+  - name: p
+    levels: [{delay: 5m}]`},
+		{"decreasing delay", `
+escalation_policies:
+  - name: p
+    levels:
+      - delay: 10m
+        receivers: [a]
+      - delay: 5m
+        receivers: [b]`},
+		{"invalid regex", `
+escalation_policies:
+  - name: p
+    match_re: {team: "("}
+    levels: [{receivers: [a]}]`},
+		{"invalid duration", `
+escalation_policies:
+  - name: p
+    levels: [{delay: soon, receivers: [a]}]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseConfig([]byte(tt.yaml))
+			assert.Error(t, err)
+		})
+	}
+}
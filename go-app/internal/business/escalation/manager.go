@@ -0,0 +1,604 @@
+package escalation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/core/services"
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure/grouping"
+	"github.com/vitaliisemenov/alert-history/internal/realtime"
+)
+
+// EscalationManager escalates firing alerts through the levels of their
+// escalation policy.
+type EscalationManager interface {
+	services.Escalator
+
+	// Status returns the escalation of an alert with its policy levels
+	// (core.ErrEscalationNotFound if the alert was never escalated)
+	Status(ctx context.Context, fingerprint string) (*Status, error)
+
+	// ListActive returns the escalations that are still notifying levels
+	ListActive(ctx context.Context) ([]*Status, error)
+}
+
+// AckLookup returns acknowledgements (implemented by services.AckService).
+type AckLookup interface {
+	GetAck(ctx context.Context, targetType core.AckTargetType, target string) (*core.AlertAck, error)
+}
+
+// ReceiverPublisher delivers notifications to the publishing targets of a
+// named receiver (implemented by services.ReceiverPublisher).
+type ReceiverPublisher interface {
+	services.GroupPublisher
+
+	// HasReceiver reports whether a receiver resolves to publishing targets
+	HasReceiver(receiver string) bool
+}
+
+// EventPublisher publishes escalation changes as real-time events
+// (implemented by realtime.EventPublisher).
+type EventPublisher interface {
+	PublishEscalationEvent(eventType string, escalation *core.AlertEscalation, receivers []string) error
+}
+
+// Status is the escalation of an alert together with its policy levels.
+type Status struct {
+	*core.AlertEscalation
+	Levels []LevelStatus `json:"levels"`
+}
+
+// LevelStatus is a policy level of an escalation.
+type LevelStatus struct {
+	Level     int       `json:"level"`
+	Receivers []string  `json:"receivers"`
+	Delay     string    `json:"delay"`
+	DueAt     time.Time `json:"due_at"`
+	Notified  bool      `json:"notified"`
+}
+
+// ManagerConfig holds configuration for DefaultEscalationManager.
+type ManagerConfig struct {
+	Config     *Config                   // required: escalation policies
+	Repository core.EscalationRepository // required: escalation state
+	Publisher  ReceiverPublisher         // required: level delivery to receivers
+	Storage    core.AlertStorage         // optional: current alert status and labels at delivery
+	Acks       AckLookup                 // optional: acknowledged alerts stop escalating
+
+	// Retention is how long stopped/completed escalations are kept (default: 7d)
+	Retention time.Duration
+
+	Logger *slog.Logger
+}
+
+// DefaultEscalationManager implements EscalationManager.
+//
+// Each active escalation has a timer for its next level. State is stored in
+// the repository before the level is delivered, so timers restored after a
+// restart (RestoreEscalations) neither skip nor repeat levels; levels that
+// fell due while the service was down are delivered right away.
+//
+// Before each level the alert is checked: a resolved alert (storage) or an
+// acknowledged one (Acks) stops the escalation instead.
+//
+// Thread-safety: All methods are safe for concurrent use.
+type DefaultEscalationManager struct {
+	config    *Config
+	repo      core.EscalationRepository
+	publisher ReceiverPublisher
+	storage   core.AlertStorage
+	acks      AckLookup
+	retention time.Duration
+	logger    *slog.Logger
+
+	// now is overridden in tests
+	now func() time.Time
+
+	// mu serialises state transitions and protects timers/events
+	mu     sync.Mutex
+	timers map[string]*time.Timer
+	events EventPublisher
+
+	stopOnce sync.Once
+	stopCh   chan struct{}
+}
+
+// levelTimeout bounds the delivery of a level.
+const levelTimeout = 30 * time.Second
+
+// NewDefaultEscalationManager creates a new escalation manager. Call
+// RestoreEscalations once at startup and Start for the cleanup of old
+// escalations.
+func NewDefaultEscalationManager(config ManagerConfig) (*DefaultEscalationManager, error) {
+	if config.Config == nil {
+		return nil, fmt.Errorf("escalation config is required")
+	}
+	if config.Repository == nil {
+		return nil, fmt.Errorf("escalation repository is required")
+	}
+	if config.Publisher == nil {
+		return nil, fmt.Errorf("publisher is required")
+	}
+	if err := checkReceivers(config.Config, config.Publisher); err != nil {
+		return nil, err
+	}
+	if config.Retention <= 0 {
+		config.Retention = 7 * 24 * time.Hour
+	}
+	if config.Logger == nil {
+		config.Logger = slog.Default()
+	}
+
+	return &DefaultEscalationManager{
+		config:    config.Config,
+		repo:      config.Repository,
+		publisher: config.Publisher,
+		storage:   config.Storage,
+		acks:      config.Acks,
+		retention: config.Retention,
+		logger:    config.Logger.With("component", "escalation_manager"),
+		now:       time.Now,
+		timers:    make(map[string]*time.Timer),
+		stopCh:    make(chan struct{}),
+	}, nil
+}
+
+// checkReceivers rejects policies with level receivers the publisher cannot
+// resolve to publishing targets.
+func checkReceivers(config *Config, publisher ReceiverPublisher) error {
+	for _, policy := range config.Policies {
+		for i, level := range policy.Levels {
+			for _, receiver := range level.Receivers {
+				if !publisher.HasReceiver(receiver) {
+					return fmt.Errorf("escalation policy %q: level %d: unknown receiver %q", policy.Name, i, receiver)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// SetEventPublisher enables real-time escalation events.
+func (m *DefaultEscalationManager) SetEventPublisher(events EventPublisher) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.events = events
+}
+
+// Escalate implements services.Escalator.Escalate.
+//
+// A new escalation starts unless the alert is already escalating (or has
+// completed its levels in the current firing episode), or it was stopped
+// by an acknowledgement that is still in effect.
+func (m *DefaultEscalationManager) Escalate(ctx context.Context, alert *core.Alert) error {
+	policy := m.config.PolicyFor(alert.Labels)
+	if policy == nil {
+		return nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	existing, err := m.repo.GetEscalation(ctx, alert.Fingerprint)
+	switch {
+	case err == nil:
+		switch existing.State {
+		case core.EscalationActive, core.EscalationCompleted:
+			return nil
+		case core.EscalationAcknowledged:
+			if m.isAcknowledged(ctx, alert.Fingerprint, now) {
+				return nil
+			}
+		}
+	case !errors.Is(err, core.ErrEscalationNotFound):
+		return fmt.Errorf("get escalation: %w", err)
+	}
+
+	nextAt := now.Add(policy.Levels[0].Delay.Duration)
+	escalation := &core.AlertEscalation{
+		Fingerprint: alert.Fingerprint,
+		AlertName:   alert.AlertName,
+		Labels:      alert.Labels,
+		Policy:      policy.Name,
+		State:       core.EscalationActive,
+		StartedAt:   now,
+		NextAt:      &nextAt,
+		UpdatedAt:   now,
+	}
+	if err := m.repo.SaveEscalation(ctx, escalation); err != nil {
+		return fmt.Errorf("save escalation: %w", err)
+	}
+	m.schedule(escalation.Fingerprint, nextAt.Sub(now))
+
+	m.logger.Info("Escalation started",
+		"fingerprint", alert.Fingerprint,
+		"alert", alert.AlertName,
+		"policy", policy.Name,
+		"levels", len(policy.Levels))
+	m.publishEvent(realtime.EventTypeEscalationStarted, escalation, nil)
+	return nil
+}
+
+// Resolve implements services.Escalator.Resolve.
+func (m *DefaultEscalationManager) Resolve(ctx context.Context, fingerprint string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	escalation, err := m.repo.GetEscalation(ctx, fingerprint)
+	if errors.Is(err, core.ErrEscalationNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("get escalation: %w", err)
+	}
+	if escalation.State == core.EscalationResolved {
+		return nil
+	}
+	return m.stop(ctx, escalation, core.EscalationResolved)
+}
+
+// Status implements EscalationManager.Status. An active escalation whose
+// alert has been acknowledged in the meantime is stopped first.
+func (m *DefaultEscalationManager) Status(ctx context.Context, fingerprint string) (*Status, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	escalation, err := m.repo.GetEscalation(ctx, fingerprint)
+	if err != nil {
+		return nil, err
+	}
+	if escalation.IsActive() && m.isAcknowledged(ctx, fingerprint, m.now()) {
+		if err := m.stop(ctx, escalation, core.EscalationAcknowledged); err != nil {
+			return nil, err
+		}
+	}
+	return m.status(escalation), nil
+}
+
+// ListActive implements EscalationManager.ListActive.
+func (m *DefaultEscalationManager) ListActive(ctx context.Context) ([]*Status, error) {
+	escalations, err := m.repo.ListEscalations(ctx, core.EscalationActive)
+	if err != nil {
+		return nil, fmt.Errorf("list escalations: %w", err)
+	}
+
+	result := make([]*Status, 0, len(escalations))
+	for _, escalation := range escalations {
+		result = append(result, m.status(escalation))
+	}
+	return result, nil
+}
+
+// RestoreEscalations re-arms the timers of active escalations after a
+// restart. Levels that fell due while the service was down (missed) are
+// delivered immediately. Replicas sharing the repository all restore the
+// same escalations; each level is claimed (AdvanceEscalation) before
+// delivery, so it is delivered by one replica only.
+func (m *DefaultEscalationManager) RestoreEscalations(ctx context.Context) (restored, missed int, err error) {
+	escalations, err := m.repo.ListEscalations(ctx, core.EscalationActive)
+	if err != nil {
+		return 0, 0, fmt.Errorf("list escalations: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	for _, escalation := range escalations {
+		delay := time.Duration(0)
+		if escalation.NextAt != nil {
+			delay = escalation.NextAt.Sub(now)
+		}
+		if delay <= 0 {
+			delay = 0
+			missed++
+		}
+		m.schedule(escalation.Fingerprint, delay)
+		restored++
+	}
+
+	m.logger.Info("Escalations restored", "restored", restored, "missed", missed)
+	return restored, missed, nil
+}
+
+// Start starts the periodic cleanup of escalations that are no longer active.
+func (m *DefaultEscalationManager) Start(ctx context.Context) {
+	go m.cleanupLoop(ctx)
+}
+
+// Stop stops the escalation timers and the cleanup loop. Pending levels
+// are restored from the repository on the next start.
+func (m *DefaultEscalationManager) Stop() {
+	m.stopOnce.Do(func() {
+		close(m.stopCh)
+
+		m.mu.Lock()
+		for fingerprint, timer := range m.timers {
+			timer.Stop()
+			delete(m.timers, fingerprint)
+		}
+		m.mu.Unlock()
+	})
+}
+
+func (m *DefaultEscalationManager) cleanupLoop(ctx context.Context) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	for {
+		deleted, err := m.repo.DeleteInactiveEscalations(ctx, m.now().Add(-m.retention))
+		if err != nil {
+			m.logger.Warn("Failed to clean up escalations", "error", err)
+		} else if deleted > 0 {
+			m.logger.Debug("Cleaned up escalations", "deleted", deleted)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-m.stopCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// schedule arms the timer of the next level of an escalation (mu held).
+func (m *DefaultEscalationManager) schedule(fingerprint string, delay time.Duration) {
+	select {
+	case <-m.stopCh:
+		return
+	default:
+	}
+
+	if timer, ok := m.timers[fingerprint]; ok {
+		timer.Stop()
+	}
+	m.timers[fingerprint] = time.AfterFunc(delay, func() {
+		m.fire(fingerprint)
+	})
+}
+
+// fire delivers the next level of an escalation, unless the alert resolved
+// or has been acknowledged.
+func (m *DefaultEscalationManager) fire(fingerprint string) {
+	ctx, cancel := context.WithTimeout(context.Background(), levelTimeout)
+	defer cancel()
+
+	m.mu.Lock()
+	delete(m.timers, fingerprint)
+
+	escalation, err := m.repo.GetEscalation(ctx, fingerprint)
+	if err != nil {
+		m.mu.Unlock()
+		if !errors.Is(err, core.ErrEscalationNotFound) {
+			m.logger.Warn("Failed to load escalation", "fingerprint", fingerprint, "error", err)
+		}
+		return
+	}
+	if !escalation.IsActive() {
+		m.mu.Unlock()
+		return
+	}
+
+	policy := m.config.Policy(escalation.Policy)
+	if policy == nil || escalation.Level >= len(policy.Levels) {
+		m.logger.Warn("Escalation policy no longer configured, completing escalation",
+			"fingerprint", fingerprint,
+			"policy", escalation.Policy)
+		m.finish(ctx, escalation, core.EscalationCompleted)
+		m.mu.Unlock()
+		return
+	}
+
+	alert := m.currentAlert(ctx, escalation)
+	now := m.now()
+	if alert.Status == core.StatusResolved {
+		m.finish(ctx, escalation, core.EscalationResolved)
+		m.mu.Unlock()
+		return
+	}
+	if m.isAcknowledged(ctx, fingerprint, now) {
+		m.finish(ctx, escalation, core.EscalationAcknowledged)
+		m.mu.Unlock()
+		return
+	}
+
+	// Claim (advance) before delivery: a level is delivered at most once,
+	// also when several replicas restored the same escalation
+	level := policy.Levels[escalation.Level]
+	fromLevel := escalation.Level
+	escalation.Level++
+	escalation.LastNotifiedAt = &now
+	escalation.UpdatedAt = now
+	if escalation.Level < len(policy.Levels) {
+		nextAt := escalation.StartedAt.Add(policy.Levels[escalation.Level].Delay.Duration)
+		escalation.NextAt = &nextAt
+	} else {
+		escalation.State = core.EscalationCompleted
+		escalation.NextAt = nil
+	}
+	claimed, err := m.repo.AdvanceEscalation(ctx, escalation, fromLevel)
+	if err != nil {
+		m.mu.Unlock()
+		m.logger.Error("Failed to save escalation, level not delivered",
+			"fingerprint", fingerprint,
+			"level", fromLevel,
+			"error", err)
+		return
+	}
+	if !claimed {
+		m.logger.Debug("Escalation level claimed by another instance",
+			"fingerprint", fingerprint,
+			"level", fromLevel)
+		m.rescheduleLocked(ctx, fingerprint, now)
+		m.mu.Unlock()
+		return
+	}
+	if escalation.IsActive() {
+		m.schedule(fingerprint, escalation.NextAt.Sub(now))
+	}
+	m.publishEvent(realtime.EventTypeEscalationNotified, escalation, level.Receivers)
+	m.mu.Unlock()
+
+	m.deliver(ctx, escalation, alert, level)
+}
+
+// rescheduleLocked re-arms the timer of an escalation advanced by another
+// instance, so this instance still competes for its next level (mu held).
+func (m *DefaultEscalationManager) rescheduleLocked(ctx context.Context, fingerprint string, now time.Time) {
+	escalation, err := m.repo.GetEscalation(ctx, fingerprint)
+	if err != nil {
+		if !errors.Is(err, core.ErrEscalationNotFound) {
+			m.logger.Warn("Failed to load escalation", "fingerprint", fingerprint, "error", err)
+		}
+		return
+	}
+	if escalation.IsActive() && escalation.NextAt != nil {
+		m.schedule(fingerprint, max(escalation.NextAt.Sub(now), 0))
+	}
+}
+
+// deliver notifies the receivers of a level.
+func (m *DefaultEscalationManager) deliver(ctx context.Context, escalation *core.AlertEscalation, alert *core.Alert, level *Level) {
+	m.logger.Info("Escalating alert",
+		"fingerprint", escalation.Fingerprint,
+		"alert", escalation.AlertName,
+		"policy", escalation.Policy,
+		"level", escalation.Level-1,
+		"receivers", level.Receivers)
+
+	for _, receiver := range level.Receivers {
+		notification := &services.GroupNotification{
+			GroupKey:    grouping.GroupKey("escalation/" + escalation.Policy + "/" + escalation.Fingerprint),
+			Receiver:    receiver,
+			GroupLabels: alert.Labels,
+			Alerts:      []*core.Alert{alert},
+		}
+		if err := m.publisher.PublishGroup(ctx, notification); err != nil {
+			m.logger.Error("Failed to deliver escalation level",
+				"fingerprint", escalation.Fingerprint,
+				"receiver", receiver,
+				"error", err)
+		}
+	}
+}
+
+// currentAlert returns the stored alert, or one rebuilt from the escalation
+// when storage is unavailable.
+func (m *DefaultEscalationManager) currentAlert(ctx context.Context, escalation *core.AlertEscalation) *core.Alert {
+	if m.storage != nil {
+		alert, err := m.storage.GetAlertByFingerprint(ctx, escalation.Fingerprint)
+		if err == nil && alert != nil {
+			return alert
+		}
+		if err != nil && !errors.Is(err, core.ErrAlertNotFound) {
+			m.logger.Warn("Failed to load escalated alert, using escalation labels",
+				"fingerprint", escalation.Fingerprint,
+				"error", err)
+		}
+	}
+
+	return &core.Alert{
+		Fingerprint: escalation.Fingerprint,
+		AlertName:   escalation.AlertName,
+		Status:      core.StatusFiring,
+		Labels:      escalation.Labels,
+		StartsAt:    escalation.StartedAt,
+	}
+}
+
+// stop stops an escalation and saves it (mu held).
+func (m *DefaultEscalationManager) stop(ctx context.Context, escalation *core.AlertEscalation, state core.EscalationState) error {
+	if timer, ok := m.timers[escalation.Fingerprint]; ok {
+		timer.Stop()
+		delete(m.timers, escalation.Fingerprint)
+	}
+
+	now := m.now()
+	escalation.State = state
+	escalation.NextAt = nil
+	escalation.StoppedAt = &now
+	escalation.UpdatedAt = now
+	if err := m.repo.SaveEscalation(ctx, escalation); err != nil {
+		return fmt.Errorf("save escalation: %w", err)
+	}
+
+	m.logger.Info("Escalation stopped",
+		"fingerprint", escalation.Fingerprint,
+		"policy", escalation.Policy,
+		"state", state,
+		"levels_notified", escalation.Level)
+	m.publishEvent(realtime.EventTypeEscalationStopped, escalation, nil)
+	return nil
+}
+
+// finish stops an escalation from its timer (mu held).
+func (m *DefaultEscalationManager) finish(ctx context.Context, escalation *core.AlertEscalation, state core.EscalationState) {
+	if state == core.EscalationCompleted {
+		now := m.now()
+		escalation.State = state
+		escalation.NextAt = nil
+		escalation.UpdatedAt = now
+		if err := m.repo.SaveEscalation(ctx, escalation); err != nil {
+			m.logger.Warn("Failed to save escalation", "fingerprint", escalation.Fingerprint, "error", err)
+		}
+		return
+	}
+	if err := m.stop(ctx, escalation, state); err != nil {
+		m.logger.Warn("Failed to stop escalation", "fingerprint", escalation.Fingerprint, "error", err)
+	}
+}
+
+// isAcknowledged reports whether the alert has an acknowledgement in effect.
+func (m *DefaultEscalationManager) isAcknowledged(ctx context.Context, fingerprint string, t time.Time) bool {
+	if m.acks == nil {
+		return false
+	}
+	ack, err := m.acks.GetAck(ctx, core.AckTargetAlert, fingerprint)
+	if err != nil {
+		if !errors.Is(err, core.ErrAckNotFound) {
+			m.logger.Warn("Acknowledgement lookup failed, escalation continues",
+				"fingerprint", fingerprint,
+				"error", err)
+		}
+		return false
+	}
+	return ack.IsActive(t)
+}
+
+// status builds the API view of an escalation.
+func (m *DefaultEscalationManager) status(escalation *core.AlertEscalation) *Status {
+	status := &Status{AlertEscalation: escalation, Levels: []LevelStatus{}}
+	policy := m.config.Policy(escalation.Policy)
+	if policy == nil {
+		return status
+	}
+
+	for i, level := range policy.Levels {
+		status.Levels = append(status.Levels, LevelStatus{
+			Level:     i,
+			Receivers: level.Receivers,
+			Delay:     level.Delay.Duration.String(),
+			DueAt:     escalation.StartedAt.Add(level.Delay.Duration),
+			Notified:  i < escalation.Level,
+		})
+	}
+	return status
+}
+
+// publishEvent publishes a real-time escalation event (mu held).
+func (m *DefaultEscalationManager) publishEvent(eventType string, escalation *core.AlertEscalation, receivers []string) {
+	if m.events == nil {
+		return
+	}
+	if err := m.events.PublishEscalationEvent(eventType, escalation, receivers); err != nil {
+		m.logger.Debug("Failed to publish escalation event", "type", eventType, "error", err)
+	}
+}
@@ -0,0 +1,333 @@
+package escalation
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/core/services"
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure/grouping"
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure/repository"
+)
+
+// recordingPublisher records the receivers notified per alert. Receivers in
+// unknown cannot be resolved.
+type recordingPublisher struct {
+	mu        sync.Mutex
+	receivers []string
+	unknown   map[string]bool
+}
+
+func (p *recordingPublisher) HasReceiver(receiver string) bool {
+	return !p.unknown[receiver]
+}
+
+func (p *recordingPublisher) PublishGroup(ctx context.Context, notification *services.GroupNotification) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.receivers = append(p.receivers, notification.Receiver)
+	return nil
+}
+
+func (p *recordingPublisher) snapshot() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.receivers...)
+}
+
+// fakeAcks acknowledges the alerts in acked.
+type fakeAcks struct {
+	mu    sync.Mutex
+	acked map[string]bool
+}
+
+func (f *fakeAcks) GetAck(ctx context.Context, targetType core.AckTargetType, target string) (*core.AlertAck, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if !f.acked[target] {
+		return nil, core.ErrAckNotFound
+	}
+	return &core.AlertAck{TargetType: targetType, Target: target, Acknowledged: true}, nil
+}
+
+func (f *fakeAcks) ack(fingerprint string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.acked[fingerprint] = true
+}
+
+// recordingEvents records published escalation event types.
+type recordingEvents struct {
+	mu    sync.Mutex
+	types []string
+}
+
+func (e *recordingEvents) PublishEscalationEvent(eventType string, escalation *core.AlertEscalation, receivers []string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.types = append(e.types, eventType)
+	return nil
+}
+
+func (e *recordingEvents) snapshot() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]string(nil), e.types...)
+}
+
+func testConfig(t *testing.T, delays ...time.Duration) *Config {
+	t.Helper()
+	policy := &Policy{Name: "critical", Match: map[string]string{"severity": "critical"}}
+	for i, delay := range delays {
+		policy.Levels = append(policy.Levels, &Level{
+			Delay:     grouping.Duration{Duration: delay},
+			Receivers: []string{[]string{"slack-team", "pagerduty-primary", "pagerduty-secondary"}[i]},
+		})
+	}
+	config := &Config{Policies: []*Policy{policy}}
+	require.NoError(t, config.Validate())
+	return config
+}
+
+func newTestManager(t *testing.T, config *Config, repo core.EscalationRepository, acks AckLookup) (*DefaultEscalationManager, *recordingPublisher) {
+	t.Helper()
+	publisher := &recordingPublisher{}
+	manager, err := NewDefaultEscalationManager(ManagerConfig{
+		Config:     config,
+		Repository: repo,
+		Publisher:  publisher,
+		Acks:       acks,
+	})
+	require.NoError(t, err)
+	t.Cleanup(manager.Stop)
+	return manager, publisher
+}
+
+func criticalAlert(fingerprint string) *core.Alert {
+	return &core.Alert{
+		Fingerprint: fingerprint,
+		AlertName:   "DiskFull",
+		Status:      core.StatusFiring,
+		Labels:      map[string]string{"alertname": "DiskFull", "severity": "critical"},
+		StartsAt:    time.Now(),
+	}
+}
+
+func TestNewDefaultEscalationManager_Validation(t *testing.T) {
+	_, err := NewDefaultEscalationManager(ManagerConfig{})
+	assert.Error(t, err)
+
+	_, err = NewDefaultEscalationManager(ManagerConfig{Config: &Config{}, Repository: repository.NewMemoryEscalationRepository()})
+	assert.Error(t, err, "publisher is required")
+
+	_, err = NewDefaultEscalationManager(ManagerConfig{
+		Config:     testConfig(t, 0, time.Minute),
+		Repository: repository.NewMemoryEscalationRepository(),
+		Publisher:  &recordingPublisher{unknown: map[string]bool{"pagerduty-primary": true}},
+	})
+	assert.ErrorContains(t, err, `unknown receiver "pagerduty-primary"`)
+}
+
+func TestEscalationManager_NotifiesLevelsInOrder(t *testing.T) {
+	repo := repository.NewMemoryEscalationRepository()
+	manager, publisher := newTestManager(t, testConfig(t, 0, 20*time.Millisecond, 40*time.Millisecond), repo, nil)
+	events := &recordingEvents{}
+	manager.SetEventPublisher(events)
+	ctx := context.Background()
+
+	require.NoError(t, manager.Escalate(ctx, criticalAlert("fp-1")))
+	require.NoError(t, manager.Escalate(ctx, criticalAlert("fp-1")), "Repeated firing keeps the escalation")
+
+	assert.Eventually(t, func() bool {
+		return len(publisher.snapshot()) == 3
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []string{"slack-team", "pagerduty-primary", "pagerduty-secondary"}, publisher.snapshot())
+
+	status, err := manager.Status(ctx, "fp-1")
+	require.NoError(t, err)
+	assert.Equal(t, core.EscalationCompleted, status.State)
+	assert.Equal(t, 3, status.Level)
+	assert.Nil(t, status.NextAt)
+	require.Len(t, status.Levels, 3)
+	assert.True(t, status.Levels[2].Notified)
+	assert.Equal(t, []string{
+		"escalation_started",
+		"escalation_notified",
+		"escalation_notified",
+		"escalation_notified",
+	}, events.snapshot())
+
+	// Completed escalations restart only after the alert resolved
+	require.NoError(t, manager.Escalate(ctx, criticalAlert("fp-1")))
+	time.Sleep(20 * time.Millisecond)
+	assert.Len(t, publisher.snapshot(), 3)
+}
+
+func TestEscalationManager_NonMatchingAlertNotEscalated(t *testing.T) {
+	repo := repository.NewMemoryEscalationRepository()
+	manager, _ := newTestManager(t, testConfig(t, 0), repo, nil)
+
+	alert := criticalAlert("fp-1")
+	alert.Labels["severity"] = "warning"
+	require.NoError(t, manager.Escalate(context.Background(), alert))
+
+	_, err := manager.Status(context.Background(), "fp-1")
+	assert.ErrorIs(t, err, core.ErrEscalationNotFound)
+}
+
+func TestEscalationManager_StopsOnResolve(t *testing.T) {
+	repo := repository.NewMemoryEscalationRepository()
+	manager, publisher := newTestManager(t, testConfig(t, 0, 50*time.Millisecond), repo, nil)
+	ctx := context.Background()
+
+	require.NoError(t, manager.Escalate(ctx, criticalAlert("fp-1")))
+	assert.Eventually(t, func() bool {
+		return len(publisher.snapshot()) == 1
+	}, time.Second, 5*time.Millisecond)
+
+	require.NoError(t, manager.Resolve(ctx, "fp-1"))
+	time.Sleep(80 * time.Millisecond)
+	assert.Equal(t, []string{"slack-team"}, publisher.snapshot())
+
+	status, err := manager.Status(ctx, "fp-1")
+	require.NoError(t, err)
+	assert.Equal(t, core.EscalationResolved, status.State)
+	assert.NotNil(t, status.StoppedAt)
+
+	// The alert fires again: a new escalation starts
+	require.NoError(t, manager.Escalate(ctx, criticalAlert("fp-1")))
+	assert.Eventually(t, func() bool {
+		return len(publisher.snapshot()) == 2
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestEscalationManager_StopsOnAck(t *testing.T) {
+	repo := repository.NewMemoryEscalationRepository()
+	acks := &fakeAcks{acked: map[string]bool{}}
+	manager, publisher := newTestManager(t, testConfig(t, 0, 30*time.Millisecond), repo, acks)
+	ctx := context.Background()
+
+	require.NoError(t, manager.Escalate(ctx, criticalAlert("fp-1")))
+	assert.Eventually(t, func() bool {
+		return len(publisher.snapshot()) == 1
+	}, time.Second, 5*time.Millisecond)
+
+	acks.ack("fp-1")
+	assert.Eventually(t, func() bool {
+		escalation, err := repo.GetEscalation(ctx, "fp-1")
+		return err == nil && escalation.State == core.EscalationAcknowledged
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []string{"slack-team"}, publisher.snapshot())
+
+	// Still acknowledged: firing again doesn't restart the escalation
+	require.NoError(t, manager.Escalate(ctx, criticalAlert("fp-1")))
+	escalation, err := repo.GetEscalation(ctx, "fp-1")
+	require.NoError(t, err)
+	assert.Equal(t, core.EscalationAcknowledged, escalation.State)
+}
+
+func TestEscalationManager_StatusStopsAcknowledged(t *testing.T) {
+	repo := repository.NewMemoryEscalationRepository()
+	acks := &fakeAcks{acked: map[string]bool{}}
+	manager, _ := newTestManager(t, testConfig(t, time.Hour), repo, acks)
+	ctx := context.Background()
+
+	require.NoError(t, manager.Escalate(ctx, criticalAlert("fp-1")))
+	acks.ack("fp-1")
+
+	status, err := manager.Status(ctx, "fp-1")
+	require.NoError(t, err)
+	assert.Equal(t, core.EscalationAcknowledged, status.State)
+
+	active, err := manager.ListActive(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, active)
+}
+
+func TestEscalationManager_RestoreEscalations(t *testing.T) {
+	repo := repository.NewMemoryEscalationRepository()
+	config := testConfig(t, 0, 10*time.Minute, time.Hour)
+	ctx := context.Background()
+
+	// Level 1 fell due while the service was down, level 2 is pending
+	startedAt := time.Now().Add(-15 * time.Minute)
+	nextAt := startedAt.Add(10 * time.Minute)
+	require.NoError(t, repo.SaveEscalation(ctx, &core.AlertEscalation{
+		Fingerprint: "fp-missed",
+		AlertName:   "DiskFull",
+		Labels:      map[string]string{"severity": "critical"},
+		Policy:      "critical",
+		State:       core.EscalationActive,
+		Level:       1,
+		StartedAt:   startedAt,
+		NextAt:      &nextAt,
+		UpdatedAt:   startedAt,
+	}))
+	pendingAt := time.Now().Add(time.Hour)
+	require.NoError(t, repo.SaveEscalation(ctx, &core.AlertEscalation{
+		Fingerprint: "fp-pending",
+		Policy:      "critical",
+		State:       core.EscalationActive,
+		Level:       1,
+		StartedAt:   time.Now(),
+		NextAt:      &pendingAt,
+		UpdatedAt:   time.Now(),
+	}))
+
+	manager, publisher := newTestManager(t, config, repo, nil)
+	restored, missed, err := manager.RestoreEscalations(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, restored)
+	assert.Equal(t, 1, missed)
+
+	assert.Eventually(t, func() bool {
+		return len(publisher.snapshot()) == 1
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []string{"pagerduty-primary"}, publisher.snapshot())
+
+	escalation, err := repo.GetEscalation(ctx, "fp-missed")
+	require.NoError(t, err)
+	assert.Equal(t, 2, escalation.Level)
+	assert.Equal(t, core.EscalationActive, escalation.State)
+	require.NotNil(t, escalation.NextAt)
+	assert.WithinDuration(t, startedAt.Add(time.Hour), *escalation.NextAt, time.Millisecond)
+}
+
+func TestEscalationManager_ReplicasDeliverLevelOnce(t *testing.T) {
+	repo := repository.NewMemoryEscalationRepository()
+	config := testConfig(t, 0, 30*time.Millisecond)
+	ctx := context.Background()
+
+	startedAt := time.Now().Add(-time.Minute)
+	require.NoError(t, repo.SaveEscalation(ctx, &core.AlertEscalation{
+		Fingerprint: "fp-shared",
+		Policy:      "critical",
+		State:       core.EscalationActive,
+		StartedAt:   startedAt,
+		NextAt:      &startedAt,
+		UpdatedAt:   startedAt,
+	}))
+
+	// Both replicas restore the escalation and race for each level
+	first, firstPublisher := newTestManager(t, config, repo, nil)
+	second, secondPublisher := newTestManager(t, config, repo, nil)
+	_, _, err := first.RestoreEscalations(ctx)
+	require.NoError(t, err)
+	_, _, err = second.RestoreEscalations(ctx)
+	require.NoError(t, err)
+
+	delivered := func() []string {
+		return append(firstPublisher.snapshot(), secondPublisher.snapshot()...)
+	}
+	require.Eventually(t, func() bool {
+		escalation, err := repo.GetEscalation(ctx, "fp-shared")
+		return err == nil && escalation.State == core.EscalationCompleted
+	}, time.Second, 5*time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	assert.ElementsMatch(t, []string{"slack-team", "pagerduty-primary"}, delivered())
+}
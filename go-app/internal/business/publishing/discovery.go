@@ -198,7 +198,7 @@ type TargetDiscoveryManager interface {
 	// GetTargetsByType filters targets by type (rootly/pagerduty/slack/webhook).
 	//
 	// Parameters:
-	//   - targetType: Target type to filter (rootly, pagerduty, slack, webhook)
+	//   - targetType: Target type to filter (rootly, pagerduty, slack, opsgenie, email, msteams, telegram, webhook)
 	//
 	// Returns:
 	//   - Slice of matching targets
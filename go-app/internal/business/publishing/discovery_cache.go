@@ -122,6 +122,34 @@ func (c *targetCache) Set(targets []*core.PublishingTarget) {
 	c.targets = newTargets
 }
 
+// Upsert adds or replaces a single target (used by watch-based discovery).
+//
+// Returns the previous target with the same name (nil if none).
+func (c *targetCache) Upsert(target *core.PublishingTarget) *core.PublishingTarget {
+	if target == nil || target.Name == "" {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	previous := c.targets[target.Name]
+	c.targets[target.Name] = target
+	return previous
+}
+
+// Delete removes a single target by name (used by watch-based discovery).
+//
+// Returns the removed target (nil if it was not cached).
+func (c *targetCache) Delete(name string) *core.PublishingTarget {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	previous := c.targets[name]
+	delete(c.targets, name)
+	return previous
+}
+
 // Get returns target by name (O(1) lookup).
 //
 // Returns:
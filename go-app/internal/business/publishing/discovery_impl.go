@@ -78,6 +78,20 @@ type DefaultTargetDiscoveryManager struct {
 	stats DiscoveryStats
 	mu    sync.RWMutex
 
+	// secretIndex maps secret key (namespace/name) → target defined by the
+	// secret (nil for invalid secrets). Protected by mu; used to apply watch
+	// events incrementally.
+	secretIndex map[string]*core.PublishingTarget
+
+	// applyMu serializes cache updates from DiscoverTargets and watch events
+	applyMu sync.Mutex
+
+	// syncSeen records secrets delivered during StartWatch's initial list (protected by applyMu)
+	syncSeen map[string]bool
+
+	// eventPublisher publishes realtime events on target changes (optional)
+	eventPublisher TargetEventPublisher
+
 	// Observability
 	logger  *slog.Logger
 	metrics *DiscoveryMetrics
@@ -86,7 +100,7 @@ type DefaultTargetDiscoveryManager struct {
 // DiscoveryMetrics holds Prometheus metrics for target discovery.
 type DiscoveryMetrics struct {
 	// TargetsTotal tracks active targets by type and enabled status.
-	// Labels: type (rootly/pagerduty/slack/webhook), enabled (true/false)
+	// Labels: type (rootly/pagerduty/slack/opsgenie/email/msteams/telegram/webhook), enabled (true/false)
 	TargetsTotal *prometheus.GaugeVec
 
 	// DurationSeconds tracks operation duration (discover/parse/validate).
@@ -171,6 +185,7 @@ func NewTargetDiscoveryManager(
 		namespace:     namespace,
 		labelSelector: labelSelector,
 		cache:         newTargetCache(),
+		secretIndex:   make(map[string]*core.PublishingTarget),
 		logger:        logger,
 		metrics:       discoveryMetrics,
 	}
@@ -221,18 +236,27 @@ func (m *DefaultTargetDiscoveryManager) DiscoverTargets(ctx context.Context) err
 	)
 
 	// Parse and validate secrets
-	validTargets, invalidCount := m.parseAndValidateSecrets(secrets)
+	validTargets, invalidCount, secretIndex := m.parseAndValidateSecrets(secrets)
+
+	m.applyMu.Lock()
+	changed := targetsChanged(m.cache.List(), validTargets)
 
 	// Update cache atomically
 	m.cache.Set(validTargets)
 
 	// Update statistics
 	m.mu.Lock()
+	m.secretIndex = secretIndex
 	m.stats.TotalTargets = len(secrets)
 	m.stats.ValidTargets = len(validTargets)
 	m.stats.InvalidTargets = invalidCount
 	m.stats.LastDiscovery = time.Now()
 	m.mu.Unlock()
+	m.applyMu.Unlock()
+
+	if changed {
+		m.publishTargetsChanged(TargetChangeResynced, nil)
+	}
 
 	// Record metrics
 	if m.metrics != nil {
@@ -260,66 +284,79 @@ func (m *DefaultTargetDiscoveryManager) DiscoverTargets(ctx context.Context) err
 	return nil
 }
 
-// parseAndValidateSecrets parses and validates secrets, returns valid targets + invalid count.
-func (m *DefaultTargetDiscoveryManager) parseAndValidateSecrets(secrets []corev1.Secret) ([]*core.PublishingTarget, int) {
+// parseAndValidateSecrets parses and validates secrets, returns valid targets,
+// invalid count and the secret key → target index (nil for invalid secrets).
+func (m *DefaultTargetDiscoveryManager) parseAndValidateSecrets(secrets []corev1.Secret) ([]*core.PublishingTarget, int, map[string]*core.PublishingTarget) {
 	var validTargets []*core.PublishingTarget
 	invalidCount := 0
+	secretIndex := make(map[string]*core.PublishingTarget, len(secrets))
 
-	for _, secret := range secrets {
-		// Parse secret
-		target, err := parseSecret(secret)
-		if err != nil {
-			m.logger.Warn("Skipping secret with parse error",
-				"secret_name", secret.Name,
-				"error", err,
-			)
-			invalidCount++
-			if m.metrics != nil {
-				m.metrics.ErrorsTotal.WithLabelValues("parse").Inc()
-			}
-			continue
-		}
-
-		// Validate target
-		validationErrs := validateTarget(target)
-		if len(validationErrs) > 0 {
-			m.logger.Warn("Skipping secret with validation errors",
-				"secret_name", secret.Name,
-				"target_name", target.Name,
-				"validation_errors", len(validationErrs),
-			)
-			for _, valErr := range validationErrs {
-				m.logger.Debug("Validation error detail",
-					"field", valErr.Field,
-					"message", valErr.Message,
-					"value", valErr.Value,
-				)
-			}
+	for i := range secrets {
+		target := m.parseAndValidateSecret(secrets[i])
+		secretIndex[secretKey(&secrets[i])] = target
+		if target == nil {
 			invalidCount++
-			if m.metrics != nil {
-				m.metrics.ErrorsTotal.WithLabelValues("validate").Inc()
-			}
 			continue
 		}
 
 		// Valid target - add to list
 		validTargets = append(validTargets, target)
+	}
+
+	return validTargets, invalidCount, secretIndex
+}
+
+// parseAndValidateSecret parses and validates a single secret.
+// Returns nil (after logging and recording metrics) if the secret is invalid.
+func (m *DefaultTargetDiscoveryManager) parseAndValidateSecret(secret corev1.Secret) *core.PublishingTarget {
+	// Parse secret
+	target, err := parseSecret(secret)
+	if err != nil {
+		m.logger.Warn("Skipping secret with parse error",
+			"secret_name", secret.Name,
+			"error", err,
+		)
+		if m.metrics != nil {
+			m.metrics.ErrorsTotal.WithLabelValues("parse").Inc()
+		}
+		return nil
+	}
 
-		m.logger.Debug("Parsed valid target",
+	// Validate target
+	validationErrs := validateTarget(target)
+	if len(validationErrs) > 0 {
+		m.logger.Warn("Skipping secret with validation errors",
+			"secret_name", secret.Name,
 			"target_name", target.Name,
-			"type", target.Type,
-			"url", target.URL,
-			"enabled", target.Enabled,
+			"validation_errors", len(validationErrs),
 		)
+		for _, valErr := range validationErrs {
+			m.logger.Debug("Validation error detail",
+				"field", valErr.Field,
+				"message", valErr.Message,
+				"value", valErr.Value,
+			)
+		}
+		if m.metrics != nil {
+			m.metrics.ErrorsTotal.WithLabelValues("validate").Inc()
+		}
+		return nil
 	}
 
-	return validTargets, invalidCount
+	m.logger.Debug("Parsed valid target",
+		"target_name", target.Name,
+		"type", target.Type,
+		"url", target.URL,
+		"enabled", target.Enabled,
+	)
+
+	return target
 }
 
 // updateTargetsGauge updates Prometheus gauge with target counts by type and enabled.
 func (m *DefaultTargetDiscoveryManager) updateTargetsGauge(targets []*core.PublishingTarget) {
 	// Reset all gauges (to handle deleted targets)
-	for _, targetType := range []string{"rootly", "pagerduty", "slack", "webhook"} {
+	for _, targetType := range []string{"rootly", "pagerduty", "slack", "opsgenie", "email", "msteams", "telegram", "webhook"} {
 		for _, enabled := range []string{"true", "false"} {
 			m.metrics.TargetsTotal.WithLabelValues(targetType, enabled).Set(0)
 		}
@@ -381,6 +418,12 @@ func (m *DefaultTargetDiscoveryManager) ListTargets() []*core.PublishingTarget {
 	return targets
 }
 
+// GetTargetCount returns the number of active targets (lets the manager
+// serve as target source of the publishers).
+func (m *DefaultTargetDiscoveryManager) GetTargetCount() int {
+	return m.cache.Len()
+}
+
 // GetTargetsByType filters targets by type.
 func (m *DefaultTargetDiscoveryManager) GetTargetsByType(targetType string) []*core.PublishingTarget {
 	targets := m.cache.GetByType(targetType)
@@ -49,6 +49,54 @@ func TestParseSecret_ValidSecret(t *testing.T) {
 	assert.Equal(t, "Bearer token123", parsed.Headers["Authorization"])
 }
 
+func TestParseSecret_OpsgenieTarget(t *testing.T) {
+	configJSON := `{"name":"opsgenie-sre","type":"opsgenie","url":"https://api.eu.opsgenie.com","format":"opsgenie","enabled":true,` +
+		`"headers":{"api_key":"og-key","responders":"team:sre,schedule:oncall","priority":"P2"}}`
+
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "opsgenie-secret",
+			Namespace: "monitoring",
+		},
+		Data: map[string][]byte{
+			"config": []byte(configJSON),
+		},
+	}
+
+	parsed, err := parseSecret(secret)
+	require.NoError(t, err)
+	assert.Equal(t, "opsgenie", parsed.Type)
+	assert.Equal(t, core.FormatOpsgenie, parsed.Format)
+	assert.Equal(t, "og-key", parsed.Headers["api_key"])
+	assert.Equal(t, "team:sre,schedule:oncall", parsed.Headers["responders"])
+	assert.Empty(t, validateTarget(parsed))
+}
+
+func TestParseSecret_EmailTarget(t *testing.T) {
+	configJSON := `{"name":"email-oncall","type":"email","url":"smtp://smtp.example.com:587","format":"email","enabled":true,` +
+		`"headers":{"to":"oncall@example.com","from":"alerts@example.com","auth_username":"alerts","auth_password":"secret"}}`
+
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "email-secret",
+			Namespace: "monitoring",
+		},
+		Data: map[string][]byte{
+			"config": []byte(configJSON),
+		},
+	}
+
+	parsed, err := parseSecret(secret)
+	require.NoError(t, err)
+	assert.Equal(t, "email", parsed.Type)
+	assert.Equal(t, core.FormatEmail, parsed.Format)
+	assert.Empty(t, validateTarget(parsed))
+
+	// HTTP URL is rejected for email targets
+	parsed.URL = "https://smtp.example.com"
+	assert.NotEmpty(t, validateTarget(parsed))
+}
+
 func TestParseSecret_MissingConfigField(t *testing.T) {
 	secret := corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -67,6 +67,10 @@ func (m *mockK8sClient) GetSecret(ctx context.Context, namespace, name string) (
 	return m.clientset.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
 }
 
+func (m *mockK8sClient) WatchSecrets(ctx context.Context, namespace string, labelSelector string, resyncPeriod time.Duration, handler k8s.SecretEventHandler) error {
+	return k8s.NewK8sClientFromClientset(m.clientset, nil).WatchSecrets(ctx, namespace, labelSelector, resyncPeriod, handler)
+}
+
 func (m *mockK8sClient) Health(ctx context.Context) error {
 	return nil // Always healthy in tests
 }
@@ -16,9 +16,9 @@ var targetNameRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
 // Validation Rules:
 //  1. Required fields: name, type, url, format
 //  2. Name: alphanumeric + hyphens, 1-63 chars (DNS-1123 compliant)
-//  3. Type: one of [rootly, pagerduty, slack, webhook]
-//  4. URL: valid HTTP/HTTPS URL
-//  5. Format: one of [alertmanager, rootly, pagerduty, slack, webhook]
+//  3. Type: one of [rootly, pagerduty, slack, opsgenie, email, msteams, telegram, webhook]
+//  4. URL: valid HTTP/HTTPS URL (smtp/smtps for email)
+//  5. Format: one of [alertmanager, rootly, pagerduty, slack, opsgenie, email, msteams, telegram, webhook]
 //  6. Type-Format compatibility (e.g., type=rootly requires format=rootly)
 //  7. Headers: no empty keys/values
 //
@@ -74,18 +74,26 @@ func validateTarget(target *core.PublishingTarget) []ValidationError {
 	} else if !isValidTargetType(target.Type) {
 		errors = append(errors, NewValidationError(
 			"type",
-			"must be one of: rootly, pagerduty, slack, webhook",
+			"must be one of: rootly, pagerduty, slack, opsgenie, email, msteams, telegram, webhook",
 			target.Type,
 		))
 	}
 
-	// Validate URL (required, valid HTTP/HTTPS)
+	// Validate URL (required, valid HTTP/HTTPS; SMTP for email targets)
 	if target.URL == "" {
 		errors = append(errors, NewValidationError(
 			"url",
 			"field is required",
 			target.URL,
 		))
+	} else if target.Type == "email" {
+		if !isValidSMTPURL(target.URL) {
+			errors = append(errors, NewValidationError(
+				"url",
+				"must be valid SMTP URL (smtp://host:port or smtps://host:port)",
+				target.URL,
+			))
+		}
 	} else if !isValidURL(target.URL) {
 		errors = append(errors, NewValidationError(
 			"url",
@@ -104,7 +112,7 @@ func validateTarget(target *core.PublishingTarget) []ValidationError {
 	} else if !isValidFormat(string(target.Format)) {
 		errors = append(errors, NewValidationError(
 			"format",
-			"must be one of: alertmanager, rootly, pagerduty, slack, webhook",
+			"must be one of: alertmanager, rootly, pagerduty, slack, opsgenie, email, msteams, telegram, webhook",
 			string(target.Format),
 		))
 	}
@@ -169,12 +177,16 @@ func isValidTargetName(name string) bool {
 //   - rootly: Rootly incident management
 //   - pagerduty: PagerDuty incident response
 //   - slack: Slack messaging
+//   - opsgenie: Opsgenie alerting
+//   - email: SMTP email
+//   - msteams: Microsoft Teams (Workflows / Incoming Webhook)
+//   - telegram: Telegram Bot API
 //   - webhook: Generic webhook (any endpoint)
 //
 // Case-sensitive: Must be lowercase.
 func isValidTargetType(targetType string) bool {
 	switch targetType {
-	case "rootly", "pagerduty", "slack", "webhook":
+	case "rootly", "pagerduty", "slack", "opsgenie", "email", "msteams", "telegram", "webhook":
 		return true
 	default:
 		return false
@@ -188,12 +200,16 @@ func isValidTargetType(targetType string) bool {
 //   - rootly: Rootly API format
 //   - pagerduty: PagerDuty Events API v2 format
 //   - slack: Slack Incoming Webhook format
+//   - opsgenie: Opsgenie Alert API v2 format
+//   - email: Templated email (rendered by email publisher)
+//   - msteams: Adaptive Card (rendered by Teams publisher)
+//   - telegram: Bot API message (rendered by Telegram publisher)
 //   - webhook: Generic JSON webhook
 //
 // Case-sensitive: Must be lowercase.
 func isValidFormat(format string) bool {
 	switch format {
-	case "alertmanager", "rootly", "pagerduty", "slack", "webhook":
+	case "alertmanager", "rootly", "pagerduty", "slack", "opsgenie", "email", "msteams", "telegram", "webhook":
 		return true
 	default:
 		return false
@@ -232,6 +248,24 @@ func isValidURL(rawURL string) bool {
 	return true
 }
 
+// isValidSMTPURL checks if URL is valid SMTP smarthost URL.
+//
+// Examples:
+//   - Valid: "smtp://smtp.example.com:587" (STARTTLS), "smtps://smtp.example.com:465" (implicit TLS)
+//   - Invalid: "https://smtp.example.com", "smtp.example.com:587" (missing scheme)
+func isValidSMTPURL(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+
+	if u.Scheme != "smtp" && u.Scheme != "smtps" {
+		return false
+	}
+
+	return u.Hostname() != ""
+}
+
 // isCompatibleTypeFormat checks type-format compatibility.
 //
 // Compatibility Matrix:
@@ -241,9 +275,13 @@ func isValidURL(rawURL string) bool {
 //	| rootly     | rootly                        | Strict: Rootly API only        |
 //	| pagerduty  | pagerduty                     | Strict: PagerDuty Events API   |
 //	| slack      | slack                         | Strict: Slack webhook only     |
+//	| opsgenie   | opsgenie                      | Strict: Opsgenie Alert API     |
+//	| email      | email                         | Strict: SMTP (templated body)  |
+//	| msteams    | msteams                       | Strict: Adaptive Card webhook  |
+//	| telegram   | telegram                      | Strict: Bot API sendMessage    |
 //	| webhook    | alertmanager, webhook         | Flexible: any generic format   |
 //
-// Why strict for rootly/pagerduty/slack?
+// Why strict for rootly/pagerduty/slack/opsgenie/msteams/telegram?
 //   - These have specific API contracts (payload structure)
 //   - Using wrong format would cause API errors
 //
@@ -262,6 +300,10 @@ func isCompatibleTypeFormat(targetType, format string) bool {
 		"rootly":     {"rootly"},
 		"pagerduty":  {"pagerduty"},
 		"slack":      {"slack"},
+		"opsgenie":   {"opsgenie"},
+		"email":      {"email"},
+		"msteams":    {"msteams"},
+		"telegram":   {"telegram"},
 		"webhook":    {"alertmanager", "webhook"}, // webhooks are flexible
 	}
 
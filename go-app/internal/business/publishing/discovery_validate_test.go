@@ -128,7 +128,7 @@ func TestValidateTarget_InvalidType(t *testing.T) {
 	for _, err := range errors {
 		if err.Field == "type" {
 			found = true
-			assert.Contains(t, err.Message, "rootly, pagerduty, slack, webhook")
+			assert.Contains(t, err.Message, "rootly, pagerduty, slack, opsgenie, email, msteams, telegram, webhook")
 			break
 		}
 	}
@@ -226,6 +226,8 @@ func TestValidateTarget_TypeFormatMismatch(t *testing.T) {
 		{"rootly/slack", "rootly", "slack", false},
 		{"pagerduty/pagerduty", "pagerduty", "pagerduty", true},
 		{"slack/slack", "slack", "slack", true},
+		{"opsgenie/opsgenie", "opsgenie", "opsgenie", true},
+		{"opsgenie/webhook", "opsgenie", "webhook", false},
 		{"webhook/alertmanager", "webhook", "alertmanager", true},
 		{"webhook/webhook", "webhook", "webhook", true},
 		{"webhook/rootly", "webhook", "rootly", false},
@@ -342,6 +344,10 @@ func TestIsValidTargetType(t *testing.T) {
 		{"rootly", "rootly", true},
 		{"pagerduty", "pagerduty", true},
 		{"slack", "slack", true},
+		{"opsgenie", "opsgenie", true},
+		{"email", "email", true},
+		{"msteams", "msteams", true},
+		{"telegram", "telegram", true},
 		{"webhook", "webhook", true},
 		{"invalid", "invalid", false},
 		{"uppercase", "ROOTLY", false},
@@ -366,6 +372,10 @@ func TestIsValidFormat(t *testing.T) {
 		{"rootly", "rootly", true},
 		{"pagerduty", "pagerduty", true},
 		{"slack", "slack", true},
+		{"opsgenie", "opsgenie", true},
+		{"email", "email", true},
+		{"msteams", "msteams", true},
+		{"telegram", "telegram", true},
 		{"webhook", "webhook", true},
 		{"invalid", "invalid", false},
 		{"uppercase", "ALERTMANAGER", false},
@@ -408,6 +418,26 @@ func TestIsValidURL(t *testing.T) {
 	}
 }
 
+func TestIsValidSMTPURL(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"smtp with port", "smtp://smtp.example.com:587", true},
+		{"smtps without port", "smtps://smtp.example.com", true},
+		{"https scheme", "https://smtp.example.com", false},
+		{"no scheme", "smtp.example.com:587", false},
+		{"no host", "smtp://", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.valid, isValidSMTPURL(tt.input))
+		})
+	}
+}
+
 func TestIsCompatibleTypeFormat(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -419,6 +449,14 @@ func TestIsCompatibleTypeFormat(t *testing.T) {
 		{"rootly/slack", "rootly", "slack", false},
 		{"pagerduty/pagerduty", "pagerduty", "pagerduty", true},
 		{"slack/slack", "slack", "slack", true},
+		{"opsgenie/opsgenie", "opsgenie", "opsgenie", true},
+		{"opsgenie/webhook", "opsgenie", "webhook", false},
+		{"email/email", "email", "email", true},
+		{"email/webhook", "email", "webhook", false},
+		{"msteams/msteams", "msteams", "msteams", true},
+		{"msteams/slack", "msteams", "slack", false},
+		{"telegram/telegram", "telegram", "telegram", true},
+		{"telegram/webhook", "telegram", "webhook", false},
 		{"webhook/alertmanager", "webhook", "alertmanager", true},
 		{"webhook/webhook", "webhook", "webhook", true},
 		{"webhook/rootly", "webhook", "rootly", false},
@@ -0,0 +1,321 @@
+package publishing
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure/k8s"
+)
+
+// TargetWatcher is implemented by discovery managers that support watch-based
+// (incremental) discovery instead of periodic relisting.
+//
+// Example:
+//
+//	if watcher, ok := manager.(publishing.TargetWatcher); ok {
+//	    watcher.SetEventPublisher(eventPublisher)
+//	    if err := watcher.StartWatch(ctx, 10*time.Minute); err != nil {
+//	        log.Warn("Watch failed, falling back to periodic refresh", "error", err)
+//	    }
+//	}
+type TargetWatcher interface {
+	// StartWatch watches target secrets and applies add/update/delete events to
+	// the cache incrementally. Blocks until the initial list has been applied;
+	// watching continues until ctx is cancelled.
+	//
+	// resyncPeriod > 0 periodically re-applies every secret to repair state
+	// after missed events (unchanged targets produce no notifications).
+	StartWatch(ctx context.Context, resyncPeriod time.Duration) error
+
+	// SetEventPublisher sets the publisher notified when targets change.
+	SetEventPublisher(publisher TargetEventPublisher)
+}
+
+// TargetEventPublisher publishes realtime notifications about target changes.
+// Implemented by realtime.EventPublisher.
+type TargetEventPublisher interface {
+	PublishTargetsChangedEvent(action string, targetName string, targetType string, totalTargets int) error
+}
+
+// Target change actions reported to TargetEventPublisher.
+const (
+	TargetChangeAdded    = "added"
+	TargetChangeUpdated  = "updated"
+	TargetChangeDeleted  = "deleted"
+	TargetChangeResynced = "resynced" // full relist via DiscoverTargets changed the target set
+)
+
+// targetChange is a single cache change produced by a watch event.
+type targetChange struct {
+	action string
+	target *core.PublishingTarget
+}
+
+// SetEventPublisher sets the publisher notified when targets change.
+func (m *DefaultTargetDiscoveryManager) SetEventPublisher(publisher TargetEventPublisher) {
+	m.mu.Lock()
+	m.eventPublisher = publisher
+	m.mu.Unlock()
+}
+
+// StartWatch watches target secrets and applies changes incrementally.
+func (m *DefaultTargetDiscoveryManager) StartWatch(ctx context.Context, resyncPeriod time.Duration) error {
+	startTime := time.Now()
+
+	// Track secrets delivered by the initial list so that targets for secrets
+	// deleted before the watch started can be pruned afterwards.
+	m.applyMu.Lock()
+	m.syncSeen = make(map[string]bool)
+	m.applyMu.Unlock()
+
+	err := m.k8sClient.WatchSecrets(ctx, m.namespace, m.labelSelector, resyncPeriod, m.handleSecretEvent)
+
+	m.applyMu.Lock()
+	seen := m.syncSeen
+	m.syncSeen = nil
+	var pruned []targetChange
+	if err == nil {
+		pruned = m.pruneUnseenLocked(seen)
+		m.refreshStatsLocked()
+	}
+	m.applyMu.Unlock()
+
+	if err != nil {
+		m.logger.Error("Failed to watch K8s secrets",
+			"namespace", m.namespace,
+			"error", err,
+		)
+
+		m.mu.Lock()
+		m.stats.DiscoveryErrors++
+		m.mu.Unlock()
+
+		if m.metrics != nil {
+			m.metrics.ErrorsTotal.WithLabelValues("k8s_api").Inc()
+		}
+
+		return NewDiscoveryFailedError(m.namespace, err)
+	}
+
+	for _, change := range pruned {
+		m.publishTargetsChanged(change.action, change.target)
+	}
+
+	if m.metrics != nil {
+		m.metrics.DurationSeconds.WithLabelValues("discover").Observe(time.Since(startTime).Seconds())
+	}
+
+	stats := m.GetStats()
+	m.logger.Info("Target watch started",
+		"namespace", m.namespace,
+		"label_selector", m.labelSelector,
+		"resync_period", resyncPeriod,
+		"valid_targets", stats.ValidTargets,
+		"invalid_targets", stats.InvalidTargets,
+		"duration_ms", time.Since(startTime).Milliseconds(),
+	)
+
+	return nil
+}
+
+// handleSecretEvent applies a single secret event to the cache.
+//
+// Several secrets may define a target of the same name: the target stays
+// cached until no secret defines it anymore.
+func (m *DefaultTargetDiscoveryManager) handleSecretEvent(event k8s.SecretEvent) {
+	if event.Secret == nil {
+		return
+	}
+	key := secretKey(event.Secret)
+
+	m.applyMu.Lock()
+
+	if m.syncSeen != nil && event.Type != k8s.SecretDeleted {
+		m.syncSeen[key] = true
+	}
+
+	m.mu.RLock()
+	previous, known := m.secretIndex[key]
+	m.mu.RUnlock()
+
+	var changes []targetChange
+
+	switch event.Type {
+	case k8s.SecretDeleted:
+		if !known {
+			m.applyMu.Unlock()
+			return
+		}
+		m.mu.Lock()
+		delete(m.secretIndex, key)
+		m.mu.Unlock()
+		if previous != nil {
+			changes = append(changes, m.releaseTargetLocked(previous.Name)...)
+		}
+
+	default:
+		target := m.parseAndValidateSecret(*event.Secret)
+
+		m.mu.Lock()
+		m.secretIndex[key] = target
+		m.mu.Unlock()
+
+		// Secret became invalid or now defines a different target
+		if previous != nil && (target == nil || target.Name != previous.Name) {
+			changes = append(changes, m.releaseTargetLocked(previous.Name)...)
+		}
+
+		if target != nil {
+			changes = append(changes, m.upsertTargetLocked(target)...)
+		}
+	}
+
+	m.refreshStatsLocked()
+	m.applyMu.Unlock()
+
+	for _, change := range changes {
+		m.logger.Info("Publishing target changed",
+			"action", change.action,
+			"target", change.target.Name,
+			"type", change.target.Type,
+			"secret", key,
+		)
+		m.publishTargetsChanged(change.action, change.target)
+	}
+}
+
+// upsertTargetLocked stores a target in the cache. Caller must hold applyMu.
+func (m *DefaultTargetDiscoveryManager) upsertTargetLocked(target *core.PublishingTarget) []targetChange {
+	previous := m.cache.Upsert(target)
+	switch {
+	case previous == nil:
+		return []targetChange{{TargetChangeAdded, target}}
+	case !reflect.DeepEqual(previous, target):
+		return []targetChange{{TargetChangeUpdated, target}}
+	}
+	return nil
+}
+
+// releaseTargetLocked removes a target that a secret no longer defines. If
+// another secret still defines a target of that name, its definition is
+// cached instead. Caller must hold applyMu.
+func (m *DefaultTargetDiscoveryManager) releaseTargetLocked(name string) []targetChange {
+	m.mu.RLock()
+	var definedBy string
+	var other *core.PublishingTarget
+	for key, target := range m.secretIndex {
+		// Lowest secret key wins, so the choice is deterministic
+		if target != nil && target.Name == name && (other == nil || key < definedBy) {
+			definedBy, other = key, target
+		}
+	}
+	m.mu.RUnlock()
+
+	if other != nil {
+		return m.upsertTargetLocked(other)
+	}
+	if removed := m.cache.Delete(name); removed != nil {
+		return []targetChange{{TargetChangeDeleted, removed}}
+	}
+	return nil
+}
+
+// pruneUnseenLocked removes targets whose secrets were not part of the initial
+// watch list. Caller must hold applyMu.
+func (m *DefaultTargetDiscoveryManager) pruneUnseenLocked(seen map[string]bool) []targetChange {
+	var released []string
+
+	m.mu.Lock()
+	for key, target := range m.secretIndex {
+		if seen[key] {
+			continue
+		}
+		delete(m.secretIndex, key)
+		if target != nil {
+			released = append(released, target.Name)
+		}
+	}
+	m.mu.Unlock()
+
+	var changes []targetChange
+	for _, name := range released {
+		changes = append(changes, m.releaseTargetLocked(name)...)
+	}
+	return changes
+}
+
+// refreshStatsLocked recomputes statistics and gauges from the secret index.
+// Caller must hold applyMu.
+func (m *DefaultTargetDiscoveryManager) refreshStatsLocked() {
+	targets := m.cache.List()
+
+	m.mu.Lock()
+	invalid := 0
+	for _, target := range m.secretIndex {
+		if target == nil {
+			invalid++
+		}
+	}
+	m.stats.TotalTargets = len(m.secretIndex)
+	m.stats.ValidTargets = len(targets)
+	m.stats.InvalidTargets = invalid
+	m.stats.LastDiscovery = time.Now()
+	m.mu.Unlock()
+
+	if m.metrics != nil {
+		m.updateTargetsGauge(targets)
+		m.metrics.LastSuccessTimestamp.Set(float64(time.Now().Unix()))
+	}
+}
+
+// publishTargetsChanged notifies the event publisher (if set) about a target change.
+func (m *DefaultTargetDiscoveryManager) publishTargetsChanged(action string, target *core.PublishingTarget) {
+	m.mu.RLock()
+	publisher := m.eventPublisher
+	m.mu.RUnlock()
+	if publisher == nil {
+		return
+	}
+
+	name, targetType := "", ""
+	if target != nil {
+		name, targetType = target.Name, target.Type
+	}
+
+	if err := publisher.PublishTargetsChangedEvent(action, name, targetType, m.cache.Len()); err != nil {
+		m.logger.Warn("Failed to publish target change event",
+			"action", action,
+			"target", name,
+			"error", err,
+		)
+	}
+}
+
+// secretKey returns the secret index key of a secret (namespace/name).
+func secretKey(secret *corev1.Secret) string {
+	return secret.Namespace + "/" + secret.Name
+}
+
+// targetsChanged reports whether two target sets differ (by name or content).
+func targetsChanged(oldTargets, newTargets []*core.PublishingTarget) bool {
+	if len(oldTargets) != len(newTargets) {
+		return true
+	}
+
+	byName := make(map[string]*core.PublishingTarget, len(oldTargets))
+	for _, target := range oldTargets {
+		byName[target.Name] = target
+	}
+	for _, target := range newTargets {
+		old, ok := byName[target.Name]
+		if !ok || !reflect.DeepEqual(old, target) {
+			return true
+		}
+	}
+
+	return false
+}
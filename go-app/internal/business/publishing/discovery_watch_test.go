@@ -0,0 +1,264 @@
+package publishing
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+)
+
+// recordingTargetEventPublisher records target change notifications.
+type recordingTargetEventPublisher struct {
+	mu      sync.Mutex
+	changes []string
+}
+
+func (p *recordingTargetEventPublisher) PublishTargetsChangedEvent(action, targetName, targetType string, totalTargets int) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.changes = append(p.changes, action+":"+targetName)
+	return nil
+}
+
+func (p *recordingTargetEventPublisher) snapshot() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.changes...)
+}
+
+// newWatchTestClient creates a fake-clientset backed K8s client and a channel
+// closed once the secret watch is registered.
+func newWatchTestClient(t *testing.T, secrets ...corev1.Secret) (*mockK8sClient, <-chan struct{}) {
+	t.Helper()
+
+	clientset := fake.NewSimpleClientset()
+	for i := range secrets {
+		require.NoError(t, clientset.Tracker().Add(&secrets[i]))
+	}
+
+	watchStarted := make(chan struct{})
+	var once sync.Once
+	clientset.PrependWatchReactor("*", func(action k8stesting.Action) (bool, watch.Interface, error) {
+		w, err := clientset.Tracker().Watch(action.GetResource(), action.GetNamespace())
+		if err != nil {
+			return false, nil, err
+		}
+		once.Do(func() { close(watchStarted) })
+		return true, w, nil
+	})
+
+	return &mockK8sClient{clientset: clientset, namespace: "default"}, watchStarted
+}
+
+// withTargetURL returns a copy of a valid test secret with a different target URL.
+func withTargetURL(secret corev1.Secret, url string) *corev1.Secret {
+	raw, _ := base64.StdEncoding.DecodeString(string(secret.Data["config"]))
+	var target core.PublishingTarget
+	_ = json.Unmarshal(raw, &target)
+	target.URL = url
+
+	configJSON, _ := json.Marshal(target)
+	updated := secret.DeepCopy()
+	updated.Data = map[string][]byte{"config": []byte(base64.StdEncoding.EncodeToString(configJSON))}
+	return updated
+}
+
+func newWatchTestManager(t *testing.T, client *mockK8sClient) (*DefaultTargetDiscoveryManager, *recordingTargetEventPublisher) {
+	t.Helper()
+
+	manager, err := NewTargetDiscoveryManager(client, "default", "publishing-target=true", nil, nil)
+	require.NoError(t, err)
+
+	watcher, ok := manager.(TargetWatcher)
+	require.True(t, ok, "DefaultTargetDiscoveryManager must implement TargetWatcher")
+
+	publisher := &recordingTargetEventPublisher{}
+	watcher.SetEventPublisher(publisher)
+
+	return manager.(*DefaultTargetDiscoveryManager), publisher
+}
+
+func TestStartWatch_AppliesEventsIncrementally(t *testing.T) {
+	rootly := createValidTestSecret("rootly-prod", "default", "rootly")
+	invalid := createInvalidTestSecret("broken", "default", "invalid_json")
+
+	client, watchStarted := newWatchTestClient(t, rootly, invalid)
+	manager, publisher := newWatchTestManager(t, client)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	require.NoError(t, manager.StartWatch(ctx, 0))
+
+	// Initial list applied before StartWatch returns
+	_, err := manager.GetTarget("rootly-prod")
+	require.NoError(t, err)
+	stats := manager.GetStats()
+	assert.Equal(t, 2, stats.TotalTargets)
+	assert.Equal(t, 1, stats.ValidTargets)
+	assert.Equal(t, 1, stats.InvalidTargets)
+	assert.Equal(t, []string{"added:rootly-prod"}, publisher.snapshot())
+
+	select {
+	case <-watchStarted:
+	case <-time.After(5 * time.Second):
+		t.Fatal("watch was not started")
+	}
+
+	secrets := client.clientset.CoreV1().Secrets("default")
+
+	// Add
+	slack := createValidTestSecret("slack-ops", "default", "slack")
+	_, err = secrets.Create(ctx, &slack, metav1.CreateOptions{})
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		_, err := manager.GetTarget("slack-ops")
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Update (rotated secret)
+	_, err = secrets.Update(ctx, withTargetURL(rootly, "https://rotated.example.com/hook"), metav1.UpdateOptions{})
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		target, err := manager.GetTarget("rootly-prod")
+		return err == nil && target.URL == "https://rotated.example.com/hook"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Delete
+	require.NoError(t, secrets.Delete(ctx, "slack-ops", metav1.DeleteOptions{}))
+	assert.Eventually(t, func() bool {
+		_, err := manager.GetTarget("slack-ops")
+		return err != nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, []string{
+		"added:rootly-prod",
+		"added:slack-ops",
+		"updated:rootly-prod",
+		"deleted:slack-ops",
+	}, publisher.snapshot())
+
+	stats = manager.GetStats()
+	assert.Equal(t, 2, stats.TotalTargets)
+	assert.Equal(t, 1, stats.ValidTargets)
+}
+
+func TestStartWatch_SecretBecomesInvalid(t *testing.T) {
+	rootly := createValidTestSecret("rootly-prod", "default", "rootly")
+
+	client, watchStarted := newWatchTestClient(t, rootly)
+	manager, publisher := newWatchTestManager(t, client)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, manager.StartWatch(ctx, 0))
+	<-watchStarted
+
+	broken := createInvalidTestSecret("rootly-prod", "default", "invalid_base64")
+	_, err := client.clientset.CoreV1().Secrets("default").Update(ctx, &broken, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		return manager.GetStats().InvalidTargets == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	_, err = manager.GetTarget("rootly-prod")
+	assert.Error(t, err)
+	assert.Equal(t, []string{"added:rootly-prod", "deleted:rootly-prod"}, publisher.snapshot())
+}
+
+func TestStartWatch_PrunesTargetsDeletedBeforeWatch(t *testing.T) {
+	rootly := createValidTestSecret("rootly-prod", "default", "rootly")
+	slack := createValidTestSecret("slack-ops", "default", "slack")
+
+	client, _ := newWatchTestClient(t, rootly, slack)
+	manager, publisher := newWatchTestManager(t, client)
+
+	// Initial relist-based discovery sees both targets
+	require.NoError(t, manager.DiscoverTargets(context.Background()))
+	assert.Len(t, manager.ListTargets(), 2)
+	assert.Equal(t, []string{"resynced:"}, publisher.snapshot())
+
+	// Secret removed while nothing was watching
+	require.NoError(t, client.clientset.CoreV1().Secrets("default").Delete(context.Background(), "slack-ops", metav1.DeleteOptions{}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, manager.StartWatch(ctx, 0))
+
+	_, err := manager.GetTarget("slack-ops")
+	assert.Error(t, err)
+	_, err = manager.GetTarget("rootly-prod")
+	assert.NoError(t, err)
+
+	// Unchanged rootly-prod produces no notification
+	assert.Equal(t, []string{"resynced:", "deleted:slack-ops"}, publisher.snapshot())
+	assert.Equal(t, 1, manager.GetStats().TotalTargets)
+}
+
+func TestDiscoverTargets_NoEventWhenUnchanged(t *testing.T) {
+	client, _ := newWatchTestClient(t, createValidTestSecret("rootly-prod", "default", "rootly"))
+	manager, publisher := newWatchTestManager(t, client)
+
+	require.NoError(t, manager.DiscoverTargets(context.Background()))
+	require.NoError(t, manager.DiscoverTargets(context.Background()))
+
+	assert.Equal(t, []string{"resynced:"}, publisher.snapshot())
+}
+
+func TestStartWatch_TargetDefinedBySeveralSecrets(t *testing.T) {
+	rootly := createValidTestSecret("rootly-prod", "default", "rootly")
+
+	client, watchStarted := newWatchTestClient(t, rootly)
+	manager, publisher := newWatchTestManager(t, client)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, manager.StartWatch(ctx, 0))
+	<-watchStarted
+
+	// A second secret defines the same target name
+	duplicate := withTargetURL(rootly, "https://v2.example.com/hook")
+	duplicate.Name = "rootly-prod-v2"
+	secrets := client.clientset.CoreV1().Secrets("default")
+	_, err := secrets.Create(ctx, duplicate, metav1.CreateOptions{})
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		target, err := manager.GetTarget("rootly-prod")
+		return err == nil && target.URL == "https://v2.example.com/hook"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Deleting one secret keeps the target defined by the other
+	require.NoError(t, secrets.Delete(ctx, "rootly-prod-v2", metav1.DeleteOptions{}))
+	assert.Eventually(t, func() bool {
+		target, err := manager.GetTarget("rootly-prod")
+		return err == nil && target.URL == "https://example.com/webhook"
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 1, manager.GetTargetCount())
+
+	// Deleting the last secret removes the target
+	require.NoError(t, secrets.Delete(ctx, "rootly-prod", metav1.DeleteOptions{}))
+	assert.Eventually(t, func() bool {
+		_, err := manager.GetTarget("rootly-prod")
+		return err != nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, []string{
+		"added:rootly-prod",
+		"updated:rootly-prod",
+		"updated:rootly-prod",
+		"deleted:rootly-prod",
+	}, publisher.snapshot())
+}
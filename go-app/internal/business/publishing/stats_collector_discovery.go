@@ -19,7 +19,7 @@ import (
 //   - discovery_errors_total (cumulative error count)
 //
 // Additional metrics (from ListTargets):
-//   - targets_by_type{type} (rootly, pagerduty, slack, webhook)
+//   - targets_by_type{type} (rootly, pagerduty, slack, opsgenie, email, msteams, telegram, webhook)
 //   - targets_enabled (count of enabled targets)
 //   - targets_disabled (count of disabled targets)
 //
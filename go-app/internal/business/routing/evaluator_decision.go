@@ -70,6 +70,18 @@ type RoutingDecision struct {
 	// Inherited from matched route (or root if no match).
 	RepeatInterval time.Duration
 
+	// MuteTimeIntervals are the matched route's mute time interval names.
+	//
+	// Notifications are muted while any of these intervals is active.
+	// Not inherited from parent routes.
+	MuteTimeIntervals []string
+
+	// ActiveTimeIntervals are the matched route's active time interval names.
+	//
+	// If non-empty, notifications are muted while none of these is active.
+	// Not inherited from parent routes.
+	ActiveTimeIntervals []string
+
 	// MatchedRoute is the path of matched route (for debugging).
 	//
 	// Example: "/routes[0]" or "/routes[0]/routes[1]"
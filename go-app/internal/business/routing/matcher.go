@@ -245,15 +245,16 @@ func (m *RouteMatcher) regexMatch(pattern string, value string) bool {
 
 // FindMatchingRoutes finds all routes matching the alert.
 //
-// Algorithm (DFS with early exit):
+// Algorithm (Alertmanager-compatible DFS):
 //  1. Start at tree root
-//  2. Walk tree depth-first:
-//     a. If node matches alert:
-//     - Add to results
-//     - If continue=false: stop traversal (early exit)
-//     - If continue=true: continue to siblings
-//     b. Recursively check children
-//  3. Return list of matched nodes with statistics
+//  2. If node matches alert, check its children in order:
+//     a. Collect matches from each matching child subtree
+//     b. Stop at the first matching child with continue=false
+//  3. If no child matched, the node itself is the match
+//  4. Return list of matched nodes with statistics
+//
+// Only the deepest matching routes are returned: a parent is returned
+// only when none of its children match (it acts as the default).
 //
 // Complexity:
 //   - Best case: O(1) (first node matches, continue=false)
@@ -284,46 +285,13 @@ func (m *RouteMatcher) FindMatchingRoutes(
 	}
 
 	start := time.Now()
-	stopped := false
 
 	// Get initial cache stats
 	initialStats := m.regexCache.Stats()
 
-	// DFS traversal with early exit
-	tree.Walk(func(node *RouteNode) bool {
-		if stopped {
-			return false
-		}
-
-		result.MatchersEvaluated += len(node.Matchers)
-
-		if m.MatchesNode(node, alert) {
-			result.Matches = append(result.Matches, node)
-
-			// Record match in metrics
-			if m.metrics != nil {
-				m.metrics.RecordMatch(node.Path, time.Since(start))
-			}
-
-			// Debug logging
-			if m.opts.EnableLogging {
-				slog.Debug("alert matched route",
-					"alert", alert.Labels["alertname"],
-					"route", node.Path,
-					"receiver", node.Receiver,
-					"matchers", len(node.Matchers),
-					"continue", node.Continue)
-			}
-
-			// Early exit if continue=false
-			if !node.Continue {
-				stopped = true
-				return false
-			}
-		}
-
-		return true // Continue to children
-	})
+	if tree.Root != nil {
+		result.Matches = m.matchNode(tree.Root, alert, result, start)
+	}
 
 	result.Duration = time.Since(start)
 
@@ -351,6 +319,56 @@ func (m *RouteMatcher) FindMatchingRoutes(
 	return result
 }
 
+// matchNode returns the deepest routes in node's subtree matching the alert.
+//
+// Returns nil if node itself does not match.
+func (m *RouteMatcher) matchNode(
+	node *RouteNode,
+	alert *Alert,
+	result *MatchResult,
+	start time.Time,
+) []*RouteNode {
+	result.MatchersEvaluated += len(node.Matchers)
+
+	if !m.MatchesNode(node, alert) {
+		return nil
+	}
+
+	var matches []*RouteNode
+	for _, child := range node.Children {
+		childMatches := m.matchNode(child, alert, result, start)
+		if len(childMatches) == 0 {
+			continue
+		}
+		matches = append(matches, childMatches...)
+
+		// Stop at first matching child unless it asks to continue
+		if !child.Continue {
+			break
+		}
+	}
+
+	if len(matches) > 0 {
+		return matches
+	}
+
+	// No child matched: this node is the match
+	if m.metrics != nil {
+		m.metrics.RecordMatch(node.Path, time.Since(start))
+	}
+
+	if m.opts.EnableLogging {
+		slog.Debug("alert matched route",
+			"alert", alert.Labels["alertname"],
+			"route", node.Path,
+			"receiver", node.Receiver,
+			"matchers", len(node.Matchers),
+			"continue", node.Continue)
+	}
+
+	return []*RouteNode{node}
+}
+
 // FindMatchingRoutesWithContext finds routes with context cancellation support.
 //
 // This variant allows cancelling long-running matching operations
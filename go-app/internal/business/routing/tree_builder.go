@@ -187,8 +187,10 @@ func (b *TreeBuilder) buildNode(
 		node.ReceiverConfig = b.tree.receivers[node.Receiver]
 	}
 
-	// 4. Set continue flag
+	// 4. Set continue flag and time intervals (not inherited)
 	node.Continue = route.Continue
+	node.MuteTimeIntervals = route.MuteTimeIntervals
+	node.ActiveTimeIntervals = route.ActiveTimeIntervals
 
 	// 5. Apply parameter inheritance
 	node.GroupBy = b.inheritGroupBy(parent, route)
@@ -407,6 +409,10 @@ type Route struct {
 	// Continue to next route after match
 	Continue bool
 
+	// Time intervals (names) muting or activating notifications
+	MuteTimeIntervals   []string
+	ActiveTimeIntervals []string
+
 	// Match conditions (label name → value)
 	Match map[string]string
 
@@ -80,6 +80,17 @@ type RouteNode struct {
 	// Default: false (stop after first match)
 	Continue bool
 
+	// Time Intervals (not inherited, Alertmanager-compatible)
+
+	// MuteTimeIntervals are named time intervals during which
+	// notifications for this route are muted.
+	MuteTimeIntervals []string
+
+	// ActiveTimeIntervals are named time intervals outside of which
+	// notifications for this route are muted.
+	// Empty means always active.
+	ActiveTimeIntervals []string
+
 	// Tree Structure
 
 	// Parent is the parent node in the routing tree.
@@ -300,6 +311,10 @@ type Receiver struct {
 	// SlackConfigs are Slack webhook configurations.
 	// Optional (at least one config type must be present).
 	SlackConfigs []*SlackConfig
+
+	// OpsgenieConfigs are Opsgenie Alert API v2 configurations.
+	// Optional (at least one config type must be present).
+	OpsgenieConfigs []*OpsgenieConfig
 }
 
 // WebhookConfig represents a generic webhook receiver configuration.
@@ -375,6 +390,38 @@ type SlackConfig struct {
 	HTTPConfig *HTTPConfig
 }
 
+// OpsgenieConfig represents an Opsgenie Alert API v2 receiver configuration.
+//
+// Alerts are created with alias = fingerprint and closed by alias on resolve.
+//
+// Example:
+//
+//	&OpsgenieConfig{
+//	    APIKey: "xxx",
+//	    Responders: []string{"team:sre"},
+//	}
+type OpsgenieConfig struct {
+	// APIKey is the Opsgenie API integration key (required).
+	APIKey string
+
+	// APIURL is the Opsgenie API base URL.
+	// Default: https://api.opsgenie.com
+	APIURL string
+
+	// Responders are "type:name" responder references
+	// (team, user, escalation, schedule).
+	// Optional (integration default responders are used if not set).
+	Responders []string
+
+	// Priority overrides severity-based priority (P1-P5).
+	// Optional.
+	Priority string
+
+	// HTTPConfig contains TLS configuration.
+	// Optional (APIKey is the primary auth mechanism).
+	HTTPConfig *HTTPConfig
+}
+
 // HTTPConfig represents HTTP client configuration for receivers.
 //
 // Used for authentication and TLS settings.
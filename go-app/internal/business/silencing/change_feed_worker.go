@@ -0,0 +1,179 @@
+package silencing
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/vitaliisemenov/alert-history/internal/core/silencing"
+	infrasilencing "github.com/vitaliisemenov/alert-history/internal/infrastructure/silencing"
+)
+
+// changeFeedWorker applies silence change events published by other replicas
+// to the in-memory cache.
+//
+// Events are applied incrementally:
+//   - created/updated: the silence is reloaded from the repository and cached
+//     if active (removed from cache otherwise)
+//   - deleted/expired: the silence is removed from cache
+//   - resync (feed reconnected, events may be lost): full cache sync
+//
+// Events published by this replica (same origin) are skipped: the manager
+// already updated its cache. The syncWorker keeps running as a safety net
+// for lost events.
+//
+// Example usage:
+//
+//	worker := newChangeFeedWorker(feed, instanceID, repo, cache, syncWorker.runSync, logger, metrics)
+//	worker.Start(ctx)
+//	defer worker.Stop()
+type changeFeedWorker struct {
+	feed       infrasilencing.SilenceChangeFeed
+	instanceID string
+	repo       infrasilencing.SilenceRepository
+	cache      *silenceCache
+	resync     func(ctx context.Context) // Full cache sync (syncWorker.runSync)
+
+	logger  *slog.Logger
+	metrics *SilenceMetrics
+
+	stopCh chan struct{} // Signal to stop worker
+	doneCh chan struct{} // Signal when worker stopped
+}
+
+// newChangeFeedWorker creates a new change feed worker (not started).
+func newChangeFeedWorker(
+	feed infrasilencing.SilenceChangeFeed,
+	instanceID string,
+	repo infrasilencing.SilenceRepository,
+	cache *silenceCache,
+	resync func(ctx context.Context),
+	logger *slog.Logger,
+	metrics *SilenceMetrics,
+) *changeFeedWorker {
+	return &changeFeedWorker{
+		feed:       feed,
+		instanceID: instanceID,
+		repo:       repo,
+		cache:      cache,
+		resync:     resync,
+		logger:     logger,
+		metrics:    metrics,
+		stopCh:     make(chan struct{}),
+		doneCh:     make(chan struct{}),
+	}
+}
+
+// Start subscribes to the change feed and applies events in a background
+// goroutine.
+//
+// Returns an error if the subscription fails; the manager then relies on
+// the periodic sync only.
+func (w *changeFeedWorker) Start(ctx context.Context) error {
+	events, err := w.feed.Subscribe(ctx)
+	if err != nil {
+		close(w.doneCh)
+		return err
+	}
+
+	go w.run(ctx, events)
+	w.logger.Info("Silence change feed worker started", "instance_id", w.instanceID)
+	return nil
+}
+
+// run is the main worker loop.
+func (w *changeFeedWorker) run(ctx context.Context, events <-chan infrasilencing.SilenceChangeEvent) {
+	defer close(w.doneCh)
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Silence change feed worker stopped (context cancelled)")
+			return
+
+		case <-w.stopCh:
+			w.logger.Info("Silence change feed worker stopped (explicit stop)")
+			return
+
+		case event, ok := <-events:
+			if !ok {
+				w.logger.Warn("Silence change feed closed, relying on periodic sync")
+				return
+			}
+			w.apply(ctx, event)
+		}
+	}
+}
+
+// apply updates the cache for a single change event.
+func (w *changeFeedWorker) apply(ctx context.Context, event infrasilencing.SilenceChangeEvent) {
+	if event.Origin != "" && event.Origin == w.instanceID {
+		return
+	}
+
+	if w.metrics != nil {
+		w.metrics.RecordChangeEvent("received", string(event.Type))
+	}
+
+	switch event.Type {
+	case infrasilencing.SilenceChangeResync:
+		w.logger.Info("Silence change feed requested resync")
+		w.resync(ctx)
+
+	case infrasilencing.SilenceChangeDeleted, infrasilencing.SilenceChangeExpired:
+		w.cache.Delete(event.SilenceID)
+		w.logger.Debug("Removed silence from cache (change feed)",
+			"silence_id", event.SilenceID,
+			"type", event.Type,
+			"origin", event.Origin)
+
+	case infrasilencing.SilenceChangeCreated, infrasilencing.SilenceChangeUpdated:
+		w.reload(ctx, event)
+
+	default:
+		w.logger.Warn("Unknown silence change event type", "type", event.Type)
+	}
+}
+
+// reload fetches a created/updated silence and refreshes its cache entry.
+func (w *changeFeedWorker) reload(ctx context.Context, event infrasilencing.SilenceChangeEvent) {
+	fetchCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	silence, err := w.repo.GetSilenceByID(fetchCtx, event.SilenceID)
+	if err != nil {
+		if errors.Is(err, infrasilencing.ErrSilenceNotFound) {
+			w.cache.Delete(event.SilenceID)
+			return
+		}
+		w.logger.Warn("Failed to reload changed silence, waiting for periodic sync",
+			"silence_id", event.SilenceID,
+			"error", err)
+		return
+	}
+
+	if silence.IsActive() {
+		silence.Status = silencing.SilenceStatusActive
+		w.cache.Set(silence)
+	} else {
+		w.cache.Delete(silence.ID)
+	}
+
+	w.logger.Debug("Applied silence change from change feed",
+		"silence_id", silence.ID,
+		"type", event.Type,
+		"origin", event.Origin,
+		"active", silence.IsActive())
+}
+
+// Stop gracefully stops the worker.
+func (w *changeFeedWorker) Stop() {
+	select {
+	case <-w.doneCh:
+		return
+	default:
+	}
+	close(w.stopCh)
+	<-w.doneCh
+}
@@ -0,0 +1,134 @@
+package silencing
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vitaliisemenov/alert-history/internal/core/silencing"
+	infrasilencing "github.com/vitaliisemenov/alert-history/internal/infrastructure/silencing"
+)
+
+// newTestReplica creates a started manager (workers not running) sharing feed.
+func newTestReplica(t *testing.T, feed infrasilencing.SilenceChangeFeed) (*DefaultSilenceManager, *mockRepository) {
+	t.Helper()
+	repo := new(mockRepository)
+	config := DefaultSilenceManagerConfig()
+	config.ChangeFeed = feed
+
+	manager := NewDefaultSilenceManager(repo, &mockMatcher{}, slog.Default(), &config)
+	manager.started.Store(true) // Fake Start() for testing
+
+	ctx, cancel := context.WithCancel(context.Background())
+	require.NoError(t, manager.changeFeedWorker.Start(ctx))
+	t.Cleanup(func() {
+		cancel()
+		manager.changeFeedWorker.Stop()
+	})
+
+	return manager, repo
+}
+
+// TestChangeFeed_PropagatesBetweenReplicas tests create/delete propagation.
+func TestChangeFeed_PropagatesBetweenReplicas(t *testing.T) {
+	feed := infrasilencing.NewMemoryChangeFeed()
+	defer feed.Close()
+
+	podA, repoA := newTestReplica(t, feed)
+	podB, repoB := newTestReplica(t, feed)
+	ctx := context.Background()
+
+	silence := newTestSilence("shared-id", silencing.SilenceStatusActive)
+	repoA.On("CreateSilence", mock.Anything, silence).Return(silence, nil)
+	repoA.On("DeleteSilence", mock.Anything, "shared-id").Return(nil)
+	repoB.On("GetSilenceByID", mock.Anything, "shared-id").Return(silence, nil)
+
+	_, err := podA.CreateSilence(ctx, silence)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		_, found := podB.cache.Get("shared-id")
+		return found
+	}, time.Second, 5*time.Millisecond, "Silence created on pod A must reach pod B cache")
+
+	require.NoError(t, podA.DeleteSilence(ctx, "shared-id"))
+
+	require.Eventually(t, func() bool {
+		_, found := podB.cache.Get("shared-id")
+		return !found
+	}, time.Second, 5*time.Millisecond, "Deleted silence must leave pod B cache")
+
+	// Pod A skips its own events (no repository reload)
+	repoA.AssertNotCalled(t, "GetSilenceByID", mock.Anything, mock.Anything)
+}
+
+// TestChangeFeed_UpdateToInactiveEvicts tests that an update ending a silence evicts it.
+func TestChangeFeed_UpdateToInactiveEvicts(t *testing.T) {
+	feed := infrasilencing.NewMemoryChangeFeed()
+	defer feed.Close()
+
+	podB, repoB := newTestReplica(t, feed)
+	podB.cache.Set(newTestSilence("ended-id", silencing.SilenceStatusActive))
+
+	ended := newTestSilence("ended-id", silencing.SilenceStatusActive)
+	ended.EndsAt = time.Now().Add(-time.Second)
+	repoB.On("GetSilenceByID", mock.Anything, "ended-id").Return(ended, nil)
+
+	require.NoError(t, feed.Publish(context.Background(), infrasilencing.SilenceChangeEvent{
+		Type:      infrasilencing.SilenceChangeUpdated,
+		SilenceID: "ended-id",
+		Origin:    "other-pod",
+	}))
+
+	require.Eventually(t, func() bool {
+		_, found := podB.cache.Get("ended-id")
+		return !found
+	}, time.Second, 5*time.Millisecond)
+}
+
+// TestChangeFeed_ResyncRebuildsCache tests the resync event (feed reconnected).
+func TestChangeFeed_ResyncRebuildsCache(t *testing.T) {
+	feed := infrasilencing.NewMemoryChangeFeed()
+	defer feed.Close()
+
+	podB, repoB := newTestReplica(t, feed)
+	podB.cache.Set(newTestSilence("stale-id", silencing.SilenceStatusActive))
+
+	repoB.On("ListSilences", mock.Anything, mock.Anything).
+		Return([]*silencing.Silence{newTestSilence("fresh-id", silencing.SilenceStatusActive)}, nil)
+
+	require.NoError(t, feed.Publish(context.Background(), infrasilencing.SilenceChangeEvent{
+		Type: infrasilencing.SilenceChangeResync,
+	}))
+
+	require.Eventually(t, func() bool {
+		_, fresh := podB.cache.Get("fresh-id")
+		_, stale := podB.cache.Get("stale-id")
+		return fresh && !stale
+	}, time.Second, 5*time.Millisecond)
+}
+
+// TestGetActiveSilences_EvictsEndedSilences tests that ended silences stop muting
+// before the next sync/GC run.
+func TestGetActiveSilences_EvictsEndedSilences(t *testing.T) {
+	manager, repo := newTestManagerWithMock()
+
+	ended := newTestSilence("ended-id", silencing.SilenceStatusActive)
+	ended.EndsAt = time.Now().Add(-time.Second)
+	manager.cache.Set(ended)
+	manager.cache.Set(newTestSilence("active-id", silencing.SilenceStatusActive))
+
+	silences, err := manager.GetActiveSilences(context.Background())
+	require.NoError(t, err)
+	require.Len(t, silences, 1)
+	assert.Equal(t, "active-id", silences[0].ID)
+
+	_, found := manager.cache.Get("ended-id")
+	assert.False(t, found)
+	repo.AssertNotCalled(t, "ListSilences", mock.Anything, mock.Anything)
+}
@@ -328,6 +328,11 @@ type SilenceManagerConfig struct {
 	// Sync Worker Settings
 	SyncInterval time.Duration // How often to sync cache (default: 1m)
 
+	// ChangeFeed pushes silence changes between replicas for instant cache
+	// updates (optional, nil = periodic sync only). The periodic sync keeps
+	// running as a safety net for lost events.
+	ChangeFeed infrasilencing.SilenceChangeFeed
+
 	// Cache Settings
 	CacheEnabled bool          // Enable in-memory cache (default: true)
 	CacheTTL     time.Duration // Not used (cache always fresh)
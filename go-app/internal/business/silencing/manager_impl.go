@@ -8,6 +8,8 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/vitaliisemenov/alert-history/internal/core/silencing"
 	infrasilencing "github.com/vitaliisemenov/alert-history/internal/infrastructure/silencing"
 )
@@ -19,6 +21,7 @@ import (
 //   - Matching layer: SilenceMatcher (regex-based)
 //   - Cache layer: In-memory silenceCache (fast lookups)
 //   - Worker layer: gcWorker, syncWorker (background tasks)
+//   - Replication layer: changeFeedWorker (optional, cross-replica cache updates)
 //
 // Lifecycle:
 //  1. Create with NewDefaultSilenceManager()
@@ -53,8 +56,13 @@ type DefaultSilenceManager struct {
 	cache *silenceCache
 
 	// Background workers
-	gcWorker   *gcWorker   // Garbage collection worker
-	syncWorker *syncWorker // Cache synchronization worker
+	gcWorker         *gcWorker         // Garbage collection worker
+	syncWorker       *syncWorker       // Cache synchronization worker
+	changeFeedWorker *changeFeedWorker // Cross-replica change events (nil without ChangeFeed)
+
+	// Replication
+	changeFeed infrasilencing.SilenceChangeFeed // Optional change feed
+	instanceID string                           // Origin of published change events
 
 	// Observability
 	metrics *SilenceMetrics
@@ -126,6 +134,7 @@ func NewDefaultSilenceManager(
 	if err := config.Validate(); err != nil {
 		logger.Warn("Invalid configuration, using defaults", "error", err)
 		defaultCfg := DefaultSilenceManagerConfig()
+		defaultCfg.ChangeFeed = config.ChangeFeed
 		config = &defaultCfg
 	}
 
@@ -142,6 +151,9 @@ func NewDefaultSilenceManager(
 		config:  *config,
 		ctx:     ctx,
 		cancel:  cancel,
+
+		changeFeed: config.ChangeFeed,
+		instanceID: uuid.NewString(),
 	}
 
 	// Initialize workers (not started yet)
@@ -162,10 +174,23 @@ func NewDefaultSilenceManager(
 		sm.metrics,
 	)
 
+	if config.ChangeFeed != nil {
+		sm.changeFeedWorker = newChangeFeedWorker(
+			config.ChangeFeed,
+			sm.instanceID,
+			repo,
+			sm.cache,
+			sm.syncWorker.runSync,
+			logger,
+			sm.metrics,
+		)
+	}
+
 	logger.Info("Silence manager created",
 		"gc_interval", config.GCInterval,
 		"gc_retention", config.GCRetention,
 		"sync_interval", config.SyncInterval,
+		"change_feed", config.ChangeFeed != nil,
 	)
 
 	return sm
@@ -239,6 +264,9 @@ func (sm *DefaultSilenceManager) CreateSilence(
 		sm.logger.Debug("Added active silence to cache", "silence_id", created.ID)
 	}
 
+	// Step 4: Notify other replicas
+	sm.publishChange(ctx, infrasilencing.SilenceChangeCreated, created.ID)
+
 	sm.logger.Info("Silence created",
 		"silence_id", created.ID,
 		"created_by", created.CreatedBy,
@@ -338,6 +366,13 @@ func (sm *DefaultSilenceManager) UpdateSilence(
 		sm.logger.Debug("Re-added updated silence to cache", "silence_id", silence.ID)
 	}
 
+	// Step 5: Notify other replicas
+	changeType := infrasilencing.SilenceChangeUpdated
+	if silence.Status == silencing.SilenceStatusExpired {
+		changeType = infrasilencing.SilenceChangeExpired
+	}
+	sm.publishChange(ctx, changeType, silence.ID)
+
 	sm.logger.Info("Silence updated",
 		"silence_id", silence.ID,
 		"status", silence.Status,
@@ -379,6 +414,9 @@ func (sm *DefaultSilenceManager) DeleteSilence(
 	sm.cache.Delete(id)
 	sm.logger.Debug("Removed silence from cache", "silence_id", id)
 
+	// Step 4: Notify other replicas
+	sm.publishChange(ctx, infrasilencing.SilenceChangeDeleted, id)
+
 	sm.logger.Info("Silence deleted", "silence_id", id)
 
 	return nil
@@ -457,8 +495,9 @@ func (sm *DefaultSilenceManager) GetActiveSilences(
 		return nil, ErrManagerShutdown
 	}
 
-	// Step 2: Fast path - return from cache
-	silences := sm.cache.GetByStatus(silencing.SilenceStatusActive)
+	// Step 2: Fast path - return from cache (silences ended since the
+	// last sync are evicted here, so they stop muting exactly at EndsAt)
+	silences := sm.activeFromCache()
 
 	// Step 3: Fallback - query repository if cache is empty
 	if len(silences) == 0 {
@@ -478,6 +517,9 @@ func (sm *DefaultSilenceManager) GetActiveSilences(
 			return nil, fmt.Errorf("get active silences: %w", err)
 		}
 
+		// Status column is only updated by GC: skip silences that already ended
+		silences = filterEnded(silences)
+
 		sm.logger.Debug("Fetched active silences from repository", "count", len(silences))
 	} else {
 		sm.logger.Debug("Returned active silences from cache", "count", len(silences))
@@ -575,6 +617,60 @@ func (sm *DefaultSilenceManager) IsAlertSilenced(
 	return silenced, matchedIDs, nil
 }
 
+// activeFromCache returns cached active silences, evicting the ones that
+// are no longer active (EndsAt passed since the last sync).
+func (sm *DefaultSilenceManager) activeFromCache() []*silencing.Silence {
+	cached := sm.cache.GetByStatus(silencing.SilenceStatusActive)
+
+	silences := cached[:0:0]
+	for _, silence := range cached {
+		if silence.CalculateStatus() == silencing.SilenceStatusExpired {
+			sm.cache.Delete(silence.ID)
+			sm.logger.Debug("Evicted ended silence from cache", "silence_id", silence.ID)
+			continue
+		}
+		silences = append(silences, silence)
+	}
+	return silences
+}
+
+// filterEnded drops silences whose EndsAt has passed.
+func filterEnded(silences []*silencing.Silence) []*silencing.Silence {
+	result := silences[:0:0]
+	for _, silence := range silences {
+		if silence.CalculateStatus() != silencing.SilenceStatusExpired {
+			result = append(result, silence)
+		}
+	}
+	return result
+}
+
+// publishChange announces a silence change to other replicas.
+//
+// Best-effort: failures are logged, the periodic sync repairs other replicas.
+func (sm *DefaultSilenceManager) publishChange(ctx context.Context, changeType infrasilencing.SilenceChangeType, id string) {
+	if sm.changeFeed == nil {
+		return
+	}
+
+	event := infrasilencing.SilenceChangeEvent{
+		Type:      changeType,
+		SilenceID: id,
+		Origin:    sm.instanceID,
+		Timestamp: time.Now(),
+	}
+	if err := sm.changeFeed.Publish(ctx, event); err != nil {
+		sm.metrics.RecordChangeEvent("publish_error", string(changeType))
+		sm.logger.Warn("Failed to publish silence change",
+			"silence_id", id,
+			"type", changeType,
+			"error", err,
+		)
+		return
+	}
+	sm.metrics.RecordChangeEvent("published", string(changeType))
+}
+
 // ==================== Lifecycle Management Implementation ====================
 
 // Start initializes and starts the SilenceManager and its background workers.
@@ -638,6 +734,14 @@ func (sm *DefaultSilenceManager) Start(ctx context.Context) error {
 	// Step 4: Start background workers
 	sm.gcWorker.Start(sm.ctx)
 	sm.syncWorker.Start(sm.ctx)
+	if sm.changeFeedWorker != nil {
+		if err := sm.changeFeedWorker.Start(sm.ctx); err != nil {
+			// Not fatal: the periodic sync still converges the cache
+			sm.logger.Warn("Failed to subscribe to silence change feed, using periodic sync only",
+				"error", err,
+			)
+		}
+	}
 
 	// Step 5: Mark as started
 	sm.started.Store(true)
@@ -700,6 +804,9 @@ func (sm *DefaultSilenceManager) Stop(ctx context.Context) error {
 	go func() {
 		sm.gcWorker.Stop()
 		sm.syncWorker.Stop()
+		if sm.changeFeedWorker != nil {
+			sm.changeFeedWorker.Stop()
+		}
 		close(doneCh)
 	}()
 
@@ -25,6 +25,8 @@ var (
 //  6. silence_manager_gc_runs_total - Counter of GC worker runs
 //  7. silence_manager_gc_cleaned_total - Counter of silences cleaned by GC
 //  8. silence_manager_sync_runs_total - Counter of sync worker runs
+//  9. silence_manager_change_events_total - Counter of cross-replica change feed events
+//  10. silence_manager_recurring_occurrences_total - Counter of materialised recurring silence occurrences
 //
 // Usage:
 //
@@ -56,6 +58,12 @@ type SilenceMetrics struct {
 	// 8. Sync worker runs
 	SyncRuns prometheus.Counter
 
+	// 9. Change feed events (direction: published/received/publish_error, type: created/updated/deleted/expired/resync)
+	ChangeEvents *prometheus.CounterVec
+
+	// 10. Recurring silence occurrences (result: created/error)
+	RecurringOccurrences *prometheus.CounterVec
+
 	// Internal counters for GetStats()
 	cacheHits     atomic.Uint64
 	cacheMisses   atomic.Uint64
@@ -151,6 +159,24 @@ func NewSilenceMetrics() *SilenceMetrics {
 			},
 		),
 
+		// 9. Change feed events
+		ChangeEvents: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "alert_history_business_silence_manager_change_events_total",
+				Help: "Total number of cross-replica silence change events by direction and type",
+			},
+			[]string{"direction", "type"},
+		),
+
+		// 10. Recurring silence occurrences
+		RecurringOccurrences: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "alert_history_business_silence_manager_recurring_occurrences_total",
+				Help: "Total number of materialised recurring silence occurrences by result",
+			},
+			[]string{"result"},
+		),
+
 			startTime: time.Now(),
 		}
 	})
@@ -197,6 +223,11 @@ func (m *SilenceMetrics) RecordCacheMiss(operation string) {
 	m.cacheMisses.Add(1)
 }
 
+// RecordChangeEvent records a change feed event (direction: published/received/publish_error).
+func (m *SilenceMetrics) RecordChangeEvent(direction, eventType string) {
+	m.ChangeEvents.WithLabelValues(direction, eventType).Inc()
+}
+
 // RecordGCRun records a GC worker run.
 func (m *SilenceMetrics) RecordGCRun(phase string, cleaned int64) {
 	m.GCRuns.WithLabelValues(phase).Inc()
@@ -0,0 +1,369 @@
+package silencing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/vitaliisemenov/alert-history/internal/core/silencing"
+	infrasilencing "github.com/vitaliisemenov/alert-history/internal/infrastructure/silencing"
+)
+
+// RecurringSilenceManager manages recurring silence series.
+//
+// A series (e.g. "every Saturday 22:00 Europe/Berlin for 2h") is
+// materialised ahead of time into concrete silences through the
+// SilenceManager, so alert filtering, the silence API and the UI handle
+// them like any other silence.
+//
+// Editing a series re-materialises its future (pending) occurrences; an
+// occurrence that is already running keeps its silence. Cancelling a series
+// deletes its pending silences and ends the running one.
+type RecurringSilenceManager interface {
+	// CreateSeries validates and stores a series and materialises its first occurrences.
+	CreateSeries(ctx context.Context, series *silencing.RecurringSilence) (*silencing.RecurringSilence, error)
+
+	// GetSeries returns a series by ID.
+	GetSeries(ctx context.Context, id string) (*silencing.RecurringSilence, error)
+
+	// ListSeries returns all series (cancelled ones if includeCancelled is set).
+	ListSeries(ctx context.Context, includeCancelled bool) ([]*silencing.RecurringSilence, error)
+
+	// UpdateSeries updates a series and re-materialises its pending occurrences.
+	UpdateSeries(ctx context.Context, series *silencing.RecurringSilence) error
+
+	// CancelSeries cancels a series: pending silences are deleted, the running one is ended.
+	CancelSeries(ctx context.Context, id string) error
+
+	// ListOccurrences returns the materialised occurrences of a series.
+	ListOccurrences(ctx context.Context, id string) ([]*silencing.RecurringOccurrence, error)
+}
+
+// RecurringSilenceConfig configures the DefaultRecurringSilenceManager.
+type RecurringSilenceConfig struct {
+	// Interval is how often series are materialised (default: 5m)
+	Interval time.Duration
+
+	// Horizon is how far ahead silences are materialised (default: 7d)
+	Horizon time.Duration
+
+	// MaxOccurrences bounds the silences materialised per series and run (default: 50)
+	MaxOccurrences int
+
+	// Retention is how long occurrence records are kept (default: 30d)
+	Retention time.Duration
+}
+
+// DefaultRecurringSilenceConfig returns the default configuration.
+func DefaultRecurringSilenceConfig() RecurringSilenceConfig {
+	return RecurringSilenceConfig{
+		Interval:       5 * time.Minute,
+		Horizon:        7 * 24 * time.Hour,
+		MaxOccurrences: 50,
+		Retention:      30 * 24 * time.Hour,
+	}
+}
+
+// DefaultRecurringSilenceManager implements RecurringSilenceManager.
+//
+// Materialisation is idempotent across replicas: every occurrence is claimed
+// in the repository before its silence is created.
+type DefaultRecurringSilenceManager struct {
+	repo     infrasilencing.RecurringSilenceRepository
+	silences SilenceManager
+	logger   *slog.Logger
+	metrics  *SilenceMetrics
+	config   RecurringSilenceConfig
+
+	// now is overridden in tests
+	now func() time.Time
+
+	stopOnce sync.Once
+	stopCh   chan struct{}
+	doneCh   chan struct{}
+}
+
+// NewDefaultRecurringSilenceManager creates a new recurring silence manager.
+// Call Start to materialise series periodically.
+//
+// Parameters:
+//   - repo: Recurring silence repository (required)
+//   - silences: Silence manager creating the concrete silences (required)
+//   - logger: Structured logger (optional, defaults to slog.Default())
+//   - config: Configuration (optional, defaults to DefaultRecurringSilenceConfig())
+func NewDefaultRecurringSilenceManager(
+	repo infrasilencing.RecurringSilenceRepository,
+	silences SilenceManager,
+	logger *slog.Logger,
+	config *RecurringSilenceConfig,
+) *DefaultRecurringSilenceManager {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	cfg := DefaultRecurringSilenceConfig()
+	if config != nil {
+		if config.Interval > 0 {
+			cfg.Interval = config.Interval
+		}
+		if config.Horizon > 0 {
+			cfg.Horizon = config.Horizon
+		}
+		if config.MaxOccurrences > 0 {
+			cfg.MaxOccurrences = config.MaxOccurrences
+		}
+		if config.Retention > 0 {
+			cfg.Retention = config.Retention
+		}
+	}
+
+	return &DefaultRecurringSilenceManager{
+		repo:     repo,
+		silences: silences,
+		logger:   logger.With("component", "recurring_silences"),
+		metrics:  NewSilenceMetrics(),
+		config:   cfg,
+		now:      time.Now,
+		stopCh:   make(chan struct{}),
+		doneCh:   make(chan struct{}),
+	}
+}
+
+// CreateSeries implements RecurringSilenceManager.CreateSeries.
+func (m *DefaultRecurringSilenceManager) CreateSeries(ctx context.Context, series *silencing.RecurringSilence) (*silencing.RecurringSilence, error) {
+	if err := series.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %s", infrasilencing.ErrValidation, err)
+	}
+
+	created, err := m.repo.CreateRecurringSilence(ctx, series)
+	if err != nil {
+		return nil, err
+	}
+
+	m.logger.Info("Recurring silence created",
+		"id", created.ID,
+		"schedule", created.Schedule,
+		"timezone", created.Timezone,
+		"duration", created.Duration,
+	)
+
+	if err := m.materialize(ctx, created); err != nil {
+		// The periodic run retries
+		m.logger.Warn("Failed to materialise recurring silence", "id", created.ID, "error", err)
+	}
+	return created, nil
+}
+
+// GetSeries implements RecurringSilenceManager.GetSeries.
+func (m *DefaultRecurringSilenceManager) GetSeries(ctx context.Context, id string) (*silencing.RecurringSilence, error) {
+	return m.repo.GetRecurringSilence(ctx, id)
+}
+
+// ListSeries implements RecurringSilenceManager.ListSeries.
+func (m *DefaultRecurringSilenceManager) ListSeries(ctx context.Context, includeCancelled bool) ([]*silencing.RecurringSilence, error) {
+	return m.repo.ListRecurringSilences(ctx, includeCancelled)
+}
+
+// UpdateSeries implements RecurringSilenceManager.UpdateSeries.
+func (m *DefaultRecurringSilenceManager) UpdateSeries(ctx context.Context, series *silencing.RecurringSilence) error {
+	if err := series.Validate(); err != nil {
+		return fmt.Errorf("%w: %s", infrasilencing.ErrValidation, err)
+	}
+	if err := m.repo.UpdateRecurringSilence(ctx, series); err != nil {
+		return err
+	}
+
+	// Pending occurrences were materialised from the old definition
+	if err := m.dropOccurrences(ctx, series, false); err != nil {
+		return err
+	}
+
+	m.logger.Info("Recurring silence updated", "id", series.ID, "schedule", series.Schedule)
+	return m.materialize(ctx, series)
+}
+
+// CancelSeries implements RecurringSilenceManager.CancelSeries.
+func (m *DefaultRecurringSilenceManager) CancelSeries(ctx context.Context, id string) error {
+	series, err := m.repo.GetRecurringSilence(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := m.repo.CancelRecurringSilence(ctx, id, m.now()); err != nil {
+		return err
+	}
+	if err := m.dropOccurrences(ctx, series, true); err != nil {
+		return err
+	}
+
+	m.logger.Info("Recurring silence cancelled", "id", id)
+	return nil
+}
+
+// ListOccurrences implements RecurringSilenceManager.ListOccurrences.
+func (m *DefaultRecurringSilenceManager) ListOccurrences(ctx context.Context, id string) ([]*silencing.RecurringOccurrence, error) {
+	if _, err := m.repo.GetRecurringSilence(ctx, id); err != nil {
+		return nil, err
+	}
+	return m.repo.ListOccurrences(ctx, id)
+}
+
+// Start materialises all series now and then every Interval.
+func (m *DefaultRecurringSilenceManager) Start(ctx context.Context) {
+	go func() {
+		defer close(m.doneCh)
+
+		ticker := time.NewTicker(m.config.Interval)
+		defer ticker.Stop()
+
+		m.RunOnce(ctx)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-m.stopCh:
+				return
+			case <-ticker.C:
+				m.RunOnce(ctx)
+			}
+		}
+	}()
+
+	m.logger.Info("Recurring silence materialiser started",
+		"interval", m.config.Interval,
+		"horizon", m.config.Horizon,
+	)
+}
+
+// Stop stops the periodic materialisation and waits for a running pass.
+func (m *DefaultRecurringSilenceManager) Stop() {
+	m.stopOnce.Do(func() {
+		close(m.stopCh)
+	})
+	<-m.doneCh
+	m.logger.Info("Recurring silence materialiser stopped")
+}
+
+// RunOnce materialises all active series and prunes old occurrence records.
+// Errors are logged; a failing series does not block the others.
+func (m *DefaultRecurringSilenceManager) RunOnce(ctx context.Context) {
+	series, err := m.repo.ListRecurringSilences(ctx, false)
+	if err != nil {
+		m.logger.Error("Failed to list recurring silences", "error", err)
+		return
+	}
+
+	for _, s := range series {
+		if err := m.materialize(ctx, s); err != nil {
+			m.logger.Error("Failed to materialise recurring silence", "id", s.ID, "error", err)
+		}
+	}
+
+	if pruned, err := m.repo.PruneOccurrences(ctx, m.now().Add(-m.config.Retention)); err != nil {
+		m.logger.Warn("Failed to prune recurring silence occurrences", "error", err)
+	} else if pruned > 0 {
+		m.logger.Debug("Pruned recurring silence occurrences", "count", pruned)
+	}
+}
+
+// materialize creates the silences of the occurrences within the horizon.
+func (m *DefaultRecurringSilenceManager) materialize(ctx context.Context, series *silencing.RecurringSilence) error {
+	if series.IsCancelled() {
+		return nil
+	}
+
+	now := m.now()
+	starts, err := series.Occurrences(now, now.Add(m.config.Horizon), m.config.MaxOccurrences)
+	if err != nil {
+		return err
+	}
+
+	for _, start := range starts {
+		claimed, err := m.repo.ClaimOccurrence(ctx, series.ID, start)
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			continue // Already materialised (possibly by another replica)
+		}
+
+		created, err := m.silences.CreateSilence(ctx, series.NewSilence(start))
+		if err != nil {
+			m.recordOccurrence("error")
+			if releaseErr := m.repo.ReleaseOccurrence(ctx, series.ID, start); releaseErr != nil {
+				m.logger.Warn("Failed to release occurrence", "id", series.ID, "starts_at", start, "error", releaseErr)
+			}
+			return fmt.Errorf("create silence for %s: %w", start.Format(time.RFC3339), err)
+		}
+		if err := m.repo.SetOccurrenceSilence(ctx, series.ID, start, created.ID); err != nil {
+			return err
+		}
+
+		m.recordOccurrence("created")
+		m.logger.Debug("Recurring silence occurrence materialised",
+			"id", series.ID,
+			"silence_id", created.ID,
+			"starts_at", start,
+		)
+	}
+	return nil
+}
+
+// dropOccurrences removes the silences of a series' pending occurrences.
+// If endRunning is set, the silence of the running occurrence is ended now.
+func (m *DefaultRecurringSilenceManager) dropOccurrences(ctx context.Context, series *silencing.RecurringSilence, endRunning bool) error {
+	occurrences, err := m.repo.ListOccurrences(ctx, series.ID)
+	if err != nil {
+		return err
+	}
+
+	now := m.now()
+	for _, occurrence := range occurrences {
+		if occurrence.StartsAt.After(now) {
+			if occurrence.SilenceID != "" {
+				err := m.silences.DeleteSilence(ctx, occurrence.SilenceID)
+				if err != nil && !errors.Is(err, infrasilencing.ErrSilenceNotFound) {
+					return fmt.Errorf("delete silence %s: %w", occurrence.SilenceID, err)
+				}
+			}
+			if err := m.repo.ReleaseOccurrence(ctx, series.ID, occurrence.StartsAt); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if endRunning && occurrence.SilenceID != "" {
+			if err := m.endSilence(ctx, occurrence.SilenceID, now); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// endSilence ends a running silence at the given time.
+func (m *DefaultRecurringSilenceManager) endSilence(ctx context.Context, id string, at time.Time) error {
+	silence, err := m.silences.GetSilence(ctx, id)
+	if errors.Is(err, infrasilencing.ErrSilenceNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("get silence %s: %w", id, err)
+	}
+	if !silence.EndsAt.After(at) {
+		return nil // Already ended
+	}
+
+	silence.EndsAt = at
+	if err := m.silences.UpdateSilence(ctx, silence); err != nil {
+		return fmt.Errorf("end silence %s: %w", id, err)
+	}
+	return nil
+}
+
+func (m *DefaultRecurringSilenceManager) recordOccurrence(result string) {
+	if m.metrics != nil {
+		m.metrics.RecurringOccurrences.WithLabelValues(result).Inc()
+	}
+}
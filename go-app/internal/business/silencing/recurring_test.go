@@ -0,0 +1,306 @@
+package silencing
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vitaliisemenov/alert-history/internal/core/silencing"
+	infrasilencing "github.com/vitaliisemenov/alert-history/internal/infrastructure/silencing"
+)
+
+// memoryRecurringRepository is an in-memory RecurringSilenceRepository.
+type memoryRecurringRepository struct {
+	mu          sync.Mutex
+	series      map[string]*silencing.RecurringSilence
+	occurrences map[string]map[time.Time]string
+}
+
+func newMemoryRecurringRepository() *memoryRecurringRepository {
+	return &memoryRecurringRepository{
+		series:      make(map[string]*silencing.RecurringSilence),
+		occurrences: make(map[string]map[time.Time]string),
+	}
+}
+
+func (r *memoryRecurringRepository) CreateRecurringSilence(ctx context.Context, series *silencing.RecurringSilence) (*silencing.RecurringSilence, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	series.ID = uuid.NewString()
+	series.CreatedAt = time.Now()
+	copied := *series
+	r.series[series.ID] = &copied
+	r.occurrences[series.ID] = make(map[time.Time]string)
+	return series, nil
+}
+
+func (r *memoryRecurringRepository) GetRecurringSilence(ctx context.Context, id string) (*silencing.RecurringSilence, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	series, ok := r.series[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", infrasilencing.ErrRecurringSilenceNotFound, id)
+	}
+	copied := *series
+	return &copied, nil
+}
+
+func (r *memoryRecurringRepository) ListRecurringSilences(ctx context.Context, includeCancelled bool) ([]*silencing.RecurringSilence, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var result []*silencing.RecurringSilence
+	for _, series := range r.series {
+		if includeCancelled || !series.IsCancelled() {
+			copied := *series
+			result = append(result, &copied)
+		}
+	}
+	return result, nil
+}
+
+func (r *memoryRecurringRepository) UpdateRecurringSilence(ctx context.Context, series *silencing.RecurringSilence) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	existing, ok := r.series[series.ID]
+	if !ok || existing.IsCancelled() {
+		return infrasilencing.ErrRecurringSilenceNotFound
+	}
+	copied := *series
+	r.series[series.ID] = &copied
+	return nil
+}
+
+func (r *memoryRecurringRepository) CancelRecurringSilence(ctx context.Context, id string, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	series, ok := r.series[id]
+	if !ok || series.IsCancelled() {
+		return infrasilencing.ErrRecurringSilenceNotFound
+	}
+	series.CancelledAt = &at
+	return nil
+}
+
+func (r *memoryRecurringRepository) ClaimOccurrence(ctx context.Context, seriesID string, startsAt time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.occurrences[seriesID][startsAt]; ok {
+		return false, nil
+	}
+	r.occurrences[seriesID][startsAt] = ""
+	return true, nil
+}
+
+func (r *memoryRecurringRepository) SetOccurrenceSilence(ctx context.Context, seriesID string, startsAt time.Time, silenceID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.occurrences[seriesID][startsAt] = silenceID
+	return nil
+}
+
+func (r *memoryRecurringRepository) ReleaseOccurrence(ctx context.Context, seriesID string, startsAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.occurrences[seriesID], startsAt)
+	return nil
+}
+
+func (r *memoryRecurringRepository) ListOccurrences(ctx context.Context, seriesID string) ([]*silencing.RecurringOccurrence, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var result []*silencing.RecurringOccurrence
+	for start, silenceID := range r.occurrences[seriesID] {
+		result = append(result, &silencing.RecurringOccurrence{SeriesID: seriesID, StartsAt: start, SilenceID: silenceID})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].StartsAt.Before(result[j].StartsAt) })
+	return result, nil
+}
+
+func (r *memoryRecurringRepository) PruneOccurrences(ctx context.Context, before time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var pruned int64
+	for _, occurrences := range r.occurrences {
+		for start := range occurrences {
+			if start.Before(before) {
+				delete(occurrences, start)
+				pruned++
+			}
+		}
+	}
+	return pruned, nil
+}
+
+// memorySilenceManager is an in-memory SilenceManager for recurring tests.
+type memorySilenceManager struct {
+	SilenceManager
+	mu       sync.Mutex
+	silences map[string]*silencing.Silence
+}
+
+func newMemorySilenceManager() *memorySilenceManager {
+	return &memorySilenceManager{silences: make(map[string]*silencing.Silence)}
+}
+
+func (m *memorySilenceManager) CreateSilence(ctx context.Context, silence *silencing.Silence) (*silencing.Silence, error) {
+	if err := silence.Validate(); err != nil {
+		return nil, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	silence.ID = uuid.NewString()
+	m.silences[silence.ID] = silence
+	return silence, nil
+}
+
+func (m *memorySilenceManager) GetSilence(ctx context.Context, id string) (*silencing.Silence, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	silence, ok := m.silences[id]
+	if !ok {
+		return nil, infrasilencing.ErrSilenceNotFound
+	}
+	copied := *silence
+	return &copied, nil
+}
+
+func (m *memorySilenceManager) UpdateSilence(ctx context.Context, silence *silencing.Silence) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.silences[silence.ID] = silence
+	return nil
+}
+
+func (m *memorySilenceManager) DeleteSilence(ctx context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.silences[id]; !ok {
+		return infrasilencing.ErrSilenceNotFound
+	}
+	delete(m.silences, id)
+	return nil
+}
+
+// sorted returns the silences ordered by start time.
+func (m *memorySilenceManager) sorted() []*silencing.Silence {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	result := make([]*silencing.Silence, 0, len(m.silences))
+	for _, silence := range m.silences {
+		result = append(result, silence)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].StartsAt.Before(result[j].StartsAt) })
+	return result
+}
+
+func newTestRecurringManager(now time.Time) (*DefaultRecurringSilenceManager, *memoryRecurringRepository, *memorySilenceManager) {
+	repo := newMemoryRecurringRepository()
+	silences := newMemorySilenceManager()
+	manager := NewDefaultRecurringSilenceManager(repo, silences, nil, &RecurringSilenceConfig{Horizon: 7 * 24 * time.Hour})
+	manager.now = func() time.Time { return now }
+	return manager, repo, silences
+}
+
+func newNightlySeries(startsAt time.Time) *silencing.RecurringSilence {
+	return &silencing.RecurringSilence{
+		CreatedBy: "ops@example.com",
+		Comment:   "Nightly backup window",
+		Schedule:  "0 2 * * *",
+		Timezone:  "UTC",
+		Duration:  2 * time.Hour,
+		StartsAt:  startsAt,
+		Matchers:  []silencing.Matcher{{Name: "job", Value: "backup", Type: silencing.MatcherTypeEqual}},
+	}
+}
+
+func TestRecurringSilenceManager_CreateMaterializes(t *testing.T) {
+	// 03:00, the 02:00-04:00 window is running
+	now := time.Date(2025, 11, 3, 3, 0, 0, 0, time.UTC)
+	manager, repo, silences := newTestRecurringManager(now)
+
+	series, err := manager.CreateSeries(context.Background(), newNightlySeries(now.Add(-72*time.Hour)))
+	require.NoError(t, err)
+
+	// Running window + 7 days ahead
+	created := silences.sorted()
+	require.Len(t, created, 8)
+	assert.Equal(t, time.Date(2025, 11, 3, 2, 0, 0, 0, time.UTC), created[0].StartsAt)
+	assert.Equal(t, time.Date(2025, 11, 3, 4, 0, 0, 0, time.UTC), created[0].EndsAt)
+	assert.Equal(t, "Nightly backup window", created[0].Comment)
+
+	occurrences, err := manager.ListOccurrences(context.Background(), series.ID)
+	require.NoError(t, err)
+	require.Len(t, occurrences, 8)
+	assert.NotEmpty(t, occurrences[0].SilenceID)
+
+	// Materialisation is idempotent
+	manager.RunOnce(context.Background())
+	assert.Len(t, silences.sorted(), 8)
+
+	// The horizon moves with time
+	manager.now = func() time.Time { return now.Add(24 * time.Hour) }
+	manager.RunOnce(context.Background())
+	assert.Len(t, silences.sorted(), 9)
+	assert.Len(t, repo.occurrences[series.ID], 9)
+}
+
+func TestRecurringSilenceManager_UpdateRematerializesPending(t *testing.T) {
+	now := time.Date(2025, 11, 3, 3, 0, 0, 0, time.UTC)
+	manager, _, silences := newTestRecurringManager(now)
+
+	series, err := manager.CreateSeries(context.Background(), newNightlySeries(now.Add(-72*time.Hour)))
+	require.NoError(t, err)
+
+	series.Schedule = "0 1 * * SAT"
+	series.Matchers = []silencing.Matcher{{Name: "job", Value: "backup-v2", Type: silencing.MatcherTypeEqual}}
+	require.NoError(t, manager.UpdateSeries(context.Background(), series))
+
+	// The running window keeps its silence, pending ones follow the new schedule
+	created := silences.sorted()
+	require.Len(t, created, 2)
+	assert.Equal(t, time.Date(2025, 11, 3, 2, 0, 0, 0, time.UTC), created[0].StartsAt)
+	assert.Equal(t, "backup", created[0].Matchers[0].Value)
+	assert.Equal(t, time.Date(2025, 11, 8, 1, 0, 0, 0, time.UTC), created[1].StartsAt)
+	assert.Equal(t, "backup-v2", created[1].Matchers[0].Value)
+}
+
+func TestRecurringSilenceManager_Cancel(t *testing.T) {
+	now := time.Date(2025, 11, 3, 3, 0, 0, 0, time.UTC)
+	manager, _, silences := newTestRecurringManager(now)
+
+	series, err := manager.CreateSeries(context.Background(), newNightlySeries(now.Add(-72*time.Hour)))
+	require.NoError(t, err)
+	require.NoError(t, manager.CancelSeries(context.Background(), series.ID))
+
+	// Only the running silence is left, ended now
+	created := silences.sorted()
+	require.Len(t, created, 1)
+	assert.Equal(t, now, created[0].EndsAt)
+
+	// Cancelled series are not materialised any more
+	manager.RunOnce(context.Background())
+	assert.Len(t, silences.sorted(), 1)
+
+	list, err := manager.ListSeries(context.Background(), false)
+	require.NoError(t, err)
+	assert.Empty(t, list)
+
+	assert.ErrorIs(t, manager.CancelSeries(context.Background(), series.ID), infrasilencing.ErrRecurringSilenceNotFound)
+}
+
+func TestRecurringSilenceManager_CreateInvalid(t *testing.T) {
+	manager, _, _ := newTestRecurringManager(time.Now())
+
+	series := newNightlySeries(time.Now())
+	series.Schedule = "0 25 * * *"
+	_, err := manager.CreateSeries(context.Background(), series)
+	assert.ErrorIs(t, err, infrasilencing.ErrValidation)
+	assert.Contains(t, err.Error(), "hour")
+}
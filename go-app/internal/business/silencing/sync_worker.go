@@ -171,7 +171,9 @@ func (w *syncWorker) runSync(ctx context.Context) {
 		return
 	}
 
-	// Step 3: Rebuild cache with fresh data
+	// Step 3: Rebuild cache with fresh data (minus silences that ended
+	// but were not expired by the GC worker yet)
+	silences = filterEnded(silences)
 	w.cache.Rebuild(silences)
 	newSize := len(silences)
 
@@ -0,0 +1,17 @@
+package timeinterval
+
+import "errors"
+
+// Time interval errors
+
+var (
+	// ErrIntervalNotFound indicates that no time interval with the requested name is defined.
+	ErrIntervalNotFound = errors.New("time interval not found")
+
+	// ErrDuplicateInterval indicates that the same interval name is defined more than once
+	// (across both time_intervals and mute_time_intervals).
+	ErrDuplicateInterval = errors.New("duplicate time interval name")
+
+	// ErrInvalidInterval indicates that a time interval definition could not be parsed.
+	ErrInvalidInterval = errors.New("invalid time interval")
+)
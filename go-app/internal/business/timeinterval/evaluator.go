@@ -0,0 +1,118 @@
+package timeinterval
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	amconfig "github.com/vitaliisemenov/alert-history/internal/alertmanager/config"
+)
+
+// Evaluator answers "is interval X active at time T" for named time intervals.
+//
+// Usage:
+//
+//	evaluator, err := timeinterval.NewEvaluator(cfg.TimeIntervals, cfg.MuteTimeIntervals)
+//	active, err := evaluator.IsActive("business-hours", time.Now())
+//	muted, err := evaluator.IsMuted(route.MuteTimeIntervals, route.ActiveTimeIntervals, time.Now())
+//
+// Thread-safety: Evaluator is immutable after construction and safe for concurrent use.
+type Evaluator struct {
+	intervals map[string][]*TimeInterval
+}
+
+// NewEvaluator compiles named time intervals.
+//
+// Accepts both top-level time_intervals and the legacy mute_time_intervals
+// lists; names must be unique across all of them.
+func NewEvaluator(intervalLists ...[]amconfig.MuteTimeInterval) (*Evaluator, error) {
+	e := &Evaluator{intervals: make(map[string][]*TimeInterval)}
+
+	for _, list := range intervalLists {
+		for _, named := range list {
+			if named.Name == "" {
+				return nil, fmt.Errorf("%w: name is required", ErrInvalidInterval)
+			}
+			if _, exists := e.intervals[named.Name]; exists {
+				return nil, fmt.Errorf("%w: %s", ErrDuplicateInterval, named.Name)
+			}
+
+			compiled := make([]*TimeInterval, 0, len(named.TimeIntervals))
+			for i, cfg := range named.TimeIntervals {
+				ti, err := Compile(cfg)
+				if err != nil {
+					return nil, fmt.Errorf("interval %s[%d]: %w", named.Name, i, err)
+				}
+				compiled = append(compiled, ti)
+			}
+			e.intervals[named.Name] = compiled
+		}
+	}
+
+	return e, nil
+}
+
+// Has reports whether an interval with the given name is defined.
+func (e *Evaluator) Has(name string) bool {
+	_, ok := e.intervals[name]
+	return ok
+}
+
+// Names returns all defined interval names (sorted).
+func (e *Evaluator) Names() []string {
+	names := make([]string, 0, len(e.intervals))
+	for name := range e.intervals {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// IsActive reports whether the named interval contains t.
+//
+// Returns ErrIntervalNotFound if the interval is not defined.
+func (e *Evaluator) IsActive(name string, t time.Time) (bool, error) {
+	intervals, ok := e.intervals[name]
+	if !ok {
+		return false, fmt.Errorf("%w: %s", ErrIntervalNotFound, name)
+	}
+
+	for _, ti := range intervals {
+		if ti.ContainsTime(t) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// IsMuted reports whether notifications for a route are muted at t.
+//
+// A route is muted when any of its mute_time_intervals is active, or when it
+// has active_time_intervals and none of them is active.
+func (e *Evaluator) IsMuted(muteIntervals, activeIntervals []string, t time.Time) (bool, error) {
+	for _, name := range muteIntervals {
+		active, err := e.IsActive(name, t)
+		if err != nil {
+			return false, err
+		}
+		if active {
+			return true, nil
+		}
+	}
+
+	if len(activeIntervals) == 0 {
+		return false, nil
+	}
+
+	for _, name := range activeIntervals {
+		active, err := e.IsActive(name, t)
+		if err != nil {
+			return false, err
+		}
+		if active {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,213 @@
+package timeinterval
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	amconfig "github.com/vitaliisemenov/alert-history/internal/alertmanager/config"
+)
+
+func mustTime(t *testing.T, value string) time.Time {
+	t.Helper()
+	parsed, err := time.Parse(time.RFC3339, value)
+	require.NoError(t, err)
+	return parsed
+}
+
+func TestCompile_ContainsTime(t *testing.T) {
+	tests := []struct {
+		name     string
+		interval amconfig.TimeInterval
+		at       string
+		want     bool
+	}{
+		{
+			name:     "empty_interval_always_matches",
+			interval: amconfig.TimeInterval{},
+			at:       "2025-11-04T03:00:00Z",
+			want:     true,
+		},
+		{
+			name: "inside_times",
+			interval: amconfig.TimeInterval{
+				Times: []amconfig.TimeRange{{StartTime: "09:00", EndTime: "17:00"}},
+			},
+			at:   "2025-11-04T09:00:00Z",
+			want: true,
+		},
+		{
+			name: "end_time_exclusive",
+			interval: amconfig.TimeInterval{
+				Times: []amconfig.TimeRange{{StartTime: "09:00", EndTime: "17:00"}},
+			},
+			at:   "2025-11-04T17:00:00Z",
+			want: false,
+		},
+		{
+			name: "weekday_range",
+			interval: amconfig.TimeInterval{
+				Weekdays: []string{"monday:friday"},
+			},
+			at:   "2025-11-08T12:00:00Z", // Saturday
+			want: false,
+		},
+		{
+			name: "weekday_single",
+			interval: amconfig.TimeInterval{
+				Weekdays: []string{"Saturday"},
+			},
+			at:   "2025-11-08T12:00:00Z",
+			want: true,
+		},
+		{
+			name: "negative_days_of_month",
+			interval: amconfig.TimeInterval{
+				DaysOfMonth: []string{"-3:-1"},
+			},
+			at:   "2025-02-27T00:00:00Z", // February has 28 days in 2025
+			want: true,
+		},
+		{
+			name: "days_of_month_clamped_to_month_length",
+			interval: amconfig.TimeInterval{
+				DaysOfMonth: []string{"30:31"},
+			},
+			at:   "2025-02-28T00:00:00Z",
+			want: false,
+		},
+		{
+			name: "months_by_name_and_number",
+			interval: amconfig.TimeInterval{
+				Months: []string{"january:march", "12"},
+			},
+			at:   "2025-12-01T00:00:00Z",
+			want: true,
+		},
+		{
+			name: "years",
+			interval: amconfig.TimeInterval{
+				Years: []string{"2024:2025"},
+			},
+			at:   "2026-01-01T00:00:00Z",
+			want: false,
+		},
+		{
+			name: "location_shifts_evaluation",
+			interval: amconfig.TimeInterval{
+				Times:    []amconfig.TimeRange{{StartTime: "09:00", EndTime: "17:00"}},
+				Location: "Asia/Tokyo",
+			},
+			at:   "2025-11-04T01:00:00Z", // 10:00 in Tokyo
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ti, err := Compile(tt.interval)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, ti.ContainsTime(mustTime(t, tt.at)))
+		})
+	}
+}
+
+func TestCompile_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		interval amconfig.TimeInterval
+	}{
+		{"bad_clock", amconfig.TimeInterval{Times: []amconfig.TimeRange{{StartTime: "9am", EndTime: "17:00"}}}},
+		{"start_after_end", amconfig.TimeInterval{Times: []amconfig.TimeRange{{StartTime: "18:00", EndTime: "17:00"}}}},
+		{"unknown_weekday", amconfig.TimeInterval{Weekdays: []string{"funday"}}},
+		{"reversed_weekdays", amconfig.TimeInterval{Weekdays: []string{"friday:monday"}}},
+		{"day_zero", amconfig.TimeInterval{DaysOfMonth: []string{"0"}}},
+		{"month_out_of_range", amconfig.TimeInterval{Months: []string{"13"}}},
+		{"bad_location", amconfig.TimeInterval{Location: "Mars/Olympus"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Compile(tt.interval)
+			assert.ErrorIs(t, err, ErrInvalidInterval)
+		})
+	}
+}
+
+func TestEvaluator_IsActive(t *testing.T) {
+	evaluator, err := NewEvaluator(
+		[]amconfig.MuteTimeInterval{{
+			Name: "business-hours",
+			TimeIntervals: []amconfig.TimeInterval{{
+				Weekdays: []string{"monday:friday"},
+				Times:    []amconfig.TimeRange{{StartTime: "09:00", EndTime: "17:00"}},
+			}},
+		}},
+		[]amconfig.MuteTimeInterval{{
+			Name:          "weekends",
+			TimeIntervals: []amconfig.TimeInterval{{Weekdays: []string{"saturday", "sunday"}}},
+		}},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"business-hours", "weekends"}, evaluator.Names())
+	assert.True(t, evaluator.Has("weekends"))
+
+	active, err := evaluator.IsActive("business-hours", mustTime(t, "2025-11-04T10:00:00Z"))
+	require.NoError(t, err)
+	assert.True(t, active)
+
+	active, err = evaluator.IsActive("business-hours", mustTime(t, "2025-11-08T10:00:00Z"))
+	require.NoError(t, err)
+	assert.False(t, active)
+
+	_, err = evaluator.IsActive("holidays", time.Now())
+	assert.True(t, errors.Is(err, ErrIntervalNotFound))
+}
+
+func TestEvaluator_IsMuted(t *testing.T) {
+	evaluator, err := NewEvaluator([]amconfig.MuteTimeInterval{
+		{
+			Name:          "business-hours",
+			TimeIntervals: []amconfig.TimeInterval{{Times: []amconfig.TimeRange{{StartTime: "09:00", EndTime: "17:00"}}}},
+		},
+		{
+			Name:          "lunch",
+			TimeIntervals: []amconfig.TimeInterval{{Times: []amconfig.TimeRange{{StartTime: "12:00", EndTime: "13:00"}}}},
+		},
+	})
+	require.NoError(t, err)
+
+	morning := mustTime(t, "2025-11-04T10:00:00Z")
+	lunch := mustTime(t, "2025-11-04T12:30:00Z")
+	night := mustTime(t, "2025-11-04T22:00:00Z")
+
+	muted, err := evaluator.IsMuted(nil, nil, night)
+	require.NoError(t, err)
+	assert.False(t, muted, "No intervals: never muted")
+
+	muted, err = evaluator.IsMuted([]string{"lunch"}, []string{"business-hours"}, lunch)
+	require.NoError(t, err)
+	assert.True(t, muted, "Mute interval active")
+
+	muted, err = evaluator.IsMuted([]string{"lunch"}, []string{"business-hours"}, morning)
+	require.NoError(t, err)
+	assert.False(t, muted)
+
+	muted, err = evaluator.IsMuted(nil, []string{"business-hours"}, night)
+	require.NoError(t, err)
+	assert.True(t, muted, "Outside active interval")
+
+	_, err = evaluator.IsMuted([]string{"unknown"}, nil, night)
+	assert.ErrorIs(t, err, ErrIntervalNotFound)
+}
+
+func TestNewEvaluator_Duplicate(t *testing.T) {
+	interval := amconfig.MuteTimeInterval{Name: "weekends", TimeIntervals: []amconfig.TimeInterval{{}}}
+
+	_, err := NewEvaluator([]amconfig.MuteTimeInterval{interval}, []amconfig.MuteTimeInterval{interval})
+	assert.ErrorIs(t, err, ErrDuplicateInterval)
+}
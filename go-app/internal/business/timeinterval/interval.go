@@ -0,0 +1,326 @@
+// Package timeinterval evaluates Alertmanager time intervals
+// (time_intervals / mute_time_intervals) at runtime.
+//
+// A named interval is active at time T if any of its time ranges contains T.
+// Each time range constrains T by times of day, weekdays, days of month,
+// months and years (all in the configured location, UTC by default).
+// Empty constraints match everything.
+//
+// Reference: https://prometheus.io/docs/alerting/latest/configuration/#time_interval
+package timeinterval
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	amconfig "github.com/vitaliisemenov/alert-history/internal/alertmanager/config"
+)
+
+// TimeInterval is a compiled time interval (one entry of a named interval's time_intervals).
+//
+// Thread-safety: TimeInterval is immutable after compilation.
+type TimeInterval struct {
+	// Times are [start, end) ranges in minutes since midnight
+	Times []MinuteRange
+
+	// Weekdays are inclusive ranges (0 = Sunday ... 6 = Saturday)
+	Weekdays []InclusiveRange
+
+	// DaysOfMonth are inclusive ranges; negative values count from month end (-1 = last day)
+	DaysOfMonth []InclusiveRange
+
+	// Months are inclusive ranges (1 = January ... 12 = December)
+	Months []InclusiveRange
+
+	// Years are inclusive ranges
+	Years []InclusiveRange
+
+	// Location is the timezone used for evaluation
+	Location *time.Location
+}
+
+// MinuteRange is a [Start, End) range in minutes since midnight.
+type MinuteRange struct {
+	Start int
+	End   int
+}
+
+// InclusiveRange is a [Begin, End] range.
+type InclusiveRange struct {
+	Begin int
+	End   int
+}
+
+var weekdayNames = map[string]int{
+	"sunday":    0,
+	"monday":    1,
+	"tuesday":   2,
+	"wednesday": 3,
+	"thursday":  4,
+	"friday":    5,
+	"saturday":  6,
+}
+
+var monthNames = map[string]int{
+	"january":   1,
+	"february":  2,
+	"march":     3,
+	"april":     4,
+	"may":       5,
+	"june":      6,
+	"july":      7,
+	"august":    8,
+	"september": 9,
+	"october":   10,
+	"november":  11,
+	"december":  12,
+}
+
+// Compile parses a time interval configuration.
+//
+// Supported formats (Alertmanager-compatible):
+//   - times: start_time/end_time as "HH:MM" (00:00 - 24:00, end exclusive)
+//   - weekdays: "monday", "monday:friday"
+//   - days_of_month: "1", "1:5", "-3:-1" (negative = from month end)
+//   - months: "january", "january:march", "1:3"
+//   - years: "2025", "2025:2026"
+//   - location: IANA timezone name ("Europe/Berlin"), "UTC" or "Local"
+func Compile(cfg amconfig.TimeInterval) (*TimeInterval, error) {
+	ti := &TimeInterval{Location: time.UTC}
+
+	for _, tr := range cfg.Times {
+		r, err := parseTimeRange(tr)
+		if err != nil {
+			return nil, err
+		}
+		ti.Times = append(ti.Times, r)
+	}
+
+	for _, s := range cfg.Weekdays {
+		r, err := parseRange(s, func(v string) (int, error) { return parseNamed(v, weekdayNames, -1, -1) })
+		if err != nil {
+			return nil, fmt.Errorf("%w: weekdays %q: %v", ErrInvalidInterval, s, err)
+		}
+		if r.Begin > r.End {
+			return nil, fmt.Errorf("%w: weekdays %q: start day after end day", ErrInvalidInterval, s)
+		}
+		ti.Weekdays = append(ti.Weekdays, r)
+	}
+
+	for _, s := range cfg.DaysOfMonth {
+		r, err := parseRange(s, parseDayOfMonth)
+		if err != nil {
+			return nil, fmt.Errorf("%w: days_of_month %q: %v", ErrInvalidInterval, s, err)
+		}
+		// Ranges with the same sign must be ordered; mixed signs are resolved per month
+		if (r.Begin > 0) == (r.End > 0) && r.Begin > r.End {
+			return nil, fmt.Errorf("%w: days_of_month %q: start day after end day", ErrInvalidInterval, s)
+		}
+		ti.DaysOfMonth = append(ti.DaysOfMonth, r)
+	}
+
+	for _, s := range cfg.Months {
+		r, err := parseRange(s, func(v string) (int, error) { return parseNamed(v, monthNames, 1, 12) })
+		if err != nil {
+			return nil, fmt.Errorf("%w: months %q: %v", ErrInvalidInterval, s, err)
+		}
+		if r.Begin > r.End {
+			return nil, fmt.Errorf("%w: months %q: start month after end month", ErrInvalidInterval, s)
+		}
+		ti.Months = append(ti.Months, r)
+	}
+
+	for _, s := range cfg.Years {
+		r, err := parseRange(s, parseYear)
+		if err != nil {
+			return nil, fmt.Errorf("%w: years %q: %v", ErrInvalidInterval, s, err)
+		}
+		if r.Begin > r.End {
+			return nil, fmt.Errorf("%w: years %q: start year after end year", ErrInvalidInterval, s)
+		}
+		ti.Years = append(ti.Years, r)
+	}
+
+	if cfg.Location != "" {
+		loc, err := time.LoadLocation(cfg.Location)
+		if err != nil {
+			return nil, fmt.Errorf("%w: location %q: %v", ErrInvalidInterval, cfg.Location, err)
+		}
+		ti.Location = loc
+	}
+
+	return ti, nil
+}
+
+// ContainsTime reports whether t falls within the interval.
+func (ti *TimeInterval) ContainsTime(t time.Time) bool {
+	t = t.In(ti.Location)
+
+	if len(ti.Times) > 0 {
+		minute := t.Hour()*60 + t.Minute()
+		if !containsMinute(ti.Times, minute) {
+			return false
+		}
+	}
+
+	if len(ti.Weekdays) > 0 && !containsValue(ti.Weekdays, int(t.Weekday())) {
+		return false
+	}
+
+	if len(ti.DaysOfMonth) > 0 {
+		daysInMonth := time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, ti.Location).Day()
+		if !containsDayOfMonth(ti.DaysOfMonth, t.Day(), daysInMonth) {
+			return false
+		}
+	}
+
+	if len(ti.Months) > 0 && !containsValue(ti.Months, int(t.Month())) {
+		return false
+	}
+
+	if len(ti.Years) > 0 && !containsValue(ti.Years, t.Year()) {
+		return false
+	}
+
+	return true
+}
+
+func containsMinute(ranges []MinuteRange, minute int) bool {
+	for _, r := range ranges {
+		if minute >= r.Start && minute < r.End {
+			return true
+		}
+	}
+	return false
+}
+
+func containsValue(ranges []InclusiveRange, value int) bool {
+	for _, r := range ranges {
+		if value >= r.Begin && value <= r.End {
+			return true
+		}
+	}
+	return false
+}
+
+func containsDayOfMonth(ranges []InclusiveRange, day, daysInMonth int) bool {
+	for _, r := range ranges {
+		begin := resolveDayOfMonth(r.Begin, daysInMonth)
+		end := resolveDayOfMonth(r.End, daysInMonth)
+
+		// Clamp to the current month (e.g. 31 in February)
+		if begin > daysInMonth {
+			continue
+		}
+		if end > daysInMonth {
+			end = daysInMonth
+		}
+		if begin < 1 {
+			begin = 1
+		}
+
+		if day >= begin && day <= end {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveDayOfMonth converts a negative day (from month end) to a calendar day.
+func resolveDayOfMonth(day, daysInMonth int) int {
+	if day < 0 {
+		return daysInMonth + day + 1
+	}
+	return day
+}
+
+// parseTimeRange parses a "HH:MM"-"HH:MM" range into minutes.
+func parseTimeRange(tr amconfig.TimeRange) (MinuteRange, error) {
+	start, err := parseClock(tr.StartTime)
+	if err != nil {
+		return MinuteRange{}, fmt.Errorf("%w: start_time %q: %v", ErrInvalidInterval, tr.StartTime, err)
+	}
+	end, err := parseClock(tr.EndTime)
+	if err != nil {
+		return MinuteRange{}, fmt.Errorf("%w: end_time %q: %v", ErrInvalidInterval, tr.EndTime, err)
+	}
+	if start >= end {
+		return MinuteRange{}, fmt.Errorf("%w: start_time %q must be before end_time %q",
+			ErrInvalidInterval, tr.StartTime, tr.EndTime)
+	}
+	return MinuteRange{Start: start, End: end}, nil
+}
+
+// parseClock parses "HH:MM" into minutes since midnight (24:00 allowed).
+func parseClock(s string) (int, error) {
+	parts := strings.Split(strings.TrimSpace(s), ":")
+	if len(parts) != 2 {
+		return 0, fmt.Errorf("expected HH:MM")
+	}
+	hours, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, fmt.Errorf("invalid hour")
+	}
+	minutes, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, fmt.Errorf("invalid minute")
+	}
+	if hours < 0 || hours > 24 || minutes < 0 || minutes > 59 || (hours == 24 && minutes != 0) {
+		return 0, fmt.Errorf("out of range (00:00 - 24:00)")
+	}
+	return hours*60 + minutes, nil
+}
+
+// parseRange parses "value" or "begin:end" using the given element parser.
+func parseRange(s string, parse func(string) (int, error)) (InclusiveRange, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	beginStr, endStr, isRange := strings.Cut(s, ":")
+
+	begin, err := parse(beginStr)
+	if err != nil {
+		return InclusiveRange{}, err
+	}
+	if !isRange {
+		return InclusiveRange{Begin: begin, End: begin}, nil
+	}
+
+	end, err := parse(endStr)
+	if err != nil {
+		return InclusiveRange{}, err
+	}
+	return InclusiveRange{Begin: begin, End: end}, nil
+}
+
+// parseNamed parses a name from names, or a number within [min, max] when min >= 0.
+func parseNamed(s string, names map[string]int, min, max int) (int, error) {
+	if v, ok := names[s]; ok {
+		return v, nil
+	}
+	if min >= 0 {
+		if v, err := strconv.Atoi(s); err == nil && v >= min && v <= max {
+			return v, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown value %q", s)
+}
+
+func parseDayOfMonth(s string) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid day %q", s)
+	}
+	if v == 0 || v < -31 || v > 31 {
+		return 0, fmt.Errorf("day %d out of range (1..31 or -31..-1)", v)
+	}
+	return v, nil
+}
+
+func parseYear(s string) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil || v < 1 {
+		return 0, fmt.Errorf("invalid year %q", s)
+	}
+	return v, nil
+}
@@ -111,6 +111,87 @@ type LLMConfig struct {
 	Temperature float64       `mapstructure:"temperature"`
 	Timeout     time.Duration `mapstructure:"timeout"`
 	MaxRetries  int           `mapstructure:"max_retries"`
+
+	// FallbackRules are declarative rules for rule-based fallback classification
+	// (used when the LLM is unavailable). Empty means built-in defaults.
+	// Hot-reloadable via SIGHUP (component "fallback_rules").
+	FallbackRules []FallbackRuleConfig `mapstructure:"fallback_rules"`
+
+	// Providers are native LLM backends tried in order (failover). Empty means
+	// the legacy classification proxy at BaseURL is used.
+	Providers []LLMProviderConfig `mapstructure:"providers"`
+
+	// PromptTemplate is the text/template for the classification prompt sent
+	// to native providers. Empty means the built-in template.
+	PromptTemplate string `mapstructure:"prompt_template"`
+
+	// GroupSummary configures incident summaries of alert groups at flush time
+	GroupSummary LLMGroupSummaryConfig `mapstructure:"group_summary"`
+}
+
+// LLMGroupSummaryConfig configures alert group summaries. Without an enabled
+// LLM (or when it fails) a rule-based summary is produced.
+type LLMGroupSummaryConfig struct {
+	Enabled   bool          `mapstructure:"enabled"`
+	MinAlerts int           `mapstructure:"min_alerts"` // minimum firing alerts to summarise
+	CacheTTL  time.Duration `mapstructure:"cache_ttl"`
+	Timeout   time.Duration `mapstructure:"timeout"`
+}
+
+// LLMProviderConfig configures a native LLM provider backend.
+type LLMProviderConfig struct {
+	Name        string        `mapstructure:"name"`
+	Type        string        `mapstructure:"type"` // openai | anthropic | ollama
+	BaseURL     string        `mapstructure:"base_url"`
+	APIKey      string        `mapstructure:"api_key"`
+	Model       string        `mapstructure:"model"`
+	MaxTokens   int           `mapstructure:"max_tokens"`
+	Temperature float64       `mapstructure:"temperature"`
+	Timeout     time.Duration `mapstructure:"timeout"`
+	MaxRetries  int           `mapstructure:"max_retries"`
+}
+
+// FallbackRuleConfig defines a single fallback classification rule.
+//
+// Rules are evaluated in order; the first rule with a matching Match entry wins.
+//
+// Example:
+//
+//	llm:
+//	  fallback_rules:
+//	    - name: node-down
+//	      match:
+//	        - alertname: ["*NodeDown*", "*InstanceDown*"]
+//	        - labels: ["severity=critical"]
+//	      severity: critical
+//	      category: infrastructure
+//	      confidence: 0.8
+//	      reasoning: "Node or instance is down"
+//	      recommendations: ["Check node health"]
+type FallbackRuleConfig struct {
+	Name            string                `mapstructure:"name"`
+	Match           []FallbackMatchConfig `mapstructure:"match"`
+	Severity        string                `mapstructure:"severity"`
+	Category        string                `mapstructure:"category"`
+	Confidence      float64               `mapstructure:"confidence"`
+	Reasoning       string                `mapstructure:"reasoning"`
+	Recommendations []string              `mapstructure:"recommendations"`
+}
+
+// FallbackMatchConfig is one alternative of a fallback rule's match list.
+//
+// All conditions set within an entry must hold (AND); a rule matches when
+// any of its entries matches (OR).
+type FallbackMatchConfig struct {
+	// AlertName holds case-insensitive globs ("*" and "?"); any may match.
+	AlertName []string `mapstructure:"alertname"`
+	// Status restricts the match to "firing" or "resolved" alerts.
+	Status string `mapstructure:"status"`
+	// Labels holds label matchers (label=value, label!=value, label=~regex,
+	// label!~regex); regexes are fully anchored, a missing label equals "".
+	Labels []string `mapstructure:"labels"`
+	// Annotations holds annotation matchers in the same format as Labels.
+	Annotations []string `mapstructure:"annotations"`
 }
 
 // LogConfig holds logging-related configuration
@@ -326,6 +407,10 @@ func setDefaults() {
 	viper.SetDefault("llm.temperature", 0.7)
 	viper.SetDefault("llm.timeout", "30s")
 	viper.SetDefault("llm.max_retries", 3)
+	viper.SetDefault("llm.group_summary.enabled", true)
+	viper.SetDefault("llm.group_summary.min_alerts", 2)
+	viper.SetDefault("llm.group_summary.cache_ttl", "1h")
+	viper.SetDefault("llm.group_summary.timeout", "30s")
 
 	// Log defaults
 	viper.SetDefault("log.level", "info")
@@ -111,6 +111,42 @@ log:
 	assert.Equal(t, "debug", cfg.Log.Level)
 }
 
+func TestLoadConfig_FallbackRules(t *testing.T) {
+	resetViper()
+
+	yaml := `
+llm:
+  fallback_rules:
+    - name: payments
+      match:
+        - alertname: ["Payment*"]
+          labels: ["team=payments", "env=~prod"]
+        - annotations: ["summary=~(?i)checkout"]
+          status: firing
+      severity: critical
+      category: business
+      confidence: 0.9
+      reasoning: "Payments are affected"
+      recommendations: ["Page the payments on-call"]
+`
+	path := writeTempYAML(t, yaml)
+
+	cfg, err := LoadConfig(path)
+	require.NoError(t, err)
+
+	require.Len(t, cfg.LLM.FallbackRules, 1)
+	rule := cfg.LLM.FallbackRules[0]
+	assert.Equal(t, "payments", rule.Name)
+	assert.Equal(t, "critical", rule.Severity)
+	assert.Equal(t, 0.9, rule.Confidence)
+	assert.Equal(t, []string{"Page the payments on-call"}, rule.Recommendations)
+	require.Len(t, rule.Match, 2)
+	assert.Equal(t, []string{"Payment*"}, rule.Match[0].AlertName)
+	assert.Equal(t, []string{"team=payments", "env=~prod"}, rule.Match[0].Labels)
+	assert.Equal(t, []string{"summary=~(?i)checkout"}, rule.Match[1].Annotations)
+	assert.Equal(t, "firing", rule.Match[1].Status)
+}
+
 func TestLoadConfig_EnvOverridesFile(t *testing.T) {
 	resetViper()
 	// Base file values
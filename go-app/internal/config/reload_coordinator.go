@@ -504,7 +504,9 @@ func (rc *ReloadCoordinator) identifyAffectedComponents(diff *ConfigDiff) []stri
 	affected := make(map[string]bool)
 
 	// Check which sections changed
-	for field := range diff.Modified {
+	for rawField := range diff.Modified {
+		// Diff paths follow the JSON encoding of Config (e.g. "LLM.FallbackRules")
+		field := strings.ToLower(rawField)
 		if startsWith(field, "route") || startsWith(field, "routes") {
 			affected["routing"] = true
 		}
@@ -523,6 +525,9 @@ func (rc *ReloadCoordinator) identifyAffectedComponents(diff *ConfigDiff) []stri
 		if startsWith(field, "llm") {
 			affected["llm"] = true
 		}
+		if startsWith(field, "llm.fallback_rules") || startsWith(field, "llm.fallbackrules") {
+			affected["fallback_rules"] = true
+		}
 		if startsWith(field, "database") {
 			affected["database"] = true
 		}
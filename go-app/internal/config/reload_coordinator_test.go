@@ -533,6 +533,15 @@ func TestReloadCoordinator_identifyAffectedComponents(t *testing.T) {
 			},
 			expected: []string{"routing", "database", "llm"},
 		},
+		{
+			name: "fallback rules changes use JSON field paths",
+			diff: &ConfigDiff{
+				Modified: map[string]DiffEntry{
+					"LLM.FallbackRules": {OldValue: nil, NewValue: []interface{}{}},
+				},
+			},
+			expected: []string{"llm", "fallback_rules"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -40,8 +40,11 @@ func (s *DefaultConfigSanitizer) Sanitize(cfg *Config) *Config {
 	// Redact Redis password
 	sanitized.Redis.Password = s.redactionValue
 
-	// Redact LLM API key
+	// Redact LLM API keys
 	sanitized.LLM.APIKey = s.redactionValue
+	for i := range sanitized.LLM.Providers {
+		sanitized.LLM.Providers[i].APIKey = s.redactionValue
+	}
 
 	// Redact webhook authentication secrets
 	sanitized.Webhook.Authentication.APIKey = s.redactionValue
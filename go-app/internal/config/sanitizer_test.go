@@ -17,6 +17,9 @@ func TestDefaultConfigSanitizer_Sanitize(t *testing.T) {
 		},
 		LLM: LLMConfig{
 			APIKey: "sk-1234567890",
+			Providers: []LLMProviderConfig{
+				{Type: "anthropic", APIKey: "anthropic-key", Model: "claude-sonnet-4-5"},
+			},
 		},
 		Webhook: WebhookConfig{
 			Authentication: AuthenticationConfig{
@@ -47,6 +50,14 @@ func TestDefaultConfigSanitizer_Sanitize(t *testing.T) {
 		t.Errorf("LLM.APIKey = %v, want ***REDACTED***", sanitized.LLM.APIKey)
 	}
 
+	if sanitized.LLM.Providers[0].APIKey != "***REDACTED***" {
+		t.Errorf("LLM.Providers[0].APIKey = %v, want ***REDACTED***", sanitized.LLM.Providers[0].APIKey)
+	}
+
+	if cfg.LLM.Providers[0].APIKey != "anthropic-key" {
+		t.Errorf("original LLM.Providers[0].APIKey was mutated: %v", cfg.LLM.Providers[0].APIKey)
+	}
+
 	if sanitized.Webhook.Authentication.APIKey != "***REDACTED***" {
 		t.Errorf("Webhook.Authentication.APIKey = %v, want ***REDACTED***", sanitized.Webhook.Authentication.APIKey)
 	}
@@ -235,15 +235,48 @@ func (cc *DefaultConfigComparator) sanitizeFieldValue(fieldPath string, value in
 	}
 
 	// Also check for common secret keywords in field path
-	lowerPath := strings.ToLower(fieldPath)
+	if isSecretFieldName(fieldPath) {
+		return "***REDACTED***"
+	}
+
+	return sanitizeNestedValue(value)
+}
+
+// isSecretFieldName checks a field path or key for common secret keywords
+func isSecretFieldName(name string) bool {
+	lowerName := strings.ToLower(name)
 	secretKeywords := []string{"password", "secret", "api_key", "apikey", "token", "jwt"}
 	for _, keyword := range secretKeywords {
-		if strings.Contains(lowerPath, keyword) {
-			return "***REDACTED***"
+		if strings.Contains(lowerName, keyword) {
+			return true
 		}
 	}
+	return false
+}
 
-	return value
+// sanitizeNestedValue redacts secret keys inside list and object values
+// (e.g. llm.providers[].api_key), which are diffed as a whole
+func sanitizeNestedValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		sanitized := make([]interface{}, len(v))
+		for i, item := range v {
+			sanitized[i] = sanitizeNestedValue(item)
+		}
+		return sanitized
+	case map[string]interface{}:
+		sanitized := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			if isSecretFieldName(key) {
+				sanitized[key] = "***REDACTED***"
+			} else {
+				sanitized[key] = sanitizeNestedValue(item)
+			}
+		}
+		return sanitized
+	default:
+		return value
+	}
 }
 
 // detectType detects value type for better formatting
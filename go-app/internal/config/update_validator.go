@@ -360,8 +360,8 @@ func (cv *DefaultConfigValidator) validateRedisConfig(cfg *RedisConfig) []Valida
 func (cv *DefaultConfigValidator) validateLLMConfig(cfg *LLMConfig) []ValidationErrorDetail {
 	errors := make([]ValidationErrorDetail, 0)
 
-	// If enabled, API key is required
-	if cfg.Enabled && cfg.APIKey == "" {
+	// If enabled without native providers, API key is required
+	if cfg.Enabled && cfg.APIKey == "" && len(cfg.Providers) == 0 {
 		errors = append(errors, ValidationErrorDetail{
 			Field:   "llm.api_key",
 			Message: "api_key is required when llm.enabled=true",
@@ -400,6 +400,50 @@ func (cv *DefaultConfigValidator) validateLLMConfig(cfg *LLMConfig) []Validation
 		})
 	}
 
+	// Native providers need a known type and a model; names must be unique
+	validTypes := map[string]bool{"openai": true, "anthropic": true, "ollama": true}
+	names := make(map[string]bool, len(cfg.Providers))
+	for i, provider := range cfg.Providers {
+		field := fmt.Sprintf("llm.providers[%d]", i)
+		if !validTypes[provider.Type] {
+			errors = append(errors, ValidationErrorDetail{
+				Field:      field + ".type",
+				Message:    fmt.Sprintf("invalid provider type: %s", provider.Type),
+				Code:       "invalid_value",
+				Value:      provider.Type,
+				Constraint: "supported: openai, anthropic, ollama",
+			})
+		}
+		if provider.Model == "" {
+			errors = append(errors, ValidationErrorDetail{
+				Field:   field + ".model",
+				Message: "model is required",
+				Code:    "required",
+			})
+		}
+		if provider.Type == "anthropic" && provider.APIKey == "" {
+			errors = append(errors, ValidationErrorDetail{
+				Field:   field + ".api_key",
+				Message: "api_key is required for anthropic providers",
+				Code:    "required_conditional",
+			})
+		}
+
+		name := provider.Name
+		if name == "" {
+			name = provider.Type
+		}
+		if names[name] {
+			errors = append(errors, ValidationErrorDetail{
+				Field:   field + ".name",
+				Message: fmt.Sprintf("duplicate provider name: %s", name),
+				Code:    "duplicate",
+				Value:   name,
+			})
+		}
+		names[name] = true
+	}
+
 	return errors
 }
 
@@ -595,7 +639,7 @@ func (cv *DefaultConfigValidator) validateSecurity(cfg *Config, sections []strin
 			})
 		}
 
-		if cfg.LLM.Enabled && cfg.LLM.APIKey == "" {
+		if cfg.LLM.Enabled && cfg.LLM.APIKey == "" && len(cfg.LLM.Providers) == 0 {
 			errors = append(errors, ValidationErrorDetail{
 				Field:   "llm.api_key",
 				Message: "llm api_key should not be empty when enabled in production",
@@ -0,0 +1,103 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// AckTargetType identifies what an acknowledgement applies to.
+type AckTargetType string
+
+const (
+	// AckTargetAlert acknowledges a single alert (target = fingerprint)
+	AckTargetAlert AckTargetType = "alert"
+
+	// AckTargetGroup acknowledges a notification group (target = group key)
+	AckTargetGroup AckTargetType = "group"
+)
+
+// AckAction is the action recorded in the acknowledgement audit trail.
+type AckAction string
+
+const (
+	AckActionAck     AckAction = "ack"
+	AckActionUnack   AckAction = "unack"
+	AckActionAssign  AckAction = "assign"
+	AckActionComment AckAction = "comment"
+)
+
+// Acknowledgement errors
+var (
+	ErrAckNotFound      = errors.New("acknowledgement not found")
+	ErrInvalidAckTarget = errors.New("invalid acknowledgement target")
+	ErrInvalidAckActor  = errors.New("actor is required (max 255 characters)")
+	ErrInvalidAckExpiry = errors.New("acknowledgement expiry must be in the future")
+	ErrInvalidAckOwner  = errors.New("owner too long (max 255 characters)")
+	ErrAckCommentLength = errors.New("comment too long (max 4096 characters)")
+	ErrAckCommentEmpty  = errors.New("comment is required")
+)
+
+// AlertAck is the current acknowledgement and ownership state of an alert
+// (fingerprint) or a notification group (group key).
+//
+// While an acknowledgement is active (see IsActive), repeat notifications
+// of the alert or group are suppressed; new alerts and resolutions are
+// still notified.
+type AlertAck struct {
+	TargetType AckTargetType `json:"target_type"`
+	Target     string        `json:"target"`
+
+	// Acknowledgement (AckedBy/AckedAt are kept after unack for reference)
+	Acknowledged bool       `json:"acknowledged"`
+	AckedBy      string     `json:"acked_by,omitempty"`
+	AckedAt      *time.Time `json:"acked_at,omitempty"`
+	ExpiresAt    *time.Time `json:"expires_at,omitempty"` // nil: until unacked
+
+	// Ownership
+	Owner      string     `json:"owner,omitempty"`
+	AssignedBy string     `json:"assigned_by,omitempty"`
+	AssignedAt *time.Time `json:"assigned_at,omitempty"`
+
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// IsActive reports whether the acknowledgement is in effect at t.
+func (a *AlertAck) IsActive(t time.Time) bool {
+	if a == nil || !a.Acknowledged {
+		return false
+	}
+	return a.ExpiresAt == nil || t.Before(*a.ExpiresAt)
+}
+
+// AckEvent is an entry of the acknowledgement audit trail.
+type AckEvent struct {
+	ID         int64         `json:"id"`
+	TargetType AckTargetType `json:"target_type"`
+	Target     string        `json:"target"`
+	Action     AckAction     `json:"action"`
+	Actor      string        `json:"actor"`
+	Comment    string        `json:"comment,omitempty"`
+	Owner      string        `json:"owner,omitempty"`      // assign: new owner ("" = unassigned)
+	ExpiresAt  *time.Time    `json:"expires_at,omitempty"` // ack: expiry
+	CreatedAt  time.Time     `json:"created_at"`
+}
+
+// AckRepository persists acknowledgement state and its audit trail.
+type AckRepository interface {
+	// GetAck returns the state of a target (ErrAckNotFound if never acked or assigned)
+	GetAck(ctx context.Context, targetType AckTargetType, target string) (*AlertAck, error)
+
+	// ListAcks returns the state of the given targets that have one, keyed by target
+	ListAcks(ctx context.Context, targetType AckTargetType, targets []string) (map[string]*AlertAck, error)
+
+	// ListActiveAcks returns all acknowledgements in effect at t
+	ListActiveAcks(ctx context.Context, t time.Time) ([]*AlertAck, error)
+
+	// SaveAck stores the new state of a target together with its audit event (atomically).
+	// state is nil for events that don't change the state (comments).
+	SaveAck(ctx context.Context, state *AlertAck, event *AckEvent) error
+
+	// ListAckEvents returns the audit trail of a target, newest first
+	ListAckEvents(ctx context.Context, targetType AckTargetType, target string, limit int) ([]*AckEvent, error)
+}
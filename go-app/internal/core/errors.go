@@ -32,6 +32,8 @@ var (
 	ErrInvalidPage       = errors.New("page must be >= 1")
 	ErrInvalidPerPage    = errors.New("per_page must be >= 1")
 	ErrPerPageTooLarge   = errors.New("per_page must be <= 1000")
+	ErrInvalidCursor     = errors.New("invalid pagination cursor")
+	ErrCursorSorting     = errors.New("cursor pagination only supports sorting by starts_at desc")
 
 	// Sorting errors
 	ErrInvalidSortField = errors.New("invalid sort field")
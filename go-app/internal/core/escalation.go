@@ -0,0 +1,77 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// EscalationState is the lifecycle state of an alert escalation.
+type EscalationState string
+
+const (
+	// EscalationActive: levels are still being notified
+	EscalationActive EscalationState = "active"
+
+	// EscalationCompleted: all levels of the policy have been notified
+	EscalationCompleted EscalationState = "completed"
+
+	// EscalationAcknowledged: stopped by an acknowledgement of the alert
+	EscalationAcknowledged EscalationState = "acknowledged"
+
+	// EscalationResolved: stopped because the alert resolved
+	EscalationResolved EscalationState = "resolved"
+)
+
+// ErrEscalationNotFound is returned when an alert has no escalation.
+var ErrEscalationNotFound = errors.New("escalation not found")
+
+// AlertEscalation is the escalation state of a firing alert (one per
+// fingerprint) under an escalation policy.
+//
+// Level is the number of policy levels notified so far, i.e. the index of
+// the next level; NextAt is when that level is due (nil once the
+// escalation is no longer active).
+type AlertEscalation struct {
+	Fingerprint string            `json:"fingerprint"`
+	AlertName   string            `json:"alert_name"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Policy      string            `json:"policy"`
+
+	State          EscalationState `json:"state"`
+	Level          int             `json:"level"`
+	StartedAt      time.Time       `json:"started_at"`
+	NextAt         *time.Time      `json:"next_at,omitempty"`
+	LastNotifiedAt *time.Time      `json:"last_notified_at,omitempty"`
+	StoppedAt      *time.Time      `json:"stopped_at,omitempty"` // acknowledged/resolved
+
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// IsActive reports whether further levels may still be notified.
+func (e *AlertEscalation) IsActive() bool {
+	return e != nil && e.State == EscalationActive
+}
+
+// EscalationRepository persists alert escalation state, so pending
+// escalation levels survive restarts.
+type EscalationRepository interface {
+	// GetEscalation returns the escalation of an alert (ErrEscalationNotFound if none)
+	GetEscalation(ctx context.Context, fingerprint string) (*AlertEscalation, error)
+
+	// SaveEscalation creates or replaces the escalation of an alert
+	SaveEscalation(ctx context.Context, escalation *AlertEscalation) error
+
+	// AdvanceEscalation claims a level: it stores the escalation's level,
+	// state and timestamps only if the stored escalation is still active at
+	// fromLevel. It reports whether the level was claimed; false means
+	// another instance already advanced (or stopped) the escalation.
+	AdvanceEscalation(ctx context.Context, escalation *AlertEscalation, fromLevel int) (bool, error)
+
+	// ListEscalations returns the escalations in the given state, oldest first
+	ListEscalations(ctx context.Context, state EscalationState) ([]*AlertEscalation, error)
+
+	// DeleteInactiveEscalations removes escalations that are no longer
+	// active and were last updated before the given time
+	DeleteInactiveEscalations(ctx context.Context, before time.Time) (int, error)
+}
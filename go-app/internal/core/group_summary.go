@@ -0,0 +1,101 @@
+package core
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"time"
+)
+
+// GroupSummarySource identifies how a group summary was produced.
+type GroupSummarySource string
+
+const (
+	// GroupSummarySourceLLM is a summary generated by the LLM
+	GroupSummarySourceLLM GroupSummarySource = "llm"
+
+	// GroupSummarySourceFallback is a rule-based summary (LLM disabled or unavailable)
+	GroupSummarySourceFallback GroupSummarySource = "fallback"
+)
+
+// GroupSummaryMetadataKey is the EnrichedAlert.EnrichmentMetadata key of the
+// summary of the alert's group (*GroupSummary).
+const GroupSummaryMetadataKey = "group_summary"
+
+// UnmarshalJSON implements json.Unmarshaler: the group summary of
+// EnrichmentMetadata is restored as *GroupSummary (e.g. for jobs read back
+// from the durable publishing queue or the DLQ).
+func (e *EnrichedAlert) UnmarshalJSON(data []byte) error {
+	type enrichedAlert EnrichedAlert
+	if err := json.Unmarshal(data, (*enrichedAlert)(e)); err != nil {
+		return err
+	}
+
+	raw, ok := e.EnrichmentMetadata[GroupSummaryMetadataKey]
+	if !ok || raw == nil {
+		return nil
+	}
+	summaryJSON, err := json.Marshal(raw)
+	if err != nil {
+		return err
+	}
+	var summary GroupSummary
+	if err := json.Unmarshal(summaryJSON, &summary); err != nil {
+		return err
+	}
+	e.EnrichmentMetadata[GroupSummaryMetadataKey] = &summary
+	return nil
+}
+
+// GroupSummary is an incident summary of an alert group (e.g. an alert
+// storm of the same alert firing on many pods).
+type GroupSummary struct {
+	// Summary is a concise description of the incident
+	Summary string `json:"summary"`
+
+	// RootCause is the probable root cause (empty if unknown)
+	RootCause string `json:"root_cause,omitempty"`
+
+	// Recommendations is the deduplicated list of recommended actions
+	Recommendations []string `json:"recommendations"`
+
+	// Confidence is the summary confidence (0.0-1.0)
+	Confidence float64 `json:"confidence"`
+
+	// Source is how the summary was produced
+	Source GroupSummarySource `json:"source"`
+
+	// AlertCount and FiringCount describe the summarised membership
+	AlertCount  int `json:"alert_count"`
+	FiringCount int `json:"firing_count"`
+
+	// MembershipHash identifies the summarised alerts (see GroupMembershipHash)
+	MembershipHash string `json:"membership_hash"`
+
+	// GeneratedAt is when the summary was produced
+	GeneratedAt time.Time `json:"generated_at"`
+
+	// Metadata holds provider details (provider, model)
+	Metadata map[string]any `json:"metadata,omitempty"`
+}
+
+// GroupMembershipHash returns a stable hash of the alerts' fingerprints and
+// statuses, independent of order. A summary is current for a group as long
+// as the hash of its alerts is unchanged.
+func GroupMembershipHash(alerts []*Alert) string {
+	members := make([]string, 0, len(alerts))
+	for _, alert := range alerts {
+		if alert != nil {
+			members = append(members, alert.Fingerprint+":"+string(alert.Status))
+		}
+	}
+	sort.Strings(members)
+
+	h := sha256.New()
+	for _, member := range members {
+		h.Write([]byte(member))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
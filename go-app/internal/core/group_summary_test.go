@@ -0,0 +1,37 @@
+package core_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+)
+
+func TestGroupMembershipHash(t *testing.T) {
+	a := &core.Alert{Fingerprint: "a", Status: core.StatusFiring}
+	b := &core.Alert{Fingerprint: "b", Status: core.StatusFiring}
+	bResolved := &core.Alert{Fingerprint: "b", Status: core.StatusResolved}
+
+	hash := core.GroupMembershipHash([]*core.Alert{a, b})
+	assert.Len(t, hash, 16)
+	assert.Equal(t, hash, core.GroupMembershipHash([]*core.Alert{b, a}), "order must not matter")
+	assert.NotEqual(t, hash, core.GroupMembershipHash([]*core.Alert{a}), "membership change")
+	assert.NotEqual(t, hash, core.GroupMembershipHash([]*core.Alert{a, bResolved}), "status change")
+}
+
+func TestEnrichedAlertUnmarshalJSON_RestoresGroupSummary(t *testing.T) {
+	summary := &core.GroupSummary{Summary: "Disk full on 3 hosts", Source: core.GroupSummarySourceLLM, AlertCount: 3}
+	data, err := json.Marshal(&core.EnrichedAlert{
+		Alert:              &core.Alert{Fingerprint: "fp-1"},
+		EnrichmentMetadata: map[string]any{core.GroupSummaryMetadataKey: summary},
+	})
+	require.NoError(t, err)
+
+	var decoded core.EnrichedAlert
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, "fp-1", decoded.Alert.Fingerprint)
+	assert.Equal(t, summary, decoded.EnrichmentMetadata[core.GroupSummaryMetadataKey])
+}
@@ -2,6 +2,8 @@ package core
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"time"
 )
 
@@ -51,6 +53,10 @@ func (r *HistoryRequest) Validate() error {
 		if err := r.Sorting.Validate(); err != nil {
 			return err
 		}
+		// Keyset pagination walks the (starts_at, fingerprint) index order
+		if r.Pagination.Cursor != nil && (r.Sorting.Field != "starts_at" || r.Sorting.Order != SortOrderDesc) {
+			return ErrCursorSorting
+		}
 	}
 	return nil
 }
@@ -64,17 +70,32 @@ type HistoryResponse struct {
 	TotalPages int      `json:"total_pages"`
 	HasNext    bool     `json:"has_next"`
 	HasPrev    bool     `json:"has_prev"`
+
+	// NextCursor continues keyset pagination (empty on the last page).
+	// Total and TotalPages are not computed for cursor requests.
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 // Pagination represents pagination parameters
 type Pagination struct {
 	Page    int `json:"page" validate:"min=1"`
 	PerPage int `json:"per_page" validate:"min=1,max=1000"`
+
+	// Cursor switches to keyset pagination (starts_at DESC, fingerprint DESC)
+	// instead of OFFSET: an empty cursor requests the first page, the
+	// NextCursor of a response the following one. Page is ignored.
+	Cursor *string `json:"cursor,omitempty"`
 }
 
 // Validate validates pagination parameters
 func (p *Pagination) Validate() error {
-	if p.Page < 1 {
+	if p.Cursor != nil {
+		if *p.Cursor != "" {
+			if _, err := DecodeHistoryCursor(*p.Cursor); err != nil {
+				return err
+			}
+		}
+	} else if p.Page < 1 {
 		return ErrInvalidPage
 	}
 	if p.PerPage < 1 {
@@ -91,6 +112,36 @@ func (p *Pagination) Offset() int {
 	return (p.Page - 1) * p.PerPage
 }
 
+// HistoryCursor is the position of an alert in keyset pagination order.
+type HistoryCursor struct {
+	StartsAt    time.Time `json:"s"`
+	Fingerprint string    `json:"f"`
+}
+
+// NewHistoryCursor returns the cursor positioned after alert.
+func NewHistoryCursor(alert *Alert) *HistoryCursor {
+	return &HistoryCursor{StartsAt: alert.StartsAt, Fingerprint: alert.Fingerprint}
+}
+
+// Encode returns the opaque string form of the cursor.
+func (c *HistoryCursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeHistoryCursor parses a cursor returned as HistoryResponse.NextCursor.
+func DecodeHistoryCursor(cursor string) (*HistoryCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var c HistoryCursor
+	if err := json.Unmarshal(data, &c); err != nil || c.Fingerprint == "" {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
+
 // Sorting represents sorting parameters
 type Sorting struct {
 	Field string    `json:"field" validate:"required,oneof=created_at starts_at ends_at status severity"`
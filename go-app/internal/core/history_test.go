@@ -1,7 +1,9 @@
 package core
 
 import (
+	"errors"
 	"testing"
+	"time"
 )
 
 // TestPagination tests the Pagination struct
@@ -264,6 +266,44 @@ func TestHistoryRequest_Validate(t *testing.T) {
 	}
 }
 
+// TestPagination_Cursor tests keyset pagination cursors
+func TestPagination_Cursor(t *testing.T) {
+	startsAt := time.Date(2025, 11, 30, 12, 0, 0, 123456789, time.UTC)
+	cursor := NewHistoryCursor(&Alert{Fingerprint: "fp-1", StartsAt: startsAt}).Encode()
+
+	decoded, err := DecodeHistoryCursor(cursor)
+	if err != nil {
+		t.Fatalf("DecodeHistoryCursor() error = %v", err)
+	}
+	if decoded.Fingerprint != "fp-1" || !decoded.StartsAt.Equal(startsAt) {
+		t.Errorf("Unexpected cursor: %+v", decoded)
+	}
+
+	empty := ""
+	if err := (&Pagination{PerPage: 50, Cursor: &empty}).Validate(); err != nil {
+		t.Errorf("Empty cursor (first page) should be valid, got %v", err)
+	}
+	if err := (&Pagination{PerPage: 50, Cursor: &cursor}).Validate(); err != nil {
+		t.Errorf("Cursor pagination ignores page, got %v", err)
+	}
+	if err := (&Pagination{PerPage: 1001, Cursor: &cursor}).Validate(); !errors.Is(err, ErrPerPageTooLarge) {
+		t.Errorf("Expected ErrPerPageTooLarge, got %v", err)
+	}
+
+	invalid := "not-a-cursor"
+	if err := (&Pagination{PerPage: 50, Cursor: &invalid}).Validate(); !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("Expected ErrInvalidCursor, got %v", err)
+	}
+
+	req := &HistoryRequest{
+		Pagination: &Pagination{PerPage: 50, Cursor: &empty},
+		Sorting:    &Sorting{Field: "severity", Order: SortOrderAsc},
+	}
+	if err := req.Validate(); !errors.Is(err, ErrCursorSorting) {
+		t.Errorf("Expected ErrCursorSorting, got %v", err)
+	}
+}
+
 // BenchmarkPagination_Offset benchmarks the offset calculation
 func BenchmarkPagination_Offset(b *testing.B) {
 	p := &Pagination{
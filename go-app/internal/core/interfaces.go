@@ -32,6 +32,10 @@ const (
 	FormatPagerDuty    PublishingFormat = "pagerduty"
 	FormatSlack        PublishingFormat = "slack"
 	FormatWebhook      PublishingFormat = "webhook"
+	FormatOpsgenie     PublishingFormat = "opsgenie"
+	FormatEmail        PublishingFormat = "email"
+	FormatMSTeams      PublishingFormat = "msteams"
+	FormatTelegram     PublishingFormat = "telegram"
 )
 
 // Alert represents alert data model
@@ -45,6 +49,8 @@ type Alert struct {
 	EndsAt       *time.Time        `json:"ends_at,omitempty"`
 	GeneratorURL *string           `json:"generator_url,omitempty" validate:"omitempty,url"`
 	Timestamp    *time.Time        `json:"timestamp,omitempty"`
+	SilencedBy   []string          `json:"silenced_by,omitempty"`  // IDs of the silences that suppressed the alert
+	InhibitedBy  []string          `json:"inhibited_by,omitempty"` // Fingerprints of the alerts that inhibited the alert
 }
 
 // Namespace returns alert namespace from labels
@@ -81,7 +87,13 @@ type PublishingTarget struct {
 	Enabled      bool              `json:"enabled"`
 	FilterConfig map[string]any    `json:"filter_config"`
 	Headers      map[string]string `json:"headers"`
-	Format       PublishingFormat  `json:"format" validate:"required,oneof=alertmanager rootly pagerduty slack webhook"`
+	Format       PublishingFormat  `json:"format" validate:"required,oneof=alertmanager rootly pagerduty slack webhook opsgenie email msteams telegram"`
+
+	// Template references a managed template (/api/v2/templates) rendering
+	// the payload title/text/fields/details; empty uses the built-in format.
+	Template string `json:"template,omitempty"`
+	// TemplateVersion pins a template version, 0 follows the current version.
+	TemplateVersion int `json:"template_version,omitempty" validate:"gte=0"`
 }
 
 // EnrichedAlert represents alert enriched with classification data
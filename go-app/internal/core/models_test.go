@@ -310,7 +310,7 @@ func TestPublishingTargetValidation(t *testing.T) {
 				Format: "invalid",
 			},
 			wantErr: true,
-			errMsg:  "Format must be one of: alertmanager, rootly, pagerduty, slack, webhook",
+			errMsg:  "Format must be one of: alertmanager, rootly, pagerduty, slack, webhook, opsgenie, email, msteams, telegram",
 		},
 	}
 
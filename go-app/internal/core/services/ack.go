@@ -0,0 +1,379 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+)
+
+const (
+	maxAckActorLength   = 255
+	maxAckCommentLength = 4096
+
+	// ackForwardTimeout bounds forwarding of a single acknowledgement to
+	// external publishing targets (runs after the API call has returned).
+	ackForwardTimeout = 30 * time.Second
+)
+
+// AckRequest describes an acknowledgement workflow action on an alert
+// (fingerprint) or notification group (group key).
+type AckRequest struct {
+	TargetType core.AckTargetType
+	Target     string
+	Actor      string
+	Comment    string
+
+	// ExpiresAt is the acknowledgement expiry (Ack only, nil: until unacked)
+	ExpiresAt *time.Time
+
+	// Owner is the new owner (Assign only, "" unassigns)
+	Owner string
+}
+
+// AckForwarder forwards acknowledgements of alerts to external systems
+// supporting them (PagerDuty, Rootly).
+type AckForwarder interface {
+	ForwardAck(ctx context.Context, alert *core.Alert, ack *core.AlertAck) error
+}
+
+// AckService implements the alert acknowledgement and ownership workflow.
+//
+// Every action is recorded in the audit trail. Active acknowledgements
+// suppress repeat notifications of the alert or group (see IsAcknowledged)
+// until they expire or are removed.
+type AckService interface {
+	// Ack acknowledges the target
+	Ack(ctx context.Context, req *AckRequest) (*core.AlertAck, error)
+
+	// Unack removes the acknowledgement of the target
+	Unack(ctx context.Context, req *AckRequest) (*core.AlertAck, error)
+
+	// Assign sets (or clears) the owner of the target
+	Assign(ctx context.Context, req *AckRequest) (*core.AlertAck, error)
+
+	// Comment adds a comment to the audit trail of the target
+	Comment(ctx context.Context, req *AckRequest) (*core.AckEvent, error)
+
+	// GetAck returns the state of the target (core.ErrAckNotFound if none)
+	GetAck(ctx context.Context, targetType core.AckTargetType, target string) (*core.AlertAck, error)
+
+	// ListAcks returns the state of the given targets that have one, keyed by target
+	ListAcks(ctx context.Context, targetType core.AckTargetType, targets []string) (map[string]*core.AlertAck, error)
+
+	// ListActiveAcks returns all acknowledgements currently in effect
+	ListActiveAcks(ctx context.Context) ([]*core.AlertAck, error)
+
+	// ListEvents returns the audit trail of the target, newest first
+	ListEvents(ctx context.Context, targetType core.AckTargetType, target string, limit int) ([]*core.AckEvent, error)
+
+	// IsAcknowledged reports whether repeat notifications of a group are
+	// suppressed at t: the group itself or all of its firing alerts are
+	// acknowledged (implements AckChecker).
+	IsAcknowledged(ctx context.Context, groupKey string, fingerprints []string, t time.Time) bool
+}
+
+// AckServiceConfig holds configuration for AckService.
+type AckServiceConfig struct {
+	// Repository persists acknowledgement state and audit trail (required)
+	Repository core.AckRepository
+
+	// Storage looks up acknowledged alerts for forwarding (optional)
+	Storage core.AlertStorage
+
+	// Forwarder forwards alert acknowledgements to publishing targets
+	// (optional, requires Storage). Group acknowledgements are not forwarded.
+	Forwarder AckForwarder
+
+	// Logger (optional, defaults to slog.Default())
+	Logger *slog.Logger
+}
+
+// ackService implements AckService.
+type ackService struct {
+	repo      core.AckRepository
+	storage   core.AlertStorage
+	forwarder AckForwarder
+	logger    *slog.Logger
+	now       func() time.Time
+}
+
+// NewAckService creates a new acknowledgement service.
+func NewAckService(config *AckServiceConfig) (AckService, error) {
+	if config == nil {
+		return nil, fmt.Errorf("config is required")
+	}
+	if config.Repository == nil {
+		return nil, fmt.Errorf("repository is required")
+	}
+	if config.Logger == nil {
+		config.Logger = slog.Default()
+	}
+
+	return &ackService{
+		repo:      config.Repository,
+		storage:   config.Storage,
+		forwarder: config.Forwarder,
+		logger:    config.Logger,
+		now:       time.Now,
+	}, nil
+}
+
+// Ack implements AckService.Ack.
+func (s *ackService) Ack(ctx context.Context, req *AckRequest) (*core.AlertAck, error) {
+	now := s.now()
+	if err := validateAckRequest(req); err != nil {
+		return nil, err
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(now) {
+		return nil, core.ErrInvalidAckExpiry
+	}
+
+	state, err := s.loadState(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	state.Acknowledged = true
+	state.AckedBy = req.Actor
+	state.AckedAt = &now
+	state.ExpiresAt = req.ExpiresAt
+	state.UpdatedAt = now
+
+	if err := s.save(ctx, state, req, core.AckActionAck, now); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Alert acknowledged",
+		"target_type", req.TargetType,
+		"target", req.Target,
+		"actor", req.Actor,
+		"expires_at", req.ExpiresAt)
+
+	s.forward(state)
+	return state, nil
+}
+
+// Unack implements AckService.Unack.
+func (s *ackService) Unack(ctx context.Context, req *AckRequest) (*core.AlertAck, error) {
+	now := s.now()
+	if err := validateAckRequest(req); err != nil {
+		return nil, err
+	}
+
+	state, err := s.repo.GetAck(ctx, req.TargetType, req.Target)
+	if err != nil {
+		return nil, err
+	}
+	if !state.Acknowledged {
+		return nil, core.ErrAckNotFound
+	}
+	state.Acknowledged = false
+	state.ExpiresAt = nil
+	state.UpdatedAt = now
+
+	if err := s.save(ctx, state, req, core.AckActionUnack, now); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Alert acknowledgement removed",
+		"target_type", req.TargetType,
+		"target", req.Target,
+		"actor", req.Actor)
+
+	return state, nil
+}
+
+// Assign implements AckService.Assign.
+func (s *ackService) Assign(ctx context.Context, req *AckRequest) (*core.AlertAck, error) {
+	now := s.now()
+	if err := validateAckRequest(req); err != nil {
+		return nil, err
+	}
+	if len(req.Owner) > maxAckActorLength {
+		return nil, core.ErrInvalidAckOwner
+	}
+
+	state, err := s.loadState(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	state.Owner = req.Owner
+	state.AssignedBy = req.Actor
+	state.AssignedAt = &now
+	state.UpdatedAt = now
+	if req.Owner == "" {
+		state.AssignedBy = ""
+		state.AssignedAt = nil
+	}
+
+	if err := s.save(ctx, state, req, core.AckActionAssign, now); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Alert owner assigned",
+		"target_type", req.TargetType,
+		"target", req.Target,
+		"actor", req.Actor,
+		"owner", req.Owner)
+
+	return state, nil
+}
+
+// Comment implements AckService.Comment.
+func (s *ackService) Comment(ctx context.Context, req *AckRequest) (*core.AckEvent, error) {
+	if err := validateAckRequest(req); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(req.Comment) == "" {
+		return nil, core.ErrAckCommentEmpty
+	}
+
+	event := newAckEvent(req, core.AckActionComment, s.now())
+	if err := s.repo.SaveAck(ctx, nil, event); err != nil {
+		return nil, fmt.Errorf("save comment: %w", err)
+	}
+	return event, nil
+}
+
+// GetAck implements AckService.GetAck.
+func (s *ackService) GetAck(ctx context.Context, targetType core.AckTargetType, target string) (*core.AlertAck, error) {
+	return s.repo.GetAck(ctx, targetType, target)
+}
+
+// ListAcks implements AckService.ListAcks.
+func (s *ackService) ListAcks(ctx context.Context, targetType core.AckTargetType, targets []string) (map[string]*core.AlertAck, error) {
+	return s.repo.ListAcks(ctx, targetType, targets)
+}
+
+// ListActiveAcks implements AckService.ListActiveAcks.
+func (s *ackService) ListActiveAcks(ctx context.Context) ([]*core.AlertAck, error) {
+	return s.repo.ListActiveAcks(ctx, s.now())
+}
+
+// ListEvents implements AckService.ListEvents.
+func (s *ackService) ListEvents(ctx context.Context, targetType core.AckTargetType, target string, limit int) ([]*core.AckEvent, error) {
+	return s.repo.ListAckEvents(ctx, targetType, target, limit)
+}
+
+// IsAcknowledged implements AckService.IsAcknowledged.
+//
+// Fail-open: repository errors do not suppress notifications.
+func (s *ackService) IsAcknowledged(ctx context.Context, groupKey string, fingerprints []string, t time.Time) bool {
+	group, err := s.repo.GetAck(ctx, core.AckTargetGroup, groupKey)
+	if err == nil && group.IsActive(t) {
+		return true
+	}
+	if err != nil && !errors.Is(err, core.ErrAckNotFound) {
+		s.logger.Warn("Acknowledgement lookup failed, not suppressing",
+			"group_key", groupKey,
+			"error", err)
+		return false
+	}
+
+	if len(fingerprints) == 0 {
+		return false
+	}
+	acks, err := s.repo.ListAcks(ctx, core.AckTargetAlert, fingerprints)
+	if err != nil {
+		s.logger.Warn("Acknowledgement lookup failed, not suppressing",
+			"group_key", groupKey,
+			"error", err)
+		return false
+	}
+	for _, fingerprint := range fingerprints {
+		if !acks[fingerprint].IsActive(t) {
+			return false
+		}
+	}
+	return true
+}
+
+// loadState returns the current state of the request target, or a new one.
+func (s *ackService) loadState(ctx context.Context, req *AckRequest) (*core.AlertAck, error) {
+	state, err := s.repo.GetAck(ctx, req.TargetType, req.Target)
+	if errors.Is(err, core.ErrAckNotFound) {
+		return &core.AlertAck{TargetType: req.TargetType, Target: req.Target}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get acknowledgement: %w", err)
+	}
+	return state, nil
+}
+
+// save stores the new state together with its audit event.
+func (s *ackService) save(ctx context.Context, state *core.AlertAck, req *AckRequest, action core.AckAction, now time.Time) error {
+	if err := s.repo.SaveAck(ctx, state, newAckEvent(req, action, now)); err != nil {
+		return fmt.Errorf("save acknowledgement: %w", err)
+	}
+	return nil
+}
+
+// forward forwards an alert acknowledgement to the publishing targets in
+// the background; failures are logged only.
+func (s *ackService) forward(state *core.AlertAck) {
+	if s.forwarder == nil || s.storage == nil || state.TargetType != core.AckTargetAlert {
+		return
+	}
+
+	ack := *state
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), ackForwardTimeout)
+		defer cancel()
+
+		alert, err := s.storage.GetAlertByFingerprint(ctx, ack.Target)
+		if err != nil || alert == nil {
+			s.logger.Debug("Acknowledged alert not found, not forwarding",
+				"fingerprint", ack.Target,
+				"error", err)
+			return
+		}
+		if err := s.forwarder.ForwardAck(ctx, alert, &ack); err != nil {
+			s.logger.Warn("Failed to forward acknowledgement",
+				"fingerprint", ack.Target,
+				"error", err)
+		}
+	}()
+}
+
+// validateAckRequest checks the fields common to all actions.
+func validateAckRequest(req *AckRequest) error {
+	if req == nil {
+		return fmt.Errorf("%w: request is nil", core.ErrInvalidAckTarget)
+	}
+	if req.TargetType != core.AckTargetAlert && req.TargetType != core.AckTargetGroup {
+		return fmt.Errorf("%w: unknown target type %q", core.ErrInvalidAckTarget, req.TargetType)
+	}
+	if strings.TrimSpace(req.Target) == "" {
+		return fmt.Errorf("%w: target is required", core.ErrInvalidAckTarget)
+	}
+	if strings.TrimSpace(req.Actor) == "" || len(req.Actor) > maxAckActorLength {
+		return core.ErrInvalidAckActor
+	}
+	if len(req.Comment) > maxAckCommentLength {
+		return core.ErrAckCommentLength
+	}
+	return nil
+}
+
+// newAckEvent builds the audit event of an action.
+func newAckEvent(req *AckRequest, action core.AckAction, now time.Time) *core.AckEvent {
+	event := &core.AckEvent{
+		TargetType: req.TargetType,
+		Target:     req.Target,
+		Action:     action,
+		Actor:      req.Actor,
+		Comment:    req.Comment,
+		CreatedAt:  now,
+	}
+	switch action {
+	case core.AckActionAck:
+		event.ExpiresAt = req.ExpiresAt
+	case core.AckActionAssign:
+		event.Owner = req.Owner
+	}
+	return event
+}
@@ -0,0 +1,174 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure/repository"
+)
+
+// recordingAckForwarder captures forwarded acknowledgements.
+type recordingAckForwarder struct {
+	mu        sync.Mutex
+	forwarded []string
+}
+
+func (f *recordingAckForwarder) ForwardAck(ctx context.Context, alert *core.Alert, ack *core.AlertAck) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.forwarded = append(f.forwarded, alert.Fingerprint+":"+ack.AckedBy)
+	return nil
+}
+
+func (f *recordingAckForwarder) snapshot() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.forwarded...)
+}
+
+// ackAlertStorage returns a firing alert for any fingerprint.
+type ackAlertStorage struct {
+	core.AlertStorage
+}
+
+func (s *ackAlertStorage) GetAlertByFingerprint(ctx context.Context, fingerprint string) (*core.Alert, error) {
+	return &core.Alert{Fingerprint: fingerprint, AlertName: "HighCPU", Status: core.StatusFiring}, nil
+}
+
+func newTestAckService(t *testing.T, forwarder AckForwarder) AckService {
+	t.Helper()
+	service, err := NewAckService(&AckServiceConfig{
+		Repository: repository.NewMemoryAckRepository(),
+		Storage:    &ackAlertStorage{},
+		Forwarder:  forwarder,
+	})
+	require.NoError(t, err)
+	return service
+}
+
+func TestNewAckService_Validation(t *testing.T) {
+	_, err := NewAckService(nil)
+	assert.Error(t, err)
+
+	_, err = NewAckService(&AckServiceConfig{})
+	assert.Error(t, err)
+}
+
+func TestAckService_Workflow(t *testing.T) {
+	forwarder := &recordingAckForwarder{}
+	service := newTestAckService(t, forwarder)
+	ctx := context.Background()
+	expires := time.Now().Add(time.Hour)
+
+	state, err := service.Ack(ctx, &AckRequest{
+		TargetType: core.AckTargetAlert,
+		Target:     "fp-1",
+		Actor:      "alice",
+		Comment:    "looking into it",
+		ExpiresAt:  &expires,
+	})
+	require.NoError(t, err)
+	assert.True(t, state.IsActive(time.Now()))
+	assert.False(t, state.IsActive(expires.Add(time.Second)))
+	assert.Eventually(t, func() bool {
+		return len(forwarder.snapshot()) == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"fp-1:alice"}, forwarder.snapshot())
+
+	state, err = service.Assign(ctx, &AckRequest{TargetType: core.AckTargetAlert, Target: "fp-1", Actor: "alice", Owner: "bob"})
+	require.NoError(t, err)
+	assert.Equal(t, "bob", state.Owner)
+	assert.True(t, state.Acknowledged, "Assign keeps the acknowledgement")
+
+	_, err = service.Comment(ctx, &AckRequest{TargetType: core.AckTargetAlert, Target: "fp-1", Actor: "bob", Comment: "root cause found"})
+	require.NoError(t, err)
+
+	state, err = service.Unack(ctx, &AckRequest{TargetType: core.AckTargetAlert, Target: "fp-1", Actor: "bob"})
+	require.NoError(t, err)
+	assert.False(t, state.Acknowledged)
+	assert.Equal(t, "bob", state.Owner)
+
+	_, err = service.Unack(ctx, &AckRequest{TargetType: core.AckTargetAlert, Target: "fp-1", Actor: "bob"})
+	assert.ErrorIs(t, err, core.ErrAckNotFound)
+
+	events, err := service.ListEvents(ctx, core.AckTargetAlert, "fp-1", 0)
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+	assert.Equal(t, core.AckActionUnack, events[0].Action)
+	assert.Equal(t, core.AckActionComment, events[1].Action)
+	assert.Equal(t, "root cause found", events[1].Comment)
+	assert.Equal(t, core.AckActionAssign, events[2].Action)
+	assert.Equal(t, "bob", events[2].Owner)
+	assert.Equal(t, core.AckActionAck, events[3].Action)
+	assert.Equal(t, "looking into it", events[3].Comment)
+}
+
+func TestAckService_GroupAckNotForwarded(t *testing.T) {
+	forwarder := &recordingAckForwarder{}
+	service := newTestAckService(t, forwarder)
+
+	_, err := service.Ack(context.Background(), &AckRequest{TargetType: core.AckTargetGroup, Target: "{}:{alertname=\"HighCPU\"}", Actor: "alice"})
+	require.NoError(t, err)
+
+	time.Sleep(50 * time.Millisecond)
+	assert.Empty(t, forwarder.snapshot())
+}
+
+func TestAckService_Validation(t *testing.T) {
+	service := newTestAckService(t, nil)
+	ctx := context.Background()
+	past := time.Now().Add(-time.Minute)
+	long := make([]byte, 4097)
+	for i := range long {
+		long[i] = 'x'
+	}
+
+	tests := []struct {
+		name string
+		req  *AckRequest
+		want error
+	}{
+		{"unknown target type", &AckRequest{TargetType: "silence", Target: "x", Actor: "alice"}, core.ErrInvalidAckTarget},
+		{"empty target", &AckRequest{TargetType: core.AckTargetAlert, Actor: "alice"}, core.ErrInvalidAckTarget},
+		{"empty actor", &AckRequest{TargetType: core.AckTargetAlert, Target: "fp-1"}, core.ErrInvalidAckActor},
+		{"expiry in the past", &AckRequest{TargetType: core.AckTargetAlert, Target: "fp-1", Actor: "alice", ExpiresAt: &past}, core.ErrInvalidAckExpiry},
+		{"comment too long", &AckRequest{TargetType: core.AckTargetAlert, Target: "fp-1", Actor: "alice", Comment: string(long)}, core.ErrAckCommentLength},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.Ack(ctx, tt.req)
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+
+	_, err := service.Comment(ctx, &AckRequest{TargetType: core.AckTargetAlert, Target: "fp-1", Actor: "alice", Comment: " "})
+	assert.ErrorIs(t, err, core.ErrAckCommentEmpty)
+}
+
+func TestAckService_IsAcknowledged(t *testing.T) {
+	service := newTestAckService(t, nil)
+	ctx := context.Background()
+	now := time.Now()
+	groupKey := "{}:{alertname=\"HighCPU\"}"
+
+	_, err := service.Ack(ctx, &AckRequest{TargetType: core.AckTargetAlert, Target: "fp-1", Actor: "alice"})
+	require.NoError(t, err)
+
+	assert.True(t, service.IsAcknowledged(ctx, groupKey, []string{"fp-1"}, now))
+	assert.False(t, service.IsAcknowledged(ctx, groupKey, []string{"fp-1", "fp-2"}, now), "All firing alerts must be acknowledged")
+	assert.False(t, service.IsAcknowledged(ctx, groupKey, nil, now))
+
+	expires := now.Add(time.Hour)
+	_, err = service.Ack(ctx, &AckRequest{TargetType: core.AckTargetGroup, Target: groupKey, Actor: "alice", ExpiresAt: &expires})
+	require.NoError(t, err)
+
+	assert.True(t, service.IsAcknowledged(ctx, groupKey, []string{"fp-1", "fp-2"}, now))
+	assert.False(t, service.IsAcknowledged(ctx, groupKey, []string{"fp-1", "fp-2"}, expires.Add(time.Second)), "Expired group acknowledgement")
+}
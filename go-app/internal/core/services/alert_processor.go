@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 	"time"
 
 	"github.com/vitaliisemenov/alert-history/internal/core"
+	coresilencing "github.com/vitaliisemenov/alert-history/internal/core/silencing"
 	"github.com/vitaliisemenov/alert-history/internal/infrastructure/inhibition"
 	"github.com/vitaliisemenov/alert-history/pkg/metrics"
 )
@@ -28,16 +30,37 @@ type Publisher interface {
 	PublishWithClassification(ctx context.Context, alert *core.Alert, classification *core.ClassificationResult) error
 }
 
+// SilenceChecker defines the interface for silence checking
+// (implemented by silencing.SilenceManager)
+type SilenceChecker interface {
+	IsAlertSilenced(ctx context.Context, alert *coresilencing.Alert) (bool, []string, error)
+}
+
+// Escalator escalates published firing alerts under escalation policies
+// (implemented by escalation.DefaultEscalationManager).
+type Escalator interface {
+	// Escalate starts the escalation of a firing alert matching a policy
+	Escalate(ctx context.Context, alert *core.Alert) error
+
+	// Resolve stops the escalation of a resolved alert
+	Resolve(ctx context.Context, fingerprint string) error
+}
+
 // AlertProcessor handles alert processing with enrichment mode support
 type AlertProcessor struct {
 	enrichmentManager EnrichmentModeManager
 	llmClient         LLMClient
 	filterEngine      FilterEngine
 	publisher         Publisher
-	deduplication     DeduplicationService                  // TN-036 Phase 3: Deduplication service
-	inhibitionMatcher inhibition.InhibitionMatcher          // TN-130 Phase 6: Inhibition checking
-	inhibitionState   inhibition.InhibitionStateManager     // TN-130 Phase 6: State tracking
-	businessMetrics   *metrics.BusinessMetrics              // TN-130 Phase 6: Business metrics for inhibition
+	deduplication     DeduplicationService              // TN-036 Phase 3: Deduplication service
+	inhibitionMatcher inhibition.InhibitionMatcher      // TN-130 Phase 6: Inhibition checking
+	inhibitionState   inhibition.InhibitionStateManager // TN-130 Phase 6: State tracking
+	activeAlerts      inhibition.ActiveAlertCache       // Firing alerts (inhibition sources, released targets)
+	businessMetrics   *metrics.BusinessMetrics          // TN-130 Phase 6: Business metrics for inhibition
+	silenceChecker    SilenceChecker                    // Silence checking (after inhibition)
+	dispatcher        Dispatcher                        // Route tree + grouping dispatch
+	escalator         Escalator                         // Escalation policies
+	storage           core.AlertStorage                 // Alert history (suppression state)
 	logger            *slog.Logger
 	metrics           *metrics.MetricsManager
 }
@@ -48,10 +71,15 @@ type AlertProcessorConfig struct {
 	LLMClient         LLMClient // optional, required only for enriched mode
 	FilterEngine      FilterEngine
 	Publisher         Publisher
-	Deduplication     DeduplicationService                  // TN-036 Phase 3: optional, recommended for production
-	InhibitionMatcher inhibition.InhibitionMatcher          // TN-130 Phase 6: optional, recommended for inhibition
-	InhibitionState   inhibition.InhibitionStateManager     // TN-130 Phase 6: optional, for state tracking
-	BusinessMetrics   *metrics.BusinessMetrics              // TN-130 Phase 6: required if using inhibition
+	Deduplication     DeduplicationService              // TN-036 Phase 3: optional, recommended for production
+	InhibitionMatcher inhibition.InhibitionMatcher      // TN-130 Phase 6: optional, recommended for inhibition
+	InhibitionState   inhibition.InhibitionStateManager // TN-130 Phase 6: optional, for state tracking
+	ActiveAlerts      inhibition.ActiveAlertCache       // optional, required to re-dispatch targets released on source resolve
+	BusinessMetrics   *metrics.BusinessMetrics          // TN-130 Phase 6: required if using inhibition
+	SilenceChecker    SilenceChecker                    // optional, skips publishing of silenced alerts
+	Dispatcher        Dispatcher                        // optional, routes and groups alerts before publishing
+	Escalator         Escalator                         // optional, escalates unacknowledged alerts
+	Storage           core.AlertStorage                 // optional, records silenced/inhibited state on the alert history
 	Logger            *slog.Logger
 	Metrics           *metrics.MetricsManager
 }
@@ -80,7 +108,12 @@ func NewAlertProcessor(config AlertProcessorConfig) (*AlertProcessor, error) {
 		deduplication:     config.Deduplication,
 		inhibitionMatcher: config.InhibitionMatcher, // TN-130 Phase 6
 		inhibitionState:   config.InhibitionState,   // TN-130 Phase 6
+		activeAlerts:      config.ActiveAlerts,      // Released targets re-dispatch
 		businessMetrics:   config.BusinessMetrics,   // TN-130 Phase 6
+		silenceChecker:    config.SilenceChecker,
+		dispatcher:        config.Dispatcher,
+		escalator:         config.Escalator,
+		storage:           config.Storage,
 		logger:            config.Logger,
 		metrics:           config.Metrics,
 	}, nil
@@ -116,6 +149,23 @@ func (p *AlertProcessor) ProcessAlert(ctx context.Context, alert *core.Alert) er
 		}
 	}
 
+	// Keep firing alerts (inhibition sources) up to date
+	p.trackActiveAlert(ctx, alert)
+
+	err := p.processAlert(ctx, alert, startTime)
+
+	// Targets inhibited by a resolved source are re-evaluated and notified
+	if alert.Status == core.StatusResolved {
+		p.releaseInhibited(ctx, alert)
+		p.resolveEscalation(ctx, alert)
+	}
+
+	return err
+}
+
+// processAlert runs inhibition, silencing, enrichment and publishing
+// for a deduplicated alert.
+func (p *AlertProcessor) processAlert(ctx context.Context, alert *core.Alert, startTime time.Time) error {
 	// TN-130 Phase 6: Step 1 - Inhibition check (after dedup, before classification)
 	if p.inhibitionMatcher != nil && alert.Status == core.StatusFiring {
 		inhibitionResult, err := p.inhibitionMatcher.ShouldInhibit(ctx, alert)
@@ -156,6 +206,7 @@ func (p *AlertProcessor) ProcessAlert(ctx context.Context, alert *core.Alert) er
 			}
 
 			// Skip publishing - alert is inhibited
+			p.recordSuppression(ctx, alert, nil, []string{inhibitionResult.InhibitedBy.Fingerprint})
 			return nil
 		} else {
 			// Alert is NOT inhibited, continue processing
@@ -170,6 +221,44 @@ func (p *AlertProcessor) ProcessAlert(ctx context.Context, alert *core.Alert) er
 		}
 	}
 
+	// Step 2 - Silence check (after inhibition, before classification)
+	if p.silenceChecker != nil && alert.Status == core.StatusFiring {
+		silenced, silenceIDs, err := p.silenceChecker.IsAlertSilenced(ctx, &coresilencing.Alert{
+			Labels:      alert.Labels,
+			Annotations: alert.Annotations,
+		})
+		if err != nil {
+			p.logger.Warn("Silence check failed, continuing with processing",
+				"error", err,
+				"alert", alert.AlertName,
+				"fingerprint", alert.Fingerprint)
+			// Fail-safe: continue processing on silence check error
+		} else if silenced {
+			p.logger.Info("Alert silenced",
+				"alert", alert.AlertName,
+				"fingerprint", alert.Fingerprint,
+				"silence_ids", silenceIDs)
+
+			if p.businessMetrics != nil {
+				p.businessMetrics.RecordSilenceOperation("check", "silenced")
+			}
+
+			// Skip publishing - alert is silenced
+			p.recordSuppression(ctx, alert, silenceIDs, nil)
+			return nil
+		} else if p.businessMetrics != nil {
+			p.businessMetrics.RecordSilenceOperation("check", "not_silenced")
+		}
+	}
+
+	// No longer suppressed (silence expired, inhibiting source resolved)
+	if len(alert.SilencedBy) > 0 || len(alert.InhibitedBy) > 0 {
+		p.recordSuppression(ctx, alert, nil, nil)
+		active := *alert
+		active.SilencedBy, active.InhibitedBy = nil, nil
+		alert = &active
+	}
+
 	// Get current enrichment mode
 	mode, err := p.enrichmentManager.GetMode(ctx)
 	if err != nil {
@@ -224,16 +313,126 @@ func (p *AlertProcessor) ProcessAlert(ctx context.Context, alert *core.Alert) er
 	return nil
 }
 
-// processTransparentWithRecommendations bypasses all processing (emergency mode)
+// trackActiveAlert adds firing alerts to the active alert cache and removes
+// resolved ones (including their own inhibition state).
+func (p *AlertProcessor) trackActiveAlert(ctx context.Context, alert *core.Alert) {
+	if p.activeAlerts == nil {
+		return
+	}
+
+	if alert.Status == core.StatusFiring {
+		if err := p.activeAlerts.AddFiringAlert(ctx, alert); err != nil {
+			p.logger.Warn("Failed to track firing alert", "error", err, "fingerprint", alert.Fingerprint)
+		}
+		return
+	}
+
+	if err := p.activeAlerts.RemoveAlert(ctx, alert.Fingerprint); err != nil {
+		p.logger.Warn("Failed to untrack resolved alert", "error", err, "fingerprint", alert.Fingerprint)
+	}
+	if p.inhibitionState != nil {
+		if inhibited, err := p.inhibitionState.IsInhibited(ctx, alert.Fingerprint); err == nil && inhibited {
+			if err := p.inhibitionState.RemoveInhibition(ctx, alert.Fingerprint); err != nil {
+				p.logger.Warn("Failed to remove inhibition of resolved alert", "error", err, "fingerprint", alert.Fingerprint)
+			}
+		}
+	}
+}
+
+// releaseInhibited re-evaluates the targets inhibited by a resolved source.
+//
+// Targets still firing go through inhibition again (another source may
+// still inhibit them), then silencing and publishing; resolved targets are
+// skipped.
+func (p *AlertProcessor) releaseInhibited(ctx context.Context, source *core.Alert) {
+	if p.inhibitionState == nil {
+		return
+	}
+
+	released, err := p.inhibitionState.ReleaseBySource(ctx, source.Fingerprint)
+	if err != nil {
+		p.logger.Warn("Failed to release inhibited alerts", "error", err, "source", source.Fingerprint)
+		return
+	}
+	if len(released) == 0 {
+		return
+	}
+	if p.activeAlerts == nil {
+		p.logger.Warn("Active alert cache not configured, released alerts are not re-dispatched",
+			"source", source.Fingerprint,
+			"released", len(released))
+		return
+	}
+
+	firingAlerts, err := p.activeAlerts.GetFiringAlerts(ctx)
+	if err != nil {
+		p.logger.Warn("Failed to load firing alerts, released alerts are not re-dispatched",
+			"error", err,
+			"source", source.Fingerprint)
+		return
+	}
+	firing := make(map[string]*core.Alert, len(firingAlerts))
+	for _, alert := range firingAlerts {
+		firing[alert.Fingerprint] = alert
+	}
+
+	for _, state := range released {
+		target, ok := firing[state.TargetFingerprint]
+		if !ok {
+			p.logger.Debug("Released alert no longer firing", "fingerprint", state.TargetFingerprint)
+			continue
+		}
+
+		p.logger.Info("Re-dispatching alert released by resolved source",
+			"alert", target.AlertName,
+			"fingerprint", target.Fingerprint,
+			"source", source.Fingerprint,
+			"rule", state.RuleName)
+
+		// The target's history record is still inhibited by the source
+		released := *target
+		released.InhibitedBy = []string{source.Fingerprint}
+		if err := p.processAlert(ctx, &released, time.Now()); err != nil {
+			p.logger.Warn("Failed to re-dispatch released alert",
+				"error", err,
+				"fingerprint", target.Fingerprint)
+		}
+	}
+}
+
+// recordSuppression stores the silences and inhibiting alerts that suppress
+// an alert on its history record (both nil clear the suppression). Failures
+// are logged: the alert is suppressed either way.
+func (p *AlertProcessor) recordSuppression(ctx context.Context, alert *core.Alert, silencedBy, inhibitedBy []string) {
+	if p.storage == nil {
+		return
+	}
+	if slices.Equal(alert.SilencedBy, silencedBy) && slices.Equal(alert.InhibitedBy, inhibitedBy) {
+		return
+	}
+
+	record := *alert
+	record.SilencedBy = silencedBy
+	record.InhibitedBy = inhibitedBy
+	if err := p.storage.UpdateAlert(ctx, &record); err != nil {
+		p.logger.Warn("Failed to record alert suppression in history",
+			"error", err,
+			"fingerprint", alert.Fingerprint)
+	}
+}
+
+// processTransparentWithRecommendations bypasses classification and
+// filtering (emergency mode). Inhibition and silences have already been
+// applied; routing, grouping and time intervals still apply via publish.
 func (p *AlertProcessor) processTransparentWithRecommendations(ctx context.Context, alert *core.Alert) error {
-	p.logger.Info("Processing in transparent_with_recommendations mode (bypass all)",
+	p.logger.Info("Processing in transparent_with_recommendations mode (no LLM, no filtering)",
 		"alert", alert.AlertName,
 	)
 
 	// NO LLM classification
 	// NO filtering
-	// Publish to ALL targets immediately
-	return p.publisher.PublishToAll(ctx, alert)
+	// Publish via the dispatch path (route tree + grouping if configured)
+	return p.publish(ctx, alert, nil)
 }
 
 // processTransparent processes without LLM but with filtering
@@ -254,8 +453,8 @@ func (p *AlertProcessor) processTransparent(ctx context.Context, alert *core.Ale
 		return nil // Not an error, just filtered out
 	}
 
-	// Publish to ALL configured targets
-	return p.publisher.PublishToAll(ctx, alert)
+	// Publish to ALL configured targets (via route tree + grouping if configured)
+	return p.publish(ctx, alert, nil)
 }
 
 // processEnriched processes with full LLM classification and filtering (production mode)
@@ -300,7 +499,42 @@ func (p *AlertProcessor) processEnriched(ctx context.Context, alert *core.Alert)
 	}
 
 	// Step 3: Publish with classification (smart routing)
-	return p.publisher.PublishWithClassification(ctx, alert, classification)
+	return p.publish(ctx, alert, classification)
+}
+
+// publish hands the alert to the dispatcher (route → group → timers → publish)
+// when configured, otherwise publishes it directly. Published firing alerts
+// are then escalated (inhibited, silenced and filtered alerts never are).
+func (p *AlertProcessor) publish(ctx context.Context, alert *core.Alert, classification *core.ClassificationResult) error {
+	defer p.escalate(ctx, alert)
+
+	if p.dispatcher != nil {
+		return p.dispatcher.Dispatch(ctx, alert, classification)
+	}
+	if classification != nil {
+		return p.publisher.PublishWithClassification(ctx, alert, classification)
+	}
+	return p.publisher.PublishToAll(ctx, alert)
+}
+
+// escalate starts the escalation of a firing alert (no-op if already escalating).
+func (p *AlertProcessor) escalate(ctx context.Context, alert *core.Alert) {
+	if p.escalator == nil || alert.Status != core.StatusFiring {
+		return
+	}
+	if err := p.escalator.Escalate(ctx, alert); err != nil {
+		p.logger.Warn("Failed to escalate alert", "error", err, "fingerprint", alert.Fingerprint)
+	}
+}
+
+// resolveEscalation stops the escalation of a resolved alert.
+func (p *AlertProcessor) resolveEscalation(ctx context.Context, alert *core.Alert) {
+	if p.escalator == nil {
+		return
+	}
+	if err := p.escalator.Resolve(ctx, alert.Fingerprint); err != nil {
+		p.logger.Warn("Failed to stop escalation of resolved alert", "error", err, "fingerprint", alert.Fingerprint)
+	}
 }
 
 // Health checks if all dependencies are healthy
@@ -9,6 +9,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/vitaliisemenov/alert-history/internal/core"
+	coresilencing "github.com/vitaliisemenov/alert-history/internal/core/silencing"
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure/inhibition"
 )
 
 // Mock implementations
@@ -392,6 +394,295 @@ func TestAlertProcessor_ProcessAlert_Enriched(t *testing.T) {
 	})
 }
 
+func TestAlertProcessor_ProcessAlert_Silenced(t *testing.T) {
+	t.Run("silenced_alert_not_published", func(t *testing.T) {
+		publishToAllCalled := false
+
+		processor, err := NewAlertProcessor(AlertProcessorConfig{
+			EnrichmentManager: &mockEnrichmentManager{mode: EnrichmentModeTransparent},
+			FilterEngine:      &mockFilterEngine{},
+			Publisher: &mockPublisher{
+				publishToAllFunc: func(ctx context.Context, alert *core.Alert) error {
+					publishToAllCalled = true
+					return nil
+				},
+			},
+			SilenceChecker: &mockSilenceChecker{silenced: true, silenceIDs: []string{"silence-1"}},
+		})
+		assert.NoError(t, err)
+
+		err = processor.ProcessAlert(context.Background(), createTestAlert())
+
+		assert.NoError(t, err) // Silencing is not an error
+		assert.False(t, publishToAllCalled, "Should not publish silenced alert")
+	})
+
+	t.Run("silenced_state_recorded_in_history", func(t *testing.T) {
+		storage := newMockAlertStorage()
+		checker := &mockSilenceChecker{silenced: true, silenceIDs: []string{"silence-1"}}
+		dispatcher := &mockDispatcher{}
+
+		processor, err := NewAlertProcessor(AlertProcessorConfig{
+			EnrichmentManager: &mockEnrichmentManager{mode: EnrichmentModeTransparent},
+			FilterEngine:      &mockFilterEngine{},
+			Publisher:         &mockPublisher{},
+			SilenceChecker:    checker,
+			Dispatcher:        dispatcher,
+			Storage:           storage,
+		})
+		assert.NoError(t, err)
+
+		alert := createTestAlert()
+		assert.NoError(t, processor.ProcessAlert(context.Background(), alert))
+
+		stored, err := storage.GetAlertByFingerprint(context.Background(), alert.Fingerprint)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"silence-1"}, stored.SilencedBy)
+		assert.Empty(t, stored.InhibitedBy)
+		assert.Empty(t, dispatcher.alerts)
+		assert.Nil(t, alert.SilencedBy, "Processed alert must not be modified")
+
+		// Silence expired: the next update of the history record (as returned
+		// by deduplication) clears the suppression and is published
+		checker.silenced = false
+		assert.NoError(t, processor.ProcessAlert(context.Background(), stored))
+
+		stored, err = storage.GetAlertByFingerprint(context.Background(), alert.Fingerprint)
+		assert.NoError(t, err)
+		assert.Empty(t, stored.SilencedBy)
+		if assert.Len(t, dispatcher.alerts, 1) {
+			assert.Empty(t, dispatcher.alerts[0].SilencedBy)
+		}
+	})
+
+	t.Run("resolved_alert_skips_silence_check", func(t *testing.T) {
+		publishToAllCalled := false
+		checker := &mockSilenceChecker{silenced: true}
+
+		processor, err := NewAlertProcessor(AlertProcessorConfig{
+			EnrichmentManager: &mockEnrichmentManager{mode: EnrichmentModeTransparent},
+			FilterEngine:      &mockFilterEngine{},
+			Publisher: &mockPublisher{
+				publishToAllFunc: func(ctx context.Context, alert *core.Alert) error {
+					publishToAllCalled = true
+					return nil
+				},
+			},
+			SilenceChecker: checker,
+		})
+		assert.NoError(t, err)
+
+		alert := createTestAlert()
+		alert.Status = core.StatusResolved
+		err = processor.ProcessAlert(context.Background(), alert)
+
+		assert.NoError(t, err)
+		assert.True(t, publishToAllCalled)
+		assert.Equal(t, 0, checker.calls)
+	})
+
+	t.Run("silence_check_error_fails_open", func(t *testing.T) {
+		publishToAllCalled := false
+
+		processor, err := NewAlertProcessor(AlertProcessorConfig{
+			EnrichmentManager: &mockEnrichmentManager{mode: EnrichmentModeTransparent},
+			FilterEngine:      &mockFilterEngine{},
+			Publisher: &mockPublisher{
+				publishToAllFunc: func(ctx context.Context, alert *core.Alert) error {
+					publishToAllCalled = true
+					return nil
+				},
+			},
+			SilenceChecker: &mockSilenceChecker{err: errors.New("cache unavailable")},
+		})
+		assert.NoError(t, err)
+
+		err = processor.ProcessAlert(context.Background(), createTestAlert())
+
+		assert.NoError(t, err)
+		assert.True(t, publishToAllCalled, "Should publish when silence check fails")
+	})
+}
+
+func TestAlertProcessor_ProcessAlert_Dispatcher(t *testing.T) {
+	t.Run("enriched_mode_dispatches_with_classification", func(t *testing.T) {
+		dispatcher := &mockDispatcher{}
+		publishCalled := false
+
+		processor, err := NewAlertProcessor(AlertProcessorConfig{
+			EnrichmentManager: &mockEnrichmentManager{mode: EnrichmentModeEnriched},
+			LLMClient:         &mockLLMClient{},
+			FilterEngine:      &mockFilterEngine{},
+			Publisher: &mockPublisher{
+				publishWithClassificationFunc: func(ctx context.Context, alert *core.Alert, classification *core.ClassificationResult) error {
+					publishCalled = true
+					return nil
+				},
+			},
+			Dispatcher: dispatcher,
+		})
+		assert.NoError(t, err)
+
+		err = processor.ProcessAlert(context.Background(), createTestAlert())
+
+		assert.NoError(t, err)
+		assert.False(t, publishCalled, "Should publish via dispatcher")
+		assert.Len(t, dispatcher.alerts, 1)
+		assert.NotNil(t, dispatcher.classifications[0])
+	})
+
+	t.Run("emergency_mode_dispatches_without_classification", func(t *testing.T) {
+		dispatcher := &mockDispatcher{}
+		publishToAllCalled := false
+
+		processor, err := NewAlertProcessor(AlertProcessorConfig{
+			EnrichmentManager: &mockEnrichmentManager{mode: EnrichmentModeTransparentWithRecommendations},
+			FilterEngine:      &mockFilterEngine{},
+			Publisher: &mockPublisher{
+				publishToAllFunc: func(ctx context.Context, alert *core.Alert) error {
+					publishToAllCalled = true
+					return nil
+				},
+			},
+			Dispatcher: dispatcher,
+		})
+		assert.NoError(t, err)
+
+		err = processor.ProcessAlert(context.Background(), createTestAlert())
+
+		assert.NoError(t, err)
+		assert.False(t, publishToAllCalled, "Should publish via dispatcher")
+		assert.Len(t, dispatcher.alerts, 1)
+		assert.Nil(t, dispatcher.classifications[0])
+	})
+
+	t.Run("emergency_mode_silenced_alert_not_dispatched", func(t *testing.T) {
+		dispatcher := &mockDispatcher{}
+
+		processor, err := NewAlertProcessor(AlertProcessorConfig{
+			EnrichmentManager: &mockEnrichmentManager{mode: EnrichmentModeTransparentWithRecommendations},
+			FilterEngine:      &mockFilterEngine{},
+			Publisher:         &mockPublisher{},
+			Dispatcher:        dispatcher,
+			SilenceChecker:    &mockSilenceChecker{silenced: true, silenceIDs: []string{"silence-1"}},
+		})
+		assert.NoError(t, err)
+
+		err = processor.ProcessAlert(context.Background(), createTestAlert())
+
+		assert.NoError(t, err)
+		assert.Empty(t, dispatcher.alerts)
+	})
+}
+
+func TestAlertProcessor_ProcessAlert_Escalation(t *testing.T) {
+	newProcessor := func(escalator Escalator, silenced bool) *AlertProcessor {
+		processor, err := NewAlertProcessor(AlertProcessorConfig{
+			EnrichmentManager: &mockEnrichmentManager{mode: EnrichmentModeTransparent},
+			FilterEngine:      &mockFilterEngine{},
+			Publisher:         &mockPublisher{},
+			SilenceChecker:    &mockSilenceChecker{silenced: silenced},
+			Escalator:         escalator,
+		})
+		assert.NoError(t, err)
+		return processor
+	}
+
+	t.Run("published_firing_alert_escalated", func(t *testing.T) {
+		escalator := &mockEscalator{}
+		err := newProcessor(escalator, false).ProcessAlert(context.Background(), createTestAlert())
+
+		assert.NoError(t, err)
+		assert.Len(t, escalator.escalated, 1)
+		assert.Empty(t, escalator.resolved)
+	})
+
+	t.Run("silenced_alert_not_escalated", func(t *testing.T) {
+		escalator := &mockEscalator{}
+		err := newProcessor(escalator, true).ProcessAlert(context.Background(), createTestAlert())
+
+		assert.NoError(t, err)
+		assert.Empty(t, escalator.escalated)
+	})
+
+	t.Run("resolved_alert_stops_escalation", func(t *testing.T) {
+		escalator := &mockEscalator{}
+		alert := createTestAlert()
+		alert.Status = core.StatusResolved
+		err := newProcessor(escalator, false).ProcessAlert(context.Background(), alert)
+
+		assert.NoError(t, err)
+		assert.Empty(t, escalator.escalated)
+		assert.Equal(t, []string{alert.Fingerprint}, escalator.resolved)
+	})
+}
+
+func TestAlertProcessor_ProcessAlert_InhibitionRelease(t *testing.T) {
+	config, err := inhibition.NewParser().ParseString(`
+inhibit_rules:
+  - source_matchers:
+      - alertname="NodeDown"
+    target_matchers:
+      - severity=~"warning|info"
+    equal:
+      - node
+`)
+	assert.NoError(t, err)
+
+	activeAlerts := inhibition.NewTwoTierAlertCache(nil, slog.Default())
+	defer activeAlerts.Stop()
+	dispatcher := &mockDispatcher{}
+	storage := newMockAlertStorage()
+
+	processor, err := NewAlertProcessor(AlertProcessorConfig{
+		EnrichmentManager: &mockEnrichmentManager{mode: EnrichmentModeTransparent},
+		FilterEngine:      &mockFilterEngine{},
+		Publisher:         &mockPublisher{},
+		InhibitionMatcher: inhibition.NewMatcher(activeAlerts, config.Rules, nil),
+		InhibitionState:   inhibition.NewDefaultStateManager(nil, nil, nil),
+		ActiveAlerts:      activeAlerts,
+		Dispatcher:        dispatcher,
+		Storage:           storage,
+	})
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	source := &core.Alert{
+		Fingerprint: "source-fp",
+		AlertName:   "NodeDown",
+		Status:      core.StatusFiring,
+		Labels:      map[string]string{"alertname": "NodeDown", "severity": "critical", "node": "n1"},
+		StartsAt:    time.Now(),
+	}
+	target := &core.Alert{
+		Fingerprint: "target-fp",
+		AlertName:   "InstanceDown",
+		Status:      core.StatusFiring,
+		Labels:      map[string]string{"alertname": "InstanceDown", "severity": "warning", "node": "n1"},
+		StartsAt:    time.Now(),
+	}
+
+	assert.NoError(t, processor.ProcessAlert(ctx, source))
+	assert.NoError(t, processor.ProcessAlert(ctx, target))
+	assert.Len(t, dispatcher.alerts, 1, "Target must be inhibited while the source fires")
+	stored, err := storage.GetAlertByFingerprint(ctx, "target-fp")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"source-fp"}, stored.InhibitedBy)
+
+	resolved := *source
+	resolved.Status = core.StatusResolved
+	assert.NoError(t, processor.ProcessAlert(ctx, &resolved))
+
+	if assert.Len(t, dispatcher.alerts, 3) {
+		assert.Equal(t, core.StatusResolved, dispatcher.alerts[1].Status)
+		assert.Equal(t, "target-fp", dispatcher.alerts[2].Fingerprint, "Released target must be re-dispatched")
+		assert.Empty(t, dispatcher.alerts[2].InhibitedBy)
+	}
+	stored, err = storage.GetAlertByFingerprint(ctx, "target-fp")
+	assert.NoError(t, err)
+	assert.Empty(t, stored.InhibitedBy, "Released target must no longer be recorded as inhibited")
+}
+
 func TestAlertProcessor_Health(t *testing.T) {
 	t.Run("healthy", func(t *testing.T) {
 		processor, err := NewAlertProcessor(AlertProcessorConfig{
@@ -494,3 +785,41 @@ func (m *mockEnrichmentManager) GetStats(ctx context.Context) (*EnrichmentStats,
 func (m *mockEnrichmentManager) RefreshCache(ctx context.Context) error {
 	return nil
 }
+
+type mockSilenceChecker struct {
+	silenced   bool
+	silenceIDs []string
+	err        error
+	calls      int
+}
+
+func (m *mockSilenceChecker) IsAlertSilenced(ctx context.Context, alert *coresilencing.Alert) (bool, []string, error) {
+	m.calls++
+	return m.silenced, m.silenceIDs, m.err
+}
+
+type mockDispatcher struct {
+	alerts          []*core.Alert
+	classifications []*core.ClassificationResult
+}
+
+func (m *mockDispatcher) Dispatch(ctx context.Context, alert *core.Alert, classification *core.ClassificationResult) error {
+	m.alerts = append(m.alerts, alert)
+	m.classifications = append(m.classifications, classification)
+	return nil
+}
+
+type mockEscalator struct {
+	escalated []*core.Alert
+	resolved  []string
+}
+
+func (m *mockEscalator) Escalate(ctx context.Context, alert *core.Alert) error {
+	m.escalated = append(m.escalated, alert)
+	return nil
+}
+
+func (m *mockEscalator) Resolve(ctx context.Context, fingerprint string) error {
+	m.resolved = append(m.resolved, fingerprint)
+	return nil
+}
@@ -139,7 +139,10 @@ func NewClassificationService(config ClassificationServiceConfig) (Classificatio
 	// Create fallback engine if enabled
 	var fallbackEngine FallbackEngine
 	if config.Config.EnableFallback {
-		fallbackEngine = NewRuleBasedFallback(config.Logger)
+		fallbackEngine = config.FallbackEngine
+		if fallbackEngine == nil {
+			fallbackEngine = NewRuleBasedFallback(config.Logger)
+		}
 	}
 
 	// Initialize in-memory cache
@@ -98,6 +98,9 @@ type ClassificationServiceConfig struct {
 	Cache     cache.Cache       // Redis cache for L2 caching
 	Storage   core.AlertStorage // Alert storage for persistence (optional)
 
+	// Fallback engine (optional, default: RuleBasedFallback with built-in rules)
+	FallbackEngine FallbackEngine
+
 	// Configuration
 	Config ClassificationConfig
 
@@ -0,0 +1,958 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/vitaliisemenov/alert-history/internal/business/routing"
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure/grouping"
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure/llm"
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure/nflog"
+)
+
+// dispatcherIntegration is the notification log integration name used for
+// publishers that don't implement IntegrationPublisher: the receiver is
+// delivered through the Publisher as a single integration (index 0).
+const dispatcherIntegration = "publisher"
+
+// Dispatcher hands alerts over to Alertmanager-style notification dispatch
+// (route evaluation → group accumulation → timed flush → receiver publish).
+type Dispatcher interface {
+	Dispatch(ctx context.Context, alert *core.Alert, classification *core.ClassificationResult) error
+}
+
+// GroupNotification is a flushed alert group ready for delivery to a receiver.
+type GroupNotification struct {
+	// GroupKey identifies the aggregation group (route + group_by labels)
+	GroupKey grouping.GroupKey `json:"group_key"`
+
+	// Receiver is the receiver name from the matched route
+	Receiver string `json:"receiver"`
+
+	// GroupLabels are the group_by label values shared by all alerts
+	GroupLabels map[string]string `json:"group_labels"`
+
+	// Alerts contains firing alerts followed by resolved alerts (sorted by fingerprint)
+	Alerts []*core.Alert `json:"alerts"`
+
+	// Classifications maps alert fingerprint to its classification (if any)
+	Classifications map[string]*core.ClassificationResult `json:"classifications,omitempty"`
+
+	// Summary is the incident summary of the group (nil if not summarised)
+	Summary *core.GroupSummary `json:"summary,omitempty"`
+}
+
+// FiringCount returns the number of firing alerts in the notification.
+func (n *GroupNotification) FiringCount() int {
+	count := 0
+	for _, alert := range n.Alerts {
+		if alert.Status == core.StatusFiring {
+			count++
+		}
+	}
+	return count
+}
+
+// GroupPublisher is implemented by publishers that deliver a whole alert
+// group to a named receiver as a single notification.
+//
+// Publishers that don't implement it receive the group alert by alert via
+// the Publisher interface.
+type GroupPublisher interface {
+	PublishGroup(ctx context.Context, notification *GroupNotification) error
+}
+
+// ReceiverIntegration is a single delivery channel of a receiver
+// (e.g. one publishing target).
+type ReceiverIntegration struct {
+	// Name identifies the integration within the publisher (e.g. target name)
+	Name string
+
+	// Type is the integration type (e.g. "slack", "webhook"), empty if unknown
+	Type string
+}
+
+// IntegrationPublisher is implemented by publishers delivering a receiver
+// through several integrations that succeed or fail independently.
+//
+// The dispatcher then records each integration in the notification log on
+// its own (receiver, integration type, index) and only retries the
+// integrations that failed.
+type IntegrationPublisher interface {
+	// ReceiverIntegrations returns the integrations of a receiver, in order
+	ReceiverIntegrations(receiver string) []ReceiverIntegration
+
+	// PublishIntegration delivers the notification through one integration
+	// of its receiver
+	PublishIntegration(ctx context.Context, notification *GroupNotification, integration ReceiverIntegration) error
+}
+
+// TimeIntervalChecker decides whether route notifications are muted at a
+// given time (implemented by timeinterval.Evaluator).
+type TimeIntervalChecker interface {
+	IsMuted(muteIntervals, activeIntervals []string, t time.Time) (bool, error)
+}
+
+// AckChecker decides whether repeat notifications of a group are suppressed
+// by an acknowledgement (implemented by AckService).
+type AckChecker interface {
+	IsAcknowledged(ctx context.Context, groupKey string, fingerprints []string, t time.Time) bool
+}
+
+// GroupDispatcherConfig holds configuration for GroupDispatcher.
+type GroupDispatcherConfig struct {
+	Evaluator    *routing.RouteEvaluator     // required: route tree evaluation
+	KeyGenerator *grouping.GroupKeyGenerator // required: group key generation
+	GroupManager grouping.AlertGroupManager  // required: group accumulation
+	TimerManager grouping.GroupTimerManager  // required: group_wait/group_interval timers
+	Publisher    Publisher                   // required: receiver publish
+	TimeChecker  TimeIntervalChecker         // optional: mute/active time intervals
+	AckChecker   AckChecker                  // optional: acknowledged groups skip repeat notifications
+	Summarizer   GroupSummarizer             // optional: group summaries at flush time
+
+	// NotificationLog records sent group notifications (optional). When set,
+	// flush decisions survive restarts and are shared by replicas using the
+	// same log, so already-sent groups are not notified again.
+	NotificationLog nflog.Log
+
+	// NotificationLogRetention is how long notification log entries are kept
+	// (default: nflog.DefaultRetention)
+	NotificationLogRetention time.Duration
+
+	Logger *slog.Logger
+}
+
+// GroupDispatcher implements Dispatcher on top of the routing tree and the
+// alert grouping system.
+//
+// Flow:
+//  1. Dispatch evaluates the route tree (honouring continue) and adds the
+//     alert to one aggregation group per matched route
+//  2. A new group starts a group_wait timer
+//  3. On timer expiry the group is flushed if its firing/resolved set changed
+//     since the last notification or repeat_interval has elapsed
+//  4. Notified resolved alerts are dropped from the group and the
+//     group_interval timer is re-armed while firing alerts remain
+//
+// Groups whose route is muted by mute_time_intervals (or outside its
+// active_time_intervals) are held: no notification is sent and the group
+// is re-checked every group_interval until the route becomes active.
+//
+// Repeat notifications (repeat_interval) of a group are skipped while the
+// group or all of its firing alerts are acknowledged (AckChecker); changes
+// to the group are still notified.
+//
+// With a Summarizer, each flushed group is summarised (reusing the group's
+// stored summary while its membership is unchanged); the summary is stored
+// on the group and attached to the notification.
+//
+// Notification state is kept per receiver integration (IntegrationPublisher):
+// an integration is recorded only once it delivered, so a partial failure
+// re-sends the group to the failed integrations only. With a
+// NotificationLog, the last notification of each integration is also read
+// from and recorded in the log (Alertmanager DedupStage semantics), so
+// timers restored after a restart do not re-send notified groups.
+//
+// Thread-safety: All methods are safe for concurrent use.
+type GroupDispatcher struct {
+	evaluator    *routing.RouteEvaluator
+	keyGenerator *grouping.GroupKeyGenerator
+	groupManager grouping.AlertGroupManager
+	timerManager grouping.GroupTimerManager
+	publisher    Publisher
+	timeChecker  TimeIntervalChecker
+	ackChecker   AckChecker
+	summarizer   GroupSummarizer
+	nflog        nflog.Log
+	nflogTTL     time.Duration
+	logger       *slog.Logger
+
+	// mu protects groups
+	mu     sync.Mutex
+	groups map[grouping.GroupKey]*dispatchGroup
+}
+
+// dispatchGroup holds per-group routing parameters and notification state.
+type dispatchGroup struct {
+	receiver       string
+	groupBy        []string
+	groupWait      time.Duration
+	groupInterval  time.Duration
+	repeatInterval time.Duration
+
+	muteTimeIntervals   []string
+	activeTimeIntervals []string
+
+	classifications map[string]*core.ClassificationResult
+
+	// Last notification per receiver integration
+	notified map[nflog.Receiver]*notifyState
+}
+
+// notifyState is the last notification of a group through one receiver
+// integration (fingerprint hashes, see nflog.HashFingerprint).
+type notifyState struct {
+	at       time.Time
+	firing   map[uint64]struct{}
+	resolved map[uint64]struct{}
+}
+
+// groupIntegration is a receiver integration a group is delivered through.
+type groupIntegration struct {
+	log         nflog.Receiver
+	integration ReceiverIntegration
+}
+
+// NewGroupDispatcher creates a new dispatcher and registers its flush
+// callback with the timer manager.
+func NewGroupDispatcher(config GroupDispatcherConfig) (*GroupDispatcher, error) {
+	if config.Evaluator == nil {
+		return nil, fmt.Errorf("route evaluator is required")
+	}
+	if config.KeyGenerator == nil {
+		return nil, fmt.Errorf("group key generator is required")
+	}
+	if config.GroupManager == nil {
+		return nil, fmt.Errorf("group manager is required")
+	}
+	if config.TimerManager == nil {
+		return nil, fmt.Errorf("timer manager is required")
+	}
+	if config.Publisher == nil {
+		return nil, fmt.Errorf("publisher is required")
+	}
+	if config.Logger == nil {
+		config.Logger = slog.Default()
+	}
+	if config.NotificationLogRetention <= 0 {
+		config.NotificationLogRetention = nflog.DefaultRetention
+	}
+
+	d := &GroupDispatcher{
+		evaluator:    config.Evaluator,
+		keyGenerator: config.KeyGenerator,
+		groupManager: config.GroupManager,
+		timerManager: config.TimerManager,
+		publisher:    config.Publisher,
+		timeChecker:  config.TimeChecker,
+		ackChecker:   config.AckChecker,
+		summarizer:   config.Summarizer,
+		nflog:        config.NotificationLog,
+		nflogTTL:     config.NotificationLogRetention,
+		logger:       config.Logger,
+		groups:       make(map[grouping.GroupKey]*dispatchGroup),
+	}
+
+	config.TimerManager.OnTimerExpired(d.onTimerExpired)
+
+	return d, nil
+}
+
+// Dispatch routes the alert and accumulates it into its notification groups.
+//
+// The alert is not published immediately: delivery happens when the group's
+// group_wait (first notification) or group_interval (updates) timer fires.
+func (d *GroupDispatcher) Dispatch(ctx context.Context, alert *core.Alert, classification *core.ClassificationResult) error {
+	if alert == nil {
+		return fmt.Errorf("alert is nil")
+	}
+
+	decisions, err := d.route(alert)
+	if err != nil {
+		return fmt.Errorf("route evaluation failed: %w", err)
+	}
+
+	var errs []error
+	for _, decision := range decisions {
+		if err := d.dispatchToGroup(ctx, alert, classification, decision); err != nil {
+			errs = append(errs, fmt.Errorf("receiver %s: %w", decision.Receiver, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Receivers returns the receivers an alert is routed to, in route tree order
+// (continue=true matches included, duplicates removed).
+func (d *GroupDispatcher) Receivers(alert *core.Alert) ([]string, error) {
+	if alert == nil {
+		return nil, fmt.Errorf("alert is nil")
+	}
+
+	decisions, err := d.route(alert)
+	if err != nil {
+		return nil, fmt.Errorf("route evaluation failed: %w", err)
+	}
+
+	receivers := make([]string, 0, len(decisions))
+	for _, decision := range decisions {
+		if !slices.Contains(receivers, decision.Receiver) {
+			receivers = append(receivers, decision.Receiver)
+		}
+	}
+	return receivers, nil
+}
+
+// GroupRoute returns the receiver and group_by labels of an aggregation group,
+// as used for its notifications. ok is false when no route produces the group
+// key (e.g. the route tree changed since the group was created).
+func (d *GroupDispatcher) GroupRoute(group *grouping.AlertGroup) (receiver string, labels map[string]string, ok bool) {
+	if group == nil {
+		return "", nil, false
+	}
+
+	state := d.lookupState(group.Key, group)
+	if state == nil {
+		return "", nil, false
+	}
+
+	firing, resolved := splitGroupAlerts(group)
+	return state.receiver, groupLabels(append(firing, resolved...), state.groupBy), true
+}
+
+// dispatchToGroup adds the alert to the group for a single routing decision.
+func (d *GroupDispatcher) dispatchToGroup(
+	ctx context.Context,
+	alert *core.Alert,
+	classification *core.ClassificationResult,
+	decision *routing.RoutingDecision,
+) error {
+	groupKey, err := d.groupKey(alert, decision)
+	if err != nil {
+		return fmt.Errorf("generate group key: %w", err)
+	}
+
+	d.mu.Lock()
+	state, exists := d.groups[groupKey]
+	if !exists {
+		state = newDispatchGroup(decision)
+		d.groups[groupKey] = state
+	}
+	if classification != nil {
+		state.classifications[alert.Fingerprint] = classification
+	}
+	d.mu.Unlock()
+
+	group, err := d.groupManager.AddAlertToGroup(ctx, alert, groupKey)
+	if err != nil {
+		return fmt.Errorf("add alert to group: %w", err)
+	}
+
+	d.logger.Debug("Alert added to notification group",
+		"alert", alert.AlertName,
+		"fingerprint", alert.Fingerprint,
+		"group_key", groupKey,
+		"receiver", decision.Receiver,
+		"route", decision.MatchedRoute)
+
+	// Existing groups are flushed by their pending group_interval timer
+	if _, err := d.timerManager.GetTimer(ctx, groupKey); err == nil {
+		return nil
+	}
+
+	if state.groupWait <= 0 {
+		return d.onTimerExpired(ctx, groupKey, grouping.GroupWaitTimer, group)
+	}
+
+	if _, err := d.timerManager.StartTimer(ctx, groupKey, grouping.GroupWaitTimer, state.groupWait); err != nil {
+		return fmt.Errorf("start group_wait timer: %w", err)
+	}
+
+	return nil
+}
+
+// onTimerExpired is the TimerCallback flushing a group when its timer fires.
+func (d *GroupDispatcher) onTimerExpired(
+	ctx context.Context,
+	groupKey grouping.GroupKey,
+	timerType grouping.TimerType,
+	group *grouping.AlertGroup,
+) error {
+	state := d.lookupState(groupKey, group)
+	if state == nil {
+		d.logger.Warn("No route found for expired group, dropping timer",
+			"group_key", groupKey,
+			"timer_type", timerType)
+		return nil
+	}
+
+	firing, resolved := splitGroupAlerts(group)
+
+	if d.isMuted(groupKey, state, time.Now()) {
+		d.logger.Debug("Notification group muted by time interval, holding",
+			"group_key", groupKey,
+			"receiver", state.receiver,
+			"mute_time_intervals", state.muteTimeIntervals,
+			"active_time_intervals", state.activeTimeIntervals)
+		return d.startGroupInterval(ctx, groupKey, state)
+	}
+
+	var flushErr error
+	if pending := d.pendingIntegrations(ctx, groupKey, state, firing, resolved); len(pending) > 0 {
+		flushErr = d.flush(ctx, groupKey, state, pending, firing, resolved, group.Summary)
+	} else {
+		d.logger.Debug("Notification group unchanged, skipping flush",
+			"group_key", groupKey,
+			"receiver", state.receiver,
+			"timer_type", timerType)
+	}
+
+	// Resolved alerts leave the group once all integrations notified them
+	if flushErr == nil {
+		for _, alert := range resolved {
+			if _, err := d.groupManager.RemoveAlertFromGroup(ctx, alert.Fingerprint, groupKey); err != nil {
+				d.logger.Warn("Failed to remove resolved alert from group",
+					"group_key", groupKey,
+					"fingerprint", alert.Fingerprint,
+					"error", err)
+			}
+			d.mu.Lock()
+			delete(state.classifications, alert.Fingerprint)
+			d.mu.Unlock()
+		}
+
+		if len(firing) == 0 {
+			d.mu.Lock()
+			delete(d.groups, groupKey)
+			d.mu.Unlock()
+
+			d.logger.Info("Notification group resolved",
+				"group_key", groupKey,
+				"receiver", state.receiver)
+			return nil
+		}
+	}
+
+	return errors.Join(flushErr, d.startGroupInterval(ctx, groupKey, state))
+}
+
+// startGroupInterval re-arms the group_interval timer for a group.
+func (d *GroupDispatcher) startGroupInterval(ctx context.Context, groupKey grouping.GroupKey, state *dispatchGroup) error {
+	if _, err := d.timerManager.StartTimer(ctx, groupKey, grouping.GroupIntervalTimer, state.groupInterval); err != nil {
+		d.logger.Error("Failed to start group_interval timer",
+			"group_key", groupKey,
+			"error", err)
+		return err
+	}
+	return nil
+}
+
+// isMuted reports whether the group's route is muted by its time intervals at t.
+//
+// Fail-safe: evaluation errors (e.g. unknown interval) do not mute.
+func (d *GroupDispatcher) isMuted(groupKey grouping.GroupKey, state *dispatchGroup, t time.Time) bool {
+	if d.timeChecker == nil || (len(state.muteTimeIntervals) == 0 && len(state.activeTimeIntervals) == 0) {
+		return false
+	}
+
+	muted, err := d.timeChecker.IsMuted(state.muteTimeIntervals, state.activeTimeIntervals, t)
+	if err != nil {
+		d.logger.Warn("Time interval check failed, not muting",
+			"group_key", groupKey,
+			"error", err)
+		return false
+	}
+	return muted
+}
+
+// pendingIntegrations returns the receiver integrations a group must be
+// notified through.
+//
+// Alertmanager semantics, per integration: send when it was never notified,
+// new alerts started firing, alerts resolved since its last notification, or
+// repeat_interval elapsed and the group is not acknowledged.
+func (d *GroupDispatcher) pendingIntegrations(
+	ctx context.Context,
+	groupKey grouping.GroupKey,
+	state *dispatchGroup,
+	firing, resolved []*core.Alert,
+) []groupIntegration {
+	if len(firing) == 0 && len(resolved) == 0 {
+		return nil
+	}
+
+	acknowledged := sync.OnceValue(func() bool {
+		return d.isAcknowledged(ctx, groupKey, firing)
+	})
+
+	var pending []groupIntegration
+	for _, integration := range d.integrations(state) {
+		changed, repeat := d.needsFlush(ctx, groupKey, state, integration.log, firing, resolved)
+		if !changed && repeat && acknowledged() {
+			d.logger.Debug("Notification group acknowledged, skipping repeat notification",
+				"group_key", groupKey,
+				"receiver", integration.log.String())
+			continue
+		}
+		if changed || repeat {
+			pending = append(pending, integration)
+		}
+	}
+	return pending
+}
+
+// needsFlush compares the group with its last notification through an
+// integration: changed when it was never notified or has new firing or
+// resolved alerts, repeat when repeat_interval elapsed with alerts firing.
+func (d *GroupDispatcher) needsFlush(
+	ctx context.Context,
+	groupKey grouping.GroupKey,
+	state *dispatchGroup,
+	receiver nflog.Receiver,
+	firing, resolved []*core.Alert,
+) (changed, repeat bool) {
+	d.restoreFromLog(ctx, groupKey, state, receiver)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	last := state.notified[receiver]
+	if last == nil ||
+		!isSubset(firing, last.firing) ||
+		(len(resolved) > 0 && !isSubset(resolved, last.resolved)) {
+		return true, false
+	}
+	return false, len(firing) > 0 && time.Since(last.at) >= state.repeatInterval
+}
+
+// integrations returns the receiver integrations of a group. Publishers
+// that don't implement IntegrationPublisher deliver the receiver as a
+// single integration.
+func (d *GroupDispatcher) integrations(state *dispatchGroup) []groupIntegration {
+	publisher, ok := d.publisher.(IntegrationPublisher)
+	if !ok {
+		return []groupIntegration{{
+			log: nflog.Receiver{GroupName: state.receiver, Integration: dispatcherIntegration},
+		}}
+	}
+
+	receiverIntegrations := publisher.ReceiverIntegrations(state.receiver)
+	integrations := make([]groupIntegration, 0, len(receiverIntegrations))
+	for i, integration := range receiverIntegrations {
+		integrations = append(integrations, groupIntegration{
+			log:         nflog.Receiver{GroupName: state.receiver, Integration: integration.Type, Index: i},
+			integration: integration,
+		})
+	}
+	return integrations
+}
+
+// isAcknowledged reports whether repeat notifications of the group are
+// suppressed by an acknowledgement.
+func (d *GroupDispatcher) isAcknowledged(ctx context.Context, groupKey grouping.GroupKey, firing []*core.Alert) bool {
+	if d.ackChecker == nil {
+		return false
+	}
+	return d.ackChecker.IsAcknowledged(ctx, string(groupKey), fingerprints(firing), time.Now())
+}
+
+// restoreFromLog adopts the notification log entry of a group when it is
+// newer than the in-memory state (restart, or notified by another replica).
+//
+// Fail-open: log errors leave the in-memory state in charge.
+func (d *GroupDispatcher) restoreFromLog(ctx context.Context, groupKey grouping.GroupKey, state *dispatchGroup, receiver nflog.Receiver) {
+	if d.nflog == nil {
+		return
+	}
+
+	entry, err := d.nflog.Query(ctx, string(groupKey), receiver)
+	if err != nil {
+		if !errors.Is(err, nflog.ErrNotFound) {
+			d.logger.Warn("Notification log query failed, using in-memory state",
+				"group_key", groupKey,
+				"receiver", receiver.String(),
+				"error", err)
+		}
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if last := state.notified[receiver]; last == nil || entry.Timestamp.After(last.at) {
+		state.notified[receiver] = &notifyState{
+			at:       entry.Timestamp,
+			firing:   nflog.HashSet(entry.FiringAlerts),
+			resolved: nflog.HashSet(entry.ResolvedAlerts),
+		}
+	}
+}
+
+// recordFlush stores a successful notification through an integration in
+// memory and in the notification log.
+func (d *GroupDispatcher) recordFlush(
+	ctx context.Context,
+	groupKey grouping.GroupKey,
+	state *dispatchGroup,
+	receiver nflog.Receiver,
+	firing, resolved []*core.Alert,
+) {
+	now := time.Now()
+	entry := nflog.NewEntry(string(groupKey), receiver,
+		fingerprints(firing), fingerprints(resolved), now, d.nflogTTL)
+
+	d.mu.Lock()
+	state.notified[receiver] = &notifyState{
+		at:       now,
+		firing:   nflog.HashSet(entry.FiringAlerts),
+		resolved: nflog.HashSet(entry.ResolvedAlerts),
+	}
+	d.mu.Unlock()
+
+	if d.nflog == nil {
+		return
+	}
+	if err := d.nflog.Log(ctx, entry); err != nil {
+		d.logger.Error("Failed to record notification in notification log",
+			"group_key", groupKey,
+			"receiver", receiver.String(),
+			"error", err)
+	}
+}
+
+// flush publishes the group through the given integrations of its receiver,
+// recording each integration that delivered. previous is the summary stored
+// on the group, if any.
+func (d *GroupDispatcher) flush(
+	ctx context.Context,
+	groupKey grouping.GroupKey,
+	state *dispatchGroup,
+	integrations []groupIntegration,
+	firing, resolved []*core.Alert,
+	previous *core.GroupSummary,
+) error {
+	alerts := make([]*core.Alert, 0, len(firing)+len(resolved))
+	alerts = append(alerts, firing...)
+	alerts = append(alerts, resolved...)
+
+	d.mu.Lock()
+	classifications := make(map[string]*core.ClassificationResult, len(state.classifications))
+	for fingerprint, classification := range state.classifications {
+		classifications[fingerprint] = classification
+	}
+	d.mu.Unlock()
+
+	notification := &GroupNotification{
+		GroupKey:        groupKey,
+		Receiver:        state.receiver,
+		GroupLabels:     groupLabels(alerts, state.groupBy),
+		Alerts:          alerts,
+		Classifications: classifications,
+	}
+	if d.summarizer != nil {
+		notification.Summary = d.summarize(ctx, notification, previous)
+	}
+
+	d.logger.Info("Flushing notification group",
+		"group_key", groupKey,
+		"receiver", state.receiver,
+		"integrations", len(integrations),
+		"firing", len(firing),
+		"resolved", len(resolved))
+
+	var errs []error
+	for _, integration := range integrations {
+		if err := d.deliver(ctx, notification, integration); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		d.recordFlush(ctx, groupKey, state, integration.log, firing, resolved)
+	}
+	return errors.Join(errs...)
+}
+
+// deliver sends a notification through one receiver integration.
+func (d *GroupDispatcher) deliver(ctx context.Context, notification *GroupNotification, integration groupIntegration) error {
+	if publisher, ok := d.publisher.(IntegrationPublisher); ok {
+		return publisher.PublishIntegration(ctx, notification, integration.integration)
+	}
+	return DeliverNotification(ctx, d.publisher, notification)
+}
+
+// summarize returns the summary of a notification group and stores it on the
+// group. Summary errors are logged: the notification is sent without summary.
+// A stored LLM summary is reused while the group membership is unchanged;
+// fallback summaries are regenerated so the LLM is retried once available.
+func (d *GroupDispatcher) summarize(ctx context.Context, notification *GroupNotification, previous *core.GroupSummary) *core.GroupSummary {
+	if previous != nil && previous.Source == core.GroupSummarySourceLLM &&
+		previous.MembershipHash == core.GroupMembershipHash(notification.Alerts) {
+		return previous
+	}
+
+	summary, err := d.summarizer.Summarize(ctx, &llm.GroupSummaryRequest{
+		GroupKey:        string(notification.GroupKey),
+		GroupLabels:     notification.GroupLabels,
+		Alerts:          notification.Alerts,
+		Classifications: notification.Classifications,
+	})
+	if err != nil {
+		d.logger.Warn("Failed to summarise notification group",
+			"group_key", notification.GroupKey,
+			"error", err)
+		return nil
+	}
+	if summary == nil {
+		return nil
+	}
+
+	if err := d.groupManager.SetGroupSummary(ctx, notification.GroupKey, summary); err != nil {
+		d.logger.Warn("Failed to store group summary",
+			"group_key", notification.GroupKey,
+			"error", err)
+	}
+	return summary
+}
+
+// DeliverNotification delivers a notification to its receiver: as a whole
+// when the publisher implements GroupPublisher, otherwise alert by alert.
+func DeliverNotification(ctx context.Context, publisher Publisher, notification *GroupNotification) error {
+	if groupPublisher, ok := publisher.(GroupPublisher); ok {
+		return groupPublisher.PublishGroup(ctx, notification)
+	}
+
+	var errs []error
+	for _, alert := range notification.Alerts {
+		var err error
+		if classification := notification.Classifications[alert.Fingerprint]; classification != nil {
+			err = publisher.PublishWithClassification(ctx, alert, classification)
+		} else {
+			err = publisher.PublishToAll(ctx, alert)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("publish %s: %w", alert.Fingerprint, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// lookupState returns the dispatch state for a group, rebuilding it from the
+// route tree when missing (e.g. timers restored after a restart).
+func (d *GroupDispatcher) lookupState(groupKey grouping.GroupKey, group *grouping.AlertGroup) *dispatchGroup {
+	d.mu.Lock()
+	state, ok := d.groups[groupKey]
+	d.mu.Unlock()
+	if ok {
+		return state
+	}
+
+	if group == nil {
+		return nil
+	}
+
+	for _, alert := range group.Alerts {
+		decisions, err := d.route(alert)
+		if err != nil {
+			continue
+		}
+		for _, decision := range decisions {
+			key, err := d.groupKey(alert, decision)
+			if err != nil || key != groupKey {
+				continue
+			}
+
+			d.mu.Lock()
+			defer d.mu.Unlock()
+			if existing, ok := d.groups[groupKey]; ok {
+				return existing
+			}
+			state = newDispatchGroup(decision)
+			d.groups[groupKey] = state
+			return state
+		}
+	}
+
+	return nil
+}
+
+// route evaluates the route tree for an alert, including continue=true matches.
+func (d *GroupDispatcher) route(alert *core.Alert) ([]*routing.RoutingDecision, error) {
+	result := d.evaluator.EvaluateWithAlternatives(toRoutingAlert(alert))
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	decisions := make([]*routing.RoutingDecision, 0, 1+len(result.Alternatives))
+	decisions = append(decisions, result.Primary)
+	decisions = append(decisions, result.Alternatives...)
+	return decisions, nil
+}
+
+// groupKey builds the aggregation group key: matched route + group_by labels.
+func (d *GroupDispatcher) groupKey(alert *core.Alert, decision *routing.RoutingDecision) (grouping.GroupKey, error) {
+	labelsKey, err := d.keyGenerator.GenerateKey(routingLabels(alert), decision.GroupBy)
+	if err != nil {
+		return "", err
+	}
+	return grouping.GroupKey(fmt.Sprintf("{%s}:%s", decision.MatchedRoute, labelsKey)), nil
+}
+
+// newDispatchGroup creates dispatch state from a routing decision.
+func newDispatchGroup(decision *routing.RoutingDecision) *dispatchGroup {
+	return &dispatchGroup{
+		receiver:       decision.Receiver,
+		groupBy:        decision.GroupBy,
+		groupWait:      decision.GroupWait,
+		groupInterval:  decision.GroupInterval,
+		repeatInterval: decision.RepeatInterval,
+
+		muteTimeIntervals:   decision.MuteTimeIntervals,
+		activeTimeIntervals: decision.ActiveTimeIntervals,
+
+		classifications: make(map[string]*core.ClassificationResult),
+		notified:        make(map[nflog.Receiver]*notifyState),
+	}
+}
+
+// toRoutingAlert converts a core alert to the routing package representation.
+func toRoutingAlert(alert *core.Alert) *routing.Alert {
+	routingAlert := &routing.Alert{
+		Labels:   routingLabels(alert),
+		StartsAt: alert.StartsAt,
+	}
+	if alert.EndsAt != nil {
+		routingAlert.EndsAt = *alert.EndsAt
+	}
+	return routingAlert
+}
+
+// routingLabels returns alert labels, guaranteeing the alertname label.
+func routingLabels(alert *core.Alert) map[string]string {
+	if _, ok := alert.Labels["alertname"]; ok || alert.AlertName == "" {
+		return alert.Labels
+	}
+
+	labels := make(map[string]string, len(alert.Labels)+1)
+	for k, v := range alert.Labels {
+		labels[k] = v
+	}
+	labels["alertname"] = alert.AlertName
+	return labels
+}
+
+// splitGroupAlerts returns firing and resolved alerts, each sorted by fingerprint.
+func splitGroupAlerts(group *grouping.AlertGroup) (firing, resolved []*core.Alert) {
+	if group == nil {
+		return nil, nil
+	}
+
+	for _, alert := range group.Alerts {
+		if alert.Status == core.StatusResolved {
+			resolved = append(resolved, alert)
+		} else {
+			firing = append(firing, alert)
+		}
+	}
+
+	sortByFingerprint(firing)
+	sortByFingerprint(resolved)
+	return firing, resolved
+}
+
+func sortByFingerprint(alerts []*core.Alert) {
+	sort.Slice(alerts, func(i, j int) bool {
+		return alerts[i].Fingerprint < alerts[j].Fingerprint
+	})
+}
+
+func fingerprints(alerts []*core.Alert) []string {
+	result := make([]string, 0, len(alerts))
+	for _, alert := range alerts {
+		result = append(result, alert.Fingerprint)
+	}
+	return result
+}
+
+// isSubset reports whether all alert fingerprint hashes are in set.
+func isSubset(alerts []*core.Alert, set map[uint64]struct{}) bool {
+	for _, alert := range alerts {
+		if _, ok := set[nflog.HashFingerprint(alert.Fingerprint)]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// groupLabels extracts the group_by label values shared by the group.
+func groupLabels(alerts []*core.Alert, groupBy []string) map[string]string {
+	labels := make(map[string]string, len(groupBy))
+	if len(alerts) == 0 {
+		return labels
+	}
+
+	source := routingLabels(alerts[0])
+	for _, name := range groupBy {
+		if value, ok := source[name]; ok {
+			labels[name] = value
+		}
+	}
+	return labels
+}
+
+// RouteConfigFromGrouping converts a grouping configuration (TN-121) into a
+// routing configuration for the route tree builder.
+//
+// Receivers are declared by name only: delivery to receiver integrations is
+// handled by the Publisher.
+func RouteConfigFromGrouping(config *grouping.GroupingConfig) (*routing.RouteConfig, error) {
+	if config == nil || config.Route == nil {
+		return nil, fmt.Errorf("grouping config has no root route")
+	}
+
+	receivers := make(map[string]struct{})
+	root := convertGroupingRoute(config.Route, receivers)
+
+	names := make([]string, 0, len(receivers))
+	for name := range receivers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	routeConfig := &routing.RouteConfig{
+		Route:     root,
+		Receivers: make([]*routing.Receiver, 0, len(names)),
+	}
+	for _, name := range names {
+		routeConfig.Receivers = append(routeConfig.Receivers, &routing.Receiver{Name: name})
+	}
+
+	return routeConfig, nil
+}
+
+// convertGroupingRoute recursively converts a grouping route, collecting
+// referenced receiver names.
+func convertGroupingRoute(route *grouping.Route, receivers map[string]struct{}) *routing.Route {
+	if route.Receiver != "" {
+		receivers[route.Receiver] = struct{}{}
+	}
+
+	converted := &routing.Route{
+		Receiver:            route.Receiver,
+		Continue:            route.Continue,
+		Match:               route.Match,
+		MatchRE:             route.MatchRE,
+		GroupBy:             route.GroupBy,
+		MuteTimeIntervals:   route.MuteTimeIntervals,
+		ActiveTimeIntervals: route.ActiveTimeIntervals,
+	}
+	if route.GroupWait != nil {
+		converted.GroupWait = route.GroupWait.Duration
+	}
+	if route.GroupInterval != nil {
+		converted.GroupInterval = route.GroupInterval.Duration
+	}
+	if route.RepeatInterval != nil {
+		converted.RepeatInterval = route.RepeatInterval.Duration
+	}
+
+	for _, child := range route.Routes {
+		converted.Routes = append(converted.Routes, convertGroupingRoute(child, receivers))
+	}
+
+	return converted
+}
@@ -0,0 +1,570 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	amconfig "github.com/vitaliisemenov/alert-history/internal/alertmanager/config"
+	"github.com/vitaliisemenov/alert-history/internal/business/routing"
+	"github.com/vitaliisemenov/alert-history/internal/business/timeinterval"
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure/grouping"
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure/nflog"
+)
+
+// recordingGroupPublisher captures group notifications.
+type recordingGroupPublisher struct {
+	mockPublisher
+
+	mu            sync.Mutex
+	notifications []*GroupNotification
+}
+
+func (p *recordingGroupPublisher) PublishGroup(ctx context.Context, notification *GroupNotification) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.notifications = append(p.notifications, notification)
+	return nil
+}
+
+func (p *recordingGroupPublisher) snapshot() []*GroupNotification {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]*GroupNotification(nil), p.notifications...)
+}
+
+func testDuration(d time.Duration) *grouping.Duration {
+	return &grouping.Duration{Duration: d}
+}
+
+func newTestDispatcher(t *testing.T, config *grouping.GroupingConfig, publisher Publisher, options ...func(*GroupDispatcherConfig)) *GroupDispatcher {
+	t.Helper()
+
+	ctx := context.Background()
+	keyGenerator := grouping.NewGroupKeyGenerator()
+
+	groupManager, err := grouping.NewDefaultGroupManager(ctx, grouping.DefaultGroupManagerConfig{
+		KeyGenerator: keyGenerator,
+		Config:       config,
+		Storage:      grouping.NewMemoryGroupStorage(nil),
+	})
+	require.NoError(t, err)
+
+	timerManager, err := grouping.NewDefaultTimerManager(grouping.TimerManagerConfig{
+		Storage:      grouping.NewInMemoryTimerStorage(nil),
+		GroupManager: groupManager,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = timerManager.Shutdown(context.Background()) })
+
+	routeConfig, err := RouteConfigFromGrouping(config)
+	require.NoError(t, err)
+	tree, err := routing.NewTreeBuilder(routeConfig, routing.DefaultBuildOptions()).Build()
+	require.NoError(t, err)
+
+	evaluator := routing.NewRouteEvaluator(
+		tree,
+		routing.NewRouteMatcher(nil, routing.MatcherOptions{CacheSize: 16}),
+		routing.EvaluatorOptions{FallbackToRoot: true},
+	)
+
+	dispatcherConfig := GroupDispatcherConfig{
+		Evaluator:    evaluator,
+		KeyGenerator: keyGenerator,
+		GroupManager: groupManager,
+		TimerManager: timerManager,
+		Publisher:    publisher,
+	}
+	for _, option := range options {
+		option(&dispatcherConfig)
+	}
+
+	dispatcher, err := NewGroupDispatcher(dispatcherConfig)
+	require.NoError(t, err)
+
+	return dispatcher
+}
+
+func newDispatchAlert(fingerprint, name string, labels map[string]string) *core.Alert {
+	allLabels := map[string]string{"alertname": name}
+	for k, v := range labels {
+		allLabels[k] = v
+	}
+	return &core.Alert{
+		Fingerprint: fingerprint,
+		AlertName:   name,
+		Status:      core.StatusFiring,
+		Labels:      allLabels,
+		StartsAt:    time.Now(),
+	}
+}
+
+func TestNewGroupDispatcher_Validation(t *testing.T) {
+	_, err := NewGroupDispatcher(GroupDispatcherConfig{})
+	assert.Error(t, err)
+}
+
+func TestGroupDispatcher_GroupsAlertsUntilGroupWait(t *testing.T) {
+	config := &grouping.GroupingConfig{
+		Route: &grouping.Route{
+			Receiver:       "default",
+			GroupBy:        []string{"alertname"},
+			GroupWait:      testDuration(100 * time.Millisecond),
+			GroupInterval:  testDuration(time.Hour),
+			RepeatInterval: testDuration(time.Hour),
+		},
+	}
+	publisher := &recordingGroupPublisher{}
+	dispatcher := newTestDispatcher(t, config, publisher)
+	ctx := context.Background()
+
+	require.NoError(t, dispatcher.Dispatch(ctx, newDispatchAlert("fp-1", "HighCPU", map[string]string{"instance": "a"}), nil))
+	require.NoError(t, dispatcher.Dispatch(ctx, newDispatchAlert("fp-2", "HighCPU", map[string]string{"instance": "b"}), nil))
+
+	assert.Empty(t, publisher.snapshot(), "Should not publish before group_wait")
+
+	require.Eventually(t, func() bool {
+		return len(publisher.snapshot()) == 1
+	}, 2*time.Second, 10*time.Millisecond)
+
+	notification := publisher.snapshot()[0]
+	assert.Equal(t, "default", notification.Receiver)
+	assert.Equal(t, map[string]string{"alertname": "HighCPU"}, notification.GroupLabels)
+	assert.Len(t, notification.Alerts, 2)
+	assert.Equal(t, 2, notification.FiringCount())
+}
+
+func TestGroupDispatcher_RoutesToDeepestMatchWithContinue(t *testing.T) {
+	config := &grouping.GroupingConfig{
+		Route: &grouping.Route{
+			Receiver:       "default",
+			GroupBy:        []string{"alertname"},
+			GroupWait:      testDuration(50 * time.Millisecond),
+			GroupInterval:  testDuration(time.Hour),
+			RepeatInterval: testDuration(time.Hour),
+			Routes: []*grouping.Route{
+				{
+					Receiver: "pager",
+					Match:    map[string]string{"severity": "critical"},
+					Continue: true,
+				},
+				{
+					Receiver: "team",
+					Match:    map[string]string{"team": "db"},
+				},
+			},
+		},
+	}
+	publisher := &recordingGroupPublisher{}
+	dispatcher := newTestDispatcher(t, config, publisher)
+
+	alert := newDispatchAlert("fp-1", "DiskFull", map[string]string{"severity": "critical", "team": "db"})
+	require.NoError(t, dispatcher.Dispatch(context.Background(), alert, nil))
+
+	require.Eventually(t, func() bool {
+		return len(publisher.snapshot()) == 2
+	}, 2*time.Second, 10*time.Millisecond)
+
+	receivers := []string{}
+	for _, notification := range publisher.snapshot() {
+		receivers = append(receivers, notification.Receiver)
+	}
+	assert.ElementsMatch(t, []string{"pager", "team"}, receivers)
+}
+
+func TestGroupDispatcher_GroupRoute(t *testing.T) {
+	config := &grouping.GroupingConfig{
+		Route: &grouping.Route{
+			Receiver:       "default",
+			GroupBy:        []string{"alertname"},
+			GroupWait:      testDuration(time.Hour),
+			GroupInterval:  testDuration(time.Hour),
+			RepeatInterval: testDuration(time.Hour),
+			Routes: []*grouping.Route{
+				{
+					Receiver: "pager",
+					Match:    map[string]string{"severity": "critical"},
+					GroupBy:  []string{"alertname", "cluster"},
+					Continue: true,
+				},
+				{
+					Receiver: "team",
+					Match:    map[string]string{"cluster": "eu"},
+				},
+			},
+		},
+	}
+	dispatcher := newTestDispatcher(t, config, &recordingGroupPublisher{})
+	ctx := context.Background()
+
+	alert := newDispatchAlert("fp-1", "DiskFull", map[string]string{"severity": "critical", "cluster": "eu"})
+	require.NoError(t, dispatcher.Dispatch(ctx, alert, nil))
+
+	groups, err := dispatcher.groupManager.ListGroups(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+
+	routes := map[string]map[string]string{}
+	for _, group := range groups {
+		receiver, labels, ok := dispatcher.GroupRoute(group)
+		require.True(t, ok)
+		routes[receiver] = labels
+	}
+	assert.Equal(t, map[string]map[string]string{
+		"pager": {"alertname": "DiskFull", "cluster": "eu"},
+		"team":  {"alertname": "DiskFull"},
+	}, routes)
+
+	_, _, ok := dispatcher.GroupRoute(&grouping.AlertGroup{Key: "{unknown}:x"})
+	assert.False(t, ok)
+
+	receivers, err := dispatcher.Receivers(alert)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"pager", "team"}, receivers)
+
+	receivers, err = dispatcher.Receivers(newDispatchAlert("fp-2", "HighCPU", nil))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"default"}, receivers)
+}
+
+func TestGroupDispatcher_ResolvedAlertNotifiedOnGroupInterval(t *testing.T) {
+	config := &grouping.GroupingConfig{
+		Route: &grouping.Route{
+			Receiver:       "default",
+			GroupBy:        []string{"alertname"},
+			GroupWait:      testDuration(50 * time.Millisecond),
+			GroupInterval:  testDuration(150 * time.Millisecond),
+			RepeatInterval: testDuration(time.Hour),
+		},
+	}
+	publisher := &recordingGroupPublisher{}
+	dispatcher := newTestDispatcher(t, config, publisher)
+	ctx := context.Background()
+
+	alert := newDispatchAlert("fp-1", "HighCPU", nil)
+	classification := &core.ClassificationResult{Severity: core.SeverityCritical}
+	require.NoError(t, dispatcher.Dispatch(ctx, alert, classification))
+
+	require.Eventually(t, func() bool {
+		return len(publisher.snapshot()) == 1
+	}, 2*time.Second, 10*time.Millisecond)
+	assert.Equal(t, classification, publisher.snapshot()[0].Classifications["fp-1"])
+
+	resolved := newDispatchAlert("fp-1", "HighCPU", nil)
+	resolved.Status = core.StatusResolved
+	require.NoError(t, dispatcher.Dispatch(ctx, resolved, nil))
+
+	require.Eventually(t, func() bool {
+		return len(publisher.snapshot()) == 2
+	}, 2*time.Second, 10*time.Millisecond)
+
+	notification := publisher.snapshot()[1]
+	assert.Equal(t, 0, notification.FiringCount())
+	assert.Len(t, notification.Alerts, 1)
+
+	// Group is dropped once everything resolved
+	require.Eventually(t, func() bool {
+		dispatcher.mu.Lock()
+		defer dispatcher.mu.Unlock()
+		return len(dispatcher.groups) == 0
+	}, 2*time.Second, 10*time.Millisecond)
+}
+
+func TestGroupDispatcher_FallsBackToPerAlertPublish(t *testing.T) {
+	config := &grouping.GroupingConfig{
+		Route: &grouping.Route{
+			Receiver:       "default",
+			GroupBy:        []string{"alertname"},
+			GroupWait:      testDuration(50 * time.Millisecond),
+			GroupInterval:  testDuration(time.Hour),
+			RepeatInterval: testDuration(time.Hour),
+		},
+	}
+
+	var mu sync.Mutex
+	published := map[string]bool{}
+	publisher := &mockPublisher{
+		publishToAllFunc: func(ctx context.Context, alert *core.Alert) error {
+			mu.Lock()
+			defer mu.Unlock()
+			published[alert.Fingerprint] = true
+			return nil
+		},
+	}
+	dispatcher := newTestDispatcher(t, config, publisher)
+	ctx := context.Background()
+
+	require.NoError(t, dispatcher.Dispatch(ctx, newDispatchAlert("fp-1", "HighCPU", nil), nil))
+	require.NoError(t, dispatcher.Dispatch(ctx, newDispatchAlert("fp-2", "HighCPU", nil), nil))
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return published["fp-1"] && published["fp-2"]
+	}, 2*time.Second, 10*time.Millisecond)
+}
+
+func TestGroupDispatcher_HoldsGroupOutsideActiveTimeIntervals(t *testing.T) {
+	config := &grouping.GroupingConfig{
+		Route: &grouping.Route{
+			Receiver:       "default",
+			GroupBy:        []string{"alertname"},
+			GroupWait:      testDuration(30 * time.Millisecond),
+			GroupInterval:  testDuration(50 * time.Millisecond),
+			RepeatInterval: testDuration(time.Hour),
+			Routes: []*grouping.Route{
+				{
+					Receiver:            "office",
+					Match:               map[string]string{"team": "office"},
+					ActiveTimeIntervals: []string{"never"},
+				},
+				{
+					Receiver:          "muted",
+					Match:             map[string]string{"team": "muted"},
+					MuteTimeIntervals: []string{"always"},
+				},
+			},
+		},
+		TimeIntervals: []amconfig.MuteTimeInterval{
+			{Name: "never", TimeIntervals: []amconfig.TimeInterval{{Years: []string{"1"}}}},
+			{Name: "always", TimeIntervals: []amconfig.TimeInterval{{}}},
+		},
+	}
+	evaluator, err := timeinterval.NewEvaluator(config.TimeIntervals)
+	require.NoError(t, err)
+
+	publisher := &recordingGroupPublisher{}
+	dispatcher := newTestDispatcher(t, config, publisher)
+	dispatcher.timeChecker = evaluator
+	ctx := context.Background()
+
+	require.NoError(t, dispatcher.Dispatch(ctx, newDispatchAlert("fp-1", "Printer", map[string]string{"team": "office"}), nil))
+	require.NoError(t, dispatcher.Dispatch(ctx, newDispatchAlert("fp-2", "Printer", map[string]string{"team": "muted"}), nil))
+	require.NoError(t, dispatcher.Dispatch(ctx, newDispatchAlert("fp-3", "Printer", map[string]string{"team": "ops"}), nil))
+
+	require.Eventually(t, func() bool {
+		return len(publisher.snapshot()) == 1
+	}, 2*time.Second, 10*time.Millisecond)
+
+	// Muted groups stay held across several group_interval cycles
+	time.Sleep(200 * time.Millisecond)
+	notifications := publisher.snapshot()
+	require.Len(t, notifications, 1)
+	assert.Equal(t, "default", notifications[0].Receiver)
+
+	dispatcher.mu.Lock()
+	defer dispatcher.mu.Unlock()
+	assert.Len(t, dispatcher.groups, 3, "Muted groups are held, not dropped")
+}
+
+func TestGroupDispatcher_NotificationLogSurvivesRestart(t *testing.T) {
+	config := &grouping.GroupingConfig{
+		Route: &grouping.Route{
+			Receiver:       "default",
+			GroupBy:        []string{"alertname"},
+			GroupWait:      testDuration(30 * time.Millisecond),
+			GroupInterval:  testDuration(50 * time.Millisecond),
+			RepeatInterval: testDuration(time.Hour),
+		},
+	}
+	notificationLog := nflog.NewMemoryLog()
+	withLog := func(c *GroupDispatcherConfig) { c.NotificationLog = notificationLog }
+	ctx := context.Background()
+
+	first := &recordingGroupPublisher{}
+	dispatcher := newTestDispatcher(t, config, first, withLog)
+	require.NoError(t, dispatcher.Dispatch(ctx, newDispatchAlert("fp-1", "HighCPU", nil), nil))
+	require.Eventually(t, func() bool {
+		return len(first.snapshot()) == 1
+	}, 2*time.Second, 10*time.Millisecond)
+
+	receiver := nflog.Receiver{GroupName: "default", Integration: dispatcherIntegration}
+	dispatcher.mu.Lock()
+	var groupKey grouping.GroupKey
+	for key := range dispatcher.groups {
+		groupKey = key
+	}
+	dispatcher.mu.Unlock()
+	entry, err := notificationLog.Query(ctx, string(groupKey), receiver)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{nflog.HashFingerprint("fp-1")}, entry.FiringAlerts)
+
+	// A restarted dispatcher (fresh in-memory state) sharing the log must not
+	// re-send the already notified group before repeat_interval
+	second := &recordingGroupPublisher{}
+	restarted := newTestDispatcher(t, config, second, withLog)
+	require.NoError(t, restarted.Dispatch(ctx, newDispatchAlert("fp-1", "HighCPU", nil), nil))
+	time.Sleep(200 * time.Millisecond)
+	assert.Empty(t, second.snapshot(), "Already notified group must not be re-sent")
+
+	// New firing alerts are still notified
+	require.NoError(t, restarted.Dispatch(ctx, newDispatchAlert("fp-2", "HighCPU", nil), nil))
+	require.Eventually(t, func() bool {
+		return len(second.snapshot()) == 1
+	}, 2*time.Second, 10*time.Millisecond)
+	assert.Len(t, second.snapshot()[0].Alerts, 2)
+}
+
+func TestGroupDispatcher_NotificationLogRepeatInterval(t *testing.T) {
+	config := &grouping.GroupingConfig{
+		Route: &grouping.Route{
+			Receiver:       "default",
+			GroupBy:        []string{"alertname"},
+			GroupWait:      testDuration(30 * time.Millisecond),
+			GroupInterval:  testDuration(50 * time.Millisecond),
+			RepeatInterval: testDuration(time.Hour),
+		},
+	}
+	notificationLog := nflog.NewMemoryLog()
+	publisher := &recordingGroupPublisher{}
+	dispatcher := newTestDispatcher(t, config, publisher, func(c *GroupDispatcherConfig) {
+		c.NotificationLog = notificationLog
+	})
+	ctx := context.Background()
+
+	// Group notified long ago by another replica: repeat_interval elapsed
+	alert := newDispatchAlert("fp-1", "HighCPU", nil)
+	decisions, err := dispatcher.route(alert)
+	require.NoError(t, err)
+	groupKey, err := dispatcher.groupKey(alert, decisions[0])
+	require.NoError(t, err)
+	receiver := nflog.Receiver{GroupName: "default", Integration: dispatcherIntegration}
+	require.NoError(t, notificationLog.Log(ctx, nflog.NewEntry(
+		string(groupKey), receiver, []string{"fp-1"}, nil, time.Now().Add(-2*time.Hour), 0)))
+
+	require.NoError(t, dispatcher.Dispatch(ctx, alert, nil))
+	require.Eventually(t, func() bool {
+		return len(publisher.snapshot()) == 1
+	}, 2*time.Second, 10*time.Millisecond)
+
+	// Subsequent group_interval flushes are deduplicated by the fresh entry
+	time.Sleep(200 * time.Millisecond)
+	assert.Len(t, publisher.snapshot(), 1)
+}
+
+// fakeAckChecker acknowledges groups while acked is set.
+type fakeAckChecker struct {
+	mu    sync.Mutex
+	acked bool
+}
+
+func (c *fakeAckChecker) IsAcknowledged(ctx context.Context, groupKey string, fingerprints []string, t time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.acked
+}
+
+func (c *fakeAckChecker) set(acked bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.acked = acked
+}
+
+func TestGroupDispatcher_AcknowledgedGroupSkipsRepeatNotification(t *testing.T) {
+	config := &grouping.GroupingConfig{
+		Route: &grouping.Route{
+			Receiver:       "default",
+			GroupBy:        []string{"alertname"},
+			GroupWait:      testDuration(30 * time.Millisecond),
+			GroupInterval:  testDuration(50 * time.Millisecond),
+			RepeatInterval: testDuration(time.Hour),
+		},
+	}
+	notificationLog := nflog.NewMemoryLog()
+	checker := &fakeAckChecker{acked: true}
+	publisher := &recordingGroupPublisher{}
+	dispatcher := newTestDispatcher(t, config, publisher, func(c *GroupDispatcherConfig) {
+		c.NotificationLog = notificationLog
+		c.AckChecker = checker
+	})
+	ctx := context.Background()
+
+	// Group notified long ago: repeat_interval elapsed, but acknowledged
+	alert := newDispatchAlert("fp-1", "HighCPU", nil)
+	decisions, err := dispatcher.route(alert)
+	require.NoError(t, err)
+	groupKey, err := dispatcher.groupKey(alert, decisions[0])
+	require.NoError(t, err)
+	receiver := nflog.Receiver{GroupName: "default", Integration: dispatcherIntegration}
+	require.NoError(t, notificationLog.Log(ctx, nflog.NewEntry(
+		string(groupKey), receiver, []string{"fp-1"}, nil, time.Now().Add(-2*time.Hour), 0)))
+
+	require.NoError(t, dispatcher.Dispatch(ctx, alert, nil))
+	time.Sleep(200 * time.Millisecond)
+	assert.Empty(t, publisher.snapshot(), "Acknowledged group must not be repeated")
+
+	// New firing alerts are still notified while acknowledged
+	require.NoError(t, dispatcher.Dispatch(ctx, newDispatchAlert("fp-2", "HighCPU", nil), nil))
+	require.Eventually(t, func() bool {
+		return len(publisher.snapshot()) == 1
+	}, 2*time.Second, 10*time.Millisecond)
+}
+
+func TestGroupDispatcher_RepeatNotificationResumesAfterAck(t *testing.T) {
+	config := &grouping.GroupingConfig{
+		Route: &grouping.Route{
+			Receiver:       "default",
+			GroupBy:        []string{"alertname"},
+			GroupWait:      testDuration(30 * time.Millisecond),
+			GroupInterval:  testDuration(50 * time.Millisecond),
+			RepeatInterval: testDuration(time.Hour),
+		},
+	}
+	notificationLog := nflog.NewMemoryLog()
+	checker := &fakeAckChecker{acked: true}
+	publisher := &recordingGroupPublisher{}
+	dispatcher := newTestDispatcher(t, config, publisher, func(c *GroupDispatcherConfig) {
+		c.NotificationLog = notificationLog
+		c.AckChecker = checker
+	})
+	ctx := context.Background()
+
+	alert := newDispatchAlert("fp-1", "HighCPU", nil)
+	decisions, err := dispatcher.route(alert)
+	require.NoError(t, err)
+	groupKey, err := dispatcher.groupKey(alert, decisions[0])
+	require.NoError(t, err)
+	receiver := nflog.Receiver{GroupName: "default", Integration: dispatcherIntegration}
+	require.NoError(t, notificationLog.Log(ctx, nflog.NewEntry(
+		string(groupKey), receiver, []string{"fp-1"}, nil, time.Now().Add(-2*time.Hour), 0)))
+
+	require.NoError(t, dispatcher.Dispatch(ctx, alert, nil))
+	time.Sleep(150 * time.Millisecond)
+	assert.Empty(t, publisher.snapshot())
+
+	// Acknowledgement expired/removed: next group_interval check repeats
+	checker.set(false)
+	require.Eventually(t, func() bool {
+		return len(publisher.snapshot()) == 1
+	}, 2*time.Second, 10*time.Millisecond)
+}
+
+func TestRouteConfigFromGrouping(t *testing.T) {
+	config := &grouping.GroupingConfig{
+		Route: &grouping.Route{
+			Receiver:  "default",
+			GroupBy:   []string{"alertname"},
+			GroupWait: testDuration(10 * time.Second),
+			Routes: []*grouping.Route{
+				{Receiver: "pager", Match: map[string]string{"severity": "critical"}},
+				{Receiver: "default", MatchRE: map[string]string{"service": "db.*"}},
+			},
+		},
+	}
+
+	routeConfig, err := RouteConfigFromGrouping(config)
+	require.NoError(t, err)
+
+	assert.Equal(t, 10*time.Second, routeConfig.Route.GroupWait)
+	assert.Len(t, routeConfig.Route.Routes, 2)
+	assert.Len(t, routeConfig.Receivers, 2)
+	assert.Equal(t, "default", routeConfig.Receivers[0].Name)
+	assert.Equal(t, "pager", routeConfig.Receivers[1].Name)
+
+	_, err = RouteConfigFromGrouping(&grouping.GroupingConfig{})
+	assert.Error(t, err)
+}
@@ -3,6 +3,7 @@ package services
 import (
 	"log/slog"
 	"strings"
+	"sync"
 
 	"github.com/vitaliisemenov/alert-history/internal/core"
 )
@@ -16,8 +17,15 @@ type FallbackEngine interface {
 	GetConfidence() float64
 }
 
+// Compile-time interface check
+var _ FallbackEngine = (*RuleBasedFallback)(nil)
+
 // RuleBasedFallback implements intelligent rule-based classification.
+//
+// Rules are declarative (see FallbackRuleSpec) and can be
+// replaced at runtime via LoadRules or config hot reload (Reloadable).
 type RuleBasedFallback struct {
+	mu         sync.RWMutex
 	rules      []ClassificationRule
 	confidence float64
 	logger     *slog.Logger
@@ -25,61 +33,152 @@ type RuleBasedFallback struct {
 
 // ClassificationRule defines a classification rule.
 type ClassificationRule struct {
-	Name       string
-	Condition  func(*core.Alert) bool
-	Severity   core.AlertSeverity
-	Category   string
-	Confidence float64
-	Reasoning  string
+	Name            string
+	Condition       func(*core.Alert) bool
+	Severity        core.AlertSeverity
+	Category        string
+	Confidence      float64
+	Reasoning       string
+	Recommendations []string // Optional; generic fallback recommendations are used if empty
+}
+
+// FallbackDryRunResult explains how the fallback engine classifies an alert.
+type FallbackDryRunResult struct {
+	Matched        bool                       `json:"matched"`
+	Rule           string                     `json:"rule,omitempty"`
+	RuleIndex      int                        `json:"rule_index"` // -1 if no rule matched
+	RulesEvaluated int                        `json:"rules_evaluated"`
+	Classification *core.ClassificationResult `json:"classification"`
 }
 
-// NewRuleBasedFallback creates a new rule-based fallback engine.
+// NewRuleBasedFallback creates a new rule-based fallback engine with the built-in rules.
 func NewRuleBasedFallback(logger *slog.Logger) *RuleBasedFallback {
 	if logger == nil {
 		logger = slog.Default()
 	}
 
+	// Built-in rules are covered by TestDefaultFallbackRules_Compile; should
+	// they ever fail, alerts get the default classification instead.
+	rules, err := CompileFallbackRules(DefaultFallbackRules())
+	if err != nil {
+		logger.Error("Invalid built-in fallback rules, using default classification only", "error", err)
+	}
+
 	return &RuleBasedFallback{
-		rules:      getDefaultRules(),
+		rules:      rules,
 		confidence: 0.6, // Default fallback confidence
 		logger:     logger,
 	}
 }
 
+// LoadRules compiles and atomically installs declarative rules.
+// An empty list restores the built-in rules. On error the current rules are kept.
+func (f *RuleBasedFallback) LoadRules(specs []FallbackRuleSpec) error {
+	if len(specs) == 0 {
+		specs = DefaultFallbackRules()
+	}
+
+	rules, err := CompileFallbackRules(specs)
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	f.rules = rules
+	f.mu.Unlock()
+
+	f.logger.Info("Fallback rules loaded", "rules", len(rules))
+	return nil
+}
+
+// RuleNames returns the names of the active rules in evaluation order.
+func (f *RuleBasedFallback) RuleNames() []string {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	names := make([]string, 0, len(f.rules))
+	for _, rule := range f.rules {
+		names = append(names, rule.Name)
+	}
+	return names
+}
+
 // Classify classifies alert using rules.
 func (f *RuleBasedFallback) Classify(alert *core.Alert) *core.ClassificationResult {
-	// Try each rule in order
-	for _, rule := range f.rules {
-		if rule.Condition(alert) {
-			f.logger.Info("Fallback rule matched",
-				"rule", rule.Name,
-				"alert", alert.AlertName,
-				"severity", rule.Severity)
-
-			return &core.ClassificationResult{
-				Severity:   rule.Severity,
-				Confidence: rule.Confidence,
-				Reasoning:  rule.Reasoning,
-				Recommendations: []string{
-					"This is a fallback classification",
-					"LLM classification unavailable",
-					"Check alert patterns for accuracy",
-				},
-				ProcessingTime: 0.001, // < 1ms
-				Metadata: map[string]any{
-					"category": rule.Category,
-					"rule":     rule.Name,
-					"fallback": true,
-				},
-			}
+	rule, _, _ := f.match(alert)
+	if rule == nil {
+		// No rule matched - return default classification
+		f.logger.Warn("No fallback rule matched, using default",
+			"alert", alert.AlertName)
+		return f.getDefaultClassification(alert)
+	}
+
+	f.logger.Info("Fallback rule matched",
+		"rule", rule.Name,
+		"alert", alert.AlertName,
+		"severity", rule.Severity)
+
+	return ruleClassification(rule)
+}
+
+// DryRun reports which rule (if any) matches the alert and the resulting
+// classification, without side effects.
+func (f *RuleBasedFallback) DryRun(alert *core.Alert) *FallbackDryRunResult {
+	rule, index, evaluated := f.match(alert)
+	if rule == nil {
+		return &FallbackDryRunResult{
+			RuleIndex:      -1,
+			RulesEvaluated: evaluated,
+			Classification: f.getDefaultClassification(alert),
 		}
 	}
 
-	// No rule matched - return default classification
-	f.logger.Warn("No fallback rule matched, using default",
-		"alert", alert.AlertName)
+	return &FallbackDryRunResult{
+		Matched:        true,
+		Rule:           rule.Name,
+		RuleIndex:      index,
+		RulesEvaluated: evaluated,
+		Classification: ruleClassification(rule),
+	}
+}
 
-	return f.getDefaultClassification(alert)
+// match returns the first matching rule, its index and the number of rules evaluated.
+func (f *RuleBasedFallback) match(alert *core.Alert) (*ClassificationRule, int, int) {
+	f.mu.RLock()
+	rules := f.rules
+	f.mu.RUnlock()
+
+	for i := range rules {
+		if rules[i].Condition(alert) {
+			return &rules[i], i, i + 1
+		}
+	}
+	return nil, -1, len(rules)
+}
+
+// ruleClassification builds the classification result for a matched rule.
+func ruleClassification(rule *ClassificationRule) *core.ClassificationResult {
+	recommendations := rule.Recommendations
+	if len(recommendations) == 0 {
+		recommendations = []string{
+			"This is a fallback classification",
+			"LLM classification unavailable",
+			"Check alert patterns for accuracy",
+		}
+	}
+
+	return &core.ClassificationResult{
+		Severity:        rule.Severity,
+		Confidence:      rule.Confidence,
+		Reasoning:       rule.Reasoning,
+		Recommendations: append([]string(nil), recommendations...),
+		ProcessingTime:  0.001, // < 1ms
+		Metadata: map[string]any{
+			"category": rule.Category,
+			"rule":     rule.Name,
+			"fallback": true,
+		},
+	}
 }
 
 // GetConfidence returns fallback confidence level.
@@ -118,203 +217,8 @@ func (f *RuleBasedFallback) getDefaultClassification(alert *core.Alert) *core.Cl
 	}
 }
 
-// getDefaultRules returns default classification rules (150% enhancement - 15+ rules).
-func getDefaultRules() []ClassificationRule {
-	return []ClassificationRule{
-		// ========== Critical Infrastructure Rules ==========
-		{
-			Name: "Node Down Critical",
-			Condition: func(a *core.Alert) bool {
-				return containsAny(a.AlertName, "NodeDown", "InstanceDown", "node_down") ||
-					a.Labels["severity"] == "critical"
-			},
-			Severity:   core.SeverityCritical,
-			Category:   "infrastructure",
-			Confidence: 0.8,
-			Reasoning:  "Node or instance is down - critical infrastructure failure",
-		},
-		{
-			Name: "Kubernetes Node NotReady",
-			Condition: func(a *core.Alert) bool {
-				return containsAny(a.AlertName, "NodeNotReady", "KubernetesNodeNotReady") ||
-					(a.Labels["alertname"] == "NodeNotReady")
-			},
-			Severity:   core.SeverityCritical,
-			Category:   "kubernetes",
-			Confidence: 0.8,
-			Reasoning:  "Kubernetes node is not ready - pod scheduling affected",
-		},
-		{
-			Name: "Disk Full Critical",
-			Condition: func(a *core.Alert) bool {
-				return containsAny(a.AlertName, "DiskFull", "disk_full", "FilesystemFull") &&
-					a.Status == core.StatusFiring
-			},
-			Severity:   core.SeverityCritical,
-			Category:   "storage",
-			Confidence: 0.75,
-			Reasoning:  "Disk space critically low or full - immediate action required",
-		},
-
-		// ========== High Resource Usage Rules ==========
-		{
-			Name: "High CPU Usage",
-			Condition: func(a *core.Alert) bool {
-				return containsAny(a.AlertName, "HighCPU", "CPUThrottling", "cpu_high")
-			},
-			Severity:   core.SeverityWarning,
-			Category:   "resource",
-			Confidence: 0.7,
-			Reasoning:  "CPU usage is high - may affect performance",
-		},
-		{
-			Name: "High Memory Usage",
-			Condition: func(a *core.Alert) bool {
-				return containsAny(a.AlertName, "HighMemory", "MemoryPressure", "memory_high", "OOM")
-			},
-			Severity:   core.SeverityWarning,
-			Category:   "resource",
-			Confidence: 0.7,
-			Reasoning:  "Memory usage is high - risk of OOM",
-		},
-		{
-			Name: "High Disk IO",
-			Condition: func(a *core.Alert) bool {
-				return containsAny(a.AlertName, "HighIO", "DiskIO", "io_wait")
-			},
-			Severity:   core.SeverityWarning,
-			Category:   "performance",
-			Confidence: 0.65,
-			Reasoning:  "Disk I/O is high - may cause slowdowns",
-		},
-
-		// ========== Application-Level Rules ==========
-		{
-			Name: "High Error Rate",
-			Condition: func(a *core.Alert) bool {
-				return containsAny(a.AlertName, "HighErrorRate", "ErrorRate", "errors_high") ||
-					containsAny(strings.ToLower(a.Annotations["description"]), "error rate", "errors")
-			},
-			Severity:   core.SeverityWarning,
-			Category:   "application",
-			Confidence: 0.7,
-			Reasoning:  "Application error rate is elevated - investigate logs",
-		},
-		{
-			Name: "Service Unavailable",
-			Condition: func(a *core.Alert) bool {
-				return containsAny(a.AlertName, "ServiceUnavailable", "EndpointDown", "TargetDown")
-			},
-			Severity:   core.SeverityCritical,
-			Category:   "availability",
-			Confidence: 0.75,
-			Reasoning:  "Service or endpoint is unavailable - affecting users",
-		},
-		{
-			Name: "High Response Time",
-			Condition: func(a *core.Alert) bool {
-				return containsAny(a.AlertName, "HighLatency", "SlowResponse", "ResponseTimeSlow")
-			},
-			Severity:   core.SeverityWarning,
-			Category:   "performance",
-			Confidence: 0.65,
-			Reasoning:  "Response time is high - user experience degraded",
-		},
-
-		// ========== Database Rules ==========
-		{
-			Name: "Database Connection Issues",
-			Condition: func(a *core.Alert) bool {
-				return containsAny(a.AlertName, "DatabaseDown", "DBConnectionFailed", "PostgresDown", "MySQLDown")
-			},
-			Severity:   core.SeverityCritical,
-			Category:   "database",
-			Confidence: 0.8,
-			Reasoning:  "Database connection issues - data access affected",
-		},
-		{
-			Name: "Database Slow Queries",
-			Condition: func(a *core.Alert) bool {
-				return containsAny(a.AlertName, "SlowQuery", "QueryLatency", "DBSlow")
-			},
-			Severity:   core.SeverityWarning,
-			Category:   "database",
-			Confidence: 0.65,
-			Reasoning:  "Database queries are slow - performance impact",
-		},
-
-		// ========== Security Rules ==========
-		{
-			Name: "Security Threat",
-			Condition: func(a *core.Alert) bool {
-				return containsAny(a.AlertName, "SecurityViolation", "UnauthorizedAccess", "BruteForce") ||
-					a.Labels["type"] == "security"
-			},
-			Severity:   core.SeverityCritical,
-			Category:   "security",
-			Confidence: 0.85,
-			Reasoning:  "Security threat detected - immediate investigation required",
-		},
-		{
-			Name: "Certificate Expiring",
-			Condition: func(a *core.Alert) bool {
-				return containsAny(a.AlertName, "CertificateExpiring", "CertExpiry", "TLSExpiring")
-			},
-			Severity:   core.SeverityWarning,
-			Category:   "security",
-			Confidence: 0.7,
-			Reasoning:  "TLS certificate expiring soon - renewal required",
-		},
-
-		// ========== Network Rules ==========
-		{
-			Name: "Network Connectivity Issues",
-			Condition: func(a *core.Alert) bool {
-				return containsAny(a.AlertName, "NetworkDown", "ConnectivityLoss", "PacketLoss")
-			},
-			Severity:   core.SeverityCritical,
-			Category:   "network",
-			Confidence: 0.75,
-			Reasoning:  "Network connectivity issues - communication affected",
-		},
-
-		// ========== Generic Informational Rules ==========
-		{
-			Name: "Pod Restarting",
-			Condition: func(a *core.Alert) bool {
-				return containsAny(a.AlertName, "PodRestarting", "ContainerRestart", "CrashLoopBackOff")
-			},
-			Severity:   core.SeverityWarning,
-			Category:   "kubernetes",
-			Confidence: 0.7,
-			Reasoning:  "Pod is restarting frequently - investigate crash logs",
-		},
-		{
-			Name: "Backup Failed",
-			Condition: func(a *core.Alert) bool {
-				return containsAny(a.AlertName, "BackupFailed", "BackupJobFailed")
-			},
-			Severity:   core.SeverityWarning,
-			Category:   "operations",
-			Confidence: 0.7,
-			Reasoning:  "Backup job failed - data recovery risk",
-		},
-	}
-}
-
 // Helper functions
 
-// containsAny checks if string contains any of the substrings (case-insensitive).
-func containsAny(s string, substrings ...string) bool {
-	lower := strings.ToLower(s)
-	for _, substr := range substrings {
-		if strings.Contains(lower, strings.ToLower(substr)) {
-			return true
-		}
-	}
-	return false
-}
-
 // isCriticalAlert checks if alert has critical indicators.
 func isCriticalAlert(alert *core.Alert) bool {
 	// Check labels
@@ -0,0 +1,394 @@
+package services
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure/inhibition"
+)
+
+// FallbackRuleSpec defines a single declarative fallback classification rule
+// (configured via llm.fallback_rules).
+//
+// Rules are evaluated in order; the first rule with a matching Match entry wins.
+type FallbackRuleSpec struct {
+	Name            string
+	Match           []FallbackMatchSpec
+	Severity        string
+	Category        string
+	Confidence      float64
+	Reasoning       string
+	Recommendations []string
+}
+
+// FallbackMatchSpec is one alternative of a fallback rule's match list.
+//
+// All conditions set within an entry must hold (AND); a rule matches when
+// any of its entries matches (OR).
+type FallbackMatchSpec struct {
+	// AlertName holds case-insensitive globs ("*" and "?"); any may match.
+	AlertName []string
+	// Status restricts the match to "firing" or "resolved" alerts.
+	Status string
+	// Labels holds label matchers (label=value, label!=value, label=~regex,
+	// label!~regex) with Alertmanager semantics: regexes are fully anchored
+	// and a missing label equals "".
+	Labels []string
+	// Annotations holds annotation matchers in the same format as Labels.
+	Annotations []string
+}
+
+// CompileFallbackRules compiles declarative fallback rules (llm.fallback_rules)
+// into ClassificationRules.
+//
+// Validation:
+//   - name is required and unique
+//   - severity must be one of critical, warning, info, noise
+//   - confidence must be within (0, 1]
+//   - at least one match entry, each with at least one condition
+//   - globs, matchers and regexes must compile
+func CompileFallbackRules(specs []FallbackRuleSpec) ([]ClassificationRule, error) {
+	rules := make([]ClassificationRule, 0, len(specs))
+	seen := make(map[string]bool, len(specs))
+
+	for i, spec := range specs {
+		if spec.Name == "" {
+			return nil, fmt.Errorf("fallback rule %d: name is required", i)
+		}
+		if seen[spec.Name] {
+			return nil, fmt.Errorf("fallback rule %q: duplicate name", spec.Name)
+		}
+		seen[spec.Name] = true
+
+		rule, err := compileFallbackRule(spec)
+		if err != nil {
+			return nil, fmt.Errorf("fallback rule %q: %w", spec.Name, err)
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+func compileFallbackRule(spec FallbackRuleSpec) (ClassificationRule, error) {
+	severity := core.AlertSeverity(strings.ToLower(spec.Severity))
+	switch severity {
+	case core.SeverityCritical, core.SeverityWarning, core.SeverityInfo, core.SeverityNoise:
+	default:
+		return ClassificationRule{}, fmt.Errorf("invalid severity %q (expected critical, warning, info or noise)", spec.Severity)
+	}
+
+	if spec.Confidence <= 0 || spec.Confidence > 1 {
+		return ClassificationRule{}, fmt.Errorf("confidence must be within (0, 1], got %v", spec.Confidence)
+	}
+
+	if len(spec.Match) == 0 {
+		return ClassificationRule{}, fmt.Errorf("at least one match entry is required")
+	}
+
+	conditions := make([]fallbackCondition, 0, len(spec.Match))
+	for i, m := range spec.Match {
+		cond, err := compileFallbackCondition(m)
+		if err != nil {
+			return ClassificationRule{}, fmt.Errorf("match[%d]: %w", i, err)
+		}
+		conditions = append(conditions, cond)
+	}
+
+	return ClassificationRule{
+		Name: spec.Name,
+		Condition: func(a *core.Alert) bool {
+			for _, cond := range conditions {
+				if cond.matches(a) {
+					return true
+				}
+			}
+			return false
+		},
+		Severity:        severity,
+		Category:        spec.Category,
+		Confidence:      spec.Confidence,
+		Reasoning:       spec.Reasoning,
+		Recommendations: spec.Recommendations,
+	}, nil
+}
+
+// fallbackCondition is a compiled FallbackMatchSpec (all set fields must hold).
+type fallbackCondition struct {
+	alertNames  []*regexp.Regexp
+	status      core.AlertStatus
+	labels      []*inhibition.Matcher
+	annotations []*inhibition.Matcher
+}
+
+func compileFallbackCondition(m FallbackMatchSpec) (fallbackCondition, error) {
+	var cond fallbackCondition
+
+	for _, glob := range m.AlertName {
+		re, err := compileGlob(glob)
+		if err != nil {
+			return cond, fmt.Errorf("alertname %q: %w", glob, err)
+		}
+		cond.alertNames = append(cond.alertNames, re)
+	}
+
+	if m.Status != "" {
+		cond.status = core.AlertStatus(strings.ToLower(m.Status))
+		if cond.status != core.StatusFiring && cond.status != core.StatusResolved {
+			return cond, fmt.Errorf("invalid status %q (expected firing or resolved)", m.Status)
+		}
+	}
+
+	var err error
+	if cond.labels, err = parseFallbackMatchers(m.Labels); err != nil {
+		return cond, fmt.Errorf("labels: %w", err)
+	}
+	if cond.annotations, err = parseFallbackMatchers(m.Annotations); err != nil {
+		return cond, fmt.Errorf("annotations: %w", err)
+	}
+
+	if len(cond.alertNames) == 0 && cond.status == "" && len(cond.labels) == 0 && len(cond.annotations) == 0 {
+		return cond, fmt.Errorf("match entry has no conditions")
+	}
+
+	return cond, nil
+}
+
+func (c fallbackCondition) matches(a *core.Alert) bool {
+	if c.status != "" && a.Status != c.status {
+		return false
+	}
+
+	if len(c.alertNames) > 0 {
+		name := a.AlertName
+		if name == "" {
+			name = a.Labels["alertname"]
+		}
+		matched := false
+		for _, re := range c.alertNames {
+			if re.MatchString(name) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	for _, m := range c.labels {
+		if !m.Matches(a.Labels) {
+			return false
+		}
+	}
+	for _, m := range c.annotations {
+		if !m.Matches(a.Annotations) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// parseFallbackMatchers parses label/annotation matchers with Alertmanager
+// semantics (anchored regexes, a missing label equals "").
+func parseFallbackMatchers(raw []string) ([]*inhibition.Matcher, error) {
+	var matchers []*inhibition.Matcher
+	for _, expr := range raw {
+		parsed, err := inhibition.ParseMatchers(expr)
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, parsed...)
+	}
+	return matchers, nil
+}
+
+// compileGlob converts a case-insensitive glob ("*" any run, "?" any single
+// character) into an anchored regular expression.
+func compileGlob(glob string) (*regexp.Regexp, error) {
+	if glob == "" {
+		return nil, fmt.Errorf("empty pattern")
+	}
+
+	var b strings.Builder
+	b.WriteString("(?i)^")
+	for _, r := range glob {
+		switch r {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+
+	return regexp.Compile(b.String())
+}
+
+// DefaultFallbackRules returns the built-in fallback rules, used when
+// llm.fallback_rules is not configured. They double as a reference for
+// writing custom rules.
+func DefaultFallbackRules() []FallbackRuleSpec {
+	return []FallbackRuleSpec{
+		// ========== Critical Infrastructure Rules ==========
+		{
+			Name: "Node Down Critical",
+			Match: []FallbackMatchSpec{
+				{AlertName: []string{"*NodeDown*", "*InstanceDown*", "*node_down*"}},
+				{Labels: []string{"severity=critical"}},
+			},
+			Severity:   "critical",
+			Category:   "infrastructure",
+			Confidence: 0.8,
+			Reasoning:  "Node or instance is down - critical infrastructure failure",
+		},
+		{
+			Name: "Kubernetes Node NotReady",
+			Match: []FallbackMatchSpec{
+				{AlertName: []string{"*NodeNotReady*", "*KubernetesNodeNotReady*"}},
+				{Labels: []string{"alertname=NodeNotReady"}},
+			},
+			Severity:   "critical",
+			Category:   "kubernetes",
+			Confidence: 0.8,
+			Reasoning:  "Kubernetes node is not ready - pod scheduling affected",
+		},
+		{
+			Name: "Disk Full Critical",
+			Match: []FallbackMatchSpec{
+				{AlertName: []string{"*DiskFull*", "*disk_full*", "*FilesystemFull*"}, Status: "firing"},
+			},
+			Severity:   "critical",
+			Category:   "storage",
+			Confidence: 0.75,
+			Reasoning:  "Disk space critically low or full - immediate action required",
+		},
+
+		// ========== High Resource Usage Rules ==========
+		{
+			Name:       "High CPU Usage",
+			Match:      []FallbackMatchSpec{{AlertName: []string{"*HighCPU*", "*CPUThrottling*", "*cpu_high*"}}},
+			Severity:   "warning",
+			Category:   "resource",
+			Confidence: 0.7,
+			Reasoning:  "CPU usage is high - may affect performance",
+		},
+		{
+			Name:       "High Memory Usage",
+			Match:      []FallbackMatchSpec{{AlertName: []string{"*HighMemory*", "*MemoryPressure*", "*memory_high*", "*OOM*"}}},
+			Severity:   "warning",
+			Category:   "resource",
+			Confidence: 0.7,
+			Reasoning:  "Memory usage is high - risk of OOM",
+		},
+		{
+			Name:       "High Disk IO",
+			Match:      []FallbackMatchSpec{{AlertName: []string{"*HighIO*", "*DiskIO*", "*io_wait*"}}},
+			Severity:   "warning",
+			Category:   "performance",
+			Confidence: 0.65,
+			Reasoning:  "Disk I/O is high - may cause slowdowns",
+		},
+
+		// ========== Application-Level Rules ==========
+		{
+			Name: "High Error Rate",
+			Match: []FallbackMatchSpec{
+				{AlertName: []string{"*HighErrorRate*", "*ErrorRate*", "*errors_high*"}},
+				{Annotations: []string{`description=~"(?is).*(error rate|errors).*"`}},
+			},
+			Severity:   "warning",
+			Category:   "application",
+			Confidence: 0.7,
+			Reasoning:  "Application error rate is elevated - investigate logs",
+		},
+		{
+			Name:       "Service Unavailable",
+			Match:      []FallbackMatchSpec{{AlertName: []string{"*ServiceUnavailable*", "*EndpointDown*", "*TargetDown*"}}},
+			Severity:   "critical",
+			Category:   "availability",
+			Confidence: 0.75,
+			Reasoning:  "Service or endpoint is unavailable - affecting users",
+		},
+		{
+			Name:       "High Response Time",
+			Match:      []FallbackMatchSpec{{AlertName: []string{"*HighLatency*", "*SlowResponse*", "*ResponseTimeSlow*"}}},
+			Severity:   "warning",
+			Category:   "performance",
+			Confidence: 0.65,
+			Reasoning:  "Response time is high - user experience degraded",
+		},
+
+		// ========== Database Rules ==========
+		{
+			Name:       "Database Connection Issues",
+			Match:      []FallbackMatchSpec{{AlertName: []string{"*DatabaseDown*", "*DBConnectionFailed*", "*PostgresDown*", "*MySQLDown*"}}},
+			Severity:   "critical",
+			Category:   "database",
+			Confidence: 0.8,
+			Reasoning:  "Database connection issues - data access affected",
+		},
+		{
+			Name:       "Database Slow Queries",
+			Match:      []FallbackMatchSpec{{AlertName: []string{"*SlowQuery*", "*QueryLatency*", "*DBSlow*"}}},
+			Severity:   "warning",
+			Category:   "database",
+			Confidence: 0.65,
+			Reasoning:  "Database queries are slow - performance impact",
+		},
+
+		// ========== Security Rules ==========
+		{
+			Name: "Security Threat",
+			Match: []FallbackMatchSpec{
+				{AlertName: []string{"*SecurityViolation*", "*UnauthorizedAccess*", "*BruteForce*"}},
+				{Labels: []string{"type=security"}},
+			},
+			Severity:   "critical",
+			Category:   "security",
+			Confidence: 0.85,
+			Reasoning:  "Security threat detected - immediate investigation required",
+		},
+		{
+			Name:       "Certificate Expiring",
+			Match:      []FallbackMatchSpec{{AlertName: []string{"*CertificateExpiring*", "*CertExpiry*", "*TLSExpiring*"}}},
+			Severity:   "warning",
+			Category:   "security",
+			Confidence: 0.7,
+			Reasoning:  "TLS certificate expiring soon - renewal required",
+		},
+
+		// ========== Network Rules ==========
+		{
+			Name:       "Network Connectivity Issues",
+			Match:      []FallbackMatchSpec{{AlertName: []string{"*NetworkDown*", "*ConnectivityLoss*", "*PacketLoss*"}}},
+			Severity:   "critical",
+			Category:   "network",
+			Confidence: 0.75,
+			Reasoning:  "Network connectivity issues - communication affected",
+		},
+
+		// ========== Generic Informational Rules ==========
+		{
+			Name:       "Pod Restarting",
+			Match:      []FallbackMatchSpec{{AlertName: []string{"*PodRestarting*", "*ContainerRestart*", "*CrashLoopBackOff*"}}},
+			Severity:   "warning",
+			Category:   "kubernetes",
+			Confidence: 0.7,
+			Reasoning:  "Pod is restarting frequently - investigate crash logs",
+		},
+		{
+			Name:       "Backup Failed",
+			Match:      []FallbackMatchSpec{{AlertName: []string{"*BackupFailed*", "*BackupJobFailed*"}}},
+			Severity:   "warning",
+			Category:   "operations",
+			Confidence: 0.7,
+			Reasoning:  "Backup job failed - data recovery risk",
+		},
+	}
+}
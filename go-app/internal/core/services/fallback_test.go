@@ -0,0 +1,176 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+)
+
+func TestDefaultFallbackRules_Compile(t *testing.T) {
+	rules, err := CompileFallbackRules(DefaultFallbackRules())
+	require.NoError(t, err)
+	assert.Len(t, rules, len(DefaultFallbackRules()))
+}
+
+func TestRuleBasedFallback_DefaultRules(t *testing.T) {
+	fallback := NewRuleBasedFallback(nil)
+
+	tests := []struct {
+		name     string
+		alert    *core.Alert
+		wantRule string
+		wantSev  core.AlertSeverity
+	}{
+		{
+			name:     "alertname_glob_case_insensitive",
+			alert:    &core.Alert{AlertName: "KubeNODEDOWN", Status: core.StatusFiring},
+			wantRule: "Node Down Critical",
+			wantSev:  core.SeverityCritical,
+		},
+		{
+			name:     "label_matcher_alternative",
+			alert:    &core.Alert{AlertName: "Whatever", Status: core.StatusFiring, Labels: map[string]string{"type": "security"}},
+			wantRule: "Security Threat",
+			wantSev:  core.SeverityCritical,
+		},
+		{
+			name:     "annotation_regex",
+			alert:    &core.Alert{AlertName: "CheckoutSLO", Status: core.StatusFiring, Annotations: map[string]string{"description": "Error Rate above 5%"}},
+			wantRule: "High Error Rate",
+			wantSev:  core.SeverityWarning,
+		},
+		{
+			name:     "status_condition",
+			alert:    &core.Alert{AlertName: "DiskFull", Status: core.StatusResolved},
+			wantRule: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := fallback.DryRun(tt.alert)
+			assert.Equal(t, tt.wantRule, result.Rule)
+			assert.Equal(t, tt.wantRule != "", result.Matched)
+			if tt.wantRule != "" {
+				assert.Equal(t, tt.wantSev, result.Classification.Severity)
+				assert.Equal(t, tt.wantRule, result.Classification.Metadata["rule"])
+			} else {
+				assert.Equal(t, -1, result.RuleIndex)
+				assert.Equal(t, true, result.Classification.Metadata["default"])
+			}
+		})
+	}
+}
+
+func TestRuleBasedFallback_LoadRules(t *testing.T) {
+	fallback := NewRuleBasedFallback(nil)
+
+	err := fallback.LoadRules([]FallbackRuleSpec{
+		{
+			Name: "payments",
+			Match: []FallbackMatchSpec{{
+				AlertName: []string{"Payment*"},
+				Labels:    []string{"team=payments", "env=~prod|staging"},
+			}},
+			Severity:        "critical",
+			Category:        "business",
+			Confidence:      0.9,
+			Reasoning:       "Payments are affected",
+			Recommendations: []string{"Page the payments on-call"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"payments"}, fallback.RuleNames())
+
+	result := fallback.Classify(&core.Alert{
+		AlertName: "PaymentLatency",
+		Status:    core.StatusFiring,
+		Labels:    map[string]string{"team": "payments", "env": "prod"},
+	})
+	assert.Equal(t, core.SeverityCritical, result.Severity)
+	assert.Equal(t, 0.9, result.Confidence)
+	assert.Equal(t, []string{"Page the payments on-call"}, result.Recommendations)
+	assert.Equal(t, "business", result.Metadata["category"])
+
+	dryRun := fallback.DryRun(&core.Alert{
+		AlertName: "PaymentLatency",
+		Labels:    map[string]string{"team": "payments", "env": "dev"},
+	})
+	assert.False(t, dryRun.Matched)
+	assert.Equal(t, 1, dryRun.RulesEvaluated)
+
+	// Empty list restores built-in rules
+	require.NoError(t, fallback.LoadRules(nil))
+	assert.Len(t, fallback.RuleNames(), len(DefaultFallbackRules()))
+}
+
+func TestRuleBasedFallback_LoadRules_MatcherSemantics(t *testing.T) {
+	fallback := NewRuleBasedFallback(nil)
+	require.NoError(t, fallback.LoadRules([]FallbackRuleSpec{{
+		Name:       "prod",
+		Match:      []FallbackMatchSpec{{Labels: []string{"namespace=~prod", "env!=dev"}}},
+		Severity:   "critical",
+		Confidence: 0.9,
+	}}))
+
+	tests := []struct {
+		name    string
+		labels  map[string]string
+		matched bool
+	}{
+		{"exact_match", map[string]string{"namespace": "prod"}, true},
+		{"regex_is_anchored", map[string]string{"namespace": "preprod"}, false},
+		{"missing_label_is_empty", map[string]string{"namespace": "prod", "env": "dev"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := fallback.DryRun(&core.Alert{AlertName: "Any", Labels: tt.labels})
+			assert.Equal(t, tt.matched, result.Matched)
+		})
+	}
+}
+
+func TestRuleBasedFallback_LoadRules_InvalidKeepsCurrent(t *testing.T) {
+	valid := FallbackRuleSpec{
+		Name:       "ok",
+		Match:      []FallbackMatchSpec{{AlertName: []string{"*"}}},
+		Severity:   "info",
+		Confidence: 0.5,
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(r *FallbackRuleSpec)
+	}{
+		{"missing_name", func(r *FallbackRuleSpec) { r.Name = "" }},
+		{"bad_severity", func(r *FallbackRuleSpec) { r.Severity = "urgent" }},
+		{"bad_confidence", func(r *FallbackRuleSpec) { r.Confidence = 1.5 }},
+		{"no_match", func(r *FallbackRuleSpec) { r.Match = nil }},
+		{"empty_match_entry", func(r *FallbackRuleSpec) { r.Match = []FallbackMatchSpec{{}} }},
+		{"bad_status", func(r *FallbackRuleSpec) { r.Match[0].Status = "pending" }},
+		{"bad_regex", func(r *FallbackRuleSpec) { r.Match[0].Labels = []string{"env=~(prod"} }},
+		{"bad_matcher", func(r *FallbackRuleSpec) { r.Match[0].Annotations = []string{"summary"} }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fallback := NewRuleBasedFallback(nil)
+			before := fallback.RuleNames()
+
+			rule := valid
+			rule.Match = []FallbackMatchSpec{{AlertName: []string{"*"}}}
+			tt.mutate(&rule)
+
+			assert.Error(t, fallback.LoadRules([]FallbackRuleSpec{rule}))
+			assert.Equal(t, before, fallback.RuleNames())
+		})
+	}
+
+	t.Run("duplicate_name", func(t *testing.T) {
+		_, err := CompileFallbackRules([]FallbackRuleSpec{valid, valid})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,293 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure/cache"
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure/llm"
+)
+
+// groupSummaryCacheKeyPrefix prefixes group summary cache keys
+// (group_summary:<group key hash>:<membership hash>).
+const groupSummaryCacheKeyPrefix = "group_summary:"
+
+// Group summary defaults
+const (
+	defaultGroupSummaryCacheTTL  = time.Hour
+	defaultGroupSummaryTimeout   = 30 * time.Second
+	defaultGroupSummaryMinAlerts = 2
+
+	maxGroupSummaryRecommendations = 10
+	fallbackGroupSummaryConfidence = 0.5
+)
+
+// GroupSummarizer produces incident summaries of alert groups at flush time.
+type GroupSummarizer interface {
+	// Summarize returns the group summary, or nil if the group has fewer
+	// firing alerts than the configured minimum.
+	Summarize(ctx context.Context, request *llm.GroupSummaryRequest) (*core.GroupSummary, error)
+}
+
+// GroupSummarizerConfig holds configuration for DefaultGroupSummarizer.
+type GroupSummarizerConfig struct {
+	// Client generates LLM summaries (optional). Without a client, or when
+	// the LLM fails, a rule-based summary is produced.
+	Client llm.GroupSummaryClient
+
+	// Cache stores LLM summaries by group key and membership hash (optional)
+	Cache    cache.Cache
+	CacheTTL time.Duration // default 1h
+
+	// MinAlerts is the minimum number of firing alerts to summarise (default 2)
+	MinAlerts int
+
+	// Timeout bounds the LLM call, which delays the flush (default 30s)
+	Timeout time.Duration
+
+	Logger *slog.Logger
+}
+
+// DefaultGroupSummarizer implements GroupSummarizer.
+//
+// The LLM summary is merged with the per-alert classifications: their
+// recommendations are appended (most frequent first) and the list is
+// deduplicated case-insensitively. A summary is reused for as long as the
+// group's membership (fingerprints and statuses) is unchanged.
+type DefaultGroupSummarizer struct {
+	client    llm.GroupSummaryClient
+	cache     cache.Cache
+	cacheTTL  time.Duration
+	minAlerts int
+	timeout   time.Duration
+	logger    *slog.Logger
+}
+
+// NewGroupSummarizer creates a new group summarizer.
+func NewGroupSummarizer(config GroupSummarizerConfig) *DefaultGroupSummarizer {
+	if config.CacheTTL <= 0 {
+		config.CacheTTL = defaultGroupSummaryCacheTTL
+	}
+	if config.MinAlerts <= 0 {
+		config.MinAlerts = defaultGroupSummaryMinAlerts
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = defaultGroupSummaryTimeout
+	}
+	if config.Logger == nil {
+		config.Logger = slog.Default()
+	}
+
+	return &DefaultGroupSummarizer{
+		client:    config.Client,
+		cache:     config.Cache,
+		cacheTTL:  config.CacheTTL,
+		minAlerts: config.MinAlerts,
+		timeout:   config.Timeout,
+		logger:    config.Logger,
+	}
+}
+
+// Summarize implements GroupSummarizer.
+func (s *DefaultGroupSummarizer) Summarize(ctx context.Context, request *llm.GroupSummaryRequest) (*core.GroupSummary, error) {
+	if request == nil {
+		return nil, fmt.Errorf("group summary request cannot be nil")
+	}
+
+	firing := 0
+	for _, alert := range request.Alerts {
+		if alert.Status == core.StatusFiring {
+			firing++
+		}
+	}
+	if firing < s.minAlerts {
+		return nil, nil
+	}
+
+	membershipHash := core.GroupMembershipHash(request.Alerts)
+	key := groupSummaryCacheKey(request.GroupKey, membershipHash)
+	if s.cache != nil {
+		var cached core.GroupSummary
+		if err := s.cache.Get(ctx, key, &cached); err == nil {
+			s.logger.Debug("Group summary cache hit", "group_key", request.GroupKey)
+			return &cached, nil
+		}
+	}
+
+	summary := s.summarizeWithLLM(ctx, request)
+	if summary == nil {
+		summary = fallbackGroupSummary(request)
+	}
+	summary.Recommendations = mergeRecommendations(summary.Recommendations, request)
+	summary.AlertCount = len(request.Alerts)
+	summary.FiringCount = firing
+	summary.MembershipHash = membershipHash
+	if summary.GeneratedAt.IsZero() {
+		summary.GeneratedAt = time.Now()
+	}
+
+	// Rule-based summaries are not cached so the LLM is retried next flush
+	if s.cache != nil && summary.Source == core.GroupSummarySourceLLM {
+		if err := s.cache.Set(ctx, key, summary, s.cacheTTL); err != nil {
+			s.logger.Warn("Failed to cache group summary",
+				"group_key", request.GroupKey,
+				"error", err)
+		}
+	}
+
+	return summary, nil
+}
+
+// summarizeWithLLM returns the LLM summary, or nil if unavailable.
+func (s *DefaultGroupSummarizer) summarizeWithLLM(ctx context.Context, request *llm.GroupSummaryRequest) *core.GroupSummary {
+	if s.client == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	summary, err := s.client.SummarizeGroup(ctx, request)
+	if err != nil {
+		s.logger.Warn("LLM group summary failed, using rule-based summary",
+			"group_key", request.GroupKey,
+			"alerts", len(request.Alerts),
+			"error", err)
+		return nil
+	}
+	return summary
+}
+
+// fallbackGroupSummary builds a rule-based summary from the alerts' names,
+// group labels, most varying label and shared description.
+func fallbackGroupSummary(request *llm.GroupSummaryRequest) *core.GroupSummary {
+	firing := 0
+	names := make(map[string]struct{})
+	descriptions := make(map[string]struct{})
+	for _, alert := range request.Alerts {
+		if alert.Status == core.StatusFiring {
+			firing++
+		}
+		names[alert.AlertName] = struct{}{}
+		description := alert.Annotations["summary"]
+		if description == "" {
+			description = alert.Annotations["description"]
+		}
+		descriptions[strings.TrimSpace(description)] = struct{}{}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d of %d alerts firing: %s", firing, len(request.Alerts), strings.Join(sortedSet(names), ", "))
+	if labels := formatGroupLabels(request.GroupLabels); labels != "" {
+		fmt.Fprintf(&b, " (%s)", labels)
+	}
+	if label, count := mostVaryingLabel(request.Alerts); count > 1 {
+		fmt.Fprintf(&b, " across %d distinct %s values", count, label)
+	}
+
+	summary := &core.GroupSummary{
+		Summary:    b.String(),
+		Confidence: fallbackGroupSummaryConfidence,
+		Source:     core.GroupSummarySourceFallback,
+	}
+	if len(descriptions) == 1 {
+		for description := range descriptions {
+			summary.RootCause = description
+		}
+	}
+	return summary
+}
+
+// mergeRecommendations appends the per-alert classification recommendations
+// (most frequent first) and deduplicates case-insensitively.
+func mergeRecommendations(recommendations []string, request *llm.GroupSummaryRequest) []string {
+	counts := make(map[string]int)
+	var order []string
+	for _, alert := range request.Alerts {
+		classification := request.Classifications[alert.Fingerprint]
+		if classification == nil {
+			continue
+		}
+		for _, recommendation := range classification.Recommendations {
+			if counts[recommendation] == 0 {
+				order = append(order, recommendation)
+			}
+			counts[recommendation]++
+		}
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return counts[order[i]] > counts[order[j]]
+	})
+
+	merged := make([]string, 0, maxGroupSummaryRecommendations)
+	seen := make(map[string]struct{})
+	for _, recommendation := range append(append([]string{}, recommendations...), order...) {
+		recommendation = strings.TrimSpace(recommendation)
+		normalized := strings.ToLower(recommendation)
+		if recommendation == "" {
+			continue
+		}
+		if _, ok := seen[normalized]; ok {
+			continue
+		}
+		seen[normalized] = struct{}{}
+		merged = append(merged, recommendation)
+		if len(merged) == maxGroupSummaryRecommendations {
+			break
+		}
+	}
+	return merged
+}
+
+// mostVaryingLabel returns the label with the most distinct values.
+func mostVaryingLabel(alerts []*core.Alert) (string, int) {
+	values := make(map[string]map[string]struct{})
+	for _, alert := range alerts {
+		for name, value := range alert.Labels {
+			if values[name] == nil {
+				values[name] = make(map[string]struct{})
+			}
+			values[name][value] = struct{}{}
+		}
+	}
+
+	best, count := "", 0
+	for _, name := range sortedSet(values) {
+		if len(values[name]) > count {
+			best, count = name, len(values[name])
+		}
+	}
+	return best, count
+}
+
+// formatGroupLabels formats labels as "a=1, b=2" in name order.
+func formatGroupLabels(labels map[string]string) string {
+	parts := make([]string, 0, len(labels))
+	for _, name := range sortedSet(labels) {
+		parts = append(parts, name+"="+labels[name])
+	}
+	return strings.Join(parts, ", ")
+}
+
+// groupSummaryCacheKey returns the cache key of a group summary. Group keys
+// can be long, so they are hashed.
+func groupSummaryCacheKey(groupKey, membershipHash string) string {
+	sum := sha256.Sum256([]byte(groupKey))
+	return groupSummaryCacheKeyPrefix + hex.EncodeToString(sum[:8]) + ":" + membershipHash
+}
+
+func sortedSet[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
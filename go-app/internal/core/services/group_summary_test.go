@@ -0,0 +1,204 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure/grouping"
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure/llm"
+)
+
+// fakeGroupSummaryClient returns a fixed LLM summary or error.
+type fakeGroupSummaryClient struct {
+	mu    sync.Mutex
+	err   error
+	calls int
+}
+
+func (c *fakeGroupSummaryClient) SummarizeGroup(ctx context.Context, request *llm.GroupSummaryRequest) (*core.GroupSummary, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls++
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &core.GroupSummary{
+		Summary:         fmt.Sprintf("%d pods crash looping", len(request.Alerts)),
+		RootCause:       "Bad config rollout",
+		Recommendations: []string{"Roll back the deployment", "check pod logs"},
+		Confidence:      0.8,
+		Source:          core.GroupSummarySourceLLM,
+	}, nil
+}
+
+func (c *fakeGroupSummaryClient) callCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.calls
+}
+
+func newSummaryRequest(count int) *llm.GroupSummaryRequest {
+	request := &llm.GroupSummaryRequest{
+		GroupKey:        `{}:{alertname="PodCrashLooping"}`,
+		GroupLabels:     map[string]string{"alertname": "PodCrashLooping"},
+		Classifications: make(map[string]*core.ClassificationResult),
+	}
+	for i := 0; i < count; i++ {
+		alert := newDispatchAlert(fmt.Sprintf("fp-%d", i), "PodCrashLooping", map[string]string{
+			"namespace": "prod",
+			"pod":       fmt.Sprintf("api-%d", i),
+		})
+		alert.Annotations = map[string]string{"summary": "Pod is crash looping"}
+		request.Alerts = append(request.Alerts, alert)
+		request.Classifications[alert.Fingerprint] = &core.ClassificationResult{
+			Severity:        core.SeverityCritical,
+			Recommendations: []string{"Check pod logs", "Check recent deployments"},
+		}
+	}
+	return request
+}
+
+func TestGroupSummarizer_LLMSummaryMergedAndCached(t *testing.T) {
+	client := &fakeGroupSummaryClient{}
+	summaryCache := newMockCache()
+	summarizer := NewGroupSummarizer(GroupSummarizerConfig{Client: client, Cache: summaryCache})
+	ctx := context.Background()
+	request := newSummaryRequest(80)
+
+	summary, err := summarizer.Summarize(ctx, request)
+	require.NoError(t, err)
+	require.NotNil(t, summary)
+	assert.Equal(t, "80 pods crash looping", summary.Summary)
+	assert.Equal(t, core.GroupSummarySourceLLM, summary.Source)
+	assert.Equal(t, []string{"Roll back the deployment", "check pod logs", "Check recent deployments"}, summary.Recommendations)
+	assert.Equal(t, 80, summary.AlertCount)
+	assert.Equal(t, 80, summary.FiringCount)
+	assert.Equal(t, core.GroupMembershipHash(request.Alerts), summary.MembershipHash)
+
+	// Same membership: served from cache
+	cached, err := summarizer.Summarize(ctx, request)
+	require.NoError(t, err)
+	assert.Equal(t, summary.Summary, cached.Summary)
+	assert.Equal(t, 1, client.callCount())
+
+	// Membership change: summarised again
+	request.Alerts[0].Status = core.StatusResolved
+	_, err = summarizer.Summarize(ctx, request)
+	require.NoError(t, err)
+	assert.Equal(t, 2, client.callCount())
+}
+
+func TestGroupSummarizer_FallbackWhenLLMFails(t *testing.T) {
+	client := &fakeGroupSummaryClient{err: errors.New("all LLM providers failed")}
+	summaryCache := newMockCache()
+	summarizer := NewGroupSummarizer(GroupSummarizerConfig{Client: client, Cache: summaryCache})
+
+	summary, err := summarizer.Summarize(context.Background(), newSummaryRequest(3))
+	require.NoError(t, err)
+	require.NotNil(t, summary)
+	assert.Equal(t, core.GroupSummarySourceFallback, summary.Source)
+	assert.Equal(t, "3 of 3 alerts firing: PodCrashLooping (alertname=PodCrashLooping) across 3 distinct pod values", summary.Summary)
+	assert.Equal(t, "Pod is crash looping", summary.RootCause)
+	assert.Equal(t, []string{"Check pod logs", "Check recent deployments"}, summary.Recommendations)
+	assert.Empty(t, summaryCache.data, "fallback summaries are not cached")
+}
+
+func TestGroupSummarizer_SkipsSmallGroups(t *testing.T) {
+	client := &fakeGroupSummaryClient{}
+	summarizer := NewGroupSummarizer(GroupSummarizerConfig{Client: client})
+
+	summary, err := summarizer.Summarize(context.Background(), newSummaryRequest(1))
+	require.NoError(t, err)
+	assert.Nil(t, summary)
+	assert.Zero(t, client.callCount())
+}
+
+func TestGroupDispatcher_SummarisesGroupOnFlush(t *testing.T) {
+	config := &grouping.GroupingConfig{
+		Route: &grouping.Route{
+			Receiver:       "default",
+			GroupBy:        []string{"alertname"},
+			GroupWait:      testDuration(30 * time.Millisecond),
+			GroupInterval:  testDuration(30 * time.Millisecond),
+			RepeatInterval: testDuration(60 * time.Millisecond),
+		},
+	}
+	client := &fakeGroupSummaryClient{}
+	publisher := &recordingGroupPublisher{}
+	dispatcher := newTestDispatcher(t, config, publisher, func(c *GroupDispatcherConfig) {
+		c.Summarizer = NewGroupSummarizer(GroupSummarizerConfig{Client: client})
+	})
+	ctx := context.Background()
+
+	alert := newDispatchAlert("fp-1", "HighCPU", map[string]string{"instance": "a"})
+	require.NoError(t, dispatcher.Dispatch(ctx, alert, nil))
+	require.NoError(t, dispatcher.Dispatch(ctx, newDispatchAlert("fp-2", "HighCPU", map[string]string{"instance": "b"}), nil))
+
+	// Repeat notifications reuse the summary stored on the group
+	require.Eventually(t, func() bool {
+		return len(publisher.snapshot()) >= 2
+	}, 2*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 1, client.callCount())
+
+	notification := publisher.snapshot()[0]
+	require.NotNil(t, notification.Summary)
+	assert.Equal(t, "2 pods crash looping", notification.Summary.Summary)
+	assert.Same(t, notification.Summary, publisher.snapshot()[1].Summary)
+
+	decisions, err := dispatcher.route(alert)
+	require.NoError(t, err)
+	groupKey, err := dispatcher.groupKey(alert, decisions[0])
+	require.NoError(t, err)
+	group, err := dispatcher.groupManager.GetGroup(ctx, groupKey)
+	require.NoError(t, err)
+	require.NotNil(t, group.Summary)
+	assert.Equal(t, notification.Summary.MembershipHash, group.Summary.MembershipHash)
+}
+
+func TestGroupDispatcher_RetriesLLMAfterFallbackSummary(t *testing.T) {
+	config := &grouping.GroupingConfig{
+		Route: &grouping.Route{
+			Receiver:       "default",
+			GroupBy:        []string{"alertname"},
+			GroupWait:      testDuration(30 * time.Millisecond),
+			GroupInterval:  testDuration(30 * time.Millisecond),
+			RepeatInterval: testDuration(60 * time.Millisecond),
+		},
+	}
+	client := &fakeGroupSummaryClient{err: errors.New("all LLM providers failed")}
+	publisher := &recordingGroupPublisher{}
+	dispatcher := newTestDispatcher(t, config, publisher, func(c *GroupDispatcherConfig) {
+		c.Summarizer = NewGroupSummarizer(GroupSummarizerConfig{Client: client})
+	})
+	ctx := context.Background()
+
+	require.NoError(t, dispatcher.Dispatch(ctx, newDispatchAlert("fp-1", "HighCPU", map[string]string{"instance": "a"}), nil))
+	require.NoError(t, dispatcher.Dispatch(ctx, newDispatchAlert("fp-2", "HighCPU", map[string]string{"instance": "b"}), nil))
+
+	require.Eventually(t, func() bool {
+		return len(publisher.snapshot()) >= 1
+	}, 2*time.Second, 10*time.Millisecond)
+	first := publisher.snapshot()[0]
+	require.NotNil(t, first.Summary)
+	assert.Equal(t, core.GroupSummarySourceFallback, first.Summary.Source)
+
+	// The LLM recovers: repeat notifications replace the fallback summary
+	client.mu.Lock()
+	client.err = nil
+	client.mu.Unlock()
+
+	require.Eventually(t, func() bool {
+		notifications := publisher.snapshot()
+		last := notifications[len(notifications)-1]
+		return last.Summary != nil && last.Summary.Source == core.GroupSummarySourceLLM
+	}, 2*time.Second, 10*time.Millisecond)
+	assert.Equal(t, "2 pods crash looping", publisher.snapshot()[len(publisher.snapshot())-1].Summary.Summary)
+}
@@ -0,0 +1,261 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+)
+
+// ErrReceiverTargetNotFound is returned when a receiver resolves to a
+// publishing target that does not exist.
+var ErrReceiverTargetNotFound = errors.New("receiver target not found")
+
+// TargetProvider provides publishing targets (implemented by the target
+// discovery managers).
+type TargetProvider interface {
+	GetTarget(name string) (*core.PublishingTarget, error)
+	ListTargets() []*core.PublishingTarget
+}
+
+// TargetPublisher publishes an alert to a single publishing target
+// (implemented by publishing.PublisherFactory).
+type TargetPublisher interface {
+	PublishToTarget(ctx context.Context, enrichedAlert *core.EnrichedAlert, target *core.PublishingTarget) error
+}
+
+// TargetQueue queues an alert for delivery to a single publishing target
+// (implemented by publishing.PublishingQueue, which retries failed jobs and
+// moves exhausted ones to the DLQ).
+type TargetQueue interface {
+	Submit(enrichedAlert *core.EnrichedAlert, target *core.PublishingTarget) error
+}
+
+// ReceiverTemplate references a managed template (and optionally a pinned
+// version, 0 follows the current version) for a receiver's notifications.
+type ReceiverTemplate struct {
+	Name    string
+	Version int
+}
+
+// ReceiverPublisherConfig holds configuration for ReceiverPublisher.
+type ReceiverPublisherConfig struct {
+	// Receivers maps receiver names to publishing target names (optional).
+	// A receiver without an entry resolves to the target of the same name.
+	Receivers map[string][]string
+
+	Targets   TargetProvider  // required
+	Publisher TargetPublisher // required
+
+	// Templates maps receiver names to a managed template reference that
+	// overrides the template of the receiver's targets (optional).
+	Templates map[string]ReceiverTemplate
+
+	// Queue receives the publishing jobs (optional, see SetQueue). Without
+	// a queue alerts are published synchronously via Publisher.
+	Queue TargetQueue
+
+	Logger *slog.Logger
+}
+
+// ReceiverPublisher delivers group notifications to the publishing targets
+// of their receiver. It implements IntegrationPublisher (each target is one
+// integration of the receiver) and GroupPublisher for the dispatcher, and
+// Publisher (all enabled targets) for alerts published without routing.
+//
+// Disabled targets are skipped; unknown targets fail the receiver's
+// delivery with ErrReceiverTargetNotFound. A receiver's template reference
+// replaces the template of its targets.
+type ReceiverPublisher struct {
+	receivers map[string][]string
+	templates map[string]ReceiverTemplate
+	targets   TargetProvider
+	publisher TargetPublisher
+	logger    *slog.Logger
+
+	mu    sync.RWMutex
+	queue TargetQueue
+}
+
+// NewReceiverPublisher creates a new receiver publisher.
+func NewReceiverPublisher(config ReceiverPublisherConfig) (*ReceiverPublisher, error) {
+	if config.Targets == nil {
+		return nil, fmt.Errorf("target provider is required")
+	}
+	if config.Publisher == nil {
+		return nil, fmt.Errorf("target publisher is required")
+	}
+	if config.Logger == nil {
+		config.Logger = slog.Default()
+	}
+
+	receivers := make(map[string][]string, len(config.Receivers))
+	for name, targets := range config.Receivers {
+		if len(targets) > 0 {
+			receivers[name] = append([]string(nil), targets...)
+		}
+	}
+
+	templates := make(map[string]ReceiverTemplate, len(config.Templates))
+	for name, template := range config.Templates {
+		if template.Name != "" {
+			templates[name] = template
+		}
+	}
+
+	return &ReceiverPublisher{
+		receivers: receivers,
+		templates: templates,
+		targets:   config.Targets,
+		publisher: config.Publisher,
+		logger:    config.Logger,
+		queue:     config.Queue,
+	}, nil
+}
+
+// SetQueue sets the publishing queue the alerts are submitted to (the queue
+// is created after the dispatcher).
+func (p *ReceiverPublisher) SetQueue(queue TargetQueue) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.queue = queue
+}
+
+// ReceiverTargets returns the publishing target names of a receiver.
+func (p *ReceiverPublisher) ReceiverTargets(receiver string) []string {
+	if targets, ok := p.receivers[receiver]; ok {
+		return targets
+	}
+	return []string{receiver}
+}
+
+// HasReceiver reports whether a receiver resolves to publishing targets: it
+// has a receiver → targets mapping, or a target of the same name exists.
+func (p *ReceiverPublisher) HasReceiver(receiver string) bool {
+	if _, ok := p.receivers[receiver]; ok {
+		return true
+	}
+	target, err := p.targets.GetTarget(receiver)
+	return err == nil && target != nil
+}
+
+// ReceiverIntegrations implements IntegrationPublisher: each publishing
+// target of the receiver is one integration of the target's type.
+func (p *ReceiverPublisher) ReceiverIntegrations(receiver string) []ReceiverIntegration {
+	names := p.ReceiverTargets(receiver)
+	integrations := make([]ReceiverIntegration, 0, len(names))
+	for _, name := range names {
+		integration := ReceiverIntegration{Name: name}
+		if target, err := p.targets.GetTarget(name); err == nil && target != nil {
+			integration.Type = target.Type
+		}
+		integrations = append(integrations, integration)
+	}
+	return integrations
+}
+
+// PublishIntegration implements IntegrationPublisher: the notification is
+// delivered to a single target of its receiver.
+func (p *ReceiverPublisher) PublishIntegration(ctx context.Context, notification *GroupNotification, integration ReceiverIntegration) error {
+	if err := p.publishToTarget(ctx, notification, integration.Name); err != nil {
+		return fmt.Errorf("receiver %s: target %s: %w", notification.Receiver, integration.Name, err)
+	}
+	return nil
+}
+
+// PublishGroup implements GroupPublisher: the notification is delivered to
+// every target of its receiver, once per target.
+func (p *ReceiverPublisher) PublishGroup(ctx context.Context, notification *GroupNotification) error {
+	var errs []error
+	for _, integration := range p.ReceiverIntegrations(notification.Receiver) {
+		if err := p.PublishIntegration(ctx, notification, integration); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// PublishToAll implements Publisher: the alert is published to all enabled targets.
+func (p *ReceiverPublisher) PublishToAll(ctx context.Context, alert *core.Alert) error {
+	return p.publishToAll(ctx, &core.EnrichedAlert{Alert: alert})
+}
+
+// PublishWithClassification implements Publisher: the classified alert is
+// published to all enabled targets.
+func (p *ReceiverPublisher) PublishWithClassification(ctx context.Context, alert *core.Alert, classification *core.ClassificationResult) error {
+	return p.publishToAll(ctx, &core.EnrichedAlert{Alert: alert, Classification: classification})
+}
+
+// publishToTarget delivers the notification's alerts to a single target.
+func (p *ReceiverPublisher) publishToTarget(ctx context.Context, notification *GroupNotification, name string) error {
+	target, err := p.targets.GetTarget(name)
+	if err != nil || target == nil {
+		return fmt.Errorf("%w: %s", ErrReceiverTargetNotFound, name)
+	}
+	if !target.Enabled {
+		p.logger.Debug("Publishing target disabled, skipping",
+			"receiver", notification.Receiver,
+			"target", name)
+		return nil
+	}
+	if template, ok := p.templates[notification.Receiver]; ok {
+		override := *target
+		override.Template = template.Name
+		override.TemplateVersion = template.Version
+		target = &override
+	}
+
+	var errs []error
+	for _, alert := range notification.Alerts {
+		enrichedAlert := &core.EnrichedAlert{
+			Alert:          alert,
+			Classification: notification.Classifications[alert.Fingerprint],
+		}
+		if notification.Summary != nil {
+			enrichedAlert.EnrichmentMetadata = map[string]any{core.GroupSummaryMetadataKey: notification.Summary}
+		}
+		if err := p.deliver(ctx, enrichedAlert, target); err != nil {
+			errs = append(errs, fmt.Errorf("publish %s: %w", alert.Fingerprint, err))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+
+	p.logger.Debug("Notification group published to target",
+		"group_key", notification.GroupKey,
+		"receiver", notification.Receiver,
+		"target", name,
+		"alerts", len(notification.Alerts))
+	return nil
+}
+
+// publishToAll publishes an alert to all enabled targets.
+func (p *ReceiverPublisher) publishToAll(ctx context.Context, enrichedAlert *core.EnrichedAlert) error {
+	var errs []error
+	for _, target := range p.targets.ListTargets() {
+		if !target.Enabled {
+			continue
+		}
+		if err := p.deliver(ctx, enrichedAlert, target); err != nil {
+			errs = append(errs, fmt.Errorf("target %s: %w", target.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// deliver submits the alert to the publishing queue, or publishes it
+// synchronously when no queue is set.
+func (p *ReceiverPublisher) deliver(ctx context.Context, enrichedAlert *core.EnrichedAlert, target *core.PublishingTarget) error {
+	p.mu.RLock()
+	queue := p.queue
+	p.mu.RUnlock()
+
+	if queue != nil {
+		return queue.Submit(enrichedAlert, target)
+	}
+	return p.publisher.PublishToTarget(ctx, enrichedAlert, target)
+}
@@ -0,0 +1,283 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure/grouping"
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure/nflog"
+)
+
+// fakeTargets serves publishing targets from memory.
+type fakeTargets map[string]*core.PublishingTarget
+
+func (t fakeTargets) GetTarget(name string) (*core.PublishingTarget, error) {
+	if target, ok := t[name]; ok {
+		return target, nil
+	}
+	return nil, errors.New("target not found")
+}
+
+func (t fakeTargets) ListTargets() []*core.PublishingTarget {
+	targets := make([]*core.PublishingTarget, 0, len(t))
+	for _, target := range t {
+		targets = append(targets, target)
+	}
+	return targets
+}
+
+func newFakeTargets(names ...string) fakeTargets {
+	targets := make(fakeTargets, len(names))
+	for _, name := range names {
+		targets[name] = &core.PublishingTarget{Name: name, Type: "webhook", Enabled: true}
+	}
+	return targets
+}
+
+// recordingTargetPublisher counts deliveries per target and fingerprint.
+type recordingTargetPublisher struct {
+	mu         sync.Mutex
+	deliveries map[string]int // target/fingerprint → count
+	fail       map[string]bool
+	templates  map[string]string // target → template@version of the last delivery
+}
+
+func (p *recordingTargetPublisher) PublishToTarget(ctx context.Context, enrichedAlert *core.EnrichedAlert, target *core.PublishingTarget) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.fail[target.Name] {
+		return errors.New("target unavailable")
+	}
+	if p.deliveries == nil {
+		p.deliveries = make(map[string]int)
+	}
+	p.deliveries[target.Name+"/"+enrichedAlert.Alert.Fingerprint]++
+	if p.templates == nil {
+		p.templates = make(map[string]string)
+	}
+	p.templates[target.Name] = fmt.Sprintf("%s@%d", target.Template, target.TemplateVersion)
+	return nil
+}
+
+func (p *recordingTargetPublisher) setFail(target string, fail bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.fail == nil {
+		p.fail = make(map[string]bool)
+	}
+	p.fail[target] = fail
+}
+
+func (p *recordingTargetPublisher) snapshot() map[string]int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	result := make(map[string]int, len(p.deliveries))
+	for key, count := range p.deliveries {
+		result[key] = count
+	}
+	return result
+}
+
+func TestReceiverPublisher_ContinueRoutesDeliverOncePerReceiver(t *testing.T) {
+	config := &grouping.GroupingConfig{
+		Route: &grouping.Route{
+			Receiver:       "default",
+			GroupBy:        []string{"alertname"},
+			GroupWait:      testDuration(30 * time.Millisecond),
+			GroupInterval:  testDuration(time.Hour),
+			RepeatInterval: testDuration(time.Hour),
+			Routes: []*grouping.Route{
+				{Receiver: "team-db", Match: map[string]string{"team": "db"}, Continue: true},
+				{Receiver: "pager", Match: map[string]string{"severity": "critical"}},
+			},
+		},
+	}
+	targetPublisher := &recordingTargetPublisher{}
+	publisher, err := NewReceiverPublisher(ReceiverPublisherConfig{
+		Receivers: map[string][]string{
+			"team-db": {"slack-db", "webhook-db"},
+			"pager":   {"pagerduty-primary"},
+		},
+		Targets:   newFakeTargets("slack-db", "webhook-db", "pagerduty-primary", "default", "slack-other"),
+		Publisher: targetPublisher,
+	})
+	require.NoError(t, err)
+	dispatcher := newTestDispatcher(t, config, publisher)
+
+	alert := newDispatchAlert("fp-1", "DiskFull", map[string]string{"team": "db", "severity": "critical"})
+	require.NoError(t, dispatcher.Dispatch(context.Background(), alert, nil))
+
+	expected := map[string]int{
+		"slack-db/fp-1":          1,
+		"webhook-db/fp-1":        1,
+		"pagerduty-primary/fp-1": 1,
+	}
+	require.Eventually(t, func() bool {
+		return len(targetPublisher.snapshot()) == len(expected)
+	}, 2*time.Second, 10*time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, expected, targetPublisher.snapshot(), "each receiver's targets get the alert once; other targets none")
+}
+
+func TestReceiverPublisher_PublishGroup(t *testing.T) {
+	targets := newFakeTargets("slack-db", "webhook-db", "default")
+	targets["webhook-db"].Enabled = false
+	targetPublisher := &recordingTargetPublisher{}
+	publisher, err := NewReceiverPublisher(ReceiverPublisherConfig{
+		Receivers: map[string][]string{"team-db": {"slack-db", "webhook-db"}, "broken": {"missing"}},
+		Targets:   targets,
+		Publisher: targetPublisher,
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+	alerts := []*core.Alert{newDispatchAlert("fp-1", "DiskFull", nil), newDispatchAlert("fp-2", "DiskFull", nil)}
+
+	// Disabled targets are skipped
+	require.NoError(t, publisher.PublishGroup(ctx, &GroupNotification{Receiver: "team-db", Alerts: alerts}))
+	assert.Equal(t, map[string]int{"slack-db/fp-1": 1, "slack-db/fp-2": 1}, targetPublisher.snapshot())
+
+	// Receivers without a mapping resolve to the target of the same name
+	require.NoError(t, publisher.PublishGroup(ctx, &GroupNotification{Receiver: "default", Alerts: alerts[:1]}))
+	assert.Equal(t, 1, targetPublisher.snapshot()["default/fp-1"])
+
+	err = publisher.PublishGroup(ctx, &GroupNotification{Receiver: "broken", Alerts: alerts})
+	assert.ErrorIs(t, err, ErrReceiverTargetNotFound)
+
+	// Mapped receivers and receivers named after a target resolve
+	assert.True(t, publisher.HasReceiver("team-db"))
+	assert.True(t, publisher.HasReceiver("default"))
+	assert.False(t, publisher.HasReceiver("unknown"))
+
+	_, err = NewReceiverPublisher(ReceiverPublisherConfig{Targets: targets})
+	assert.Error(t, err)
+}
+
+func TestReceiverPublisher_PartialFailureRetriesFailedIntegration(t *testing.T) {
+	config := &grouping.GroupingConfig{
+		Route: &grouping.Route{
+			Receiver:       "team-db",
+			GroupBy:        []string{"alertname"},
+			GroupWait:      testDuration(30 * time.Millisecond),
+			GroupInterval:  testDuration(50 * time.Millisecond),
+			RepeatInterval: testDuration(time.Hour),
+		},
+	}
+	targetPublisher := &recordingTargetPublisher{}
+	targetPublisher.setFail("webhook-db", true)
+	publisher, err := NewReceiverPublisher(ReceiverPublisherConfig{
+		Receivers: map[string][]string{"team-db": {"slack-db", "webhook-db"}},
+		Targets:   newFakeTargets("slack-db", "webhook-db"),
+		Publisher: targetPublisher,
+	})
+	require.NoError(t, err)
+	notificationLog := nflog.NewMemoryLog()
+	dispatcher := newTestDispatcher(t, config, publisher, func(c *GroupDispatcherConfig) {
+		c.NotificationLog = notificationLog
+	})
+	ctx := context.Background()
+
+	alert := newDispatchAlert("fp-1", "DiskFull", nil)
+	decisions, err := dispatcher.route(alert)
+	require.NoError(t, err)
+	groupKey, err := dispatcher.groupKey(alert, decisions[0])
+	require.NoError(t, err)
+	slack := nflog.Receiver{GroupName: "team-db", Integration: "webhook", Index: 0}
+	webhook := nflog.Receiver{GroupName: "team-db", Integration: "webhook", Index: 1}
+
+	require.NoError(t, dispatcher.Dispatch(ctx, alert, nil))
+	require.Eventually(t, func() bool {
+		return targetPublisher.snapshot()["slack-db/fp-1"] == 1
+	}, 2*time.Second, 10*time.Millisecond)
+
+	// Only the integration that delivered is recorded
+	_, err = notificationLog.Query(ctx, string(groupKey), slack)
+	require.NoError(t, err)
+	_, err = notificationLog.Query(ctx, string(groupKey), webhook)
+	assert.ErrorIs(t, err, nflog.ErrNotFound)
+
+	// The failed integration is retried on the next group_interval, the
+	// delivered one is not notified again
+	targetPublisher.setFail("webhook-db", false)
+	require.Eventually(t, func() bool {
+		return targetPublisher.snapshot()["webhook-db/fp-1"] == 1
+	}, 2*time.Second, 10*time.Millisecond)
+	time.Sleep(200 * time.Millisecond)
+	assert.Equal(t, map[string]int{"slack-db/fp-1": 1, "webhook-db/fp-1": 1}, targetPublisher.snapshot())
+
+	_, err = notificationLog.Query(ctx, string(groupKey), webhook)
+	assert.NoError(t, err)
+}
+
+// recordingTargetQueue records submitted publishing jobs.
+type recordingTargetQueue struct {
+	mu   sync.Mutex
+	jobs []string // target/fingerprint
+	err  error
+}
+
+func (q *recordingTargetQueue) Submit(enrichedAlert *core.EnrichedAlert, target *core.PublishingTarget) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.err != nil {
+		return q.err
+	}
+	q.jobs = append(q.jobs, target.Name+"/"+enrichedAlert.Alert.Fingerprint)
+	return nil
+}
+
+func TestReceiverPublisher_SubmitsToQueue(t *testing.T) {
+	targetPublisher := &recordingTargetPublisher{}
+	publisher, err := NewReceiverPublisher(ReceiverPublisherConfig{
+		Receivers: map[string][]string{"team-db": {"slack-db", "webhook-db"}},
+		Targets:   newFakeTargets("slack-db", "webhook-db"),
+		Publisher: targetPublisher,
+	})
+	require.NoError(t, err)
+	queue := &recordingTargetQueue{}
+	publisher.SetQueue(queue)
+	ctx := context.Background()
+	alerts := []*core.Alert{newDispatchAlert("fp-1", "DiskFull", nil), newDispatchAlert("fp-2", "DiskFull", nil)}
+
+	require.NoError(t, publisher.PublishGroup(ctx, &GroupNotification{Receiver: "team-db", Alerts: alerts}))
+	assert.ElementsMatch(t, []string{"slack-db/fp-1", "slack-db/fp-2", "webhook-db/fp-1", "webhook-db/fp-2"}, queue.jobs)
+	assert.Empty(t, targetPublisher.snapshot(), "queued jobs are not published synchronously")
+
+	// Rejected jobs (queue full) fail the integration so the flush is retried
+	queue.err = errors.New("queue full")
+	err = publisher.PublishIntegration(ctx, &GroupNotification{Receiver: "team-db", Alerts: alerts}, ReceiverIntegration{Name: "slack-db"})
+	assert.ErrorContains(t, err, "queue full")
+}
+
+func TestReceiverPublisher_ReceiverTemplate(t *testing.T) {
+	targets := newFakeTargets("slack-db", "webhook-db")
+	targets["slack-db"].Template = "slack-default"
+	targetPublisher := &recordingTargetPublisher{}
+	publisher, err := NewReceiverPublisher(ReceiverPublisherConfig{
+		Receivers: map[string][]string{"team-db": {"slack-db", "webhook-db"}, "team-web": {"slack-db"}},
+		Templates: map[string]ReceiverTemplate{"team-db": {Name: "team-db-incident", Version: 3}},
+		Targets:   targets,
+		Publisher: targetPublisher,
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+	alerts := []*core.Alert{newDispatchAlert("fp-1", "DiskFull", nil)}
+
+	require.NoError(t, publisher.PublishGroup(ctx, &GroupNotification{Receiver: "team-db", Alerts: alerts}))
+	assert.Equal(t, map[string]string{
+		"slack-db":   "team-db-incident@3",
+		"webhook-db": "team-db-incident@3",
+	}, targetPublisher.templates)
+	assert.Equal(t, "slack-default", targets["slack-db"].Template, "shared targets are not modified")
+
+	// Receivers without a template keep the target's template
+	require.NoError(t, publisher.PublishGroup(ctx, &GroupNotification{Receiver: "team-web", Alerts: alerts}))
+	assert.Equal(t, "slack-default@0", targetPublisher.templates["slack-db"])
+}
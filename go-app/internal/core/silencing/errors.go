@@ -77,3 +77,19 @@ var (
 	// typically during long-running MatchesAny operations with many silences.
 	ErrContextCancelled = errors.New("matching cancelled: context done")
 )
+
+// Recurring silence validation errors
+
+var (
+	// ErrRecurringInvalidSchedule indicates that the cron expression or RRULE is invalid.
+	ErrRecurringInvalidSchedule = errors.New("invalid schedule: must be a 5-field cron expression or RRULE")
+
+	// ErrRecurringInvalidTimezone indicates that the timezone is not a valid IANA location.
+	ErrRecurringInvalidTimezone = errors.New("invalid timezone: must be an IANA time zone (e.g. Europe/Berlin)")
+
+	// ErrRecurringInvalidDuration indicates that the window duration is out of range.
+	ErrRecurringInvalidDuration = errors.New("invalid duration: must be between 1 minute and 30 days")
+
+	// ErrRecurringInvalidRange indicates that the series ends before it starts.
+	ErrRecurringInvalidRange = errors.New("invalid series range: endsAt must be after startsAt")
+)
@@ -0,0 +1,199 @@
+package silencing
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Recurring window duration limits.
+const (
+	MinRecurringDuration = time.Minute
+	MaxRecurringDuration = 30 * 24 * time.Hour
+)
+
+// RecurringSilence defines a series of silences that repeat on a schedule,
+// e.g. a weekly maintenance window.
+//
+// The series itself never silences alerts: concrete Silences are
+// materialised ahead of time for each occurrence (StartsAt = occurrence,
+// EndsAt = occurrence + Duration) and are then handled like any other silence.
+//
+// Example:
+//
+//	series := &RecurringSilence{
+//	    CreatedBy: "ops@example.com",
+//	    Comment:   "Weekly database maintenance",
+//	    Schedule:  "0 22 * * SAT",
+//	    Timezone:  "Europe/Berlin",
+//	    Duration:  2 * time.Hour,
+//	    StartsAt:  time.Now(),
+//	    Matchers:  []Matcher{{Name: "team", Value: "dba", Type: MatcherTypeEqual}},
+//	}
+type RecurringSilence struct {
+	// ID is the unique identifier of the series (UUID v4).
+	ID string `json:"id"`
+
+	// CreatedBy is the creator of the series (copied to every silence).
+	CreatedBy string `json:"createdBy"`
+
+	// Comment explains the series (copied to every silence).
+	Comment string `json:"comment"`
+
+	// Matchers are the label matchers of every silence of the series.
+	Matchers []Matcher `json:"matchers"`
+
+	// Schedule is a 5-field cron expression or an RRULE (see ParseSchedule).
+	Schedule string `json:"schedule"`
+
+	// Timezone is the IANA time zone the schedule is evaluated in (default: UTC).
+	Timezone string `json:"timezone"`
+
+	// Duration is the length of every silence window.
+	Duration time.Duration `json:"duration"`
+
+	// StartsAt is the start of the series: no occurrence starts before it.
+	// It also anchors RRULE intervals and counts.
+	StartsAt time.Time `json:"startsAt"`
+
+	// EndsAt is the optional end of the series: no occurrence starts at or after it.
+	EndsAt *time.Time `json:"endsAt,omitempty"`
+
+	// CancelledAt is set when the series was cancelled.
+	CancelledAt *time.Time `json:"cancelledAt,omitempty"`
+
+	// CreatedAt is the creation time of the series.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// UpdatedAt is the time of the last edit.
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// RecurringOccurrence records a materialised occurrence of a series.
+type RecurringOccurrence struct {
+	// SeriesID is the ID of the RecurringSilence.
+	SeriesID string `json:"seriesId"`
+
+	// StartsAt is the start of the occurrence window.
+	StartsAt time.Time `json:"startsAt"`
+
+	// SilenceID is the ID of the created silence (empty while being created).
+	SilenceID string `json:"silenceId,omitempty"`
+
+	// CreatedAt is the materialisation time.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Validate validates the series, including its matchers and schedule.
+func (r *RecurringSilence) Validate() error {
+	if r.ID != "" {
+		if _, err := uuid.Parse(r.ID); err != nil {
+			return fmt.Errorf("%w: %s", ErrSilenceInvalidID, err)
+		}
+	}
+	if r.CreatedBy == "" || len(r.CreatedBy) > 255 {
+		return ErrSilenceInvalidCreatedBy
+	}
+	if len(r.Comment) < 3 || len(r.Comment) > 1024 {
+		return ErrSilenceInvalidComment
+	}
+
+	if len(r.Matchers) == 0 {
+		return ErrSilenceNoMatchers
+	}
+	if len(r.Matchers) > 100 {
+		return ErrSilenceTooManyMatchers
+	}
+	for i := range r.Matchers {
+		if err := r.Matchers[i].Validate(); err != nil {
+			return fmt.Errorf("matcher %d: %w", i, err)
+		}
+	}
+
+	if r.Duration < MinRecurringDuration || r.Duration > MaxRecurringDuration {
+		return ErrRecurringInvalidDuration
+	}
+	if r.StartsAt.IsZero() {
+		return ErrRecurringInvalidRange
+	}
+	if r.EndsAt != nil && !r.EndsAt.After(r.StartsAt) {
+		return ErrRecurringInvalidRange
+	}
+
+	_, err := r.ParseSchedule()
+	return err
+}
+
+// Location returns the time zone of the series.
+func (r *RecurringSilence) Location() (*time.Location, error) {
+	if r.Timezone == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(r.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrRecurringInvalidTimezone, r.Timezone)
+	}
+	return loc, nil
+}
+
+// ParseSchedule parses the schedule in the series time zone.
+func (r *RecurringSilence) ParseSchedule() (Schedule, error) {
+	loc, err := r.Location()
+	if err != nil {
+		return nil, err
+	}
+	return ParseSchedule(r.Schedule, loc, r.StartsAt)
+}
+
+// IsCancelled reports whether the series was cancelled.
+func (r *RecurringSilence) IsCancelled() bool {
+	return r.CancelledAt != nil
+}
+
+// Occurrences returns the start times of the windows overlapping [from, to),
+// oldest first: windows already running at from are included. At most limit
+// start times are returned (limit <= 0 means no limit).
+func (r *RecurringSilence) Occurrences(from, to time.Time, limit int) ([]time.Time, error) {
+	schedule, err := r.ParseSchedule()
+	if err != nil {
+		return nil, err
+	}
+
+	// Next is exclusive: start one tick before the series start so that an
+	// occurrence at StartsAt is included
+	cursor := from.Add(-r.Duration)
+	if seriesStart := r.StartsAt.Add(-time.Nanosecond); cursor.Before(seriesStart) {
+		cursor = seriesStart
+	}
+
+	var starts []time.Time
+	for limit <= 0 || len(starts) < limit {
+		next := schedule.Next(cursor)
+		if next.IsZero() || !next.Before(to) {
+			break
+		}
+		if r.EndsAt != nil && !next.Before(*r.EndsAt) {
+			break
+		}
+		if next.Add(r.Duration).After(from) {
+			starts = append(starts, next)
+		}
+		cursor = next
+	}
+	return starts, nil
+}
+
+// NewSilence returns the silence of the occurrence starting at start.
+func (r *RecurringSilence) NewSilence(start time.Time) *Silence {
+	matchers := make([]Matcher, len(r.Matchers))
+	copy(matchers, r.Matchers)
+
+	return &Silence{
+		CreatedBy: r.CreatedBy,
+		Comment:   r.Comment,
+		StartsAt:  start,
+		EndsAt:    start.Add(r.Duration),
+		Matchers:  matchers,
+	}
+}
@@ -0,0 +1,487 @@
+package silencing
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxScheduleScanDays bounds the search for the next occurrence of a schedule.
+// Schedules without an occurrence in this period (e.g. "0 0 30 2 *") are
+// treated as ended.
+const maxScheduleScanDays = 5 * 366
+
+// Schedule computes the start times of a recurring silence.
+type Schedule interface {
+	// Next returns the first start time strictly after t, or the zero time
+	// if the schedule has no further occurrences.
+	Next(t time.Time) time.Time
+}
+
+// ParseSchedule parses a cron expression or an RRULE.
+//
+// Cron expressions have five fields (minute hour day-of-month month
+// day-of-week) and support *, lists, ranges, steps and month/weekday names:
+//
+//	0 22 * * SAT        every Saturday 22:00
+//	30 1 1-7 * MON      01:30 on the first Monday of the month
+//	@daily, @weekly, @monthly, @yearly
+//
+// RRULEs (RFC 5545) support FREQ=DAILY|WEEKLY|MONTHLY with INTERVAL, BYDAY
+// (with ordinals for MONTHLY, e.g. 1MO, -1FR), BYMONTHDAY, BYHOUR,
+// BYMINUTE, COUNT and UNTIL:
+//
+//	FREQ=WEEKLY;INTERVAL=2;BYDAY=SA;BYHOUR=22;BYMINUTE=0
+//
+// dtstart anchors the RRULE (INTERVAL, COUNT and the defaults of BYDAY,
+// BYMONTHDAY, BYHOUR and BYMINUTE). Times are evaluated in loc.
+func ParseSchedule(expr string, loc *time.Location, dtstart time.Time) (Schedule, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return nil, fmt.Errorf("%w: empty expression", ErrRecurringInvalidSchedule)
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	upper := strings.ToUpper(expr)
+	if strings.HasPrefix(upper, "RRULE:") || strings.Contains(upper, "FREQ=") {
+		return parseRRule(strings.TrimPrefix(upper, "RRULE:"), loc, dtstart)
+	}
+	return parseCron(expr, loc)
+}
+
+// ==================== Cron ====================
+
+// cronSchedule is a parsed five-field cron expression. Each field is a bitset
+// of the accepted values.
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	domStar, dowStar              bool
+	loc                           *time.Location
+}
+
+var cronDescriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var cronMonthNames = map[string]int{
+	"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+	"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+}
+
+var cronDayNames = map[string]int{
+	"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+}
+
+func parseCron(expr string, loc *time.Location) (*cronSchedule, error) {
+	if descriptor, ok := cronDescriptors[strings.ToLower(expr)]; ok {
+		expr = descriptor
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("%w: cron expression must have 5 fields, got %d", ErrRecurringInvalidSchedule, len(fields))
+	}
+
+	s := &cronSchedule{loc: loc}
+	var err error
+	if s.minute, err = parseCronField(fields[0], 0, 59, nil); err != nil {
+		return nil, fmt.Errorf("%w: minute: %v", ErrRecurringInvalidSchedule, err)
+	}
+	if s.hour, err = parseCronField(fields[1], 0, 23, nil); err != nil {
+		return nil, fmt.Errorf("%w: hour: %v", ErrRecurringInvalidSchedule, err)
+	}
+	if s.dom, err = parseCronField(fields[2], 1, 31, nil); err != nil {
+		return nil, fmt.Errorf("%w: day of month: %v", ErrRecurringInvalidSchedule, err)
+	}
+	if s.month, err = parseCronField(fields[3], 1, 12, cronMonthNames); err != nil {
+		return nil, fmt.Errorf("%w: month: %v", ErrRecurringInvalidSchedule, err)
+	}
+	if s.dow, err = parseCronField(fields[4], 0, 7, cronDayNames); err != nil {
+		return nil, fmt.Errorf("%w: day of week: %v", ErrRecurringInvalidSchedule, err)
+	}
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1 // 7 is Sunday as well
+	}
+	s.domStar = fields[2] == "*" || fields[2] == "?"
+	s.dowStar = fields[4] == "*" || fields[4] == "?"
+	return s, nil
+}
+
+// parseCronField parses a comma-separated list of values, ranges and steps.
+func parseCronField(field string, min, max int, names map[string]int) (uint64, error) {
+	var set uint64
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			n, err := strconv.Atoi(part[i+1:])
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("invalid step %q", part)
+			}
+			step, part = n, part[:i]
+		}
+
+		low, high := min, max
+		switch {
+		case part == "*" || part == "?":
+		case strings.Contains(part, "-"):
+			bounds := strings.SplitN(part, "-", 2)
+			var err error
+			if low, err = parseCronValue(bounds[0], names); err != nil {
+				return 0, err
+			}
+			if high, err = parseCronValue(bounds[1], names); err != nil {
+				return 0, err
+			}
+		default:
+			value, err := parseCronValue(part, names)
+			if err != nil {
+				return 0, err
+			}
+			low, high = value, value
+			if step > 1 {
+				high = max // "5/15" means 5-max/15
+			}
+		}
+
+		if low < min || high > max || low > high {
+			return 0, fmt.Errorf("%q out of range %d-%d", part, min, max)
+		}
+		for v := low; v <= high; v += step {
+			set |= 1 << uint(v)
+		}
+	}
+	return set, nil
+}
+
+func parseCronValue(value string, names map[string]int) (int, error) {
+	if n, ok := names[strings.ToUpper(value)]; ok {
+		return n, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", value)
+	}
+	return n, nil
+}
+
+// Next implements Schedule.
+func (s *cronSchedule) Next(t time.Time) time.Time {
+	t = t.In(s.loc)
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, s.loc)
+
+	for i := 0; i < maxScheduleScanDays; i++ {
+		d := day.AddDate(0, 0, i)
+		if !s.matchesDay(d) {
+			continue
+		}
+		for hour := 0; hour < 24; hour++ {
+			if s.hour&(1<<uint(hour)) == 0 {
+				continue
+			}
+			for minute := 0; minute < 60; minute++ {
+				if s.minute&(1<<uint(minute)) == 0 {
+					continue
+				}
+				candidate := time.Date(d.Year(), d.Month(), d.Day(), hour, minute, 0, 0, s.loc)
+				if candidate.After(t) {
+					return candidate
+				}
+			}
+		}
+	}
+	return time.Time{}
+}
+
+// matchesDay applies the cron day rules: if both day-of-month and
+// day-of-week are restricted, either of them may match.
+func (s *cronSchedule) matchesDay(d time.Time) bool {
+	if s.month&(1<<uint(d.Month())) == 0 {
+		return false
+	}
+	domMatch := s.dom&(1<<uint(d.Day())) != 0
+	dowMatch := s.dow&(1<<uint(d.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// ==================== RRULE ====================
+
+// rruleWeekday is a BYDAY entry; ordinal is 0 for every weekday of the period.
+type rruleWeekday struct {
+	ordinal int
+	weekday time.Weekday
+}
+
+// rruleSchedule is a parsed RRULE.
+type rruleSchedule struct {
+	freq       string
+	interval   int
+	byDay      []rruleWeekday
+	byMonthDay []int
+	byHour     uint64
+	byMinute   uint64
+	count      int
+	until      time.Time
+	dtstart    time.Time
+	loc        *time.Location
+}
+
+var rruleDayNames = map[string]time.Weekday{
+	"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday,
+	"TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday,
+}
+
+func parseRRule(expr string, loc *time.Location, dtstart time.Time) (*rruleSchedule, error) {
+	if dtstart.IsZero() {
+		return nil, fmt.Errorf("%w: RRULE requires a series start", ErrRecurringInvalidSchedule)
+	}
+	dtstart = dtstart.In(loc)
+
+	r := &rruleSchedule{interval: 1, dtstart: dtstart, loc: loc}
+	for _, part := range strings.Split(strings.TrimSuffix(expr, ";"), ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("%w: invalid RRULE part %q", ErrRecurringInvalidSchedule, part)
+		}
+
+		var err error
+		switch key {
+		case "FREQ":
+			if value != "DAILY" && value != "WEEKLY" && value != "MONTHLY" {
+				return nil, fmt.Errorf("%w: unsupported FREQ %q (DAILY, WEEKLY, MONTHLY)", ErrRecurringInvalidSchedule, value)
+			}
+			r.freq = value
+		case "INTERVAL":
+			if r.interval, err = strconv.Atoi(value); err != nil || r.interval <= 0 {
+				return nil, fmt.Errorf("%w: invalid INTERVAL %q", ErrRecurringInvalidSchedule, value)
+			}
+		case "COUNT":
+			if r.count, err = strconv.Atoi(value); err != nil || r.count <= 0 {
+				return nil, fmt.Errorf("%w: invalid COUNT %q", ErrRecurringInvalidSchedule, value)
+			}
+		case "UNTIL":
+			if r.until, err = parseRRuleTime(value, loc); err != nil {
+				return nil, fmt.Errorf("%w: invalid UNTIL %q", ErrRecurringInvalidSchedule, value)
+			}
+		case "BYDAY":
+			for _, day := range strings.Split(value, ",") {
+				wd, err := parseRRuleWeekday(day)
+				if err != nil {
+					return nil, fmt.Errorf("%w: %v", ErrRecurringInvalidSchedule, err)
+				}
+				r.byDay = append(r.byDay, wd)
+			}
+		case "BYMONTHDAY":
+			for _, day := range strings.Split(value, ",") {
+				n, err := strconv.Atoi(day)
+				if err != nil || n == 0 || n < -31 || n > 31 {
+					return nil, fmt.Errorf("%w: invalid BYMONTHDAY %q", ErrRecurringInvalidSchedule, day)
+				}
+				r.byMonthDay = append(r.byMonthDay, n)
+			}
+		case "BYHOUR":
+			if r.byHour, err = parseCronField(value, 0, 23, nil); err != nil {
+				return nil, fmt.Errorf("%w: BYHOUR: %v", ErrRecurringInvalidSchedule, err)
+			}
+		case "BYMINUTE":
+			if r.byMinute, err = parseCronField(value, 0, 59, nil); err != nil {
+				return nil, fmt.Errorf("%w: BYMINUTE: %v", ErrRecurringInvalidSchedule, err)
+			}
+		case "WKST":
+			if value != "MO" {
+				return nil, fmt.Errorf("%w: only WKST=MO is supported", ErrRecurringInvalidSchedule)
+			}
+		default:
+			return nil, fmt.Errorf("%w: unsupported RRULE part %q", ErrRecurringInvalidSchedule, key)
+		}
+	}
+
+	if r.freq == "" {
+		return nil, fmt.Errorf("%w: RRULE requires FREQ", ErrRecurringInvalidSchedule)
+	}
+	if r.count > 0 && !r.until.IsZero() {
+		return nil, fmt.Errorf("%w: COUNT and UNTIL are mutually exclusive", ErrRecurringInvalidSchedule)
+	}
+	for _, wd := range r.byDay {
+		if wd.ordinal != 0 && r.freq != "MONTHLY" {
+			return nil, fmt.Errorf("%w: BYDAY ordinals require FREQ=MONTHLY", ErrRecurringInvalidSchedule)
+		}
+	}
+
+	// Defaults from the series start
+	if r.byHour == 0 {
+		r.byHour = 1 << uint(dtstart.Hour())
+	}
+	if r.byMinute == 0 {
+		r.byMinute = 1 << uint(dtstart.Minute())
+	}
+	if r.freq == "WEEKLY" && len(r.byDay) == 0 {
+		r.byDay = []rruleWeekday{{weekday: dtstart.Weekday()}}
+	}
+	if r.freq == "MONTHLY" && len(r.byDay) == 0 && len(r.byMonthDay) == 0 {
+		r.byMonthDay = []int{dtstart.Day()}
+	}
+	return r, nil
+}
+
+func parseRRuleWeekday(value string) (rruleWeekday, error) {
+	if len(value) < 2 {
+		return rruleWeekday{}, fmt.Errorf("invalid BYDAY %q", value)
+	}
+	weekday, ok := rruleDayNames[value[len(value)-2:]]
+	if !ok {
+		return rruleWeekday{}, fmt.Errorf("invalid BYDAY %q", value)
+	}
+	ordinal := 0
+	if prefix := value[:len(value)-2]; prefix != "" {
+		n, err := strconv.Atoi(prefix)
+		if err != nil || n == 0 || n < -5 || n > 5 {
+			return rruleWeekday{}, fmt.Errorf("invalid BYDAY %q", value)
+		}
+		ordinal = n
+	}
+	return rruleWeekday{ordinal: ordinal, weekday: weekday}, nil
+}
+
+func parseRRuleTime(value string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("20060102T150405", value, loc); err == nil {
+		return t, nil
+	}
+	t, err := time.ParseInLocation("20060102", value, loc)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.AddDate(0, 0, 1).Add(-time.Second), nil // whole day
+}
+
+// Next implements Schedule.
+func (r *rruleSchedule) Next(t time.Time) time.Time {
+	t = t.In(r.loc)
+
+	// COUNT needs all occurrences from the series start, otherwise the scan
+	// can start at t
+	start := t
+	if r.count > 0 || t.Before(r.dtstart) {
+		start = r.dtstart
+	}
+	day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, r.loc)
+	seen := 0
+
+	for i := 0; i < maxScheduleScanDays+int(t.Sub(start).Hours()/24); i++ {
+		d := day.AddDate(0, 0, i)
+		if !r.matchesDay(d) {
+			continue
+		}
+		for hour := 0; hour < 24; hour++ {
+			if r.byHour&(1<<uint(hour)) == 0 {
+				continue
+			}
+			for minute := 0; minute < 60; minute++ {
+				if r.byMinute&(1<<uint(minute)) == 0 {
+					continue
+				}
+				candidate := time.Date(d.Year(), d.Month(), d.Day(), hour, minute, 0, 0, r.loc)
+				if candidate.Before(r.dtstart) {
+					continue
+				}
+				if !r.until.IsZero() && candidate.After(r.until) {
+					return time.Time{}
+				}
+				seen++
+				if r.count > 0 && seen > r.count {
+					return time.Time{}
+				}
+				if candidate.After(t) {
+					return candidate
+				}
+			}
+		}
+	}
+	return time.Time{}
+}
+
+// matchesDay checks the FREQ/INTERVAL period and the BYDAY/BYMONTHDAY rules.
+func (r *rruleSchedule) matchesDay(d time.Time) bool {
+	switch r.freq {
+	case "DAILY":
+		if daysBetween(r.dtstart, d)%r.interval != 0 {
+			return false
+		}
+	case "WEEKLY":
+		weeks := daysBetween(startOfWeek(r.dtstart), startOfWeek(d)) / 7
+		if weeks%r.interval != 0 {
+			return false
+		}
+	case "MONTHLY":
+		months := (d.Year()-r.dtstart.Year())*12 + int(d.Month()) - int(r.dtstart.Month())
+		if months%r.interval != 0 {
+			return false
+		}
+	}
+
+	if len(r.byMonthDay) > 0 && !matchesMonthDay(d, r.byMonthDay) {
+		return false
+	}
+	if len(r.byDay) > 0 && !matchesWeekday(d, r.byDay) {
+		return false
+	}
+	return true
+}
+
+func matchesMonthDay(d time.Time, days []int) bool {
+	last := daysInMonth(d)
+	for _, day := range days {
+		if day == d.Day() || (day < 0 && last+day+1 == d.Day()) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesWeekday(d time.Time, weekdays []rruleWeekday) bool {
+	for _, wd := range weekdays {
+		if wd.weekday != d.Weekday() {
+			continue
+		}
+		switch {
+		case wd.ordinal == 0:
+			return true
+		case wd.ordinal > 0 && (d.Day()-1)/7+1 == wd.ordinal:
+			return true
+		case wd.ordinal < 0 && (daysInMonth(d)-d.Day())/7+1 == -wd.ordinal:
+			return true
+		}
+	}
+	return false
+}
+
+// daysBetween returns the number of calendar days from a to b.
+func daysBetween(a, b time.Time) int {
+	da := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	db := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	return int(db.Sub(da).Hours() / 24)
+}
+
+// startOfWeek returns the Monday of the week of t (WKST=MO).
+func startOfWeek(t time.Time) time.Time {
+	offset := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, t.Location())
+}
+
+func daysInMonth(t time.Time) int {
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
@@ -0,0 +1,247 @@
+package silencing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	require.NoError(t, err)
+	return loc
+}
+
+// nextN returns the next n start times after t.
+func nextN(schedule Schedule, t time.Time, n int) []time.Time {
+	var result []time.Time
+	for i := 0; i < n; i++ {
+		t = schedule.Next(t)
+		if t.IsZero() {
+			break
+		}
+		result = append(result, t)
+	}
+	return result
+}
+
+func TestParseSchedule_Cron(t *testing.T) {
+	// Saturday 2025-11-01 10:00 UTC
+	from := time.Date(2025, 11, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		expr string
+		want []time.Time
+	}{
+		{
+			name: "weekly by name",
+			expr: "0 22 * * SAT",
+			want: []time.Time{
+				time.Date(2025, 11, 1, 22, 0, 0, 0, time.UTC),
+				time.Date(2025, 11, 8, 22, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "steps and lists",
+			expr: "*/30 9,10 * * *",
+			want: []time.Time{
+				time.Date(2025, 11, 1, 10, 30, 0, 0, time.UTC),
+				time.Date(2025, 11, 2, 9, 0, 0, 0, time.UTC),
+				time.Date(2025, 11, 2, 9, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "day of month or weekday",
+			expr: "0 0 15 * MON",
+			want: []time.Time{
+				time.Date(2025, 11, 3, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, 11, 10, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, 11, 15, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "sunday as 7",
+			expr: "0 3 * * 7",
+			want: []time.Time{time.Date(2025, 11, 2, 3, 0, 0, 0, time.UTC)},
+		},
+		{
+			name: "descriptor",
+			expr: "@monthly",
+			want: []time.Time{time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := ParseSchedule(tt.expr, time.UTC, time.Time{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, nextN(schedule, from, len(tt.want)))
+		})
+	}
+}
+
+func TestParseSchedule_CronTimezone(t *testing.T) {
+	berlin := mustLocation(t, "Europe/Berlin")
+	schedule, err := ParseSchedule("0 22 * * SAT", berlin, time.Time{})
+	require.NoError(t, err)
+
+	// 22:00 local stays 22:00 across the DST change on 2025-10-26
+	starts := nextN(schedule, time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC), 2)
+	require.Len(t, starts, 2)
+	assert.Equal(t, time.Date(2025, 10, 25, 20, 0, 0, 0, time.UTC), starts[0].UTC())
+	assert.Equal(t, time.Date(2025, 11, 1, 21, 0, 0, 0, time.UTC), starts[1].UTC())
+}
+
+func TestParseSchedule_RRule(t *testing.T) {
+	// Saturday 2025-11-01 22:00 UTC
+	dtstart := time.Date(2025, 11, 1, 22, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		expr string
+		want []time.Time
+	}{
+		{
+			name: "bi-weekly defaults from dtstart",
+			expr: "FREQ=WEEKLY;INTERVAL=2",
+			want: []time.Time{
+				dtstart,
+				time.Date(2025, 11, 15, 22, 0, 0, 0, time.UTC),
+				time.Date(2025, 11, 29, 22, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "weekly by days and time",
+			expr: "RRULE:FREQ=WEEKLY;BYDAY=TU,TH;BYHOUR=6;BYMINUTE=30",
+			want: []time.Time{
+				time.Date(2025, 11, 4, 6, 30, 0, 0, time.UTC),
+				time.Date(2025, 11, 6, 6, 30, 0, 0, time.UTC),
+				time.Date(2025, 11, 11, 6, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "last friday of the month",
+			expr: "FREQ=MONTHLY;BYDAY=-1FR;BYHOUR=18",
+			want: []time.Time{
+				time.Date(2025, 11, 28, 18, 0, 0, 0, time.UTC),
+				time.Date(2025, 12, 26, 18, 0, 0, 0, time.UTC),
+				time.Date(2026, 1, 30, 18, 0, 0, 0, time.UTC),
+			},
+		},
+		// COUNT and UNTIL end the series after two occurrences
+		{
+			name: "count",
+			expr: "FREQ=DAILY;COUNT=2",
+			want: []time.Time{dtstart, time.Date(2025, 11, 2, 22, 0, 0, 0, time.UTC)},
+		},
+		{
+			name: "until",
+			expr: "FREQ=DAILY;UNTIL=20251102",
+			want: []time.Time{dtstart, time.Date(2025, 11, 2, 22, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := ParseSchedule(tt.expr, time.UTC, dtstart)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, nextN(schedule, dtstart.Add(-time.Minute), 3))
+		})
+	}
+}
+
+func TestParseSchedule_Invalid(t *testing.T) {
+	dtstart := time.Now()
+	for _, expr := range []string{
+		"",
+		"0 22 * *",
+		"60 * * * *",
+		"0 22 * * FUNDAY",
+		"*/0 * * * *",
+		"FREQ=YEARLY",
+		"FREQ=WEEKLY;BYDAY=1MO",
+		"FREQ=DAILY;COUNT=2;UNTIL=20251102",
+		"FREQ=DAILY;BYSETPOS=1",
+		"INTERVAL=2",
+	} {
+		_, err := ParseSchedule(expr, time.UTC, dtstart)
+		assert.ErrorIs(t, err, ErrRecurringInvalidSchedule, expr)
+	}
+}
+
+func TestRecurringSilence_Occurrences(t *testing.T) {
+	series := &RecurringSilence{
+		CreatedBy: "ops@example.com",
+		Comment:   "Nightly backup window",
+		Schedule:  "0 2 * * *",
+		Duration:  2 * time.Hour,
+		StartsAt:  time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC),
+		Matchers:  []Matcher{{Name: "job", Value: "backup", Type: MatcherTypeEqual}},
+	}
+	require.NoError(t, series.Validate())
+
+	// The window running at from (02:00-04:00) is included
+	from := time.Date(2025, 11, 3, 3, 0, 0, 0, time.UTC)
+	starts, err := series.Occurrences(from, from.Add(48*time.Hour), 0)
+	require.NoError(t, err)
+	assert.Equal(t, []time.Time{
+		time.Date(2025, 11, 3, 2, 0, 0, 0, time.UTC),
+		time.Date(2025, 11, 4, 2, 0, 0, 0, time.UTC),
+		time.Date(2025, 11, 5, 2, 0, 0, 0, time.UTC),
+	}, starts)
+
+	// Series bounds and limit
+	endsAt := time.Date(2025, 11, 5, 0, 0, 0, 0, time.UTC)
+	series.EndsAt = &endsAt
+	starts, err = series.Occurrences(series.StartsAt.Add(-24*time.Hour), from.Add(48*time.Hour), 3)
+	require.NoError(t, err)
+	assert.Equal(t, []time.Time{
+		time.Date(2025, 11, 1, 2, 0, 0, 0, time.UTC),
+		time.Date(2025, 11, 2, 2, 0, 0, 0, time.UTC),
+		time.Date(2025, 11, 3, 2, 0, 0, 0, time.UTC),
+	}, starts)
+
+	silence := series.NewSilence(starts[0])
+	assert.Equal(t, starts[0].Add(2*time.Hour), silence.EndsAt)
+	assert.NoError(t, silence.Validate())
+}
+
+func TestRecurringSilence_Validate(t *testing.T) {
+	valid := func() *RecurringSilence {
+		return &RecurringSilence{
+			CreatedBy: "ops@example.com",
+			Comment:   "Weekly maintenance",
+			Schedule:  "0 22 * * SAT",
+			Timezone:  "Europe/Berlin",
+			Duration:  2 * time.Hour,
+			StartsAt:  time.Now(),
+			Matchers:  []Matcher{{Name: "team", Value: "dba", Type: MatcherTypeEqual}},
+		}
+	}
+	require.NoError(t, valid().Validate())
+
+	series := valid()
+	series.Timezone = "Mars/Olympus"
+	assert.ErrorIs(t, series.Validate(), ErrRecurringInvalidTimezone)
+
+	series = valid()
+	series.Duration = 10 * time.Second
+	assert.ErrorIs(t, series.Validate(), ErrRecurringInvalidDuration)
+
+	series = valid()
+	endsAt := series.StartsAt.Add(-time.Hour)
+	series.EndsAt = &endsAt
+	assert.ErrorIs(t, series.Validate(), ErrRecurringInvalidRange)
+
+	series = valid()
+	series.Schedule = "every saturday"
+	assert.ErrorIs(t, series.Validate(), ErrRecurringInvalidSchedule)
+
+	series = valid()
+	series.Matchers = nil
+	assert.ErrorIs(t, series.Validate(), ErrSilenceNoMatchers)
+}
@@ -14,6 +14,8 @@ package grouping
 import (
 	"fmt"
 	"time"
+
+	amconfig "github.com/vitaliisemenov/alert-history/internal/alertmanager/config"
 )
 
 // GroupingConfig represents the complete alert grouping configuration.
@@ -21,6 +23,40 @@ import (
 type GroupingConfig struct {
 	// Route is the root route configuration with grouping parameters
 	Route *Route `yaml:"route" validate:"required"`
+
+	// TimeIntervals defines named time intervals referenced by routes
+	// via mute_time_intervals / active_time_intervals
+	TimeIntervals []amconfig.MuteTimeInterval `yaml:"time_intervals,omitempty" validate:"dive"`
+
+	// MuteTimeIntervals is the legacy name for TimeIntervals (Alertmanager < 0.24)
+	MuteTimeIntervals []amconfig.MuteTimeInterval `yaml:"mute_time_intervals,omitempty" validate:"dive"`
+
+	// Receivers maps receiver names to publishing targets. A receiver
+	// without an entry is delivered to the publishing target of the same name.
+	Receivers []Receiver `yaml:"receivers,omitempty" validate:"dive"`
+}
+
+// Receiver lists the publishing targets (integrations) of a receiver.
+//
+// Example:
+//
+//	receivers:
+//	  - name: 'team-db'
+//	    targets: ['slack-db', 'pagerduty-db']
+//	    template: 'team-db-incident'
+type Receiver struct {
+	// Name is the receiver name referenced by routes
+	Name string `yaml:"name" validate:"required"`
+
+	// Targets are publishing target names, in integration index order
+	Targets []string `yaml:"targets,omitempty"`
+
+	// Template references a managed template (/api/v2/templates) rendering
+	// the receiver's notifications; it overrides the targets' template.
+	Template string `yaml:"template,omitempty"`
+
+	// TemplateVersion pins a template version, 0 follows the current version.
+	TemplateVersion int `yaml:"template_version,omitempty" validate:"gte=0"`
 }
 
 // Route defines a routing path with grouping parameters.
@@ -73,6 +109,16 @@ type Route struct {
 	// Default: false
 	Continue bool `yaml:"continue,omitempty"`
 
+	// MuteTimeIntervals lists named time intervals during which
+	// notifications for this route are muted.
+	// Example: mute_time_intervals: ["weekends", "holidays"]
+	MuteTimeIntervals []string `yaml:"mute_time_intervals,omitempty"`
+
+	// ActiveTimeIntervals lists named time intervals outside of which
+	// notifications for this route are muted.
+	// Example: active_time_intervals: ["business-hours"]
+	ActiveTimeIntervals []string `yaml:"active_time_intervals,omitempty"`
+
 	// Routes contains nested child routes for hierarchical routing.
 	// Child routes inherit parent's grouping settings unless overridden.
 	Routes []*Route `yaml:"routes,omitempty"`
@@ -229,6 +275,13 @@ func (r *Route) Clone() *Route {
 
 	copy(clone.GroupBy, r.GroupBy)
 
+	if r.MuteTimeIntervals != nil {
+		clone.MuteTimeIntervals = append([]string(nil), r.MuteTimeIntervals...)
+	}
+	if r.ActiveTimeIntervals != nil {
+		clone.ActiveTimeIntervals = append([]string(nil), r.ActiveTimeIntervals...)
+	}
+
 	if r.GroupWait != nil {
 		clone.GroupWait = &Duration{r.GroupWait.Duration}
 	}
@@ -89,6 +89,11 @@ type AlertGroup struct {
 	// Redis storage will reject Store if version mismatch detected
 	Version int64 `json:"version"`
 
+	// Summary is the latest incident summary of the group, produced at flush
+	// time (nil if not summarised). It describes the alerts identified by
+	// Summary.MembershipHash, which may lag behind the current membership.
+	Summary *core.GroupSummary `json:"summary,omitempty"`
+
 	// mu protects concurrent access to Alerts and Metadata
 	// 150% Enhancement: Thread-safe by design
 	mu sync.RWMutex `json:"-"`
@@ -214,10 +219,12 @@ func (g *AlertGroup) Clone() *AlertGroup {
 		copy(metadataCopy.GroupBy, g.Metadata.GroupBy)
 	}
 
+	// Summary is replaced, never mutated: share the pointer
 	return &AlertGroup{
 		Key:      g.Key,
 		Alerts:   alertsCopy,
 		Metadata: &metadataCopy,
+		Summary:  g.Summary,
 	}
 }
 
@@ -435,6 +442,15 @@ type AlertGroupManager interface {
 	// Thread-safe: Yes
 	UpdateGroupState(ctx context.Context, groupKey GroupKey) (*AlertGroup, error)
 
+	// SetGroupSummary stores the incident summary of a group (replacing any
+	// previous summary).
+	//
+	// Returns:
+	//   - error: GroupNotFoundError, StorageError
+	//
+	// Thread-safe: Yes
+	SetGroupSummary(ctx context.Context, groupKey GroupKey, summary *core.GroupSummary) error
+
 	// CleanupExpiredGroups deletes groups that are inactive for more than maxAge.
 	//
 	// A group is considered expired if:
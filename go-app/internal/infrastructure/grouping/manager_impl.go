@@ -352,6 +352,44 @@ func (m *DefaultGroupManager) UpdateGroupState(
 	return group.Clone(), nil
 }
 
+// SetGroupSummary implements AlertGroupManager.SetGroupSummary.
+func (m *DefaultGroupManager) SetGroupSummary(
+	ctx context.Context,
+	groupKey GroupKey,
+	summary *core.GroupSummary,
+) error {
+	// Check context cancellation
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	// Load group from storage (TN-125)
+	group, err := m.storage.Load(ctx, groupKey)
+	if err != nil {
+		return err
+	}
+
+	group.mu.Lock()
+	group.Summary = summary
+	group.mu.Unlock()
+
+	// Persist updated group (TN-125)
+	if storeErr := m.storage.Store(ctx, group); storeErr != nil {
+		m.logger.Error("failed to persist group after summary update",
+			"group_key", groupKey,
+			"error", storeErr)
+		return fmt.Errorf("store group: %w", storeErr)
+	}
+
+	m.logger.Debug("updated group summary",
+		"group_key", groupKey,
+		"membership_hash", summary.MembershipHash)
+
+	return nil
+}
+
 // CleanupExpiredGroups implements AlertGroupManager.CleanupExpiredGroups.
 func (m *DefaultGroupManager) CleanupExpiredGroups(
 	ctx context.Context,
@@ -535,6 +535,38 @@ func TestCleanupExpiredGroups_NoExpiredGroups(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// === SetGroupSummary Tests ===
+
+func TestSetGroupSummary(t *testing.T) {
+	manager := createTestManager(t)
+	ctx := context.Background()
+
+	groupKey := GroupKey("alertname=Test")
+	alert := createTestAlert("Test-1", core.StatusFiring, map[string]string{})
+	_, err := manager.AddAlertToGroup(ctx, alert, groupKey)
+	require.NoError(t, err)
+
+	summary := &core.GroupSummary{
+		Summary:        "Test is firing",
+		MembershipHash: core.GroupMembershipHash([]*core.Alert{alert}),
+	}
+	require.NoError(t, manager.SetGroupSummary(ctx, groupKey, summary))
+
+	group, err := manager.GetGroup(ctx, groupKey)
+	require.NoError(t, err)
+	assert.Equal(t, summary, group.Summary)
+
+	// Summary survives membership updates
+	_, err = manager.AddAlertToGroup(ctx, createTestAlert("Test-2", core.StatusFiring, map[string]string{}), groupKey)
+	require.NoError(t, err)
+	group, err = manager.GetGroup(ctx, groupKey)
+	require.NoError(t, err)
+	assert.Equal(t, summary, group.Summary)
+
+	err = manager.SetGroupSummary(ctx, GroupKey("alertname=Missing"), summary)
+	assert.Error(t, err)
+}
+
 // === UpdateGroupState Tests ===
 
 func TestUpdateGroupState_AllFiring(t *testing.T) {
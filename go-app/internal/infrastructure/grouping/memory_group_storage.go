@@ -149,6 +149,7 @@ func (m *MemoryGroupStorage) Store(ctx context.Context, group *AlertGroup) error
 		Alerts:   make(map[string]*core.Alert, len(group.Alerts)),
 		Metadata: m.copyMetadata(group.Metadata),
 		Version:  group.Version,
+		Summary:  group.Summary,
 	}
 
 	// Copy alerts map
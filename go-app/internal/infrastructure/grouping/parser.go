@@ -6,6 +6,8 @@ import (
 
 	"github.com/go-playground/validator/v10"
 	"gopkg.in/yaml.v3"
+
+	amconfig "github.com/vitaliisemenov/alert-history/internal/alertmanager/config"
 )
 
 // Parser defines the interface for parsing grouping configuration.
@@ -144,6 +146,33 @@ func (p *DefaultParser) validateSemantics(config *GroupingConfig) error {
 	// Validate the route tree recursively
 	p.validateRouteSemantics(config.Route, &errors, "route")
 
+	// Validate time interval references
+	intervals := make(map[string]struct{})
+	for _, list := range [][]amconfig.MuteTimeInterval{config.TimeIntervals, config.MuteTimeIntervals} {
+		for _, interval := range list {
+			if _, exists := intervals[interval.Name]; exists {
+				errors.Add("time_intervals", interval.Name, "duplicate",
+					fmt.Sprintf("time interval '%s' is defined more than once", interval.Name))
+			}
+			intervals[interval.Name] = struct{}{}
+		}
+	}
+	validateTimeIntervalRefs(config.Route, intervals, &errors, "route")
+
+	receivers := make(map[string]struct{})
+	for _, receiver := range config.Receivers {
+		if _, exists := receivers[receiver.Name]; exists {
+			errors.Add("receivers", receiver.Name, "duplicate",
+				fmt.Sprintf("receiver '%s' is defined more than once", receiver.Name))
+		}
+		receivers[receiver.Name] = struct{}{}
+
+		if receiver.TemplateVersion != 0 && receiver.Template == "" {
+			errors.Add("receivers", receiver.Name, "template_version",
+				fmt.Sprintf("receiver '%s' pins template_version without a template", receiver.Name))
+		}
+	}
+
 	if errors.HasErrors() {
 		return errors
 	}
@@ -231,6 +260,27 @@ func (p *DefaultParser) validateRouteSemantics(route *Route, errors *ValidationE
 	}
 }
 
+// validateTimeIntervalRefs checks that every mute_time_intervals/active_time_intervals
+// entry references a defined time interval.
+func validateTimeIntervalRefs(route *Route, intervals map[string]struct{}, errors *ValidationErrors, path string) {
+	for _, name := range route.MuteTimeIntervals {
+		if _, ok := intervals[name]; !ok {
+			errors.Add(fmt.Sprintf("%s.mute_time_intervals", path), name, "undefined",
+				fmt.Sprintf("time interval '%s' is not defined", name))
+		}
+	}
+	for _, name := range route.ActiveTimeIntervals {
+		if _, ok := intervals[name]; !ok {
+			errors.Add(fmt.Sprintf("%s.active_time_intervals", path), name, "undefined",
+				fmt.Sprintf("time interval '%s' is not defined", name))
+		}
+	}
+
+	for i, nestedRoute := range route.Routes {
+		validateTimeIntervalRefs(nestedRoute, intervals, errors, fmt.Sprintf("%s.routes[%d]", path, i))
+	}
+}
+
 // applyRouteDefaults recursively applies default values to a route and its nested routes.
 func applyRouteDefaults(route *Route) {
 	if route == nil {
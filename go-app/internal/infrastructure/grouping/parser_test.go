@@ -256,6 +256,94 @@ route:
 	assert.Equal(t, "test", config.Route.Receiver)
 }
 
+// TestParser_TimeIntervals tests time interval parsing and route references
+func TestParser_TimeIntervals(t *testing.T) {
+	parser := NewParser()
+
+	t.Run("valid_references", func(t *testing.T) {
+		config, err := parser.ParseString(`
+route:
+  receiver: "default"
+  routes:
+    - receiver: "pager"
+      match:
+        severity: critical
+      active_time_intervals: ["business-hours"]
+      mute_time_intervals: ["weekends"]
+time_intervals:
+  - name: business-hours
+    time_intervals:
+      - times:
+          - start_time: "09:00"
+            end_time: "17:00"
+        weekdays: ["monday:friday"]
+        location: "Europe/Berlin"
+mute_time_intervals:
+  - name: weekends
+    time_intervals:
+      - weekdays: ["saturday", "sunday"]
+`)
+		require.NoError(t, err)
+		require.Len(t, config.TimeIntervals, 1)
+		require.Len(t, config.MuteTimeIntervals, 1)
+		assert.Equal(t, "Europe/Berlin", config.TimeIntervals[0].TimeIntervals[0].Location)
+		assert.Equal(t, []string{"business-hours"}, config.Route.Routes[0].ActiveTimeIntervals)
+		assert.Equal(t, []string{"weekends"}, config.Route.Routes[0].MuteTimeIntervals)
+	})
+
+	t.Run("undefined_reference", func(t *testing.T) {
+		_, err := parser.ParseString(`
+route:
+  receiver: "default"
+  mute_time_intervals: ["holidays"]
+`)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "holidays")
+	})
+}
+
+// TestParser_Receivers tests receiver → publishing target mappings
+func TestParser_Receivers(t *testing.T) {
+	parser := NewParser()
+
+	config, err := parser.ParseString(`
+route:
+  receiver: "team-db"
+receivers:
+  - name: team-db
+    targets: ["slack-db", "pagerduty-db"]
+    template: team-db-incident
+    template_version: 3
+  - name: default
+`)
+	require.NoError(t, err)
+	require.Len(t, config.Receivers, 2)
+	assert.Equal(t, []string{"slack-db", "pagerduty-db"}, config.Receivers[0].Targets)
+	assert.Equal(t, "team-db-incident", config.Receivers[0].Template)
+	assert.Equal(t, 3, config.Receivers[0].TemplateVersion)
+	assert.Empty(t, config.Receivers[1].Targets)
+
+	_, err = parser.ParseString(`
+route:
+  receiver: "default"
+receivers:
+  - name: default
+    template_version: 2
+`)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "without a template")
+
+	_, err = parser.ParseString(`
+route:
+  receiver: "default"
+receivers:
+  - name: default
+  - name: default
+`)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "defined more than once")
+}
+
 // TestParser_ParseFile tests file parsing
 func TestParser_ParseFile(t *testing.T) {
 	parser := NewParser()
@@ -591,6 +591,16 @@ func (tm *DefaultTimerManager) onTimerExpired(ctx context.Context, groupKey Grou
 		}
 	}()
 
+	// Detach the expired handle before invoking callbacks, so that a callback
+	// scheduling the next timer (group_wait → group_interval) does not cancel
+	// the context it is running under.
+	tm.timersMu.Lock()
+	expired := tm.timers[groupKey]
+	if expired != nil && expired.timerType == timerType {
+		delete(tm.timers, groupKey)
+	}
+	tm.timersMu.Unlock()
+
 	// Get group snapshot
 	groupCtx, groupCancel := context.WithTimeout(ctx, 5*time.Second)
 	defer groupCancel()
@@ -600,6 +610,7 @@ func (tm *DefaultTimerManager) onTimerExpired(ctx context.Context, groupKey Grou
 		tm.logger.Error("Failed to get group for timer expiration",
 			"group_key", groupKey,
 			"error", err)
+		tm.deleteExpiredTimer(ctx, groupKey)
 		return
 	}
 
@@ -621,20 +632,8 @@ func (tm *DefaultTimerManager) onTimerExpired(ctx context.Context, groupKey Grou
 		callbackCancel()
 	}
 
-	// Remove from active timers
-	tm.timersMu.Lock()
-	delete(tm.timers, groupKey)
-	tm.timersMu.Unlock()
-
-	// Delete from storage
-	deleteCtx, deleteCancel := context.WithTimeout(ctx, 5*time.Second)
-	defer deleteCancel()
-
-	if err := tm.storage.DeleteTimer(deleteCtx, groupKey); err != nil {
-		tm.logger.Warn("Failed to delete expired timer from storage",
-			"group_key", groupKey,
-			"error", err)
-	}
+	// Delete from storage unless a callback already scheduled a follow-up timer
+	tm.deleteExpiredTimer(ctx, groupKey)
 
 	// Update statistics
 	tm.statsMu.Lock()
@@ -653,6 +652,29 @@ func (tm *DefaultTimerManager) onTimerExpired(ctx context.Context, groupKey Grou
 		"lock_id", lockID)
 }
 
+// deleteExpiredTimer removes an expired timer from storage.
+//
+// Skipped when a new timer has been registered for the same group in the
+// meantime (e.g. a callback started the group_interval timer), since the
+// storage entry now belongs to that timer.
+func (tm *DefaultTimerManager) deleteExpiredTimer(ctx context.Context, groupKey GroupKey) {
+	tm.timersMu.RLock()
+	_, replaced := tm.timers[groupKey]
+	tm.timersMu.RUnlock()
+	if replaced {
+		return
+	}
+
+	deleteCtx, deleteCancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer deleteCancel()
+
+	if err := tm.storage.DeleteTimer(deleteCtx, groupKey); err != nil {
+		tm.logger.Warn("Failed to delete expired timer from storage",
+			"group_key", groupKey,
+			"error", err)
+	}
+}
+
 // RestoreTimers recovers timers from storage after restart.
 //
 // Algorithm:
@@ -238,9 +238,9 @@ func (m *DefaultInhibitionMatcher) FindInhibitors(
 //
 // Core matching logic (pure function, no I/O):
 //  1. Check source_match (exact label matching)
-//  2. Check source_match_re (regex label matching)
+//  2. Check source_match_re (regex label matching) and source_matchers
 //  3. Check target_match (exact label matching)
-//  4. Check target_match_re (regex label matching)
+//  4. Check target_match_re (regex label matching) and target_matchers
 //  5. Check equal labels (must have same value in both alerts)
 //
 // All conditions must match (AND logic).
@@ -291,6 +291,12 @@ func (m *DefaultInhibitionMatcher) matchRuleFast(
 		}
 	}
 
+	// 2b. Check source_matchers (Alertmanager matcher syntax)
+	if len(rule.SourceMatchers) > 0 &&
+		(rule.parsedSourceMatchers == nil || !matchAll(rule.parsedSourceMatchers, sourceAlert.Labels)) {
+		return false // Early exit (unparsed matchers never match)
+	}
+
 	// 3. Check target_match conditions (exact matching) - INLINED
 	for key, requiredValue := range rule.TargetMatch {
 		actualValue, exists := targetAlert.Labels[key]
@@ -312,6 +318,12 @@ func (m *DefaultInhibitionMatcher) matchRuleFast(
 		}
 	}
 
+	// 4b. Check target_matchers (Alertmanager matcher syntax)
+	if len(rule.TargetMatchers) > 0 &&
+		(rule.parsedTargetMatchers == nil || !matchAll(rule.parsedTargetMatchers, targetAlert.Labels)) {
+		return false // Early exit (unparsed matchers never match)
+	}
+
 	// 5. Check equal labels (must match between source and target) - INLINED
 	for _, labelName := range rule.Equal {
 		sourceVal, sourceOk := sourceAlert.Labels[labelName]
@@ -0,0 +1,249 @@
+package inhibition
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// MatchType is the operator of a label matcher.
+type MatchType string
+
+const (
+	// MatchEqual matches labels equal to the value (label="value").
+	MatchEqual MatchType = "="
+
+	// MatchNotEqual matches labels not equal to the value (label!="value").
+	MatchNotEqual MatchType = "!="
+
+	// MatchRegexp matches labels fully matching the regex (label=~"regex").
+	MatchRegexp MatchType = "=~"
+
+	// MatchNotRegexp matches labels not fully matching the regex (label!~"regex").
+	MatchNotRegexp MatchType = "!~"
+)
+
+// Matcher is a parsed label matcher of source_matchers/target_matchers.
+//
+// Semantics follow Alertmanager:
+//   - A missing label is treated as the empty string, so env!="dev" matches
+//     alerts without an env label and foo="" matches alerts without foo
+//   - Regexes are fully anchored: severity=~"crit" does not match "critical"
+type Matcher struct {
+	// Name is the label name
+	Name string
+
+	// Type is the match operator (=, !=, =~, !~)
+	Type MatchType
+
+	// Value is the label value or regex pattern
+	Value string
+
+	// re is the anchored compiled regex (=~ and !~ only)
+	re *regexp.Regexp
+}
+
+// NewLabelMatcher creates a matcher, compiling the regex for =~ and !~.
+//
+// Returns:
+//   - *Matcher: ready-to-use matcher
+//   - error: invalid label name, unknown operator or invalid regex
+func NewLabelMatcher(t MatchType, name, value string) (*Matcher, error) {
+	if !isValidLabelName(name) {
+		return nil, fmt.Errorf("invalid label name: %q", name)
+	}
+
+	m := &Matcher{Name: name, Type: t, Value: value}
+	switch t {
+	case MatchEqual, MatchNotEqual:
+	case MatchRegexp, MatchNotRegexp:
+		re, err := regexp.Compile("^(?:" + value + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("invalid regex %q: %w", value, err)
+		}
+		m.re = re
+	default:
+		return nil, fmt.Errorf("unknown match type: %q", t)
+	}
+
+	return m, nil
+}
+
+// Matches reports whether the label set satisfies the matcher.
+func (m *Matcher) Matches(labels map[string]string) bool {
+	value := labels[m.Name] // Missing label == ""
+
+	switch m.Type {
+	case MatchEqual:
+		return value == m.Value
+	case MatchNotEqual:
+		return value != m.Value
+	case MatchRegexp:
+		return m.re.MatchString(value)
+	case MatchNotRegexp:
+		return !m.re.MatchString(value)
+	default:
+		return false
+	}
+}
+
+// String returns the matcher in Alertmanager syntax (label=~"value").
+func (m *Matcher) String() string {
+	return m.Name + string(m.Type) + strconv.Quote(m.Value)
+}
+
+// ParseMatchers parses a matcher expression in Alertmanager syntax.
+//
+// Supported forms (values may be quoted or unquoted):
+//
+//	severity="critical"
+//	severity=~"critical|page", env!="dev"
+//	{alertname="NodeDown", cluster=~"prod-.*"}
+//
+// Returns:
+//   - []*Matcher: parsed matchers (empty for "{}")
+//   - error: syntax error, invalid label name or invalid regex
+func ParseMatchers(expr string) ([]*Matcher, error) {
+	s := strings.TrimSpace(expr)
+	if strings.HasPrefix(s, "{") {
+		if !strings.HasSuffix(s, "}") {
+			return nil, fmt.Errorf("matchers %q: missing closing '}'", expr)
+		}
+		s = strings.TrimSpace(s[1 : len(s)-1])
+	} else if strings.HasSuffix(s, "}") {
+		return nil, fmt.Errorf("matchers %q: missing opening '{'", expr)
+	}
+
+	if s == "" {
+		return []*Matcher{}, nil
+	}
+
+	parts, err := splitMatchers(s)
+	if err != nil {
+		return nil, fmt.Errorf("matchers %q: %w", expr, err)
+	}
+
+	matchers := make([]*Matcher, 0, len(parts))
+	for _, part := range parts {
+		m, err := parseMatcher(part)
+		if err != nil {
+			return nil, fmt.Errorf("matcher %q: %w", strings.TrimSpace(part), err)
+		}
+		matchers = append(matchers, m)
+	}
+
+	return matchers, nil
+}
+
+// parseMatcherList parses a source_matchers/target_matchers list.
+// Every element may itself contain several comma-separated matchers.
+func parseMatcherList(exprs []string) ([]*Matcher, error) {
+	matchers := make([]*Matcher, 0, len(exprs))
+	for _, expr := range exprs {
+		parsed, err := ParseMatchers(expr)
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, parsed...)
+	}
+	return matchers, nil
+}
+
+// splitMatchers splits on commas outside of quoted values.
+// A trailing comma is allowed.
+func splitMatchers(s string) ([]string, error) {
+	var parts []string
+	inQuotes, escaped := false, false
+	start := 0
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case escaped:
+			escaped = false
+		case c == '\\' && inQuotes:
+			escaped = true
+		case c == '"':
+			inQuotes = !inQuotes
+		case c == ',' && !inQuotes:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	if inQuotes {
+		return nil, fmt.Errorf("unterminated quoted value")
+	}
+
+	if last := s[start:]; strings.TrimSpace(last) != "" {
+		parts = append(parts, last)
+	}
+
+	for _, part := range parts {
+		if strings.TrimSpace(part) == "" {
+			return nil, fmt.Errorf("empty matcher")
+		}
+	}
+
+	return parts, nil
+}
+
+// parseMatcher parses a single label<op>value matcher.
+func parseMatcher(s string) (*Matcher, error) {
+	s = strings.TrimSpace(s)
+
+	end := 0
+	for end < len(s) && isLabelNameChar(s[end], end == 0) {
+		end++
+	}
+	name := s[:end]
+	if name == "" {
+		return nil, fmt.Errorf("expected label name")
+	}
+
+	rest := strings.TrimLeft(s[end:], " \t")
+	var t MatchType
+	switch {
+	case strings.HasPrefix(rest, "=~"):
+		t = MatchRegexp
+	case strings.HasPrefix(rest, "!~"):
+		t = MatchNotRegexp
+	case strings.HasPrefix(rest, "!="):
+		t = MatchNotEqual
+	case strings.HasPrefix(rest, "="):
+		t = MatchEqual
+	default:
+		return nil, fmt.Errorf("expected one of =, !=, =~, !~ after %q", name)
+	}
+
+	value := strings.TrimSpace(rest[len(t):])
+	if strings.HasPrefix(value, `"`) {
+		unquoted, err := strconv.Unquote(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid quoted value %s", value)
+		}
+		value = unquoted
+	} else if strings.ContainsAny(value, `"`) {
+		return nil, fmt.Errorf("unexpected quote in value %q", value)
+	}
+
+	return NewLabelMatcher(t, name, value)
+}
+
+// isLabelNameChar reports whether c may appear in a label name.
+func isLabelNameChar(c byte, first bool) bool {
+	if c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') {
+		return true
+	}
+	return !first && c >= '0' && c <= '9'
+}
+
+// matchAll reports whether the label set satisfies all matchers.
+func matchAll(matchers []*Matcher, labels map[string]string) bool {
+	for _, m := range matchers {
+		if !m.Matches(labels) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,167 @@
+package inhibition
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+)
+
+func TestParseMatchers_Syntax(t *testing.T) {
+	tests := []struct {
+		expr string
+		want []string
+	}{
+		{`severity="critical"`, []string{`severity="critical"`}},
+		{`severity=~"critical|page"`, []string{`severity=~"critical|page"`}},
+		{`env!="dev"`, []string{`env!="dev"`}},
+		{`job!~"test-.*"`, []string{`job!~"test-.*"`}},
+		{`severity=critical`, []string{`severity="critical"`}},
+		{` env != "dev" `, []string{`env!="dev"`}},
+		{`{alertname="NodeDown", cluster=~"prod-.*"}`, []string{`alertname="NodeDown"`, `cluster=~"prod-.*"`}},
+		{`a="x,y", b="say \"hi\"",`, []string{`a="x,y"`, `b="say \"hi\""`}},
+		{`{}`, []string{}},
+	}
+
+	for _, tt := range tests {
+		matchers, err := ParseMatchers(tt.expr)
+		if err != nil {
+			t.Errorf("ParseMatchers(%q) error = %v", tt.expr, err)
+			continue
+		}
+		if len(matchers) != len(tt.want) {
+			t.Errorf("ParseMatchers(%q) returned %d matchers, want %d", tt.expr, len(matchers), len(tt.want))
+			continue
+		}
+		for i, m := range matchers {
+			if m.String() != tt.want[i] {
+				t.Errorf("ParseMatchers(%q)[%d] = %s, want %s", tt.expr, i, m.String(), tt.want[i])
+			}
+		}
+	}
+}
+
+func TestParseMatchers_Invalid(t *testing.T) {
+	invalid := []string{
+		`severity`,
+		`="critical"`,
+		`1abc="x"`,
+		`severity=~"(unclosed"`,
+		`severity="unterminated`,
+		`{severity="critical"`,
+		`severity="critical"}`,
+		`a="x",,b="y"`,
+		`severity=crit"ical`,
+	}
+
+	for _, expr := range invalid {
+		if _, err := ParseMatchers(expr); err == nil {
+			t.Errorf("ParseMatchers(%q) expected error", expr)
+		}
+	}
+}
+
+func TestMatcher_Matches(t *testing.T) {
+	labels := map[string]string{"severity": "critical", "env": "prod"}
+
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{`severity="critical"`, true},
+		{`severity=~"critical|page"`, true},
+		{`severity=~"crit"`, false}, // Anchored
+		{`severity!~"warning|info"`, true},
+		{`env!="dev"`, true},
+		{`env!="prod"`, false},
+		{`team!="dba"`, true}, // Missing label == ""
+		{`team=""`, true},
+		{`team=~".+"`, false},
+	}
+
+	for _, tt := range tests {
+		matchers, err := ParseMatchers(tt.expr)
+		if err != nil {
+			t.Fatalf("ParseMatchers(%q) error = %v", tt.expr, err)
+		}
+		if got := matchAll(matchers, labels); got != tt.want {
+			t.Errorf("%s matches = %v, want %v", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestParse_SourceTargetMatchers(t *testing.T) {
+	yaml := `
+inhibit_rules:
+  - name: critical-inhibits-warning
+    source_matchers:
+      - severity=~"critical|page"
+    target_matchers:
+      - severity="warning"
+      - env!="dev"
+    equal:
+      - cluster
+`
+	config, err := NewParser().ParseString(yaml)
+	if err != nil {
+		t.Fatalf("ParseString() error = %v", err)
+	}
+
+	source := createTestAlert("NodeDown", "page", "node1", "prod")
+	target := createTestAlert("HighLatency", "warning", "node2", "prod")
+	devTarget := createTestAlert("HighLatency", "warning", "node3", "prod")
+	devTarget.Labels["env"] = "dev"
+
+	matcher := NewMatcher(&mockCache{firingAlerts: []*core.Alert{source}}, config.Rules, nil)
+
+	result, err := matcher.ShouldInhibit(context.Background(), target)
+	if err != nil {
+		t.Fatalf("ShouldInhibit() error = %v", err)
+	}
+	if !result.Matched {
+		t.Error("Expected target to be inhibited")
+	}
+
+	result, err = matcher.ShouldInhibit(context.Background(), devTarget)
+	if err != nil {
+		t.Fatalf("ShouldInhibit() error = %v", err)
+	}
+	if result.Matched {
+		t.Error("Expected env=dev target not to be inhibited")
+	}
+}
+
+func TestParse_InvalidMatchers(t *testing.T) {
+	yaml := `
+inhibit_rules:
+  - source_matchers:
+      - severity=~"(critical"
+    target_matchers:
+      - severity="warning"
+`
+	_, err := NewParser().ParseString(yaml)
+	if !IsParseError(err) {
+		t.Fatalf("Expected ParseError, got %v", err)
+	}
+}
+
+func TestMatchRule_UnparsedMatchersNeverMatch(t *testing.T) {
+	rule := InhibitionRule{
+		SourceMatchers: []string{`severity="critical"`},
+		TargetMatchers: []string{`severity="warning"`},
+	}
+	source := createTestAlert("NodeDown", "critical", "node1", "prod")
+	target := createTestAlert("InstanceDown", "warning", "node1", "prod")
+	matcher := NewMatcher(&mockCache{}, nil, nil)
+
+	if matcher.MatchRule(&rule, source, target) {
+		t.Error("Expected unparsed matchers not to match")
+	}
+
+	if err := rule.CompileMatchers(); err != nil {
+		t.Fatalf("CompileMatchers() error = %v", err)
+	}
+	if !matcher.MatchRule(&rule, source, target) {
+		t.Error("Expected compiled matchers to match")
+	}
+}
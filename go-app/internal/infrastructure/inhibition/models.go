@@ -27,8 +27,8 @@ import (
 //  3. Equal labels: labels that must have the same value in both source and target
 //
 // The rule triggers when:
-//   - Source alert matches all source conditions (source_match AND source_match_re)
-//   - Target alert matches all target conditions (target_match AND target_match_re)
+//   - Source alert matches all source conditions (source_match AND source_match_re AND source_matchers)
+//   - Target alert matches all target conditions (target_match AND target_match_re AND target_matchers)
 //   - All equal labels have the same value in both alerts
 //
 // Example:
@@ -53,7 +53,7 @@ type InhibitionRule struct {
 	//     alertname: "NodeDown"
 	//     severity: "critical"
 	//
-	// Optional: at least one of (SourceMatch, SourceMatchRE) must be present
+	// Optional: at least one of (SourceMatch, SourceMatchRE, SourceMatchers) must be present
 	SourceMatch map[string]string `yaml:"source_match,omitempty" json:"source_match,omitempty"`
 
 	// SourceMatchRE defines regex label matches for the source alert.
@@ -65,7 +65,7 @@ type InhibitionRule struct {
 	//     environment: "prod.*"
 	//
 	// Regex syntax: Go RE2 (no backreferences)
-	// Optional: at least one of (SourceMatch, SourceMatchRE) must be present
+	// Optional: at least one of (SourceMatch, SourceMatchRE, SourceMatchers) must be present
 	SourceMatchRE map[string]string `yaml:"source_match_re,omitempty" json:"source_match_re,omitempty"`
 
 	// TargetMatch defines exact label matches for the target alert (inhibited).
@@ -76,7 +76,7 @@ type InhibitionRule struct {
 	//     alertname: "InstanceDown"
 	//     severity: "warning"
 	//
-	// Optional: at least one of (TargetMatch, TargetMatchRE) must be present
+	// Optional: at least one of (TargetMatch, TargetMatchRE, TargetMatchers) must be present
 	TargetMatch map[string]string `yaml:"target_match,omitempty" json:"target_match,omitempty"`
 
 	// TargetMatchRE defines regex label matches for the target alert.
@@ -88,9 +88,31 @@ type InhibitionRule struct {
 	//     alertname: ".*Down$"
 	//
 	// Regex syntax: Go RE2 (no backreferences)
-	// Optional: at least one of (TargetMatch, TargetMatchRE) must be present
+	// Optional: at least one of (TargetMatch, TargetMatchRE, TargetMatchers) must be present
 	TargetMatchRE map[string]string `yaml:"target_match_re,omitempty" json:"target_match_re,omitempty"`
 
+	// SourceMatchers defines source conditions in Alertmanager matcher syntax.
+	// Supports =, !=, =~ and !~; regexes are fully anchored.
+	// Every element may contain several comma-separated matchers.
+	//
+	// Example:
+	//   source_matchers:
+	//     - alertname="NodeDown"
+	//     - severity=~"critical|page"
+	//
+	// Optional: combined (AND) with SourceMatch and SourceMatchRE
+	SourceMatchers []string `yaml:"source_matchers,omitempty" json:"source_matchers,omitempty"`
+
+	// TargetMatchers defines target conditions in Alertmanager matcher syntax.
+	//
+	// Example:
+	//   target_matchers:
+	//     - severity=~"warning|info"
+	//     - env!="dev"
+	//
+	// Optional: combined (AND) with TargetMatch and TargetMatchRE
+	TargetMatchers []string `yaml:"target_matchers,omitempty" json:"target_matchers,omitempty"`
+
 	// Equal defines labels that must have the same value in both source and target alerts.
 	// If any of these labels is missing in either alert, the rule does not match.
 	//
@@ -131,6 +153,13 @@ type InhibitionRule struct {
 	// Value: compiled regexp.Regexp
 	compiledTargetRE map[string]*regexp.Regexp `yaml:"-" json:"-"`
 
+	// parsedSourceMatchers contains parsed source_matchers.
+	// Parsed during parsing (see CompileMatchers).
+	parsedSourceMatchers []*Matcher `yaml:"-" json:"-"`
+
+	// parsedTargetMatchers contains parsed target_matchers.
+	parsedTargetMatchers []*Matcher `yaml:"-" json:"-"`
+
 	// CreatedAt is the timestamp when the rule was created/loaded.
 	// Set automatically during parsing.
 	CreatedAt time.Time `yaml:"-" json:"-"`
@@ -143,8 +172,8 @@ type InhibitionRule struct {
 // Validate checks if the inhibition rule is valid.
 //
 // Validation rules:
-//  1. At least one source condition (source_match or source_match_re) must be present
-//  2. At least one target condition (target_match or target_match_re) must be present
+//  1. At least one source condition (source_match, source_match_re or source_matchers) must be present
+//  2. At least one target condition (target_match, target_match_re or target_matchers) must be present
 //  3. All label names must be valid Prometheus label names
 //  4. Regex patterns must be valid (this is checked during parsing, not here)
 //
@@ -155,20 +184,36 @@ type InhibitionRule struct {
 // Note: Regex compilation is handled by the parser, not by this method.
 func (r *InhibitionRule) Validate() error {
 	// Check source conditions
-	if len(r.SourceMatch) == 0 && len(r.SourceMatchRE) == 0 {
+	if !r.hasSourceConditions() {
 		return &ValidationError{
-			Field:   "source_match/source_match_re",
+			Field:   "source_match/source_match_re/source_matchers",
 			Rule:    "required_one_of",
-			Message: "at least one of source_match or source_match_re must be present",
+			Message: "at least one of source_match, source_match_re or source_matchers must be present",
 		}
 	}
 
 	// Check target conditions
-	if len(r.TargetMatch) == 0 && len(r.TargetMatchRE) == 0 {
+	if !r.hasTargetConditions() {
 		return &ValidationError{
-			Field:   "target_match/target_match_re",
+			Field:   "target_match/target_match_re/target_matchers",
 			Rule:    "required_one_of",
-			Message: "at least one of target_match or target_match_re must be present",
+			Message: "at least one of target_match, target_match_re or target_matchers must be present",
+		}
+	}
+
+	// Validate matcher syntax
+	if _, err := parseMatcherList(r.SourceMatchers); err != nil {
+		return &ValidationError{
+			Field:   "source_matchers",
+			Rule:    "valid_matcher",
+			Message: err.Error(),
+		}
+	}
+	if _, err := parseMatcherList(r.TargetMatchers); err != nil {
+		return &ValidationError{
+			Field:   "target_matchers",
+			Rule:    "valid_matcher",
+			Message: err.Error(),
 		}
 	}
 
@@ -230,6 +275,38 @@ func (r *InhibitionRule) Validate() error {
 	return nil
 }
 
+// CompileMatchers parses source_matchers and target_matchers.
+//
+// Called by the parser; rules built in code must call it before matching
+// (like *_match_re patterns, unparsed matchers never match).
+//
+// Returns:
+//   - error: syntax error, invalid label name or invalid regex
+func (r *InhibitionRule) CompileMatchers() error {
+	source, err := parseMatcherList(r.SourceMatchers)
+	if err != nil {
+		return fmt.Errorf("source_matchers: %w", err)
+	}
+	target, err := parseMatcherList(r.TargetMatchers)
+	if err != nil {
+		return fmt.Errorf("target_matchers: %w", err)
+	}
+
+	r.parsedSourceMatchers = source
+	r.parsedTargetMatchers = target
+	return nil
+}
+
+// hasSourceConditions reports whether any source condition is configured.
+func (r *InhibitionRule) hasSourceConditions() bool {
+	return len(r.SourceMatch) > 0 || len(r.SourceMatchRE) > 0 || len(r.SourceMatchers) > 0
+}
+
+// hasTargetConditions reports whether any target condition is configured.
+func (r *InhibitionRule) hasTargetConditions() bool {
+	return len(r.TargetMatch) > 0 || len(r.TargetMatchRE) > 0 || len(r.TargetMatchers) > 0
+}
+
 // GetCompiledSourceRE returns the pre-compiled regex for a source label.
 // Returns nil if the pattern doesn't exist or wasn't compiled.
 //
@@ -286,8 +363,8 @@ func (r *InhibitionRule) String() string {
 		sb.WriteString(fmt.Sprintf("name=%q, ", r.Name))
 	}
 
-	sb.WriteString(fmt.Sprintf("source=%d matchers, ", len(r.SourceMatch)+len(r.SourceMatchRE)))
-	sb.WriteString(fmt.Sprintf("target=%d matchers, ", len(r.TargetMatch)+len(r.TargetMatchRE)))
+	sb.WriteString(fmt.Sprintf("source=%d matchers, ", len(r.SourceMatch)+len(r.SourceMatchRE)+len(r.SourceMatchers)))
+	sb.WriteString(fmt.Sprintf("target=%d matchers, ", len(r.TargetMatch)+len(r.TargetMatchRE)+len(r.TargetMatchers)))
 	sb.WriteString(fmt.Sprintf("equal=%v", r.Equal))
 	sb.WriteString("}")
 
@@ -157,7 +157,7 @@ func NewParser() *DefaultInhibitionParser {
 //  1. YAML unmarshal → InhibitionConfig struct
 //  2. Apply defaults (initialize maps, set timestamps)
 //  3. Struct validation (validator tags)
-//  4. Compile regex patterns (pre-compile for performance)
+//  4. Compile regex patterns and parse matchers (pre-compile for performance)
 //  5. Semantic validation (business rules)
 //
 // Performance: < 10µs per rule (target), < 1ms for 100 rules.
@@ -292,7 +292,8 @@ func (p *DefaultInhibitionParser) applyDefaults(config *InhibitionConfig) {
 	}
 }
 
-// compileRegexPatterns compiles all regex patterns in the configuration.
+// compileRegexPatterns compiles all regex patterns in the configuration
+// and parses source_matchers/target_matchers.
 //
 // Pre-compilation improves performance during matching.
 // Invalid patterns return ParseError with detailed information.
@@ -327,6 +328,28 @@ func (p *DefaultInhibitionParser) compileRegexPatterns(config *InhibitionConfig)
 			}
 			rule.compiledTargetRE[key] = re
 		}
+
+		// Parse source_matchers (Alertmanager matcher syntax)
+		sourceMatchers, err := parseMatcherList(rule.SourceMatchers)
+		if err != nil {
+			return NewParseError(
+				fmt.Sprintf("rules[%d].source_matchers", i),
+				rule.SourceMatchers,
+				err,
+			)
+		}
+		rule.parsedSourceMatchers = sourceMatchers
+
+		// Parse target_matchers (Alertmanager matcher syntax)
+		targetMatchers, err := parseMatcherList(rule.TargetMatchers)
+		if err != nil {
+			return NewParseError(
+				fmt.Sprintf("rules[%d].target_matchers", i),
+				rule.TargetMatchers,
+				err,
+			)
+		}
+		rule.parsedTargetMatchers = targetMatchers
 	}
 
 	return nil
@@ -350,13 +373,13 @@ func (p *DefaultInhibitionParser) validateSemantics(config *InhibitionConfig) er
 	// Validate each rule
 	for i, rule := range config.Rules {
 		// At least one source condition
-		if len(rule.SourceMatch) == 0 && len(rule.SourceMatchRE) == 0 {
-			errors = append(errors, fmt.Errorf("rule %d: at least one of source_match or source_match_re required", i))
+		if !rule.hasSourceConditions() {
+			errors = append(errors, fmt.Errorf("rule %d: at least one of source_match, source_match_re or source_matchers required", i))
 		}
 
 		// At least one target condition
-		if len(rule.TargetMatch) == 0 && len(rule.TargetMatchRE) == 0 {
-			errors = append(errors, fmt.Errorf("rule %d: at least one of target_match or target_match_re required", i))
+		if !rule.hasTargetConditions() {
+			errors = append(errors, fmt.Errorf("rule %d: at least one of target_match, target_match_re or target_matchers required", i))
 		}
 
 		// Validate label names in equal
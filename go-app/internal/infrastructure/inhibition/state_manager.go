@@ -44,6 +44,12 @@ type InhibitionStateManager interface {
 	// This is called when the source alert resolves or the inhibition expires.
 	RemoveInhibition(ctx context.Context, targetFingerprint string) error
 
+	// ReleaseBySource removes all inhibitions caused by a source alert and
+	// returns the released states.
+	// This is called when the source alert resolves, so that the released
+	// targets can be re-evaluated and notified.
+	ReleaseBySource(ctx context.Context, sourceFingerprint string) ([]*InhibitionState, error)
+
 	// GetActiveInhibitions returns all currently active inhibition relationships.
 	GetActiveInhibitions(ctx context.Context) ([]*InhibitionState, error)
 
@@ -174,11 +180,53 @@ func (sm *DefaultStateManager) RemoveInhibition(ctx context.Context, targetFinge
 		return fmt.Errorf("target fingerprint cannot be empty")
 	}
 
+	sm.removeState(ctx, targetFingerprint, "manual", start)
+
+	return nil
+}
+
+// ReleaseBySource removes all inhibitions caused by the source alert.
+//
+// Only in-memory states are scanned: states persisted by other replicas
+// are not indexed by source in Redis.
+func (sm *DefaultStateManager) ReleaseBySource(ctx context.Context, sourceFingerprint string) ([]*InhibitionState, error) {
+	start := time.Now()
+
+	if sourceFingerprint == "" {
+		return nil, fmt.Errorf("source fingerprint cannot be empty")
+	}
+
+	released := make([]*InhibitionState, 0)
+	sm.states.Range(func(key, value interface{}) bool {
+		if state, ok := value.(*InhibitionState); ok && state.SourceFingerprint == sourceFingerprint {
+			released = append(released, state)
+		}
+		return true
+	})
+
+	for _, state := range released {
+		sm.removeState(ctx, state.TargetFingerprint, "source_resolved", start)
+	}
+
+	if len(released) > 0 {
+		sm.logger.Info("Released inhibitions of resolved source",
+			"source", sourceFingerprint,
+			"released", len(released),
+		)
+	}
+
+	return released, nil
+}
+
+// removeState removes an inhibition state from memory and Redis and records
+// the removal with the given reason.
+func (sm *DefaultStateManager) removeState(ctx context.Context, targetFingerprint, reason string, start time.Time) {
 	// Remove from memory
 	sm.states.Delete(targetFingerprint)
 
 	sm.logger.Debug("Removed inhibition",
 		"target", targetFingerprint,
+		"reason", reason,
 	)
 
 	// Remove from Redis if available
@@ -198,15 +246,12 @@ func (sm *DefaultStateManager) RemoveInhibition(ctx context.Context, targetFinge
 	}
 
 	// Record metrics
-	duration := time.Since(start)
 	if sm.metrics != nil {
-		sm.metrics.RecordInhibitionStateRemoval("manual", duration)
+		sm.metrics.RecordInhibitionStateRemoval(reason, time.Since(start))
 		// Update active gauge
 		count := sm.countActiveStates()
 		sm.metrics.SetInhibitionStateActive(count)
 	}
-
-	return nil
 }
 
 // GetActiveInhibitions returns all currently active inhibition relationships.
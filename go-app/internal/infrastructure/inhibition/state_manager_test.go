@@ -477,3 +477,39 @@ func TestCountActiveStates(t *testing.T) {
 		t.Errorf("Expected 3 active states (expired not counted), got %d", count)
 	}
 }
+
+func TestReleaseBySource(t *testing.T) {
+	sm := newTestStateManager(t)
+	ctx := context.Background()
+
+	for _, state := range []*InhibitionState{
+		newTestState("target-1", "source-1", "rule-1"),
+		newTestState("target-2", "source-1", "rule-1"),
+		newTestState("target-3", "source-2", "rule-1"),
+	} {
+		if err := sm.RecordInhibition(ctx, state); err != nil {
+			t.Fatalf("RecordInhibition() failed: %v", err)
+		}
+	}
+
+	released, err := sm.ReleaseBySource(ctx, "source-1")
+	if err != nil {
+		t.Fatalf("ReleaseBySource() failed: %v", err)
+	}
+	if len(released) != 2 {
+		t.Fatalf("Expected 2 released states, got %d", len(released))
+	}
+
+	for _, fp := range []string{"target-1", "target-2"} {
+		if inhibited, _ := sm.IsInhibited(ctx, fp); inhibited {
+			t.Errorf("Expected %s to be released", fp)
+		}
+	}
+	if inhibited, _ := sm.IsInhibited(ctx, "target-3"); !inhibited {
+		t.Error("Expected target-3 to stay inhibited")
+	}
+
+	if _, err := sm.ReleaseBySource(ctx, ""); err == nil {
+		t.Error("Expected error for empty source fingerprint")
+	}
+}
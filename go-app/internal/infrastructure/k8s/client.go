@@ -44,6 +44,11 @@ type K8sClient interface {
 	// Returns NotFoundError if secret doesn't exist.
 	GetSecret(ctx context.Context, namespace, name string) (*corev1.Secret, error)
 
+	// WatchSecrets delivers add/update/delete events for secrets matching label selector.
+	// Blocks until the initial list is delivered; watching continues until ctx is cancelled.
+	// A positive resyncPeriod periodically re-delivers all secrets as SecretUpdated.
+	WatchSecrets(ctx context.Context, namespace string, labelSelector string, resyncPeriod time.Duration, handler SecretEventHandler) error
+
 	// Health checks if K8s API is accessible.
 	// Returns ConnectionError if API is unavailable.
 	Health(ctx context.Context) error
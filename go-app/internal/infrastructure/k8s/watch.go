@@ -0,0 +1,162 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+// SecretEventType is the kind of change observed for a secret.
+type SecretEventType string
+
+const (
+	// SecretAdded is emitted for secrets present in the initial list and newly created secrets.
+	SecretAdded SecretEventType = "added"
+
+	// SecretUpdated is emitted when a secret changes, and for every cached
+	// secret on each resync (the secret may be unchanged).
+	SecretUpdated SecretEventType = "updated"
+
+	// SecretDeleted is emitted when a secret is deleted or stops matching the label selector.
+	SecretDeleted SecretEventType = "deleted"
+)
+
+// SecretEvent describes a change to a secret matching a watch.
+type SecretEvent struct {
+	Type   SecretEventType
+	Secret *corev1.Secret
+}
+
+// SecretEventHandler receives secret events.
+// Events are delivered sequentially from a single goroutine.
+type SecretEventHandler func(event SecretEvent)
+
+// NewK8sClientFromClientset creates a K8s client around an existing clientset
+// (e.g. out-of-cluster config or k8s.io/client-go/kubernetes/fake in tests).
+// Unlike NewK8sClient it does not perform an initial health check.
+func NewK8sClientFromClientset(clientset kubernetes.Interface, config *K8sClientConfig) K8sClient {
+	if config == nil {
+		config = DefaultK8sClientConfig()
+	}
+	if config.Logger == nil {
+		config.Logger = DefaultK8sClientConfig().Logger
+	}
+
+	return &DefaultK8sClient{
+		clientset: clientset,
+		config:    config,
+		logger:    config.Logger,
+	}
+}
+
+// WatchSecrets starts a shared informer for secrets in namespace matching labelSelector.
+//
+// The informer performs an initial list (delivered as SecretAdded events) and then
+// watches for changes. Watch interruptions trigger a relist, and deletions missed
+// during the interruption are still delivered as SecretDeleted. If resyncPeriod > 0,
+// every cached secret is re-delivered as SecretUpdated on that period so consumers
+// can reconcile state.
+//
+// Blocks until the initial list has been delivered, then keeps watching in the
+// background until ctx is cancelled.
+func (c *DefaultK8sClient) WatchSecrets(
+	ctx context.Context,
+	namespace string,
+	labelSelector string,
+	resyncPeriod time.Duration,
+	handler SecretEventHandler,
+) error {
+	if handler == nil {
+		return fmt.Errorf("secret event handler is required")
+	}
+
+	selector, err := labels.Parse(labelSelector)
+	if err != nil {
+		return fmt.Errorf("invalid label selector %q: %w", labelSelector, err)
+	}
+
+	c.mu.RLock()
+	clientset := c.clientset
+	c.mu.RUnlock()
+	if clientset == nil {
+		return NewConnectionError("K8s client is closed", nil)
+	}
+
+	factory := informers.NewSharedInformerFactoryWithOptions(
+		clientset,
+		resyncPeriod,
+		informers.WithNamespace(namespace),
+		informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+			opts.LabelSelector = labelSelector
+		}),
+	)
+	informer := factory.Core().V1().Secrets().Informer()
+
+	// Label selector is re-checked client-side so that an update removing the
+	// label is surfaced as a deletion, regardless of server-side filtering.
+	matches := func(secret *corev1.Secret) bool {
+		return selector.Matches(labels.Set(secret.Labels))
+	}
+
+	registration, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			if secret, ok := obj.(*corev1.Secret); ok && matches(secret) {
+				handler(SecretEvent{Type: SecretAdded, Secret: secret})
+			}
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			secret, ok := newObj.(*corev1.Secret)
+			if !ok {
+				return
+			}
+			if matches(secret) {
+				handler(SecretEvent{Type: SecretUpdated, Secret: secret})
+			} else if old, ok := oldObj.(*corev1.Secret); ok && matches(old) {
+				handler(SecretEvent{Type: SecretDeleted, Secret: secret})
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if secret, ok := obj.(*corev1.Secret); ok {
+				handler(SecretEvent{Type: SecretDeleted, Secret: secret})
+			}
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register secret event handler: %w", err)
+	}
+
+	factory.Start(ctx.Done())
+
+	// registration.HasSynced also waits for the initial events to reach the handler
+	if !cache.WaitForCacheSync(ctx.Done(), informer.HasSynced, registration.HasSynced) {
+		factory.Shutdown()
+		return NewTimeoutError("secret informer cache sync cancelled", ctx.Err())
+	}
+
+	go func() {
+		<-ctx.Done()
+		factory.Shutdown()
+		c.logger.Info("Stopped watching K8s secrets",
+			"namespace", namespace,
+			"label_selector", labelSelector,
+		)
+	}()
+
+	c.logger.Info("Watching K8s secrets",
+		"namespace", namespace,
+		"label_selector", labelSelector,
+		"resync_period", resyncPeriod,
+	)
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package k8s
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// newWatchableFakeClientset returns a fake clientset and a channel closed once
+// the informer's watch is registered (fake watches do not replay missed events).
+func newWatchableFakeClientset(secrets ...*corev1.Secret) (*fake.Clientset, <-chan struct{}) {
+	clientset := fake.NewSimpleClientset()
+	for _, secret := range secrets {
+		_ = clientset.Tracker().Add(secret)
+	}
+
+	watchStarted := make(chan struct{})
+	var once sync.Once
+	clientset.PrependWatchReactor("*", func(action k8stesting.Action) (bool, watch.Interface, error) {
+		w, err := clientset.Tracker().Watch(action.GetResource(), action.GetNamespace())
+		if err != nil {
+			return false, nil, err
+		}
+		once.Do(func() { close(watchStarted) })
+		return true, w, nil
+	})
+
+	return clientset, watchStarted
+}
+
+// eventRecorder collects secret events for assertions.
+type eventRecorder struct {
+	mu     sync.Mutex
+	events []SecretEvent
+}
+
+func (r *eventRecorder) handle(event SecretEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+}
+
+func (r *eventRecorder) snapshot() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := make([]string, 0, len(r.events))
+	for _, e := range r.events {
+		out = append(out, string(e.Type)+":"+e.Secret.Name)
+	}
+	return out
+}
+
+func TestWatchSecrets_Events(t *testing.T) {
+	labels := map[string]string{"publishing-target": "true"}
+	clientset, watchStarted := newWatchableFakeClientset(
+		createTestSecret("existing", "default", labels, nil),
+		createTestSecret("unlabeled", "default", nil, nil),
+	)
+	client := NewK8sClientFromClientset(clientset, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	recorder := &eventRecorder{}
+	err := client.WatchSecrets(ctx, "default", "publishing-target=true", 0, recorder.handle)
+	require.NoError(t, err)
+
+	// Initial list is delivered before WatchSecrets returns
+	assert.Equal(t, []string{"added:existing"}, recorder.snapshot())
+
+	select {
+	case <-watchStarted:
+	case <-time.After(5 * time.Second):
+		t.Fatal("watch was not started")
+	}
+
+	secrets := clientset.CoreV1().Secrets("default")
+	_, err = secrets.Create(ctx, createTestSecret("created", "default", labels, nil), metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	updated := createTestSecret("existing", "default", labels, map[string][]byte{"config": []byte("v2")})
+	_, err = secrets.Update(ctx, updated, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	// Removing the label is reported as a deletion
+	_, err = secrets.Update(ctx, createTestSecret("created", "default", map[string]string{}, nil), metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	require.NoError(t, secrets.Delete(ctx, "existing", metav1.DeleteOptions{}))
+
+	want := []string{"added:existing", "added:created", "updated:existing", "deleted:created", "deleted:existing"}
+	assert.Eventually(t, func() bool {
+		return len(recorder.snapshot()) >= len(want)
+	}, 5*time.Second, 10*time.Millisecond)
+	// Ordering is only guaranteed per secret
+	assert.ElementsMatch(t, want, recorder.snapshot())
+}
+
+func TestWatchSecrets_InvalidInput(t *testing.T) {
+	clientset, _ := newWatchableFakeClientset()
+	client := NewK8sClientFromClientset(clientset, nil)
+
+	err := client.WatchSecrets(context.Background(), "default", "publishing-target=true", 0, nil)
+	assert.Error(t, err)
+
+	err = client.WatchSecrets(context.Background(), "default", "bad selector!!", 0, func(SecretEvent) {})
+	assert.Error(t, err)
+}
+
+func TestWatchSecrets_ClosedClient(t *testing.T) {
+	clientset, _ := newWatchableFakeClientset()
+	client := NewK8sClientFromClientset(clientset, nil)
+	require.NoError(t, client.Close())
+
+	err := client.WatchSecrets(context.Background(), "default", "", 0, func(SecretEvent) {})
+	var connErr *ConnectionError
+	assert.ErrorAs(t, err, &connErr)
+}
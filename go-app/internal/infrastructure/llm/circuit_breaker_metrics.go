@@ -7,8 +7,15 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 )
 
+// ProxyProviderName is the provider label of the circuit breaker in front of
+// the legacy classification proxy (HTTPLLMClient).
+const ProxyProviderName = "proxy"
+
 // CircuitBreakerMetrics holds Prometheus metrics for circuit breaker.
 // Provides comprehensive observability for production monitoring.
+//
+// All metrics carry a provider label, so the breakers of several LLM
+// providers (FailoverClient) can be told apart.
 type CircuitBreakerMetrics struct {
 	// State represents current circuit breaker state (0=closed, 1=open, 2=half_open)
 	State prometheus.Gauge
@@ -32,84 +39,107 @@ type CircuitBreakerMetrics struct {
 	SlowCalls prometheus.Counter
 
 	// CallDuration tracks duration of LLM calls (150% enhancement: includes percentiles)
-	CallDuration *prometheus.HistogramVec
+	CallDuration prometheus.ObserverVec
+}
+
+// circuitBreakerMetricVecs holds the registered metric families, labeled by provider.
+type circuitBreakerMetricVecs struct {
+	state            *prometheus.GaugeVec
+	failures         *prometheus.CounterVec
+	successes        *prometheus.CounterVec
+	stateChanges     *prometheus.CounterVec
+	requestsBlocked  *prometheus.CounterVec
+	halfOpenRequests *prometheus.CounterVec
+	slowCalls        *prometheus.CounterVec
+	callDuration     *prometheus.HistogramVec
 }
 
 var (
-	// Global singleton metrics instance to prevent duplicate registration
-	defaultMetrics     *CircuitBreakerMetrics
-	defaultMetricsOnce sync.Once
+	// Global singleton metric families to prevent duplicate registration
+	defaultMetricVecs     *circuitBreakerMetricVecs
+	defaultMetricVecsOnce sync.Once
 )
 
 // NewCircuitBreakerMetrics creates Prometheus metrics for circuit breaker.
-// Uses standard alert_history namespace with llm_circuit_breaker subsystem.
-// Returns singleton instance to prevent duplicate metric registration.
+// Uses standard alert_history namespace with llm_circuit_breaker subsystem
+// and the ProxyProviderName provider label.
 func NewCircuitBreakerMetrics() *CircuitBreakerMetrics {
-	defaultMetricsOnce.Do(func() {
-		defaultMetrics = NewCircuitBreakerMetricsWithNamespace("alert_history", "llm_circuit_breaker")
+	return NewProviderCircuitBreakerMetrics(ProxyProviderName)
+}
+
+// NewProviderCircuitBreakerMetrics returns the circuit breaker metrics of an
+// LLM provider (provider label). Metric families are registered once and
+// shared; calls for the same provider return metrics of the same series.
+func NewProviderCircuitBreakerMetrics(provider string) *CircuitBreakerMetrics {
+	defaultMetricVecsOnce.Do(func() {
+		defaultMetricVecs = newCircuitBreakerMetricVecs("alert_history", "llm_circuit_breaker")
 	})
-	return defaultMetrics
+	return defaultMetricVecs.forProvider(provider)
 }
 
 // NewCircuitBreakerMetricsWithNamespace creates metrics with custom namespace.
 // Allows flexibility for different deployment environments.
 // WARNING: This should only be called once per namespace/subsystem combination to avoid duplicate registration.
 func NewCircuitBreakerMetricsWithNamespace(namespace, subsystem string) *CircuitBreakerMetrics {
-	return &CircuitBreakerMetrics{
-		State: promauto.NewGauge(prometheus.GaugeOpts{
+	return newCircuitBreakerMetricVecs(namespace, subsystem).forProvider(ProxyProviderName)
+}
+
+func newCircuitBreakerMetricVecs(namespace, subsystem string) *circuitBreakerMetricVecs {
+	return &circuitBreakerMetricVecs{
+		state: promauto.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: namespace,
 			Subsystem: subsystem,
 			Name:      "state",
 			Help:      "Current state of LLM circuit breaker (0=closed, 1=open, 2=half_open)",
-		}),
+		}, []string{"provider"}),
 
-		Failures: promauto.NewCounter(prometheus.CounterOpts{
+		failures: promauto.NewCounterVec(prometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: subsystem,
 			Name:      "failures_total",
 			Help:      "Total number of failed LLM calls (includes slow calls)",
-		}),
+		}, []string{"provider"}),
 
-		Successes: promauto.NewCounter(prometheus.CounterOpts{
+		successes: promauto.NewCounterVec(prometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: subsystem,
 			Name:      "successes_total",
 			Help:      "Total number of successful LLM calls",
-		}),
+		}, []string{"provider"}),
 
-		StateChanges: promauto.NewCounterVec(
+		stateChanges: promauto.NewCounterVec(
 			prometheus.CounterOpts{
 				Namespace: namespace,
 				Subsystem: subsystem,
 				Name:      "state_changes_total",
 				Help:      "Total number of circuit breaker state changes",
 			},
-			[]string{"from", "to"},
+			[]string{"provider", "from", "to"},
 		),
 
-		RequestsBlocked: promauto.NewCounter(prometheus.CounterOpts{
+		requestsBlocked: promauto.NewCounterVec(prometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: subsystem,
 			Name:      "requests_blocked_total",
 			Help:      "Total number of requests blocked by circuit breaker (fail-fast)",
-		}),
+		}, []string{"provider"}),
 
-		HalfOpenRequests: promauto.NewCounter(prometheus.CounterOpts{
+		halfOpenRequests: promauto.NewCounterVec(prometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: subsystem,
 			Name:      "half_open_requests_total",
 			Help:      "Total number of test requests in half-open state",
-		}),
+		}, []string{"provider"}),
 
-		SlowCalls: promauto.NewCounter(prometheus.CounterOpts{
+		slowCalls: promauto.NewCounterVec(prometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: subsystem,
 			Name:      "slow_calls_total",
 			Help:      "Total number of slow LLM calls (exceeding threshold)",
-		}),
+		}, []string{"provider"}),
 
 		// 150% Enhancement: Histogram for latency percentiles (p50, p95, p99)
-		CallDuration: promauto.NewHistogramVec(
+		callDuration: promauto.NewHistogramVec(
 			prometheus.HistogramOpts{
 				Namespace: namespace,
 				Subsystem: subsystem,
@@ -118,11 +148,26 @@ func NewCircuitBreakerMetricsWithNamespace(namespace, subsystem string) *Circuit
 				// Buckets optimized for LLM API calls: 100ms to 30s
 				Buckets: []float64{0.1, 0.25, 0.5, 1.0, 2.0, 3.0, 5.0, 10.0, 30.0},
 			},
-			[]string{"result"}, // result=success|failure
+			[]string{"provider", "result"}, // result=success|failure
 		),
 	}
 }
 
+// forProvider returns the metrics of a single provider.
+func (v *circuitBreakerMetricVecs) forProvider(provider string) *CircuitBreakerMetrics {
+	labels := prometheus.Labels{"provider": provider}
+	return &CircuitBreakerMetrics{
+		State:            v.state.WithLabelValues(provider),
+		Failures:         v.failures.WithLabelValues(provider),
+		Successes:        v.successes.WithLabelValues(provider),
+		StateChanges:     v.stateChanges.MustCurryWith(labels),
+		RequestsBlocked:  v.requestsBlocked.WithLabelValues(provider),
+		HalfOpenRequests: v.halfOpenRequests.WithLabelValues(provider),
+		SlowCalls:        v.slowCalls.WithLabelValues(provider),
+		CallDuration:     v.callDuration.MustCurryWith(labels),
+	}
+}
+
 // RecordStateChange records a state transition in metrics.
 // Helper method for consistent metric recording.
 func (m *CircuitBreakerMetrics) RecordStateChange(from, to CircuitBreakerState) {
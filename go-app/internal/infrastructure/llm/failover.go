@@ -0,0 +1,177 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+)
+
+// NamedLLMClient is an LLM client identified by provider name.
+type NamedLLMClient interface {
+	LLMClient
+	Name() string
+}
+
+// failoverProvider is a provider with its own circuit breaker.
+type failoverProvider struct {
+	client  NamedLLMClient
+	breaker *CircuitBreaker // nil when circuit breaking is disabled
+}
+
+// FailoverClient implements LLMClient and GroupSummaryClient over an ordered
+// list of providers.
+//
+// Each request is sent to the first provider; on error the next provider is
+// tried. Every provider has its own circuit breaker, so a failing provider is
+// skipped without waiting for its timeout until the breaker half-opens.
+// Breakers report the llm_circuit_breaker metrics with a provider label.
+type FailoverClient struct {
+	providers []*failoverProvider
+	logger    *slog.Logger
+}
+
+// NewFailoverClient creates a failover client. Providers are tried in order.
+func NewFailoverClient(providers []NamedLLMClient, breakerConfig CircuitBreakerConfig, logger *slog.Logger) (*FailoverClient, error) {
+	if len(providers) == 0 {
+		return nil, fmt.Errorf("at least one LLM provider is required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	client := &FailoverClient{logger: logger}
+	names := make(map[string]struct{}, len(providers))
+	for _, provider := range providers {
+		if _, ok := names[provider.Name()]; ok {
+			return nil, fmt.Errorf("duplicate LLM provider name %q", provider.Name())
+		}
+		names[provider.Name()] = struct{}{}
+
+		entry := &failoverProvider{client: provider}
+		if breakerConfig.Enabled {
+			breaker, err := NewCircuitBreaker(breakerConfig, logger.With("provider", provider.Name()), NewProviderCircuitBreakerMetrics(provider.Name()))
+			if err != nil {
+				return nil, fmt.Errorf("provider %s: %w", provider.Name(), err)
+			}
+			entry.breaker = breaker
+		}
+		client.providers = append(client.providers, entry)
+	}
+	return client, nil
+}
+
+// NewFailoverClientFromConfig creates native provider clients sharing a
+// prompt template (empty: DefaultPromptTemplate) and the failover client.
+func NewFailoverClientFromConfig(
+	configs []ProviderConfig,
+	promptTemplate string,
+	breakerConfig CircuitBreakerConfig,
+	logger *slog.Logger,
+) (*FailoverClient, error) {
+	prompt, err := NewPromptTemplate(promptTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	providers := make([]NamedLLMClient, 0, len(configs))
+	for _, config := range configs {
+		provider, err := NewProviderClient(config, prompt, logger)
+		if err != nil {
+			return nil, err
+		}
+		providers = append(providers, provider)
+	}
+	return NewFailoverClient(providers, breakerConfig, logger)
+}
+
+// ClassifyAlert classifies the alert with the first provider that succeeds.
+func (c *FailoverClient) ClassifyAlert(ctx context.Context, alert *core.Alert) (*core.ClassificationResult, error) {
+	if alert == nil {
+		return nil, fmt.Errorf("alert cannot be nil")
+	}
+
+	var result *core.ClassificationResult
+	err := c.failover(ctx, "classify_alert", func(ctx context.Context, client NamedLLMClient) error {
+		var err error
+		result, err = client.ClassifyAlert(ctx, alert)
+		return err
+	})
+	return result, err
+}
+
+// failover calls the providers in order, each through its circuit breaker,
+// until call succeeds.
+func (c *FailoverClient) failover(ctx context.Context, operation string, call func(ctx context.Context, client NamedLLMClient) error) error {
+	var errs []error
+	for i, provider := range c.providers {
+		if ctx.Err() != nil {
+			errs = append(errs, ctx.Err())
+			break
+		}
+
+		err := provider.call(ctx, call)
+		if err == nil {
+			if i > 0 {
+				c.logger.Info("LLM request served by failover provider",
+					"operation", operation,
+					"provider", provider.client.Name(),
+					"failed_providers", i)
+			}
+			return nil
+		}
+
+		if errors.Is(err, ErrCircuitBreakerOpen) {
+			c.logger.Debug("LLM provider circuit breaker is open, skipping",
+				"provider", provider.client.Name())
+			err = fmt.Errorf("provider %s: %w", provider.client.Name(), err)
+		} else {
+			c.logger.Warn("LLM provider failed, trying next provider",
+				"operation", operation,
+				"provider", provider.client.Name(),
+				"error", err)
+		}
+		errs = append(errs, err)
+	}
+
+	return fmt.Errorf("all LLM providers failed: %w", errors.Join(errs...))
+}
+
+// call calls the provider through its circuit breaker.
+func (p *failoverProvider) call(ctx context.Context, call func(ctx context.Context, client NamedLLMClient) error) error {
+	if p.breaker == nil {
+		return call(ctx, p.client)
+	}
+	return p.breaker.Call(ctx, func(ctx context.Context) error {
+		return call(ctx, p.client)
+	})
+}
+
+// Health reports healthy when at least one provider is healthy.
+func (c *FailoverClient) Health(ctx context.Context) error {
+	var errs []error
+	for _, provider := range c.providers {
+		err := provider.client.Health(ctx)
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, err)
+	}
+	return fmt.Errorf("no healthy LLM provider: %w", errors.Join(errs...))
+}
+
+// ProviderStates returns the circuit breaker state of each provider by name.
+// Providers without circuit breaker are reported closed.
+func (c *FailoverClient) ProviderStates() map[string]CircuitBreakerState {
+	states := make(map[string]CircuitBreakerState, len(c.providers))
+	for _, provider := range c.providers {
+		state := StateClosed
+		if provider.breaker != nil {
+			state = provider.breaker.GetState()
+		}
+		states[provider.client.Name()] = state
+	}
+	return states
+}
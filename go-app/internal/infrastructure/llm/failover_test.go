@@ -0,0 +1,130 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+)
+
+// stubProvider is a NamedLLMClient returning a fixed result or error.
+type stubProvider struct {
+	name      string
+	err       error
+	healthErr error
+	calls     int
+}
+
+func (s *stubProvider) Name() string { return s.name }
+
+func (s *stubProvider) ClassifyAlert(ctx context.Context, alert *core.Alert) (*core.ClassificationResult, error) {
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &core.ClassificationResult{
+		Severity:   core.SeverityWarning,
+		Confidence: 0.8,
+		Metadata:   map[string]any{"provider": s.name},
+	}, nil
+}
+
+func (s *stubProvider) Health(ctx context.Context) error { return s.healthErr }
+
+func TestFailoverClient_UsesNextProvider(t *testing.T) {
+	primary := &stubProvider{name: "primary", err: &HTTPError{StatusCode: 503, Message: "unavailable"}}
+	secondary := &stubProvider{name: "secondary"}
+
+	client, err := NewFailoverClient([]NamedLLMClient{primary, secondary}, CircuitBreakerConfig{}, nil)
+	require.NoError(t, err)
+
+	result, err := client.ClassifyAlert(context.Background(), newProviderTestAlert())
+	require.NoError(t, err)
+	assert.Equal(t, "secondary", result.Metadata["provider"])
+	assert.Equal(t, 1, primary.calls)
+	assert.Equal(t, 1, secondary.calls)
+}
+
+func TestFailoverClient_CircuitBreakerSkipsProvider(t *testing.T) {
+	primary := &stubProvider{name: "primary", err: errors.New("connection refused")}
+	secondary := &stubProvider{name: "secondary"}
+
+	breakerConfig := DefaultCircuitBreakerConfig()
+	breakerConfig.MaxFailures = 2
+	breakerConfig.ResetTimeout = time.Minute
+	client, err := NewFailoverClient([]NamedLLMClient{primary, secondary}, breakerConfig, nil)
+	require.NoError(t, err)
+
+	for i := 0; i < 4; i++ {
+		_, err := client.ClassifyAlert(context.Background(), newProviderTestAlert())
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 2, primary.calls, "open breaker should skip the primary")
+	assert.Equal(t, 4, secondary.calls)
+	assert.Equal(t, StateOpen, client.ProviderStates()["primary"])
+	assert.Equal(t, StateClosed, client.ProviderStates()["secondary"])
+
+	// Each provider's breaker reports its own state series
+	assert.Equal(t, float64(StateOpen), testutil.ToFloat64(NewProviderCircuitBreakerMetrics("primary").State))
+	assert.Equal(t, float64(StateClosed), testutil.ToFloat64(NewProviderCircuitBreakerMetrics("secondary").State))
+}
+
+func TestFailoverClient_AllProvidersFail(t *testing.T) {
+	client, err := NewFailoverClient([]NamedLLMClient{
+		&stubProvider{name: "a", err: ErrInvalidResponse},
+		&stubProvider{name: "b", err: &HTTPError{StatusCode: 500, Message: "boom"}},
+	}, CircuitBreakerConfig{}, nil)
+	require.NoError(t, err)
+
+	_, err = client.ClassifyAlert(context.Background(), newProviderTestAlert())
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidResponse)
+	var httpErr *HTTPError
+	assert.ErrorAs(t, err, &httpErr)
+}
+
+func TestFailoverClient_Health(t *testing.T) {
+	down := &stubProvider{name: "down", healthErr: errors.New("down")}
+	up := &stubProvider{name: "up"}
+
+	client, err := NewFailoverClient([]NamedLLMClient{down, up}, CircuitBreakerConfig{}, nil)
+	require.NoError(t, err)
+	assert.NoError(t, client.Health(context.Background()))
+
+	client, err = NewFailoverClient([]NamedLLMClient{down}, CircuitBreakerConfig{}, nil)
+	require.NoError(t, err)
+	assert.Error(t, client.Health(context.Background()))
+}
+
+func TestNewFailoverClient_Invalid(t *testing.T) {
+	_, err := NewFailoverClient(nil, CircuitBreakerConfig{}, nil)
+	assert.Error(t, err)
+
+	_, err = NewFailoverClient([]NamedLLMClient{
+		&stubProvider{name: "openai"},
+		&stubProvider{name: "openai"},
+	}, CircuitBreakerConfig{}, nil)
+	assert.Error(t, err)
+
+	_, err = NewFailoverClientFromConfig([]ProviderConfig{
+		{Type: ProviderOllama, Model: "llama3.1"},
+	}, "{{ .Missing", CircuitBreakerConfig{}, nil)
+	assert.Error(t, err)
+}
+
+func TestParseStructuredClassification(t *testing.T) {
+	result, err := ParseStructuredClassification("Here you go:\n```json\n" + testClassificationJSON + "\n```")
+	require.NoError(t, err)
+	assert.Equal(t, core.SeverityCritical, result.Severity)
+	assert.Equal(t, "infrastructure", result.Metadata["category"])
+
+	_, err = ParseStructuredClassification("")
+	assert.ErrorIs(t, err, ErrInvalidResponse)
+}
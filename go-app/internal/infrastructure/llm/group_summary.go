@@ -0,0 +1,299 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/core/resilience"
+)
+
+// GroupSummaryRequest is an alert group to summarise.
+type GroupSummaryRequest struct {
+	GroupKey    string
+	GroupLabels map[string]string
+	Alerts      []*core.Alert
+
+	// Classifications maps alert fingerprint to its classification (optional)
+	Classifications map[string]*core.ClassificationResult
+}
+
+// GroupSummaryClient summarises alert groups (implemented by ProviderClient
+// and FailoverClient).
+type GroupSummaryClient interface {
+	SummarizeGroup(ctx context.Context, request *GroupSummaryRequest) (*core.GroupSummary, error)
+}
+
+// groupSummarySystemPrompt instructs providers to answer with the summary JSON only.
+const groupSummarySystemPrompt = "You are an SRE assistant that summarises groups of related monitoring alerts " +
+	"into a single incident. Respond only with a JSON object matching the requested schema."
+
+// Limits keeping group prompts small for large alert storms.
+const (
+	maxPromptLabelValues = 10
+	maxPromptLines       = 20
+)
+
+// groupSummaryOutput is the structured output of group summarisation.
+var groupSummaryOutput = structuredOutput{
+	name:        "alert_group_summary",
+	description: "Record the incident summary of the alert group.",
+	schema: map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"summary":         map[string]any{"type": "string"},
+			"root_cause":      map[string]any{"type": "string"},
+			"recommendations": map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+			"confidence":      map[string]any{"type": "number"},
+		},
+		"required":             []string{"summary", "root_cause", "recommendations", "confidence"},
+		"additionalProperties": false,
+	},
+}
+
+// SummarizeGroup summarises an alert group, retrying transient errors.
+func (c *ProviderClient) SummarizeGroup(ctx context.Context, request *GroupSummaryRequest) (*core.GroupSummary, error) {
+	if request == nil || len(request.Alerts) == 0 {
+		return nil, fmt.Errorf("%w: group has no alerts", ErrInvalidRequest)
+	}
+	prompt := RenderGroupSummaryPrompt(request)
+
+	summary, err := resilience.WithRetryFunc(ctx, c.retryPolicy("llm_summarize_group"), func() (*core.GroupSummary, error) {
+		content, err := c.complete(ctx, groupSummarySystemPrompt, prompt, groupSummaryOutput)
+		if err != nil {
+			return nil, err
+		}
+		return ParseStructuredGroupSummary(content)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("provider %s: %w", c.config.Name, err)
+	}
+
+	summary.Metadata = map[string]any{
+		"provider": c.config.Name,
+		"model":    c.config.Model,
+	}
+	c.logger.Info("Alert group summarised successfully",
+		"group_key", request.GroupKey,
+		"alerts", len(request.Alerts),
+		"confidence", summary.Confidence,
+	)
+	return summary, nil
+}
+
+// SummarizeGroup summarises the group with the first provider that succeeds.
+func (c *FailoverClient) SummarizeGroup(ctx context.Context, request *GroupSummaryRequest) (*core.GroupSummary, error) {
+	var summary *core.GroupSummary
+	err := c.failover(ctx, "summarize_group", func(ctx context.Context, client NamedLLMClient) error {
+		summarizer, ok := client.(GroupSummaryClient)
+		if !ok {
+			return fmt.Errorf("provider %s: group summaries not supported", client.Name())
+		}
+		var err error
+		summary, err = summarizer.SummarizeGroup(ctx, request)
+		return err
+	})
+	return summary, err
+}
+
+// structuredGroupSummary is the group summary structured output.
+type structuredGroupSummary struct {
+	Summary         string   `json:"summary"`
+	RootCause       string   `json:"root_cause"`
+	Recommendations []string `json:"recommendations"`
+	Confidence      *float64 `json:"confidence"`
+}
+
+// ParseStructuredGroupSummary parses a provider's structured output into a
+// group summary (Source llm). Markdown code fences around the JSON object
+// are tolerated. Errors wrap ErrInvalidResponse.
+func ParseStructuredGroupSummary(content string) (*core.GroupSummary, error) {
+	content = strings.TrimSpace(content)
+	if start, end := strings.Index(content, "{"), strings.LastIndex(content, "}"); start >= 0 && end > start {
+		content = content[start : end+1]
+	}
+
+	var parsed structuredGroupSummary
+	if err := json.Unmarshal([]byte(content), &parsed); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidResponse, err)
+	}
+	if strings.TrimSpace(parsed.Summary) == "" || parsed.Confidence == nil {
+		return nil, fmt.Errorf("%w: summary and confidence are required", ErrInvalidResponse)
+	}
+	if *parsed.Confidence < 0 || *parsed.Confidence > 1 {
+		return nil, fmt.Errorf("%w: confidence %v out of range [0, 1]", ErrInvalidResponse, *parsed.Confidence)
+	}
+
+	return &core.GroupSummary{
+		Summary:         strings.TrimSpace(parsed.Summary),
+		RootCause:       strings.TrimSpace(parsed.RootCause),
+		Recommendations: parsed.Recommendations,
+		Confidence:      *parsed.Confidence,
+		Source:          core.GroupSummarySourceLLM,
+		GeneratedAt:     time.Now(),
+	}, nil
+}
+
+// RenderGroupSummaryPrompt renders the summary prompt of an alert group.
+//
+// Alerts are aggregated rather than listed, so the prompt stays small for
+// storms of hundreds of alerts: labels shared by all alerts are listed once,
+// varying labels with a sample of their values, and annotation summaries and
+// per-alert classifications are deduplicated.
+func RenderGroupSummaryPrompt(request *GroupSummaryRequest) string {
+	var b strings.Builder
+	alerts := request.Alerts
+
+	firing := 0
+	names := make(map[string]int)
+	for _, alert := range alerts {
+		if alert.Status == core.StatusFiring {
+			firing++
+		}
+		names[alert.AlertName]++
+	}
+
+	b.WriteString("Summarise the following group of related alerts as a single incident.\n\n")
+	groupLabels, _ := toJSON(request.GroupLabels)
+	fmt.Fprintf(&b, "Group: %s\n", groupLabels)
+	fmt.Fprintf(&b, "Alerts: %d (%d firing, %d resolved)\n", len(alerts), firing, len(alerts)-firing)
+	fmt.Fprintf(&b, "Alert names: %s\n", formatCounts(names))
+
+	common, varying := splitAlertLabels(alerts)
+	commonJSON, _ := toJSON(common)
+	fmt.Fprintf(&b, "Common labels: %s\n", commonJSON)
+	if len(varying) > 0 {
+		b.WriteString("Varying labels:\n")
+		for _, name := range sortedMapKeys(varying) {
+			values := varying[name]
+			sample := values
+			if len(sample) > maxPromptLabelValues {
+				sample = sample[:maxPromptLabelValues]
+			}
+			fmt.Fprintf(&b, "- %s (%d values): %s", name, len(values), strings.Join(sample, ", "))
+			if len(values) > len(sample) {
+				b.WriteString(", ...")
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	summaries := make(map[string]int)
+	for _, alert := range alerts {
+		for _, key := range []string{"summary", "description", "message"} {
+			if text := strings.TrimSpace(alert.Annotations[key]); text != "" {
+				summaries[text]++
+				break
+			}
+		}
+	}
+	writeCountedLines(&b, "Alert descriptions", summaries)
+
+	severities := make(map[string]int)
+	recommendations := make(map[string]int)
+	for _, alert := range alerts {
+		classification := request.Classifications[alert.Fingerprint]
+		if classification == nil {
+			continue
+		}
+		severities[string(classification.Severity)]++
+		for _, recommendation := range classification.Recommendations {
+			if recommendation = strings.TrimSpace(recommendation); recommendation != "" {
+				recommendations[recommendation]++
+			}
+		}
+	}
+	if len(severities) > 0 {
+		fmt.Fprintf(&b, "Per-alert severities: %s\n", formatCounts(severities))
+	}
+	writeCountedLines(&b, "Per-alert recommendations", recommendations)
+
+	b.WriteString(`
+Provide:
+1. summary (one or two sentences describing the incident as a whole)
+2. root_cause (the most probable common root cause)
+3. recommendations (deduplicated list of actions, most important first)
+4. confidence (0.0-1.0)`)
+	return b.String()
+}
+
+// splitAlertLabels returns the labels shared by all alerts and the sorted
+// distinct values of the others.
+func splitAlertLabels(alerts []*core.Alert) (common map[string]string, varying map[string][]string) {
+	values := make(map[string]map[string]struct{})
+	present := make(map[string]int)
+	for _, alert := range alerts {
+		for name, value := range alert.Labels {
+			if values[name] == nil {
+				values[name] = make(map[string]struct{})
+			}
+			values[name][value] = struct{}{}
+			present[name]++
+		}
+	}
+
+	common = make(map[string]string)
+	varying = make(map[string][]string)
+	for name, set := range values {
+		if len(set) == 1 && present[name] == len(alerts) {
+			for value := range set {
+				common[name] = value
+			}
+			continue
+		}
+		list := make([]string, 0, len(set))
+		for value := range set {
+			list = append(list, value)
+		}
+		sort.Strings(list)
+		varying[name] = list
+	}
+	return common, varying
+}
+
+// writeCountedLines writes a titled list of lines by descending count.
+func writeCountedLines(b *strings.Builder, title string, counts map[string]int) {
+	if len(counts) == 0 {
+		return
+	}
+	lines := sortedByCount(counts)
+	fmt.Fprintf(b, "%s:\n", title)
+	for i, line := range lines {
+		if i == maxPromptLines {
+			fmt.Fprintf(b, "- ... (%d more)\n", len(lines)-i)
+			break
+		}
+		fmt.Fprintf(b, "- %s (x%d)\n", line, counts[line])
+	}
+}
+
+// formatCounts formats counts as "a (3), b (1)" by descending count.
+func formatCounts(counts map[string]int) string {
+	parts := make([]string, 0, len(counts))
+	for _, key := range sortedByCount(counts) {
+		parts = append(parts, fmt.Sprintf("%s (%d)", key, counts[key]))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// sortedByCount returns the keys by descending count, then name.
+func sortedByCount(counts map[string]int) []string {
+	keys := sortedMapKeys(counts)
+	sort.SliceStable(keys, func(i, j int) bool {
+		return counts[keys[i]] > counts[keys[j]]
+	})
+	return keys
+}
+
+func sortedMapKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,123 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+)
+
+const testGroupSummaryJSON = `{"summary": "80 api pods crash-looping in prod", "root_cause": "Bad config rollout",` +
+	` "recommendations": ["Roll back the deployment"], "confidence": 0.8}`
+
+// newStormRequest returns a group of count pods firing the same alert.
+func newStormRequest(count int) *GroupSummaryRequest {
+	request := &GroupSummaryRequest{
+		GroupKey:        "{}:{alertname=\"PodCrashLooping\"}",
+		GroupLabels:     map[string]string{"alertname": "PodCrashLooping"},
+		Classifications: make(map[string]*core.ClassificationResult),
+	}
+	for i := 0; i < count; i++ {
+		fingerprint := fmt.Sprintf("fp-%02d", i)
+		request.Alerts = append(request.Alerts, &core.Alert{
+			Fingerprint: fingerprint,
+			AlertName:   "PodCrashLooping",
+			Status:      core.StatusFiring,
+			Labels: map[string]string{
+				"alertname": "PodCrashLooping",
+				"namespace": "prod",
+				"pod":       fmt.Sprintf("api-%02d", i),
+			},
+			Annotations: map[string]string{"summary": "Pod is crash looping"},
+		})
+		request.Classifications[fingerprint] = &core.ClassificationResult{
+			Severity:        core.SeverityCritical,
+			Recommendations: []string{"Check pod logs"},
+		}
+	}
+	return request
+}
+
+func TestProviderClient_SummarizeGroup(t *testing.T) {
+	var request map[string]any
+	var headers http.Header
+	server := newProviderTestServer(t, "/chat/completions", map[string]any{
+		"choices": []any{map[string]any{
+			"message": map[string]any{"role": "assistant", "content": testGroupSummaryJSON},
+		}},
+	}, &request, &headers)
+
+	client, err := NewProviderClient(ProviderConfig{
+		Type:    ProviderOpenAI,
+		BaseURL: server.URL,
+		Model:   "gpt-4o-mini",
+	}, nil, nil)
+	require.NoError(t, err)
+
+	summary, err := client.SummarizeGroup(context.Background(), newStormRequest(80))
+	require.NoError(t, err)
+	assert.Equal(t, "80 api pods crash-looping in prod", summary.Summary)
+	assert.Equal(t, "Bad config rollout", summary.RootCause)
+	assert.Equal(t, []string{"Roll back the deployment"}, summary.Recommendations)
+	assert.Equal(t, core.GroupSummarySourceLLM, summary.Source)
+	assert.Equal(t, "openai", summary.Metadata["provider"])
+
+	format := request["response_format"].(map[string]any)
+	assert.Equal(t, groupSummaryOutput.name, format["json_schema"].(map[string]any)["name"])
+
+	_, err = client.SummarizeGroup(context.Background(), &GroupSummaryRequest{})
+	assert.ErrorIs(t, err, ErrInvalidRequest)
+}
+
+func TestRenderGroupSummaryPrompt(t *testing.T) {
+	prompt := RenderGroupSummaryPrompt(newStormRequest(80))
+
+	assert.Contains(t, prompt, "Alerts: 80 (80 firing, 0 resolved)")
+	assert.Contains(t, prompt, "Alert names: PodCrashLooping (80)")
+	assert.Contains(t, prompt, `"namespace":"prod"`)
+	assert.Contains(t, prompt, "- pod (80 values): api-00, api-01,")
+	assert.Contains(t, prompt, "api-09, ...")
+	assert.NotContains(t, prompt, "api-10")
+	assert.Contains(t, prompt, "- Pod is crash looping (x80)")
+	assert.Contains(t, prompt, "Per-alert severities: critical (80)")
+	assert.Contains(t, prompt, "- Check pod logs (x80)")
+	assert.Less(t, strings.Count(prompt, "\n"), 30)
+}
+
+func TestParseStructuredGroupSummary(t *testing.T) {
+	summary, err := ParseStructuredGroupSummary("```json\n" + testGroupSummaryJSON + "\n```")
+	require.NoError(t, err)
+	assert.Equal(t, 0.8, summary.Confidence)
+
+	for _, content := range []string{
+		"not json",
+		`{"summary": "", "confidence": 0.5}`,
+		`{"summary": "x"}`,
+		`{"summary": "x", "confidence": 2}`,
+	} {
+		_, err := ParseStructuredGroupSummary(content)
+		assert.ErrorIs(t, err, ErrInvalidResponse, content)
+	}
+}
+
+func TestFailoverClient_SummarizeGroup(t *testing.T) {
+	server := newProviderTestServer(t, "/api/chat", map[string]any{
+		"message": map[string]any{"role": "assistant", "content": testGroupSummaryJSON},
+	}, new(map[string]any), new(http.Header))
+	ollama, err := NewProviderClient(ProviderConfig{Type: ProviderOllama, BaseURL: server.URL, Model: "llama3.1"}, nil, nil)
+	require.NoError(t, err)
+
+	// stubProvider does not implement GroupSummaryClient and is skipped
+	client, err := NewFailoverClient([]NamedLLMClient{&stubProvider{name: "legacy"}, ollama}, CircuitBreakerConfig{}, nil)
+	require.NoError(t, err)
+
+	summary, err := client.SummarizeGroup(context.Background(), newStormRequest(3))
+	require.NoError(t, err)
+	assert.Equal(t, "ollama", summary.Metadata["provider"])
+}
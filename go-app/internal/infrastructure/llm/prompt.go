@@ -0,0 +1,153 @@
+package llm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+)
+
+// classificationSystemPrompt instructs providers to answer with the classification JSON only.
+const classificationSystemPrompt = "You are an SRE assistant that classifies monitoring alerts. " +
+	"Respond only with a JSON object matching the requested schema."
+
+// DefaultPromptTemplate is the default classification prompt for native providers.
+//
+// Templates are rendered with LLMAlertRequest data and the toJSON function.
+const DefaultPromptTemplate = `Classify the following alert.
+
+Alert: {{ .AlertName }} ({{ .Status }})
+Labels: {{ toJSON .Labels }}
+Annotations: {{ toJSON .Annotations }}
+Started at: {{ .StartsAt }}{{ if .EndsAt }}
+Ended at: {{ .EndsAt }}{{ end }}
+
+Provide:
+1. severity (1=noise, 2=info, 3=warning, 4=critical)
+2. category (infrastructure, application, security, etc.)
+3. summary (brief description)
+4. confidence (0.0-1.0)
+5. reasoning (why this classification)
+6. suggestions (list of recommended actions)`
+
+// classificationToolName is the structured output name used by providers.
+const classificationToolName = "alert_classification"
+
+// classificationSchema is the JSON schema of LLMClassificationResponse, used
+// for provider structured output (OpenAI json_schema, Anthropic tool input,
+// Ollama format).
+var classificationSchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"severity":    map[string]any{"type": "integer", "enum": []int{1, 2, 3, 4}},
+		"category":    map[string]any{"type": "string"},
+		"summary":     map[string]any{"type": "string"},
+		"confidence":  map[string]any{"type": "number"},
+		"reasoning":   map[string]any{"type": "string"},
+		"suggestions": map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+	},
+	"required":             []string{"severity", "category", "summary", "confidence", "reasoning", "suggestions"},
+	"additionalProperties": false,
+}
+
+// structuredOutput is a named JSON schema requested from providers.
+type structuredOutput struct {
+	name        string
+	description string
+	schema      map[string]any
+}
+
+// classificationOutput is the structured output of alert classification.
+var classificationOutput = structuredOutput{
+	name:        classificationToolName,
+	description: "Record the alert classification.",
+	schema:      classificationSchema,
+}
+
+// PromptTemplate renders classification prompts from alerts.
+type PromptTemplate struct {
+	tmpl *template.Template
+}
+
+// NewPromptTemplate parses a classification prompt template.
+// An empty text uses DefaultPromptTemplate.
+func NewPromptTemplate(text string) (*PromptTemplate, error) {
+	if strings.TrimSpace(text) == "" {
+		text = DefaultPromptTemplate
+	}
+
+	tmpl, err := template.New("classification").
+		Option("missingkey=zero").
+		Funcs(template.FuncMap{"toJSON": toJSON}).
+		Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid prompt template: %w", err)
+	}
+	return &PromptTemplate{tmpl: tmpl}, nil
+}
+
+// Render renders the prompt for an alert.
+func (p *PromptTemplate) Render(alert *core.Alert) (string, error) {
+	data := CoreAlertToLLMRequest(alert)
+	if data == nil {
+		return "", fmt.Errorf("%w: alert cannot be nil", ErrInvalidRequest)
+	}
+
+	var buf bytes.Buffer
+	if err := p.tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("%w: render prompt: %v", ErrInvalidRequest, err)
+	}
+	return buf.String(), nil
+}
+
+func toJSON(v any) (string, error) {
+	data, err := json.Marshal(v)
+	return string(data), err
+}
+
+// structuredClassification is LLMClassificationResponse with required numeric fields.
+type structuredClassification struct {
+	Severity    *int     `json:"severity"`
+	Category    string   `json:"category"`
+	Summary     string   `json:"summary"`
+	Confidence  *float64 `json:"confidence"`
+	Reasoning   string   `json:"reasoning"`
+	Suggestions []string `json:"suggestions"`
+}
+
+// ParseStructuredClassification parses a provider's structured output into a
+// classification result. Markdown code fences around the JSON object are
+// tolerated. Errors wrap ErrInvalidResponse.
+func ParseStructuredClassification(content string) (*core.ClassificationResult, error) {
+	content = strings.TrimSpace(content)
+	if start, end := strings.Index(content, "{"), strings.LastIndex(content, "}"); start >= 0 && end > start {
+		content = content[start : end+1]
+	}
+
+	var parsed structuredClassification
+	if err := json.Unmarshal([]byte(content), &parsed); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidResponse, err)
+	}
+	if parsed.Severity == nil || parsed.Confidence == nil {
+		return nil, fmt.Errorf("%w: severity and confidence are required", ErrInvalidResponse)
+	}
+	if *parsed.Confidence < 0 || *parsed.Confidence > 1 {
+		return nil, fmt.Errorf("%w: confidence %v out of range [0, 1]", ErrInvalidResponse, *parsed.Confidence)
+	}
+
+	result, err := LLMResponseToCoreClassification(&LLMClassificationResponse{
+		Severity:    *parsed.Severity,
+		Category:    parsed.Category,
+		Summary:     parsed.Summary,
+		Confidence:  *parsed.Confidence,
+		Reasoning:   parsed.Reasoning,
+		Suggestions: parsed.Suggestions,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidResponse, err)
+	}
+	return result, nil
+}
@@ -0,0 +1,277 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/core/resilience"
+	"github.com/vitaliisemenov/alert-history/pkg/metrics"
+)
+
+// ProviderType identifies a native LLM provider API.
+type ProviderType string
+
+const (
+	// ProviderOpenAI is the OpenAI-compatible chat completions API
+	// (OpenAI, Azure OpenAI, vLLM, LiteLLM, ...).
+	ProviderOpenAI ProviderType = "openai"
+	// ProviderAnthropic is the Anthropic Messages API.
+	ProviderAnthropic ProviderType = "anthropic"
+	// ProviderOllama is the local Ollama chat API.
+	ProviderOllama ProviderType = "ollama"
+)
+
+// maxResponseBytes limits provider response bodies.
+const maxResponseBytes = 4 << 20
+
+// ProviderConfig holds configuration for a native LLM provider backend.
+type ProviderConfig struct {
+	Name        string        `mapstructure:"name"` // defaults to Type
+	Type        ProviderType  `mapstructure:"type"`
+	BaseURL     string        `mapstructure:"base_url"` // defaults to the provider's public endpoint
+	APIKey      string        `mapstructure:"api_key"`
+	Model       string        `mapstructure:"model"`
+	MaxTokens   int           `mapstructure:"max_tokens"`
+	Temperature float64       `mapstructure:"temperature"`
+	Timeout     time.Duration `mapstructure:"timeout"`     // per-request timeout (default 30s)
+	MaxRetries  int           `mapstructure:"max_retries"` // retries of transient errors before failover
+	RetryDelay  time.Duration `mapstructure:"retry_delay"`
+}
+
+// withDefaults returns the configuration with provider defaults applied.
+func (c ProviderConfig) withDefaults() ProviderConfig {
+	if c.Name == "" {
+		c.Name = string(c.Type)
+	}
+	if c.BaseURL == "" {
+		switch c.Type {
+		case ProviderOpenAI:
+			c.BaseURL = "https://api.openai.com/v1"
+		case ProviderAnthropic:
+			c.BaseURL = "https://api.anthropic.com"
+		case ProviderOllama:
+			c.BaseURL = "http://localhost:11434"
+		}
+	}
+	c.BaseURL = strings.TrimSuffix(c.BaseURL, "/")
+	if c.MaxTokens <= 0 {
+		c.MaxTokens = 1000
+	}
+	if c.Timeout <= 0 {
+		c.Timeout = 30 * time.Second
+	}
+	if c.RetryDelay <= 0 {
+		c.RetryDelay = time.Second
+	}
+	return c
+}
+
+// providerBackend performs the provider-specific API calls.
+type providerBackend interface {
+	// complete sends the prompts and returns the structured output JSON.
+	complete(ctx context.Context, system, prompt string, output structuredOutput) (string, error)
+	health(ctx context.Context) error
+}
+
+// ProviderClient implements LLMClient and GroupSummaryClient on a native
+// provider API with structured (JSON schema) output parsed into
+// core.ClassificationResult and core.GroupSummary.
+type ProviderClient struct {
+	config  ProviderConfig
+	prompt  *PromptTemplate
+	backend providerBackend
+	logger  *slog.Logger
+}
+
+// NewProviderClient creates a client for a native provider.
+// A nil prompt uses DefaultPromptTemplate.
+func NewProviderClient(config ProviderConfig, prompt *PromptTemplate, logger *slog.Logger) (*ProviderClient, error) {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if prompt == nil {
+		var err error
+		if prompt, err = NewPromptTemplate(""); err != nil {
+			return nil, err
+		}
+	}
+
+	config = config.withDefaults()
+	if config.Model == "" {
+		return nil, fmt.Errorf("provider %s: model is required", config.Name)
+	}
+
+	api := &providerAPI{
+		config:     config,
+		httpClient: &http.Client{Timeout: config.Timeout},
+	}
+	var backend providerBackend
+	switch config.Type {
+	case ProviderOpenAI:
+		backend = &openAIBackend{api}
+	case ProviderAnthropic:
+		if config.APIKey == "" {
+			return nil, fmt.Errorf("provider %s: api_key is required", config.Name)
+		}
+		backend = &anthropicBackend{api}
+	case ProviderOllama:
+		backend = &ollamaBackend{api}
+	default:
+		return nil, fmt.Errorf("provider %s: unknown type %q (expected openai, anthropic or ollama)", config.Name, config.Type)
+	}
+
+	return &ProviderClient{
+		config:  config,
+		prompt:  prompt,
+		backend: backend,
+		logger:  logger.With("provider", config.Name),
+	}, nil
+}
+
+// Name returns the provider name.
+func (c *ProviderClient) Name() string {
+	return c.config.Name
+}
+
+// ClassifyAlert classifies an alert, retrying transient errors.
+func (c *ProviderClient) ClassifyAlert(ctx context.Context, alert *core.Alert) (*core.ClassificationResult, error) {
+	if alert == nil {
+		return nil, fmt.Errorf("alert cannot be nil")
+	}
+	prompt, err := c.prompt.Render(alert)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := resilience.WithRetryFunc(ctx, c.retryPolicy("llm_classify_alert"), func() (*core.ClassificationResult, error) {
+		return c.classifyOnce(ctx, prompt)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("provider %s: %w", c.config.Name, err)
+	}
+
+	c.logger.Info("Alert classified successfully",
+		"alert", alert.AlertName,
+		"severity", result.Severity,
+		"confidence", result.Confidence,
+	)
+	return result, nil
+}
+
+// retryPolicy returns the retry policy for transient provider errors.
+func (c *ProviderClient) retryPolicy(operation string) *resilience.RetryPolicy {
+	return &resilience.RetryPolicy{
+		MaxRetries:    c.config.MaxRetries,
+		BaseDelay:     c.config.RetryDelay,
+		MaxDelay:      c.config.RetryDelay * 10,
+		Multiplier:    2.0,
+		Jitter:        true,
+		ErrorChecker:  &llmErrorChecker{},
+		Logger:        c.logger,
+		Metrics:       metrics.DefaultRegistry().Technical().Retry,
+		OperationName: operation,
+	}
+}
+
+// classifyOnce performs a single classification request within the provider timeout.
+func (c *ProviderClient) classifyOnce(ctx context.Context, prompt string) (*core.ClassificationResult, error) {
+	start := time.Now()
+	content, err := c.complete(ctx, classificationSystemPrompt, prompt, classificationOutput)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := ParseStructuredClassification(content)
+	if err != nil {
+		return nil, err
+	}
+	result.ProcessingTime = time.Since(start).Seconds()
+	if result.Metadata == nil {
+		result.Metadata = map[string]any{}
+	}
+	result.Metadata["provider"] = c.config.Name
+	result.Metadata["model"] = c.config.Model
+	return result, nil
+}
+
+// complete performs a single structured output request within the provider timeout.
+func (c *ProviderClient) complete(ctx context.Context, system, prompt string, output structuredOutput) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+	return c.backend.complete(ctx, system, prompt, output)
+}
+
+// Health checks if the provider API is reachable and authorized.
+func (c *ProviderClient) Health(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+	if err := c.backend.health(ctx); err != nil {
+		return fmt.Errorf("provider %s: %w", c.config.Name, err)
+	}
+	return nil
+}
+
+// providerAPI holds the HTTP plumbing shared by provider backends.
+type providerAPI struct {
+	config     ProviderConfig
+	httpClient *http.Client
+}
+
+// do sends a JSON request and decodes the JSON response into out.
+// Non-2xx responses are returned as *HTTPError.
+func (a *providerAPI) do(ctx context.Context, method, path string, headers map[string]string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, a.config.BaseURL+path, body)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "alert-history-go/1.0.0")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("HTTP request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return &HTTPError{
+			StatusCode: resp.StatusCode,
+			Message:    fmt.Sprintf("%s API error: status %d, body: %s", a.config.Name, resp.StatusCode, string(data)),
+		}
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidResponse, err)
+	}
+	return nil
+}
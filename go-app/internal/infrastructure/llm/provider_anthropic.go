@@ -0,0 +1,97 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// anthropicVersion is the Anthropic API version header value.
+const anthropicVersion = "2023-06-01"
+
+// anthropicBackend talks to the Anthropic Messages API.
+//
+// Structured output uses a forced tool call whose input schema is the
+// output schema.
+type anthropicBackend struct {
+	*providerAPI
+}
+
+type anthropicMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type anthropicTool struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	InputSchema map[string]any `json:"input_schema"`
+}
+
+type anthropicRequest struct {
+	Model       string             `json:"model"`
+	System      string             `json:"system"`
+	Messages    []anthropicMessage `json:"messages"`
+	MaxTokens   int                `json:"max_tokens"`
+	Temperature float64            `json:"temperature"`
+	Tools       []anthropicTool    `json:"tools"`
+	ToolChoice  map[string]string  `json:"tool_choice"`
+}
+
+type anthropicResponse struct {
+	Content []struct {
+		Type  string         `json:"type"`
+		Text  string         `json:"text"`
+		Name  string         `json:"name"`
+		Input map[string]any `json:"input"`
+	} `json:"content"`
+	StopReason string `json:"stop_reason"`
+}
+
+func (b *anthropicBackend) headers() map[string]string {
+	return map[string]string{
+		"x-api-key":         b.config.APIKey,
+		"anthropic-version": anthropicVersion,
+	}
+}
+
+func (b *anthropicBackend) complete(ctx context.Context, system, prompt string, output structuredOutput) (string, error) {
+	request := anthropicRequest{
+		Model:       b.config.Model,
+		System:      system,
+		Messages:    []anthropicMessage{{Role: "user", Content: prompt}},
+		MaxTokens:   b.config.MaxTokens,
+		Temperature: b.config.Temperature,
+		Tools: []anthropicTool{{
+			Name:        output.name,
+			Description: output.description,
+			InputSchema: output.schema,
+		}},
+		ToolChoice: map[string]string{"type": "tool", "name": output.name},
+	}
+
+	var response anthropicResponse
+	if err := b.do(ctx, http.MethodPost, "/v1/messages", b.headers(), request, &response); err != nil {
+		return "", err
+	}
+
+	for _, block := range response.Content {
+		if block.Type == "tool_use" && block.Name == output.name {
+			input, err := toJSON(block.Input)
+			if err != nil {
+				return "", fmt.Errorf("%w: %v", ErrInvalidResponse, err)
+			}
+			return input, nil
+		}
+	}
+	for _, block := range response.Content {
+		if block.Type == "text" {
+			return block.Text, nil
+		}
+	}
+	return "", fmt.Errorf("%w: no %s in response (stop_reason %s)", ErrInvalidResponse, output.name, response.StopReason)
+}
+
+func (b *anthropicBackend) health(ctx context.Context) error {
+	return b.do(ctx, http.MethodGet, "/v1/models", b.headers(), nil, nil)
+}
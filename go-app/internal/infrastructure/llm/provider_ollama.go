@@ -0,0 +1,59 @@
+package llm
+
+import (
+	"context"
+	"net/http"
+)
+
+// ollamaBackend talks to the local Ollama chat API.
+//
+// Structured output uses the format parameter with the output schema.
+type ollamaBackend struct {
+	*providerAPI
+}
+
+type ollamaChatRequest struct {
+	Model    string          `json:"model"`
+	Messages []openAIMessage `json:"messages"`
+	Stream   bool            `json:"stream"`
+	Format   map[string]any  `json:"format"`
+	Options  map[string]any  `json:"options,omitempty"`
+}
+
+type ollamaChatResponse struct {
+	Message struct {
+		Content string `json:"content"`
+	} `json:"message"`
+}
+
+func (b *ollamaBackend) headers() map[string]string {
+	if b.config.APIKey == "" {
+		return nil
+	}
+	return map[string]string{"Authorization": "Bearer " + b.config.APIKey}
+}
+
+func (b *ollamaBackend) complete(ctx context.Context, system, prompt string, output structuredOutput) (string, error) {
+	request := ollamaChatRequest{
+		Model: b.config.Model,
+		Messages: []openAIMessage{
+			{Role: "system", Content: system},
+			{Role: "user", Content: prompt},
+		},
+		Format: output.schema,
+		Options: map[string]any{
+			"temperature": b.config.Temperature,
+			"num_predict": b.config.MaxTokens,
+		},
+	}
+
+	var response ollamaChatResponse
+	if err := b.do(ctx, http.MethodPost, "/api/chat", b.headers(), request, &response); err != nil {
+		return "", err
+	}
+	return response.Message.Content, nil
+}
+
+func (b *ollamaBackend) health(ctx context.Context) error {
+	return b.do(ctx, http.MethodGet, "/api/tags", b.headers(), nil, nil)
+}
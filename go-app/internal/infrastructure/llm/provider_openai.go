@@ -0,0 +1,82 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// openAIBackend talks to OpenAI-compatible chat completions APIs.
+//
+// Structured output uses response_format json_schema (strict mode).
+type openAIBackend struct {
+	*providerAPI
+}
+
+type openAIMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type openAIChatRequest struct {
+	Model          string          `json:"model"`
+	Messages       []openAIMessage `json:"messages"`
+	MaxTokens      int             `json:"max_tokens,omitempty"`
+	Temperature    float64         `json:"temperature"`
+	ResponseFormat map[string]any  `json:"response_format"`
+}
+
+type openAIChatResponse struct {
+	Choices []struct {
+		Message struct {
+			Content string `json:"content"`
+			Refusal string `json:"refusal"`
+		} `json:"message"`
+		FinishReason string `json:"finish_reason"`
+	} `json:"choices"`
+}
+
+func (b *openAIBackend) headers() map[string]string {
+	if b.config.APIKey == "" {
+		return nil
+	}
+	return map[string]string{"Authorization": "Bearer " + b.config.APIKey}
+}
+
+func (b *openAIBackend) complete(ctx context.Context, system, prompt string, output structuredOutput) (string, error) {
+	request := openAIChatRequest{
+		Model: b.config.Model,
+		Messages: []openAIMessage{
+			{Role: "system", Content: system},
+			{Role: "user", Content: prompt},
+		},
+		MaxTokens:   b.config.MaxTokens,
+		Temperature: b.config.Temperature,
+		ResponseFormat: map[string]any{
+			"type": "json_schema",
+			"json_schema": map[string]any{
+				"name":   output.name,
+				"strict": true,
+				"schema": output.schema,
+			},
+		},
+	}
+
+	var response openAIChatResponse
+	if err := b.do(ctx, http.MethodPost, "/chat/completions", b.headers(), request, &response); err != nil {
+		return "", err
+	}
+	if len(response.Choices) == 0 {
+		return "", fmt.Errorf("%w: no choices in response", ErrInvalidResponse)
+	}
+
+	message := response.Choices[0].Message
+	if message.Refusal != "" {
+		return "", fmt.Errorf("%w: model refused: %s", ErrInvalidResponse, message.Refusal)
+	}
+	return message.Content, nil
+}
+
+func (b *openAIBackend) health(ctx context.Context) error {
+	return b.do(ctx, http.MethodGet, "/models", b.headers(), nil, nil)
+}
@@ -0,0 +1,236 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+)
+
+const testClassificationJSON = `{"severity": 4, "category": "infrastructure", "summary": "Disk full",` +
+	` "confidence": 0.9, "reasoning": "Root volume at 100%", "suggestions": ["Free space"]}`
+
+func newProviderTestAlert() *core.Alert {
+	return &core.Alert{
+		Fingerprint: "abc123",
+		AlertName:   "DiskFull",
+		Status:      core.StatusFiring,
+		Labels:      map[string]string{"instance": "db-1"},
+		Annotations: map[string]string{"summary": "Disk full on db-1"},
+		StartsAt:    time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+}
+
+// newProviderTestServer serves POST path (recording the decoded request body
+// and headers) and answers health check GETs.
+func newProviderTestServer(t *testing.T, path string, response any, request *map[string]any, headers *http.Header) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(`{"data": []}`))
+			return
+		}
+		if r.URL.Path != path {
+			http.NotFound(w, r)
+			return
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(request))
+		*headers = r.Header.Clone()
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestProviderClient_OpenAI(t *testing.T) {
+	var request map[string]any
+	var headers http.Header
+	server := newProviderTestServer(t, "/v1/chat/completions", map[string]any{
+		"choices": []any{map[string]any{
+			"message":       map[string]any{"role": "assistant", "content": testClassificationJSON},
+			"finish_reason": "stop",
+		}},
+	}, &request, &headers)
+
+	client, err := NewProviderClient(ProviderConfig{
+		Type:    ProviderOpenAI,
+		BaseURL: server.URL + "/v1/",
+		APIKey:  "sk-test",
+		Model:   "gpt-4o-mini",
+	}, nil, nil)
+	require.NoError(t, err)
+
+	result, err := client.ClassifyAlert(context.Background(), newProviderTestAlert())
+	require.NoError(t, err)
+	assert.Equal(t, core.SeverityCritical, result.Severity)
+	assert.Equal(t, 0.9, result.Confidence)
+	assert.Equal(t, []string{"Free space"}, result.Recommendations)
+	assert.Equal(t, "openai", result.Metadata["provider"])
+	assert.Equal(t, "infrastructure", result.Metadata["category"])
+
+	assert.Equal(t, "Bearer sk-test", headers.Get("Authorization"))
+	assert.Equal(t, "gpt-4o-mini", request["model"])
+	format := request["response_format"].(map[string]any)
+	assert.Equal(t, "json_schema", format["type"])
+	assert.Equal(t, true, format["json_schema"].(map[string]any)["strict"])
+	messages := request["messages"].([]any)
+	require.Len(t, messages, 2)
+	assert.Contains(t, messages[1].(map[string]any)["content"], `{"instance":"db-1"}`)
+
+	assert.NoError(t, client.Health(context.Background()))
+}
+
+func TestProviderClient_Anthropic(t *testing.T) {
+	var request map[string]any
+	var headers http.Header
+	var input map[string]any
+	require.NoError(t, json.Unmarshal([]byte(testClassificationJSON), &input))
+	server := newProviderTestServer(t, "/v1/messages", map[string]any{
+		"content": []any{
+			map[string]any{"type": "text", "text": "Classifying the alert."},
+			map[string]any{"type": "tool_use", "name": classificationToolName, "input": input},
+		},
+		"stop_reason": "tool_use",
+	}, &request, &headers)
+
+	client, err := NewProviderClient(ProviderConfig{
+		Name:    "claude",
+		Type:    ProviderAnthropic,
+		BaseURL: server.URL,
+		APIKey:  "anthropic-key",
+		Model:   "claude-sonnet-4-5",
+	}, nil, nil)
+	require.NoError(t, err)
+
+	result, err := client.ClassifyAlert(context.Background(), newProviderTestAlert())
+	require.NoError(t, err)
+	assert.Equal(t, core.SeverityCritical, result.Severity)
+	assert.Equal(t, "claude", result.Metadata["provider"])
+
+	assert.Equal(t, "anthropic-key", headers.Get("x-api-key"))
+	assert.Equal(t, anthropicVersion, headers.Get("anthropic-version"))
+	assert.Equal(t, map[string]any{"type": "tool", "name": classificationToolName}, request["tool_choice"])
+	assert.NotEmpty(t, request["system"])
+	assert.EqualValues(t, 1000, request["max_tokens"])
+
+	_, err = NewProviderClient(ProviderConfig{Type: ProviderAnthropic, Model: "claude-sonnet-4-5"}, nil, nil)
+	assert.Error(t, err, "api_key is required")
+}
+
+func TestProviderClient_Ollama(t *testing.T) {
+	var request map[string]any
+	var headers http.Header
+	server := newProviderTestServer(t, "/api/chat", map[string]any{
+		"message": map[string]any{"role": "assistant", "content": "```json\n" + testClassificationJSON + "\n```"},
+		"done":    true,
+	}, &request, &headers)
+
+	prompt, err := NewPromptTemplate("Alert {{ .AlertName }} on {{ index .Labels \"instance\" }}")
+	require.NoError(t, err)
+	client, err := NewProviderClient(ProviderConfig{
+		Type:        ProviderOllama,
+		BaseURL:     server.URL,
+		Model:       "llama3.1",
+		Temperature: 0.1,
+	}, prompt, nil)
+	require.NoError(t, err)
+
+	result, err := client.ClassifyAlert(context.Background(), newProviderTestAlert())
+	require.NoError(t, err)
+	assert.Equal(t, core.SeverityCritical, result.Severity)
+
+	assert.Equal(t, false, request["stream"])
+	assert.Equal(t, "object", request["format"].(map[string]any)["type"])
+	assert.Equal(t, 0.1, request["options"].(map[string]any)["temperature"])
+	messages := request["messages"].([]any)
+	assert.Equal(t, "Alert DiskFull on db-1", messages[1].(map[string]any)["content"])
+}
+
+func TestProviderClient_Errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		content   string
+		retryable bool
+	}{
+		{"server error", http.StatusServiceUnavailable, "", true},
+		{"unauthorized", http.StatusUnauthorized, "", false},
+		{"not json", http.StatusOK, "I think this is critical", false},
+		{"missing confidence", http.StatusOK, `{"severity": 4}`, false},
+		{"unknown severity", http.StatusOK, `{"severity": 7, "confidence": 0.5}`, false},
+		{"confidence out of range", http.StatusOK, `{"severity": 4, "confidence": 90}`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				w.WriteHeader(tt.status)
+				_ = json.NewEncoder(w).Encode(map[string]any{
+					"choices": []any{map[string]any{"message": map[string]any{"content": tt.content}}},
+				})
+			}))
+			defer server.Close()
+
+			client, err := NewProviderClient(ProviderConfig{
+				Type:       ProviderOpenAI,
+				BaseURL:    server.URL,
+				Model:      "gpt-4o-mini",
+				MaxRetries: 1,
+				RetryDelay: time.Millisecond,
+			}, nil, nil)
+			require.NoError(t, err)
+
+			_, err = client.ClassifyAlert(context.Background(), newProviderTestAlert())
+			require.Error(t, err)
+			assert.Equal(t, tt.retryable, IsRetryableError(err))
+			if tt.retryable {
+				assert.Equal(t, 2, calls)
+			} else {
+				assert.Equal(t, 1, calls)
+			}
+		})
+	}
+}
+
+func TestProviderClient_Timeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewProviderClient(ProviderConfig{
+		Type:    ProviderOllama,
+		BaseURL: server.URL,
+		Model:   "llama3.1",
+		Timeout: 50 * time.Millisecond,
+	}, nil, nil)
+	require.NoError(t, err)
+
+	start := time.Now()
+	_, err = client.ClassifyAlert(context.Background(), newProviderTestAlert())
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+}
+
+func TestNewProviderClient_Invalid(t *testing.T) {
+	_, err := NewProviderClient(ProviderConfig{Type: "cohere", Model: "command"}, nil, nil)
+	assert.Error(t, err)
+
+	_, err = NewProviderClient(ProviderConfig{Type: ProviderOpenAI}, nil, nil)
+	assert.Error(t, err)
+
+	_, err = NewPromptTemplate("{{ .AlertName ")
+	assert.Error(t, err)
+}
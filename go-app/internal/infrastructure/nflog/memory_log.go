@@ -0,0 +1,62 @@
+package nflog
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryLog is an in-memory notification log.
+//
+// Entries are lost on restart; use it when no shared storage is available.
+type MemoryLog struct {
+	mu      sync.RWMutex
+	entries map[string]*Entry
+	now     func() time.Time
+}
+
+// NewMemoryLog creates a new in-memory notification log.
+func NewMemoryLog() *MemoryLog {
+	return &MemoryLog{
+		entries: make(map[string]*Entry),
+		now:     time.Now,
+	}
+}
+
+// Log records a notification.
+func (l *MemoryLog) Log(ctx context.Context, entry *Entry) error {
+	stored := *entry
+	l.mu.Lock()
+	l.entries[entryKey(entry.GroupKey, entry.Receiver)] = &stored
+	l.mu.Unlock()
+	return nil
+}
+
+// Query returns the last entry for a group key and receiver integration.
+func (l *MemoryLog) Query(ctx context.Context, groupKey string, receiver Receiver) (*Entry, error) {
+	l.mu.RLock()
+	entry, ok := l.entries[entryKey(groupKey, receiver)]
+	l.mu.RUnlock()
+	if !ok || !entry.ExpiresAt.After(l.now()) {
+		return nil, ErrNotFound
+	}
+	result := *entry
+	return &result, nil
+}
+
+// GC deletes expired entries.
+func (l *MemoryLog) GC(ctx context.Context) (int, error) {
+	now := l.now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	deleted := 0
+	for key, entry := range l.entries {
+		if !entry.ExpiresAt.After(now) {
+			delete(l.entries, key)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
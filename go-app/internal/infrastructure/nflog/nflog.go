@@ -0,0 +1,183 @@
+// Package nflog implements an Alertmanager-compatible notification log.
+//
+// The notification log records, per (group key, receiver, integration index),
+// which alerts were firing and which were resolved when the group was last
+// notified. The dispatcher consults it before flushing a group so that
+// notifications already delivered (e.g. before a restart, or by another
+// replica sharing the log) are not sent again and repeat_interval is honoured
+// across restarts.
+//
+// Backends:
+//   - MemoryLog: single-process, non-persistent (tests, no storage configured)
+//   - RedisLog: shared across replicas, entries expire with Redis TTL
+//   - PostgresLog: shared across replicas (notification_log table)
+//   - SQLiteLog: Lite profile (single-node, persisted in the SQLite file)
+package nflog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"log/slog"
+	"sort"
+	"time"
+)
+
+// DefaultRetention is how long notification log entries are kept
+// (Alertmanager --data.retention default).
+const DefaultRetention = 120 * time.Hour
+
+// ErrNotFound is returned by Query when no entry exists for the key.
+var ErrNotFound = errors.New("notification log entry not found")
+
+// Receiver identifies a single notification integration of a receiver.
+type Receiver struct {
+	// GroupName is the receiver name from the routing configuration
+	GroupName string `json:"group_name"`
+
+	// Integration is the integration type (e.g. "webhook", "slack")
+	Integration string `json:"integration"`
+
+	// Index is the position of the integration within the receiver
+	Index int `json:"index"`
+}
+
+// String returns the receiver in Alertmanager format: name/integration[index].
+func (r Receiver) String() string {
+	return fmt.Sprintf("%s/%s[%d]", r.GroupName, r.Integration, r.Index)
+}
+
+// Entry records the last notification of a group to a receiver integration.
+type Entry struct {
+	// GroupKey identifies the aggregation group
+	GroupKey string `json:"group_key"`
+
+	// Receiver is the notified receiver integration
+	Receiver Receiver `json:"receiver"`
+
+	// FiringAlerts are sorted fingerprint hashes of alerts notified as firing
+	FiringAlerts []uint64 `json:"firing_alerts"`
+
+	// ResolvedAlerts are sorted fingerprint hashes of alerts notified as resolved
+	ResolvedAlerts []uint64 `json:"resolved_alerts"`
+
+	// Timestamp is when the notification was sent
+	Timestamp time.Time `json:"timestamp"`
+
+	// ExpiresAt is when the entry may be garbage collected
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// NewEntry creates an entry for a notification sent at now, hashing the
+// firing and resolved alert fingerprints.
+func NewEntry(groupKey string, receiver Receiver, firing, resolved []string, now time.Time, retention time.Duration) *Entry {
+	if retention <= 0 {
+		retention = DefaultRetention
+	}
+	return &Entry{
+		GroupKey:       groupKey,
+		Receiver:       receiver,
+		FiringAlerts:   HashFingerprints(firing),
+		ResolvedAlerts: HashFingerprints(resolved),
+		Timestamp:      now,
+		ExpiresAt:      now.Add(retention),
+	}
+}
+
+// IsFiringSubset reports whether all given hashes were notified as firing.
+func (e *Entry) IsFiringSubset(hashes map[uint64]struct{}) bool {
+	return isSubset(hashes, e.FiringAlerts)
+}
+
+// IsResolvedSubset reports whether all given hashes were notified as resolved.
+func (e *Entry) IsResolvedSubset(hashes map[uint64]struct{}) bool {
+	return isSubset(hashes, e.ResolvedAlerts)
+}
+
+// Log is the notification log storage.
+//
+// Implementations must be safe for concurrent use.
+type Log interface {
+	// Log records a notification, replacing the previous entry for the same
+	// group key and receiver integration.
+	Log(ctx context.Context, entry *Entry) error
+
+	// Query returns the last entry for a group key and receiver integration,
+	// or ErrNotFound.
+	Query(ctx context.Context, groupKey string, receiver Receiver) (*Entry, error)
+
+	// GC deletes expired entries and returns the number of deleted entries.
+	GC(ctx context.Context) (int, error)
+}
+
+// HashFingerprint returns the 64-bit FNV-1a hash of an alert fingerprint.
+func HashFingerprint(fingerprint string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(fingerprint))
+	return h.Sum64()
+}
+
+// HashFingerprints returns the sorted hashes of alert fingerprints.
+func HashFingerprints(fingerprints []string) []uint64 {
+	hashes := make([]uint64, 0, len(fingerprints))
+	for _, fingerprint := range fingerprints {
+		hashes = append(hashes, HashFingerprint(fingerprint))
+	}
+	sort.Slice(hashes, func(i, j int) bool { return hashes[i] < hashes[j] })
+	return hashes
+}
+
+// HashSet converts hashes to a set.
+func HashSet(hashes []uint64) map[uint64]struct{} {
+	set := make(map[uint64]struct{}, len(hashes))
+	for _, hash := range hashes {
+		set[hash] = struct{}{}
+	}
+	return set
+}
+
+// RunGC periodically garbage collects expired entries until ctx is done.
+func RunGC(ctx context.Context, log Log, interval time.Duration, logger *slog.Logger) {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if interval <= 0 {
+		interval = 15 * time.Minute
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := log.GC(ctx)
+			if err != nil {
+				logger.Warn("Notification log GC failed", "error", err)
+				continue
+			}
+			if deleted > 0 {
+				logger.Debug("Notification log GC completed", "deleted", deleted)
+			}
+		}
+	}
+}
+
+// entryKey builds the composite storage key of an entry.
+func entryKey(groupKey string, receiver Receiver) string {
+	return groupKey + "|" + receiver.String()
+}
+
+// isSubset reports whether every element of set is contained in sorted.
+func isSubset(set map[uint64]struct{}, sorted []uint64) bool {
+	for hash := range set {
+		i := sort.Search(len(sorted), func(i int) bool { return sorted[i] >= hash })
+		if i == len(sorted) || sorted[i] != hash {
+			return false
+		}
+	}
+	return true
+}
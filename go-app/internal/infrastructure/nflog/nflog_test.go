@@ -0,0 +1,128 @@
+package nflog
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure/cache"
+)
+
+func newTestSQLiteLog(t *testing.T) *SQLiteLog {
+	t.Helper()
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "nflog.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	log, err := NewSQLiteLog(context.Background(), db, slog.Default())
+	require.NoError(t, err)
+	return log
+}
+
+func newTestRedisLog(t *testing.T) *RedisLog {
+	t.Helper()
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	t.Cleanup(mr.Close)
+
+	redisCache, err := cache.NewRedisCache(&cache.CacheConfig{
+		Addr:        mr.Addr(),
+		PoolSize:    5,
+		DialTimeout: time.Second,
+		ReadTimeout: time.Second,
+	}, slog.Default())
+	require.NoError(t, err)
+	t.Cleanup(func() { redisCache.Close() })
+
+	log, err := NewRedisLog(redisCache, slog.Default())
+	require.NoError(t, err)
+	return log
+}
+
+func TestLogBackends(t *testing.T) {
+	backends := map[string]func(t *testing.T) Log{
+		"memory": func(t *testing.T) Log { return NewMemoryLog() },
+		"sqlite": func(t *testing.T) Log { return newTestSQLiteLog(t) },
+		"redis":  func(t *testing.T) Log { return newTestRedisLog(t) },
+	}
+
+	for name, newLog := range backends {
+		t.Run(name, func(t *testing.T) {
+			log := newLog(t)
+			ctx := context.Background()
+			receiver := Receiver{GroupName: "pager", Integration: "webhook"}
+			now := time.Now().Truncate(time.Millisecond)
+
+			_, err := log.Query(ctx, "{}:alertname=HighCPU", receiver)
+			assert.ErrorIs(t, err, ErrNotFound)
+
+			require.NoError(t, log.Log(ctx, NewEntry("{}:alertname=HighCPU", receiver,
+				[]string{"fp-2", "fp-1"}, nil, now, time.Hour)))
+
+			entry, err := log.Query(ctx, "{}:alertname=HighCPU", receiver)
+			require.NoError(t, err)
+			assert.Equal(t, HashFingerprints([]string{"fp-1", "fp-2"}), entry.FiringAlerts)
+			assert.Empty(t, entry.ResolvedAlerts)
+			assert.True(t, now.Equal(entry.Timestamp))
+			assert.True(t, entry.IsFiringSubset(HashSet(HashFingerprints([]string{"fp-1"}))))
+			assert.False(t, entry.IsFiringSubset(HashSet(HashFingerprints([]string{"fp-3"}))))
+
+			// Later notification replaces the entry
+			require.NoError(t, log.Log(ctx, NewEntry("{}:alertname=HighCPU", receiver,
+				[]string{"fp-1"}, []string{"fp-2"}, now.Add(time.Minute), time.Hour)))
+			entry, err = log.Query(ctx, "{}:alertname=HighCPU", receiver)
+			require.NoError(t, err)
+			assert.Equal(t, []uint64{HashFingerprint("fp-1")}, entry.FiringAlerts)
+			assert.True(t, entry.IsResolvedSubset(HashSet([]uint64{HashFingerprint("fp-2")})))
+
+			// Other integrations of the receiver are independent
+			_, err = log.Query(ctx, "{}:alertname=HighCPU", Receiver{GroupName: "pager", Integration: "webhook", Index: 1})
+			assert.ErrorIs(t, err, ErrNotFound)
+		})
+	}
+}
+
+func TestLogBackends_Expiry(t *testing.T) {
+	ctx := context.Background()
+	receiver := Receiver{GroupName: "pager", Integration: "webhook"}
+	expired := &Entry{
+		GroupKey:     "group",
+		Receiver:     receiver,
+		FiringAlerts: []uint64{1},
+		Timestamp:    time.Now().Add(-2 * time.Hour),
+		ExpiresAt:    time.Now().Add(-time.Hour),
+	}
+
+	for name, log := range map[string]Log{
+		"memory": NewMemoryLog(),
+		"sqlite": newTestSQLiteLog(t),
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.NoError(t, log.Log(ctx, expired))
+
+			_, err := log.Query(ctx, "group", receiver)
+			assert.ErrorIs(t, err, ErrNotFound, "expired entries are not returned")
+
+			deleted, err := log.GC(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, 1, deleted)
+		})
+	}
+}
+
+func TestHashFingerprints(t *testing.T) {
+	hashes := HashFingerprints([]string{"b", "a", "c"})
+	require.Len(t, hashes, 3)
+	assert.IsIncreasing(t, hashes)
+	assert.Equal(t, HashFingerprint("a"), HashFingerprint("a"))
+	assert.NotEqual(t, HashFingerprint("a"), HashFingerprint("b"))
+	assert.Equal(t, "pager/webhook[2]", Receiver{GroupName: "pager", Integration: "webhook", Index: 2}.String())
+}
@@ -0,0 +1,111 @@
+package nflog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresLog stores notification log entries in the notification_log table
+// (migration 20251127000000_create_notification_log).
+//
+// Fingerprint hashes are stored as BIGINT[] (uint64 reinterpreted as int64).
+type PostgresLog struct {
+	db     *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewPostgresLog creates a new PostgreSQL-backed notification log.
+func NewPostgresLog(db *pgxpool.Pool, logger *slog.Logger) (*PostgresLog, error) {
+	if db == nil {
+		return nil, fmt.Errorf("postgres pool is required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &PostgresLog{db: db, logger: logger}, nil
+}
+
+// Log records a notification (upsert by group key and receiver integration).
+func (l *PostgresLog) Log(ctx context.Context, entry *Entry) error {
+	const query = `
+		INSERT INTO notification_log (
+			group_key, receiver, integration, integration_index,
+			firing_alerts, resolved_alerts, notified_at, expires_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (group_key, receiver, integration, integration_index) DO UPDATE SET
+			firing_alerts = EXCLUDED.firing_alerts,
+			resolved_alerts = EXCLUDED.resolved_alerts,
+			notified_at = EXCLUDED.notified_at,
+			expires_at = EXCLUDED.expires_at`
+
+	_, err := l.db.Exec(ctx, query,
+		entry.GroupKey,
+		entry.Receiver.GroupName,
+		entry.Receiver.Integration,
+		entry.Receiver.Index,
+		toInt64s(entry.FiringAlerts),
+		toInt64s(entry.ResolvedAlerts),
+		entry.Timestamp,
+		entry.ExpiresAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to store notification log entry: %w", err)
+	}
+	return nil
+}
+
+// Query returns the last entry for a group key and receiver integration.
+func (l *PostgresLog) Query(ctx context.Context, groupKey string, receiver Receiver) (*Entry, error) {
+	const query = `
+		SELECT firing_alerts, resolved_alerts, notified_at, expires_at
+		FROM notification_log
+		WHERE group_key = $1 AND receiver = $2 AND integration = $3 AND integration_index = $4
+			AND expires_at > NOW()`
+
+	var firing, resolved []int64
+	entry := &Entry{GroupKey: groupKey, Receiver: receiver}
+
+	err := l.db.QueryRow(ctx, query, groupKey, receiver.GroupName, receiver.Integration, receiver.Index).
+		Scan(&firing, &resolved, &entry.Timestamp, &entry.ExpiresAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to load notification log entry: %w", err)
+	}
+
+	entry.FiringAlerts = toUint64s(firing)
+	entry.ResolvedAlerts = toUint64s(resolved)
+	return entry, nil
+}
+
+// GC deletes expired entries.
+func (l *PostgresLog) GC(ctx context.Context) (int, error) {
+	tag, err := l.db.Exec(ctx, `DELETE FROM notification_log WHERE expires_at <= $1`, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired notification log entries: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+func toInt64s(values []uint64) []int64 {
+	result := make([]int64, len(values))
+	for i, value := range values {
+		result[i] = int64(value)
+	}
+	return result
+}
+
+func toUint64s(values []int64) []uint64 {
+	result := make([]uint64, len(values))
+	for i, value := range values {
+		result[i] = uint64(value)
+	}
+	return result
+}
@@ -0,0 +1,72 @@
+package nflog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure/cache"
+)
+
+// redisKeyPrefix prefixes notification log keys in Redis.
+const redisKeyPrefix = "nflog:"
+
+// RedisLog stores notification log entries in Redis.
+//
+// Redis Schema:
+//
+//	Key: "nflog:{groupKey}|{receiver}/{integration}[{index}]"
+//	Type: String (JSON Entry)
+//	TTL: until ExpiresAt
+//
+// Expired entries are removed by Redis, so GC is a no-op.
+type RedisLog struct {
+	cache  cache.Cache
+	logger *slog.Logger
+}
+
+// NewRedisLog creates a new Redis-backed notification log.
+func NewRedisLog(redisCache cache.Cache, logger *slog.Logger) (*RedisLog, error) {
+	if redisCache == nil {
+		return nil, fmt.Errorf("redis cache is required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &RedisLog{cache: redisCache, logger: logger}, nil
+}
+
+// Log records a notification.
+func (l *RedisLog) Log(ctx context.Context, entry *Entry) error {
+	ttl := time.Until(entry.ExpiresAt)
+	if ttl <= 0 {
+		return nil
+	}
+	if err := l.cache.Set(ctx, l.key(entry.GroupKey, entry.Receiver), entry, ttl); err != nil {
+		return fmt.Errorf("failed to store notification log entry: %w", err)
+	}
+	return nil
+}
+
+// Query returns the last entry for a group key and receiver integration.
+func (l *RedisLog) Query(ctx context.Context, groupKey string, receiver Receiver) (*Entry, error) {
+	var entry Entry
+	if err := l.cache.Get(ctx, l.key(groupKey, receiver), &entry); err != nil {
+		if errors.Is(err, cache.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to load notification log entry: %w", err)
+	}
+	return &entry, nil
+}
+
+// GC is a no-op: entries expire with their Redis TTL.
+func (l *RedisLog) GC(ctx context.Context) (int, error) {
+	return 0, nil
+}
+
+func (l *RedisLog) key(groupKey string, receiver Receiver) string {
+	return redisKeyPrefix + entryKey(groupKey, receiver)
+}
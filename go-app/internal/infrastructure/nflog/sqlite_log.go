@@ -0,0 +1,146 @@
+package nflog
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// SQLiteLog stores notification log entries in a SQLite database (Lite profile).
+//
+// The notification_log table is created on construction. Fingerprint hashes
+// are stored as JSON arrays, timestamps as Unix milliseconds (same convention
+// as the SQLite alert storage).
+type SQLiteLog struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+// NewSQLiteLog creates a new SQLite-backed notification log and initializes
+// its schema.
+func NewSQLiteLog(ctx context.Context, db *sql.DB, logger *slog.Logger) (*SQLiteLog, error) {
+	if db == nil {
+		return nil, fmt.Errorf("sqlite database is required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	const schema = `
+		CREATE TABLE IF NOT EXISTS notification_log (
+			group_key TEXT NOT NULL,
+			receiver TEXT NOT NULL,
+			integration TEXT NOT NULL,
+			integration_index INTEGER NOT NULL DEFAULT 0,
+			firing_alerts TEXT NOT NULL DEFAULT '[]',
+			resolved_alerts TEXT NOT NULL DEFAULT '[]',
+			notified_at INTEGER NOT NULL,
+			expires_at INTEGER NOT NULL,
+			PRIMARY KEY (group_key, receiver, integration, integration_index)
+		);
+		CREATE INDEX IF NOT EXISTS idx_notification_log_expires_at ON notification_log(expires_at);`
+
+	if _, err := db.ExecContext(ctx, schema); err != nil {
+		return nil, fmt.Errorf("failed to create notification_log table: %w", err)
+	}
+
+	return &SQLiteLog{db: db, logger: logger}, nil
+}
+
+// Log records a notification (upsert by group key and receiver integration).
+func (l *SQLiteLog) Log(ctx context.Context, entry *Entry) error {
+	firing, err := json.Marshal(nonNil(entry.FiringAlerts))
+	if err != nil {
+		return fmt.Errorf("failed to encode firing alerts: %w", err)
+	}
+	resolved, err := json.Marshal(nonNil(entry.ResolvedAlerts))
+	if err != nil {
+		return fmt.Errorf("failed to encode resolved alerts: %w", err)
+	}
+
+	const query = `
+		INSERT INTO notification_log (
+			group_key, receiver, integration, integration_index,
+			firing_alerts, resolved_alerts, notified_at, expires_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (group_key, receiver, integration, integration_index) DO UPDATE SET
+			firing_alerts = excluded.firing_alerts,
+			resolved_alerts = excluded.resolved_alerts,
+			notified_at = excluded.notified_at,
+			expires_at = excluded.expires_at`
+
+	_, err = l.db.ExecContext(ctx, query,
+		entry.GroupKey,
+		entry.Receiver.GroupName,
+		entry.Receiver.Integration,
+		entry.Receiver.Index,
+		string(firing),
+		string(resolved),
+		entry.Timestamp.UnixMilli(),
+		entry.ExpiresAt.UnixMilli(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to store notification log entry: %w", err)
+	}
+	return nil
+}
+
+// Query returns the last entry for a group key and receiver integration.
+func (l *SQLiteLog) Query(ctx context.Context, groupKey string, receiver Receiver) (*Entry, error) {
+	const query = `
+		SELECT firing_alerts, resolved_alerts, notified_at, expires_at
+		FROM notification_log
+		WHERE group_key = ? AND receiver = ? AND integration = ? AND integration_index = ?
+			AND expires_at > ?`
+
+	var firing, resolved string
+	var notifiedAt, expiresAt int64
+
+	err := l.db.QueryRowContext(ctx, query,
+		groupKey, receiver.GroupName, receiver.Integration, receiver.Index, time.Now().UnixMilli(),
+	).Scan(&firing, &resolved, &notifiedAt, &expiresAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to load notification log entry: %w", err)
+	}
+
+	entry := &Entry{
+		GroupKey:  groupKey,
+		Receiver:  receiver,
+		Timestamp: time.UnixMilli(notifiedAt),
+		ExpiresAt: time.UnixMilli(expiresAt),
+	}
+	if err := json.Unmarshal([]byte(firing), &entry.FiringAlerts); err != nil {
+		return nil, fmt.Errorf("failed to decode firing alerts: %w", err)
+	}
+	if err := json.Unmarshal([]byte(resolved), &entry.ResolvedAlerts); err != nil {
+		return nil, fmt.Errorf("failed to decode resolved alerts: %w", err)
+	}
+	return entry, nil
+}
+
+// GC deletes expired entries.
+func (l *SQLiteLog) GC(ctx context.Context) (int, error) {
+	result, err := l.db.ExecContext(ctx, `DELETE FROM notification_log WHERE expires_at <= ?`, time.Now().UnixMilli())
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired notification log entries: %w", err)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(deleted), nil
+}
+
+func nonNil(values []uint64) []uint64 {
+	if values == nil {
+		return []uint64{}
+	}
+	return values
+}
@@ -0,0 +1,86 @@
+package publishing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+)
+
+// AckPublisher is implemented by publishers whose target API supports
+// acknowledging a previously published alert.
+type AckPublisher interface {
+	Acknowledge(ctx context.Context, alert *core.Alert, ack *core.AlertAck, target *core.PublishingTarget) error
+}
+
+// TargetAckForwarder forwards alert acknowledgements to all enabled
+// publishing targets whose publisher implements AckPublisher (PagerDuty,
+// Rootly, Opsgenie). Implements services.AckForwarder.
+type TargetAckForwarder struct {
+	factory   *PublisherFactory
+	discovery TargetDiscoveryManager
+	logger    *slog.Logger
+}
+
+// NewTargetAckForwarder creates a new acknowledgement forwarder.
+func NewTargetAckForwarder(factory *PublisherFactory, discovery TargetDiscoveryManager, logger *slog.Logger) *TargetAckForwarder {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &TargetAckForwarder{
+		factory:   factory,
+		discovery: discovery,
+		logger:    logger,
+	}
+}
+
+// ForwardAck acknowledges the alert on every supporting target.
+//
+// Targets that never received the alert (not tracked) are skipped.
+func (f *TargetAckForwarder) ForwardAck(ctx context.Context, alert *core.Alert, ack *core.AlertAck) error {
+	var errs []error
+	for _, target := range f.discovery.ListTargets() {
+		if !target.Enabled {
+			continue
+		}
+		switch TargetType(target.Type) {
+		case TargetTypePagerDuty, TargetTypeRootly, TargetTypeOpsgenie:
+		default:
+			continue
+		}
+
+		publisher, err := f.factory.CreatePublisherForTarget(target)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("target %s: %w", target.Name, err))
+			continue
+		}
+		ackPublisher, ok := publisher.(AckPublisher)
+		if !ok {
+			// Fallback HTTP publisher (target without API key)
+			continue
+		}
+
+		err = ackPublisher.Acknowledge(ctx, alert, ack, target)
+		if errors.Is(err, ErrEventNotTracked) || errors.Is(err, ErrIncidentNotTracked) || errors.Is(err, ErrOpsgenieAlertNotFound) {
+			f.logger.Debug("Alert not tracked by target, acknowledgement not forwarded",
+				"target", target.Name,
+				"fingerprint", alert.Fingerprint)
+			continue
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("target %s: %w", target.Name, err))
+			continue
+		}
+
+		f.logger.Info("Acknowledgement forwarded",
+			"target", target.Name,
+			"type", target.Type,
+			"fingerprint", alert.Fingerprint,
+			"acked_by", ack.AckedBy)
+	}
+
+	return errors.Join(errs...)
+}
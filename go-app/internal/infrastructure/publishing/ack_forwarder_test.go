@@ -0,0 +1,143 @@
+package publishing
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+)
+
+func TestTargetAckForwarder_ForwardAck(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		requests []string
+		fields   map[string]interface{}
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		var req UpdateIncidentRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		fields = req.CustomFields
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"data":{"id":"inc-1","type":"incidents"}}`))
+	}))
+	defer server.Close()
+
+	// Factory without registered metrics (NewPublisherFactory registers
+	// Prometheus collectors once per process)
+	factory := &PublisherFactory{
+		formatter:          NewAlertFormatter(),
+		logger:             slog.Default(),
+		rootlyCache:        NewIncidentIDCache(time.Hour),
+		rootlyMetrics:      newUnregisteredRootlyMetrics(),
+		rootlyClientMap:    make(map[string]RootlyIncidentsClient),
+		pagerDutyCache:     NewEventKeyCache(time.Hour),
+		pagerDutyClientMap: make(map[string]PagerDutyEventsClient),
+	}
+	factory.rootlyCache.Set("fp-1", "inc-1")
+
+	discovery := NewStubTargetDiscoveryManager(slog.Default())
+	discovery.SetTargets([]*core.PublishingTarget{
+		{Name: "rootly", Type: "rootly", URL: server.URL, Enabled: true, Headers: map[string]string{"Authorization": "Bearer key"}},
+		{Name: "rootly-disabled", Type: "rootly", URL: server.URL, Enabled: false, Headers: map[string]string{"Authorization": "Bearer key"}},
+		{Name: "pagerduty", Type: "pagerduty", URL: server.URL, Enabled: true, Headers: map[string]string{"routing_key": "rk"}},
+		{Name: "slack", Type: "slack", URL: server.URL, Enabled: true},
+	})
+
+	forwarder := NewTargetAckForwarder(factory, discovery, nil)
+	ackedAt := time.Date(2025, 11, 29, 10, 0, 0, 0, time.UTC)
+	ack := &core.AlertAck{TargetType: core.AckTargetAlert, Target: "fp-1", Acknowledged: true, AckedBy: "alice", AckedAt: &ackedAt, Owner: "bob"}
+
+	// Rootly incident tracked: acknowledged; PagerDuty event not tracked: skipped
+	require.NoError(t, forwarder.ForwardAck(context.Background(), &core.Alert{Fingerprint: "fp-1", AlertName: "HighCPU"}, ack))
+
+	mu.Lock()
+	assert.Equal(t, []string{"PATCH /incidents/inc-1"}, requests)
+	assert.Equal(t, "alice", fields["acknowledged_by"])
+	assert.Equal(t, "2025-11-29T10:00:00Z", fields["acknowledged_at"])
+	assert.Equal(t, "bob", fields["owner"])
+	mu.Unlock()
+
+	// Alert never published: nothing to acknowledge
+	require.NoError(t, forwarder.ForwardAck(context.Background(), &core.Alert{Fingerprint: "fp-2", AlertName: "HighCPU"}, ack))
+
+	mu.Lock()
+	assert.Len(t, requests, 1)
+	mu.Unlock()
+}
+
+func TestTargetAckForwarder_ForwardAckOpsgenie(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		requests []string
+		ackReq   AcknowledgeAlertRequest
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		_ = json.NewDecoder(r.Body).Decode(&ackReq)
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/v2/alerts/fp-1/acknowledge" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Alert does not exist","took":0.01,"requestId":"r2"}`))
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"result":"Request will be processed","took":0.01,"requestId":"r1"}`))
+	}))
+	defer server.Close()
+
+	factory := &PublisherFactory{
+		formatter:         NewAlertFormatter(),
+		logger:            slog.Default(),
+		opsgenieMetrics:   NewOpsgenieMetrics(),
+		opsgenieClientMap: make(map[string]OpsgenieAlertsClient),
+	}
+	discovery := NewStubTargetDiscoveryManager(slog.Default())
+	discovery.SetTargets([]*core.PublishingTarget{
+		{Name: "opsgenie", Type: "opsgenie", URL: server.URL, Enabled: true, Headers: map[string]string{"api_key": "key"}},
+	})
+
+	forwarder := NewTargetAckForwarder(factory, discovery, nil)
+	ack := &core.AlertAck{TargetType: core.AckTargetAlert, Target: "fp-1", Acknowledged: true, AckedBy: "alice", Owner: "bob"}
+
+	require.NoError(t, forwarder.ForwardAck(context.Background(), &core.Alert{Fingerprint: "fp-1", AlertName: "HighCPU"}, ack))
+
+	mu.Lock()
+	assert.Equal(t, []string{"POST /v2/alerts/fp-1/acknowledge"}, requests)
+	assert.Equal(t, "alice", ackReq.User)
+	assert.Contains(t, ackReq.Note, "bob")
+	mu.Unlock()
+
+	// Alert unknown to Opsgenie: not an error
+	require.NoError(t, forwarder.ForwardAck(context.Background(), &core.Alert{Fingerprint: "fp-2", AlertName: "HighCPU"}, ack))
+
+	mu.Lock()
+	assert.Len(t, requests, 2)
+	mu.Unlock()
+}
+
+// newUnregisteredRootlyMetrics creates Rootly metrics without registering them.
+func newUnregisteredRootlyMetrics() *RootlyMetrics {
+	return &RootlyMetrics{
+		incidentsUpdatedTotal: prometheus.NewCounterVec(prometheus.CounterOpts{Name: "test_rootly_incidents_updated_total"}, []string{"reason"}),
+		apiErrorsTotal:        prometheus.NewCounterVec(prometheus.CounterOpts{Name: "test_rootly_api_errors_total"}, []string{"endpoint", "error_type"}),
+	}
+}
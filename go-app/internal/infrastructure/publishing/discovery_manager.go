@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -234,13 +235,25 @@ func (m *DefaultTargetDiscoveryManager) parseSecretToTarget(secret *corev1.Secre
 		headers["Authorization"] = "Bearer " + authToken
 	}
 
+	// Managed template reference (optional pinned version)
+	templateVersion := 0
+	if versionStr := decodeData("template_version"); versionStr != "" {
+		version, err := strconv.Atoi(versionStr)
+		if err != nil || version < 0 {
+			return nil, fmt.Errorf("invalid template_version: %q", versionStr)
+		}
+		templateVersion = version
+	}
+
 	target := &core.PublishingTarget{
-		Name:    name,
-		Type:    targetType,
-		URL:     url,
-		Enabled: enabled,
-		Format:  core.PublishingFormat(format),
-		Headers: headers,
+		Name:            name,
+		Type:            targetType,
+		URL:             url,
+		Enabled:         enabled,
+		Format:          core.PublishingFormat(format),
+		Headers:         headers,
+		Template:        decodeData("template"),
+		TemplateVersion: templateVersion,
 	}
 
 	return target, nil
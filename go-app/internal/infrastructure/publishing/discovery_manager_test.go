@@ -48,6 +48,9 @@ func TestParseTargetType(t *testing.T) {
 		{"slack", TargetTypeSlack},
 		{"webhook", TargetTypeWebhook},
 		{"alertmanager", TargetTypeAlertmanager},
+		{"msteams", TargetTypeMSTeams},
+		{"teams", TargetTypeMSTeams},
+		{"telegram", TargetTypeTelegram},
 		{"unknown", TargetTypeWebhook}, // Default
 	}
 
@@ -165,6 +168,31 @@ func TestParseSecretToTarget_CustomHeaders(t *testing.T) {
 	assert.Equal(t, "value2", target.Headers["X-Another"])
 }
 
+func TestParseSecretToTarget_Template(t *testing.T) {
+	manager := &DefaultTargetDiscoveryManager{
+		config: DefaultTargetDiscoveryConfig(),
+		logger: slog.Default(),
+	}
+
+	target, err := manager.parseSecretToTarget(createTestSecret("test-slack", "default", map[string]string{
+		"type":             "slack",
+		"url":              "https://hooks.slack.com/services/xxx",
+		"template":         "slack_oncall",
+		"template_version": "3",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "slack_oncall", target.Template)
+	assert.Equal(t, 3, target.TemplateVersion)
+
+	_, err = manager.parseSecretToTarget(createTestSecret("test-slack", "default", map[string]string{
+		"type":             "slack",
+		"url":              "https://hooks.slack.com/services/xxx",
+		"template":         "slack_oncall",
+		"template_version": "latest",
+	}))
+	assert.ErrorContains(t, err, "template_version")
+}
+
 func TestGetTarget_NotFound(t *testing.T) {
 	config := DefaultTargetDiscoveryConfig()
 	manager := &DefaultTargetDiscoveryManager{
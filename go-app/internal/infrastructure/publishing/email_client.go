@@ -0,0 +1,514 @@
+package publishing
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	"sync"
+	"time"
+)
+
+// EmailSMTPClient delivers email messages over SMTP
+type EmailSMTPClient interface {
+	// Send delivers message in a single SMTP transaction (MAIL, RCPT, DATA)
+	Send(ctx context.Context, msg *EmailMessage) error
+
+	// Close terminates the cached SMTP connection (QUIT)
+	Close() error
+}
+
+// SMTPClientConfig holds configuration for SMTP client
+type SMTPClientConfig struct {
+	// Smarthost is the SMTP server address (host:port)
+	Smarthost string
+
+	// ImplicitTLS connects with TLS from the start (smtps, port 465)
+	ImplicitTLS bool
+
+	// RequireTLS fails delivery if the server does not offer STARTTLS
+	RequireTLS bool
+
+	// InsecureSkipVerify disables TLS certificate verification
+	InsecureSkipVerify bool
+
+	// Hello is the hostname sent in EHLO (default: "localhost")
+	Hello string
+
+	// AuthUsername is the SMTP auth username (auth disabled if empty)
+	AuthUsername string
+
+	// AuthPassword is the password for PLAIN and LOGIN auth
+	AuthPassword string
+
+	// AuthSecret is the secret for CRAM-MD5 auth (default: AuthPassword)
+	AuthSecret string
+
+	// AuthIdentity is the authorization identity for PLAIN auth
+	AuthIdentity string
+
+	// AuthMechanism forces auth mechanism (PLAIN, LOGIN, CRAM-MD5)
+	AuthMechanism string
+
+	// Timeout is the timeout for dialing and a single SMTP transaction (default: 30s)
+	Timeout time.Duration
+
+	// IdleTimeout is how long an idle connection is kept for reuse (default: 60s)
+	IdleTimeout time.Duration
+}
+
+// withDefaults returns config with default timeouts applied
+func (c SMTPClientConfig) withDefaults() SMTPClientConfig {
+	if c.Timeout == 0 {
+		c.Timeout = 30 * time.Second
+	}
+	if c.IdleTimeout == 0 {
+		c.IdleTimeout = 60 * time.Second
+	}
+	return c
+}
+
+// host returns smarthost host part (used for TLS ServerName and auth)
+func (c SMTPClientConfig) host() string {
+	host, _, err := net.SplitHostPort(c.Smarthost)
+	if err != nil {
+		return c.Smarthost
+	}
+	return host
+}
+
+// smtpClientImpl implements EmailSMTPClient with connection reuse.
+// One connection is cached and reused across Send calls as long as
+// it passes a NOOP check and has not been idle longer than IdleTimeout.
+// Sends are serialized (SMTP transactions cannot be interleaved on one connection).
+type smtpClientImpl struct {
+	config  SMTPClientConfig
+	metrics *EmailMetrics
+	logger  *slog.Logger
+
+	mu       sync.Mutex
+	conn     net.Conn
+	client   *smtp.Client
+	lastUsed time.Time
+}
+
+// NewEmailSMTPClient creates a new SMTP client
+func NewEmailSMTPClient(config SMTPClientConfig, metrics *EmailMetrics, logger *slog.Logger) EmailSMTPClient {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if metrics == nil {
+		metrics = NewEmailMetrics()
+	}
+	return &smtpClientImpl{
+		config:  config.withDefaults(),
+		metrics: metrics,
+		logger:  logger,
+	}
+}
+
+// Send delivers message via SMTP, reusing cached connection when possible
+func (c *smtpClientImpl) Send(ctx context.Context, msg *EmailMessage) error {
+	if len(msg.To) == 0 {
+		return ErrMissingEmailRecipients
+	}
+	if msg.From == "" {
+		return ErrMissingEmailSender
+	}
+
+	data, err := msg.Bytes()
+	if err != nil {
+		return fmt.Errorf("failed to build email message: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.ensureConnected(ctx); err != nil {
+		c.recordError(err)
+		return err
+	}
+
+	// Bound the transaction by ctx and timeout
+	c.setDeadline(ctx)
+	stop := context.AfterFunc(ctx, func() {
+		c.conn.SetDeadline(time.Unix(1, 0))
+	})
+	defer stop()
+
+	if err := c.transaction(msg, data); err != nil {
+		c.recordError(err)
+
+		// Reply errors leave the connection usable after RSET; anything else drops it
+		var smtpErr *SMTPError
+		if errors.As(err, &smtpErr) && smtpErr.Code > 0 && ctx.Err() == nil {
+			if resetErr := c.client.Reset(); resetErr == nil {
+				c.lastUsed = time.Now()
+				return err
+			}
+		}
+		c.dropConnection()
+		return err
+	}
+
+	c.lastUsed = time.Now()
+	return nil
+}
+
+// Close terminates cached connection
+func (c *smtpClientImpl) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.client == nil {
+		return nil
+	}
+
+	c.conn.SetDeadline(time.Now().Add(c.config.Timeout))
+	err := c.client.Quit()
+	c.dropConnection()
+	return err
+}
+
+// ensureConnected reuses cached connection if alive, otherwise dials a new one
+func (c *smtpClientImpl) ensureConnected(ctx context.Context) error {
+	if c.client != nil {
+		if time.Since(c.lastUsed) < c.config.IdleTimeout {
+			c.setDeadline(ctx)
+			if err := c.client.Noop(); err == nil {
+				c.metrics.ConnectionsReused.Inc()
+				return nil
+			}
+		}
+		c.dropConnection()
+	}
+
+	return c.connect(ctx)
+}
+
+// connect dials smarthost, negotiates TLS and authenticates
+func (c *smtpClientImpl) connect(ctx context.Context) error {
+	host := c.config.host()
+	tlsConfig := &tls.Config{
+		ServerName:         host,
+		InsecureSkipVerify: c.config.InsecureSkipVerify, //nolint:gosec // opt-in per target
+		MinVersion:         tls.VersionTLS12,
+	}
+
+	dialer := &net.Dialer{Timeout: c.config.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", c.config.Smarthost)
+	if err != nil {
+		return fmt.Errorf("smtp CONNECT %s: %w", c.config.Smarthost, err)
+	}
+
+	if c.config.ImplicitTLS {
+		tlsConn := tls.Client(conn, tlsConfig)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return fmt.Errorf("smtp TLS handshake with %s: %w", c.config.Smarthost, err)
+		}
+		conn = tlsConn
+	}
+
+	c.conn = conn
+	c.setDeadline(ctx)
+
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		c.conn = nil
+		return wrapSMTPError("CONNECT", err)
+	}
+	c.client = client
+
+	if err := c.handshake(tlsConfig); err != nil {
+		c.dropConnection()
+		return err
+	}
+
+	c.metrics.ConnectionsOpened.Inc()
+	c.logger.Debug("SMTP connection established",
+		"smarthost", c.config.Smarthost,
+		"implicit_tls", c.config.ImplicitTLS,
+	)
+
+	return nil
+}
+
+// handshake performs EHLO, STARTTLS and AUTH on a fresh connection
+func (c *smtpClientImpl) handshake(tlsConfig *tls.Config) error {
+	if c.config.Hello != "" {
+		if err := c.client.Hello(c.config.Hello); err != nil {
+			return wrapSMTPError("EHLO", err)
+		}
+	}
+
+	if !c.config.ImplicitTLS {
+		if ok, _ := c.client.Extension("STARTTLS"); ok {
+			if err := c.client.StartTLS(tlsConfig); err != nil {
+				return wrapSMTPError("STARTTLS", err)
+			}
+		} else if c.config.RequireTLS {
+			return &SMTPError{
+				Command: "STARTTLS",
+				Message: ErrSMTPStartTLSUnsupported.Error(),
+				Err:     ErrSMTPStartTLSUnsupported,
+			}
+		}
+	}
+
+	if c.config.AuthUsername == "" {
+		return nil
+	}
+
+	auth, err := c.selectAuth()
+	if err != nil {
+		return &SMTPError{Command: "AUTH", Message: err.Error(), Err: err}
+	}
+	if err := c.client.Auth(auth); err != nil {
+		return wrapSMTPError("AUTH", err)
+	}
+
+	return nil
+}
+
+// selectAuth picks auth mechanism supported by both server and configuration.
+// Preference order: CRAM-MD5, PLAIN, LOGIN (unless AuthMechanism forces one).
+func (c *smtpClientImpl) selectAuth() (smtp.Auth, error) {
+	ok, advertised := c.client.Extension("AUTH")
+	if !ok {
+		return nil, fmt.Errorf("%w: server does not advertise AUTH", ErrSMTPAuthUnsupported)
+	}
+
+	offered := make(map[string]bool)
+	for _, mech := range strings.Fields(advertised) {
+		offered[strings.ToUpper(mech)] = true
+	}
+
+	host := c.config.host()
+	for _, mech := range []string{"CRAM-MD5", "PLAIN", "LOGIN"} {
+		if !offered[mech] || (c.config.AuthMechanism != "" && c.config.AuthMechanism != mech) {
+			continue
+		}
+
+		switch mech {
+		case "CRAM-MD5":
+			secret := c.config.AuthSecret
+			if secret == "" {
+				secret = c.config.AuthPassword
+			}
+			if secret == "" {
+				continue
+			}
+			return smtp.CRAMMD5Auth(c.config.AuthUsername, secret), nil
+		case "PLAIN":
+			if c.config.AuthPassword == "" {
+				continue
+			}
+			return smtp.PlainAuth(c.config.AuthIdentity, c.config.AuthUsername, c.config.AuthPassword, host), nil
+		case "LOGIN":
+			if c.config.AuthPassword == "" {
+				continue
+			}
+			return &loginAuth{username: c.config.AuthUsername, password: c.config.AuthPassword, host: host}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: server offers %q", ErrSMTPAuthUnsupported, advertised)
+}
+
+// transaction runs MAIL, RCPT and DATA commands
+func (c *smtpClientImpl) transaction(msg *EmailMessage, data []byte) error {
+	if err := c.client.Mail(extractAddress(msg.From)); err != nil {
+		return wrapSMTPError("MAIL", err)
+	}
+	for _, rcpt := range msg.To {
+		if err := c.client.Rcpt(extractAddress(rcpt)); err != nil {
+			return wrapSMTPError("RCPT", err)
+		}
+	}
+
+	w, err := c.client.Data()
+	if err != nil {
+		return wrapSMTPError("DATA", err)
+	}
+	if _, err := w.Write(data); err != nil {
+		return wrapSMTPError("DATA", err)
+	}
+	if err := w.Close(); err != nil {
+		return wrapSMTPError("DATA", err)
+	}
+
+	return nil
+}
+
+// setDeadline sets connection deadline to the earlier of ctx deadline and now+Timeout
+func (c *smtpClientImpl) setDeadline(ctx context.Context) {
+	deadline := time.Now().Add(c.config.Timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	c.conn.SetDeadline(deadline)
+}
+
+// dropConnection closes and forgets cached connection
+func (c *smtpClientImpl) dropConnection() {
+	if c.client != nil {
+		c.client.Close()
+	} else if c.conn != nil {
+		c.conn.Close()
+	}
+	c.client = nil
+	c.conn = nil
+}
+
+// recordError records SMTP error metrics
+func (c *smtpClientImpl) recordError(err error) {
+	var smtpErr *SMTPError
+	if errors.As(err, &smtpErr) {
+		c.metrics.SMTPErrors.WithLabelValues(smtpErr.Command, smtpErr.Type()).Inc()
+		return
+	}
+	c.metrics.SMTPErrors.WithLabelValues("CONNECTION", "network").Inc()
+}
+
+// wrapSMTPError converts SMTP reply errors to SMTPError.
+// Network errors are wrapped as-is so classifyPublishingError sees net.Error.
+func wrapSMTPError(command string, err error) error {
+	var protoErr *textproto.Error
+	if errors.As(err, &protoErr) {
+		return &SMTPError{Command: command, Code: protoErr.Code, Message: protoErr.Msg, Err: err}
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) || isRetryableNetworkError(err) {
+		return fmt.Errorf("smtp %s: %w", command, err)
+	}
+
+	// Client-side rejection (e.g. PLAIN auth over unencrypted connection)
+	return &SMTPError{Command: command, Message: err.Error(), Err: err}
+}
+
+// extractAddress extracts bare address from "Name <addr>" form
+func extractAddress(addr string) string {
+	if start := strings.LastIndex(addr, "<"); start >= 0 {
+		if end := strings.LastIndex(addr, ">"); end > start {
+			return addr[start+1 : end]
+		}
+	}
+	return strings.TrimSpace(addr)
+}
+
+// loginAuth implements the LOGIN auth mechanism (not provided by net/smtp)
+type loginAuth struct {
+	username string
+	password string
+	host     string
+}
+
+// Start begins LOGIN authentication
+func (a *loginAuth) Start(server *smtp.ServerInfo) (string, []byte, error) {
+	// Same policy as smtp.PlainAuth: never send credentials in clear text to remote hosts
+	if !server.TLS && !isLocalSMTPHost(server.Name) {
+		return "", nil, errors.New("unencrypted connection")
+	}
+	if server.Name != a.host {
+		return "", nil, errors.New("wrong host name")
+	}
+	return "LOGIN", nil, nil
+}
+
+// Next answers username and password challenges
+func (a *loginAuth) Next(fromServer []byte, more bool) ([]byte, error) {
+	if !more {
+		return nil, nil
+	}
+
+	switch strings.ToLower(strings.TrimSpace(string(fromServer))) {
+	case "username:":
+		return []byte(a.username), nil
+	case "password:":
+		return []byte(a.password), nil
+	default:
+		return nil, fmt.Errorf("unexpected LOGIN challenge: %q", fromServer)
+	}
+}
+
+// isLocalSMTPHost checks if host is loopback
+func isLocalSMTPHost(host string) bool {
+	return host == "localhost" || host == "127.0.0.1" || host == "::1"
+}
+
+// EmailClientPool caches SMTP clients per target so connections are reused
+// across publishes. Clients are keyed by target name; when a target's SMTP
+// settings change (e.g. rotated credentials) its client is closed and replaced.
+type EmailClientPool struct {
+	metrics *EmailMetrics
+	logger  *slog.Logger
+
+	mu      sync.Mutex
+	clients map[string]*pooledEmailClient
+}
+
+// pooledEmailClient is a cached SMTP client with the settings it was built from
+type pooledEmailClient struct {
+	config SMTPClientConfig
+	client EmailSMTPClient
+}
+
+// NewEmailClientPool creates a new SMTP client pool
+func NewEmailClientPool(metrics *EmailMetrics, logger *slog.Logger) *EmailClientPool {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &EmailClientPool{
+		metrics: metrics,
+		logger:  logger,
+		clients: make(map[string]*pooledEmailClient),
+	}
+}
+
+// Get returns cached SMTP client for target, creating it on first use or
+// replacing it when the SMTP settings changed
+func (p *EmailClientPool) Get(targetName string, config SMTPClientConfig) EmailSMTPClient {
+	p.mu.Lock()
+	cached, ok := p.clients[targetName]
+	if ok && cached.config == config {
+		p.mu.Unlock()
+		return cached.client
+	}
+
+	client := NewEmailSMTPClient(config, p.metrics, p.logger)
+	p.clients[targetName] = &pooledEmailClient{config: config, client: client}
+	p.mu.Unlock()
+
+	if ok {
+		if err := cached.client.Close(); err != nil {
+			p.logger.Warn("Failed to close replaced SMTP client",
+				"target", targetName,
+				"error", err)
+		}
+	}
+	return client
+}
+
+// Close closes all cached SMTP connections
+func (p *EmailClientPool) Close() error {
+	p.mu.Lock()
+	clients := p.clients
+	p.clients = make(map[string]*pooledEmailClient)
+	p.mu.Unlock()
+
+	var errs []error
+	for _, cached := range clients {
+		if err := cached.client.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,466 @@
+package publishing
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"log/slog"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSMTPServer is a minimal in-process ESMTP server for tests.
+// Supports EHLO, STARTTLS, AUTH (PLAIN, LOGIN, CRAM-MD5), MAIL, RCPT, DATA, RSET, NOOP, QUIT.
+type fakeSMTPServer struct {
+	ln net.Listener
+
+	tlsConfig   *tls.Config       // enables STARTTLS (or implicit TLS)
+	implicitTLS bool              // wrap listener with TLS
+	authMechs   string            // advertised AUTH mechanisms ("" = no AUTH)
+	username    string            // accepted username
+	password    string            // accepted password / CRAM-MD5 secret
+	rcptReplies map[string]string // recipient -> reply line (default 250)
+	closeAfter  bool              // close connection after each message
+
+	mu          sync.Mutex
+	messages    []fakeSMTPMessage
+	connections int
+	authUsed    []string
+}
+
+type fakeSMTPMessage struct {
+	From string
+	To   []string
+	TLS  bool
+	Data string
+}
+
+func newTestTLSConfig(t *testing.T) *tls.Config {
+	t.Helper()
+	srv := httptest.NewTLSServer(http.NotFoundHandler())
+	t.Cleanup(srv.Close)
+	return &tls.Config{Certificates: srv.TLS.Certificates}
+}
+
+func (s *fakeSMTPServer) start(t *testing.T) string {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	if s.implicitTLS {
+		ln = tls.NewListener(ln, s.tlsConfig)
+	}
+	s.ln = ln
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.connections++
+			s.mu.Unlock()
+			go s.serve(conn)
+		}
+	}()
+
+	return ln.Addr().String()
+}
+
+func (s *fakeSMTPServer) serve(conn net.Conn) {
+	defer conn.Close()
+
+	tp := textproto.NewConn(conn)
+	isTLS := s.implicitTLS
+	authenticated := s.authMechs == ""
+	var current *fakeSMTPMessage
+
+	tp.PrintfLine("220 fake.local ESMTP ready")
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			lines := []string{"fake.local"}
+			if s.tlsConfig != nil && !isTLS {
+				lines = append(lines, "STARTTLS")
+			}
+			if s.authMechs != "" {
+				lines = append(lines, "AUTH "+s.authMechs)
+			}
+			for i, l := range lines {
+				sep := "-"
+				if i == len(lines)-1 {
+					sep = " "
+				}
+				tp.PrintfLine("250%s%s", sep, l)
+			}
+		case "STARTTLS":
+			tp.PrintfLine("220 Ready to start TLS")
+			tlsConn := tls.Server(conn, s.tlsConfig)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			conn = tlsConn
+			tp = textproto.NewConn(conn)
+			isTLS = true
+		case "AUTH":
+			mech, initial, _ := strings.Cut(arg, " ")
+			if s.authenticate(tp, strings.ToUpper(mech), initial) {
+				authenticated = true
+				tp.PrintfLine("235 2.7.0 Authentication successful")
+			} else {
+				tp.PrintfLine("535 5.7.8 Authentication credentials invalid")
+			}
+		case "MAIL":
+			if !authenticated {
+				tp.PrintfLine("530 5.7.0 Authentication required")
+				continue
+			}
+			current = &fakeSMTPMessage{From: angleAddr(arg), TLS: isTLS}
+			tp.PrintfLine("250 OK")
+		case "RCPT":
+			addr := angleAddr(arg)
+			if reply, ok := s.rcptReplies[addr]; ok {
+				tp.PrintfLine("%s", reply)
+				continue
+			}
+			current.To = append(current.To, addr)
+			tp.PrintfLine("250 OK")
+		case "DATA":
+			tp.PrintfLine("354 End data with <CR><LF>.<CR><LF>")
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			current.Data = string(data)
+			s.mu.Lock()
+			s.messages = append(s.messages, *current)
+			s.mu.Unlock()
+			tp.PrintfLine("250 OK queued")
+			if s.closeAfter {
+				return
+			}
+		case "RSET":
+			current = nil
+			tp.PrintfLine("250 OK")
+		case "NOOP":
+			tp.PrintfLine("250 OK")
+		case "QUIT":
+			tp.PrintfLine("221 Bye")
+			return
+		default:
+			tp.PrintfLine("502 5.5.2 Command not recognized")
+		}
+	}
+}
+
+func (s *fakeSMTPServer) authenticate(tp *textproto.Conn, mech, initial string) bool {
+	s.mu.Lock()
+	s.authUsed = append(s.authUsed, mech)
+	s.mu.Unlock()
+
+	readResponse := func(challenge string) string {
+		tp.PrintfLine("334 %s", base64.StdEncoding.EncodeToString([]byte(challenge)))
+		line, _ := tp.ReadLine()
+		decoded, _ := base64.StdEncoding.DecodeString(line)
+		return string(decoded)
+	}
+
+	switch mech {
+	case "PLAIN":
+		decoded, _ := base64.StdEncoding.DecodeString(initial)
+		parts := strings.Split(string(decoded), "\x00")
+		return len(parts) == 3 && parts[1] == s.username && parts[2] == s.password
+	case "LOGIN":
+		username := readResponse("Username:")
+		password := readResponse("Password:")
+		return username == s.username && password == s.password
+	case "CRAM-MD5":
+		challenge := "<1234.5678@fake.local>"
+		user, digest, _ := strings.Cut(readResponse(challenge), " ")
+		mac := hmac.New(md5.New, []byte(s.password))
+		mac.Write([]byte(challenge))
+		return user == s.username && digest == hex.EncodeToString(mac.Sum(nil))
+	default:
+		return false
+	}
+}
+
+func (s *fakeSMTPServer) snapshot() ([]fakeSMTPMessage, int, []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]fakeSMTPMessage(nil), s.messages...), s.connections, append([]string(nil), s.authUsed...)
+}
+
+func angleAddr(arg string) string {
+	start, end := strings.Index(arg, "<"), strings.Index(arg, ">")
+	if start < 0 || end < start {
+		return ""
+	}
+	return arg[start+1 : end]
+}
+
+func newTestEmailMessage(to ...string) *EmailMessage {
+	return &EmailMessage{
+		From:    "Alerts <alerts@example.com>",
+		To:      to,
+		Subject: "[ALERT] HighCPU — prod",
+		Text:    "CPU above 90%",
+		HTML:    "<p>CPU above <b>90%</b></p>",
+		Headers: map[string]string{"X-Alert-Fingerprint": "fp-1"},
+	}
+}
+
+// TestEmailSMTPClient_SendAndReuse tests delivery, multipart body and connection reuse
+func TestEmailSMTPClient_SendAndReuse(t *testing.T) {
+	server := &fakeSMTPServer{authMechs: "PLAIN", username: "user", password: "pass"}
+	addr := server.start(t)
+
+	client := NewEmailSMTPClient(SMTPClientConfig{
+		Smarthost:    addr,
+		AuthUsername: "user",
+		AuthPassword: "pass",
+		Timeout:      5 * time.Second,
+	}, NewEmailMetrics(), slog.Default())
+	defer client.Close()
+
+	ctx := context.Background()
+	require.NoError(t, client.Send(ctx, newTestEmailMessage("oncall@example.com", "Team <team@example.com>")))
+	require.NoError(t, client.Send(ctx, newTestEmailMessage("oncall@example.com")))
+
+	messages, connections, authUsed := server.snapshot()
+	require.Len(t, messages, 2)
+	assert.Equal(t, 1, connections, "second send must reuse the connection")
+	assert.Equal(t, []string{"PLAIN"}, authUsed)
+	assert.Equal(t, "alerts@example.com", messages[0].From)
+	assert.Equal(t, []string{"oncall@example.com", "team@example.com"}, messages[0].To)
+
+	// Parse multipart/alternative body
+	parsed, err := mail.ReadMessage(strings.NewReader(messages[0].Data))
+	require.NoError(t, err)
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "[ALERT] HighCPU — prod", subject)
+	assert.Equal(t, "fp-1", parsed.Header.Get("X-Alert-Fingerprint"))
+	assert.NotEmpty(t, parsed.Header.Get("Message-Id"))
+
+	mediaType, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/alternative", mediaType)
+
+	reader := multipart.NewReader(parsed.Body, params["boundary"])
+	var parts []string
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		body, err := io.ReadAll(part) // quoted-printable decoded by multipart reader
+		require.NoError(t, err)
+		parts = append(parts, part.Header.Get("Content-Type")+"|"+string(body))
+	}
+	assert.Equal(t, []string{
+		"text/plain; charset=UTF-8|CPU above 90%",
+		"text/html; charset=UTF-8|<p>CPU above <b>90%</b></p>",
+	}, parts)
+}
+
+// TestEmailSMTPClient_STARTTLSWithLoginAuth tests STARTTLS upgrade and LOGIN auth
+func TestEmailSMTPClient_STARTTLSWithLoginAuth(t *testing.T) {
+	server := &fakeSMTPServer{
+		tlsConfig: newTestTLSConfig(t),
+		authMechs: "LOGIN",
+		username:  "user",
+		password:  "pass",
+	}
+	addr := server.start(t)
+
+	client := NewEmailSMTPClient(SMTPClientConfig{
+		Smarthost:          addr,
+		RequireTLS:         true,
+		InsecureSkipVerify: true,
+		AuthUsername:       "user",
+		AuthPassword:       "pass",
+	}, nil, nil)
+	defer client.Close()
+
+	require.NoError(t, client.Send(context.Background(), newTestEmailMessage("oncall@example.com")))
+
+	messages, _, authUsed := server.snapshot()
+	require.Len(t, messages, 1)
+	assert.True(t, messages[0].TLS, "message must be sent after STARTTLS")
+	assert.Equal(t, []string{"LOGIN"}, authUsed)
+}
+
+// TestEmailSMTPClient_ImplicitTLSWithCramMD5 tests smtps connection and CRAM-MD5 auth
+func TestEmailSMTPClient_ImplicitTLSWithCramMD5(t *testing.T) {
+	server := &fakeSMTPServer{
+		tlsConfig:   newTestTLSConfig(t),
+		implicitTLS: true,
+		authMechs:   "LOGIN PLAIN CRAM-MD5",
+		username:    "user",
+		password:    "secret",
+	}
+	addr := server.start(t)
+
+	client := NewEmailSMTPClient(SMTPClientConfig{
+		Smarthost:          addr,
+		ImplicitTLS:        true,
+		InsecureSkipVerify: true,
+		AuthUsername:       "user",
+		AuthSecret:         "secret",
+	}, nil, nil)
+	defer client.Close()
+
+	require.NoError(t, client.Send(context.Background(), newTestEmailMessage("oncall@example.com")))
+
+	messages, _, authUsed := server.snapshot()
+	require.Len(t, messages, 1)
+	assert.True(t, messages[0].TLS)
+	assert.Equal(t, []string{"CRAM-MD5"}, authUsed)
+}
+
+// TestEmailSMTPClient_ErrorClassification tests 4xx/5xx replies map to transient/permanent
+func TestEmailSMTPClient_ErrorClassification(t *testing.T) {
+	server := &fakeSMTPServer{rcptReplies: map[string]string{
+		"busy@example.com":    "450 4.2.1 Mailbox busy, try again later",
+		"unknown@example.com": "550 5.1.1 No such user",
+	}}
+	addr := server.start(t)
+
+	client := NewEmailSMTPClient(SMTPClientConfig{Smarthost: addr}, nil, nil)
+	defer client.Close()
+	ctx := context.Background()
+
+	err := client.Send(ctx, newTestEmailMessage("busy@example.com"))
+	require.Error(t, err)
+	var smtpErr *SMTPError
+	require.ErrorAs(t, err, &smtpErr)
+	assert.Equal(t, "RCPT", smtpErr.Command)
+	assert.Equal(t, 450, smtpErr.Code)
+	assert.True(t, IsSMTPTransientError(err))
+	assert.Equal(t, QueueErrorTypeTransient, classifyPublishingError(err))
+
+	err = client.Send(ctx, newTestEmailMessage("unknown@example.com"))
+	require.Error(t, err)
+	assert.False(t, IsSMTPTransientError(err))
+	assert.Equal(t, QueueErrorTypePermanent, classifyPublishingError(err))
+
+	// Reply errors keep the connection (RSET), next send succeeds on it
+	require.NoError(t, client.Send(ctx, newTestEmailMessage("oncall@example.com")))
+	messages, connections, _ := server.snapshot()
+	assert.Len(t, messages, 1)
+	assert.Equal(t, 1, connections)
+}
+
+// TestEmailSMTPClient_RequireTLS tests delivery fails when STARTTLS is not offered
+func TestEmailSMTPClient_RequireTLS(t *testing.T) {
+	server := &fakeSMTPServer{}
+	addr := server.start(t)
+
+	client := NewEmailSMTPClient(SMTPClientConfig{Smarthost: addr, RequireTLS: true}, nil, nil)
+	defer client.Close()
+
+	err := client.Send(context.Background(), newTestEmailMessage("oncall@example.com"))
+	assert.ErrorIs(t, err, ErrSMTPStartTLSUnsupported)
+	assert.Equal(t, QueueErrorTypePermanent, classifyPublishingError(err))
+
+	messages, _, _ := server.snapshot()
+	assert.Empty(t, messages)
+}
+
+// TestEmailSMTPClient_AuthFailure tests rejected credentials are permanent auth errors
+func TestEmailSMTPClient_AuthFailure(t *testing.T) {
+	server := &fakeSMTPServer{authMechs: "PLAIN", username: "user", password: "pass"}
+	addr := server.start(t)
+
+	client := NewEmailSMTPClient(SMTPClientConfig{
+		Smarthost:    addr,
+		AuthUsername: "user",
+		AuthPassword: "wrong",
+	}, nil, nil)
+	defer client.Close()
+
+	err := client.Send(context.Background(), newTestEmailMessage("oncall@example.com"))
+	require.Error(t, err)
+	assert.True(t, IsSMTPAuthError(err))
+	assert.Equal(t, QueueErrorTypePermanent, classifyPublishingError(err))
+}
+
+// TestEmailSMTPClient_Reconnect tests a closed connection is replaced transparently
+func TestEmailSMTPClient_Reconnect(t *testing.T) {
+	server := &fakeSMTPServer{closeAfter: true}
+	addr := server.start(t)
+
+	client := NewEmailSMTPClient(SMTPClientConfig{Smarthost: addr}, nil, nil)
+	defer client.Close()
+
+	require.NoError(t, client.Send(context.Background(), newTestEmailMessage("oncall@example.com")))
+	require.NoError(t, client.Send(context.Background(), newTestEmailMessage("oncall@example.com")))
+
+	messages, connections, _ := server.snapshot()
+	assert.Len(t, messages, 2)
+	assert.Equal(t, 2, connections)
+}
+
+// closeCountingSMTPClient is an EmailSMTPClient recording Close calls
+type closeCountingSMTPClient struct {
+	closed int
+}
+
+func (c *closeCountingSMTPClient) Send(context.Context, *EmailMessage) error { return nil }
+
+func (c *closeCountingSMTPClient) Close() error {
+	c.closed++
+	return nil
+}
+
+// TestEmailClientPool tests clients are cached per target and replaced when
+// the target's SMTP settings change
+func TestEmailClientPool(t *testing.T) {
+	pool := NewEmailClientPool(NewEmailMetrics(), slog.Default())
+	cfg := SMTPClientConfig{Smarthost: "127.0.0.1:25"}
+
+	first := pool.Get("email-oncall", cfg)
+	assert.Same(t, first, pool.Get("email-oncall", cfg))
+	assert.NotSame(t, first, pool.Get("email-team", cfg))
+
+	// Rotated credentials close and replace the cached client
+	stale := &closeCountingSMTPClient{}
+	pool.clients["email-oncall"].client = stale
+
+	cfg.AuthPassword = "rotated"
+	rotated := pool.Get("email-oncall", cfg)
+	assert.NotSame(t, first, rotated)
+	assert.Equal(t, 1, stale.closed)
+	assert.Same(t, rotated, pool.Get("email-oncall", cfg))
+	assert.Len(t, pool.clients, 2)
+
+	assert.NoError(t, pool.Close())
+	assert.Empty(t, pool.clients)
+}
@@ -0,0 +1,98 @@
+package publishing
+
+import (
+	"errors"
+	"fmt"
+)
+
+// email_errors.go - SMTP error types and classification helpers
+
+// SMTPError represents a failed SMTP command.
+//
+// Code is the SMTP reply code (RFC 5321). 4xx replies are transient
+// (greylisting, mailbox busy, rate limits), 5xx replies are permanent
+// (unknown recipient, auth failure). Code 0 means the client rejected the
+// exchange itself (e.g. TLS required but not offered) and is permanent.
+type SMTPError struct {
+	// Command is the SMTP stage that failed (CONNECT, STARTTLS, AUTH, MAIL, RCPT, DATA)
+	Command string
+
+	// Code is the SMTP reply code (0 if no reply was received)
+	Code int
+
+	// Message is the server reply text or client-side reason
+	Message string
+
+	// Err is the underlying error (optional)
+	Err error
+}
+
+// Error implements the error interface
+func (e *SMTPError) Error() string {
+	if e.Code > 0 {
+		return fmt.Sprintf("smtp %s failed: reply %d %s", e.Command, e.Code, e.Message)
+	}
+	return fmt.Sprintf("smtp %s failed: %s", e.Command, e.Message)
+}
+
+// Unwrap returns the underlying error
+func (e *SMTPError) Unwrap() error {
+	return e.Err
+}
+
+// Temporary reports whether the SMTP reply is a transient (4xx) failure
+func (e *SMTPError) Temporary() bool {
+	return e.Code >= 400 && e.Code < 500
+}
+
+// Type returns the error type classification based on SMTP reply code
+func (e *SMTPError) Type() string {
+	switch {
+	case e.Command == "AUTH":
+		return "auth"
+	case e.Code == 0:
+		return "client"
+	case e.Temporary():
+		return "transient"
+	case e.Command == "RCPT":
+		return "recipient_rejected"
+	default:
+		return "permanent"
+	}
+}
+
+// Sentinel errors for email target configuration and SMTP capabilities
+var (
+	// ErrMissingEmailRecipients is returned when target has no "to" addresses
+	ErrMissingEmailRecipients = errors.New("email: no recipients (to) in target configuration")
+
+	// ErrMissingEmailSender is returned when target has no "from" address
+	ErrMissingEmailSender = errors.New("email: sender (from) not found in target configuration")
+
+	// ErrInvalidSMTPURL is returned when target URL is not smtp://host[:port] or smtps://host[:port]
+	ErrInvalidSMTPURL = errors.New("email: invalid SMTP URL (expected smtp://host:port or smtps://host:port)")
+
+	// ErrSMTPStartTLSUnsupported is returned when TLS is required but the server does not offer STARTTLS
+	ErrSMTPStartTLSUnsupported = errors.New("email: server does not support STARTTLS")
+
+	// ErrSMTPAuthUnsupported is returned when credentials are set but no common auth mechanism exists
+	ErrSMTPAuthUnsupported = errors.New("email: no supported SMTP auth mechanism")
+)
+
+// IsSMTPTransientError checks if SMTP error is transient (4xx reply)
+func IsSMTPTransientError(err error) bool {
+	var smtpErr *SMTPError
+	if errors.As(err, &smtpErr) {
+		return smtpErr.Temporary()
+	}
+	return false
+}
+
+// IsSMTPAuthError checks if SMTP error is an authentication failure
+func IsSMTPAuthError(err error) bool {
+	var smtpErr *SMTPError
+	if errors.As(err, &smtpErr) {
+		return smtpErr.Command == "AUTH"
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+package publishing
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Email Prometheus Metrics
+
+// EmailMetrics holds all email (SMTP) publisher Prometheus metrics
+type EmailMetrics struct {
+	// EmailsSent tracks the total number of emails sent by target and status
+	EmailsSent *prometheus.CounterVec
+
+	// EmailsSkipped tracks resolved notifications skipped due to send_resolved=false
+	EmailsSkipped *prometheus.CounterVec
+
+	// SMTPErrors tracks SMTP errors by command and error type
+	SMTPErrors *prometheus.CounterVec
+
+	// SendDuration tracks the duration of SMTP transactions
+	SendDuration *prometheus.HistogramVec
+
+	// ConnectionsOpened tracks the number of new SMTP connections
+	ConnectionsOpened prometheus.Counter
+
+	// ConnectionsReused tracks the number of reused SMTP connections
+	ConnectionsReused prometheus.Counter
+}
+
+// NewEmailMetrics creates a new EmailMetrics instance
+func NewEmailMetrics() *EmailMetrics {
+	return &EmailMetrics{
+		EmailsSent: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "email_messages_sent_total",
+				Help: "Total number of email notifications sent",
+			},
+			[]string{"target", "status"},
+		),
+		EmailsSkipped: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "email_messages_skipped_total",
+				Help: "Total number of email notifications skipped",
+			},
+			[]string{"target", "reason"},
+		),
+		SMTPErrors: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "email_smtp_errors_total",
+				Help: "Total number of SMTP errors",
+			},
+			[]string{"command", "error_type"},
+		),
+		SendDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "email_smtp_send_duration_seconds",
+				Help:    "Duration of SMTP transactions in seconds",
+				Buckets: prometheus.DefBuckets,
+			},
+			[]string{"target"},
+		),
+		ConnectionsOpened: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Name: "email_smtp_connections_opened_total",
+				Help: "Total number of SMTP connections opened",
+			},
+		),
+		ConnectionsReused: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Name: "email_smtp_connections_reused_total",
+				Help: "Total number of SMTP transactions on a reused connection",
+			},
+		),
+	}
+}
@@ -0,0 +1,272 @@
+package publishing
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/textproto"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+)
+
+// email_models.go - Email message and SMTP target configuration
+
+const (
+	// defaultSMTPPort is the submission port used for smtp:// URLs without port
+	defaultSMTPPort = "587"
+
+	// defaultSMTPSPort is the implicit TLS port used for smtps:// URLs without port
+	defaultSMTPSPort = "465"
+)
+
+// EmailMessage represents a single email to be delivered via SMTP
+type EmailMessage struct {
+	// From is the envelope and header sender address
+	From string
+
+	// To is the list of recipient addresses
+	To []string
+
+	// Subject is the email subject (UTF-8, encoded on the wire)
+	Subject string
+
+	// Text is the plain text body (optional if HTML is set)
+	Text string
+
+	// HTML is the HTML body (optional if Text is set)
+	HTML string
+
+	// Headers are additional email headers (e.g. X-Alert-Fingerprint)
+	Headers map[string]string
+}
+
+// Bytes renders the message in RFC 5322 format.
+// When both Text and HTML bodies are present, a multipart/alternative
+// message is produced with the plain text part first.
+func (m *EmailMessage) Bytes() ([]byte, error) {
+	var buf bytes.Buffer
+
+	header := textproto.MIMEHeader{}
+	header.Set("From", m.From)
+	header.Set("To", strings.Join(m.To, ", "))
+	header.Set("Subject", mime.QEncoding.Encode("utf-8", m.Subject))
+	header.Set("Date", time.Now().Format(time.RFC1123Z))
+	header.Set("Message-Id", generateMessageID(m.From))
+	header.Set("MIME-Version", "1.0")
+	for key, value := range m.Headers {
+		header.Set(key, value)
+	}
+
+	switch {
+	case m.Text != "" && m.HTML != "":
+		mw := multipart.NewWriter(&buf)
+		header.Set("Content-Type", "multipart/alternative; boundary="+mw.Boundary())
+		writeEmailHeader(&buf, header)
+
+		if err := writeEmailPart(mw, "text/plain", m.Text); err != nil {
+			return nil, err
+		}
+		if err := writeEmailPart(mw, "text/html", m.HTML); err != nil {
+			return nil, err
+		}
+		if err := mw.Close(); err != nil {
+			return nil, err
+		}
+	case m.HTML != "":
+		header.Set("Content-Type", "text/html; charset=UTF-8")
+		header.Set("Content-Transfer-Encoding", "quoted-printable")
+		writeEmailHeader(&buf, header)
+		if err := writeQuotedPrintable(&buf, m.HTML); err != nil {
+			return nil, err
+		}
+	default:
+		header.Set("Content-Type", "text/plain; charset=UTF-8")
+		header.Set("Content-Transfer-Encoding", "quoted-printable")
+		writeEmailHeader(&buf, header)
+		if err := writeQuotedPrintable(&buf, m.Text); err != nil {
+			return nil, err
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+// writeEmailHeader writes headers in stable (sorted) order followed by blank line
+func writeEmailHeader(buf *bytes.Buffer, header textproto.MIMEHeader) {
+	keys := make([]string, 0, len(header))
+	for key := range header {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		for _, value := range header[key] {
+			fmt.Fprintf(buf, "%s: %s\r\n", key, value)
+		}
+	}
+	buf.WriteString("\r\n")
+}
+
+// writeEmailPart writes a quoted-printable body part to multipart writer
+func writeEmailPart(mw *multipart.Writer, contentType, body string) error {
+	part, err := mw.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {contentType + "; charset=UTF-8"},
+		"Content-Transfer-Encoding": {"quoted-printable"},
+	})
+	if err != nil {
+		return err
+	}
+
+	qp := quotedprintable.NewWriter(part)
+	if _, err := qp.Write([]byte(body)); err != nil {
+		return err
+	}
+	return qp.Close()
+}
+
+// writeQuotedPrintable writes quoted-printable encoded body
+func writeQuotedPrintable(buf *bytes.Buffer, body string) error {
+	qp := quotedprintable.NewWriter(buf)
+	if _, err := qp.Write([]byte(body)); err != nil {
+		return err
+	}
+	return qp.Close()
+}
+
+// generateMessageID generates unique Message-Id using sender domain
+func generateMessageID(from string) string {
+	domain := "alert-history.local"
+	if at := strings.LastIndex(from, "@"); at >= 0 {
+		domain = strings.TrimSuffix(from[at+1:], ">")
+	}
+
+	random := make([]byte, 12)
+	_, _ = rand.Read(random)
+
+	return fmt.Sprintf("<%d.%s@%s>", time.Now().UnixNano(), hex.EncodeToString(random), domain)
+}
+
+// EmailTargetConfig represents email target configuration parsed from PublishingTarget
+//
+// Target URL:
+//   - smtp://host[:port]  - plain connection upgraded with STARTTLS (default port 587)
+//   - smtps://host[:port] - implicit TLS (default port 465)
+//
+// Target configuration (PublishingTarget.Headers):
+//   - to: comma-separated recipient list (required)
+//   - from: sender address (required)
+//   - auth_username, auth_password, auth_secret, auth_identity: SMTP credentials
+//   - auth_mechanism: PLAIN, LOGIN or CRAM-MD5 (default: best advertised by server)
+//   - require_tls: "false" to allow unencrypted delivery (default: true)
+//   - insecure_skip_verify: "true" to skip TLS certificate verification
+//   - hello: hostname used in EHLO
+//   - subject, html, text: template overrides (default: built-in email templates)
+//   - send_resolved: "false" to skip resolved notifications (default: true)
+//   - X-*: passed through as email headers
+type EmailTargetConfig struct {
+	SMTP         SMTPClientConfig
+	From         string
+	To           []string
+	Subject      string
+	HTML         string
+	Text         string
+	SendResolved bool
+	Headers      map[string]string
+}
+
+// parseEmailTargetConfig parses email configuration from publishing target
+func parseEmailTargetConfig(target *core.PublishingTarget) (*EmailTargetConfig, error) {
+	smtpConfig, err := parseSMTPURL(target.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := target.Headers
+	cfg := &EmailTargetConfig{
+		SMTP:         smtpConfig,
+		From:         strings.TrimSpace(headers["from"]),
+		Subject:      headers["subject"],
+		HTML:         headers["html"],
+		Text:         headers["text"],
+		SendResolved: parseBoolHeader(headers["send_resolved"], true),
+		Headers:      make(map[string]string),
+	}
+
+	for _, addr := range strings.Split(headers["to"], ",") {
+		if addr = strings.TrimSpace(addr); addr != "" {
+			cfg.To = append(cfg.To, addr)
+		}
+	}
+	if len(cfg.To) == 0 {
+		return nil, ErrMissingEmailRecipients
+	}
+	if cfg.From == "" {
+		return nil, ErrMissingEmailSender
+	}
+
+	cfg.SMTP.Hello = headers["hello"]
+	cfg.SMTP.AuthUsername = headers["auth_username"]
+	cfg.SMTP.AuthPassword = headers["auth_password"]
+	cfg.SMTP.AuthSecret = headers["auth_secret"]
+	cfg.SMTP.AuthIdentity = headers["auth_identity"]
+	cfg.SMTP.AuthMechanism = strings.ToUpper(strings.TrimSpace(headers["auth_mechanism"]))
+	cfg.SMTP.RequireTLS = parseBoolHeader(headers["require_tls"], true)
+	cfg.SMTP.InsecureSkipVerify = parseBoolHeader(headers["insecure_skip_verify"], false)
+
+	for key, value := range headers {
+		if strings.HasPrefix(strings.ToLower(key), "x-") {
+			cfg.Headers[key] = value
+		}
+	}
+
+	return cfg, nil
+}
+
+// parseSMTPURL parses smtp:// or smtps:// target URL into SMTP client config
+func parseSMTPURL(rawURL string) (SMTPClientConfig, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Hostname() == "" {
+		return SMTPClientConfig{}, fmt.Errorf("%w: %q", ErrInvalidSMTPURL, rawURL)
+	}
+
+	var cfg SMTPClientConfig
+	port := u.Port()
+	switch strings.ToLower(u.Scheme) {
+	case "smtp":
+		if port == "" {
+			port = defaultSMTPPort
+		}
+	case "smtps":
+		cfg.ImplicitTLS = true
+		if port == "" {
+			port = defaultSMTPSPort
+		}
+	default:
+		return SMTPClientConfig{}, fmt.Errorf("%w: %q", ErrInvalidSMTPURL, rawURL)
+	}
+
+	cfg.Smarthost = net.JoinHostPort(u.Hostname(), port)
+	return cfg, nil
+}
+
+// parseBoolHeader parses boolean header value, returning fallback if empty or invalid
+func parseBoolHeader(value string, fallback bool) bool {
+	if value == "" {
+		return fallback
+	}
+	parsed, err := strconv.ParseBool(strings.TrimSpace(value))
+	if err != nil {
+		return fallback
+	}
+	return parsed
+}
@@ -0,0 +1,140 @@
+package publishing
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/notification/template"
+	"github.com/vitaliisemenov/alert-history/internal/notification/template/defaults"
+)
+
+// EnhancedEmailPublisher implements AlertPublisher for SMTP email delivery
+// Renders subject, plain text and HTML bodies with NotificationTemplateEngine
+// (TN-153) using the TN-154 default email templates unless overridden, and
+// sends a multipart/alternative message over a pooled SMTP connection.
+//
+// The SMTP client is resolved per target at publish time, so the same
+// publisher instance serves all email targets (including queue workers
+// that create publishers by target type only).
+//
+// Target configuration: see EmailTargetConfig.
+type EnhancedEmailPublisher struct {
+	clients  *EmailClientPool
+	engine   template.NotificationTemplateEngine
+	metrics  *EmailMetrics
+	logger   *slog.Logger
+	defaults *defaults.EmailTemplates
+}
+
+// NewEnhancedEmailPublisher creates a new enhanced email publisher
+func NewEnhancedEmailPublisher(
+	clients *EmailClientPool,
+	engine template.NotificationTemplateEngine,
+	metrics *EmailMetrics,
+	logger *slog.Logger,
+) AlertPublisher {
+	return &EnhancedEmailPublisher{
+		clients:  clients,
+		engine:   engine,
+		metrics:  metrics,
+		logger:   logger,
+		defaults: defaults.GetDefaultEmailTemplates(),
+	}
+}
+
+// Publish renders and sends enriched alert as email
+func (p *EnhancedEmailPublisher) Publish(ctx context.Context, enrichedAlert *core.EnrichedAlert, target *core.PublishingTarget) error {
+	alert := enrichedAlert.Alert
+
+	cfg, err := parseEmailTargetConfig(target)
+	if err != nil {
+		return fmt.Errorf("invalid email target %s: %w", target.Name, err)
+	}
+
+	if alert.Status == core.StatusResolved && !cfg.SendResolved {
+		p.metrics.EmailsSkipped.WithLabelValues(target.Name, "send_resolved_disabled").Inc()
+		p.logger.Debug("Skipping resolved email notification",
+			"fingerprint", alert.Fingerprint,
+			"target", target.Name,
+		)
+		return nil
+	}
+
+	msg, err := p.buildMessage(ctx, enrichedAlert, target, cfg)
+	if err != nil {
+		return err
+	}
+
+	client := p.clients.Get(target.Name, cfg.SMTP)
+
+	start := time.Now()
+	err = client.Send(ctx, msg)
+	p.metrics.SendDuration.WithLabelValues(target.Name).Observe(time.Since(start).Seconds())
+	if err != nil {
+		p.metrics.EmailsSent.WithLabelValues(target.Name, "error").Inc()
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+
+	p.metrics.EmailsSent.WithLabelValues(target.Name, "success").Inc()
+
+	p.logger.Info("Email notification sent",
+		"fingerprint", alert.Fingerprint,
+		"target", target.Name,
+		"alert_name", alert.AlertName,
+		"recipients", len(msg.To),
+	)
+
+	return nil
+}
+
+// Name returns publisher name
+func (p *EnhancedEmailPublisher) Name() string {
+	return "Email"
+}
+
+// buildMessage renders email templates for enriched alert
+func (p *EnhancedEmailPublisher) buildMessage(ctx context.Context, enrichedAlert *core.EnrichedAlert, target *core.PublishingTarget, cfg *EmailTargetConfig) (*EmailMessage, error) {
+	alert := enrichedAlert.Alert
+
+	emailConfig := &template.EmailConfig{
+		To:      cfg.To,
+		Subject: firstNonEmpty(cfg.Subject, p.defaults.Subject),
+		Body:    firstNonEmpty(cfg.Text, p.defaults.Text),
+		HTML:    firstNonEmpty(cfg.HTML, p.defaults.HTML),
+	}
+
+	rendered, err := template.ProcessEmailConfig(ctx, p.engine, emailConfig, newAlertTemplateData(enrichedAlert, target, "email"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to render email: %w", err)
+	}
+
+	headers := map[string]string{
+		"X-Alert-Fingerprint": alert.Fingerprint,
+		"X-Alert-Status":      string(alert.Status),
+	}
+	for key, value := range cfg.Headers {
+		headers[key] = value
+	}
+
+	return &EmailMessage{
+		From:    cfg.From,
+		To:      cfg.To,
+		Subject: rendered.Subject,
+		Text:    rendered.Body,
+		HTML:    rendered.HTML,
+		Headers: headers,
+	}, nil
+}
+
+// firstNonEmpty returns first non-empty string
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,191 @@
+package publishing
+
+import (
+	"context"
+	"log/slog"
+	"mime"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/notification/template"
+)
+
+func newTestEmailPublisher(t *testing.T) AlertPublisher {
+	t.Helper()
+	engine, err := template.NewNotificationTemplateEngine(template.DefaultTemplateEngineOptions())
+	require.NoError(t, err)
+
+	metrics := NewEmailMetrics()
+	pool := NewEmailClientPool(metrics, slog.Default())
+	t.Cleanup(func() { pool.Close() })
+
+	return NewEnhancedEmailPublisher(pool, engine, metrics, slog.Default())
+}
+
+func newEmailTestAlert(status core.AlertStatus) *core.EnrichedAlert {
+	return &core.EnrichedAlert{
+		Alert: &core.Alert{
+			Fingerprint: "fp-email",
+			AlertName:   "HighCPU",
+			Status:      status,
+			Labels:      map[string]string{"alertname": "HighCPU", "severity": "critical", "namespace": "prod"},
+			Annotations: map[string]string{"summary": "CPU above 90%"},
+			StartsAt:    time.Now().Add(-5 * time.Minute),
+		},
+	}
+}
+
+func TestEnhancedEmailPublisher_Publish(t *testing.T) {
+	server := &fakeSMTPServer{}
+	addr := server.start(t)
+	publisher := newTestEmailPublisher(t)
+
+	target := &core.PublishingTarget{
+		Name: "email-oncall",
+		Type: "email",
+		URL:  "smtp://" + addr,
+		Headers: map[string]string{
+			"to":          "oncall@example.com, team@example.com",
+			"from":        "alerts@example.com",
+			"require_tls": "false",
+			"X-Team":      "sre",
+		},
+	}
+
+	ctx := context.Background()
+	require.NoError(t, publisher.Publish(ctx, newEmailTestAlert(core.StatusFiring), target))
+	require.NoError(t, publisher.Publish(ctx, newEmailTestAlert(core.StatusResolved), target))
+
+	messages, connections, _ := server.snapshot()
+	require.Len(t, messages, 2)
+	assert.Equal(t, 1, connections, "target connection must be reused")
+	assert.Equal(t, []string{"oncall@example.com", "team@example.com"}, messages[0].To)
+
+	parsed, err := mail.ReadMessage(strings.NewReader(messages[0].Data))
+	require.NoError(t, err)
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "[ALERT] HighCPU (1 alert)", subject)
+	assert.Equal(t, "sre", parsed.Header.Get("X-Team"))
+	assert.Equal(t, "fp-email", parsed.Header.Get("X-Alert-Fingerprint"))
+	assert.Contains(t, parsed.Header.Get("Content-Type"), "multipart/alternative")
+
+	resolved, err := mail.ReadMessage(strings.NewReader(messages[1].Data))
+	require.NoError(t, err)
+	subject, err = new(mime.WordDecoder).DecodeHeader(resolved.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "[RESOLVED] HighCPU (1 alert)", subject)
+}
+
+func TestEnhancedEmailPublisher_CustomTemplatesAndSendResolved(t *testing.T) {
+	server := &fakeSMTPServer{}
+	addr := server.start(t)
+	publisher := newTestEmailPublisher(t)
+
+	target := &core.PublishingTarget{
+		Name: "email-custom",
+		Type: "email",
+		URL:  "smtp://" + addr,
+		Headers: map[string]string{
+			"to":            "oncall@example.com",
+			"from":          "alerts@example.com",
+			"require_tls":   "false",
+			"subject":       "{{ .Labels.severity | upper }}: {{ .Labels.alertname }}",
+			"text":          "{{ .Annotations.summary }}",
+			"send_resolved": "false",
+		},
+	}
+
+	ctx := context.Background()
+	require.NoError(t, publisher.Publish(ctx, newEmailTestAlert(core.StatusFiring), target))
+	require.NoError(t, publisher.Publish(ctx, newEmailTestAlert(core.StatusResolved), target))
+
+	messages, _, _ := server.snapshot()
+	require.Len(t, messages, 1, "resolved notification must be skipped")
+
+	parsed, err := mail.ReadMessage(strings.NewReader(messages[0].Data))
+	require.NoError(t, err)
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "CRITICAL: HighCPU", subject)
+	assert.Contains(t, messages[0].Data, "CPU above 90")
+}
+
+func TestEnhancedEmailPublisher_InvalidTarget(t *testing.T) {
+	publisher := newTestEmailPublisher(t)
+	alert := newEmailTestAlert(core.StatusFiring)
+
+	err := publisher.Publish(context.Background(), alert, &core.PublishingTarget{
+		Name: "email", URL: "smtp://mail.example.com", Headers: map[string]string{"from": "a@example.com"},
+	})
+	assert.ErrorIs(t, err, ErrMissingEmailRecipients)
+
+	err = publisher.Publish(context.Background(), alert, &core.PublishingTarget{
+		Name: "email", URL: "https://mail.example.com", Headers: map[string]string{"to": "b@example.com", "from": "a@example.com"},
+	})
+	assert.ErrorIs(t, err, ErrInvalidSMTPURL)
+}
+
+func TestParseEmailTargetConfig(t *testing.T) {
+	cfg, err := parseEmailTargetConfig(&core.PublishingTarget{
+		URL: "smtp://mail.example.com",
+		Headers: map[string]string{
+			"to":             "a@example.com,b@example.com",
+			"from":           "alerts@example.com",
+			"auth_username":  "user",
+			"auth_password":  "pass",
+			"auth_mechanism": "login",
+			"X-Env":          "prod",
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "mail.example.com:587", cfg.SMTP.Smarthost)
+	assert.False(t, cfg.SMTP.ImplicitTLS)
+	assert.True(t, cfg.SMTP.RequireTLS)
+	assert.Equal(t, "LOGIN", cfg.SMTP.AuthMechanism)
+	assert.Equal(t, []string{"a@example.com", "b@example.com"}, cfg.To)
+	assert.True(t, cfg.SendResolved)
+	assert.Equal(t, map[string]string{"X-Env": "prod"}, cfg.Headers)
+
+	cfg, err = parseEmailTargetConfig(&core.PublishingTarget{
+		URL:     "smtps://mail.example.com",
+		Headers: map[string]string{"to": "a@example.com", "from": "alerts@example.com"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "mail.example.com:465", cfg.SMTP.Smarthost)
+	assert.True(t, cfg.SMTP.ImplicitTLS)
+
+	_, err = parseEmailTargetConfig(&core.PublishingTarget{
+		URL:     "smtp://mail.example.com",
+		Headers: map[string]string{"to": "a@example.com"},
+	})
+	assert.ErrorIs(t, err, ErrMissingEmailSender)
+}
+
+func TestPublisherFactory_Email(t *testing.T) {
+	engine, err := template.NewNotificationTemplateEngine(template.DefaultTemplateEngineOptions())
+	require.NoError(t, err)
+	metrics := NewEmailMetrics()
+	factory := &PublisherFactory{
+		formatter:      NewAlertFormatter(),
+		logger:         slog.Default(),
+		emailMetrics:   metrics,
+		emailClients:   NewEmailClientPool(metrics, slog.Default()),
+		templateEngine: engine,
+	}
+	defer factory.Shutdown()
+
+	publisher, err := factory.CreatePublisher("email")
+	require.NoError(t, err)
+	assert.IsType(t, &EnhancedEmailPublisher{}, publisher)
+
+	publisher, err = factory.CreatePublisherForTarget(&core.PublishingTarget{Name: "email", Type: "email"})
+	require.NoError(t, err)
+	assert.IsType(t, &EnhancedEmailPublisher{}, publisher)
+}
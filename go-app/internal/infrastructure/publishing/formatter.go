@@ -36,6 +36,7 @@ func NewAlertFormatter() AlertFormatter {
 	formatter.formatters[core.FormatPagerDuty] = formatter.formatPagerDuty
 	formatter.formatters[core.FormatSlack] = formatter.formatSlack
 	formatter.formatters[core.FormatWebhook] = formatter.formatWebhook
+	formatter.formatters[core.FormatOpsgenie] = formatter.formatOpsgenie
 
 	return formatter
 }
@@ -263,6 +264,49 @@ func (f *DefaultAlertFormatter) formatPagerDuty(enrichedAlert *core.EnrichedAler
 	}, nil
 }
 
+// formatOpsgenie formats alert for Opsgenie Alert API v2
+func (f *DefaultAlertFormatter) formatOpsgenie(enrichedAlert *core.EnrichedAlert) (map[string]any, error) {
+	alert := enrichedAlert.Alert
+	classification := enrichedAlert.Classification
+
+	// Determine action (alias-based close for resolved alerts)
+	action := "create"
+	if alert.Status == core.StatusResolved {
+		action = "close"
+	}
+
+	// Build message (Opsgenie limit: 130 chars)
+	message := fmt.Sprintf("[%s] %s", alert.AlertName, alert.Status)
+	if summary, ok := alert.Annotations["summary"]; ok && summary != "" {
+		message = fmt.Sprintf("[%s] %s", alert.AlertName, summary)
+	}
+
+	// Build details (Opsgenie accepts string values only)
+	details := map[string]string{
+		"fingerprint": alert.Fingerprint,
+		"status":      string(alert.Status),
+		"starts_at":   alert.StartsAt.Format(time.RFC3339),
+	}
+	for k, v := range alert.Labels {
+		details["label_"+k] = v
+	}
+	if classification != nil {
+		details["ai_severity"] = string(classification.Severity)
+		details["ai_confidence"] = fmt.Sprintf("%.0f%%", classification.Confidence*100)
+	}
+
+	return map[string]any{
+		"action":      action,
+		"message":     truncateString(message, opsgenieMaxMessageLength),
+		"alias":       alert.Fingerprint,
+		"description": truncateString(alert.Annotations["description"], opsgenieMaxDescriptionLength),
+		"tags":        buildOpsgenieTags(alert, ""),
+		"details":     details,
+		"priority":    string(getOpsgeniePriority(enrichedAlert)),
+		"source":      "alert-history-service",
+	}, nil
+}
+
 // formatSlack formats alert for Slack webhook with Blocks API
 func (f *DefaultAlertFormatter) formatSlack(enrichedAlert *core.EnrichedAlert) (map[string]any, error) {
 	alert := enrichedAlert.Alert
@@ -437,6 +481,15 @@ func truncateString(s string, maxLen int) string {
 	return s[:maxLen-3] + "..."
 }
 
+// truncateRunes truncates s to at most maxRunes characters without splitting UTF-8 sequences
+func truncateRunes(s string, maxRunes int) string {
+	runes := []rune(s)
+	if len(runes) <= maxRunes {
+		return s
+	}
+	return string(runes[:maxRunes-1]) + "…"
+}
+
 func labelsToTags(labels map[string]string) []string {
 	tags := make([]string, 0, len(labels))
 	for k, v := range labels {
@@ -21,6 +21,10 @@ const (
 	TargetTypeSlack      TargetType = "slack"
 	TargetTypeWebhook    TargetType = "webhook"
 	TargetTypeAlertmanager TargetType = "alertmanager"
+	TargetTypeOpsgenie   TargetType = "opsgenie"
+	TargetTypeEmail      TargetType = "email"
+	TargetTypeMSTeams    TargetType = "msteams"
+	TargetTypeTelegram   TargetType = "telegram"
 )
 
 // ParseTargetType converts string to TargetType
@@ -36,6 +40,14 @@ func ParseTargetType(s string) TargetType {
 		return TargetTypeWebhook
 	case "alertmanager":
 		return TargetTypeAlertmanager
+	case "opsgenie", "ops_genie":
+		return TargetTypeOpsgenie
+	case "email", "smtp":
+		return TargetTypeEmail
+	case "msteams", "teams", "ms_teams":
+		return TargetTypeMSTeams
+	case "telegram":
+		return TargetTypeTelegram
 	default:
 		return TargetTypeWebhook // Default to generic webhook
 	}
@@ -0,0 +1,232 @@
+package publishing
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// msteams_client.go - Microsoft Teams webhook client with per-webhook rate limiting and Retry-After handling
+
+// MSTeamsWebhookClient defines the interface for posting Adaptive Cards to Teams webhooks
+type MSTeamsWebhookClient interface {
+	// PostMessage posts a message to the given webhook URL
+	// Retries 429/5xx responses, honouring Retry-After (up to maxRetryAfterWait)
+	PostMessage(ctx context.Context, webhookURL string, message *MSTeamsMessage) error
+}
+
+// MSTeamsClientConfig holds configuration for the Teams webhook client
+type MSTeamsClientConfig struct {
+	// Timeout is the HTTP client timeout
+	// Default: 10s
+	Timeout time.Duration
+
+	// MaxRetries is the maximum number of retries for transient errors
+	// Default: 3
+	MaxRetries int
+
+	// RateLimit is the per-webhook rate limit in requests per second
+	// Default: 4 (Teams throttles connectors above ~4 req/s)
+	RateLimit float64
+
+	// MaxRetryAfter is the longest Retry-After the client waits for in-process
+	// Longer waits are returned as retryable errors for the publishing queue
+	// Default: 30s
+	MaxRetryAfter time.Duration
+}
+
+// msTeamsLegacyErrorPattern matches throttling/errors reported by legacy Office 365
+// connectors inside a 200 OK body, e.g.
+// "Microsoft Teams endpoint returned HTTP error 429 with ContextId ..."
+var msTeamsLegacyErrorPattern = regexp.MustCompile(`HTTP error (\d{3})`)
+
+// httpMSTeamsWebhookClient implements MSTeamsWebhookClient
+type httpMSTeamsWebhookClient struct {
+	httpClient  *http.Client
+	config      MSTeamsClientConfig
+	metrics     *MSTeamsMetrics
+	logger      *slog.Logger
+	baseBackoff time.Duration
+	maxBackoff  time.Duration
+	limitersMu  sync.Mutex
+	limiters    map[string]*rate.Limiter // webhook URL → limiter
+}
+
+// NewMSTeamsWebhookClient creates a new Teams webhook client
+func NewMSTeamsWebhookClient(config MSTeamsClientConfig, metrics *MSTeamsMetrics, logger *slog.Logger) MSTeamsWebhookClient {
+	if config.Timeout == 0 {
+		config.Timeout = 10 * time.Second
+	}
+	if config.MaxRetries == 0 {
+		config.MaxRetries = 3
+	}
+	if config.RateLimit == 0 {
+		config.RateLimit = 4.0
+	}
+	if config.MaxRetryAfter == 0 {
+		config.MaxRetryAfter = maxRetryAfterWait
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &httpMSTeamsWebhookClient{
+		httpClient: &http.Client{
+			Timeout: config.Timeout,
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					MinVersion: tls.VersionTLS12,
+				},
+				MaxIdleConnsPerHost: 2,
+				IdleConnTimeout:     30 * time.Second,
+			},
+		},
+		config:      config,
+		metrics:     metrics,
+		logger:      logger.With("component", "msteams_client"),
+		baseBackoff: 100 * time.Millisecond,
+		maxBackoff:  5 * time.Second,
+		limiters:    make(map[string]*rate.Limiter),
+	}
+}
+
+// PostMessage posts an Adaptive Card message to a Teams webhook
+func (c *httpMSTeamsWebhookClient) PostMessage(ctx context.Context, webhookURL string, message *MSTeamsMessage) error {
+	if webhookURL == "" {
+		return ErrMissingMSTeamsWebhookURL
+	}
+
+	body, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message: %w", err)
+	}
+	if len(body) > msTeamsMaxPayloadSize {
+		return fmt.Errorf("%w: %d bytes", ErrMSTeamsPayloadTooLarge, len(body))
+	}
+
+	limiter := c.limiter(webhookURL)
+	backoff := c.baseBackoff
+
+	var lastErr error
+	for attempt := 0; attempt <= c.config.MaxRetries; attempt++ {
+		if err := limiter.Wait(ctx); err != nil {
+			return fmt.Errorf("rate limiter wait failed: %w", err)
+		}
+
+		apiErr, err := c.post(ctx, webhookURL, body)
+		if err == nil && apiErr == nil {
+			return nil
+		}
+
+		if err != nil {
+			lastErr = err
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if !isRetryableNetworkError(err) || attempt == c.config.MaxRetries {
+				c.metrics.APIErrors.WithLabelValues("network_error").Inc()
+				return err
+			}
+		} else {
+			lastErr = apiErr
+			if apiErr.StatusCode == http.StatusTooManyRequests {
+				c.metrics.RateLimitHits.Inc()
+			}
+			if !shouldRetryMSTeams(apiErr.StatusCode) || attempt == c.config.MaxRetries {
+				c.metrics.APIErrors.WithLabelValues(apiErr.Type()).Inc()
+				return apiErr
+			}
+
+			// Honour Retry-After; hand long waits back to the publishing queue
+			if apiErr.RetryAfter > 0 {
+				if apiErr.RetryAfter > c.config.MaxRetryAfter {
+					c.metrics.APIErrors.WithLabelValues(apiErr.Type()).Inc()
+					return apiErr
+				}
+				c.logger.InfoContext(ctx, "Rate limited, respecting Retry-After",
+					slog.String("webhook_url", maskWebhookURL(webhookURL)),
+					slog.Duration("retry_after", apiErr.RetryAfter))
+				if err := waitRetryAfter(ctx, apiErr.RetryAfter); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		c.logger.WarnContext(ctx, "Retrying after transient error",
+			slog.Int("attempt", attempt+1),
+			slog.String("error", lastErr.Error()))
+		if err := waitRetryAfter(ctx, backoff); err != nil {
+			return err
+		}
+		backoff *= 2
+		if backoff > c.maxBackoff {
+			backoff = c.maxBackoff
+		}
+	}
+
+	return fmt.Errorf("max retries (%d) exceeded: %w", c.config.MaxRetries, lastErr)
+}
+
+// post performs a single webhook request
+// Returns (nil, err) for transport errors and (apiErr, nil) for error responses
+func (c *httpMSTeamsWebhookClient) post(ctx context.Context, webhookURL string, body []byte) (*MSTeamsAPIError, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	start := time.Now()
+	resp, err := c.httpClient.Do(req)
+	c.metrics.APIDuration.Observe(time.Since(start).Seconds())
+	if err != nil {
+		c.metrics.APIRequests.WithLabelValues("0").Inc()
+		return nil, fmt.Errorf("HTTP request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	c.metrics.APIRequests.WithLabelValues(strconv.Itoa(resp.StatusCode)).Inc()
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	message := string(bytes.TrimSpace(respBody))
+
+	statusCode := resp.StatusCode
+	if statusCode >= 200 && statusCode < 300 {
+		// Legacy connectors report downstream failures inside a 200 OK body
+		match := msTeamsLegacyErrorPattern.FindStringSubmatch(message)
+		if match == nil {
+			return nil, nil
+		}
+		statusCode, _ = strconv.Atoi(match[1])
+	}
+
+	return &MSTeamsAPIError{
+		StatusCode: statusCode,
+		Message:    message,
+		RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+	}, nil
+}
+
+// limiter returns the rate limiter for a webhook URL
+func (c *httpMSTeamsWebhookClient) limiter(webhookURL string) *rate.Limiter {
+	c.limitersMu.Lock()
+	defer c.limitersMu.Unlock()
+
+	limiter, ok := c.limiters[webhookURL]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Limit(c.config.RateLimit), 1)
+		c.limiters[webhookURL] = limiter
+	}
+	return limiter
+}
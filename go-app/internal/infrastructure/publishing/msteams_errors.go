@@ -0,0 +1,95 @@
+package publishing
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// msteams_errors.go - Microsoft Teams webhook error types and classification helpers
+
+// MSTeamsAPIError represents an error returned by a Teams webhook
+type MSTeamsAPIError struct {
+	// StatusCode is the HTTP status code (or the code embedded in a legacy connector 200 response)
+	StatusCode int
+
+	// Message is the response body (truncated)
+	Message string
+
+	// RetryAfter is the parsed Retry-After header (0 if absent)
+	RetryAfter time.Duration
+}
+
+// Error implements the error interface.
+// Includes the status code so classifyPublishingError can classify it.
+func (e *MSTeamsAPIError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("msteams webhook error %d: %s (retry after %s)", e.StatusCode, e.Message, e.RetryAfter)
+	}
+	return fmt.Sprintf("msteams webhook error %d: %s", e.StatusCode, e.Message)
+}
+
+// Type returns the error type classification based on HTTP status code
+func (e *MSTeamsAPIError) Type() string {
+	switch e.StatusCode {
+	case 400:
+		return "bad_request"
+	case 401, 403:
+		return "auth_error"
+	case 404:
+		return "not_found"
+	case 413:
+		return "payload_too_large"
+	case 429:
+		return "rate_limit"
+	case 500, 502, 503, 504:
+		return "server_error"
+	default:
+		return "unknown"
+	}
+}
+
+// Sentinel errors for common Microsoft Teams integration issues
+var (
+	// ErrMissingMSTeamsWebhookURL is returned when the target has no webhook URL
+	ErrMissingMSTeamsWebhookURL = errors.New("msteams: webhook URL not found in target configuration")
+
+	// ErrMSTeamsPayloadTooLarge is returned when the card exceeds the 28 KB webhook limit
+	ErrMSTeamsPayloadTooLarge = errors.New("msteams: message exceeds 28KB payload limit")
+)
+
+// IsMSTeamsRetryableError checks if Teams error is retryable (429, 5xx, network errors)
+func IsMSTeamsRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var apiErr *MSTeamsAPIError
+	if errors.As(err, &apiErr) {
+		return shouldRetryMSTeams(apiErr.StatusCode)
+	}
+
+	return isRetryableNetworkError(err)
+}
+
+// IsMSTeamsRateLimitError checks if Teams error is a rate limit error (429)
+func IsMSTeamsRateLimitError(err error) bool {
+	var apiErr *MSTeamsAPIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusTooManyRequests
+	}
+	return false
+}
+
+// shouldRetryMSTeams determines if the request is retryable based on HTTP status code
+func shouldRetryMSTeams(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
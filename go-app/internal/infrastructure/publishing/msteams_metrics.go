@@ -0,0 +1,75 @@
+package publishing
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Microsoft Teams Prometheus Metrics
+
+// MSTeamsMetrics holds all Microsoft Teams-specific Prometheus metrics
+type MSTeamsMetrics struct {
+	// MessagesPosted tracks the total number of cards posted
+	MessagesPosted *prometheus.CounterVec
+
+	// MessagesSkipped tracks notifications intentionally not sent
+	MessagesSkipped *prometheus.CounterVec
+
+	// APIRequests tracks the total number of webhook requests
+	APIRequests *prometheus.CounterVec
+
+	// APIErrors tracks the total number of webhook errors
+	APIErrors *prometheus.CounterVec
+
+	// APIDuration tracks the duration of webhook requests
+	APIDuration prometheus.Histogram
+
+	// RateLimitHits tracks the number of 429 responses (including legacy connector throttling)
+	RateLimitHits prometheus.Counter
+}
+
+// NewMSTeamsMetrics creates a new MSTeamsMetrics instance
+func NewMSTeamsMetrics() *MSTeamsMetrics {
+	return &MSTeamsMetrics{
+		MessagesPosted: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "msteams_messages_posted_total",
+				Help: "Total number of Microsoft Teams messages posted",
+			},
+			[]string{"target", "status"},
+		),
+		MessagesSkipped: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "msteams_messages_skipped_total",
+				Help: "Total number of Microsoft Teams messages skipped",
+			},
+			[]string{"target", "reason"},
+		),
+		APIRequests: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "msteams_api_requests_total",
+				Help: "Total number of Microsoft Teams webhook requests",
+			},
+			[]string{"status"},
+		),
+		APIErrors: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "msteams_api_errors_total",
+				Help: "Total number of Microsoft Teams webhook errors",
+			},
+			[]string{"error_type"},
+		),
+		APIDuration: prometheus.NewHistogram(
+			prometheus.HistogramOpts{
+				Name:    "msteams_api_duration_seconds",
+				Help:    "Duration of Microsoft Teams webhook requests in seconds",
+				Buckets: prometheus.DefBuckets,
+			},
+		),
+		RateLimitHits: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Name: "msteams_rate_limit_hits_total",
+				Help: "Total number of Microsoft Teams rate limit responses",
+			},
+		),
+	}
+}
@@ -0,0 +1,145 @@
+package publishing
+
+// msteams_models.go - Microsoft Teams webhook payload (Adaptive Card) data structures
+// Compatible with both Power Automate Workflows webhooks and legacy Office 365 Incoming Webhooks
+// https://learn.microsoft.com/en-us/microsoftteams/platform/webhooks-and-connectors/how-to/connectors-using
+
+const (
+	// msTeamsAdaptiveCardContentType is the attachment content type for Adaptive Cards
+	msTeamsAdaptiveCardContentType = "application/vnd.microsoft.card.adaptive"
+
+	// msTeamsAdaptiveCardSchema is the Adaptive Card JSON schema URL
+	msTeamsAdaptiveCardSchema = "http://adaptivecards.io/schemas/adaptive-card.json"
+
+	// msTeamsAdaptiveCardVersion is the highest Adaptive Card version supported by Teams webhooks
+	msTeamsAdaptiveCardVersion = "1.4"
+
+	// msTeamsMaxPayloadSize is the Teams webhook message size limit (28 KB)
+	msTeamsMaxPayloadSize = 28 * 1024
+
+	// msTeamsMaxTextLength caps rendered card text so the payload stays below msTeamsMaxPayloadSize
+	msTeamsMaxTextLength = 20000
+)
+
+// MSTeamsMessage is the webhook request body carrying a single Adaptive Card
+type MSTeamsMessage struct {
+	// Type is always "message"
+	Type string `json:"type"`
+
+	// Attachments contains the Adaptive Card
+	Attachments []MSTeamsAttachment `json:"attachments"`
+}
+
+// MSTeamsAttachment wraps an Adaptive Card
+type MSTeamsAttachment struct {
+	// ContentType is always application/vnd.microsoft.card.adaptive
+	ContentType string `json:"contentType"`
+
+	// ContentURL must be null for inline cards (required by Workflows webhooks)
+	ContentURL *string `json:"contentUrl"`
+
+	// Content is the Adaptive Card
+	Content *AdaptiveCard `json:"content"`
+}
+
+// AdaptiveCard is a minimal Adaptive Card (schema 1.4)
+type AdaptiveCard struct {
+	Schema       string                `json:"$schema"`
+	Type         string                `json:"type"`
+	Version      string                `json:"version"`
+	FallbackText string                `json:"fallbackText,omitempty"` // Shown in notifications and unsupported clients
+	Body         []AdaptiveCardElement `json:"body"`
+	Actions      []AdaptiveCardAction  `json:"actions,omitempty"`
+	MSTeams      *AdaptiveCardMSTeams  `json:"msteams,omitempty"`
+}
+
+// AdaptiveCardElement is a card body element (TextBlock or FactSet)
+type AdaptiveCardElement struct {
+	Type    string             `json:"type"`
+	Text    string             `json:"text,omitempty"`
+	Size    string             `json:"size,omitempty"`
+	Weight  string             `json:"weight,omitempty"`
+	Color   string             `json:"color,omitempty"`
+	Wrap    bool               `json:"wrap,omitempty"`
+	Spacing string             `json:"spacing,omitempty"`
+	Facts   []AdaptiveCardFact `json:"facts,omitempty"`
+}
+
+// AdaptiveCardFact is a title/value pair in a FactSet
+type AdaptiveCardFact struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+}
+
+// AdaptiveCardAction is a card action (Action.OpenUrl)
+type AdaptiveCardAction struct {
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+// AdaptiveCardMSTeams holds Teams-specific card properties
+type AdaptiveCardMSTeams struct {
+	// Width "Full" renders the card using the full conversation width
+	Width string `json:"width,omitempty"`
+}
+
+// NewMSTeamsMessage wraps an Adaptive Card into a Teams webhook message
+func NewMSTeamsMessage(card *AdaptiveCard) *MSTeamsMessage {
+	return &MSTeamsMessage{
+		Type: "message",
+		Attachments: []MSTeamsAttachment{
+			{
+				ContentType: msTeamsAdaptiveCardContentType,
+				Content:     card,
+			},
+		},
+	}
+}
+
+// NewAdaptiveCard creates an empty Adaptive Card with Teams full-width layout
+func NewAdaptiveCard() *AdaptiveCard {
+	return &AdaptiveCard{
+		Schema:  msTeamsAdaptiveCardSchema,
+		Type:    "AdaptiveCard",
+		Version: msTeamsAdaptiveCardVersion,
+		Body:    []AdaptiveCardElement{},
+		MSTeams: &AdaptiveCardMSTeams{Width: "Full"},
+	}
+}
+
+// AddTextBlock appends a wrapped TextBlock element
+func (c *AdaptiveCard) AddTextBlock(text, size, weight, color string) {
+	c.Body = append(c.Body, AdaptiveCardElement{
+		Type:   "TextBlock",
+		Text:   text,
+		Size:   size,
+		Weight: weight,
+		Color:  color,
+		Wrap:   true,
+	})
+}
+
+// AddFactSet appends a FactSet element (skipped if facts is empty)
+func (c *AdaptiveCard) AddFactSet(facts []AdaptiveCardFact) {
+	if len(facts) == 0 {
+		return
+	}
+	c.Body = append(c.Body, AdaptiveCardElement{
+		Type:    "FactSet",
+		Spacing: "Medium",
+		Facts:   facts,
+	})
+}
+
+// AddOpenURLAction appends an Action.OpenUrl button (skipped if url is empty)
+func (c *AdaptiveCard) AddOpenURLAction(title, url string) {
+	if url == "" {
+		return
+	}
+	c.Actions = append(c.Actions, AdaptiveCardAction{
+		Type:  "Action.OpenUrl",
+		Title: title,
+		URL:   url,
+	})
+}
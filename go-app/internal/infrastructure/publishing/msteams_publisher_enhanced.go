@@ -0,0 +1,145 @@
+package publishing
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/notification/template"
+	"github.com/vitaliisemenov/alert-history/internal/notification/template/defaults"
+)
+
+// EnhancedMSTeamsPublisher implements AlertPublisher for Microsoft Teams
+// Renders title, summary and text with NotificationTemplateEngine (TN-153)
+// using the default Teams templates unless overridden, and posts an
+// Adaptive Card (labels as FactSet, runbook/dashboard/source as buttons).
+//
+// Target configuration (core.PublishingTarget):
+//   - URL: Workflows or Incoming Webhook URL
+//   - Headers["title"], ["summary"], ["text"]: template overrides (optional)
+//   - Headers["send_resolved"]: "false" to skip resolved notifications (default: true)
+type EnhancedMSTeamsPublisher struct {
+	client   MSTeamsWebhookClient
+	engine   template.NotificationTemplateEngine
+	metrics  *MSTeamsMetrics
+	logger   *slog.Logger
+	defaults *defaults.MSTeamsTemplates
+}
+
+// NewEnhancedMSTeamsPublisher creates a new enhanced Microsoft Teams publisher
+func NewEnhancedMSTeamsPublisher(
+	client MSTeamsWebhookClient,
+	engine template.NotificationTemplateEngine,
+	metrics *MSTeamsMetrics,
+	logger *slog.Logger,
+) AlertPublisher {
+	return &EnhancedMSTeamsPublisher{
+		client:   client,
+		engine:   engine,
+		metrics:  metrics,
+		logger:   logger,
+		defaults: defaults.GetDefaultMSTeamsTemplates(),
+	}
+}
+
+// Publish renders and posts enriched alert as an Adaptive Card
+func (p *EnhancedMSTeamsPublisher) Publish(ctx context.Context, enrichedAlert *core.EnrichedAlert, target *core.PublishingTarget) error {
+	alert := enrichedAlert.Alert
+
+	if target.URL == "" {
+		return fmt.Errorf("invalid msteams target %s: %w", target.Name, ErrMissingMSTeamsWebhookURL)
+	}
+
+	if alert.Status == core.StatusResolved && !parseBoolHeader(target.Headers["send_resolved"], true) {
+		p.metrics.MessagesSkipped.WithLabelValues(target.Name, "send_resolved_disabled").Inc()
+		p.logger.Debug("Skipping resolved Teams notification",
+			"fingerprint", alert.Fingerprint,
+			"target", target.Name,
+		)
+		return nil
+	}
+
+	message, err := p.buildMessage(ctx, enrichedAlert, target)
+	if err != nil {
+		return err
+	}
+
+	if err := p.client.PostMessage(ctx, target.URL, message); err != nil {
+		p.metrics.MessagesPosted.WithLabelValues(target.Name, "error").Inc()
+		return fmt.Errorf("failed to post Teams message: %w", err)
+	}
+
+	p.metrics.MessagesPosted.WithLabelValues(target.Name, "success").Inc()
+
+	p.logger.Info("Teams notification sent",
+		"fingerprint", alert.Fingerprint,
+		"target", target.Name,
+		"alert_name", alert.AlertName,
+		"status", alert.Status,
+	)
+
+	return nil
+}
+
+// Name returns publisher name
+func (p *EnhancedMSTeamsPublisher) Name() string {
+	return "MSTeams"
+}
+
+// buildMessage renders Teams templates and assembles the Adaptive Card
+func (p *EnhancedMSTeamsPublisher) buildMessage(ctx context.Context, enrichedAlert *core.EnrichedAlert, target *core.PublishingTarget) (*MSTeamsMessage, error) {
+	alert := enrichedAlert.Alert
+	data := newAlertTemplateData(enrichedAlert, target, "msteams")
+
+	rendered, err := p.engine.ExecuteMultiple(ctx, map[string]string{
+		"title":   firstNonEmpty(target.Headers["title"], p.defaults.Title),
+		"summary": firstNonEmpty(target.Headers["summary"], p.defaults.Summary),
+		"text":    firstNonEmpty(target.Headers["text"], p.defaults.Text),
+	}, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render Teams message: %w", err)
+	}
+
+	color := "Good"
+	if alert.Status != core.StatusResolved {
+		color = p.defaults.ColorFunc(data.Labels["severity"])
+	}
+
+	card := NewAdaptiveCard()
+	card.FallbackText = rendered["summary"]
+	card.AddTextBlock(rendered["title"], "Large", "Bolder", color)
+	if text := rendered["text"]; text != "" {
+		card.AddTextBlock(truncateRunes(text, msTeamsMaxTextLength), "", "", "")
+	}
+	card.AddFactSet(msTeamsFacts(data.Labels, alert.StartsAt))
+	card.AddOpenURLAction("Runbook", alert.Annotations["runbook_url"])
+	card.AddOpenURLAction("Dashboard", alert.Annotations["dashboard_url"])
+	if alert.GeneratorURL != nil {
+		card.AddOpenURLAction("Source", *alert.GeneratorURL)
+	}
+
+	return NewMSTeamsMessage(card), nil
+}
+
+// msTeamsFacts converts alert labels to a sorted FactSet (alertname is already in the title)
+func msTeamsFacts(labels map[string]string, startsAt time.Time) []AdaptiveCardFact {
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		if key != "alertname" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	facts := make([]AdaptiveCardFact, 0, len(keys)+1)
+	for _, key := range keys {
+		facts = append(facts, AdaptiveCardFact{Title: key, Value: labels[key]})
+	}
+	if !startsAt.IsZero() {
+		facts = append(facts, AdaptiveCardFact{Title: "Started", Value: startsAt.UTC().Format(time.RFC3339)})
+	}
+	return facts
+}
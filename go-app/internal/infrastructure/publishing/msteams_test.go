@@ -0,0 +1,186 @@
+package publishing
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/notification/template"
+)
+
+func newTestMSTeamsClient() MSTeamsWebhookClient {
+	return NewMSTeamsWebhookClient(MSTeamsClientConfig{RateLimit: 1000, MaxRetries: 2}, NewMSTeamsMetrics(), slog.Default())
+}
+
+func TestMSTeamsClient_RetryAfter(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	start := time.Now()
+	err := newTestMSTeamsClient().PostMessage(context.Background(), server.URL, NewMSTeamsMessage(NewAdaptiveCard()))
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), calls.Load())
+	assert.GreaterOrEqual(t, time.Since(start), time.Second, "client must wait Retry-After before retrying")
+}
+
+func TestMSTeamsClient_LegacyConnectorThrottling(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		_, _ = io.WriteString(w, "Microsoft Teams endpoint returned HTTP error 429 with ContextId tcid=0")
+	}))
+	defer server.Close()
+
+	err := newTestMSTeamsClient().PostMessage(context.Background(), server.URL, NewMSTeamsMessage(NewAdaptiveCard()))
+	require.Error(t, err)
+	assert.True(t, IsMSTeamsRateLimitError(err))
+	assert.True(t, IsMSTeamsRetryableError(err))
+	assert.Equal(t, int32(3), calls.Load(), "throttled 200 responses must be retried")
+	assert.Equal(t, QueueErrorTypeTransient, classifyPublishingError(err))
+}
+
+func TestMSTeamsClient_PermanentErrorAndLongRetryAfter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/throttled" {
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = io.WriteString(w, "invalid card")
+	}))
+	defer server.Close()
+
+	client := newTestMSTeamsClient()
+
+	err := client.PostMessage(context.Background(), server.URL+"/bad", NewMSTeamsMessage(NewAdaptiveCard()))
+	require.Error(t, err)
+	assert.False(t, IsMSTeamsRetryableError(err))
+	assert.Equal(t, QueueErrorTypePermanent, classifyPublishingError(err))
+
+	// Retry-After above MaxRetryAfter is returned immediately for the queue to reschedule
+	start := time.Now()
+	err = client.PostMessage(context.Background(), server.URL+"/throttled", NewMSTeamsMessage(NewAdaptiveCard()))
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), 5*time.Second)
+	var apiErr *MSTeamsAPIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, time.Hour, apiErr.RetryAfter)
+}
+
+func TestMSTeamsClient_PayloadTooLarge(t *testing.T) {
+	card := NewAdaptiveCard()
+	card.AddTextBlock(strings.Repeat("x", msTeamsMaxPayloadSize), "", "", "")
+
+	err := newTestMSTeamsClient().PostMessage(context.Background(), "https://example.invalid/webhook", NewMSTeamsMessage(card))
+	assert.ErrorIs(t, err, ErrMSTeamsPayloadTooLarge)
+}
+
+func TestEnhancedMSTeamsPublisher_Publish(t *testing.T) {
+	var mu sync.Mutex
+	var received []MSTeamsMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var msg MSTeamsMessage
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+		mu.Lock()
+		received = append(received, msg)
+		mu.Unlock()
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	engine, err := template.NewNotificationTemplateEngine(template.DefaultTemplateEngineOptions())
+	require.NoError(t, err)
+	metrics := NewMSTeamsMetrics()
+	publisher := NewEnhancedMSTeamsPublisher(newTestMSTeamsClient(), engine, metrics, slog.Default())
+
+	generatorURL := "https://prometheus.example.com/graph"
+	alert := newEmailTestAlert(core.StatusFiring)
+	alert.Alert.GeneratorURL = &generatorURL
+	alert.Alert.Annotations["runbook_url"] = "https://runbook.example.com/cpu"
+
+	target := &core.PublishingTarget{Name: "teams-sre", Type: "msteams", URL: server.URL}
+	require.NoError(t, publisher.Publish(context.Background(), alert, target))
+
+	target.Headers = map[string]string{"send_resolved": "false"}
+	require.NoError(t, publisher.Publish(context.Background(), newEmailTestAlert(core.StatusResolved), target))
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, received, 1, "resolved notification must be skipped")
+
+	msg := received[0]
+	assert.Equal(t, "message", msg.Type)
+	require.Len(t, msg.Attachments, 1)
+	assert.Equal(t, msTeamsAdaptiveCardContentType, msg.Attachments[0].ContentType)
+
+	card := msg.Attachments[0].Content
+	require.NotNil(t, card)
+	assert.Equal(t, "AdaptiveCard", card.Type)
+	assert.Equal(t, "HighCPU: CPU above 90%", card.FallbackText)
+	require.GreaterOrEqual(t, len(card.Body), 3)
+	assert.Equal(t, "🔥 ALERT: HighCPU", card.Body[0].Text)
+	assert.Equal(t, "Attention", card.Body[0].Color)
+	assert.Contains(t, card.Body[1].Text, "CPU above 90%")
+	assert.Equal(t, "FactSet", card.Body[2].Type)
+	assert.Contains(t, card.Body[2].Facts, AdaptiveCardFact{Title: "severity", Value: "critical"})
+
+	require.Len(t, card.Actions, 2)
+	assert.Equal(t, "https://runbook.example.com/cpu", card.Actions[0].URL)
+	assert.Equal(t, generatorURL, card.Actions[1].URL)
+}
+
+func TestPublisherFactory_MSTeamsAndTelegram(t *testing.T) {
+	engine, err := template.NewNotificationTemplateEngine(template.DefaultTemplateEngineOptions())
+	require.NoError(t, err)
+	factory := &PublisherFactory{
+		formatter:       NewAlertFormatter(),
+		logger:          slog.Default(),
+		msteamsMetrics:  NewMSTeamsMetrics(),
+		msteamsClient:   newTestMSTeamsClient(),
+		telegramMetrics: NewTelegramMetrics(),
+		telegramClient:  newTestTelegramClient(),
+		telegramCache:   NewMessageCache(),
+		templateEngine:  engine,
+	}
+	defer factory.Shutdown()
+
+	publisher, err := factory.CreatePublisher("msteams")
+	require.NoError(t, err)
+	assert.IsType(t, &EnhancedMSTeamsPublisher{}, publisher)
+
+	publisher, err = factory.CreatePublisherForTarget(&core.PublishingTarget{Name: "tg", Type: "telegram"})
+	require.NoError(t, err)
+	assert.IsType(t, &EnhancedTelegramPublisher{}, publisher)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 30*time.Second, parseRetryAfter("30", now))
+	assert.Equal(t, 90*time.Second, parseRetryAfter(now.Add(90*time.Second).Format(http.TimeFormat), now))
+	assert.Zero(t, parseRetryAfter("", now))
+	assert.Zero(t, parseRetryAfter("-5", now))
+	assert.Zero(t, parseRetryAfter("soon", now))
+	assert.Zero(t, parseRetryAfter(now.Add(-time.Minute).Format(http.TimeFormat), now))
+}
@@ -0,0 +1,366 @@
+package publishing
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Opsgenie Alert API v2 Client
+// https://docs.opsgenie.com/docs/alert-api
+
+// OpsgenieAlertsClient defines the interface for Opsgenie Alert API v2
+type OpsgenieAlertsClient interface {
+	// CreateAlert creates an alert (Opsgenie deduplicates open alerts by alias)
+	CreateAlert(ctx context.Context, req *CreateAlertRequest) (*OpsgenieResponse, error)
+
+	// CloseAlert closes the open alert with the given alias
+	CloseAlert(ctx context.Context, alias string, req *CloseAlertRequest) (*OpsgenieResponse, error)
+
+	// AcknowledgeAlert acknowledges the open alert with the given alias
+	AcknowledgeAlert(ctx context.Context, alias string, req *AcknowledgeAlertRequest) (*OpsgenieResponse, error)
+
+	// Health checks API connectivity
+	Health(ctx context.Context) error
+}
+
+// OpsgenieClientConfig holds configuration for Opsgenie Alert API v2 client
+type OpsgenieClientConfig struct {
+	// BaseURL is the base URL for Opsgenie API
+	// Default: https://api.opsgenie.com (EU: https://api.eu.opsgenie.com)
+	BaseURL string
+
+	// APIKey is the API integration key (sent as "Authorization: GenieKey <key>")
+	APIKey string
+
+	// Timeout is the HTTP client timeout
+	// Default: 10s
+	Timeout time.Duration
+
+	// MaxRetries is the maximum number of retries for transient errors
+	// Default: 3
+	MaxRetries int
+
+	// RateLimit is the rate limit in requests per minute
+	// Default: 600.0 (Opsgenie create alert limit for standard plans)
+	RateLimit float64
+}
+
+// opsgenieAlertsClientImpl implements OpsgenieAlertsClient
+type opsgenieAlertsClientImpl struct {
+	httpClient  *http.Client
+	baseURL     string
+	apiKey      string
+	rateLimiter *rate.Limiter
+	logger      *slog.Logger
+	metrics     *OpsgenieMetrics
+	maxRetries  int
+	baseBackoff time.Duration
+	maxBackoff  time.Duration
+}
+
+// NewOpsgenieAlertsClient creates a new Opsgenie Alert API v2 client
+func NewOpsgenieAlertsClient(config OpsgenieClientConfig, logger *slog.Logger) OpsgenieAlertsClient {
+	// Set defaults
+	if config.BaseURL == "" {
+		config.BaseURL = "https://api.opsgenie.com"
+	}
+	if config.Timeout == 0 {
+		config.Timeout = 10 * time.Second
+	}
+	if config.MaxRetries == 0 {
+		config.MaxRetries = 3
+	}
+	if config.RateLimit == 0 {
+		config.RateLimit = 600.0 // 600 req/min
+	}
+
+	// Create HTTP client with TLS 1.2+
+	httpClient := &http.Client{
+		Timeout: config.Timeout,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				MinVersion: tls.VersionTLS12,
+			},
+		},
+	}
+
+	return &opsgenieAlertsClientImpl{
+		httpClient:  httpClient,
+		baseURL:     normalizeOpsgenieBaseURL(config.BaseURL),
+		apiKey:      config.APIKey,
+		rateLimiter: rate.NewLimiter(rate.Limit(config.RateLimit/60.0), 10), // Burst: 10
+		logger:      logger,
+		metrics:     NewOpsgenieMetrics(),
+		maxRetries:  config.MaxRetries,
+		baseBackoff: 100 * time.Millisecond,
+		maxBackoff:  5 * time.Second,
+	}
+}
+
+// CreateAlert creates an alert in Opsgenie
+func (c *opsgenieAlertsClientImpl) CreateAlert(ctx context.Context, req *CreateAlertRequest) (*OpsgenieResponse, error) {
+	if c.apiKey == "" {
+		return nil, ErrMissingOpsgenieAPIKey
+	}
+	if req.Message == "" {
+		return nil, ErrMissingOpsgenieMessage
+	}
+
+	resp, err := c.doRequest(ctx, "create", "/v2/alerts", req)
+	if err != nil {
+		c.logger.Error("Failed to create Opsgenie alert",
+			"error", err,
+			"alias", req.Alias,
+		)
+		return nil, err
+	}
+
+	c.logger.Debug("Opsgenie alert create accepted",
+		"alias", req.Alias,
+		"priority", req.Priority,
+		"request_id", resp.RequestID,
+	)
+
+	return resp, nil
+}
+
+// CloseAlert closes an alert in Opsgenie (identified by alias)
+func (c *opsgenieAlertsClientImpl) CloseAlert(ctx context.Context, alias string, req *CloseAlertRequest) (*OpsgenieResponse, error) {
+	if c.apiKey == "" {
+		return nil, ErrMissingOpsgenieAPIKey
+	}
+	if alias == "" {
+		return nil, ErrMissingOpsgenieAlias
+	}
+	if req == nil {
+		req = &CloseAlertRequest{}
+	}
+
+	resp, err := c.doRequest(ctx, "close", aliasEndpoint(alias, "close"), req)
+	if err != nil {
+		c.logger.Error("Failed to close Opsgenie alert",
+			"error", err,
+			"alias", alias,
+		)
+		return nil, err
+	}
+
+	c.logger.Debug("Opsgenie alert close accepted",
+		"alias", alias,
+		"request_id", resp.RequestID,
+	)
+
+	return resp, nil
+}
+
+// AcknowledgeAlert acknowledges an alert in Opsgenie (identified by alias)
+func (c *opsgenieAlertsClientImpl) AcknowledgeAlert(ctx context.Context, alias string, req *AcknowledgeAlertRequest) (*OpsgenieResponse, error) {
+	if c.apiKey == "" {
+		return nil, ErrMissingOpsgenieAPIKey
+	}
+	if alias == "" {
+		return nil, ErrMissingOpsgenieAlias
+	}
+	if req == nil {
+		req = &AcknowledgeAlertRequest{}
+	}
+
+	resp, err := c.doRequest(ctx, "acknowledge", aliasEndpoint(alias, "acknowledge"), req)
+	if err != nil {
+		c.logger.Error("Failed to acknowledge Opsgenie alert",
+			"error", err,
+			"alias", alias,
+		)
+		return nil, err
+	}
+
+	c.logger.Debug("Opsgenie alert acknowledge accepted",
+		"alias", alias,
+		"request_id", resp.RequestID,
+	)
+
+	return resp, nil
+}
+
+// Health checks API connectivity and API key validity
+func (c *opsgenieAlertsClientImpl) Health(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/v2/heartbeats", nil)
+	if err != nil {
+		return fmt.Errorf("health check failed: %w", err)
+	}
+	c.setHeaders(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("health check failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized, resp.StatusCode == http.StatusForbidden:
+		return c.parseError(resp)
+	case resp.StatusCode < 500:
+		// Any other response means API is reachable
+		return nil
+	default:
+		return fmt.Errorf("health check failed: unexpected status %d", resp.StatusCode)
+	}
+}
+
+// doRequest performs POST request with retry logic and decodes the 202 response
+func (c *opsgenieAlertsClientImpl) doRequest(ctx context.Context, operation string, endpoint string, body interface{}) (*OpsgenieResponse, error) {
+	// Wait for rate limiter
+	if err := c.rateLimiter.Wait(ctx); err != nil {
+		c.metrics.RateLimitHits.Inc()
+		c.logger.Warn("Rate limiter triggered", "error", err)
+		return nil, ErrOpsgenieRateLimitExceeded
+	}
+
+	jsonData, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal body: %w", err)
+	}
+
+	reqURL := c.baseURL + endpoint
+
+	var lastErr error
+	for attempt := 0; attempt <= c.maxRetries; attempt++ {
+		if attempt > 0 {
+			backoff := c.calculateBackoff(attempt - 1)
+			c.logger.Debug("Retrying after backoff", "backoff", backoff, "attempt", attempt+1)
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(backoff):
+			}
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, reqURL, bytes.NewBuffer(jsonData))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+		c.setHeaders(req)
+
+		start := time.Now()
+		resp, err := c.httpClient.Do(req)
+		duration := time.Since(start)
+
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		c.metrics.APIRequests.WithLabelValues(operation, strconv.Itoa(statusCode)).Inc()
+		c.metrics.APIDuration.WithLabelValues(operation).Observe(duration.Seconds())
+
+		if err != nil {
+			lastErr = fmt.Errorf("HTTP request failed: %w", err)
+			c.logger.Warn("HTTP request failed",
+				"attempt", attempt+1,
+				"url", reqURL,
+				"error", err,
+			)
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			continue
+		}
+
+		if resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusOK {
+			defer resp.Body.Close()
+			var ogResp OpsgenieResponse
+			if err := json.NewDecoder(resp.Body).Decode(&ogResp); err != nil {
+				return nil, fmt.Errorf("failed to decode response: %w", err)
+			}
+			return &ogResp, nil
+		}
+
+		apiErr := c.parseError(resp)
+		resp.Body.Close()
+		lastErr = apiErr
+
+		if shouldRetryOpsgenie(resp.StatusCode) && attempt < c.maxRetries {
+			c.logger.Warn("Retryable error",
+				"attempt", attempt+1,
+				"status", resp.StatusCode,
+				"error", apiErr,
+			)
+			continue
+		}
+
+		// Permanent error (or retries exhausted) - no retry
+		c.metrics.APIErrors.WithLabelValues(apiErr.Type()).Inc()
+		return nil, apiErr
+	}
+
+	c.metrics.APIErrors.WithLabelValues("network_error").Inc()
+	return nil, fmt.Errorf("request failed after %d attempts: %w", c.maxRetries+1, lastErr)
+}
+
+// setHeaders sets authentication and content headers
+func (c *opsgenieAlertsClientImpl) setHeaders(req *http.Request) {
+	req.Header.Set("Authorization", "GenieKey "+c.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "AlertHistory/1.0 (+github.com/ipiton/alert-history)")
+}
+
+// calculateBackoff calculates exponential backoff duration (100ms * 2^attempt, capped at 5s)
+func (c *opsgenieAlertsClientImpl) calculateBackoff(attempt int) time.Duration {
+	backoff := c.baseBackoff * time.Duration(1<<uint(attempt))
+	if backoff > c.maxBackoff {
+		backoff = c.maxBackoff
+	}
+	return backoff
+}
+
+// parseError parses error response from Opsgenie API
+// Error format: {"message": "...", "took": 0.001, "requestId": "..."}
+func (c *opsgenieAlertsClientImpl) parseError(resp *http.Response) *OpsgenieAPIError {
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return &OpsgenieAPIError{
+			StatusCode: resp.StatusCode,
+			Message:    "failed to read error response: " + err.Error(),
+		}
+	}
+
+	var errorResp struct {
+		Message   string `json:"message"`
+		RequestID string `json:"requestId"`
+	}
+	if err := json.Unmarshal(body, &errorResp); err != nil || errorResp.Message == "" {
+		return &OpsgenieAPIError{
+			StatusCode: resp.StatusCode,
+			Message:    string(body),
+		}
+	}
+
+	return &OpsgenieAPIError{
+		StatusCode: resp.StatusCode,
+		Message:    errorResp.Message,
+		RequestID:  errorResp.RequestID,
+	}
+}
+
+// aliasEndpoint builds alert action endpoint addressed by alias
+func aliasEndpoint(alias, action string) string {
+	return fmt.Sprintf("/v2/alerts/%s/%s?identifierType=alias", url.PathEscape(alias), action)
+}
+
+// normalizeOpsgenieBaseURL accepts both API root and full alerts endpoint URLs
+func normalizeOpsgenieBaseURL(baseURL string) string {
+	baseURL = strings.TrimRight(baseURL, "/")
+	baseURL = strings.TrimSuffix(baseURL, "/v2/alerts")
+	return strings.TrimRight(baseURL, "/")
+}
@@ -0,0 +1,148 @@
+package publishing
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestOpsgenieClient(baseURL string) OpsgenieAlertsClient {
+	return NewOpsgenieAlertsClient(OpsgenieClientConfig{
+		BaseURL:    baseURL,
+		APIKey:     "test-api-key",
+		Timeout:    5 * time.Second,
+		MaxRetries: 2,
+	}, slog.Default())
+}
+
+func writeOpsgenieAccepted(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(OpsgenieResponse{Result: "Request will be processed", RequestID: "req-1"})
+}
+
+// TestOpsgenieCreateAlert tests creating Opsgenie alerts
+func TestOpsgenieCreateAlert(t *testing.T) {
+	t.Run("success - 202 accepted", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "POST", r.Method)
+			assert.Equal(t, "/v2/alerts", r.URL.Path)
+			assert.Equal(t, "GenieKey test-api-key", r.Header.Get("Authorization"))
+
+			var req CreateAlertRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, "fp-1", req.Alias)
+			assert.Equal(t, OpsgeniePriorityP1, req.Priority)
+			require.Len(t, req.Responders, 1)
+			assert.Equal(t, "team", req.Responders[0].Type)
+
+			writeOpsgenieAccepted(w)
+		}))
+		defer server.Close()
+
+		// Full alerts endpoint URL is accepted as base URL
+		client := newTestOpsgenieClient(server.URL + "/v2/alerts")
+		resp, err := client.CreateAlert(context.Background(), &CreateAlertRequest{
+			Message:    "[HighCPU] firing",
+			Alias:      "fp-1",
+			Priority:   OpsgeniePriorityP1,
+			Responders: []OpsgenieResponder{{Type: "team", Name: "sre"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "req-1", resp.RequestID)
+	})
+
+	t.Run("missing message", func(t *testing.T) {
+		client := newTestOpsgenieClient("http://127.0.0.1:1")
+		_, err := client.CreateAlert(context.Background(), &CreateAlertRequest{Alias: "fp-1"})
+		assert.ErrorIs(t, err, ErrMissingOpsgenieMessage)
+	})
+
+	t.Run("retry on 503", func(t *testing.T) {
+		var calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if calls.Add(1) == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			writeOpsgenieAccepted(w)
+		}))
+		defer server.Close()
+
+		client := newTestOpsgenieClient(server.URL)
+		_, err := client.CreateAlert(context.Background(), &CreateAlertRequest{Message: "m", Alias: "fp-1"})
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), calls.Load())
+	})
+
+	t.Run("permanent 422 error", func(t *testing.T) {
+		var calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			w.Write([]byte(`{"message":"Message can not be empty.","took":0.001,"requestId":"req-2"}`))
+		}))
+		defer server.Close()
+
+		client := newTestOpsgenieClient(server.URL)
+		_, err := client.CreateAlert(context.Background(), &CreateAlertRequest{Message: "m"})
+		require.Error(t, err)
+
+		var apiErr *OpsgenieAPIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, 422, apiErr.StatusCode)
+		assert.Equal(t, "req-2", apiErr.RequestID)
+		assert.Equal(t, "unprocessable_entity", apiErr.Type())
+		assert.Contains(t, err.Error(), "422", "status code must be classifiable")
+		assert.False(t, IsOpsgenieRetryableError(err))
+		assert.Equal(t, int32(1), calls.Load())
+	})
+}
+
+// TestOpsgenieCloseAndAcknowledge tests alias-based alert actions
+func TestOpsgenieCloseAndAcknowledge(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.EscapedPath()+"?"+r.URL.RawQuery)
+		writeOpsgenieAccepted(w)
+	}))
+	defer server.Close()
+
+	client := newTestOpsgenieClient(server.URL)
+	ctx := context.Background()
+
+	_, err := client.AcknowledgeAlert(ctx, "fp/1", &AcknowledgeAlertRequest{User: "jane"})
+	require.NoError(t, err)
+	_, err = client.CloseAlert(ctx, "fp/1", nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"/v2/alerts/fp%2F1/acknowledge?identifierType=alias",
+		"/v2/alerts/fp%2F1/close?identifierType=alias",
+	}, paths)
+
+	_, err = client.CloseAlert(ctx, "", nil)
+	assert.ErrorIs(t, err, ErrMissingOpsgenieAlias)
+}
+
+// TestOpsgenieErrorClassification tests error helpers
+func TestOpsgenieErrorClassification(t *testing.T) {
+	assert.True(t, IsOpsgenieRetryableError(&OpsgenieAPIError{StatusCode: 429}))
+	assert.True(t, IsOpsgenieRetryableError(&OpsgenieAPIError{StatusCode: 502}))
+	assert.True(t, IsOpsgenieRateLimitError(ErrOpsgenieRateLimitExceeded))
+	assert.True(t, IsOpsgenieAuthError(&OpsgenieAPIError{StatusCode: 401}))
+	assert.True(t, IsOpsgenieAuthError(ErrMissingOpsgenieAPIKey))
+	assert.True(t, IsOpsgenieNotFoundError(&OpsgenieAPIError{StatusCode: 404}))
+	assert.False(t, IsOpsgenieRetryableError(&OpsgenieAPIError{StatusCode: 400}))
+	assert.False(t, IsOpsgenieRetryableError(nil))
+
+	assert.Equal(t, QueueErrorTypeTransient, classifyPublishingError(&OpsgenieAPIError{StatusCode: 503}))
+	assert.Equal(t, QueueErrorTypePermanent, classifyPublishingError(&OpsgenieAPIError{StatusCode: 401}))
+}
@@ -0,0 +1,158 @@
+package publishing
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// opsgenie_errors.go - Opsgenie Alert API error types and classification helpers
+
+// OpsgenieAPIError represents an error from Opsgenie Alert API v2
+type OpsgenieAPIError struct {
+	// StatusCode is the HTTP status code from the API response
+	StatusCode int
+
+	// Message is the error message from the API
+	Message string
+
+	// RequestID is the Opsgenie request ID (for support tickets)
+	RequestID string
+}
+
+// Error implements the error interface.
+// Includes the status code so classifyPublishingError can classify it.
+func (e *OpsgenieAPIError) Error() string {
+	if e.RequestID != "" {
+		return fmt.Sprintf("opsgenie API error %d: %s (request_id: %s)", e.StatusCode, e.Message, e.RequestID)
+	}
+	return fmt.Sprintf("opsgenie API error %d: %s", e.StatusCode, e.Message)
+}
+
+// Type returns the error type classification based on HTTP status code
+func (e *OpsgenieAPIError) Type() string {
+	switch e.StatusCode {
+	case 400:
+		return "bad_request"
+	case 401:
+		return "unauthorized"
+	case 403:
+		return "forbidden"
+	case 404:
+		return "not_found"
+	case 409:
+		return "conflict"
+	case 422:
+		return "unprocessable_entity"
+	case 429:
+		return "rate_limit"
+	case 500, 502, 503, 504:
+		return "server_error"
+	default:
+		return "unknown"
+	}
+}
+
+// Sentinel errors for common Opsgenie integration issues
+var (
+	// ErrMissingOpsgenieAPIKey is returned when api_key is missing from target configuration
+	ErrMissingOpsgenieAPIKey = errors.New("opsgenie: api_key not found in target configuration")
+
+	// ErrMissingOpsgenieAlias is returned when close/acknowledge is called without alias
+	ErrMissingOpsgenieAlias = errors.New("opsgenie: alert alias is required")
+
+	// ErrMissingOpsgenieMessage is returned when create is called without message
+	ErrMissingOpsgenieMessage = errors.New("opsgenie: alert message is required")
+
+	// ErrOpsgenieRateLimitExceeded is returned when the client-side rate limiter rejects a request
+	ErrOpsgenieRateLimitExceeded = errors.New("opsgenie: rate limit exceeded")
+
+	// ErrInvalidOpsgenieResponder is returned when a responder spec cannot be parsed
+	ErrInvalidOpsgenieResponder = errors.New("opsgenie: invalid responder (expected type:name)")
+
+	// ErrOpsgenieAlertNotFound is returned when acknowledging an alert Opsgenie does not know
+	ErrOpsgenieAlertNotFound = errors.New("opsgenie: alert not found")
+)
+
+// IsOpsgenieRetryableError checks if Opsgenie error is retryable (transient failure)
+// Retryable errors: 429 (rate limit), 5xx, network errors
+// Non-retryable errors: 400, 401, 403, 404, 422
+func IsOpsgenieRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, ErrOpsgenieRateLimitExceeded) {
+		return true
+	}
+
+	var apiErr *OpsgenieAPIError
+	if errors.As(err, &apiErr) {
+		return shouldRetryOpsgenie(apiErr.StatusCode)
+	}
+
+	return isRetryableNetworkError(err)
+}
+
+// IsOpsgenieRateLimitError checks if Opsgenie error is a rate limit error (429)
+func IsOpsgenieRateLimitError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, ErrOpsgenieRateLimitExceeded) {
+		return true
+	}
+
+	var apiErr *OpsgenieAPIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusTooManyRequests
+	}
+	return false
+}
+
+// IsOpsgenieAuthError checks if Opsgenie error is authentication/authorization error (401, 403)
+// 401: Invalid API key
+// 403: API key lacks permission (e.g. read-only integration)
+func IsOpsgenieAuthError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, ErrMissingOpsgenieAPIKey) {
+		return true
+	}
+
+	var apiErr *OpsgenieAPIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusUnauthorized ||
+			apiErr.StatusCode == http.StatusForbidden
+	}
+	return false
+}
+
+// IsOpsgenieNotFoundError checks if Opsgenie error is not found (404)
+// Returned when closing/acknowledging an alert alias that does not exist
+func IsOpsgenieNotFoundError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var apiErr *OpsgenieAPIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusNotFound
+	}
+	return false
+}
+
+// shouldRetryOpsgenie determines if the request is retryable based on HTTP status code
+func shouldRetryOpsgenie(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,86 @@
+package publishing
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Opsgenie Prometheus Metrics
+
+// OpsgenieMetrics holds all Opsgenie-specific Prometheus metrics
+type OpsgenieMetrics struct {
+	// AlertsCreated tracks the total number of Opsgenie alerts created
+	AlertsCreated *prometheus.CounterVec
+
+	// AlertsClosed tracks the total number of Opsgenie alerts closed
+	AlertsClosed *prometheus.CounterVec
+
+	// AlertsAcknowledged tracks the total number of Opsgenie alerts acknowledged
+	AlertsAcknowledged *prometheus.CounterVec
+
+	// APIRequests tracks the total number of API requests
+	APIRequests *prometheus.CounterVec
+
+	// APIErrors tracks the total number of API errors
+	APIErrors *prometheus.CounterVec
+
+	// APIDuration tracks the duration of API requests
+	APIDuration *prometheus.HistogramVec
+
+	// RateLimitHits tracks the number of rate limit hits
+	RateLimitHits prometheus.Counter
+}
+
+// NewOpsgenieMetrics creates a new OpsgenieMetrics instance
+func NewOpsgenieMetrics() *OpsgenieMetrics {
+	return &OpsgenieMetrics{
+		AlertsCreated: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "opsgenie_alerts_created_total",
+				Help: "Total number of Opsgenie alerts created",
+			},
+			[]string{"target", "priority"},
+		),
+		AlertsClosed: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "opsgenie_alerts_closed_total",
+				Help: "Total number of Opsgenie alerts closed",
+			},
+			[]string{"target"},
+		),
+		AlertsAcknowledged: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "opsgenie_alerts_acknowledged_total",
+				Help: "Total number of Opsgenie alerts acknowledged",
+			},
+			[]string{"target"},
+		),
+		APIRequests: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "opsgenie_api_requests_total",
+				Help: "Total number of Opsgenie API requests",
+			},
+			[]string{"operation", "status"},
+		),
+		APIErrors: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "opsgenie_api_errors_total",
+				Help: "Total number of Opsgenie API errors",
+			},
+			[]string{"error_type"},
+		),
+		APIDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "opsgenie_api_duration_seconds",
+				Help:    "Duration of Opsgenie API requests in seconds",
+				Buckets: prometheus.DefBuckets,
+			},
+			[]string{"operation"},
+		),
+		RateLimitHits: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Name: "opsgenie_rate_limit_hits_total",
+				Help: "Total number of Opsgenie rate limit hits",
+			},
+		),
+	}
+}
@@ -0,0 +1,136 @@
+package publishing
+
+// Opsgenie Alert API v2 Data Models
+// https://docs.opsgenie.com/docs/alert-api
+
+// Opsgenie field limits
+const (
+	opsgenieMaxMessageLength     = 130
+	opsgenieMaxDescriptionLength = 15000
+	opsgenieMaxTags              = 20
+)
+
+// OpsgeniePriority is the alert priority (P1 = highest, P5 = lowest)
+type OpsgeniePriority string
+
+// Opsgenie priorities
+const (
+	OpsgeniePriorityP1 OpsgeniePriority = "P1"
+	OpsgeniePriorityP2 OpsgeniePriority = "P2"
+	OpsgeniePriorityP3 OpsgeniePriority = "P3"
+	OpsgeniePriorityP4 OpsgeniePriority = "P4"
+	OpsgeniePriorityP5 OpsgeniePriority = "P5"
+)
+
+// IsValid reports whether priority is one of P1..P5
+func (p OpsgeniePriority) IsValid() bool {
+	switch p {
+	case OpsgeniePriorityP1, OpsgeniePriorityP2, OpsgeniePriorityP3, OpsgeniePriorityP4, OpsgeniePriorityP5:
+		return true
+	default:
+		return false
+	}
+}
+
+// Opsgenie responder types
+const (
+	OpsgenieResponderTeam       = "team"
+	OpsgenieResponderUser       = "user"
+	OpsgenieResponderEscalation = "escalation"
+	OpsgenieResponderSchedule   = "schedule"
+)
+
+// OpsgenieResponder is a team, user, escalation or schedule notified for an alert.
+// Either ID or Name (Username for users) identifies the responder.
+type OpsgenieResponder struct {
+	// Type is one of team, user, escalation, schedule
+	Type string `json:"type"`
+
+	// ID is the responder ID
+	ID string `json:"id,omitempty"`
+
+	// Name is the team, escalation or schedule name
+	Name string `json:"name,omitempty"`
+
+	// Username is the user login (email), for type=user
+	Username string `json:"username,omitempty"`
+}
+
+// CreateAlertRequest represents a create alert request to Opsgenie Alert API v2
+type CreateAlertRequest struct {
+	// Message is the alert text (required, max 130 chars)
+	Message string `json:"message"`
+
+	// Alias is the client-defined identifier used for deduplication (alert fingerprint)
+	Alias string `json:"alias,omitempty"`
+
+	// Description is the detailed description (max 15000 chars)
+	Description string `json:"description,omitempty"`
+
+	// Responders are notified for the alert
+	Responders []OpsgenieResponder `json:"responders,omitempty"`
+
+	// VisibleTo lists teams/users the alert is visible to without being notified
+	VisibleTo []OpsgenieResponder `json:"visibleTo,omitempty"`
+
+	// Actions are custom actions available for the alert
+	Actions []string `json:"actions,omitempty"`
+
+	// Tags of the alert (max 20)
+	Tags []string `json:"tags,omitempty"`
+
+	// Details are custom key-value properties
+	Details map[string]string `json:"details,omitempty"`
+
+	// Entity is the domain of the alert (e.g. service or cluster)
+	Entity string `json:"entity,omitempty"`
+
+	// Source is the alert source
+	Source string `json:"source,omitempty"`
+
+	// Priority is the alert priority (P1..P5, default P3)
+	Priority OpsgeniePriority `json:"priority,omitempty"`
+
+	// User is the display name of the request owner
+	User string `json:"user,omitempty"`
+
+	// Note is added to the alert on creation
+	Note string `json:"note,omitempty"`
+}
+
+// CloseAlertRequest represents a close alert request
+type CloseAlertRequest struct {
+	// User is the display name of the request owner
+	User string `json:"user,omitempty"`
+
+	// Source is the source of the close action
+	Source string `json:"source,omitempty"`
+
+	// Note is added to the alert on close
+	Note string `json:"note,omitempty"`
+}
+
+// AcknowledgeAlertRequest represents an acknowledge alert request
+type AcknowledgeAlertRequest struct {
+	// User is the display name of the request owner
+	User string `json:"user,omitempty"`
+
+	// Source is the source of the acknowledge action
+	Source string `json:"source,omitempty"`
+
+	// Note is added to the alert on acknowledge
+	Note string `json:"note,omitempty"`
+}
+
+// OpsgenieResponse represents the asynchronous API response (202 Accepted).
+// Requests are processed asynchronously; RequestID can be used to query the status.
+type OpsgenieResponse struct {
+	// Result is the result message ("Request will be processed")
+	Result string `json:"result"`
+
+	// Took is the processing time in seconds
+	Took float64 `json:"took"`
+
+	// RequestID identifies the asynchronous request
+	RequestID string `json:"requestId"`
+}
@@ -0,0 +1,319 @@
+package publishing
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+)
+
+// EnhancedOpsgeniePublisher implements AlertPublisher with full Opsgenie Alert API v2 support
+// Provides alert lifecycle management (create, acknowledge, close) keyed by alias = fingerprint.
+// Opsgenie deduplicates open alerts by alias, so no local alias cache is needed.
+//
+// Target configuration (PublishingTarget.Headers):
+//   - api_key (or "Authorization: GenieKey <key>"): integration API key (required)
+//   - responders: comma-separated type:name list, e.g. "team:sre,user:jane@example.com,escalation:ops,schedule:oncall"
+//   - visible_to: same format as responders
+//   - tags: comma-separated extra tags
+//   - priority: fixed priority P1..P5 (overrides severity mapping)
+//   - entity: alert entity
+type EnhancedOpsgeniePublisher struct {
+	client    OpsgenieAlertsClient
+	metrics   *OpsgenieMetrics
+	formatter AlertFormatter
+	logger    *slog.Logger
+}
+
+// NewEnhancedOpsgeniePublisher creates a new enhanced Opsgenie publisher
+func NewEnhancedOpsgeniePublisher(
+	client OpsgenieAlertsClient,
+	metrics *OpsgenieMetrics,
+	formatter AlertFormatter,
+	logger *slog.Logger,
+) AlertPublisher {
+	return &EnhancedOpsgeniePublisher{
+		client:    client,
+		metrics:   metrics,
+		formatter: formatter,
+		logger:    logger,
+	}
+}
+
+// Publish publishes enriched alert to Opsgenie
+// Routes to create/close based on alert status
+func (p *EnhancedOpsgeniePublisher) Publish(ctx context.Context, enrichedAlert *core.EnrichedAlert, target *core.PublishingTarget) error {
+	alert := enrichedAlert.Alert
+
+	switch alert.Status {
+	case core.StatusFiring:
+		return p.createAlert(ctx, enrichedAlert, target)
+	case core.StatusResolved:
+		return p.closeAlert(ctx, enrichedAlert, target)
+	default:
+		return fmt.Errorf("unknown alert status: %s", alert.Status)
+	}
+}
+
+// Name returns publisher name
+func (p *EnhancedOpsgeniePublisher) Name() string {
+	return "Opsgenie"
+}
+
+// Acknowledge acknowledges the Opsgenie alert (by alias = fingerprint).
+// Implements AckPublisher; alerts unknown to Opsgenie return
+// ErrOpsgenieAlertNotFound.
+func (p *EnhancedOpsgeniePublisher) Acknowledge(ctx context.Context, alert *core.Alert, ack *core.AlertAck, target *core.PublishingTarget) error {
+	note := "Acknowledged in alert-history"
+	if ack.Owner != "" {
+		note += " (owner: " + ack.Owner + ")"
+	}
+	req := &AcknowledgeAlertRequest{
+		User:   ack.AckedBy,
+		Source: "alert-history-service",
+		Note:   note,
+	}
+	if _, err := p.client.AcknowledgeAlert(ctx, alert.Fingerprint, req); err != nil {
+		if IsOpsgenieNotFoundError(err) {
+			return ErrOpsgenieAlertNotFound
+		}
+		return fmt.Errorf("failed to acknowledge alert: %w", err)
+	}
+
+	p.metrics.AlertsAcknowledged.WithLabelValues(target.Name).Inc()
+
+	p.logger.Info("Opsgenie alert acknowledged",
+		"fingerprint", alert.Fingerprint,
+		"target", target.Name,
+		"alert_name", alert.AlertName,
+		"user", ack.AckedBy,
+	)
+
+	return nil
+}
+
+// createAlert creates (or deduplicates) Opsgenie alert
+func (p *EnhancedOpsgeniePublisher) createAlert(ctx context.Context, enrichedAlert *core.EnrichedAlert, target *core.PublishingTarget) error {
+	alert := enrichedAlert.Alert
+
+	// Format alert using TN-051 formatter
+	formattedPayload, err := p.formatter.FormatAlert(ctx, enrichedAlert, core.FormatOpsgenie)
+	if err != nil {
+		return fmt.Errorf("failed to format alert: %w", err)
+	}
+
+	req, err := p.buildCreateRequest(formattedPayload, enrichedAlert, target)
+	if err != nil {
+		return err
+	}
+
+	resp, err := p.client.CreateAlert(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to create alert: %w", err)
+	}
+
+	p.metrics.AlertsCreated.WithLabelValues(target.Name, string(req.Priority)).Inc()
+
+	p.logger.Info("Opsgenie alert created",
+		"fingerprint", alert.Fingerprint,
+		"target", target.Name,
+		"alert_name", alert.AlertName,
+		"priority", req.Priority,
+		"responders", len(req.Responders),
+		"request_id", resp.RequestID,
+	)
+
+	return nil
+}
+
+// closeAlert closes Opsgenie alert by alias
+func (p *EnhancedOpsgeniePublisher) closeAlert(ctx context.Context, enrichedAlert *core.EnrichedAlert, target *core.PublishingTarget) error {
+	alert := enrichedAlert.Alert
+
+	req := &CloseAlertRequest{
+		Source: "alert-history-service",
+		Note:   "Alert resolved",
+	}
+	_, err := p.client.CloseAlert(ctx, alert.Fingerprint, req)
+	if err != nil {
+		// Alert already closed or never created: nothing to do
+		if IsOpsgenieNotFoundError(err) {
+			p.logger.Debug("Opsgenie alert not found on close, ignoring",
+				"fingerprint", alert.Fingerprint,
+				"target", target.Name,
+			)
+			return nil
+		}
+		return fmt.Errorf("failed to close alert: %w", err)
+	}
+
+	p.metrics.AlertsClosed.WithLabelValues(target.Name).Inc()
+
+	p.logger.Info("Opsgenie alert closed",
+		"fingerprint", alert.Fingerprint,
+		"target", target.Name,
+		"alert_name", alert.AlertName,
+	)
+
+	return nil
+}
+
+// Helper Methods
+
+// buildCreateRequest builds CreateAlertRequest from formatted data and target configuration
+func (p *EnhancedOpsgeniePublisher) buildCreateRequest(formattedData map[string]any, enrichedAlert *core.EnrichedAlert, target *core.PublishingTarget) (*CreateAlertRequest, error) {
+	alert := enrichedAlert.Alert
+
+	req := &CreateAlertRequest{
+		Alias:    alert.Fingerprint,
+		Source:   "alert-history-service",
+		Priority: getOpsgeniePriority(enrichedAlert),
+		Entity:   alert.Labels["service"],
+	}
+
+	// Extract fields from formatted data
+	if message, ok := formattedData["message"].(string); ok {
+		req.Message = message
+	}
+	if description, ok := formattedData["description"].(string); ok {
+		req.Description = description
+	}
+	if source, ok := formattedData["source"].(string); ok {
+		req.Source = source
+	}
+	if details, ok := formattedData["details"].(map[string]string); ok {
+		req.Details = details
+	}
+
+	// Target overrides
+	if priority, ok := target.Headers["priority"]; ok && priority != "" {
+		override := OpsgeniePriority(strings.ToUpper(priority))
+		if !override.IsValid() {
+			return nil, fmt.Errorf("%w: invalid priority %q (expected P1..P5)", ErrInvalidRequest, priority)
+		}
+		req.Priority = override
+	}
+	if entity, ok := target.Headers["entity"]; ok && entity != "" {
+		req.Entity = entity
+	}
+
+	responders, err := parseOpsgenieResponders(target.Headers["responders"])
+	if err != nil {
+		return nil, err
+	}
+	req.Responders = responders
+
+	visibleTo, err := parseOpsgenieResponders(target.Headers["visible_to"])
+	if err != nil {
+		return nil, err
+	}
+	req.VisibleTo = visibleTo
+
+	req.Tags = buildOpsgenieTags(alert, target.Headers["tags"])
+
+	return req, nil
+}
+
+// extractOpsgenieAPIKey extracts API key from target configuration
+func extractOpsgenieAPIKey(target *core.PublishingTarget) string {
+	if apiKey, ok := target.Headers["api_key"]; ok && apiKey != "" {
+		return apiKey
+	}
+
+	// Authorization header ("GenieKey <key>" format)
+	if auth, ok := target.Headers["Authorization"]; ok {
+		return strings.TrimSpace(strings.TrimPrefix(auth, "GenieKey "))
+	}
+
+	return ""
+}
+
+// getOpsgeniePriority derives Opsgenie priority from classification severity,
+// falling back to the "severity" label (default: P3)
+func getOpsgeniePriority(enrichedAlert *core.EnrichedAlert) OpsgeniePriority {
+	severity := ""
+	if enrichedAlert.Classification != nil {
+		severity = string(enrichedAlert.Classification.Severity)
+	} else if enrichedAlert.Alert != nil {
+		severity = strings.ToLower(enrichedAlert.Alert.Labels["severity"])
+	}
+
+	switch core.AlertSeverity(severity) {
+	case core.SeverityCritical:
+		return OpsgeniePriorityP1
+	case core.SeverityWarning:
+		return OpsgeniePriorityP3
+	case core.SeverityInfo:
+		return OpsgeniePriorityP4
+	case core.SeverityNoise:
+		return OpsgeniePriorityP5
+	default:
+		return OpsgeniePriorityP3
+	}
+}
+
+// parseOpsgenieResponders parses "type:name" comma-separated responder list.
+// Users are addressed by username, other types by name.
+func parseOpsgenieResponders(spec string) ([]OpsgenieResponder, error) {
+	if strings.TrimSpace(spec) == "" {
+		return nil, nil
+	}
+
+	var responders []OpsgenieResponder
+	for _, item := range strings.Split(spec, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		responderType, name, ok := strings.Cut(item, ":")
+		responderType = strings.ToLower(strings.TrimSpace(responderType))
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidOpsgenieResponder, item)
+		}
+
+		switch responderType {
+		case OpsgenieResponderUser:
+			responders = append(responders, OpsgenieResponder{Type: responderType, Username: name})
+		case OpsgenieResponderTeam, OpsgenieResponderEscalation, OpsgenieResponderSchedule:
+			responders = append(responders, OpsgenieResponder{Type: responderType, Name: name})
+		default:
+			return nil, fmt.Errorf("%w: unknown type %q", ErrInvalidOpsgenieResponder, responderType)
+		}
+	}
+
+	return responders, nil
+}
+
+// buildOpsgenieTags builds tag list: alertname, namespace, severity labels plus extra tags (max 20)
+func buildOpsgenieTags(alert *core.Alert, extra string) []string {
+	seen := make(map[string]bool)
+	var tags []string
+	add := func(tag string) {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || seen[tag] || len(tags) >= opsgenieMaxTags {
+			return
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+
+	for _, tag := range strings.Split(extra, ",") {
+		add(tag)
+	}
+
+	keys := []string{"alertname", "namespace", "severity", "cluster"}
+	for _, key := range keys {
+		if value, ok := alert.Labels[key]; ok {
+			add(key + ":" + value)
+		}
+	}
+	if _, ok := alert.Labels["alertname"]; !ok && alert.AlertName != "" {
+		add("alertname:" + alert.AlertName)
+	}
+
+	return tags
+}
@@ -0,0 +1,172 @@
+package publishing
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+)
+
+// fakeOpsgenieClient records requests sent by the publisher
+type fakeOpsgenieClient struct {
+	created   []*CreateAlertRequest
+	closed    []string
+	acked     []string
+	closeErr  error
+	createErr error
+}
+
+func (c *fakeOpsgenieClient) CreateAlert(ctx context.Context, req *CreateAlertRequest) (*OpsgenieResponse, error) {
+	if c.createErr != nil {
+		return nil, c.createErr
+	}
+	c.created = append(c.created, req)
+	return &OpsgenieResponse{RequestID: "req"}, nil
+}
+
+func (c *fakeOpsgenieClient) CloseAlert(ctx context.Context, alias string, req *CloseAlertRequest) (*OpsgenieResponse, error) {
+	if c.closeErr != nil {
+		return nil, c.closeErr
+	}
+	c.closed = append(c.closed, alias)
+	return &OpsgenieResponse{RequestID: "req"}, nil
+}
+
+func (c *fakeOpsgenieClient) AcknowledgeAlert(ctx context.Context, alias string, req *AcknowledgeAlertRequest) (*OpsgenieResponse, error) {
+	c.acked = append(c.acked, alias)
+	return &OpsgenieResponse{RequestID: "req"}, nil
+}
+
+func (c *fakeOpsgenieClient) Health(ctx context.Context) error { return nil }
+
+func newOpsgenieTestAlert(status core.AlertStatus, severity core.AlertSeverity) *core.EnrichedAlert {
+	return &core.EnrichedAlert{
+		Alert: &core.Alert{
+			Fingerprint: "fp-opsgenie",
+			AlertName:   "HighCPU",
+			Status:      status,
+			Labels: map[string]string{
+				"alertname": "HighCPU",
+				"namespace": "prod",
+				"service":   "api",
+			},
+			Annotations: map[string]string{"summary": "CPU above 90%", "description": "CPU is high"},
+			StartsAt:    time.Now(),
+		},
+		Classification: &core.ClassificationResult{Severity: severity, Confidence: 0.9},
+	}
+}
+
+func TestEnhancedOpsgeniePublisher_CreateMapping(t *testing.T) {
+	client := &fakeOpsgenieClient{}
+	publisher := NewEnhancedOpsgeniePublisher(client, NewOpsgenieMetrics(), NewAlertFormatter(), slog.Default())
+	target := &core.PublishingTarget{
+		Name: "opsgenie-sre",
+		Type: "opsgenie",
+		Headers: map[string]string{
+			"api_key":    "key",
+			"responders": "team:sre, user:jane@example.com,escalation:ops,schedule:oncall",
+			"tags":       "k8s,prod",
+		},
+	}
+
+	require.NoError(t, publisher.Publish(context.Background(), newOpsgenieTestAlert(core.StatusFiring, core.SeverityCritical), target))
+	require.Len(t, client.created, 1)
+
+	req := client.created[0]
+	assert.Equal(t, "fp-opsgenie", req.Alias)
+	assert.Equal(t, "[HighCPU] CPU above 90%", req.Message)
+	assert.Equal(t, OpsgeniePriorityP1, req.Priority)
+	assert.Equal(t, "api", req.Entity)
+	assert.Equal(t, []OpsgenieResponder{
+		{Type: "team", Name: "sre"},
+		{Type: "user", Username: "jane@example.com"},
+		{Type: "escalation", Name: "ops"},
+		{Type: "schedule", Name: "oncall"},
+	}, req.Responders)
+	assert.Equal(t, []string{"k8s", "prod", "alertname:HighCPU", "namespace:prod"}, req.Tags)
+	assert.Equal(t, "critical", req.Details["ai_severity"])
+}
+
+func TestEnhancedOpsgeniePublisher_Priority(t *testing.T) {
+	tests := []struct {
+		severity core.AlertSeverity
+		override string
+		want     OpsgeniePriority
+	}{
+		{core.SeverityCritical, "", OpsgeniePriorityP1},
+		{core.SeverityWarning, "", OpsgeniePriorityP3},
+		{core.SeverityInfo, "", OpsgeniePriorityP4},
+		{core.SeverityNoise, "", OpsgeniePriorityP5},
+		{core.SeverityInfo, "p2", OpsgeniePriorityP2},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.severity)+tt.override, func(t *testing.T) {
+			client := &fakeOpsgenieClient{}
+			publisher := NewEnhancedOpsgeniePublisher(client, NewOpsgenieMetrics(), NewAlertFormatter(), slog.Default())
+			target := &core.PublishingTarget{Name: "og", Headers: map[string]string{"priority": tt.override}}
+
+			require.NoError(t, publisher.Publish(context.Background(), newOpsgenieTestAlert(core.StatusFiring, tt.severity), target))
+			require.Len(t, client.created, 1)
+			assert.Equal(t, tt.want, client.created[0].Priority)
+		})
+	}
+}
+
+func TestEnhancedOpsgeniePublisher_InvalidConfig(t *testing.T) {
+	client := &fakeOpsgenieClient{}
+	publisher := NewEnhancedOpsgeniePublisher(client, NewOpsgenieMetrics(), NewAlertFormatter(), slog.Default())
+	alert := newOpsgenieTestAlert(core.StatusFiring, core.SeverityWarning)
+
+	err := publisher.Publish(context.Background(), alert, &core.PublishingTarget{Headers: map[string]string{"responders": "squad:x"}})
+	assert.ErrorIs(t, err, ErrInvalidOpsgenieResponder)
+
+	err = publisher.Publish(context.Background(), alert, &core.PublishingTarget{Headers: map[string]string{"priority": "urgent"}})
+	assert.ErrorIs(t, err, ErrInvalidRequest)
+	assert.Empty(t, client.created)
+}
+
+func TestEnhancedOpsgeniePublisher_CloseAndAcknowledge(t *testing.T) {
+	client := &fakeOpsgenieClient{}
+	publisher := NewEnhancedOpsgeniePublisher(client, NewOpsgenieMetrics(), NewAlertFormatter(), slog.Default())
+	target := &core.PublishingTarget{Name: "og"}
+
+	require.NoError(t, publisher.(*EnhancedOpsgeniePublisher).Acknowledge(context.Background(),
+		newOpsgenieTestAlert(core.StatusFiring, core.SeverityCritical).Alert, &core.AlertAck{Acknowledged: true, AckedBy: "jane"}, target))
+	require.NoError(t, publisher.Publish(context.Background(), newOpsgenieTestAlert(core.StatusResolved, core.SeverityCritical), target))
+	assert.Equal(t, []string{"fp-opsgenie"}, client.acked)
+	assert.Equal(t, []string{"fp-opsgenie"}, client.closed)
+
+	// Alert unknown to Opsgenie: close is a no-op
+	client.closeErr = &OpsgenieAPIError{StatusCode: 404, Message: "Alert does not exist"}
+	assert.NoError(t, publisher.Publish(context.Background(), newOpsgenieTestAlert(core.StatusResolved, core.SeverityCritical), target))
+}
+
+func TestPublisherFactory_Opsgenie(t *testing.T) {
+	factory := &PublisherFactory{
+		formatter:         NewAlertFormatter(),
+		logger:            slog.Default(),
+		opsgenieMetrics:   NewOpsgenieMetrics(),
+		opsgenieClientMap: make(map[string]OpsgenieAlertsClient),
+	}
+
+	publisher, err := factory.CreatePublisherForTarget(&core.PublishingTarget{
+		Name:    "og",
+		Type:    "opsgenie",
+		Headers: map[string]string{"Authorization": "GenieKey secret"},
+	})
+	require.NoError(t, err)
+	assert.IsType(t, &EnhancedOpsgeniePublisher{}, publisher)
+	assert.Len(t, factory.opsgenieClientMap, 1)
+
+	// Missing API key falls back to HTTP publisher
+	publisher, err = factory.CreatePublisherForTarget(&core.PublishingTarget{Name: "og", Type: "opsgenie"})
+	require.NoError(t, err)
+	assert.IsType(t, &OpsgeniePublisher{}, publisher)
+}
@@ -115,6 +115,13 @@ func (p *EnhancedPagerDutyPublisher) triggerEvent(ctx context.Context, enrichedA
 	return nil
 }
 
+// Acknowledge acknowledges the PagerDuty event of an alert (implements
+// AckPublisher). Returns ErrEventNotTracked if the alert was not triggered
+// through this instance.
+func (p *EnhancedPagerDutyPublisher) Acknowledge(ctx context.Context, alert *core.Alert, ack *core.AlertAck, target *core.PublishingTarget) error {
+	return p.acknowledgeEvent(ctx, &core.EnrichedAlert{Alert: alert}, p.extractRoutingKey(target))
+}
+
 // acknowledgeEvent acknowledges an event in PagerDuty
 func (p *EnhancedPagerDutyPublisher) acknowledgeEvent(ctx context.Context, enrichedAlert *core.EnrichedAlert, routingKey string) error {
 	alert := enrichedAlert.Alert
@@ -281,6 +288,10 @@ func (p *EnhancedPagerDutyPublisher) buildPayload(formattedData map[string]any)
 
 	// Extract custom_details from payload
 	if payloadMap, ok := formattedData["payload"].(map[string]any); ok {
+		// Formatter output nests the event payload (Events API v2 shape)
+		if summary, ok := payloadMap["summary"].(string); ok && payload.Summary == "" {
+			payload.Summary = summary
+		}
 		if customDetails, ok := payloadMap["custom_details"].(map[string]any); ok {
 			payload.CustomDetails = customDetails
 		}
@@ -9,9 +9,12 @@ import (
 	"log/slog"
 	"net/http"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/notification/template"
 )
 
 // AlertPublisher interface for publishing alerts to external systems
@@ -182,21 +185,56 @@ func (p *WebhookPublisher) Name() string {
 	return "Webhook"
 }
 
+// OpsgeniePublisher publishes alerts to Opsgenie
+type OpsgeniePublisher struct {
+	*HTTPPublisher
+}
+
+// NewOpsgeniePublisher creates a new Opsgenie publisher
+func NewOpsgeniePublisher(formatter AlertFormatter, logger *slog.Logger) AlertPublisher {
+	return &OpsgeniePublisher{
+		HTTPPublisher: NewHTTPPublisher(formatter, logger),
+	}
+}
+
+// Publish publishes alert to Opsgenie
+func (p *OpsgeniePublisher) Publish(ctx context.Context, enrichedAlert *core.EnrichedAlert, target *core.PublishingTarget) error {
+	return p.publish(ctx, enrichedAlert, target)
+}
+
+// Name returns publisher name
+func (p *OpsgeniePublisher) Name() string {
+	return "Opsgenie"
+}
+
 // PublisherFactory creates publishers based on target type
 type PublisherFactory struct {
-	formatter           AlertFormatter
-	logger              *slog.Logger
-	rootlyCache         IncidentIDCache                  // Shared Rootly incident cache
-	rootlyMetrics       *RootlyMetrics                   // Shared Rootly metrics
-	rootlyClientMap     map[string]RootlyIncidentsClient // Cache of Rootly clients by API key
-	pagerDutyCache      EventKeyCache                    // Shared PagerDuty event key cache
-	pagerDutyMetrics    *PagerDutyMetrics                // Shared PagerDuty metrics
-	pagerDutyClientMap  map[string]PagerDutyEventsClient // Cache of PagerDuty clients by routing key
-	slackCache          MessageIDCache                   // Shared Slack message cache (for threading)
-	slackMetrics        *SlackMetrics                    // Shared Slack metrics
-	slackClientMap      map[string]SlackWebhookClient    // Cache of Slack clients by webhook URL
-	slackCleanupWorker  func()                           // Slack cache cleanup worker cancel function
-	webhookMetrics      *WebhookMetrics                  // Shared Webhook metrics
+	formatter             AlertFormatter
+	logger                *slog.Logger
+	rootlyCache           IncidentIDCache                     // Shared Rootly incident cache
+	rootlyMetrics         *RootlyMetrics                      // Shared Rootly metrics
+	clientsMu             sync.Mutex                          // Guards the client caches (publishers are created concurrently)
+	rootlyClientMap       map[string]RootlyIncidentsClient    // Cache of Rootly clients by API key
+	pagerDutyCache        EventKeyCache                       // Shared PagerDuty event key cache
+	pagerDutyMetrics      *PagerDutyMetrics                   // Shared PagerDuty metrics
+	pagerDutyClientMap    map[string]PagerDutyEventsClient    // Cache of PagerDuty clients by routing key
+	slackCache            MessageIDCache                      // Shared Slack message cache (for threading)
+	slackMetrics          *SlackMetrics                       // Shared Slack metrics
+	slackClientMap        map[string]SlackWebhookClient       // Cache of Slack clients by webhook URL
+	slackCleanupWorker    func()                              // Slack cache cleanup worker cancel function
+	opsgenieMetrics       *OpsgenieMetrics                    // Shared Opsgenie metrics
+	opsgenieClientMap     map[string]OpsgenieAlertsClient     // Cache of Opsgenie clients by API URL + key
+	emailMetrics          *EmailMetrics                       // Shared Email metrics
+	emailClients          *EmailClientPool                    // Pooled SMTP connections per email target
+	msteamsMetrics        *MSTeamsMetrics                     // Shared Microsoft Teams metrics
+	msteamsClient         MSTeamsWebhookClient                // Shared Teams webhook client (per-webhook rate limits)
+	telegramMetrics       *TelegramMetrics                    // Shared Telegram metrics
+	telegramClient        TelegramBotClient                   // Shared Telegram Bot API client (per-chat rate limits)
+	telegramCache         MessageIDCache                      // Shared Telegram message cache (for reply threading)
+	telegramCleanupWorker func()                              // Telegram cache cleanup worker cancel function
+	templateEngine        template.NotificationTemplateEngine // Template engine for email/Teams/Telegram rendering
+	webhookMetrics        *WebhookMetrics                     // Shared Webhook metrics
+	templateRenderer      atomic.Pointer[TemplateRenderer]    // Managed template rendering (set once templates are available)
 }
 
 // NewPublisherFactory creates a new publisher factory
@@ -205,37 +243,96 @@ func NewPublisherFactory(formatter AlertFormatter, logger *slog.Logger) *Publish
 	slackCache := NewMessageCache()
 	slackCleanupWorker := StartCleanupWorker(slackCache, 5*time.Minute, 24*time.Hour)
 
+	// Create Telegram cache (reply threading) and start background cleanup worker
+	telegramCache := NewMessageCache()
+	telegramCleanupWorker := StartCleanupWorker(telegramCache, 5*time.Minute, 24*time.Hour)
+
+	// Template engine for email, Teams and Telegram rendering (TN-153)
+	templateEngine, err := template.NewNotificationTemplateEngine(template.DefaultTemplateEngineOptions())
+	if err != nil {
+		logger.Warn("Failed to create template engine, email/msteams/telegram targets disabled", "error", err)
+	}
+	emailMetrics := NewEmailMetrics()
+	msteamsMetrics := NewMSTeamsMetrics()
+	telegramMetrics := NewTelegramMetrics()
+
 	return &PublisherFactory{
-		formatter:          formatter,
-		logger:             logger,
-		rootlyCache:        NewIncidentIDCache(24 * time.Hour),        // 24h TTL for Rootly incident tracking
-		rootlyMetrics:      NewRootlyMetrics(),
-		rootlyClientMap:    make(map[string]RootlyIncidentsClient),
-		pagerDutyCache:     NewEventKeyCache(24 * time.Hour),          // 24h TTL for PagerDuty event tracking
-		pagerDutyMetrics:   NewPagerDutyMetrics(),
-		pagerDutyClientMap: make(map[string]PagerDutyEventsClient),
-		slackCache:         slackCache,                                // Slack message cache for threading
-		slackMetrics:       NewSlackMetrics(),
-		slackClientMap:     make(map[string]SlackWebhookClient),
-		slackCleanupWorker: slackCleanupWorker,
-		webhookMetrics:     NewWebhookMetrics(nil),                    // Webhook metrics (no registry, will use default)
+		formatter:             formatter,
+		logger:                logger,
+		rootlyCache:           NewIncidentIDCache(24 * time.Hour), // 24h TTL for Rootly incident tracking
+		rootlyMetrics:         NewRootlyMetrics(),
+		rootlyClientMap:       make(map[string]RootlyIncidentsClient),
+		pagerDutyCache:        NewEventKeyCache(24 * time.Hour), // 24h TTL for PagerDuty event tracking
+		pagerDutyMetrics:      NewPagerDutyMetrics(),
+		pagerDutyClientMap:    make(map[string]PagerDutyEventsClient),
+		slackCache:            slackCache, // Slack message cache for threading
+		slackMetrics:          NewSlackMetrics(),
+		slackClientMap:        make(map[string]SlackWebhookClient),
+		slackCleanupWorker:    slackCleanupWorker,
+		opsgenieMetrics:       NewOpsgenieMetrics(),
+		opsgenieClientMap:     make(map[string]OpsgenieAlertsClient),
+		emailMetrics:          emailMetrics,
+		emailClients:          NewEmailClientPool(emailMetrics, logger),
+		msteamsMetrics:        msteamsMetrics,
+		msteamsClient:         NewMSTeamsWebhookClient(MSTeamsClientConfig{}, msteamsMetrics, logger),
+		telegramMetrics:       telegramMetrics,
+		telegramClient:        NewTelegramBotClient(TelegramClientConfig{}, telegramMetrics, logger),
+		telegramCache:         telegramCache,
+		telegramCleanupWorker: telegramCleanupWorker,
+		templateEngine:        templateEngine,
+		webhookMetrics:        NewWebhookMetrics(nil), // Webhook metrics (no registry, will use default)
 	}
 }
 
 // CreatePublisher creates a publisher for the given target type
 func (f *PublisherFactory) CreatePublisher(targetType string) (AlertPublisher, error) {
+	return f.createHTTPPublisher(targetType, f.formatter)
+}
+
+// CreateHTTPPublisherForTarget creates the publisher for the target type like
+// CreatePublisher, rendering payloads from the target's managed template
+func (f *PublisherFactory) CreateHTTPPublisherForTarget(target *core.PublishingTarget) (AlertPublisher, error) {
+	return f.createHTTPPublisher(target.Type, f.formatterFor(target))
+}
+
+// createHTTPPublisher creates the plain HTTP publisher for the given target type
+func (f *PublisherFactory) createHTTPPublisher(targetType string, formatter AlertFormatter) (AlertPublisher, error) {
 	switch TargetType(targetType) {
 	case TargetTypeRootly:
-		return NewRootlyPublisher(f.formatter, f.logger), nil
+		return NewRootlyPublisher(formatter, f.logger), nil
 	case TargetTypePagerDuty:
-		return NewPagerDutyPublisher(f.formatter, f.logger), nil
+		return NewPagerDutyPublisher(formatter, f.logger), nil
 	case TargetTypeSlack:
-		return NewSlackPublisher(f.formatter, f.logger), nil
+		return NewSlackPublisher(formatter, f.logger), nil
+	case TargetTypeOpsgenie:
+		return NewOpsgeniePublisher(formatter, f.logger), nil
+	case TargetTypeEmail:
+		return f.createEmailPublisher()
+	case TargetTypeMSTeams:
+		return f.createMSTeamsPublisher()
+	case TargetTypeTelegram:
+		return f.createTelegramPublisher()
 	case TargetTypeWebhook, TargetTypeAlertmanager:
-		return NewWebhookPublisher(f.formatter, f.logger), nil
+		return NewWebhookPublisher(formatter, f.logger), nil
 	default:
-		return NewWebhookPublisher(f.formatter, f.logger), nil // Default to webhook
+		return NewWebhookPublisher(formatter, f.logger), nil // Default to webhook
+	}
+}
+
+// SetTemplateRenderer enables managed templates referenced by targets
+// (PublishingTarget.Template) for publishers created afterwards
+func (f *PublisherFactory) SetTemplateRenderer(renderer *TemplateRenderer) {
+	f.templateRenderer.Store(renderer)
+}
+
+// formatterFor returns the formatter for target: the shared formatter,
+// wrapped with the target's managed template if it references one
+func (f *PublisherFactory) formatterFor(target *core.PublishingTarget) AlertFormatter {
+	renderer := f.templateRenderer.Load()
+	if renderer == nil || target.Template == "" {
+		return f.formatter
 	}
+	return NewTemplatedFormatter(f.formatter, renderer, target)
 }
 
 // CreatePublisherForTarget creates a publisher for a specific target with full configuration
@@ -247,6 +344,14 @@ func (f *PublisherFactory) CreatePublisherForTarget(target *core.PublishingTarge
 		return f.createEnhancedPagerDutyPublisher(target)
 	case TargetTypeSlack:
 		return f.createEnhancedSlackPublisher(target)
+	case TargetTypeOpsgenie:
+		return f.createEnhancedOpsgeniePublisher(target)
+	case TargetTypeEmail:
+		return f.createEmailPublisher()
+	case TargetTypeMSTeams:
+		return f.createMSTeamsPublisher()
+	case TargetTypeTelegram:
+		return f.createTelegramPublisher()
 	case TargetTypeWebhook, TargetTypeAlertmanager:
 		return f.createEnhancedWebhookPublisher(target)
 	default:
@@ -254,6 +359,16 @@ func (f *PublisherFactory) CreatePublisherForTarget(target *core.PublishingTarge
 	}
 }
 
+// PublishToTarget publishes an enriched alert to a target with the publisher
+// for its type (implements services.TargetPublisher)
+func (f *PublisherFactory) PublishToTarget(ctx context.Context, enrichedAlert *core.EnrichedAlert, target *core.PublishingTarget) error {
+	publisher, err := f.CreatePublisherForTarget(target)
+	if err != nil {
+		return fmt.Errorf("create publisher for target %s: %w", target.Name, err)
+	}
+	return publisher.Publish(ctx, enrichedAlert, target)
+}
+
 // createEnhancedRootlyPublisher creates an EnhancedRootlyPublisher with full Rootly API integration
 func (f *PublisherFactory) createEnhancedRootlyPublisher(target *core.PublishingTarget) (AlertPublisher, error) {
 	// Extract API key from target headers
@@ -265,10 +380,11 @@ func (f *PublisherFactory) createEnhancedRootlyPublisher(target *core.Publishing
 
 	if apiKey == "" {
 		f.logger.Warn("Rootly target missing API key, falling back to HTTP publisher", "target", target.Name)
-		return NewRootlyPublisher(f.formatter, f.logger), nil
+		return NewRootlyPublisher(f.formatterFor(target), f.logger), nil
 	}
 
 	// Get or create Rootly client for this API key
+	f.clientsMu.Lock()
 	client, ok := f.rootlyClientMap[apiKey]
 	if !ok {
 		// Create new client with configuration
@@ -280,13 +396,14 @@ func (f *PublisherFactory) createEnhancedRootlyPublisher(target *core.Publishing
 		client = NewRootlyIncidentsClient(config, f.logger)
 		f.rootlyClientMap[apiKey] = client
 	}
+	f.clientsMu.Unlock()
 
 	// Create EnhancedRootlyPublisher with shared cache and metrics
 	return NewEnhancedRootlyPublisher(
 		client,
 		f.rootlyCache,
 		f.rootlyMetrics,
-		f.formatter,
+		f.formatterFor(target),
 		f.logger,
 	), nil
 }
@@ -312,10 +429,11 @@ func (f *PublisherFactory) createEnhancedPagerDutyPublisher(target *core.Publish
 
 	if routingKey == "" {
 		f.logger.Warn("PagerDuty target missing routing_key, falling back to HTTP publisher", "target", target.Name)
-		return NewPagerDutyPublisher(f.formatter, f.logger), nil
+		return NewPagerDutyPublisher(f.formatterFor(target), f.logger), nil
 	}
 
 	// Get or create PagerDuty client for this routing key
+	f.clientsMu.Lock()
 	client, ok := f.pagerDutyClientMap[routingKey]
 	if !ok {
 		// Create new client with configuration
@@ -331,13 +449,14 @@ func (f *PublisherFactory) createEnhancedPagerDutyPublisher(target *core.Publish
 		client = NewPagerDutyEventsClient(config, f.logger)
 		f.pagerDutyClientMap[routingKey] = client
 	}
+	f.clientsMu.Unlock()
 
 	// Create EnhancedPagerDutyPublisher with shared cache and metrics
 	return NewEnhancedPagerDutyPublisher(
 		client,
 		f.pagerDutyCache,
 		f.pagerDutyMetrics,
-		f.formatter,
+		f.formatterFor(target),
 		f.logger,
 	), nil
 }
@@ -348,23 +467,104 @@ func (f *PublisherFactory) createEnhancedSlackPublisher(target *core.PublishingT
 	webhookURL := target.URL
 	if webhookURL == "" {
 		f.logger.Warn("Slack target missing webhook URL, falling back to HTTP publisher", "target", target.Name)
-		return NewSlackPublisher(f.formatter, f.logger), nil
+		return NewSlackPublisher(f.formatterFor(target), f.logger), nil
 	}
 
 	// Get or create Slack client for this webhook URL
+	f.clientsMu.Lock()
 	client, ok := f.slackClientMap[webhookURL]
 	if !ok {
 		// Create new Slack webhook client
 		client = NewHTTPSlackWebhookClient(webhookURL, f.logger)
 		f.slackClientMap[webhookURL] = client
 	}
+	f.clientsMu.Unlock()
 
 	// Create EnhancedSlackPublisher with shared cache and metrics
 	return NewEnhancedSlackPublisher(
 		client,
 		f.slackCache,
 		f.slackMetrics,
-		f.formatter,
+		f.formatterFor(target),
+		f.logger,
+	), nil
+}
+
+// createEnhancedOpsgeniePublisher creates an EnhancedOpsgeniePublisher with full Opsgenie Alert API v2 integration
+func (f *PublisherFactory) createEnhancedOpsgeniePublisher(target *core.PublishingTarget) (AlertPublisher, error) {
+	apiKey := extractOpsgenieAPIKey(target)
+	if apiKey == "" {
+		f.logger.Warn("Opsgenie target missing api_key, falling back to HTTP publisher", "target", target.Name)
+		return NewOpsgeniePublisher(f.formatterFor(target), f.logger), nil
+	}
+
+	// Get or create Opsgenie client for this API URL and key
+	clientKey := target.URL + "|" + apiKey
+	f.clientsMu.Lock()
+	client, ok := f.opsgenieClientMap[clientKey]
+	if !ok {
+		config := OpsgenieClientConfig{
+			BaseURL:    target.URL,
+			APIKey:     apiKey,
+			Timeout:    10 * time.Second,
+			MaxRetries: 3,
+		}
+		client = NewOpsgenieAlertsClient(config, f.logger)
+		f.opsgenieClientMap[clientKey] = client
+	}
+	f.clientsMu.Unlock()
+
+	// Create EnhancedOpsgeniePublisher with shared metrics
+	return NewEnhancedOpsgeniePublisher(
+		client,
+		f.opsgenieMetrics,
+		f.formatterFor(target),
+		f.logger,
+	), nil
+}
+
+// createEmailPublisher creates an EnhancedEmailPublisher backed by the shared SMTP client pool.
+// SMTP settings are resolved per target at publish time, so no target is needed here.
+func (f *PublisherFactory) createEmailPublisher() (AlertPublisher, error) {
+	if f.templateEngine == nil || f.emailClients == nil {
+		return nil, fmt.Errorf("email publisher not available: template engine not initialized")
+	}
+
+	return NewEnhancedEmailPublisher(
+		f.emailClients,
+		f.templateEngine,
+		f.emailMetrics,
+		f.logger,
+	), nil
+}
+
+// createMSTeamsPublisher creates an EnhancedMSTeamsPublisher backed by the shared Teams webhook client.
+// The webhook URL is taken from the target at publish time, so no target is needed here.
+func (f *PublisherFactory) createMSTeamsPublisher() (AlertPublisher, error) {
+	if f.templateEngine == nil || f.msteamsClient == nil {
+		return nil, fmt.Errorf("msteams publisher not available: template engine not initialized")
+	}
+
+	return NewEnhancedMSTeamsPublisher(
+		f.msteamsClient,
+		f.templateEngine,
+		f.msteamsMetrics,
+		f.logger,
+	), nil
+}
+
+// createTelegramPublisher creates an EnhancedTelegramPublisher backed by the shared Bot API client
+// and message cache. Bot token and chat are taken from the target at publish time.
+func (f *PublisherFactory) createTelegramPublisher() (AlertPublisher, error) {
+	if f.templateEngine == nil || f.telegramClient == nil {
+		return nil, fmt.Errorf("telegram publisher not available: template engine not initialized")
+	}
+
+	return NewEnhancedTelegramPublisher(
+		f.telegramClient,
+		f.telegramCache,
+		f.templateEngine,
+		f.telegramMetrics,
 		f.logger,
 	), nil
 }
@@ -385,7 +585,7 @@ func (f *PublisherFactory) createEnhancedWebhookPublisher(target *core.Publishin
 	publisher := NewEnhancedWebhookPublisher(
 		client,
 		validator,
-		f.formatter,
+		f.formatterFor(target),
 		f.webhookMetrics, // Shared metrics instance
 		f.logger,
 	)
@@ -404,4 +604,17 @@ func (f *PublisherFactory) Shutdown() {
 		f.slackCleanupWorker()
 		f.logger.Info("Stopped Slack cache cleanup worker")
 	}
+
+	// Stop Telegram cache cleanup worker
+	if f.telegramCleanupWorker != nil {
+		f.telegramCleanupWorker()
+		f.logger.Info("Stopped Telegram cache cleanup worker")
+	}
+
+	// Close pooled SMTP connections
+	if f.emailClients != nil {
+		if err := f.emailClients.Close(); err != nil {
+			f.logger.Warn("Failed to close SMTP connections", "error", err)
+		}
+	}
 }
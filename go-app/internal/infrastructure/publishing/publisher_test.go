@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"testing"
 	"time"
 
@@ -200,3 +201,45 @@ func TestPublisherFactory_CreatePublisher(t *testing.T) {
 		})
 	}
 }
+
+func TestPublisherFactory_CreatePublisherForTarget_Concurrent(t *testing.T) {
+	// Factory without registered metrics (NewPublisherFactory registers
+	// Prometheus collectors once per process)
+	factory := &PublisherFactory{
+		formatter:          NewAlertFormatter(),
+		logger:             slog.Default(),
+		rootlyCache:        NewIncidentIDCache(time.Hour),
+		rootlyClientMap:    make(map[string]RootlyIncidentsClient),
+		pagerDutyCache:     NewEventKeyCache(time.Hour),
+		pagerDutyClientMap: make(map[string]PagerDutyEventsClient),
+		slackCache:         NewMessageCache(),
+		slackClientMap:     make(map[string]SlackWebhookClient),
+		opsgenieClientMap:  make(map[string]OpsgenieAlertsClient),
+	}
+
+	targets := []*core.PublishingTarget{
+		{Name: "rootly", Type: "rootly", URL: "https://api.rootly.com", Headers: map[string]string{"Authorization": "Bearer key"}},
+		{Name: "pagerduty", Type: "pagerduty", Headers: map[string]string{"routing_key": "key"}},
+		{Name: "slack", Type: "slack", URL: "https://hooks.slack.com/services/T/B/X"},
+		{Name: "opsgenie", Type: "opsgenie", URL: "https://api.opsgenie.com", Headers: map[string]string{"api_key": "key"}},
+	}
+
+	// Group flushes create publishers from concurrent timer goroutines
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		for _, target := range targets {
+			wg.Add(1)
+			go func(target *core.PublishingTarget) {
+				defer wg.Done()
+				_, err := factory.CreatePublisherForTarget(target)
+				assert.NoError(t, err)
+			}(target)
+		}
+	}
+	wg.Wait()
+
+	assert.Len(t, factory.rootlyClientMap, 1)
+	assert.Len(t, factory.pagerDutyClientMap, 1)
+	assert.Len(t, factory.slackClientMap, 1)
+	assert.Len(t, factory.opsgenieClientMap, 1)
+}
@@ -2,6 +2,7 @@ package publishing
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"math"
@@ -102,14 +103,16 @@ type PublishingJob struct {
 	CompletedAt *time.Time     // When processing completed
 	LastError   error          // Most recent error
 	ErrorType   QueueErrorType // transient/permanent/unknown
+
+	// Durable backend delivery tracking
+	Deliveries int    // Times the job was leased (>1 means redelivery)
+	leaseToken string // Backend lease handle (set by Dequeue)
 }
 
 // PublishingQueue manages async publishing with worker pool and retry logic
 type PublishingQueue struct {
-	// Priority queues (3 tiers)
-	highPriorityJobs   chan *PublishingJob
-	mediumPriorityJobs chan *PublishingJob
-	lowPriorityJobs    chan *PublishingJob
+	// Job storage (in-memory priority channels or durable backend)
+	backend QueueBackend
 
 	factory           *PublisherFactory
 	dlqRepository     DLQRepository     // Dead Letter Queue for failed jobs
@@ -118,6 +121,7 @@ type PublishingQueue struct {
 	maxRetries        int
 	retryInterval     time.Duration
 	workerCount       int
+	maxDeliveries     int
 	logger            *slog.Logger
 	metrics           *PublishingMetrics
 	wg                sync.WaitGroup
@@ -136,6 +140,13 @@ type PublishingQueueConfig struct {
 	MaxRetries              int
 	RetryInterval           time.Duration
 	CircuitTimeout          time.Duration
+
+	// Backend stores queued jobs (nil = in-memory channels sized by *QueueSize)
+	Backend QueueBackend
+
+	// MaxDeliveries sends a job to the DLQ once a durable backend has
+	// delivered it this many times without an ack (0 = unlimited)
+	MaxDeliveries int
 }
 
 // DefaultPublishingQueueConfig returns default configuration
@@ -148,6 +159,7 @@ func DefaultPublishingQueueConfig() PublishingQueueConfig {
 		MaxRetries:              3,
 		RetryInterval:           2 * time.Second,
 		CircuitTimeout:          30 * time.Second,
+		MaxDeliveries:           5,
 	}
 }
 
@@ -157,12 +169,15 @@ func NewPublishingQueue(factory *PublisherFactory, dlqRepository DLQRepository,
 		logger = slog.Default()
 	}
 
+	backend := config.Backend
+	if backend == nil {
+		backend = NewMemoryQueueBackend(config.HighPriorityQueueSize, config.MediumPriorityQueueSize, config.LowPriorityQueueSize)
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 
 	queue := &PublishingQueue{
-		highPriorityJobs:   make(chan *PublishingJob, config.HighPriorityQueueSize),
-		mediumPriorityJobs: make(chan *PublishingJob, config.MediumPriorityQueueSize),
-		lowPriorityJobs:    make(chan *PublishingJob, config.LowPriorityQueueSize),
+		backend:            backend,
 		factory:            factory,
 		dlqRepository:      dlqRepository,
 		jobTrackingStore:   jobTrackingStore,
@@ -170,6 +185,7 @@ func NewPublishingQueue(factory *PublisherFactory, dlqRepository DLQRepository,
 		maxRetries:         config.MaxRetries,
 		retryInterval:      config.RetryInterval,
 		workerCount:        config.WorkerCount,
+		maxDeliveries:      config.MaxDeliveries,
 		logger:             logger,
 		metrics:            metrics,
 		ctx:                ctx,
@@ -180,17 +196,27 @@ func NewPublishingQueue(factory *PublisherFactory, dlqRepository DLQRepository,
 	// Initialize worker metrics
 	if metrics != nil {
 		metrics.InitializeWorkerMetrics(config.WorkerCount)
-		metrics.UpdateQueueSize("high", 0, config.HighPriorityQueueSize)
-		metrics.UpdateQueueSize("medium", 0, config.MediumPriorityQueueSize)
-		metrics.UpdateQueueSize("low", 0, config.LowPriorityQueueSize)
+		for _, priority := range []Priority{PriorityHigh, PriorityMedium, PriorityLow} {
+			metrics.UpdateQueueSize(priority.String(), 0, backend.CapacityByPriority(priority))
+		}
 	}
 
 	return queue
 }
 
-// Start starts the worker pool
+// Start runs the backend recovery scan and starts the worker pool
 func (q *PublishingQueue) Start() {
-	q.logger.Info("Starting publishing queue", "workers", q.workerCount)
+	q.logger.Info("Starting publishing queue", "workers", q.workerCount, "backend", q.backend.Name())
+
+	// Make jobs leased before a crash/restart visible again
+	ctx, cancel := context.WithTimeout(q.ctx, 30*time.Second)
+	recovered, err := q.backend.Recover(ctx)
+	cancel()
+	if err != nil {
+		q.logger.Error("Publishing queue recovery scan failed", "backend", q.backend.Name(), "error", err)
+	} else if recovered > 0 {
+		q.logger.Info("Publishing queue recovered leased jobs", "backend", q.backend.Name(), "jobs", recovered)
+	}
 
 	for i := 0; i < q.workerCount; i++ {
 		q.wg.Add(1)
@@ -202,10 +228,11 @@ func (q *PublishingQueue) Start() {
 func (q *PublishingQueue) Stop(timeout time.Duration) error {
 	q.logger.Info("Stopping publishing queue", "timeout", timeout)
 
-	// Close all priority job channels to signal workers
-	close(q.highPriorityJobs)
-	close(q.mediumPriorityJobs)
-	close(q.lowPriorityJobs)
+	// Close backend to signal workers (durable backends keep un-acked jobs
+	// and redeliver them after the visibility timeout)
+	if err := q.backend.Close(); err != nil {
+		q.logger.Warn("Failed to close publishing queue backend", "error", err)
+	}
 
 	// Wait for workers with timeout
 	done := make(chan struct{})
@@ -243,26 +270,14 @@ func (q *PublishingQueue) Submit(enrichedAlert *core.EnrichedAlert, target *core
 		State:         JobStateQueued,
 	}
 
-	// Select appropriate queue
-	var targetQueue chan *PublishingJob
-	switch priority {
-	case PriorityHigh:
-		targetQueue = q.highPriorityJobs
-	case PriorityMedium:
-		targetQueue = q.mediumPriorityJobs
-	case PriorityLow:
-		targetQueue = q.lowPriorityJobs
-	default:
-		targetQueue = q.mediumPriorityJobs
-	}
-
-	// Submit to queue
-	select {
-	case targetQueue <- job:
+	// Submit to queue backend
+	err := q.backend.Enqueue(q.ctx, job)
+	switch {
+	case err == nil:
 		// Update metrics
 		if q.metrics != nil {
 			q.metrics.RecordQueueSubmission(priority.String(), true)
-			q.metrics.UpdateQueueSize(priority.String(), len(targetQueue), cap(targetQueue))
+			q.updateQueueSizeMetric(priority)
 		}
 
 		// Track job
@@ -277,110 +292,168 @@ func (q *PublishingQueue) Submit(enrichedAlert *core.EnrichedAlert, target *core
 			"fingerprint", enrichedAlert.Alert.Fingerprint,
 		)
 		return nil
-	case <-q.ctx.Done():
+	case errors.Is(err, ErrQueueClosed):
+		if q.metrics != nil {
+			q.metrics.RecordQueueSubmission(priority.String(), false)
+		}
+		return err
+	case errors.Is(err, ErrQueueFull):
 		if q.metrics != nil {
 			q.metrics.RecordQueueSubmission(priority.String(), false)
 		}
-		return fmt.Errorf("publishing queue is shutting down")
+		return fmt.Errorf("%w (priority=%s, capacity=%d)", ErrQueueFull, priority, q.backend.CapacityByPriority(priority))
 	default:
 		if q.metrics != nil {
 			q.metrics.RecordQueueSubmission(priority.String(), false)
 		}
-		return fmt.Errorf("queue full (priority=%s, capacity=%d)", priority, cap(targetQueue))
+		return fmt.Errorf("failed to submit job (backend=%s): %w", q.backend.Name(), err)
 	}
 }
 
-// worker processes jobs from the queue with priority-based selection
+// worker processes jobs from the queue backend with priority-based selection
 func (q *PublishingQueue) worker(id int) {
 	defer q.wg.Done()
 
 	q.logger.Debug("Worker started", "worker_id", id)
 
 	for {
-		var job *PublishingJob
-		var priority Priority
-
-		// Priority-based select (HIGH > MEDIUM > LOW)
-		select {
-		case job = <-q.highPriorityJobs:
-			if job == nil {
-				// High priority channel closed
+		// Priority-based dequeue (HIGH > MEDIUM > LOW)
+		job, err := q.backend.Dequeue(q.ctx)
+		if err != nil {
+			if errors.Is(err, ErrQueueClosed) || q.ctx.Err() != nil {
 				return
 			}
-			priority = PriorityHigh
-		case <-q.ctx.Done():
-			return
-		default:
-			// Check medium, then low
+			q.logger.Error("Failed to dequeue job",
+				"worker_id", id,
+				"backend", q.backend.Name(),
+				"error", err,
+			)
 			select {
-			case job = <-q.mediumPriorityJobs:
-				if job == nil {
-					// Medium priority channel closed
-					return
-				}
-				priority = PriorityMedium
+			case <-time.After(time.Second):
+				continue
 			case <-q.ctx.Done():
 				return
-			default:
-				// Check low
-				select {
-				case job = <-q.lowPriorityJobs:
-					if job == nil {
-						// Low priority channel closed
-						return
-					}
-					priority = PriorityLow
-				case <-q.ctx.Done():
-					return
-				case <-time.After(100 * time.Millisecond):
-					// Idle timeout, loop back to check high priority
-					continue
-				}
 			}
 		}
+		if job == nil {
+			// Idle timeout, loop back to check high priority
+			continue
+		}
 
-		if job != nil {
-			// TN-060: Check mode before processing (metrics-only mode fallback)
-			if q.modeManager != nil && q.modeManager.IsMetricsOnly() {
-				q.logger.Debug("Job skipped (metrics-only mode)",
-					"job_id", job.ID,
-					"target", job.Target.Name,
-					"worker_id", id,
-				)
-				// Skip processing, continue to next job
-				continue
-			}
+		// TN-060: Check mode before processing (metrics-only mode fallback)
+		if q.modeManager != nil && q.modeManager.IsMetricsOnly() {
+			q.logger.Debug("Job skipped (metrics-only mode)",
+				"job_id", job.ID,
+				"target", job.Target.Name,
+				"worker_id", id,
+			)
+			// Skip processing, continue to next job
+			q.ackJob(job)
+			continue
+		}
 
-			// Update worker metrics
-			if q.metrics != nil {
-				q.metrics.RecordWorkerActive(id, true)
+		// Poison job protection: redelivered too many times without ack
+		if q.maxDeliveries > 0 && job.Deliveries > q.maxDeliveries {
+			job.LastError = fmt.Errorf("job delivered %d times without ack (max_deliveries=%d)", job.Deliveries, q.maxDeliveries)
+			job.ErrorType = QueueErrorTypeUnknown
+			q.logger.Error("Job exceeded max deliveries",
+				"job_id", job.ID,
+				"target", job.Target.Name,
+				"deliveries", job.Deliveries,
+			)
+			if q.sendToDLQ(job) {
+				q.ackJob(job)
 			}
+			continue
+		}
 
-			// Process job
-			q.processJob(job)
+		// Update worker metrics
+		if q.metrics != nil {
+			q.metrics.RecordWorkerActive(id, true)
+		}
 
-			// Update worker metrics
-			if q.metrics != nil {
-				q.metrics.RecordWorkerActive(id, false)
-			}
+		// Process job (lease is extended while publish/retries are running)
+		stopHeartbeat := q.startLeaseHeartbeat(job)
+		if q.processJob(job) {
+			stopHeartbeat()
+			q.ackJob(job)
+		} else {
+			// Leave job un-acked: durable backends redeliver it after the visibility timeout
+			stopHeartbeat()
+		}
+
+		// Update worker metrics
+		if q.metrics != nil {
+			q.metrics.RecordWorkerActive(id, false)
+			q.updateQueueSizeMetric(job.Priority)
+		}
+	}
+}
+
+// startLeaseHeartbeat periodically extends the job lease until stop is called
+// (no-op for backends without leases)
+func (q *PublishingQueue) startLeaseHeartbeat(job *PublishingJob) (stop func()) {
+	timeout := q.backend.VisibilityTimeout()
+	if timeout <= 0 {
+		return func() {}
+	}
+
+	ctx, cancel := context.WithCancel(q.ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
 
-			// Update queue size metric
-			if q.metrics != nil {
-				switch priority {
-				case PriorityHigh:
-					q.metrics.UpdateQueueSize("high", len(q.highPriorityJobs), cap(q.highPriorityJobs))
-				case PriorityMedium:
-					q.metrics.UpdateQueueSize("medium", len(q.mediumPriorityJobs), cap(q.mediumPriorityJobs))
-				case PriorityLow:
-					q.metrics.UpdateQueueSize("low", len(q.lowPriorityJobs), cap(q.lowPriorityJobs))
+		ticker := time.NewTicker(timeout / 3)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := q.backend.ExtendLease(ctx, job); err != nil {
+					if ctx.Err() != nil {
+						return
+					}
+					q.logger.Warn("Failed to extend job lease",
+						"job_id", job.ID,
+						"target", job.Target.Name,
+						"error", err,
+					)
+					if errors.Is(err, ErrQueueLeaseLost) {
+						return
+					}
 				}
 			}
 		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
 	}
 }
 
-// processJob processes a single publishing job with retry logic
-func (q *PublishingQueue) processJob(job *PublishingJob) {
+// ackJob removes a finished job from the backend
+func (q *PublishingQueue) ackJob(job *PublishingJob) {
+	// Not bound to q.ctx: jobs finished during shutdown must still be acked
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := q.backend.Ack(ctx, job); err != nil {
+		q.logger.Warn("Failed to ack job, it may be delivered again",
+			"job_id", job.ID,
+			"target", job.Target.Name,
+			"backend", q.backend.Name(),
+			"error", err,
+		)
+	}
+}
+
+// processJob processes a single publishing job with retry logic.
+// Returns false if the job should stay in the backend for redelivery.
+func (q *PublishingQueue) processJob(job *PublishingJob) bool {
 	// Update job state to Processing
 	job.State = JobStateProcessing
 	now := time.Now()
@@ -398,11 +471,11 @@ func (q *PublishingQueue) processJob(job *PublishingJob) {
 			"target", job.Target.Name,
 			"state", cb.State(),
 		)
-		return
+		return false
 	}
 
 	// Create publisher
-	publisher, err := q.factory.CreatePublisher(job.Target.Type)
+	publisher, err := q.factory.CreateHTTPPublisherForTarget(job.Target)
 	if err != nil {
 		q.logger.Error("Failed to create publisher",
 			"target", job.Target.Name,
@@ -410,7 +483,7 @@ func (q *PublishingQueue) processJob(job *PublishingJob) {
 			"error", err,
 		)
 		cb.RecordFailure()
-		return
+		return true
 	}
 
 	// Attempt publish with retry
@@ -419,6 +492,15 @@ func (q *PublishingQueue) processJob(job *PublishingJob) {
 	duration := time.Since(startTime).Seconds()
 
 	if err != nil {
+		if q.ctx.Err() != nil {
+			// Forced shutdown interrupted retries; keep job for redelivery
+			q.logger.Warn("Publish interrupted by shutdown",
+				"job_id", job.ID,
+				"target", job.Target.Name,
+			)
+			return false
+		}
+
 		q.logger.Error("Failed to publish after retries",
 			"job_id", job.ID,
 			"target", job.Target.Name,
@@ -431,45 +513,55 @@ func (q *PublishingQueue) processJob(job *PublishingJob) {
 		}
 
 		// Send to Dead Letter Queue
-		if q.dlqRepository != nil {
-			job.State = JobStateDLQ
-			dlqErr := q.dlqRepository.Write(q.ctx, job)
-			if dlqErr != nil {
-				q.logger.Error("Failed to write to DLQ",
-					"job_id", job.ID,
-					"target", job.Target.Name,
-					"error", dlqErr,
-				)
-			} else {
-				q.logger.Info("Job sent to DLQ",
-					"job_id", job.ID,
-					"target", job.Target.Name,
-					"error_type", job.ErrorType,
-				)
-			}
+		return q.sendToDLQ(job)
+	}
 
-			// Track DLQ state
-			if q.jobTrackingStore != nil {
-				q.jobTrackingStore.Add(job)
-			}
-		}
-	} else {
-		q.logger.Info("Alert published successfully",
+	q.logger.Info("Alert published successfully",
+		"job_id", job.ID,
+		"target", job.Target.Name,
+		"fingerprint", job.EnrichedAlert.Alert.Fingerprint,
+		"queue_time", time.Since(job.SubmittedAt),
+	)
+	cb.RecordSuccess()
+	if q.metrics != nil {
+		q.metrics.RecordJobSuccess(job.Target.Name, job.Priority.String(), duration)
+	}
+
+	// Track success state (updated in retryPublish)
+	if q.jobTrackingStore != nil {
+		q.jobTrackingStore.Add(job)
+	}
+	return true
+}
+
+// sendToDLQ writes a failed job to the Dead Letter Queue.
+// Returns false if the write failed (job should stay in the backend).
+func (q *PublishingQueue) sendToDLQ(job *PublishingJob) bool {
+	if q.dlqRepository == nil {
+		return true
+	}
+
+	job.State = JobStateDLQ
+	if dlqErr := q.dlqRepository.Write(q.ctx, job); dlqErr != nil {
+		q.logger.Error("Failed to write to DLQ",
 			"job_id", job.ID,
 			"target", job.Target.Name,
-			"fingerprint", job.EnrichedAlert.Alert.Fingerprint,
-			"queue_time", time.Since(job.SubmittedAt),
+			"error", dlqErr,
 		)
-		cb.RecordSuccess()
-		if q.metrics != nil {
-			q.metrics.RecordJobSuccess(job.Target.Name, job.Priority.String(), duration)
-		}
+		return false
+	}
 
-		// Track success state (updated in retryPublish)
-		if q.jobTrackingStore != nil {
-			q.jobTrackingStore.Add(job)
-		}
+	q.logger.Info("Job sent to DLQ",
+		"job_id", job.ID,
+		"target", job.Target.Name,
+		"error_type", job.ErrorType,
+	)
+
+	// Track DLQ state
+	if q.jobTrackingStore != nil {
+		q.jobTrackingStore.Add(job)
 	}
+	return true
 }
 
 // getCircuitBreaker gets or creates circuit breaker for target
@@ -509,26 +601,56 @@ func (q *PublishingQueue) getCircuitBreaker(targetName string) *CircuitBreaker {
 
 // GetQueueSize returns total current queue size (all priorities)
 func (q *PublishingQueue) GetQueueSize() int {
-	return len(q.highPriorityJobs) + len(q.mediumPriorityJobs) + len(q.lowPriorityJobs)
+	return q.backend.Size()
 }
 
-// GetQueueCapacity returns total queue capacity (all priorities)
+// GetQueueCapacity returns total queue capacity (all priorities, 0 = unbounded)
 func (q *PublishingQueue) GetQueueCapacity() int {
-	return cap(q.highPriorityJobs) + cap(q.mediumPriorityJobs) + cap(q.lowPriorityJobs)
+	return q.backend.Capacity()
 }
 
 // GetQueueSizeByPriority returns queue size for specific priority
 func (q *PublishingQueue) GetQueueSizeByPriority(priority Priority) int {
-	switch priority {
-	case PriorityHigh:
-		return len(q.highPriorityJobs)
-	case PriorityMedium:
-		return len(q.mediumPriorityJobs)
-	case PriorityLow:
-		return len(q.lowPriorityJobs)
-	default:
+	return q.backend.SizeByPriority(priority)
+}
+
+// Backend returns the queue backend name (memory, postgres)
+func (q *PublishingQueue) Backend() string {
+	return q.backend.Name()
+}
+
+// updateQueueSizeMetric refreshes queue size gauge for a priority
+func (q *PublishingQueue) updateQueueSizeMetric(priority Priority) {
+	q.metrics.UpdateQueueSize(priority.String(), q.backend.SizeByPriority(priority), q.backend.CapacityByPriority(priority))
+}
+
+// GetJob returns tracked job snapshot by ID (nil if not tracked)
+func (q *PublishingQueue) GetJob(id string) *JobSnapshot {
+	if q.jobTrackingStore == nil {
+		return nil
+	}
+	return q.jobTrackingStore.Get(id)
+}
+
+// ListJobs returns tracked job snapshots (most recent first)
+func (q *PublishingQueue) ListJobs(filters JobFilters) []*JobSnapshot {
+	if q.jobTrackingStore == nil {
+		return []*JobSnapshot{}
+	}
+	return q.jobTrackingStore.List(filters)
+}
+
+// TrackedJobCount returns number of jobs in the tracking store
+func (q *PublishingQueue) TrackedJobCount() int {
+	if q.jobTrackingStore == nil {
 		return 0
 	}
+	return q.jobTrackingStore.Size()
+}
+
+// DLQ returns the Dead Letter Queue repository (nil if not configured)
+func (q *PublishingQueue) DLQ() DLQRepository {
+	return q.dlqRepository
 }
 
 // QueueStats represents queue statistics
@@ -0,0 +1,222 @@
+package publishing
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Queue backend errors returned by QueueBackend implementations
+var (
+	// ErrQueueFull is returned when the backend cannot accept more jobs
+	ErrQueueFull = errors.New("queue full")
+
+	// ErrQueueClosed is returned after the backend has been closed
+	ErrQueueClosed = errors.New("publishing queue is shutting down")
+)
+
+// QueueBackend stores publishing jobs between Submit and worker processing.
+//
+// Durable backends lease jobs to workers for VisibilityTimeout. A job that is
+// not acknowledged before its lease expires becomes visible again and is
+// redelivered (at-least-once semantics), so publishers must tolerate
+// duplicates. The in-memory backend has no leases: un-acknowledged jobs are
+// simply dropped.
+type QueueBackend interface {
+	// Name returns backend name for logs and stats (memory, postgres)
+	Name() string
+
+	// Enqueue stores a job (ErrQueueFull if at capacity, ErrQueueClosed after Close)
+	Enqueue(ctx context.Context, job *PublishingJob) error
+
+	// Dequeue returns the next visible job in priority order (HIGH > MEDIUM > LOW).
+	// Returns (nil, nil) if no job became available within the backend poll interval,
+	// ErrQueueClosed after Close.
+	Dequeue(ctx context.Context) (*PublishingJob, error)
+
+	// Ack removes a finished job (succeeded, sent to DLQ or dropped)
+	Ack(ctx context.Context, job *PublishingJob) error
+
+	// ExtendLease pushes the visibility deadline of a leased job by VisibilityTimeout
+	ExtendLease(ctx context.Context, job *PublishingJob) error
+
+	// VisibilityTimeout returns lease duration (0 if the backend has no leases)
+	VisibilityTimeout() time.Duration
+
+	// Recover makes jobs with stale leases visible again; called once at startup.
+	// Returns number of recovered jobs.
+	Recover(ctx context.Context) (int, error)
+
+	// Size returns number of queued jobs (all priorities)
+	Size() int
+
+	// SizeByPriority returns number of queued jobs for a priority
+	SizeByPriority(priority Priority) int
+
+	// Capacity returns maximum number of queued jobs (0 = unbounded)
+	Capacity() int
+
+	// CapacityByPriority returns maximum number of queued jobs for a priority (0 = unbounded)
+	CapacityByPriority(priority Priority) int
+
+	// Close stops accepting and handing out jobs
+	Close() error
+}
+
+// MemoryQueueBackend keeps jobs in three buffered channels (one per priority).
+// Jobs are lost on restart.
+type MemoryQueueBackend struct {
+	high   chan *PublishingJob
+	medium chan *PublishingJob
+	low    chan *PublishingJob
+
+	pollInterval time.Duration
+	closed       bool
+	mu           sync.RWMutex
+}
+
+// NewMemoryQueueBackend creates in-memory backend with per-priority capacities
+func NewMemoryQueueBackend(highSize, mediumSize, lowSize int) *MemoryQueueBackend {
+	return &MemoryQueueBackend{
+		high:         make(chan *PublishingJob, highSize),
+		medium:       make(chan *PublishingJob, mediumSize),
+		low:          make(chan *PublishingJob, lowSize),
+		pollInterval: 100 * time.Millisecond,
+	}
+}
+
+// Name returns backend name
+func (b *MemoryQueueBackend) Name() string {
+	return "memory"
+}
+
+// Enqueue adds job to its priority channel without blocking
+func (b *MemoryQueueBackend) Enqueue(ctx context.Context, job *PublishingJob) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if b.closed {
+		return ErrQueueClosed
+	}
+
+	select {
+	case b.channel(job.Priority) <- job:
+		return nil
+	case <-ctx.Done():
+		return ErrQueueClosed
+	default:
+		return ErrQueueFull
+	}
+}
+
+// Dequeue takes next job with priority-based select (HIGH > MEDIUM > LOW)
+func (b *MemoryQueueBackend) Dequeue(ctx context.Context) (*PublishingJob, error) {
+	var job *PublishingJob
+	var ok bool
+
+	select {
+	case job, ok = <-b.high:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+		// Check medium, then low
+		select {
+		case job, ok = <-b.medium:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+			select {
+			case job, ok = <-b.low:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(b.pollInterval):
+				// Idle timeout, caller loops back to check high priority
+				return nil, nil
+			}
+		}
+	}
+
+	if !ok {
+		return nil, ErrQueueClosed
+	}
+	return job, nil
+}
+
+// Ack is a no-op: jobs leave the channel when dequeued
+func (b *MemoryQueueBackend) Ack(ctx context.Context, job *PublishingJob) error {
+	return nil
+}
+
+// ExtendLease is a no-op: the in-memory backend has no leases
+func (b *MemoryQueueBackend) ExtendLease(ctx context.Context, job *PublishingJob) error {
+	return nil
+}
+
+// VisibilityTimeout returns 0 (no leases)
+func (b *MemoryQueueBackend) VisibilityTimeout() time.Duration {
+	return 0
+}
+
+// Recover is a no-op: nothing survives a restart
+func (b *MemoryQueueBackend) Recover(ctx context.Context) (int, error) {
+	return 0, nil
+}
+
+// Size returns number of buffered jobs
+func (b *MemoryQueueBackend) Size() int {
+	return len(b.high) + len(b.medium) + len(b.low)
+}
+
+// SizeByPriority returns number of buffered jobs for a priority
+func (b *MemoryQueueBackend) SizeByPriority(priority Priority) int {
+	switch priority {
+	case PriorityHigh, PriorityMedium, PriorityLow:
+		return len(b.channel(priority))
+	default:
+		return 0
+	}
+}
+
+// Capacity returns total channel capacity
+func (b *MemoryQueueBackend) Capacity() int {
+	return cap(b.high) + cap(b.medium) + cap(b.low)
+}
+
+// CapacityByPriority returns channel capacity for a priority
+func (b *MemoryQueueBackend) CapacityByPriority(priority Priority) int {
+	switch priority {
+	case PriorityHigh, PriorityMedium, PriorityLow:
+		return cap(b.channel(priority))
+	default:
+		return 0
+	}
+}
+
+// Close closes all priority channels. Workers drain buffered high priority
+// jobs and then stop; jobs still buffered at lower priorities are lost.
+func (b *MemoryQueueBackend) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return nil
+	}
+	b.closed = true
+	close(b.high)
+	close(b.medium)
+	close(b.low)
+	return nil
+}
+
+// channel returns channel for priority (unknown priorities go to medium)
+func (b *MemoryQueueBackend) channel(priority Priority) chan *PublishingJob {
+	switch priority {
+	case PriorityHigh:
+		return b.high
+	case PriorityLow:
+		return b.low
+	default:
+		return b.medium
+	}
+}
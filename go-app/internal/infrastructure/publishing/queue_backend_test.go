@@ -0,0 +1,323 @@
+package publishing
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+)
+
+func newBackendTestJob(priority Priority) *PublishingJob {
+	return &PublishingJob{
+		ID:       uuid.NewString(),
+		Priority: priority,
+		EnrichedAlert: &core.EnrichedAlert{
+			Alert: &core.Alert{Fingerprint: "fp-" + priority.String(), AlertName: "TestAlert", Status: core.StatusFiring},
+		},
+		Target: &core.PublishingTarget{Name: "test-target", Type: "webhook", Format: core.FormatWebhook},
+	}
+}
+
+func TestMemoryQueueBackend_PriorityOrder(t *testing.T) {
+	backend := NewMemoryQueueBackend(2, 2, 2)
+	ctx := context.Background()
+
+	low := newBackendTestJob(PriorityLow)
+	medium := newBackendTestJob(PriorityMedium)
+	high := newBackendTestJob(PriorityHigh)
+	for _, job := range []*PublishingJob{low, medium, high} {
+		require.NoError(t, backend.Enqueue(ctx, job))
+	}
+	assert.Equal(t, 3, backend.Size())
+	assert.Equal(t, 6, backend.Capacity())
+
+	for _, want := range []*PublishingJob{high, medium, low} {
+		job, err := backend.Dequeue(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, job)
+		assert.Equal(t, want.ID, job.ID)
+	}
+
+	// Idle poll returns no job
+	job, err := backend.Dequeue(ctx)
+	assert.NoError(t, err)
+	assert.Nil(t, job)
+}
+
+func TestMemoryQueueBackend_FullAndClosed(t *testing.T) {
+	backend := NewMemoryQueueBackend(1, 1, 1)
+	ctx := context.Background()
+
+	require.NoError(t, backend.Enqueue(ctx, newBackendTestJob(PriorityHigh)))
+	assert.ErrorIs(t, backend.Enqueue(ctx, newBackendTestJob(PriorityHigh)), ErrQueueFull)
+
+	require.NoError(t, backend.Close())
+	require.NoError(t, backend.Close())
+	assert.ErrorIs(t, backend.Enqueue(ctx, newBackendTestJob(PriorityLow)), ErrQueueClosed)
+
+	// Buffered high priority job is still handed out, then the backend reports closed
+	job, err := backend.Dequeue(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, job)
+
+	_, err = backend.Dequeue(ctx)
+	assert.ErrorIs(t, err, ErrQueueClosed)
+}
+
+func TestQueueJobEncoding_RoundTrip(t *testing.T) {
+	job := newBackendTestJob(PriorityHigh)
+	job.Target.Headers = map[string]string{"Authorization": "Bearer x"}
+
+	alertJSON, targetJSON, err := encodeQueueJob(job)
+	require.NoError(t, err)
+
+	decoded, err := decodeQueueJob(alertJSON, targetJSON)
+	require.NoError(t, err)
+	assert.Equal(t, job.EnrichedAlert.Alert.Fingerprint, decoded.EnrichedAlert.Alert.Fingerprint)
+	assert.Equal(t, job.Target.Name, decoded.Target.Name)
+	assert.Equal(t, "Bearer x", decoded.Target.Headers["Authorization"])
+
+	_, _, err = encodeQueueJob(&PublishingJob{ID: "no-alert", Target: job.Target})
+	assert.Error(t, err)
+}
+
+// leasingBackend is an in-memory QueueBackend with visibility timeouts
+type leasingBackend struct {
+	mu         sync.Mutex
+	jobs       map[string]*leasedJob
+	visibility time.Duration
+	recovered  bool
+	acked      []string
+	extended   int
+	closed     bool
+}
+
+type leasedJob struct {
+	job        PublishingJob
+	visibleAt  time.Time
+	deliveries int
+	token      string
+}
+
+func newLeasingBackend(visibility time.Duration) *leasingBackend {
+	return &leasingBackend{jobs: make(map[string]*leasedJob), visibility: visibility}
+}
+
+func (b *leasingBackend) Name() string { return "leasing" }
+
+func (b *leasingBackend) Enqueue(ctx context.Context, job *PublishingJob) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return ErrQueueClosed
+	}
+	b.jobs[job.ID] = &leasedJob{job: *job, visibleAt: time.Now(), deliveries: job.Deliveries}
+	return nil
+}
+
+func (b *leasingBackend) Dequeue(ctx context.Context) (*PublishingJob, error) {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return nil, ErrQueueClosed
+	}
+	now := time.Now()
+	for _, entry := range b.jobs {
+		if entry.visibleAt.After(now) {
+			continue
+		}
+		entry.deliveries++
+		entry.visibleAt = now.Add(b.visibility)
+		entry.token = uuid.NewString()
+		job := entry.job
+		job.Deliveries = entry.deliveries
+		job.leaseToken = entry.token
+		b.mu.Unlock()
+		return &job, nil
+	}
+	b.mu.Unlock()
+
+	select {
+	case <-time.After(5 * time.Millisecond):
+		return nil, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (b *leasingBackend) Ack(ctx context.Context, job *PublishingJob) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	entry, ok := b.jobs[job.ID]
+	if !ok || entry.token != job.leaseToken {
+		return ErrQueueLeaseLost
+	}
+	delete(b.jobs, job.ID)
+	b.acked = append(b.acked, job.ID)
+	return nil
+}
+
+func (b *leasingBackend) ExtendLease(ctx context.Context, job *PublishingJob) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	entry, ok := b.jobs[job.ID]
+	if !ok || entry.token != job.leaseToken {
+		return ErrQueueLeaseLost
+	}
+	entry.visibleAt = time.Now().Add(b.visibility)
+	b.extended++
+	return nil
+}
+
+func (b *leasingBackend) VisibilityTimeout() time.Duration { return b.visibility }
+
+func (b *leasingBackend) Recover(ctx context.Context) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.recovered = true
+	return 0, nil
+}
+
+func (b *leasingBackend) Size() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.jobs)
+}
+
+func (b *leasingBackend) SizeByPriority(priority Priority) int     { return 0 }
+func (b *leasingBackend) Capacity() int                            { return 0 }
+func (b *leasingBackend) CapacityByPriority(priority Priority) int { return 0 }
+
+func (b *leasingBackend) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	return nil
+}
+
+func (b *leasingBackend) ackedIDs() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]string(nil), b.acked...)
+}
+
+// recordingDLQ records jobs written to the DLQ
+type recordingDLQ struct {
+	emptyDLQRepository
+	mu   sync.Mutex
+	jobs []string
+}
+
+func (d *recordingDLQ) Write(ctx context.Context, job *PublishingJob) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.jobs = append(d.jobs, job.ID)
+	return nil
+}
+
+func (d *recordingDLQ) written() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]string(nil), d.jobs...)
+}
+
+type emptyDLQRepository struct{}
+
+func (emptyDLQRepository) Write(ctx context.Context, job *PublishingJob) error { return nil }
+func (emptyDLQRepository) Read(ctx context.Context, filters DLQFilters) ([]*DLQEntry, error) {
+	return nil, nil
+}
+func (emptyDLQRepository) Replay(ctx context.Context, id uuid.UUID) error { return nil }
+func (emptyDLQRepository) Purge(ctx context.Context, olderThan time.Duration) (int64, error) {
+	return 0, nil
+}
+func (emptyDLQRepository) GetStats(ctx context.Context) (*DLQStats, error) { return &DLQStats{}, nil }
+
+func newBackendTestQueue(backend QueueBackend, dlq DLQRepository) *PublishingQueue {
+	config := DefaultPublishingQueueConfig()
+	config.WorkerCount = 2
+	config.MaxRetries = 0
+	config.Backend = backend
+	config.MaxDeliveries = 2
+
+	factory := &PublisherFactory{formatter: NewAlertFormatter(), logger: slog.Default()}
+	return NewPublishingQueue(factory, dlq, nil, config, nil, nil, nil)
+}
+
+func TestPublishingQueue_DurableBackendAcksAfterPublish(t *testing.T) {
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		time.Sleep(60 * time.Millisecond) // longer than the visibility timeout
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	backend := newLeasingBackend(30 * time.Millisecond)
+	queue := newBackendTestQueue(backend, &recordingDLQ{})
+	queue.Start()
+	defer queue.Stop(time.Second)
+
+	job := newBackendTestJob(PriorityHigh)
+	require.NoError(t, queue.Submit(job.EnrichedAlert, &core.PublishingTarget{
+		Name: "webhook", Type: "webhook", URL: server.URL, Format: core.FormatWebhook,
+	}))
+
+	require.Eventually(t, func() bool { return len(backend.ackedIDs()) == 1 }, 2*time.Second, 10*time.Millisecond)
+
+	assert.True(t, backend.recovered, "recovery scan runs at startup")
+	assert.Equal(t, int32(1), hits.Load(), "lease heartbeat prevents redelivery during publish")
+	backend.mu.Lock()
+	assert.Positive(t, backend.extended)
+	backend.mu.Unlock()
+	assert.Equal(t, "leasing", queue.Backend())
+}
+
+func TestPublishingQueue_MaxDeliveriesSendsToDLQ(t *testing.T) {
+	backend := newLeasingBackend(time.Minute)
+	dlq := &recordingDLQ{}
+	queue := newBackendTestQueue(backend, dlq)
+
+	// Job already delivered MaxDeliveries times (e.g. workers crashed mid-publish)
+	job := newBackendTestJob(PriorityMedium)
+	job.Deliveries = 2
+	require.NoError(t, backend.Enqueue(context.Background(), job))
+
+	queue.Start()
+	defer queue.Stop(time.Second)
+
+	require.Eventually(t, func() bool { return len(backend.ackedIDs()) == 1 }, 2*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{job.ID}, dlq.written())
+}
+
+func TestPublishingQueue_SubmitAfterStop(t *testing.T) {
+	queue := NewPublishingQueue(nil, nil, nil, DefaultPublishingQueueConfig(), nil, nil, nil)
+	queue.Start()
+	require.NoError(t, queue.Stop(time.Second))
+
+	job := newBackendTestJob(PriorityHigh)
+	err := queue.Submit(job.EnrichedAlert, job.Target)
+	assert.True(t, errors.Is(err, ErrQueueClosed), "got %v", err)
+}
+
+func TestNewPostgresQueueBackend_InstanceID(t *testing.T) {
+	first := NewPostgresQueueBackend(nil, PostgresQueueBackendConfig{}, nil)
+	second := NewPostgresQueueBackend(nil, PostgresQueueBackendConfig{}, nil)
+	assert.NotEmpty(t, first.config.InstanceID)
+	assert.NotEqual(t, first.config.InstanceID, second.config.InstanceID,
+		"processes sharing a hostname must not own each other's leases")
+
+	configured := NewPostgresQueueBackend(nil, PostgresQueueBackendConfig{InstanceID: "alert-history-0"}, nil)
+	assert.Equal(t, "alert-history-0", configured.config.InstanceID)
+}
@@ -3,6 +3,7 @@ package publishing
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
@@ -34,8 +35,18 @@ type DLQEntry struct {
 	ReplayResult   *string                 `json:"replay_result,omitempty"`
 }
 
+// DLQ errors returned by DLQRepository implementations
+var (
+	// ErrDLQEntryNotFound is returned when a DLQ entry does not exist
+	ErrDLQEntryNotFound = errors.New("DLQ entry not found")
+
+	// ErrDLQEntryAlreadyReplayed is returned when replaying an entry twice
+	ErrDLQEntryAlreadyReplayed = errors.New("DLQ entry already replayed")
+)
+
 // DLQFilters for querying DLQ entries
 type DLQFilters struct {
+	ID          *uuid.UUID
 	TargetName  string
 	ErrorType   string
 	Priority    string
@@ -191,6 +202,12 @@ func (r *PostgreSQLDLQRepository) Read(ctx context.Context, filters DLQFilters)
 	argCount := 1
 
 	// Apply filters
+	if filters.ID != nil {
+		query += fmt.Sprintf(" AND id = $%d", argCount)
+		args = append(args, *filters.ID)
+		argCount++
+	}
+
 	if filters.TargetName != "" {
 		query += fmt.Sprintf(" AND target_name = $%d", argCount)
 		args = append(args, filters.TargetName)
@@ -297,13 +314,13 @@ func (r *PostgreSQLDLQRepository) Read(ctx context.Context, filters DLQFilters)
 // Replay attempts to replay a specific DLQ entry
 func (r *PostgreSQLDLQRepository) Replay(ctx context.Context, id uuid.UUID) error {
 	// Fetch entry
-	entries, err := r.Read(ctx, DLQFilters{Limit: 1})
+	entries, err := r.Read(ctx, DLQFilters{ID: &id, Limit: 1})
 	if err != nil {
 		return fmt.Errorf("failed to read DLQ entry: %w", err)
 	}
 
 	if len(entries) == 0 {
-		return fmt.Errorf("DLQ entry not found: %s", id)
+		return fmt.Errorf("%w: %s", ErrDLQEntryNotFound, id)
 	}
 
 	entry := entries[0]
@@ -311,7 +328,11 @@ func (r *PostgreSQLDLQRepository) Replay(ctx context.Context, id uuid.UUID) erro
 	// Check if already replayed
 	if entry.Replayed {
 		r.logger.Warn("DLQ entry already replayed", "dlq_id", id)
-		return fmt.Errorf("entry already replayed")
+		return fmt.Errorf("%w: %s", ErrDLQEntryAlreadyReplayed, id)
+	}
+
+	if r.queue == nil {
+		return fmt.Errorf("failed to replay job: publishing queue not set")
 	}
 
 	// Re-submit to queue
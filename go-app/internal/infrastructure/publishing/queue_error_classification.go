@@ -19,6 +19,7 @@ import (
 //   - Network errors (connection refused, timeout, DNS failure)
 //   - Temporary errors (net.Error with Temporary() = true)
 //   - syscall.ECONNREFUSED, syscall.ECONNRESET, syscall.ETIMEDOUT
+//   - SMTP 4xx replies (SMTPError)
 //
 // PERMANENT (do NOT retry):
 //   - HTTP 400 (Bad Request) - invalid payload
@@ -28,6 +29,7 @@ import (
 //   - HTTP 405 (Method Not Allowed) - wrong HTTP method
 //   - HTTP 422 (Unprocessable Entity) - invalid data format
 //   - HTTP 5xx (except 502/503/504) - permanent server errors
+//   - SMTP 5xx replies and client-side SMTP rejections (SMTPError)
 //
 // UNKNOWN (retry with caution):
 //   - All other errors - default to transient with conservative retry
@@ -49,6 +51,15 @@ func classifyPublishingError(err error) QueueErrorType {
 		return QueueErrorTypeUnknown
 	}
 
+	// SMTP reply errors (4xx transient, 5xx and client-side rejections permanent)
+	var smtpErr *SMTPError
+	if errors.As(err, &smtpErr) {
+		if smtpErr.Temporary() {
+			return QueueErrorTypeTransient
+		}
+		return QueueErrorTypePermanent
+	}
+
 	// HTTP response errors
 	var httpErr interface{ StatusCode() int }
 	if errors.As(err, &httpErr) {
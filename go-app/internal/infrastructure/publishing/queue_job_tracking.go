@@ -126,8 +126,9 @@ func (s *LRUJobTrackingStore) Add(job *PublishingJob) {
 
 // Get retrieves a job by ID (nil if not found)
 func (s *LRUJobTrackingStore) Get(id string) *JobSnapshot {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+	// Write lock: Get reorders the LRU list
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
 	if elem, ok := s.store[id]; ok {
 		// Move to front (most recently used)
@@ -0,0 +1,398 @@
+package publishing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vitaliisemenov/alert-history/internal/core"
+)
+
+// ErrQueueLeaseLost is returned by Ack/ExtendLease when the job lease expired
+// and the job was leased again (or recovered) in the meantime
+var ErrQueueLeaseLost = errors.New("queue lease lost")
+
+// PostgresQueueBackendConfig holds configuration for PostgresQueueBackend
+type PostgresQueueBackendConfig struct {
+	// VisibilityTimeout is how long a dequeued job stays invisible to other workers
+	VisibilityTimeout time.Duration
+
+	// PollInterval is how long Dequeue waits when no job is visible
+	PollInterval time.Duration
+
+	// MaxSize limits number of stored jobs (0 = unbounded)
+	MaxSize int
+
+	// InstanceID identifies lease owner; leases held by the same instance are
+	// released by Recover at startup. It must be unique among live replicas
+	// (e.g. a StatefulSet pod name). Default: hostname with a per-process
+	// random suffix, so only expired leases are recovered after a restart.
+	InstanceID string
+
+	// SizeCacheTTL limits how often Size/SizeByPriority query the table
+	SizeCacheTTL time.Duration
+}
+
+// DefaultPostgresQueueBackendConfig returns default configuration
+func DefaultPostgresQueueBackendConfig() PostgresQueueBackendConfig {
+	return PostgresQueueBackendConfig{
+		VisibilityTimeout: 5 * time.Minute,
+		PollInterval:      500 * time.Millisecond,
+		SizeCacheTTL:      time.Second,
+	}
+}
+
+// PostgresQueueBackend implements QueueBackend on the publishing_queue table.
+//
+// Workers lease jobs with SELECT ... FOR UPDATE SKIP LOCKED, so any number of
+// replicas can share the table. Leasing pushes visible_at forward by the
+// visibility timeout; acknowledging deletes the row. Jobs whose lease expires
+// without an ack are leased again (at-least-once delivery).
+type PostgresQueueBackend struct {
+	db     *pgxpool.Pool
+	config PostgresQueueBackendConfig
+	logger *slog.Logger
+
+	done      chan struct{}
+	closeOnce sync.Once
+
+	sizeMu  sync.Mutex
+	sizes   map[Priority]int
+	sizesAt time.Time
+}
+
+// NewPostgresQueueBackend creates a new PostgreSQL queue backend
+func NewPostgresQueueBackend(db *pgxpool.Pool, config PostgresQueueBackendConfig, logger *slog.Logger) *PostgresQueueBackend {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	defaults := DefaultPostgresQueueBackendConfig()
+	if config.VisibilityTimeout <= 0 {
+		config.VisibilityTimeout = defaults.VisibilityTimeout
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = defaults.PollInterval
+	}
+	if config.SizeCacheTTL <= 0 {
+		config.SizeCacheTTL = defaults.SizeCacheTTL
+	}
+	if config.InstanceID == "" {
+		config.InstanceID = defaultQueueInstanceID()
+	}
+
+	return &PostgresQueueBackend{
+		db:     db,
+		config: config,
+		logger: logger,
+		done:   make(chan struct{}),
+		sizes:  make(map[Priority]int),
+	}
+}
+
+// Name returns backend name
+func (b *PostgresQueueBackend) Name() string {
+	return "postgres"
+}
+
+// Enqueue inserts job into publishing_queue (idempotent by job ID)
+func (b *PostgresQueueBackend) Enqueue(ctx context.Context, job *PublishingJob) error {
+	if b.isClosed() {
+		return ErrQueueClosed
+	}
+	if b.config.MaxSize > 0 && b.Size() >= b.config.MaxSize {
+		return ErrQueueFull
+	}
+
+	enrichedAlertJSON, targetConfigJSON, err := encodeQueueJob(job)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO publishing_queue (
+			id, priority, fingerprint, target_name,
+			enriched_alert, target_config, submitted_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (id) DO NOTHING
+	`
+
+	_, err = b.db.Exec(ctx, query,
+		job.ID,
+		int(job.Priority),
+		job.EnrichedAlert.Alert.Fingerprint,
+		job.Target.Name,
+		enrichedAlertJSON,
+		targetConfigJSON,
+		job.SubmittedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue job: %w", err)
+	}
+
+	b.invalidateSizes()
+	return nil
+}
+
+// Dequeue leases the next visible job (priority, then submission order)
+func (b *PostgresQueueBackend) Dequeue(ctx context.Context) (*PublishingJob, error) {
+	if b.isClosed() {
+		return nil, ErrQueueClosed
+	}
+
+	leaseToken := uuid.NewString()
+	query := `
+		UPDATE publishing_queue
+		SET visible_at = NOW() + make_interval(secs => $1),
+			lease_token = $2,
+			lease_owner = $3,
+			deliveries = deliveries + 1
+		WHERE id = (
+			SELECT id FROM publishing_queue
+			WHERE visible_at <= NOW()
+			ORDER BY priority, submitted_at
+			FOR UPDATE SKIP LOCKED
+			LIMIT 1
+		)
+		RETURNING id, priority, enriched_alert, target_config, deliveries, submitted_at
+	`
+
+	var (
+		id                uuid.UUID
+		priority          int
+		enrichedAlertJSON []byte
+		targetConfigJSON  []byte
+		deliveries        int
+		submittedAt       time.Time
+	)
+	err := b.db.QueryRow(ctx, query,
+		b.config.VisibilityTimeout.Seconds(),
+		leaseToken,
+		b.config.InstanceID,
+	).Scan(&id, &priority, &enrichedAlertJSON, &targetConfigJSON, &deliveries, &submittedAt)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Nothing visible, wait before caller polls again
+		select {
+		case <-time.After(b.config.PollInterval):
+			return nil, nil
+		case <-b.done:
+			return nil, ErrQueueClosed
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to dequeue job: %w", err)
+	}
+
+	job, err := decodeQueueJob(enrichedAlertJSON, targetConfigJSON)
+	if err != nil {
+		// Leave the row leased; it becomes visible again after the timeout
+		return nil, fmt.Errorf("failed to decode job %s: %w", id, err)
+	}
+	job.ID = id.String()
+	job.Priority = Priority(priority)
+	job.State = JobStateQueued
+	job.SubmittedAt = submittedAt
+	job.Deliveries = deliveries
+	job.leaseToken = leaseToken
+
+	return job, nil
+}
+
+// Ack deletes the job if the caller still holds its lease
+func (b *PostgresQueueBackend) Ack(ctx context.Context, job *PublishingJob) error {
+	tag, err := b.db.Exec(ctx,
+		`DELETE FROM publishing_queue WHERE id = $1 AND lease_token = $2`,
+		job.ID, job.leaseToken,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to ack job: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%w: job %s", ErrQueueLeaseLost, job.ID)
+	}
+
+	b.invalidateSizes()
+	return nil
+}
+
+// ExtendLease pushes visible_at by VisibilityTimeout if the caller still holds the lease
+func (b *PostgresQueueBackend) ExtendLease(ctx context.Context, job *PublishingJob) error {
+	tag, err := b.db.Exec(ctx, `
+		UPDATE publishing_queue
+		SET visible_at = NOW() + make_interval(secs => $3)
+		WHERE id = $1 AND lease_token = $2
+	`, job.ID, job.leaseToken, b.config.VisibilityTimeout.Seconds())
+	if err != nil {
+		return fmt.Errorf("failed to extend lease: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%w: job %s", ErrQueueLeaseLost, job.ID)
+	}
+	return nil
+}
+
+// VisibilityTimeout returns lease duration
+func (b *PostgresQueueBackend) VisibilityTimeout() time.Duration {
+	return b.config.VisibilityTimeout
+}
+
+// Recover releases leases held by this instance before a restart and clears
+// expired leases, making those jobs immediately visible. Unexpired leases of
+// other instances are left alone.
+func (b *PostgresQueueBackend) Recover(ctx context.Context) (int, error) {
+	tag, err := b.db.Exec(ctx, `
+		UPDATE publishing_queue
+		SET visible_at = NOW(), lease_token = NULL, lease_owner = NULL
+		WHERE lease_token IS NOT NULL
+			AND (lease_owner = $1 OR visible_at <= NOW())
+	`, b.config.InstanceID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to recover queue leases: %w", err)
+	}
+
+	b.invalidateSizes()
+	return int(tag.RowsAffected()), nil
+}
+
+// Size returns number of stored jobs (queued and leased)
+func (b *PostgresQueueBackend) Size() int {
+	sizes := b.loadSizes()
+	return sizes[PriorityHigh] + sizes[PriorityMedium] + sizes[PriorityLow]
+}
+
+// SizeByPriority returns number of stored jobs for a priority
+func (b *PostgresQueueBackend) SizeByPriority(priority Priority) int {
+	return b.loadSizes()[priority]
+}
+
+// Capacity returns MaxSize (0 = unbounded)
+func (b *PostgresQueueBackend) Capacity() int {
+	return b.config.MaxSize
+}
+
+// CapacityByPriority returns 0: MaxSize is shared by all priorities
+func (b *PostgresQueueBackend) CapacityByPriority(priority Priority) int {
+	return 0
+}
+
+// Close stops Enqueue/Dequeue; leased jobs stay in the table until their lease expires
+func (b *PostgresQueueBackend) Close() error {
+	b.closeOnce.Do(func() {
+		close(b.done)
+	})
+	return nil
+}
+
+func (b *PostgresQueueBackend) isClosed() bool {
+	select {
+	case <-b.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// loadSizes returns per-priority row counts, cached for SizeCacheTTL
+func (b *PostgresQueueBackend) loadSizes() map[Priority]int {
+	b.sizeMu.Lock()
+	defer b.sizeMu.Unlock()
+
+	if !b.sizesAt.IsZero() && time.Since(b.sizesAt) < b.config.SizeCacheTTL {
+		return b.sizes
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	rows, err := b.db.Query(ctx, `SELECT priority, COUNT(*) FROM publishing_queue GROUP BY priority`)
+	if err != nil {
+		b.logger.Warn("Failed to count publishing queue jobs", "error", err)
+		return b.sizes
+	}
+	defer rows.Close()
+
+	sizes := make(map[Priority]int)
+	for rows.Next() {
+		var priority, count int
+		if err := rows.Scan(&priority, &count); err != nil {
+			b.logger.Warn("Failed to scan publishing queue size", "error", err)
+			return b.sizes
+		}
+		sizes[Priority(priority)] = count
+	}
+	if err := rows.Err(); err != nil {
+		b.logger.Warn("Failed to count publishing queue jobs", "error", err)
+		return b.sizes
+	}
+
+	b.sizes = sizes
+	b.sizesAt = time.Now()
+	return sizes
+}
+
+func (b *PostgresQueueBackend) invalidateSizes() {
+	b.sizeMu.Lock()
+	b.sizesAt = time.Time{}
+	b.sizeMu.Unlock()
+}
+
+// defaultQueueInstanceID returns the hostname with a per-process random
+// suffix: replicas sharing a hostname must not release each other's leases.
+func defaultQueueInstanceID() string {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		return uuid.NewString()
+	}
+	return hostname + "-" + uuid.NewString()[:8]
+}
+
+// encodeQueueJob serializes job payload to JSONB columns
+func encodeQueueJob(job *PublishingJob) (enrichedAlertJSON, targetConfigJSON []byte, err error) {
+	if job.EnrichedAlert == nil || job.EnrichedAlert.Alert == nil {
+		return nil, nil, fmt.Errorf("job %s has no alert", job.ID)
+	}
+	if job.Target == nil {
+		return nil, nil, fmt.Errorf("job %s has no target", job.ID)
+	}
+
+	enrichedAlertJSON, err = json.Marshal(job.EnrichedAlert)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal enriched alert: %w", err)
+	}
+	targetConfigJSON, err = json.Marshal(job.Target)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal target config: %w", err)
+	}
+	return enrichedAlertJSON, targetConfigJSON, nil
+}
+
+// decodeQueueJob restores job payload from JSONB columns
+func decodeQueueJob(enrichedAlertJSON, targetConfigJSON []byte) (*PublishingJob, error) {
+	var enrichedAlert core.EnrichedAlert
+	if err := json.Unmarshal(enrichedAlertJSON, &enrichedAlert); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal enriched alert: %w", err)
+	}
+	var target core.PublishingTarget
+	if err := json.Unmarshal(targetConfigJSON, &target); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal target config: %w", err)
+	}
+	if enrichedAlert.Alert == nil {
+		return nil, fmt.Errorf("enriched alert has no alert")
+	}
+
+	return &PublishingJob{
+		EnrichedAlert: &enrichedAlert,
+		Target:        &target,
+	}, nil
+}
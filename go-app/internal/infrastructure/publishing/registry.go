@@ -19,13 +19,13 @@ import (
 // Example:
 //
 //	registry := NewDefaultFormatRegistry()
-//	registry.Register(core.PublishingFormat("opsgenie"), formatOpsgenie)
-//	fn, _ := registry.Get(core.PublishingFormat("opsgenie"))
+//	registry.Register(core.PublishingFormat("victorops"), formatVictorOps)
+//	fn, _ := registry.Get(core.PublishingFormat("victorops"))
 type FormatRegistry interface {
 	// Register adds a new format or replaces existing one.
 	//
 	// Parameters:
-	//   format: Unique format identifier (e.g., "opsgenie")
+	//   format: Unique format identifier (e.g., "victorops")
 	//   fn: Format implementation function
 	//
 	// Returns:
@@ -107,7 +107,7 @@ type DefaultFormatRegistry struct {
 // NewDefaultFormatRegistry creates a registry with built-in formats.
 //
 // Returns:
-//   FormatRegistry: Registry pre-loaded with 5 standard formats
+//   FormatRegistry: Registry pre-loaded with 6 standard formats
 func NewDefaultFormatRegistry() FormatRegistry {
 	r := &DefaultFormatRegistry{
 		formats:   make(map[core.PublishingFormat]formatFunc, 10),
@@ -120,7 +120,7 @@ func NewDefaultFormatRegistry() FormatRegistry {
 	return r
 }
 
-// registerBuiltins adds the 5 standard formats
+// registerBuiltins adds the 6 standard formats
 func (r *DefaultFormatRegistry) registerBuiltins() {
 	// Create formatter instance to access methods
 	baseFormatter := &DefaultAlertFormatter{}
@@ -130,6 +130,7 @@ func (r *DefaultFormatRegistry) registerBuiltins() {
 	baseFormatter.formatters[core.FormatPagerDuty] = baseFormatter.formatPagerDuty
 	baseFormatter.formatters[core.FormatSlack] = baseFormatter.formatSlack
 	baseFormatter.formatters[core.FormatWebhook] = baseFormatter.formatWebhook
+	baseFormatter.formatters[core.FormatOpsgenie] = baseFormatter.formatOpsgenie
 
 	// Register formats without validation (built-ins are trusted)
 	r.formats[core.FormatAlertmanager] = baseFormatter.formatAlertmanager
@@ -137,6 +138,7 @@ func (r *DefaultFormatRegistry) registerBuiltins() {
 	r.formats[core.FormatPagerDuty] = baseFormatter.formatPagerDuty
 	r.formats[core.FormatSlack] = baseFormatter.formatSlack
 	r.formats[core.FormatWebhook] = baseFormatter.formatWebhook
+	r.formats[core.FormatOpsgenie] = baseFormatter.formatOpsgenie
 
 	// Initialize reference counts
 	for format := range r.formats {
@@ -9,12 +9,12 @@ import (
 	"github.com/vitaliisemenov/alert-history/internal/core"
 )
 
-// TestNewDefaultFormatRegistry_BuiltinFormats verifies all 5 built-in formats are registered
+// TestNewDefaultFormatRegistry_BuiltinFormats verifies all 6 built-in formats are registered
 func TestNewDefaultFormatRegistry_BuiltinFormats(t *testing.T) {
 	registry := NewDefaultFormatRegistry()
 
 	// Verify count
-	assert.Equal(t, 5, registry.Count(), "Should have 5 built-in formats")
+	assert.Equal(t, 6, registry.Count(), "Should have 6 built-in formats")
 
 	// Verify each built-in format
 	builtinFormats := []core.PublishingFormat{
@@ -23,6 +23,7 @@ func TestNewDefaultFormatRegistry_BuiltinFormats(t *testing.T) {
 		core.FormatPagerDuty,
 		core.FormatSlack,
 		core.FormatWebhook,
+		core.FormatOpsgenie,
 	}
 
 	for _, format := range builtinFormats {
@@ -39,9 +40,9 @@ func TestFormatRegistry_Register_Success(t *testing.T) {
 	registry := NewDefaultFormatRegistry()
 
 	// Define custom format
-	customFormat := core.PublishingFormat("opsgenie")
+	customFormat := core.PublishingFormat("victorops")
 	customFn := func(alert *core.EnrichedAlert) (map[string]any, error) {
-		return map[string]any{"format": "opsgenie"}, nil
+		return map[string]any{"format": "victorops"}, nil
 	}
 
 	// Register custom format
@@ -50,7 +51,7 @@ func TestFormatRegistry_Register_Success(t *testing.T) {
 
 	// Verify format is registered
 	assert.True(t, registry.Supports(customFormat), "Custom format should be supported")
-	assert.Equal(t, 6, registry.Count(), "Should have 6 formats (5 built-in + 1 custom)")
+	assert.Equal(t, 7, registry.Count(), "Should have 7 formats (6 built-in + 1 custom)")
 
 	// Verify format can be retrieved
 	fn, err := registry.Get(customFormat)
@@ -60,7 +61,7 @@ func TestFormatRegistry_Register_Success(t *testing.T) {
 	// Verify function works
 	result, err := fn(createTestEnrichedAlert())
 	require.NoError(t, err, "Custom format function should execute")
-	assert.Equal(t, "opsgenie", result["format"], "Should return correct format")
+	assert.Equal(t, "victorops", result["format"], "Should return correct format")
 }
 
 // TestFormatRegistry_Register_Overwrite tests overwriting existing format
@@ -156,7 +157,7 @@ func TestFormatRegistry_Unregister_Success(t *testing.T) {
 
 	err := registry.Register(customFormat, customFn)
 	require.NoError(t, err)
-	assert.Equal(t, 6, registry.Count())
+	assert.Equal(t, 7, registry.Count())
 
 	// Unregister format
 	err = registry.Unregister(customFormat)
@@ -164,7 +165,7 @@ func TestFormatRegistry_Unregister_Success(t *testing.T) {
 
 	// Verify format is removed
 	assert.False(t, registry.Supports(customFormat), "Format should no longer be supported")
-	assert.Equal(t, 5, registry.Count(), "Count should decrease")
+	assert.Equal(t, 6, registry.Count(), "Count should decrease")
 
 	// Verify Get returns error
 	_, err = registry.Get(customFormat)
@@ -292,7 +293,7 @@ func TestFormatRegistry_List(t *testing.T) {
 
 	// Get list of built-in formats
 	formats := registry.List()
-	assert.Len(t, formats, 5, "Should have 5 built-in formats")
+	assert.Len(t, formats, 6, "Should have 6 built-in formats")
 
 	// Verify sorting (alphabetical)
 	assert.Equal(t, core.FormatAlertmanager, formats[0], "First should be alertmanager")
@@ -303,7 +304,7 @@ func TestFormatRegistry_List(t *testing.T) {
 
 	// Get updated list
 	formats = registry.List()
-	assert.Len(t, formats, 6, "Should have 6 formats")
+	assert.Len(t, formats, 7, "Should have 7 formats")
 	assert.Equal(t, customFormat, formats[0], "Custom format should be first (alphabetically)")
 
 	// Verify list is a copy (not live view)
@@ -317,7 +318,7 @@ func TestFormatRegistry_Count(t *testing.T) {
 	registry := NewDefaultFormatRegistry()
 
 	// Initial count
-	assert.Equal(t, 5, registry.Count(), "Should start with 5 built-in formats")
+	assert.Equal(t, 6, registry.Count(), "Should start with 6 built-in formats")
 
 	// Register custom formats
 	for i := 1; i <= 3; i++ {
@@ -325,11 +326,11 @@ func TestFormatRegistry_Count(t *testing.T) {
 		_ = registry.Register(format, func(*core.EnrichedAlert) (map[string]any, error) { return nil, nil })
 	}
 
-	assert.Equal(t, 8, registry.Count(), "Should have 8 formats after registering 3")
+	assert.Equal(t, 9, registry.Count(), "Should have 9 formats after registering 3")
 
 	// Unregister one format
 	_ = registry.Unregister(core.PublishingFormat("custom-a"))
-	assert.Equal(t, 7, registry.Count(), "Should have 7 formats after unregistering 1")
+	assert.Equal(t, 8, registry.Count(), "Should have 8 formats after unregistering 1")
 }
 
 // TestFormatRegistry_ThreadSafety tests concurrent access
@@ -386,7 +387,7 @@ func TestFormatRegistry_ThreadSafety(t *testing.T) {
 
 	// Verify registry is still functional
 	assert.True(t, registry.Supports(core.FormatAlertmanager), "Registry should still be functional")
-	assert.GreaterOrEqual(t, registry.Count(), 5, "Should have at least 5 formats")
+	assert.GreaterOrEqual(t, registry.Count(), 6, "Should have at least 6 formats")
 }
 
 // TestIsValidFormatName tests format name validation
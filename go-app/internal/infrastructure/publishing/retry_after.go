@@ -0,0 +1,53 @@
+package publishing
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// retry_after.go - Retry-After parsing shared by chat-ops clients (Microsoft Teams, Telegram)
+
+// maxRetryAfterWait caps how long a client blocks a publishing worker honouring Retry-After.
+// Longer waits are surfaced as a transient error so the publishing queue reschedules the job.
+const maxRetryAfterWait = 30 * time.Second
+
+// parseRetryAfter parses a Retry-After header value (RFC 9110 §10.2.3)
+// Supports both delay-seconds ("120") and HTTP-date ("Wed, 21 Oct 2015 07:28:00 GMT")
+// Returns 0 if the value is empty, malformed or in the past
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds <= 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		if wait := date.Sub(now); wait > 0 {
+			return wait
+		}
+	}
+
+	return 0
+}
+
+// waitRetryAfter blocks for the given duration or until ctx is done
+func waitRetryAfter(ctx context.Context, wait time.Duration) error {
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
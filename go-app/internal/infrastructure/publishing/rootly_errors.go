@@ -1,9 +1,14 @@
 package publishing
 
 import (
+	"errors"
 	"fmt"
 )
 
+// ErrIncidentNotTracked is returned when acknowledging an alert whose
+// Rootly incident is not in the incident ID cache.
+var ErrIncidentNotTracked = errors.New("rootly: incident not tracked in cache")
+
 // RootlyAPIError represents error from Rootly API
 type RootlyAPIError struct {
 	StatusCode int    // HTTP status code
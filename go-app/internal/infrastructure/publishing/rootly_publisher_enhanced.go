@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/vitaliisemenov/alert-history/internal/core"
 )
@@ -177,6 +178,47 @@ func (p *EnhancedRootlyPublisher) updateIncident(
 	return nil
 }
 
+// Acknowledge records an alert acknowledgement on its Rootly incident
+// (implements AckPublisher).
+//
+// Rootly incidents have no acknowledged state, so the acknowledgement and
+// owner are stored in the incident custom fields.
+func (p *EnhancedRootlyPublisher) Acknowledge(ctx context.Context, alert *core.Alert, ack *core.AlertAck, target *core.PublishingTarget) error {
+	incidentID, exists := p.cache.Get(alert.Fingerprint)
+	if !exists {
+		return ErrIncidentNotTracked
+	}
+
+	customFields := map[string]interface{}{
+		"acknowledged_by": ack.AckedBy,
+	}
+	if ack.AckedAt != nil {
+		customFields["acknowledged_at"] = ack.AckedAt.UTC().Format(time.RFC3339)
+	}
+	if ack.Owner != "" {
+		customFields["owner"] = ack.Owner
+	}
+
+	if _, err := p.client.UpdateIncident(ctx, incidentID, &UpdateIncidentRequest{CustomFields: customFields}); err != nil {
+		if IsNotFoundError(err) {
+			p.cache.Delete(alert.Fingerprint)
+			return ErrIncidentNotTracked
+		}
+		p.metrics.RecordError("acknowledge", err)
+		return fmt.Errorf("acknowledge incident failed: %w", err)
+	}
+
+	p.metrics.RecordIncidentUpdated("acknowledged")
+
+	p.logger.Info("Rootly incident acknowledged",
+		"incident_id", incidentID,
+		"fingerprint", alert.Fingerprint,
+		"acked_by", ack.AckedBy,
+	)
+
+	return nil
+}
+
 // resolveIncident resolves a Rootly incident
 func (p *EnhancedRootlyPublisher) resolveIncident(
 	ctx context.Context,
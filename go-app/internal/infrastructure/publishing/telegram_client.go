@@ -0,0 +1,264 @@
+package publishing
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// telegram_client.go - Telegram Bot API client with per-chat rate limiting and flood-control handling
+
+// TelegramBotClient defines the interface for Telegram Bot API operations
+type TelegramBotClient interface {
+	// SendMessage sends a message via the bot identified by botToken
+	// apiURL is the Bot API root (empty = https://api.telegram.org)
+	// Retries 429/5xx responses, honouring retry_after (up to maxRetryAfterWait)
+	SendMessage(ctx context.Context, apiURL, botToken string, req *TelegramSendMessageRequest) (*TelegramMessageResult, error)
+}
+
+// TelegramClientConfig holds configuration for the Telegram Bot API client
+type TelegramClientConfig struct {
+	// Timeout is the HTTP client timeout
+	// Default: 10s
+	Timeout time.Duration
+
+	// MaxRetries is the maximum number of retries for transient errors
+	// Default: 3
+	MaxRetries int
+
+	// ChatRateLimit is the per-chat rate limit in messages per second
+	// Default: 1 (Telegram flood control limit for a single chat)
+	ChatRateLimit float64
+
+	// MaxRetryAfter is the longest retry_after the client waits for in-process
+	// Longer waits are returned as retryable errors for the publishing queue
+	// Default: 30s
+	MaxRetryAfter time.Duration
+}
+
+// httpTelegramBotClient implements TelegramBotClient
+type httpTelegramBotClient struct {
+	httpClient  *http.Client
+	config      TelegramClientConfig
+	metrics     *TelegramMetrics
+	logger      *slog.Logger
+	baseBackoff time.Duration
+	maxBackoff  time.Duration
+	limitersMu  sync.Mutex
+	limiters    map[string]*rate.Limiter // bot token + chat ID → limiter
+}
+
+// NewTelegramBotClient creates a new Telegram Bot API client
+func NewTelegramBotClient(config TelegramClientConfig, metrics *TelegramMetrics, logger *slog.Logger) TelegramBotClient {
+	if config.Timeout == 0 {
+		config.Timeout = 10 * time.Second
+	}
+	if config.MaxRetries == 0 {
+		config.MaxRetries = 3
+	}
+	if config.ChatRateLimit == 0 {
+		config.ChatRateLimit = 1.0
+	}
+	if config.MaxRetryAfter == 0 {
+		config.MaxRetryAfter = maxRetryAfterWait
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &httpTelegramBotClient{
+		httpClient: &http.Client{
+			Timeout: config.Timeout,
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					MinVersion: tls.VersionTLS12,
+				},
+				MaxIdleConnsPerHost: 2,
+				IdleConnTimeout:     30 * time.Second,
+			},
+		},
+		config:      config,
+		metrics:     metrics,
+		logger:      logger.With("component", "telegram_client"),
+		baseBackoff: 100 * time.Millisecond,
+		maxBackoff:  5 * time.Second,
+		limiters:    make(map[string]*rate.Limiter),
+	}
+}
+
+// SendMessage sends a message via Bot API sendMessage
+func (c *httpTelegramBotClient) SendMessage(ctx context.Context, apiURL, botToken string, req *TelegramSendMessageRequest) (*TelegramMessageResult, error) {
+	if botToken == "" {
+		return nil, ErrMissingTelegramBotToken
+	}
+	if req.ChatID == "" {
+		return nil, ErrMissingTelegramChatID
+	}
+	req.Text = truncateRunes(req.Text, telegramMaxMessageLength)
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	if apiURL == "" {
+		apiURL = defaultTelegramAPIURL
+	}
+	endpoint := strings.TrimRight(apiURL, "/") + "/bot" + botToken + "/sendMessage"
+
+	limiter := c.limiter(botToken + "|" + req.ChatID)
+	backoff := c.baseBackoff
+
+	var lastErr error
+	for attempt := 0; attempt <= c.config.MaxRetries; attempt++ {
+		if err := limiter.Wait(ctx); err != nil {
+			return nil, fmt.Errorf("rate limiter wait failed: %w", err)
+		}
+
+		result, apiErr, err := c.post(ctx, endpoint, botToken, body)
+		if err == nil && apiErr == nil {
+			return result, nil
+		}
+
+		if err != nil {
+			lastErr = err
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			if !isRetryableNetworkError(err) || attempt == c.config.MaxRetries {
+				c.metrics.APIErrors.WithLabelValues("network_error").Inc()
+				return nil, err
+			}
+		} else {
+			lastErr = apiErr
+			if apiErr.StatusCode == http.StatusTooManyRequests {
+				c.metrics.RateLimitHits.Inc()
+			}
+			if !shouldRetryTelegram(apiErr.StatusCode) || attempt == c.config.MaxRetries {
+				c.metrics.APIErrors.WithLabelValues(apiErr.Type()).Inc()
+				return nil, apiErr
+			}
+
+			// Honour flood control; hand long waits back to the publishing queue
+			if apiErr.RetryAfter > 0 {
+				if apiErr.RetryAfter > c.config.MaxRetryAfter {
+					c.metrics.APIErrors.WithLabelValues(apiErr.Type()).Inc()
+					return nil, apiErr
+				}
+				c.logger.InfoContext(ctx, "Flood control, respecting retry_after",
+					slog.String("chat_id", req.ChatID),
+					slog.Duration("retry_after", apiErr.RetryAfter))
+				if err := waitRetryAfter(ctx, apiErr.RetryAfter); err != nil {
+					return nil, err
+				}
+				continue
+			}
+		}
+
+		c.logger.WarnContext(ctx, "Retrying after transient error",
+			slog.Int("attempt", attempt+1),
+			slog.String("error", lastErr.Error()))
+		if err := waitRetryAfter(ctx, backoff); err != nil {
+			return nil, err
+		}
+		backoff *= 2
+		if backoff > c.maxBackoff {
+			backoff = c.maxBackoff
+		}
+	}
+
+	return nil, fmt.Errorf("max retries (%d) exceeded: %w", c.config.MaxRetries, lastErr)
+}
+
+// post performs a single sendMessage request
+// Returns (nil, nil, err) for transport errors and (nil, apiErr, nil) for API errors
+func (c *httpTelegramBotClient) post(ctx context.Context, endpoint, botToken string, body []byte) (*TelegramMessageResult, *TelegramAPIError, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create request: %w", redactTelegramToken(err, botToken))
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	start := time.Now()
+	resp, err := c.httpClient.Do(httpReq)
+	c.metrics.APIDuration.Observe(time.Since(start).Seconds())
+	if err != nil {
+		c.metrics.APIRequests.WithLabelValues("0").Inc()
+		return nil, nil, fmt.Errorf("HTTP request failed: %w", redactTelegramToken(err, botToken))
+	}
+	defer resp.Body.Close()
+	c.metrics.APIRequests.WithLabelValues(strconv.Itoa(resp.StatusCode)).Inc()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var tgResp TelegramResponse
+	if err := json.Unmarshal(respBody, &tgResp); err != nil {
+		// Non-JSON response (proxy/gateway error)
+		return nil, &TelegramAPIError{
+			StatusCode:  resp.StatusCode,
+			Description: truncateString(string(respBody), 256),
+			RetryAfter:  parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+		}, nil
+	}
+
+	if tgResp.OK && resp.StatusCode == http.StatusOK {
+		if tgResp.Result == nil {
+			return nil, nil, fmt.Errorf("telegram response missing result")
+		}
+		return tgResp.Result, nil, nil
+	}
+
+	apiErr := &TelegramAPIError{
+		StatusCode:  tgResp.ErrorCode,
+		Description: tgResp.Description,
+		RetryAfter:  parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+	}
+	if apiErr.StatusCode == 0 {
+		apiErr.StatusCode = resp.StatusCode
+	}
+	if tgResp.Parameters != nil {
+		if tgResp.Parameters.RetryAfter > 0 {
+			apiErr.RetryAfter = time.Duration(tgResp.Parameters.RetryAfter) * time.Second
+		}
+		apiErr.MigrateToChatID = tgResp.Parameters.MigrateToChatID
+	}
+	return nil, apiErr, nil
+}
+
+// limiter returns the rate limiter for a bot/chat pair
+func (c *httpTelegramBotClient) limiter(key string) *rate.Limiter {
+	c.limitersMu.Lock()
+	defer c.limitersMu.Unlock()
+
+	limiter, ok := c.limiters[key]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Limit(c.config.ChatRateLimit), 1)
+		c.limiters[key] = limiter
+	}
+	return limiter
+}
+
+// redactTelegramToken removes the bot token (embedded in the request URL) from transport errors
+func redactTelegramToken(err error, botToken string) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		urlErr.URL = strings.ReplaceAll(urlErr.URL, botToken, "***")
+	}
+	return err
+}
@@ -0,0 +1,101 @@
+package publishing
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// telegram_errors.go - Telegram Bot API error types and classification helpers
+
+// TelegramAPIError represents an error returned by the Telegram Bot API
+type TelegramAPIError struct {
+	// StatusCode is the Bot API error_code (mirrors the HTTP status)
+	StatusCode int
+
+	// Description is the human-readable error from the API
+	Description string
+
+	// RetryAfter is the flood-control wait (parameters.retry_after or Retry-After header)
+	RetryAfter time.Duration
+
+	// MigrateToChatID is set when the group was upgraded to a supergroup
+	MigrateToChatID int64
+}
+
+// Error implements the error interface.
+// Includes the status code so classifyPublishingError can classify it.
+func (e *TelegramAPIError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("telegram API error %d: %s (retry after %s)", e.StatusCode, e.Description, e.RetryAfter)
+	}
+	return fmt.Sprintf("telegram API error %d: %s", e.StatusCode, e.Description)
+}
+
+// Type returns the error type classification based on status code
+func (e *TelegramAPIError) Type() string {
+	switch e.StatusCode {
+	case 400:
+		return "bad_request"
+	case 401:
+		return "unauthorized"
+	case 403:
+		return "forbidden"
+	case 404:
+		return "not_found"
+	case 429:
+		return "rate_limit"
+	case 500, 502, 503, 504:
+		return "server_error"
+	default:
+		return "unknown"
+	}
+}
+
+// Sentinel errors for common Telegram integration issues
+var (
+	// ErrMissingTelegramBotToken is returned when bot_token is missing from target configuration
+	ErrMissingTelegramBotToken = errors.New("telegram: bot_token not found in target configuration")
+
+	// ErrMissingTelegramChatID is returned when chat_id is missing from target configuration
+	ErrMissingTelegramChatID = errors.New("telegram: chat_id not found in target configuration")
+
+	// ErrInvalidTelegramParseMode is returned for unsupported parse_mode values
+	ErrInvalidTelegramParseMode = errors.New("telegram: parse_mode must be one of HTML, Markdown, MarkdownV2")
+)
+
+// IsTelegramRetryableError checks if Telegram error is retryable (429, 5xx, network errors)
+func IsTelegramRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var apiErr *TelegramAPIError
+	if errors.As(err, &apiErr) {
+		return shouldRetryTelegram(apiErr.StatusCode)
+	}
+
+	return isRetryableNetworkError(err)
+}
+
+// IsTelegramRateLimitError checks if Telegram error is flood control (429)
+func IsTelegramRateLimitError(err error) bool {
+	var apiErr *TelegramAPIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusTooManyRequests
+	}
+	return false
+}
+
+// shouldRetryTelegram determines if the request is retryable based on status code
+func shouldRetryTelegram(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
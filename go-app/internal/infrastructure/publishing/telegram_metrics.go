@@ -0,0 +1,85 @@
+package publishing
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Telegram Prometheus Metrics
+
+// TelegramMetrics holds all Telegram-specific Prometheus metrics
+type TelegramMetrics struct {
+	// MessagesSent tracks the total number of messages sent
+	MessagesSent *prometheus.CounterVec
+
+	// MessagesSkipped tracks notifications intentionally not sent
+	MessagesSkipped *prometheus.CounterVec
+
+	// ThreadReplies tracks resolved notifications sent as replies to the firing message
+	ThreadReplies *prometheus.CounterVec
+
+	// APIRequests tracks the total number of Bot API requests
+	APIRequests *prometheus.CounterVec
+
+	// APIErrors tracks the total number of Bot API errors
+	APIErrors *prometheus.CounterVec
+
+	// APIDuration tracks the duration of Bot API requests
+	APIDuration prometheus.Histogram
+
+	// RateLimitHits tracks the number of flood-control (429) responses
+	RateLimitHits prometheus.Counter
+}
+
+// NewTelegramMetrics creates a new TelegramMetrics instance
+func NewTelegramMetrics() *TelegramMetrics {
+	return &TelegramMetrics{
+		MessagesSent: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "telegram_messages_sent_total",
+				Help: "Total number of Telegram messages sent",
+			},
+			[]string{"target", "status"},
+		),
+		MessagesSkipped: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "telegram_messages_skipped_total",
+				Help: "Total number of Telegram messages skipped",
+			},
+			[]string{"target", "reason"},
+		),
+		ThreadReplies: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "telegram_thread_replies_total",
+				Help: "Total number of Telegram messages sent as replies",
+			},
+			[]string{"target"},
+		),
+		APIRequests: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "telegram_api_requests_total",
+				Help: "Total number of Telegram Bot API requests",
+			},
+			[]string{"status"},
+		),
+		APIErrors: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "telegram_api_errors_total",
+				Help: "Total number of Telegram Bot API errors",
+			},
+			[]string{"error_type"},
+		),
+		APIDuration: prometheus.NewHistogram(
+			prometheus.HistogramOpts{
+				Name:    "telegram_api_duration_seconds",
+				Help:    "Duration of Telegram Bot API requests in seconds",
+				Buckets: prometheus.DefBuckets,
+			},
+		),
+		RateLimitHits: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Name: "telegram_rate_limit_hits_total",
+				Help: "Total number of Telegram flood-control responses",
+			},
+		),
+	}
+}
@@ -0,0 +1,73 @@
+package publishing
+
+// telegram_models.go - Telegram Bot API sendMessage data structures
+// https://core.telegram.org/bots/api#sendmessage
+
+const (
+	// defaultTelegramAPIURL is the public Telegram Bot API endpoint
+	defaultTelegramAPIURL = "https://api.telegram.org"
+
+	// telegramMaxMessageLength is the sendMessage text limit (characters)
+	telegramMaxMessageLength = 4096
+)
+
+// TelegramSendMessageRequest is the sendMessage request body
+type TelegramSendMessageRequest struct {
+	// ChatID is the target chat ID (negative for groups/channels) or @channelusername
+	ChatID string `json:"chat_id"`
+
+	// MessageThreadID targets a forum topic (supergroups with topics enabled)
+	MessageThreadID int64 `json:"message_thread_id,omitempty"`
+
+	// Text is the message text (1-4096 characters after entity parsing)
+	Text string `json:"text"`
+
+	// ParseMode is "HTML", "Markdown" or "MarkdownV2" (empty = plain text)
+	ParseMode string `json:"parse_mode,omitempty"`
+
+	// DisableNotification sends the message silently
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// LinkPreviewOptions disables link previews (runbook links would otherwise expand)
+	LinkPreviewOptions *TelegramLinkPreviewOptions `json:"link_preview_options,omitempty"`
+
+	// ReplyParameters makes the message a reply (used to thread resolved notifications)
+	ReplyParameters *TelegramReplyParameters `json:"reply_parameters,omitempty"`
+}
+
+// TelegramLinkPreviewOptions controls link preview generation
+type TelegramLinkPreviewOptions struct {
+	IsDisabled bool `json:"is_disabled"`
+}
+
+// TelegramReplyParameters describes the message being replied to
+type TelegramReplyParameters struct {
+	// MessageID is the ID of the message to reply to
+	MessageID int64 `json:"message_id"`
+
+	// AllowSendingWithoutReply sends the message even if the original was deleted
+	AllowSendingWithoutReply bool `json:"allow_sending_without_reply"`
+}
+
+// TelegramResponse is the generic Bot API response envelope
+type TelegramResponse struct {
+	OK          bool                        `json:"ok"`
+	Result      *TelegramMessageResult      `json:"result,omitempty"`
+	ErrorCode   int                         `json:"error_code,omitempty"`
+	Description string                      `json:"description,omitempty"`
+	Parameters  *TelegramResponseParameters `json:"parameters,omitempty"`
+}
+
+// TelegramMessageResult is the subset of the Message object used for threading
+type TelegramMessageResult struct {
+	MessageID int64 `json:"message_id"`
+}
+
+// TelegramResponseParameters carries retry/migration hints for failed requests
+type TelegramResponseParameters struct {
+	// RetryAfter is the number of seconds to wait before repeating (flood control)
+	RetryAfter int `json:"retry_after,omitempty"`
+
+	// MigrateToChatID is the new chat ID when a group was upgraded to a supergroup
+	MigrateToChatID int64 `json:"migrate_to_chat_id,omitempty"`
+}
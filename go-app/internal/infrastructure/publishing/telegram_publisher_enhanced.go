@@ -0,0 +1,190 @@
+package publishing
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/notification/template"
+	"github.com/vitaliisemenov/alert-history/internal/notification/template/defaults"
+)
+
+// EnhancedTelegramPublisher implements AlertPublisher for Telegram Bot API
+// Renders the message with NotificationTemplateEngine (TN-153) using the
+// default Telegram template unless overridden.
+//
+// Threading: the message ID of the first notification for an alert is cached
+// (per target + fingerprint); later notifications for the same alert, including
+// the resolved one, are sent as replies to it.
+//
+// Target configuration (core.PublishingTarget):
+//   - URL: Bot API root (optional, default https://api.telegram.org)
+//   - Headers["bot_token"], ["chat_id"]: required
+//   - Headers["message_thread_id"]: forum topic ID (optional)
+//   - Headers["parse_mode"]: HTML (default), Markdown or MarkdownV2
+//   - Headers["disable_notification"]: "true" to send silently
+//   - Headers["message"]: template override (optional)
+//   - Headers["send_resolved"]: "false" to skip resolved notifications (default: true)
+type EnhancedTelegramPublisher struct {
+	client   TelegramBotClient
+	cache    MessageIDCache
+	engine   template.NotificationTemplateEngine
+	metrics  *TelegramMetrics
+	logger   *slog.Logger
+	defaults *defaults.TelegramTemplates
+}
+
+// TelegramTargetConfig is the Telegram configuration parsed from a publishing target
+type TelegramTargetConfig struct {
+	APIURL              string
+	BotToken            string
+	ChatID              string
+	MessageThreadID     int64
+	ParseMode           string
+	DisableNotification bool
+	Message             string
+	SendResolved        bool
+}
+
+// NewEnhancedTelegramPublisher creates a new enhanced Telegram publisher
+func NewEnhancedTelegramPublisher(
+	client TelegramBotClient,
+	cache MessageIDCache,
+	engine template.NotificationTemplateEngine,
+	metrics *TelegramMetrics,
+	logger *slog.Logger,
+) AlertPublisher {
+	return &EnhancedTelegramPublisher{
+		client:   client,
+		cache:    cache,
+		engine:   engine,
+		metrics:  metrics,
+		logger:   logger,
+		defaults: defaults.GetDefaultTelegramTemplates(),
+	}
+}
+
+// Publish renders and sends enriched alert as a Telegram message
+func (p *EnhancedTelegramPublisher) Publish(ctx context.Context, enrichedAlert *core.EnrichedAlert, target *core.PublishingTarget) error {
+	alert := enrichedAlert.Alert
+
+	cfg, err := parseTelegramTargetConfig(target)
+	if err != nil {
+		return fmt.Errorf("invalid telegram target %s: %w", target.Name, err)
+	}
+
+	cacheKey := target.Name + "|" + alert.Fingerprint
+
+	if alert.Status == core.StatusResolved && !cfg.SendResolved {
+		p.cache.Delete(cacheKey)
+		p.metrics.MessagesSkipped.WithLabelValues(target.Name, "send_resolved_disabled").Inc()
+		p.logger.Debug("Skipping resolved Telegram notification",
+			"fingerprint", alert.Fingerprint,
+			"target", target.Name,
+		)
+		return nil
+	}
+
+	text, err := p.engine.Execute(ctx, firstNonEmpty(cfg.Message, p.defaults.Message), newAlertTemplateData(enrichedAlert, target, "telegram"))
+	if err != nil {
+		return fmt.Errorf("failed to render Telegram message: %w", err)
+	}
+
+	req := &TelegramSendMessageRequest{
+		ChatID:              cfg.ChatID,
+		MessageThreadID:     cfg.MessageThreadID,
+		Text:                text,
+		ParseMode:           cfg.ParseMode,
+		DisableNotification: cfg.DisableNotification,
+		LinkPreviewOptions:  &TelegramLinkPreviewOptions{IsDisabled: true},
+	}
+
+	// Reply to the original message if one was sent for this alert
+	entry, threaded := p.cache.Get(cacheKey)
+	if threaded {
+		if messageID, err := strconv.ParseInt(entry.MessageTS, 10, 64); err == nil {
+			req.ReplyParameters = &TelegramReplyParameters{
+				MessageID:                messageID,
+				AllowSendingWithoutReply: true,
+			}
+		}
+	}
+
+	result, err := p.client.SendMessage(ctx, cfg.APIURL, cfg.BotToken, req)
+	if err != nil {
+		p.metrics.MessagesSent.WithLabelValues(target.Name, "error").Inc()
+		return fmt.Errorf("failed to send Telegram message: %w", err)
+	}
+
+	p.metrics.MessagesSent.WithLabelValues(target.Name, "success").Inc()
+	if req.ReplyParameters != nil {
+		p.metrics.ThreadReplies.WithLabelValues(target.Name).Inc()
+	}
+
+	switch {
+	case alert.Status == core.StatusResolved:
+		p.cache.Delete(cacheKey)
+	case !threaded:
+		p.cache.Store(cacheKey, &MessageEntry{
+			MessageTS: strconv.FormatInt(result.MessageID, 10),
+			CreatedAt: time.Now(),
+		})
+	}
+
+	p.logger.Info("Telegram notification sent",
+		"fingerprint", alert.Fingerprint,
+		"target", target.Name,
+		"alert_name", alert.AlertName,
+		"message_id", result.MessageID,
+		"reply", req.ReplyParameters != nil,
+	)
+
+	return nil
+}
+
+// Name returns publisher name
+func (p *EnhancedTelegramPublisher) Name() string {
+	return "Telegram"
+}
+
+// parseTelegramTargetConfig extracts Telegram configuration from target headers
+func parseTelegramTargetConfig(target *core.PublishingTarget) (*TelegramTargetConfig, error) {
+	headers := target.Headers
+
+	cfg := &TelegramTargetConfig{
+		APIURL:              target.URL,
+		BotToken:            strings.TrimSpace(headers["bot_token"]),
+		ChatID:              strings.TrimSpace(headers["chat_id"]),
+		ParseMode:           firstNonEmpty(strings.TrimSpace(headers["parse_mode"]), defaults.DefaultTelegramParseMode),
+		DisableNotification: parseBoolHeader(headers["disable_notification"], false),
+		Message:             headers["message"],
+		SendResolved:        parseBoolHeader(headers["send_resolved"], true),
+	}
+
+	if cfg.BotToken == "" {
+		return nil, ErrMissingTelegramBotToken
+	}
+	if cfg.ChatID == "" {
+		return nil, ErrMissingTelegramChatID
+	}
+
+	switch cfg.ParseMode {
+	case "HTML", "Markdown", "MarkdownV2":
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrInvalidTelegramParseMode, cfg.ParseMode)
+	}
+
+	if threadID := strings.TrimSpace(headers["message_thread_id"]); threadID != "" {
+		id, err := strconv.ParseInt(threadID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("telegram: invalid message_thread_id %q: %w", threadID, err)
+		}
+		cfg.MessageThreadID = id
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,176 @@
+package publishing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/notification/template"
+)
+
+func newTestTelegramClient() TelegramBotClient {
+	return NewTelegramBotClient(TelegramClientConfig{ChatRateLimit: 1000, MaxRetries: 2}, NewTelegramMetrics(), slog.Default())
+}
+
+// fakeTelegramAPI is a minimal Bot API sendMessage stand-in
+type fakeTelegramAPI struct {
+	mu        sync.Mutex
+	requests  []TelegramSendMessageRequest
+	paths     []string
+	nextID    int64
+	floodOnce atomic.Bool
+}
+
+func (f *fakeTelegramAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req TelegramSendMessageRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if f.floodOnce.CompareAndSwap(true, false) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, `{"ok":false,"error_code":429,"description":"Too Many Requests: retry after 1","parameters":{"retry_after":1}}`)
+		return
+	}
+
+	f.mu.Lock()
+	f.nextID++
+	id := f.nextID
+	f.requests = append(f.requests, req)
+	f.paths = append(f.paths, r.URL.Path)
+	f.mu.Unlock()
+
+	fmt.Fprintf(w, `{"ok":true,"result":{"message_id":%d}}`, id)
+}
+
+func (f *fakeTelegramAPI) snapshot() ([]TelegramSendMessageRequest, []string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]TelegramSendMessageRequest(nil), f.requests...), append([]string(nil), f.paths...)
+}
+
+func TestTelegramClient_FloodControl(t *testing.T) {
+	api := &fakeTelegramAPI{}
+	api.floodOnce.Store(true)
+	server := httptest.NewServer(api)
+	defer server.Close()
+
+	start := time.Now()
+	result, err := newTestTelegramClient().SendMessage(context.Background(), server.URL, "123:abc", &TelegramSendMessageRequest{
+		ChatID: "-100",
+		Text:   strings.Repeat("a", telegramMaxMessageLength+10),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), result.MessageID)
+	assert.GreaterOrEqual(t, time.Since(start), time.Second, "client must wait retry_after before retrying")
+
+	requests, paths := api.snapshot()
+	require.Len(t, requests, 1)
+	assert.Equal(t, "/bot123:abc/sendMessage", paths[0])
+	assert.Len(t, []rune(requests[0].Text), telegramMaxMessageLength, "text must be truncated to Telegram limit")
+}
+
+func TestTelegramClient_Errors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"ok":false,"error_code":400,"description":"Bad Request: chat not found"}`)
+	}))
+	defer server.Close()
+
+	client := newTestTelegramClient()
+	_, err := client.SendMessage(context.Background(), server.URL, "123:abc", &TelegramSendMessageRequest{ChatID: "42", Text: "hi"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "chat not found")
+	assert.False(t, IsTelegramRetryableError(err))
+	assert.Equal(t, QueueErrorTypePermanent, classifyPublishingError(err))
+
+	_, err = client.SendMessage(context.Background(), server.URL, "", &TelegramSendMessageRequest{ChatID: "42"})
+	assert.ErrorIs(t, err, ErrMissingTelegramBotToken)
+
+	// Transport errors must not leak the bot token
+	_, err = client.SendMessage(context.Background(), "http://127.0.0.1:1", "123:secret", &TelegramSendMessageRequest{ChatID: "42", Text: "hi"})
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "secret")
+}
+
+func TestEnhancedTelegramPublisher_Threading(t *testing.T) {
+	api := &fakeTelegramAPI{}
+	server := httptest.NewServer(api)
+	defer server.Close()
+
+	engine, err := template.NewNotificationTemplateEngine(template.DefaultTemplateEngineOptions())
+	require.NoError(t, err)
+	cache := NewMessageCache()
+	publisher := NewEnhancedTelegramPublisher(newTestTelegramClient(), cache, engine, NewTelegramMetrics(), slog.Default())
+
+	target := &core.PublishingTarget{
+		Name: "tg-oncall",
+		Type: "telegram",
+		URL:  server.URL,
+		Headers: map[string]string{
+			"bot_token":            "123:abc",
+			"chat_id":              "-1001234",
+			"message_thread_id":    "7",
+			"disable_notification": "true",
+		},
+	}
+
+	ctx := context.Background()
+	require.NoError(t, publisher.Publish(ctx, newEmailTestAlert(core.StatusFiring), target))
+	require.NoError(t, publisher.Publish(ctx, newEmailTestAlert(core.StatusResolved), target))
+
+	requests, _ := api.snapshot()
+	require.Len(t, requests, 2)
+
+	firing := requests[0]
+	assert.Equal(t, "-1001234", firing.ChatID)
+	assert.Equal(t, int64(7), firing.MessageThreadID)
+	assert.Equal(t, "HTML", firing.ParseMode)
+	assert.True(t, firing.DisableNotification)
+	assert.Contains(t, firing.Text, "<b>ALERT: HighCPU</b>")
+	assert.Nil(t, firing.ReplyParameters)
+
+	resolved := requests[1]
+	assert.Contains(t, resolved.Text, "<b>RESOLVED: HighCPU</b>")
+	require.NotNil(t, resolved.ReplyParameters, "resolved notification must reply to the firing message")
+	assert.Equal(t, int64(1), resolved.ReplyParameters.MessageID)
+	assert.True(t, resolved.ReplyParameters.AllowSendingWithoutReply)
+
+	assert.Zero(t, cache.Size(), "thread entry must be dropped after resolve")
+}
+
+func TestParseTelegramTargetConfig(t *testing.T) {
+	cfg, err := parseTelegramTargetConfig(&core.PublishingTarget{
+		Headers: map[string]string{"bot_token": "t", "chat_id": "1", "parse_mode": "MarkdownV2", "send_resolved": "false"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "MarkdownV2", cfg.ParseMode)
+	assert.False(t, cfg.SendResolved)
+	assert.Zero(t, cfg.MessageThreadID)
+
+	_, err = parseTelegramTargetConfig(&core.PublishingTarget{Headers: map[string]string{"bot_token": "t"}})
+	assert.ErrorIs(t, err, ErrMissingTelegramChatID)
+
+	_, err = parseTelegramTargetConfig(&core.PublishingTarget{
+		Headers: map[string]string{"bot_token": "t", "chat_id": "1", "parse_mode": "BBCode"},
+	})
+	assert.ErrorIs(t, err, ErrInvalidTelegramParseMode)
+
+	_, err = parseTelegramTargetConfig(&core.PublishingTarget{
+		Headers: map[string]string{"bot_token": "t", "chat_id": "1", "message_thread_id": "abc"},
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,57 @@
+package publishing
+
+import (
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/notification/template"
+)
+
+// newAlertTemplateData converts enriched alert to template data (single-alert group)
+// Shared by template-based publishers (email, Microsoft Teams, Telegram)
+func newAlertTemplateData(enrichedAlert *core.EnrichedAlert, target *core.PublishingTarget, receiverType string) *template.TemplateData {
+	alert := enrichedAlert.Alert
+
+	labels := make(map[string]string, len(alert.Labels)+1)
+	for key, value := range alert.Labels {
+		labels[key] = value
+	}
+	if _, ok := labels["alertname"]; !ok && alert.AlertName != "" {
+		labels["alertname"] = alert.AlertName
+	}
+	if enrichedAlert.Classification != nil {
+		labels["ai_severity"] = string(enrichedAlert.Classification.Severity)
+	}
+
+	data := template.NewTemplateData(string(alert.Status), labels, alert.Annotations, alert.StartsAt).
+		WithGroupInfo(map[string]string{"alertname": labels["alertname"]}, labels, alert.Annotations, alert.Fingerprint).
+		WithReceiver(target.Name, receiverType).
+		WithFingerprint(alert.Fingerprint)
+
+	item := template.Alert{
+		Status:      string(alert.Status),
+		Labels:      labels,
+		Annotations: data.Annotations,
+		StartsAt:    alert.StartsAt,
+		Fingerprint: alert.Fingerprint,
+	}
+	if alert.EndsAt != nil {
+		data.WithEndsAt(*alert.EndsAt)
+		item.EndsAt = *alert.EndsAt
+	}
+	if alert.GeneratorURL != nil {
+		data.WithGeneratorURL(*alert.GeneratorURL)
+		item.GeneratorURL = *alert.GeneratorURL
+	}
+	data.Alerts = []template.Alert{item}
+
+	if summary, ok := enrichedAlert.EnrichmentMetadata[core.GroupSummaryMetadataKey].(*core.GroupSummary); ok && summary != nil {
+		data.WithGroupSummary(&template.GroupSummary{
+			Summary:         summary.Summary,
+			RootCause:       summary.RootCause,
+			Recommendations: summary.Recommendations,
+			Confidence:      summary.Confidence,
+			Source:          string(summary.Source),
+		})
+	}
+
+	return data
+}
@@ -0,0 +1,419 @@
+package publishing
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"gopkg.in/yaml.v3"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/core/domain"
+	"github.com/vitaliisemenov/alert-history/internal/notification/template"
+)
+
+// TemplateSource provides managed templates (business/template.TemplateManager)
+type TemplateSource interface {
+	// GetTemplate returns the current version of a template
+	GetTemplate(ctx context.Context, name string) (*domain.Template, error)
+
+	// GetVersion returns a historical version of a template
+	GetVersion(ctx context.Context, name string, version int) (*domain.TemplateVersion, error)
+}
+
+// PayloadTemplate is the content of a managed template rendering publisher
+// payloads. Every string is a Go template executed by the notification
+// template engine with the alert's TemplateData:
+//
+//	title: "{{ .Labels.alertname }} is {{ .Status | toUpper }}"
+//	text: "{{ .Annotations.description }}"
+//	fields:
+//	  - title: Severity
+//	    value: "{{ .Labels.severity }}"
+//	details:
+//	  runbook: "{{ .Annotations.runbook_url }}"
+type PayloadTemplate struct {
+	Title   string                 `yaml:"title"`
+	Text    string                 `yaml:"text"`
+	Fields  []PayloadTemplateField `yaml:"fields"`
+	Details map[string]string      `yaml:"details"`
+}
+
+// PayloadTemplateField is a titled field of a payload template
+type PayloadTemplateField struct {
+	Title string `yaml:"title"`
+	Value string `yaml:"value"`
+}
+
+// ParsePayloadTemplate parses managed template content (YAML or JSON)
+func ParsePayloadTemplate(content string) (*PayloadTemplate, error) {
+	decoder := yaml.NewDecoder(strings.NewReader(content))
+	decoder.KnownFields(true)
+
+	var payloadTemplate PayloadTemplate
+	if err := decoder.Decode(&payloadTemplate); err != nil {
+		return nil, fmt.Errorf("invalid payload template: %w", err)
+	}
+	if payloadTemplate.Title == "" && payloadTemplate.Text == "" &&
+		len(payloadTemplate.Fields) == 0 && len(payloadTemplate.Details) == 0 {
+		return nil, errors.New("invalid payload template: no title, text, fields or details")
+	}
+
+	return &payloadTemplate, nil
+}
+
+// RenderedPayload is a payload template rendered for an alert
+type RenderedPayload struct {
+	Title   string
+	Text    string
+	Fields  []RenderedField
+	Details map[string]string
+}
+
+// RenderedField is a rendered payload template field
+type RenderedField struct {
+	Title string
+	Value string
+}
+
+// TemplateRenderMetrics holds Prometheus metrics for managed template rendering
+type TemplateRenderMetrics struct {
+	// RendersTotal counts renders by template, version and result (rendered, fallback)
+	RendersTotal *prometheus.CounterVec
+
+	// RenderDuration tracks template fetch + render duration by template and version
+	RenderDuration *prometheus.HistogramVec
+}
+
+// NewTemplateRenderMetrics creates template render metrics, registered with registry if not nil
+func NewTemplateRenderMetrics(registry prometheus.Registerer) *TemplateRenderMetrics {
+	metrics := &TemplateRenderMetrics{
+		RendersTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "publishing_template_renders_total",
+				Help: "Total number of publisher payloads rendered from managed templates",
+			},
+			[]string{"template", "version", "result"},
+		),
+		RenderDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "publishing_template_render_duration_seconds",
+				Help:    "Duration of managed template rendering in seconds",
+				Buckets: []float64{.0001, .0005, .001, .005, .01, .05, .1, .5, 1},
+			},
+			[]string{"template", "version"},
+		),
+	}
+
+	if registry != nil {
+		registry.MustRegister(metrics.RendersTotal, metrics.RenderDuration)
+	}
+
+	return metrics
+}
+
+// TemplateRenderer renders managed templates referenced by publishing targets
+type TemplateRenderer struct {
+	source  TemplateSource
+	engine  template.NotificationTemplateEngine
+	metrics *TemplateRenderMetrics
+	logger  *slog.Logger
+}
+
+// NewTemplateRenderer creates a template renderer (metrics may be nil)
+func NewTemplateRenderer(source TemplateSource, engine template.NotificationTemplateEngine, metrics *TemplateRenderMetrics, logger *slog.Logger) *TemplateRenderer {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &TemplateRenderer{
+		source:  source,
+		engine:  engine,
+		metrics: metrics,
+		logger:  logger,
+	}
+}
+
+// Render renders the template referenced by target for an alert. It returns
+// the rendered payload and the template version used (0 if unresolved).
+func (r *TemplateRenderer) Render(ctx context.Context, enrichedAlert *core.EnrichedAlert, target *core.PublishingTarget, format core.PublishingFormat) (*RenderedPayload, int, error) {
+	content, version, err := r.fetch(ctx, target.Template, target.TemplateVersion)
+	if err != nil {
+		return nil, version, err
+	}
+
+	payloadTemplate, err := ParsePayloadTemplate(content)
+	if err != nil {
+		return nil, version, err
+	}
+
+	// Render all strings in one pass, keyed by their position
+	templates := make(map[string]string)
+	addTemplate := func(key, text string) {
+		if text != "" {
+			templates[key] = text
+		}
+	}
+	addTemplate("title", payloadTemplate.Title)
+	addTemplate("text", payloadTemplate.Text)
+	for i, field := range payloadTemplate.Fields {
+		addTemplate(fmt.Sprintf("fields.%d.title", i), field.Title)
+		addTemplate(fmt.Sprintf("fields.%d.value", i), field.Value)
+	}
+	for key, value := range payloadTemplate.Details {
+		addTemplate("details."+key, value)
+	}
+
+	data := newAlertTemplateData(enrichedAlert, target, string(format))
+	output, err := r.engine.ExecuteMultiple(ctx, templates, data)
+	if err != nil {
+		return nil, version, fmt.Errorf("failed to render template %s v%d: %w", target.Template, version, err)
+	}
+
+	// Missing map keys render as "<no value>", treated as empty
+	value := func(key string) string {
+		return strings.TrimSpace(strings.ReplaceAll(output[key], "<no value>", ""))
+	}
+	rendered := &RenderedPayload{
+		Title:   value("title"),
+		Text:    value("text"),
+		Details: make(map[string]string, len(payloadTemplate.Details)),
+	}
+	for i := range payloadTemplate.Fields {
+		field := RenderedField{
+			Title: value(fmt.Sprintf("fields.%d.title", i)),
+			Value: value(fmt.Sprintf("fields.%d.value", i)),
+		}
+		if field.Value != "" {
+			rendered.Fields = append(rendered.Fields, field)
+		}
+	}
+	for key := range payloadTemplate.Details {
+		if detail := value("details." + key); detail != "" {
+			rendered.Details[key] = detail
+		}
+	}
+
+	return rendered, version, nil
+}
+
+// fetch returns the template content, the current version if version is 0
+func (r *TemplateRenderer) fetch(ctx context.Context, name string, version int) (string, int, error) {
+	if version > 0 {
+		templateVersion, err := r.source.GetVersion(ctx, name, version)
+		if err != nil {
+			return "", version, fmt.Errorf("failed to get template %s v%d: %w", name, version, err)
+		}
+		return templateVersion.Content, templateVersion.Version, nil
+	}
+
+	tmpl, err := r.source.GetTemplate(ctx, name)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to get template %s: %w", name, err)
+	}
+	return tmpl.Content, tmpl.Version, nil
+}
+
+// record records a render result (rendered or fallback)
+func (r *TemplateRenderer) record(name string, version int, result string, duration time.Duration) {
+	if r.metrics == nil {
+		return
+	}
+	versionLabel := "unknown"
+	if version > 0 {
+		versionLabel = strconv.Itoa(version)
+	}
+	r.metrics.RendersTotal.WithLabelValues(name, versionLabel, result).Inc()
+	r.metrics.RenderDuration.WithLabelValues(name, versionLabel).Observe(duration.Seconds())
+}
+
+// templateFormats are the formats whose payloads can be rendered from managed templates
+var templateFormats = map[core.PublishingFormat]bool{
+	core.FormatSlack:     true,
+	core.FormatPagerDuty: true,
+	core.FormatRootly:    true,
+	core.FormatOpsgenie:  true,
+	core.FormatWebhook:   true,
+}
+
+// TemplatedFormatter renders the title/text/fields/details of a target's
+// payload from its managed template, over the payload built by next.
+// Template fetch and render errors fall back to the built-in payload.
+type TemplatedFormatter struct {
+	next     AlertFormatter
+	renderer *TemplateRenderer
+	target   *core.PublishingTarget
+}
+
+// NewTemplatedFormatter wraps next with the managed template of target
+func NewTemplatedFormatter(next AlertFormatter, renderer *TemplateRenderer, target *core.PublishingTarget) AlertFormatter {
+	return &TemplatedFormatter{
+		next:     next,
+		renderer: renderer,
+		target:   target,
+	}
+}
+
+// FormatAlert formats the alert with next and applies the rendered template
+func (f *TemplatedFormatter) FormatAlert(ctx context.Context, enrichedAlert *core.EnrichedAlert, format core.PublishingFormat) (map[string]any, error) {
+	payload, err := f.next.FormatAlert(ctx, enrichedAlert, format)
+	if err != nil || !templateFormats[format] {
+		return payload, err
+	}
+
+	start := time.Now()
+	rendered, version, err := f.renderer.Render(ctx, enrichedAlert, f.target, format)
+	if err != nil {
+		f.renderer.record(f.target.Template, version, "fallback", time.Since(start))
+		f.renderer.logger.Warn("Managed template render failed, using built-in format",
+			"target", f.target.Name,
+			"template", f.target.Template,
+			"version", version,
+			"error", err,
+		)
+		return payload, nil
+	}
+	f.renderer.record(f.target.Template, version, "rendered", time.Since(start))
+
+	applyRenderedPayload(payload, format, rendered)
+	return payload, nil
+}
+
+// applyRenderedPayload overlays the rendered template on a built-in payload
+func applyRenderedPayload(payload map[string]any, format core.PublishingFormat, rendered *RenderedPayload) {
+	switch format {
+	case core.FormatSlack:
+		applySlackTemplate(payload, rendered)
+	case core.FormatPagerDuty:
+		if body, ok := payload["payload"].(map[string]any); ok {
+			if rendered.Title != "" {
+				body["summary"] = truncateString(rendered.Title, 1024)
+			}
+			details, _ := body["custom_details"].(map[string]any)
+			if details == nil {
+				details = make(map[string]any)
+			}
+			if rendered.Text != "" {
+				details["text"] = rendered.Text
+			}
+			for _, field := range rendered.Fields {
+				details[field.Title] = field.Value
+			}
+			for key, value := range rendered.Details {
+				details[key] = value
+			}
+			body["custom_details"] = details
+		}
+	case core.FormatRootly:
+		if rendered.Title != "" {
+			payload["title"] = rendered.Title
+		}
+		if description := renderedDescription(rendered); description != "" {
+			payload["description"] = description
+		}
+		if len(rendered.Details) > 0 {
+			customFields := make(map[string]interface{}, len(rendered.Details))
+			for key, value := range rendered.Details {
+				customFields[key] = value
+			}
+			payload["custom_fields"] = customFields
+		}
+	case core.FormatOpsgenie:
+		if rendered.Title != "" {
+			payload["message"] = truncateString(rendered.Title, opsgenieMaxMessageLength)
+		}
+		if description := renderedDescription(rendered); description != "" {
+			payload["description"] = truncateString(description, opsgenieMaxDescriptionLength)
+		}
+		if details, ok := payload["details"].(map[string]string); ok {
+			for key, value := range rendered.Details {
+				details[key] = value
+			}
+		}
+	default:
+		payload["title"] = rendered.Title
+		payload["text"] = rendered.Text
+		fields := make([]map[string]any, 0, len(rendered.Fields))
+		for _, field := range rendered.Fields {
+			fields = append(fields, map[string]any{"title": field.Title, "value": field.Value})
+		}
+		payload["fields"] = fields
+		payload["details"] = rendered.Details
+	}
+}
+
+// applySlackTemplate replaces the Slack blocks with the rendered template,
+// keeping the attachment color and the fingerprint context
+func applySlackTemplate(payload map[string]any, rendered *RenderedPayload) {
+	var blocks []any
+	if rendered.Title != "" {
+		payload["text"] = rendered.Title
+		blocks = append(blocks, map[string]any{
+			"type": "header",
+			"text": map[string]any{"type": "plain_text", "text": truncateRunes(rendered.Title, 150)},
+		})
+	}
+	if rendered.Text != "" {
+		blocks = append(blocks, map[string]any{
+			"type": "section",
+			"text": map[string]any{"type": "mrkdwn", "text": truncateRunes(rendered.Text, 3000)},
+		})
+	}
+
+	fields := make([]any, 0, len(rendered.Fields)+len(rendered.Details))
+	for _, field := range rendered.Fields {
+		fields = append(fields, map[string]any{"type": "mrkdwn", "text": fmt.Sprintf("*%s:*\n%s", field.Title, field.Value)})
+	}
+	for _, key := range sortedKeys(rendered.Details) {
+		fields = append(fields, map[string]any{"type": "mrkdwn", "text": fmt.Sprintf("*%s:*\n%s", key, rendered.Details[key])})
+	}
+	if len(fields) > 10 {
+		fields = fields[:10] // Slack section limit
+	}
+	if len(fields) > 0 {
+		blocks = append(blocks, map[string]any{"type": "section", "fields": fields})
+	}
+
+	// Keep the built-in trailing blocks (divider, fingerprint context)
+	if builtIn, ok := payload["blocks"].([]map[string]any); ok {
+		for _, block := range builtIn {
+			if block["type"] == "divider" || block["type"] == "context" {
+				blocks = append(blocks, block)
+			}
+		}
+	}
+	payload["blocks"] = blocks
+
+	if attachments, ok := payload["attachments"].([]map[string]any); ok && len(attachments) > 0 {
+		payload["attachments"] = []any{map[string]any{"color": attachments[0]["color"]}}
+	}
+}
+
+// renderedDescription joins the rendered text and fields as Markdown
+func renderedDescription(rendered *RenderedPayload) string {
+	var description bytes.Buffer
+	description.WriteString(rendered.Text)
+	for i, field := range rendered.Fields {
+		if i == 0 && description.Len() > 0 {
+			description.WriteString("\n\n")
+		}
+		fmt.Fprintf(&description, "**%s:** %s\n", field.Title, field.Value)
+	}
+	return strings.TrimSpace(description.String())
+}
+
+// sortedKeys returns the keys of m in order
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,248 @@
+package publishing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/internal/core/domain"
+	"github.com/vitaliisemenov/alert-history/internal/notification/template"
+)
+
+// fakeTemplateSource serves template versions from memory (last version is current)
+type fakeTemplateSource struct {
+	versions map[string][]string
+}
+
+func (s *fakeTemplateSource) GetTemplate(ctx context.Context, name string) (*domain.Template, error) {
+	versions, ok := s.versions[name]
+	if !ok {
+		return nil, errors.New("template not found")
+	}
+	return &domain.Template{Name: name, Content: versions[len(versions)-1], Version: len(versions)}, nil
+}
+
+func (s *fakeTemplateSource) GetVersion(ctx context.Context, name string, version int) (*domain.TemplateVersion, error) {
+	versions, ok := s.versions[name]
+	if !ok || version > len(versions) {
+		return nil, errors.New("version not found")
+	}
+	return &domain.TemplateVersion{Content: versions[version-1], Version: version}, nil
+}
+
+const testPayloadTemplate = `
+title: "{{ .Labels.alertname }} is {{ .Status }}"
+text: "{{ .Annotations.summary }}"
+fields:
+  - title: Severity
+    value: "{{ .Labels.severity }}"
+  - title: Missing
+    value: "{{ .Labels.missing }}"
+details:
+  runbook: "https://runbooks/{{ .Labels.alertname }}"
+`
+
+func newTestTemplateRenderer(t *testing.T, versions map[string][]string) (*TemplateRenderer, *TemplateRenderMetrics) {
+	engine, err := template.NewNotificationTemplateEngine(template.DefaultTemplateEngineOptions())
+	require.NoError(t, err)
+	metrics := NewTemplateRenderMetrics(prometheus.NewRegistry())
+	return NewTemplateRenderer(&fakeTemplateSource{versions: versions}, engine, metrics, slog.Default()), metrics
+}
+
+func newTemplateTestAlert() *core.EnrichedAlert {
+	return &core.EnrichedAlert{
+		Alert: &core.Alert{
+			Fingerprint: "fp-1",
+			AlertName:   "HighCPU",
+			Status:      core.StatusFiring,
+			Labels:      map[string]string{"alertname": "HighCPU", "severity": "critical"},
+			Annotations: map[string]string{"summary": "CPU above 90%"},
+			StartsAt:    time.Date(2025, 12, 1, 10, 0, 0, 0, time.UTC),
+		},
+	}
+}
+
+func TestParsePayloadTemplate(t *testing.T) {
+	payloadTemplate, err := ParsePayloadTemplate(testPayloadTemplate)
+	require.NoError(t, err)
+	assert.Equal(t, "{{ .Labels.alertname }} is {{ .Status }}", payloadTemplate.Title)
+	assert.Len(t, payloadTemplate.Fields, 2)
+	assert.Equal(t, "https://runbooks/{{ .Labels.alertname }}", payloadTemplate.Details["runbook"])
+
+	// JSON is valid YAML
+	_, err = ParsePayloadTemplate(`{"title": "{{ .Status }}"}`)
+	assert.NoError(t, err)
+
+	for _, content := range []string{"", "titel: typo", "title: [unclosed", "{{ .Status }}"} {
+		_, err := ParsePayloadTemplate(content)
+		assert.Error(t, err, content)
+	}
+}
+
+func TestTemplatedFormatter_Formats(t *testing.T) {
+	renderer, metrics := newTestTemplateRenderer(t, map[string][]string{"cpu": {testPayloadTemplate}})
+	target := &core.PublishingTarget{Name: "target", Template: "cpu"}
+	formatter := NewTemplatedFormatter(NewAlertFormatter(), renderer, target)
+	ctx := context.Background()
+
+	t.Run("slack", func(t *testing.T) {
+		payload, err := formatter.FormatAlert(ctx, newTemplateTestAlert(), core.FormatSlack)
+		require.NoError(t, err)
+
+		message := (&EnhancedSlackPublisher{}).buildMessage(payload)
+		assert.Equal(t, "HighCPU is firing", message.Text)
+		require.GreaterOrEqual(t, len(message.Blocks), 3)
+		assert.Equal(t, "header", message.Blocks[0].Type)
+		assert.Equal(t, "HighCPU is firing", message.Blocks[0].Text.Text)
+		assert.Equal(t, "CPU above 90%", message.Blocks[1].Text.Text)
+		require.Len(t, message.Blocks[2].Fields, 2, "empty fields are dropped")
+		assert.Equal(t, "*Severity:*\ncritical", message.Blocks[2].Fields[0].Text)
+		assert.Equal(t, "*runbook:*\nhttps://runbooks/HighCPU", message.Blocks[2].Fields[1].Text)
+	})
+
+	t.Run("pagerduty", func(t *testing.T) {
+		payload, err := formatter.FormatAlert(ctx, newTemplateTestAlert(), core.FormatPagerDuty)
+		require.NoError(t, err)
+
+		event := (&EnhancedPagerDutyPublisher{}).buildPayload(payload)
+		assert.Equal(t, "HighCPU is firing", event.Summary)
+		assert.Equal(t, "critical", event.CustomDetails["Severity"])
+		assert.Equal(t, "https://runbooks/HighCPU", event.CustomDetails["runbook"])
+		assert.Equal(t, "fp-1", event.CustomDetails["fingerprint"], "built-in details are kept")
+	})
+
+	t.Run("rootly", func(t *testing.T) {
+		payload, err := formatter.FormatAlert(ctx, newTemplateTestAlert(), core.FormatRootly)
+		require.NoError(t, err)
+		assert.Equal(t, "HighCPU is firing", payload["title"])
+		assert.Equal(t, "CPU above 90%\n\n**Severity:** critical", payload["description"])
+		assert.Equal(t, map[string]interface{}{"runbook": "https://runbooks/HighCPU"}, payload["custom_fields"])
+	})
+
+	t.Run("webhook", func(t *testing.T) {
+		payload, err := formatter.FormatAlert(ctx, newTemplateTestAlert(), core.FormatWebhook)
+		require.NoError(t, err)
+		assert.Equal(t, "HighCPU is firing", payload["title"])
+		assert.Equal(t, "CPU above 90%", payload["text"])
+		assert.Equal(t, []map[string]any{{"title": "Severity", "value": "critical"}}, payload["fields"])
+		assert.Equal(t, "fp-1", payload["fingerprint"])
+	})
+
+	t.Run("alertmanager is not templated", func(t *testing.T) {
+		payload, err := formatter.FormatAlert(ctx, newTemplateTestAlert(), core.FormatAlertmanager)
+		require.NoError(t, err)
+		assert.NotContains(t, payload, "title")
+	})
+
+	assert.Equal(t, 4.0, testutil.ToFloat64(metrics.RendersTotal.WithLabelValues("cpu", "1", "rendered")))
+}
+
+func TestTemplatedFormatter_PinnedVersion(t *testing.T) {
+	renderer, metrics := newTestTemplateRenderer(t, map[string][]string{
+		"cpu": {`title: "v1 {{ .Status }}"`, `title: "v2 {{ .Status }}"`},
+	})
+	ctx := context.Background()
+
+	current := NewTemplatedFormatter(NewAlertFormatter(), renderer, &core.PublishingTarget{Template: "cpu"})
+	payload, err := current.FormatAlert(ctx, newTemplateTestAlert(), core.FormatWebhook)
+	require.NoError(t, err)
+	assert.Equal(t, "v2 firing", payload["title"])
+
+	pinned := NewTemplatedFormatter(NewAlertFormatter(), renderer, &core.PublishingTarget{Template: "cpu", TemplateVersion: 1})
+	payload, err = pinned.FormatAlert(ctx, newTemplateTestAlert(), core.FormatWebhook)
+	require.NoError(t, err)
+	assert.Equal(t, "v1 firing", payload["title"])
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.RendersTotal.WithLabelValues("cpu", "1", "rendered")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.RendersTotal.WithLabelValues("cpu", "2", "rendered")))
+}
+
+func TestTemplatedFormatter_GroupSummary(t *testing.T) {
+	renderer, _ := newTestTemplateRenderer(t, map[string][]string{
+		"storm": {`title: "{{ with .GroupSummary }}{{ .Summary }}{{ else }}{{ .Labels.alertname }}{{ end }}"`},
+	})
+	formatter := NewTemplatedFormatter(NewAlertFormatter(), renderer, &core.PublishingTarget{Template: "storm"})
+	ctx := context.Background()
+
+	payload, err := formatter.FormatAlert(ctx, newTemplateTestAlert(), core.FormatWebhook)
+	require.NoError(t, err)
+	assert.Equal(t, "HighCPU", payload["title"])
+
+	alert := newTemplateTestAlert()
+	alert.EnrichmentMetadata = map[string]any{
+		core.GroupSummaryMetadataKey: &core.GroupSummary{Summary: "80 nodes above 90% CPU", Source: core.GroupSummarySourceLLM},
+	}
+	payload, err = formatter.FormatAlert(ctx, alert, core.FormatWebhook)
+	require.NoError(t, err)
+	assert.Equal(t, "80 nodes above 90% CPU", payload["title"])
+}
+
+func TestTemplatedFormatter_Fallback(t *testing.T) {
+	renderer, metrics := newTestTemplateRenderer(t, map[string][]string{
+		"broken":  {`title: "{{ .Labels.alertname"`},
+		"invalid": {`not a payload template`},
+	})
+	ctx := context.Background()
+
+	builtIn, err := NewAlertFormatter().FormatAlert(ctx, newTemplateTestAlert(), core.FormatRootly)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		target  *core.PublishingTarget
+		version string
+	}{
+		{"missing template", &core.PublishingTarget{Template: "missing"}, "unknown"},
+		{"missing version", &core.PublishingTarget{Template: "broken", TemplateVersion: 7}, "7"},
+		{"render error", &core.PublishingTarget{Template: "broken"}, "1"},
+		{"invalid content", &core.PublishingTarget{Template: "invalid"}, "1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			formatter := NewTemplatedFormatter(NewAlertFormatter(), renderer, tt.target)
+			payload, err := formatter.FormatAlert(ctx, newTemplateTestAlert(), core.FormatRootly)
+
+			require.NoError(t, err)
+			assert.Equal(t, builtIn["title"], payload["title"])
+			assert.Equal(t, 1.0, testutil.ToFloat64(metrics.RendersTotal.WithLabelValues(tt.target.Template, tt.version, "fallback")))
+		})
+	}
+}
+
+func TestPublisherFactory_TemplatedTargets(t *testing.T) {
+	var received map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// Factory without registered metrics (NewPublisherFactory registers
+	// Prometheus collectors once per process)
+	factory := &PublisherFactory{formatter: NewAlertFormatter(), logger: slog.Default()}
+	target := &core.PublishingTarget{Name: "hook", Type: "webhook", URL: server.URL, Format: core.FormatWebhook, Template: "cpu"}
+
+	assert.Same(t, factory.formatter, factory.formatterFor(target), "templates are disabled until a renderer is set")
+
+	renderer, _ := newTestTemplateRenderer(t, map[string][]string{"cpu": {testPayloadTemplate}})
+	factory.SetTemplateRenderer(renderer)
+	assert.Same(t, factory.formatter, factory.formatterFor(&core.PublishingTarget{Name: "plain"}))
+
+	publisher, err := factory.CreateHTTPPublisherForTarget(target)
+	require.NoError(t, err)
+	require.NoError(t, publisher.Publish(context.Background(), newTemplateTestAlert(), target))
+	assert.Equal(t, "HighCPU is firing", received["title"])
+	assert.Equal(t, map[string]any{"runbook": "https://runbooks/HighCPU"}, received["details"])
+}
@@ -0,0 +1,391 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/pkg/history/filters"
+)
+
+// historyBackend is a history repository plus the alert storage it reads from.
+type historyBackend struct {
+	repo    core.AlertHistoryRepository
+	storage core.AlertStorage
+}
+
+// runHistoryConformance runs the AlertHistoryRepository contract against a backend.
+// newBackend must return an empty backend for every call.
+func runHistoryConformance(t *testing.T, newBackend func(t *testing.T) historyBackend) {
+	t.Run("AggregatedStats", func(t *testing.T) {
+		b := newBackend(t)
+		seedHistoryAlerts(t, b.storage)
+
+		stats, err := b.repo.GetAggregatedStats(context.Background(), nil)
+		if err != nil {
+			t.Fatalf("GetAggregatedStats() error = %v", err)
+		}
+
+		if stats.TotalAlerts != 4 || stats.UniqueFingerprints != 4 {
+			t.Errorf("Expected 4 alerts/4 fingerprints, got %d/%d", stats.TotalAlerts, stats.UniqueFingerprints)
+		}
+		if stats.FiringAlerts != 2 || stats.ResolvedAlerts != 2 {
+			t.Errorf("Expected 2 firing/2 resolved, got %d/%d", stats.FiringAlerts, stats.ResolvedAlerts)
+		}
+		expectCounts(t, "severity", stats.AlertsBySeverity, map[string]int64{"critical": 1, "warning": 2, "info": 1})
+		expectCounts(t, "namespace", stats.AlertsByNamespace, map[string]int64{"prod": 2, "staging": 2})
+		if stats.AvgResolutionTime == nil || *stats.AvgResolutionTime != 45*time.Minute {
+			t.Errorf("Expected avg resolution 45m, got %v", stats.AvgResolutionTime)
+		}
+	})
+
+	t.Run("AggregatedStatsTimeRange", func(t *testing.T) {
+		b := newBackend(t)
+		seedHistoryAlerts(t, b.storage)
+
+		from := time.Now().Add(-24 * time.Hour)
+		stats, err := b.repo.GetAggregatedStats(context.Background(), &core.TimeRange{From: &from})
+		if err != nil {
+			t.Fatalf("GetAggregatedStats() error = %v", err)
+		}
+
+		if stats.TotalAlerts != 3 || stats.ResolvedAlerts != 1 {
+			t.Errorf("Expected 3 alerts/1 resolved in the last 24h, got %d/%d", stats.TotalAlerts, stats.ResolvedAlerts)
+		}
+		if stats.AvgResolutionTime == nil || *stats.AvgResolutionTime != time.Hour {
+			t.Errorf("Expected avg resolution 1h, got %v", stats.AvgResolutionTime)
+		}
+	})
+
+	t.Run("AggregatedStatsEmpty", func(t *testing.T) {
+		b := newBackend(t)
+
+		stats, err := b.repo.GetAggregatedStats(context.Background(), nil)
+		if err != nil {
+			t.Fatalf("GetAggregatedStats() error = %v", err)
+		}
+		if stats.TotalAlerts != 0 || stats.AvgResolutionTime != nil {
+			t.Errorf("Expected empty stats, got %d alerts, avg %v", stats.TotalAlerts, stats.AvgResolutionTime)
+		}
+	})
+
+	t.Run("TopAlerts", func(t *testing.T) {
+		b := newBackend(t)
+		seedHistoryAlerts(t, b.storage)
+
+		top, err := b.repo.GetTopAlerts(context.Background(), nil, 10)
+		if err != nil {
+			t.Fatalf("GetTopAlerts() error = %v", err)
+		}
+
+		// Only firing alerts, most recently fired first on equal counts
+		if len(top) != 2 {
+			t.Fatalf("Expected 2 top alerts, got %d", len(top))
+		}
+		if top[0].Fingerprint != "fp-memory" || top[1].Fingerprint != "fp-cpu" {
+			t.Errorf("Unexpected order: %s, %s", top[0].Fingerprint, top[1].Fingerprint)
+		}
+		if top[0].FireCount != 1 || top[0].AlertName != "HighMemory" {
+			t.Errorf("Unexpected top alert: %+v", top[0])
+		}
+		if top[0].Namespace == nil || *top[0].Namespace != "prod" {
+			t.Errorf("Expected namespace prod, got %v", top[0].Namespace)
+		}
+		if top[0].AvgDuration == nil || *top[0].AvgDuration < 7200 {
+			t.Errorf("Expected avg duration >= 2h for a still firing alert, got %v", top[0].AvgDuration)
+		}
+
+		limited, err := b.repo.GetTopAlerts(context.Background(), nil, 1)
+		if err != nil {
+			t.Fatalf("GetTopAlerts() error = %v", err)
+		}
+		if len(limited) != 1 || limited[0].Fingerprint != "fp-memory" {
+			t.Errorf("Expected limit 1 to return fp-memory, got %d alerts", len(limited))
+		}
+
+		from := time.Now().Add(-150 * time.Minute)
+		ranged, err := b.repo.GetTopAlerts(context.Background(), &core.TimeRange{From: &from}, 10)
+		if err != nil {
+			t.Fatalf("GetTopAlerts() error = %v", err)
+		}
+		if len(ranged) != 1 || ranged[0].Fingerprint != "fp-memory" {
+			t.Errorf("Expected time range to return fp-memory only, got %d alerts", len(ranged))
+		}
+	})
+
+	t.Run("FlappingAlertsStable", func(t *testing.T) {
+		b := newBackend(t)
+		seedHistoryAlerts(t, b.storage)
+
+		flapping, err := b.repo.GetFlappingAlerts(context.Background(), nil, 1)
+		if err != nil {
+			t.Fatalf("GetFlappingAlerts() error = %v", err)
+		}
+		if len(flapping) != 0 {
+			t.Errorf("Expected no flapping alerts, got %d", len(flapping))
+		}
+	})
+
+	t.Run("AlertsByFingerprint", func(t *testing.T) {
+		b := newBackend(t)
+		seedHistoryAlerts(t, b.storage)
+
+		alerts, err := b.repo.GetAlertsByFingerprint(context.Background(), "fp-disk", 0)
+		if err != nil {
+			t.Fatalf("GetAlertsByFingerprint() error = %v", err)
+		}
+		if len(alerts) != 1 {
+			t.Fatalf("Expected 1 alert, got %d", len(alerts))
+		}
+		alert := alerts[0]
+		if alert.AlertName != "DiskFull" || alert.Status != core.StatusResolved {
+			t.Errorf("Unexpected alert: %s/%s", alert.AlertName, alert.Status)
+		}
+		if alert.Labels["namespace"] != "staging" || alert.Annotations["summary"] != "DiskFull" {
+			t.Errorf("Unexpected labels/annotations: %v %v", alert.Labels, alert.Annotations)
+		}
+		if alert.EndsAt == nil || alert.EndsAt.Sub(alert.StartsAt) != time.Hour {
+			t.Errorf("Expected 1h between starts_at and ends_at, got %v", alert.EndsAt)
+		}
+
+		if _, err := b.repo.GetAlertsByFingerprint(context.Background(), "", 10); err == nil {
+			t.Error("Expected error for empty fingerprint")
+		}
+
+		unknown, err := b.repo.GetAlertsByFingerprint(context.Background(), "fp-unknown", 10)
+		if err != nil || len(unknown) != 0 {
+			t.Errorf("Expected no alerts for unknown fingerprint, got %d (err=%v)", len(unknown), err)
+		}
+	})
+
+	t.Run("HistoryPaginationAndLabels", func(t *testing.T) {
+		b := newBackend(t)
+		seedHistoryAlerts(t, b.storage)
+
+		resp, err := b.repo.GetHistory(context.Background(), &core.HistoryRequest{
+			Pagination: &core.Pagination{Page: 1, PerPage: 3},
+		})
+		if err != nil {
+			t.Fatalf("GetHistory() error = %v", err)
+		}
+		if resp.Total != 4 || len(resp.Alerts) != 3 || resp.TotalPages != 2 || !resp.HasNext || resp.HasPrev {
+			t.Errorf("Unexpected page 1: total=%d alerts=%d pages=%d next=%v prev=%v",
+				resp.Total, len(resp.Alerts), resp.TotalPages, resp.HasNext, resp.HasPrev)
+		}
+
+		resp, err = b.repo.GetHistory(context.Background(), &core.HistoryRequest{
+			Filters:    &core.AlertFilters{Labels: map[string]string{"team": "storage"}},
+			Pagination: &core.Pagination{Page: 1, PerPage: 10},
+		})
+		if err != nil {
+			t.Fatalf("GetHistory() error = %v", err)
+		}
+		if resp.Total != 1 || len(resp.Alerts) != 1 || resp.Alerts[0].Fingerprint != "fp-disk" {
+			t.Errorf("Expected label filter to return fp-disk only, got total=%d", resp.Total)
+		}
+
+		if _, err := b.repo.GetHistory(context.Background(), &core.HistoryRequest{}); err == nil {
+			t.Error("Expected error for missing pagination")
+		}
+	})
+
+	t.Run("HistoryCursor", func(t *testing.T) {
+		b := newBackend(t)
+		seedHistoryAlerts(t, b.storage)
+
+		// Walk all alerts two at a time, newest first
+		var fingerprints []string
+		cursor := ""
+		for page := 0; page < 3; page++ {
+			c := cursor
+			resp, err := b.repo.GetHistory(context.Background(), &core.HistoryRequest{
+				Pagination: &core.Pagination{PerPage: 2, Cursor: &c},
+			})
+			if err != nil {
+				t.Fatalf("GetHistory() error = %v", err)
+			}
+			for _, alert := range resp.Alerts {
+				fingerprints = append(fingerprints, alert.Fingerprint)
+			}
+			if resp.HasNext != (resp.NextCursor != "") || resp.HasPrev != (cursor != "") {
+				t.Errorf("Unexpected page %d: next=%v cursor=%q prev=%v", page, resp.HasNext, resp.NextCursor, resp.HasPrev)
+			}
+			if resp.NextCursor == "" {
+				break
+			}
+			cursor = resp.NextCursor
+		}
+		want := []string{"fp-memory", "fp-cpu", "fp-disk", "fp-old"}
+		if len(fingerprints) != len(want) {
+			t.Fatalf("Expected %v, got %v", want, fingerprints)
+		}
+		for i := range want {
+			if fingerprints[i] != want[i] {
+				t.Errorf("Expected %v, got %v", want, fingerprints)
+				break
+			}
+		}
+
+		// Filters apply to every page
+		status := core.StatusFiring
+		first := ""
+		resp, err := b.repo.GetHistory(context.Background(), &core.HistoryRequest{
+			Filters:    &core.AlertFilters{Status: &status},
+			Pagination: &core.Pagination{PerPage: 1, Cursor: &first},
+		})
+		if err != nil {
+			t.Fatalf("GetHistory() error = %v", err)
+		}
+		if len(resp.Alerts) != 1 || resp.Alerts[0].Fingerprint != "fp-memory" || !resp.HasNext {
+			t.Fatalf("Unexpected first firing page: %d alerts, next=%v", len(resp.Alerts), resp.HasNext)
+		}
+		resp, err = b.repo.GetHistory(context.Background(), &core.HistoryRequest{
+			Filters:    &core.AlertFilters{Status: &status},
+			Pagination: &core.Pagination{PerPage: 1, Cursor: &resp.NextCursor},
+		})
+		if err != nil {
+			t.Fatalf("GetHistory() error = %v", err)
+		}
+		if len(resp.Alerts) != 1 || resp.Alerts[0].Fingerprint != "fp-cpu" || resp.HasNext {
+			t.Errorf("Unexpected last firing page: %d alerts, next=%v", len(resp.Alerts), resp.HasNext)
+		}
+
+		invalid := "invalid"
+		if _, err := b.repo.GetHistory(context.Background(), &core.HistoryRequest{
+			Pagination: &core.Pagination{PerPage: 2, Cursor: &invalid},
+		}); err == nil {
+			t.Error("Expected error for invalid cursor")
+		}
+	})
+
+	t.Run("ExportHistory", func(t *testing.T) {
+		b := newBackend(t)
+		seedHistoryAlerts(t, b.storage)
+		exporter, ok := b.repo.(interface {
+			ExportHistory(ctx context.Context, historyFilters []filters.Filter, fn func(alert *core.Alert) error) error
+		})
+		if !ok {
+			t.Fatal("Expected the repository to implement ExportHistory")
+		}
+
+		export := func(params map[string][]string) []string {
+			t.Helper()
+			historyFilters, err := filters.NewRegistry(nil).CreateFromQueryParams(params)
+			if err != nil {
+				t.Fatalf("CreateFromQueryParams() error = %v", err)
+			}
+			var fingerprints []string
+			if err := exporter.ExportHistory(context.Background(), historyFilters, func(alert *core.Alert) error {
+				fingerprints = append(fingerprints, alert.Fingerprint)
+				return nil
+			}); err != nil {
+				t.Fatalf("ExportHistory() error = %v", err)
+			}
+			return fingerprints
+		}
+
+		tests := []struct {
+			name   string
+			params map[string][]string
+			want   []string
+		}{
+			{"all_newest_first", nil, []string{"fp-memory", "fp-cpu", "fp-disk", "fp-old"}},
+			{"status_and_namespace", map[string][]string{"status": {"resolved"}, "namespace": {"staging"}}, []string{"fp-disk", "fp-old"}},
+			{"labels_exact", map[string][]string{"labels_exact[team]": {"storage"}}, []string{"fp-disk"}},
+			{"search", map[string][]string{"search": {"high"}}, []string{"fp-memory", "fp-cpu"}},
+			{"alert_name_pattern", map[string][]string{"alert_name_pattern": {"High%"}}, []string{"fp-memory", "fp-cpu"}},
+			{"no_match", map[string][]string{"severity": {"noise"}}, nil},
+		}
+		for _, tt := range tests {
+			got := export(tt.params)
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+			}
+		}
+
+		// An error returned by fn stops the export
+		stop := errors.New("client gone")
+		calls := 0
+		err := exporter.ExportHistory(context.Background(), nil, func(alert *core.Alert) error {
+			calls++
+			return stop
+		})
+		if !errors.Is(err, stop) || calls != 1 {
+			t.Errorf("Expected the export to stop after the first alert, got calls=%d err=%v", calls, err)
+		}
+	})
+
+	t.Run("RecentAlerts", func(t *testing.T) {
+		b := newBackend(t)
+		seedHistoryAlerts(t, b.storage)
+
+		alerts, err := b.repo.GetRecentAlerts(context.Background(), 2)
+		if err != nil {
+			t.Fatalf("GetRecentAlerts() error = %v", err)
+		}
+		if len(alerts) != 2 {
+			t.Errorf("Expected 2 recent alerts, got %d", len(alerts))
+		}
+	})
+}
+
+// seedHistoryAlerts stores 2 firing and 2 resolved alerts:
+//
+//	fp-cpu     firing    critical  prod     started 3h ago
+//	fp-memory  firing    warning   prod     started 2h ago
+//	fp-disk    resolved  warning   staging  started 5h ago, resolved after 1h
+//	fp-old     resolved  info      staging  started 48h ago, resolved after 30m
+func seedHistoryAlerts(t *testing.T, storage core.AlertStorage) {
+	t.Helper()
+
+	// Millisecond precision is the common denominator of both backends
+	now := time.Now().Truncate(time.Millisecond)
+	diskEnd := now.Add(-4 * time.Hour)
+	oldEnd := now.Add(-47*time.Hour - 30*time.Minute)
+
+	alerts := []*core.Alert{
+		newHistoryAlert("fp-cpu", "HighCPU", core.StatusFiring, "critical", "prod", now.Add(-3*time.Hour), nil),
+		newHistoryAlert("fp-memory", "HighMemory", core.StatusFiring, "warning", "prod", now.Add(-2*time.Hour), nil),
+		newHistoryAlert("fp-disk", "DiskFull", core.StatusResolved, "warning", "staging", now.Add(-5*time.Hour), &diskEnd),
+		newHistoryAlert("fp-old", "OldAlert", core.StatusResolved, "info", "staging", now.Add(-48*time.Hour), &oldEnd),
+	}
+	alerts[2].Labels["team"] = "storage"
+
+	for _, alert := range alerts {
+		if err := storage.SaveAlert(context.Background(), alert); err != nil {
+			t.Fatalf("Failed to save alert %s: %v", alert.Fingerprint, err)
+		}
+	}
+}
+
+func newHistoryAlert(fingerprint, name string, status core.AlertStatus, severity, namespace string, startsAt time.Time, endsAt *time.Time) *core.Alert {
+	return &core.Alert{
+		Fingerprint: fingerprint,
+		AlertName:   name,
+		Status:      status,
+		Labels: map[string]string{
+			"alertname": name,
+			"severity":  severity,
+			"namespace": namespace,
+		},
+		Annotations: map[string]string{"summary": name},
+		StartsAt:    startsAt,
+		EndsAt:      endsAt,
+	}
+}
+
+func expectCounts(t *testing.T, name string, got, want map[string]int64) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Errorf("Expected %s counts %v, got %v", name, want, got)
+		return
+	}
+	for key, count := range want {
+		if got[key] != count {
+			t.Errorf("Expected %s[%s] = %d, got %d", name, key, count, got[key])
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"github.com/vitaliisemenov/alert-history/internal/core"
+)
+
+// parseHistoryCursor returns the position to continue after, nil for the
+// first page of keyset pagination.
+func parseHistoryCursor(pagination *core.Pagination) (*core.HistoryCursor, error) {
+	if pagination.Cursor == nil || *pagination.Cursor == "" {
+		return nil, nil
+	}
+	return core.DecodeHistoryCursor(*pagination.Cursor)
+}
+
+// newCursorHistoryResponse builds a keyset page from alerts queried with
+// LIMIT per_page+1: the extra row only signals that a next page exists.
+func newCursorHistoryResponse(alerts []*core.Alert, pagination *core.Pagination) *core.HistoryResponse {
+	response := &core.HistoryResponse{
+		PerPage: pagination.PerPage,
+		HasPrev: *pagination.Cursor != "",
+	}
+
+	if len(alerts) > pagination.PerPage {
+		alerts = alerts[:pagination.PerPage]
+		response.HasNext = true
+		response.NextCursor = core.NewHistoryCursor(alerts[len(alerts)-1]).Encode()
+	}
+	if alerts == nil {
+		alerts = []*core.Alert{}
+	}
+	response.Alerts = alerts
+
+	return response
+}
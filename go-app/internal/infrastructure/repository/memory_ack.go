@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+)
+
+// MemoryAckRepository implements core.AckRepository in memory.
+//
+// Used when PostgreSQL is not available (Lite profile): acknowledgements
+// are lost on restart and not shared between replicas.
+type MemoryAckRepository struct {
+	mu     sync.RWMutex
+	acks   map[core.AckTargetType]map[string]*core.AlertAck
+	events []*core.AckEvent
+	nextID int64
+}
+
+// NewMemoryAckRepository creates a new in-memory acknowledgement repository.
+func NewMemoryAckRepository() *MemoryAckRepository {
+	return &MemoryAckRepository{
+		acks: map[core.AckTargetType]map[string]*core.AlertAck{
+			core.AckTargetAlert: {},
+			core.AckTargetGroup: {},
+		},
+	}
+}
+
+// GetAck implements core.AckRepository.GetAck.
+func (r *MemoryAckRepository) GetAck(ctx context.Context, targetType core.AckTargetType, target string) (*core.AlertAck, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ack, ok := r.acks[targetType][target]
+	if !ok {
+		return nil, core.ErrAckNotFound
+	}
+	copied := *ack
+	return &copied, nil
+}
+
+// ListAcks implements core.AckRepository.ListAcks.
+func (r *MemoryAckRepository) ListAcks(ctx context.Context, targetType core.AckTargetType, targets []string) (map[string]*core.AlertAck, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make(map[string]*core.AlertAck, len(targets))
+	for _, target := range targets {
+		if ack, ok := r.acks[targetType][target]; ok {
+			copied := *ack
+			result[target] = &copied
+		}
+	}
+	return result, nil
+}
+
+// ListActiveAcks implements core.AckRepository.ListActiveAcks.
+func (r *MemoryAckRepository) ListActiveAcks(ctx context.Context, t time.Time) ([]*core.AlertAck, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []*core.AlertAck
+	for _, acks := range r.acks {
+		for _, ack := range acks {
+			if ack.IsActive(t) {
+				copied := *ack
+				result = append(result, &copied)
+			}
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].AckedAt.After(*result[j].AckedAt)
+	})
+	return result, nil
+}
+
+// SaveAck implements core.AckRepository.SaveAck.
+func (r *MemoryAckRepository) SaveAck(ctx context.Context, state *core.AlertAck, event *core.AckEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if state != nil {
+		acks, ok := r.acks[state.TargetType]
+		if !ok {
+			return core.ErrInvalidAckTarget
+		}
+		copied := *state
+		acks[state.Target] = &copied
+	}
+
+	r.nextID++
+	event.ID = r.nextID
+	copied := *event
+	r.events = append(r.events, &copied)
+	return nil
+}
+
+// ListAckEvents implements core.AckRepository.ListAckEvents.
+func (r *MemoryAckRepository) ListAckEvents(ctx context.Context, targetType core.AckTargetType, target string, limit int) ([]*core.AckEvent, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if limit <= 0 {
+		limit = 100
+	}
+
+	var result []*core.AckEvent
+	for i := len(r.events) - 1; i >= 0 && len(result) < limit; i-- {
+		if event := r.events[i]; event.TargetType == targetType && event.Target == target {
+			copied := *event
+			result = append(result, &copied)
+		}
+	}
+	return result, nil
+}
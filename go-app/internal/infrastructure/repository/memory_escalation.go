@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+)
+
+// MemoryEscalationRepository implements core.EscalationRepository in memory.
+//
+// Used when PostgreSQL is not available (Lite profile): pending escalation
+// levels are lost on restart.
+type MemoryEscalationRepository struct {
+	mu          sync.RWMutex
+	escalations map[string]*core.AlertEscalation
+}
+
+// NewMemoryEscalationRepository creates a new in-memory escalation repository.
+func NewMemoryEscalationRepository() *MemoryEscalationRepository {
+	return &MemoryEscalationRepository{
+		escalations: make(map[string]*core.AlertEscalation),
+	}
+}
+
+// GetEscalation implements core.EscalationRepository.GetEscalation.
+func (r *MemoryEscalationRepository) GetEscalation(ctx context.Context, fingerprint string) (*core.AlertEscalation, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	escalation, ok := r.escalations[fingerprint]
+	if !ok {
+		return nil, core.ErrEscalationNotFound
+	}
+	return copyEscalation(escalation), nil
+}
+
+// SaveEscalation implements core.EscalationRepository.SaveEscalation.
+func (r *MemoryEscalationRepository) SaveEscalation(ctx context.Context, escalation *core.AlertEscalation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.escalations[escalation.Fingerprint] = copyEscalation(escalation)
+	return nil
+}
+
+// AdvanceEscalation implements core.EscalationRepository.AdvanceEscalation.
+func (r *MemoryEscalationRepository) AdvanceEscalation(ctx context.Context, escalation *core.AlertEscalation, fromLevel int) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.escalations[escalation.Fingerprint]
+	if !ok || !stored.IsActive() || stored.Level != fromLevel {
+		return false, nil
+	}
+	stored.State = escalation.State
+	stored.Level = escalation.Level
+	stored.NextAt = escalation.NextAt
+	stored.LastNotifiedAt = escalation.LastNotifiedAt
+	stored.UpdatedAt = escalation.UpdatedAt
+	return true, nil
+}
+
+// ListEscalations implements core.EscalationRepository.ListEscalations.
+func (r *MemoryEscalationRepository) ListEscalations(ctx context.Context, state core.EscalationState) ([]*core.AlertEscalation, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []*core.AlertEscalation
+	for _, escalation := range r.escalations {
+		if escalation.State == state {
+			result = append(result, copyEscalation(escalation))
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].StartedAt.Before(result[j].StartedAt)
+	})
+	return result, nil
+}
+
+// DeleteInactiveEscalations implements core.EscalationRepository.DeleteInactiveEscalations.
+func (r *MemoryEscalationRepository) DeleteInactiveEscalations(ctx context.Context, before time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	deleted := 0
+	for fingerprint, escalation := range r.escalations {
+		if !escalation.IsActive() && escalation.UpdatedAt.Before(before) {
+			delete(r.escalations, fingerprint)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// copyEscalation returns a copy that doesn't share labels with the original.
+func copyEscalation(escalation *core.AlertEscalation) *core.AlertEscalation {
+	copied := *escalation
+	if escalation.Labels != nil {
+		copied.Labels = make(map[string]string, len(escalation.Labels))
+		for name, value := range escalation.Labels {
+			copied.Labels[name] = value
+		}
+	}
+	return &copied
+}
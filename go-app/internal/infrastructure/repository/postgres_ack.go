@@ -0,0 +1,223 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vitaliisemenov/alert-history/internal/core"
+)
+
+// PostgresAckRepository implements core.AckRepository on the alert_acks and
+// alert_ack_events tables (migration 20251129000000_create_alert_acks).
+type PostgresAckRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewPostgresAckRepository creates a new PostgreSQL acknowledgement repository.
+func NewPostgresAckRepository(pool *pgxpool.Pool, logger *slog.Logger) *PostgresAckRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &PostgresAckRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+const ackColumns = `
+	target_type, target, acknowledged,
+	COALESCE(acked_by, ''), acked_at, expires_at,
+	COALESCE(owner, ''), COALESCE(assigned_by, ''), assigned_at,
+	updated_at`
+
+// GetAck implements core.AckRepository.GetAck.
+func (r *PostgresAckRepository) GetAck(ctx context.Context, targetType core.AckTargetType, target string) (*core.AlertAck, error) {
+	query := `SELECT ` + ackColumns + ` FROM alert_acks WHERE target_type = $1 AND target = $2`
+
+	ack, err := scanAck(r.pool.QueryRow(ctx, query, targetType, target))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, core.ErrAckNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get acknowledgement: %w", err)
+	}
+	return ack, nil
+}
+
+// ListAcks implements core.AckRepository.ListAcks.
+func (r *PostgresAckRepository) ListAcks(ctx context.Context, targetType core.AckTargetType, targets []string) (map[string]*core.AlertAck, error) {
+	result := make(map[string]*core.AlertAck, len(targets))
+	if len(targets) == 0 {
+		return result, nil
+	}
+
+	query := `SELECT ` + ackColumns + ` FROM alert_acks WHERE target_type = $1 AND target = ANY($2)`
+	rows, err := r.pool.Query(ctx, query, targetType, targets)
+	if err != nil {
+		return nil, fmt.Errorf("list acknowledgements: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		ack, err := scanAck(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan acknowledgement: %w", err)
+		}
+		result[ack.Target] = ack
+	}
+	return result, rows.Err()
+}
+
+// ListActiveAcks implements core.AckRepository.ListActiveAcks.
+func (r *PostgresAckRepository) ListActiveAcks(ctx context.Context, t time.Time) ([]*core.AlertAck, error) {
+	query := `SELECT ` + ackColumns + ` FROM alert_acks
+		WHERE acknowledged AND (expires_at IS NULL OR expires_at > $1)
+		ORDER BY acked_at DESC`
+
+	rows, err := r.pool.Query(ctx, query, t)
+	if err != nil {
+		return nil, fmt.Errorf("list active acknowledgements: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*core.AlertAck
+	for rows.Next() {
+		ack, err := scanAck(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan acknowledgement: %w", err)
+		}
+		result = append(result, ack)
+	}
+	return result, rows.Err()
+}
+
+// SaveAck implements core.AckRepository.SaveAck.
+func (r *PostgresAckRepository) SaveAck(ctx context.Context, state *core.AlertAck, event *core.AckEvent) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if state != nil {
+		_, err = tx.Exec(ctx, `
+			INSERT INTO alert_acks (
+				target_type, target, acknowledged, acked_by, acked_at, expires_at,
+				owner, assigned_by, assigned_at, updated_at
+			) VALUES ($1, $2, $3, NULLIF($4, ''), $5, $6, NULLIF($7, ''), NULLIF($8, ''), $9, $10)
+			ON CONFLICT (target_type, target) DO UPDATE SET
+				acknowledged = EXCLUDED.acknowledged,
+				acked_by = EXCLUDED.acked_by,
+				acked_at = EXCLUDED.acked_at,
+				expires_at = EXCLUDED.expires_at,
+				owner = EXCLUDED.owner,
+				assigned_by = EXCLUDED.assigned_by,
+				assigned_at = EXCLUDED.assigned_at,
+				updated_at = EXCLUDED.updated_at`,
+			state.TargetType,
+			state.Target,
+			state.Acknowledged,
+			state.AckedBy,
+			state.AckedAt,
+			state.ExpiresAt,
+			state.Owner,
+			state.AssignedBy,
+			state.AssignedAt,
+			state.UpdatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("store acknowledgement: %w", err)
+		}
+	}
+
+	err = tx.QueryRow(ctx, `
+		INSERT INTO alert_ack_events (target_type, target, action, actor, comment, owner, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, NULLIF($5, ''), NULLIF($6, ''), $7, $8)
+		RETURNING id`,
+		event.TargetType,
+		event.Target,
+		event.Action,
+		event.Actor,
+		event.Comment,
+		event.Owner,
+		event.ExpiresAt,
+		event.CreatedAt,
+	).Scan(&event.ID)
+	if err != nil {
+		return fmt.Errorf("store acknowledgement event: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit acknowledgement: %w", err)
+	}
+	return nil
+}
+
+// ListAckEvents implements core.AckRepository.ListAckEvents.
+func (r *PostgresAckRepository) ListAckEvents(ctx context.Context, targetType core.AckTargetType, target string, limit int) ([]*core.AckEvent, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT id, target_type, target, action, actor,
+			COALESCE(comment, ''), COALESCE(owner, ''), expires_at, created_at
+		FROM alert_ack_events
+		WHERE target_type = $1 AND target = $2
+		ORDER BY created_at DESC, id DESC
+		LIMIT $3`,
+		targetType, target, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list acknowledgement events: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*core.AckEvent
+	for rows.Next() {
+		event := &core.AckEvent{}
+		if err := rows.Scan(
+			&event.ID,
+			&event.TargetType,
+			&event.Target,
+			&event.Action,
+			&event.Actor,
+			&event.Comment,
+			&event.Owner,
+			&event.ExpiresAt,
+			&event.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan acknowledgement event: %w", err)
+		}
+		result = append(result, event)
+	}
+	return result, rows.Err()
+}
+
+// scanAck scans an alert_acks row selected with ackColumns.
+func scanAck(row pgx.Row) (*core.AlertAck, error) {
+	ack := &core.AlertAck{}
+	err := row.Scan(
+		&ack.TargetType,
+		&ack.Target,
+		&ack.Acknowledged,
+		&ack.AckedBy,
+		&ack.AckedAt,
+		&ack.ExpiresAt,
+		&ack.Owner,
+		&ack.AssignedBy,
+		&ack.AssignedAt,
+		&ack.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return ack, nil
+}
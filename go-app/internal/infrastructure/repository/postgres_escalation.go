@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vitaliisemenov/alert-history/internal/core"
+)
+
+// PostgresEscalationRepository implements core.EscalationRepository on the
+// alert_escalations table (migration 20251130000000_create_alert_escalations).
+type PostgresEscalationRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewPostgresEscalationRepository creates a new PostgreSQL escalation repository.
+func NewPostgresEscalationRepository(pool *pgxpool.Pool, logger *slog.Logger) *PostgresEscalationRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &PostgresEscalationRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+const escalationColumns = `
+	fingerprint, alert_name, labels, policy,
+	state, level, started_at, next_at, last_notified_at, stopped_at,
+	updated_at`
+
+// GetEscalation implements core.EscalationRepository.GetEscalation.
+func (r *PostgresEscalationRepository) GetEscalation(ctx context.Context, fingerprint string) (*core.AlertEscalation, error) {
+	query := `SELECT ` + escalationColumns + ` FROM alert_escalations WHERE fingerprint = $1`
+
+	escalation, err := scanEscalation(r.pool.QueryRow(ctx, query, fingerprint))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, core.ErrEscalationNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get escalation: %w", err)
+	}
+	return escalation, nil
+}
+
+// SaveEscalation implements core.EscalationRepository.SaveEscalation.
+func (r *PostgresEscalationRepository) SaveEscalation(ctx context.Context, escalation *core.AlertEscalation) error {
+	labels, err := json.Marshal(escalation.Labels)
+	if err != nil {
+		return fmt.Errorf("marshal escalation labels: %w", err)
+	}
+
+	_, err = r.pool.Exec(ctx, `
+		INSERT INTO alert_escalations (
+			fingerprint, alert_name, labels, policy,
+			state, level, started_at, next_at, last_notified_at, stopped_at,
+			updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (fingerprint) DO UPDATE SET
+			alert_name = EXCLUDED.alert_name,
+			labels = EXCLUDED.labels,
+			policy = EXCLUDED.policy,
+			state = EXCLUDED.state,
+			level = EXCLUDED.level,
+			started_at = EXCLUDED.started_at,
+			next_at = EXCLUDED.next_at,
+			last_notified_at = EXCLUDED.last_notified_at,
+			stopped_at = EXCLUDED.stopped_at,
+			updated_at = EXCLUDED.updated_at`,
+		escalation.Fingerprint,
+		escalation.AlertName,
+		labels,
+		escalation.Policy,
+		escalation.State,
+		escalation.Level,
+		escalation.StartedAt,
+		escalation.NextAt,
+		escalation.LastNotifiedAt,
+		escalation.StoppedAt,
+		escalation.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("store escalation: %w", err)
+	}
+	return nil
+}
+
+// AdvanceEscalation implements core.EscalationRepository.AdvanceEscalation.
+//
+// The conditional update lets replicas that restored the same escalation
+// race for a level: only the one that updates the row delivers it.
+func (r *PostgresEscalationRepository) AdvanceEscalation(ctx context.Context, escalation *core.AlertEscalation, fromLevel int) (bool, error) {
+	tag, err := r.pool.Exec(ctx, `
+		UPDATE alert_escalations SET
+			state = $3,
+			level = $4,
+			next_at = $5,
+			last_notified_at = $6,
+			updated_at = $7
+		WHERE fingerprint = $1 AND level = $2 AND state = 'active'`,
+		escalation.Fingerprint,
+		fromLevel,
+		escalation.State,
+		escalation.Level,
+		escalation.NextAt,
+		escalation.LastNotifiedAt,
+		escalation.UpdatedAt,
+	)
+	if err != nil {
+		return false, fmt.Errorf("advance escalation: %w", err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+// ListEscalations implements core.EscalationRepository.ListEscalations.
+func (r *PostgresEscalationRepository) ListEscalations(ctx context.Context, state core.EscalationState) ([]*core.AlertEscalation, error) {
+	query := `SELECT ` + escalationColumns + ` FROM alert_escalations
+		WHERE state = $1
+		ORDER BY started_at`
+
+	rows, err := r.pool.Query(ctx, query, state)
+	if err != nil {
+		return nil, fmt.Errorf("list escalations: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*core.AlertEscalation
+	for rows.Next() {
+		escalation, err := scanEscalation(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan escalation: %w", err)
+		}
+		result = append(result, escalation)
+	}
+	return result, rows.Err()
+}
+
+// DeleteInactiveEscalations implements core.EscalationRepository.DeleteInactiveEscalations.
+func (r *PostgresEscalationRepository) DeleteInactiveEscalations(ctx context.Context, before time.Time) (int, error) {
+	tag, err := r.pool.Exec(ctx,
+		`DELETE FROM alert_escalations WHERE state <> $1 AND updated_at < $2`,
+		core.EscalationActive, before,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("delete inactive escalations: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// scanEscalation scans an alert_escalations row selected with escalationColumns.
+func scanEscalation(row pgx.Row) (*core.AlertEscalation, error) {
+	escalation := &core.AlertEscalation{}
+	var labels []byte
+	err := row.Scan(
+		&escalation.Fingerprint,
+		&escalation.AlertName,
+		&labels,
+		&escalation.Policy,
+		&escalation.State,
+		&escalation.Level,
+		&escalation.StartedAt,
+		&escalation.NextAt,
+		&escalation.LastNotifiedAt,
+		&escalation.StoppedAt,
+		&escalation.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(labels) > 0 {
+		if err := json.Unmarshal(labels, &escalation.Labels); err != nil {
+			return nil, fmt.Errorf("unmarshal escalation labels: %w", err)
+		}
+	}
+	return escalation, nil
+}
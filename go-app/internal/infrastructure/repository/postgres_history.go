@@ -6,14 +6,29 @@ import (
 	"fmt"
 	"log/slog"
 	"math"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/pkg/history/filters"
+	"github.com/vitaliisemenov/alert-history/pkg/history/query"
 )
 
+// historyColumns are the alerts columns read by scanHistoryAlert.
+var historyColumns = []string{
+	"fingerprint", "alert_name", "status", "labels", "annotations",
+	"starts_at", "ends_at", "generator_url", "timestamp",
+	"silenced_by", "inhibited_by",
+}
+
+// exportBatchSize is the number of rows ExportHistory reads per query.
+const exportBatchSize = 1000
+
 // PostgresHistoryRepository implements AlertHistoryRepository for PostgreSQL
 type PostgresHistoryRepository struct {
 	pool    *pgxpool.Pool
@@ -36,58 +51,70 @@ func NewPostgresHistoryRepository(pool *pgxpool.Pool, storage core.AlertStorage,
 		logger = slog.Default()
 	}
 
-	// FIXED: Added Namespace and Subsystem for proper metric naming
-	// Old: alert_history_query_duration_seconds (no subsystem)
-	// New: alert_history_infra_repository_query_duration_seconds
-	metrics := &HistoryMetrics{
-		QueryDuration: promauto.NewHistogramVec(
-			prometheus.HistogramOpts{
-				Namespace: "alert_history",
-				Subsystem: "infra_repository",
-				Name:      "query_duration_seconds",
-				Help:      "Duration of alert history queries",
-				Buckets:   []float64{.001, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
-			},
-			[]string{"operation", "status"},
-		),
-		QueryErrors: promauto.NewCounterVec(
-			prometheus.CounterOpts{
-				Namespace: "alert_history",
-				Subsystem: "infra_repository",
-				Name:      "query_errors_total",
-				Help:      "Total number of alert history query errors",
-			},
-			[]string{"operation", "error_type"},
-		),
-		QueryResults: promauto.NewHistogramVec(
-			prometheus.HistogramOpts{
-				Namespace: "alert_history",
-				Subsystem: "infra_repository",
-				Name:      "query_results_total",
-				Help:      "Number of results returned by history queries",
-				Buckets:   []float64{0, 1, 5, 10, 25, 50, 100, 250, 500, 1000},
-			},
-			[]string{"operation"},
-		),
-		CacheHits: promauto.NewCounterVec(
-			prometheus.CounterOpts{
-				Namespace: "alert_history",
-				Subsystem: "infra_cache",
-				Name:      "hits_total",
-				Help:      "Total number of cache hits for history queries",
-			},
-			[]string{"cache_type"},
-		),
-	}
-
 	return &PostgresHistoryRepository{
 		pool:    pool,
 		storage: storage,
 		logger:  logger,
-		metrics: metrics,
+		metrics: getHistoryMetrics(),
 	}
 }
 
+var (
+	historyMetrics     *HistoryMetrics
+	historyMetricsOnce sync.Once
+)
+
+// getHistoryMetrics returns the history metrics shared by all repository
+// backends (registered once).
+func getHistoryMetrics() *HistoryMetrics {
+	historyMetricsOnce.Do(func() {
+		// FIXED: Added Namespace and Subsystem for proper metric naming
+		// Old: alert_history_query_duration_seconds (no subsystem)
+		// New: alert_history_infra_repository_query_duration_seconds
+		historyMetrics = &HistoryMetrics{
+			QueryDuration: promauto.NewHistogramVec(
+				prometheus.HistogramOpts{
+					Namespace: "alert_history",
+					Subsystem: "infra_repository",
+					Name:      "query_duration_seconds",
+					Help:      "Duration of alert history queries",
+					Buckets:   []float64{.001, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+				},
+				[]string{"operation", "status"},
+			),
+			QueryErrors: promauto.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: "alert_history",
+					Subsystem: "infra_repository",
+					Name:      "query_errors_total",
+					Help:      "Total number of alert history query errors",
+				},
+				[]string{"operation", "error_type"},
+			),
+			QueryResults: promauto.NewHistogramVec(
+				prometheus.HistogramOpts{
+					Namespace: "alert_history",
+					Subsystem: "infra_repository",
+					Name:      "query_results_total",
+					Help:      "Number of results returned by history queries",
+					Buckets:   []float64{0, 1, 5, 10, 25, 50, 100, 250, 500, 1000},
+				},
+				[]string{"operation"},
+			),
+			CacheHits: promauto.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: "alert_history",
+					Subsystem: "infra_cache",
+					Name:      "hits_total",
+					Help:      "Total number of cache hits for history queries",
+				},
+				[]string{"cache_type"},
+			),
+		}
+	})
+	return historyMetrics
+}
+
 // GetHistory retrieves paginated alert history with advanced filtering and sorting
 func (r *PostgresHistoryRepository) GetHistory(ctx context.Context, req *core.HistoryRequest) (*core.HistoryResponse, error) {
 	start := time.Now()
@@ -108,6 +135,10 @@ func (r *PostgresHistoryRepository) GetHistory(ctx context.Context, req *core.Hi
 		return nil, fmt.Errorf("invalid history request: %w", err)
 	}
 
+	if req.Pagination.Cursor != nil {
+		return r.getHistoryByCursor(ctx, req)
+	}
+
 	// Use existing AlertStorage for listing
 	filters := req.Filters
 	if filters == nil {
@@ -141,6 +172,189 @@ func (r *PostgresHistoryRepository) GetHistory(ctx context.Context, req *core.Hi
 	return response, nil
 }
 
+// getHistoryByCursor serves a keyset page of GetHistory: no OFFSET scan and
+// no COUNT, so the cost of a page does not grow with its depth.
+func (r *PostgresHistoryRepository) getHistoryByCursor(ctx context.Context, req *core.HistoryRequest) (*core.HistoryResponse, error) {
+	operation := "get_history_cursor"
+
+	cursor, err := parseHistoryCursor(req.Pagination)
+	if err != nil {
+		r.metrics.QueryErrors.WithLabelValues(operation, "validation").Inc()
+		return nil, err
+	}
+
+	qb := query.NewBuilder()
+	if err := applyAlertFilters(qb, req.Filters); err != nil {
+		r.metrics.QueryErrors.WithLabelValues(operation, "validation").Inc()
+		return nil, err
+	}
+
+	// One extra row tells whether a next page exists
+	alerts, err := r.queryHistoryPage(ctx, qb, cursor, req.Pagination.PerPage+1)
+	if err != nil {
+		r.metrics.QueryErrors.WithLabelValues(operation, "database").Inc()
+		return nil, err
+	}
+
+	r.metrics.QueryResults.WithLabelValues(operation).Observe(float64(len(alerts)))
+
+	return newCursorHistoryResponse(alerts, req.Pagination), nil
+}
+
+// ExportHistory calls fn for every alert matching historyFilters, newest
+// first. Alerts are read in keyset batches of exportBatchSize, so neither
+// memory use nor the cost of a batch grows with the size of the export.
+// An error returned by fn stops the export and is returned.
+func (r *PostgresHistoryRepository) ExportHistory(ctx context.Context, historyFilters []filters.Filter, fn func(alert *core.Alert) error) error {
+	start := time.Now()
+	operation := "export_history"
+	exported := 0
+
+	defer func() {
+		r.metrics.QueryDuration.WithLabelValues(operation, "success").Observe(time.Since(start).Seconds())
+		r.logger.Debug("ExportHistory completed",
+			"duration_ms", time.Since(start).Milliseconds(),
+			"alerts", exported)
+	}()
+
+	var cursor *core.HistoryCursor
+	for {
+		qb := query.NewBuilder()
+		for _, filter := range historyFilters {
+			if err := filter.ApplyToQuery(qb); err != nil {
+				r.metrics.QueryErrors.WithLabelValues(operation, "validation").Inc()
+				return fmt.Errorf("failed to apply %s filter: %w", filter.Type(), err)
+			}
+		}
+
+		alerts, err := r.queryHistoryPage(ctx, qb, cursor, exportBatchSize)
+		if err != nil {
+			r.metrics.QueryErrors.WithLabelValues(operation, "database").Inc()
+			return err
+		}
+
+		for _, alert := range alerts {
+			if err := fn(alert); err != nil {
+				return err
+			}
+			exported++
+		}
+
+		if len(alerts) < exportBatchSize {
+			return nil
+		}
+		cursor = core.NewHistoryCursor(alerts[len(alerts)-1])
+	}
+}
+
+// queryHistoryPage reads up to limit alerts of qb following cursor
+// (nil for the first page) in keyset order.
+func (r *PostgresHistoryRepository) queryHistoryPage(ctx context.Context, qb *query.Builder, cursor *core.HistoryCursor, limit int) ([]*core.Alert, error) {
+	qb.SetColumns(historyColumns...)
+	if cursor != nil {
+		qb.AddWhere("(starts_at, fingerprint) < (?, ?)", cursor.StartsAt, cursor.Fingerprint)
+	}
+	qb.AddOrderBy("starts_at", core.SortOrderDesc)
+	qb.AddOrderBy("fingerprint", core.SortOrderDesc)
+	qb.SetLimit(limit)
+
+	sql, args := qb.Build()
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query alerts: %w", err)
+	}
+	defer rows.Close()
+
+	var alerts []*core.Alert
+	for rows.Next() {
+		alert, err := scanHistoryAlert(rows)
+		if err != nil {
+			return nil, err
+		}
+		alerts = append(alerts, alert)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate alerts: %w", err)
+	}
+
+	return alerts, nil
+}
+
+// applyAlertFilters adds the conditions of core.AlertFilters to qb, with the
+// same semantics as the ListAlerts of the PostgreSQL alert storage.
+func applyAlertFilters(qb *query.Builder, alertFilters *core.AlertFilters) error {
+	if alertFilters == nil {
+		return nil
+	}
+	if alertFilters.Status != nil {
+		qb.AddWhere("status = ?", string(*alertFilters.Status))
+	}
+	if alertFilters.Severity != nil {
+		qb.AddWhere("labels->>'severity' = ?", *alertFilters.Severity)
+	}
+	if alertFilters.Namespace != nil {
+		qb.AddWhere("namespace = ?", *alertFilters.Namespace)
+	}
+	if alertFilters.TimeRange != nil {
+		if alertFilters.TimeRange.From != nil {
+			qb.AddWhere("starts_at >= ?", *alertFilters.TimeRange.From)
+		}
+		if alertFilters.TimeRange.To != nil {
+			qb.AddWhere("starts_at <= ?", *alertFilters.TimeRange.To)
+		}
+	}
+	if len(alertFilters.Labels) > 0 {
+		labels, err := json.Marshal(alertFilters.Labels)
+		if err != nil {
+			return fmt.Errorf("failed to marshal labels filter: %w", err)
+		}
+		qb.AddWhere("labels @> ?", labels)
+	}
+	return nil
+}
+
+// scanHistoryAlert scans a row of historyColumns.
+func scanHistoryAlert(rows pgx.Rows) (*core.Alert, error) {
+	alert := &core.Alert{}
+	var labelsJSON, annotationsJSON, silencedByJSON, inhibitedByJSON []byte
+	var endsAt, timestamp *time.Time
+	var generatorURL *string
+
+	if err := rows.Scan(
+		&alert.Fingerprint,
+		&alert.AlertName,
+		&alert.Status,
+		&labelsJSON,
+		&annotationsJSON,
+		&alert.StartsAt,
+		&endsAt,
+		&generatorURL,
+		&timestamp,
+		&silencedByJSON,
+		&inhibitedByJSON,
+	); err != nil {
+		return nil, fmt.Errorf("failed to scan alert: %w", err)
+	}
+
+	if err := json.Unmarshal(labelsJSON, &alert.Labels); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal labels: %w", err)
+	}
+	if err := json.Unmarshal(annotationsJSON, &alert.Annotations); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal annotations: %w", err)
+	}
+	if err := json.Unmarshal(silencedByJSON, &alert.SilencedBy); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal silenced_by: %w", err)
+	}
+	if err := json.Unmarshal(inhibitedByJSON, &alert.InhibitedBy); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal inhibited_by: %w", err)
+	}
+	alert.EndsAt = endsAt
+	alert.GeneratorURL = generatorURL
+	alert.Timestamp = timestamp
+
+	return alert, nil
+}
+
 // GetAlertsByFingerprint retrieves all alerts with the same fingerprint
 func (r *PostgresHistoryRepository) GetAlertsByFingerprint(ctx context.Context, fingerprint string, limit int) ([]*core.Alert, error) {
 	start := time.Now()
@@ -164,8 +378,7 @@ func (r *PostgresHistoryRepository) GetAlertsByFingerprint(ctx context.Context,
 	}
 
 	query := `
-		SELECT fingerprint, alert_name, status, labels, annotations,
-		       starts_at, ends_at, generator_url, timestamp
+		SELECT ` + strings.Join(historyColumns, ", ") + `
 		FROM alerts
 		WHERE fingerprint = $1
 		ORDER BY starts_at DESC
@@ -180,51 +393,11 @@ func (r *PostgresHistoryRepository) GetAlertsByFingerprint(ctx context.Context,
 
 	var alerts []*core.Alert
 	for rows.Next() {
-		alert := &core.Alert{}
-		var labelsJSON, annotationsJSON []byte
-		var endsAt, generatorURL, timestamp interface{}
-
-		err := rows.Scan(
-			&alert.Fingerprint,
-			&alert.AlertName,
-			&alert.Status,
-			&labelsJSON,
-			&annotationsJSON,
-			&alert.StartsAt,
-			&endsAt,
-			&generatorURL,
-			&timestamp,
-		)
+		alert, err := scanHistoryAlert(rows)
 		if err != nil {
 			r.metrics.QueryErrors.WithLabelValues(operation, "scan").Inc()
-			return nil, fmt.Errorf("failed to scan alert: %w", err)
-		}
-
-		// Deserialize JSONB fields
-		if err := json.Unmarshal(labelsJSON, &alert.Labels); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal labels: %w", err)
-		}
-		if err := json.Unmarshal(annotationsJSON, &alert.Annotations); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal annotations: %w", err)
-		}
-
-		// Handle nullable fields
-		if endsAt != nil {
-			if t, ok := endsAt.(time.Time); ok {
-				alert.EndsAt = &t
-			}
-		}
-		if generatorURL != nil {
-			if s, ok := generatorURL.(string); ok {
-				alert.GeneratorURL = &s
-			}
+			return nil, err
 		}
-		if timestamp != nil {
-			if t, ok := timestamp.(time.Time); ok {
-				alert.Timestamp = &t
-			}
-		}
-
 		alerts = append(alerts, alert)
 	}
 
@@ -341,7 +514,7 @@ func (r *PostgresHistoryRepository) GetAggregatedStats(ctx context.Context, time
 	severityQuery := fmt.Sprintf(`
 		SELECT labels->>'severity' as severity, COUNT(*)
 		FROM alerts %s
-		WHERE labels->>'severity' IS NOT NULL
+		AND labels->>'severity' IS NOT NULL
 		GROUP BY severity`, whereClause)
 
 	rows, err = r.pool.Query(ctx, severityQuery, args...)
@@ -364,7 +537,7 @@ func (r *PostgresHistoryRepository) GetAggregatedStats(ctx context.Context, time
 	namespaceQuery := fmt.Sprintf(`
 		SELECT labels->>'namespace' as namespace, COUNT(*)
 		FROM alerts %s
-		WHERE labels->>'namespace' IS NOT NULL
+		AND labels->>'namespace' IS NOT NULL
 		GROUP BY namespace
 		ORDER BY COUNT(*) DESC
 		LIMIT 10`, whereClause)
@@ -459,7 +632,7 @@ func (r *PostgresHistoryRepository) GetTopAlerts(ctx context.Context, timeRange
 		FROM alerts
 		%s
 		GROUP BY fingerprint, alert_name, labels->>'namespace'
-		ORDER BY fire_count DESC
+		ORDER BY fire_count DESC, last_fired_at DESC, fingerprint
 		LIMIT $%d`, whereClause, argCount)
 
 	args = append(args, limit)
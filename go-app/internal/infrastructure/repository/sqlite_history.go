@@ -0,0 +1,645 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+	"github.com/vitaliisemenov/alert-history/pkg/history/filters"
+)
+
+// SQLiteHistoryRepository implements AlertHistoryRepository for SQLite (Lite profile).
+//
+// Works on the alerts table of sqlite.SQLiteStorage (timestamps stored as
+// Unix milliseconds, labels as JSON text) and mirrors the semantics of
+// PostgresHistoryRepository: window functions for flapping detection and
+// JSON1 json_extract() instead of JSONB operators.
+type SQLiteHistoryRepository struct {
+	db      *sql.DB
+	storage core.AlertStorage
+	logger  *slog.Logger
+	metrics *HistoryMetrics
+}
+
+// NewSQLiteHistoryRepository creates a new SQLite history repository.
+// db is the connection of the SQLite alert storage (sqlite.SQLiteStorage.DB()).
+func NewSQLiteHistoryRepository(db *sql.DB, storage core.AlertStorage, logger *slog.Logger) *SQLiteHistoryRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &SQLiteHistoryRepository{
+		db:      db,
+		storage: storage,
+		logger:  logger,
+		metrics: getHistoryMetrics(),
+	}
+}
+
+// GetHistory retrieves paginated alert history with advanced filtering and sorting
+func (r *SQLiteHistoryRepository) GetHistory(ctx context.Context, req *core.HistoryRequest) (*core.HistoryResponse, error) {
+	start := time.Now()
+	operation := "get_history"
+
+	defer func() {
+		r.metrics.QueryDuration.WithLabelValues(operation, "success").Observe(time.Since(start).Seconds())
+	}()
+
+	if err := req.Validate(); err != nil {
+		r.metrics.QueryErrors.WithLabelValues(operation, "validation").Inc()
+		return nil, fmt.Errorf("invalid history request: %w", err)
+	}
+
+	if req.Pagination.Cursor != nil {
+		return r.getHistoryByCursor(ctx, req)
+	}
+
+	filters := req.Filters
+	if filters == nil {
+		filters = &core.AlertFilters{}
+	}
+	filters.Limit = req.Pagination.PerPage
+	filters.Offset = req.Pagination.Offset()
+
+	alertList, err := r.storage.ListAlerts(ctx, filters)
+	if err != nil {
+		r.metrics.QueryErrors.WithLabelValues(operation, "database").Inc()
+		return nil, fmt.Errorf("failed to list alerts: %w", err)
+	}
+
+	totalPages := int(math.Ceil(float64(alertList.Total) / float64(req.Pagination.PerPage)))
+
+	r.metrics.QueryResults.WithLabelValues(operation).Observe(float64(len(alertList.Alerts)))
+
+	return &core.HistoryResponse{
+		Alerts:     alertList.Alerts,
+		Total:      int64(alertList.Total),
+		Page:       req.Pagination.Page,
+		PerPage:    req.Pagination.PerPage,
+		TotalPages: totalPages,
+		HasNext:    req.Pagination.Page < totalPages,
+		HasPrev:    req.Pagination.Page > 1,
+	}, nil
+}
+
+// getHistoryByCursor serves a keyset page of GetHistory: no OFFSET scan and
+// no COUNT, so the cost of a page does not grow with its depth.
+func (r *SQLiteHistoryRepository) getHistoryByCursor(ctx context.Context, req *core.HistoryRequest) (*core.HistoryResponse, error) {
+	operation := "get_history_cursor"
+
+	cursor, err := parseHistoryCursor(req.Pagination)
+	if err != nil {
+		r.metrics.QueryErrors.WithLabelValues(operation, "validation").Inc()
+		return nil, err
+	}
+
+	// Same filter columns as sqlite.SQLiteStorage.ListAlerts
+	whereClause := "WHERE 1=1"
+	var args []interface{}
+	if filters := req.Filters; filters != nil {
+		if filters.Status != nil {
+			whereClause += " AND status = ?"
+			args = append(args, string(*filters.Status))
+		}
+		if filters.Severity != nil {
+			whereClause += " AND severity = ?"
+			args = append(args, *filters.Severity)
+		}
+		if filters.Namespace != nil {
+			whereClause += " AND namespace = ?"
+			args = append(args, *filters.Namespace)
+		}
+		for key, value := range filters.Labels {
+			whereClause += " AND json_extract(labels, ?) = ?"
+			args = append(args, `$."`+key+`"`, value)
+		}
+		whereClause, args = sqliteTimeRangeClause(whereClause, filters.TimeRange, args...)
+	}
+
+	// One extra row tells whether a next page exists
+	alerts, err := r.queryHistoryPage(ctx, whereClause, args, cursor, req.Pagination.PerPage+1)
+	if err != nil {
+		r.metrics.QueryErrors.WithLabelValues(operation, "database").Inc()
+		return nil, err
+	}
+
+	r.metrics.QueryResults.WithLabelValues(operation).Observe(float64(len(alerts)))
+
+	return newCursorHistoryResponse(alerts, req.Pagination), nil
+}
+
+// ExportHistory calls fn for every alert matching historyFilters, newest
+// first. Alerts are read in keyset batches of exportBatchSize and the
+// filters are evaluated in memory (filters.Filter.Matches), since their
+// query form is PostgreSQL-specific. An error returned by fn stops the
+// export and is returned.
+func (r *SQLiteHistoryRepository) ExportHistory(ctx context.Context, historyFilters []filters.Filter, fn func(alert *core.Alert) error) error {
+	start := time.Now()
+	operation := "export_history"
+	exported := 0
+
+	defer func() {
+		r.metrics.QueryDuration.WithLabelValues(operation, "success").Observe(time.Since(start).Seconds())
+		r.logger.Debug("ExportHistory completed",
+			"duration_ms", time.Since(start).Milliseconds(),
+			"alerts", exported)
+	}()
+
+	var cursor *core.HistoryCursor
+	for {
+		alerts, err := r.queryHistoryPage(ctx, "WHERE 1=1", nil, cursor, exportBatchSize)
+		if err != nil {
+			r.metrics.QueryErrors.WithLabelValues(operation, "database").Inc()
+			return err
+		}
+
+	nextAlert:
+		for _, alert := range alerts {
+			for _, filter := range historyFilters {
+				if !filter.Matches(alert) {
+					continue nextAlert
+				}
+			}
+			if err := fn(alert); err != nil {
+				return err
+			}
+			exported++
+		}
+
+		if len(alerts) < exportBatchSize {
+			return nil
+		}
+		cursor = core.NewHistoryCursor(alerts[len(alerts)-1])
+	}
+}
+
+// queryHistoryPage reads up to limit alerts matching whereClause following
+// cursor (nil for the first page) in keyset order.
+func (r *SQLiteHistoryRepository) queryHistoryPage(ctx context.Context, whereClause string, args []interface{}, cursor *core.HistoryCursor, limit int) ([]*core.Alert, error) {
+	if cursor != nil {
+		whereClause += " AND (starts_at < ? OR (starts_at = ? AND fingerprint < ?))"
+		args = append(args, cursor.StartsAt.UnixMilli(), cursor.StartsAt.UnixMilli(), cursor.Fingerprint)
+	}
+	args = append(args, limit)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT fingerprint, alert_name, status, labels, annotations,
+		       starts_at, ends_at, generator_url, silenced_by, inhibited_by
+		FROM alerts `+whereClause+`
+		ORDER BY starts_at DESC, fingerprint DESC
+		LIMIT ?`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query alerts: %w", err)
+	}
+	defer rows.Close()
+
+	var alerts []*core.Alert
+	for rows.Next() {
+		alert, err := scanSQLiteHistoryAlert(rows)
+		if err != nil {
+			return nil, err
+		}
+		alerts = append(alerts, alert)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate alerts: %w", err)
+	}
+
+	return alerts, nil
+}
+
+// GetAlertsByFingerprint retrieves all alerts with the same fingerprint
+func (r *SQLiteHistoryRepository) GetAlertsByFingerprint(ctx context.Context, fingerprint string, limit int) ([]*core.Alert, error) {
+	start := time.Now()
+	operation := "get_alerts_by_fingerprint"
+
+	defer func() {
+		r.metrics.QueryDuration.WithLabelValues(operation, "success").Observe(time.Since(start).Seconds())
+	}()
+
+	if fingerprint == "" {
+		r.metrics.QueryErrors.WithLabelValues(operation, "validation").Inc()
+		return nil, fmt.Errorf("fingerprint cannot be empty")
+	}
+
+	if limit <= 0 {
+		limit = 100
+	}
+	if limit > 1000 {
+		limit = 1000
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT fingerprint, alert_name, status, labels, annotations,
+		       starts_at, ends_at, generator_url, silenced_by, inhibited_by
+		FROM alerts
+		WHERE fingerprint = ?
+		ORDER BY starts_at DESC
+		LIMIT ?`, fingerprint, limit)
+	if err != nil {
+		r.metrics.QueryErrors.WithLabelValues(operation, "database").Inc()
+		return nil, fmt.Errorf("failed to query alerts by fingerprint: %w", err)
+	}
+	defer rows.Close()
+
+	var alerts []*core.Alert
+	for rows.Next() {
+		alert, err := scanSQLiteHistoryAlert(rows)
+		if err != nil {
+			r.metrics.QueryErrors.WithLabelValues(operation, "scan").Inc()
+			return nil, err
+		}
+		alerts = append(alerts, alert)
+	}
+	if err := rows.Err(); err != nil {
+		r.metrics.QueryErrors.WithLabelValues(operation, "database").Inc()
+		return nil, fmt.Errorf("failed to iterate alerts: %w", err)
+	}
+
+	r.metrics.QueryResults.WithLabelValues(operation).Observe(float64(len(alerts)))
+
+	return alerts, nil
+}
+
+// GetRecentAlerts retrieves the most recent alerts across all fingerprints
+func (r *SQLiteHistoryRepository) GetRecentAlerts(ctx context.Context, limit int) ([]*core.Alert, error) {
+	start := time.Now()
+	operation := "get_recent_alerts"
+
+	defer func() {
+		r.metrics.QueryDuration.WithLabelValues(operation, "success").Observe(time.Since(start).Seconds())
+	}()
+
+	if limit <= 0 {
+		limit = 50
+	}
+	if limit > 1000 {
+		limit = 1000
+	}
+
+	alertList, err := r.storage.ListAlerts(ctx, &core.AlertFilters{Limit: limit})
+	if err != nil {
+		r.metrics.QueryErrors.WithLabelValues(operation, "database").Inc()
+		return nil, fmt.Errorf("failed to get recent alerts: %w", err)
+	}
+
+	r.metrics.QueryResults.WithLabelValues(operation).Observe(float64(len(alertList.Alerts)))
+
+	return alertList.Alerts, nil
+}
+
+// GetAggregatedStats computes statistical aggregations over a time range
+func (r *SQLiteHistoryRepository) GetAggregatedStats(ctx context.Context, timeRange *core.TimeRange) (*core.AggregatedStats, error) {
+	start := time.Now()
+	operation := "get_aggregated_stats"
+
+	defer func() {
+		r.metrics.QueryDuration.WithLabelValues(operation, "success").Observe(time.Since(start).Seconds())
+	}()
+
+	stats := &core.AggregatedStats{
+		TimeRange:         timeRange,
+		AlertsByStatus:    make(map[string]int64),
+		AlertsBySeverity:  make(map[string]int64),
+		AlertsByNamespace: make(map[string]int64),
+	}
+
+	whereClause, args := sqliteTimeRangeClause("WHERE 1=1", timeRange)
+
+	// Total alerts + unique fingerprints
+	err := r.db.QueryRowContext(ctx,
+		fmt.Sprintf("SELECT COUNT(*), COUNT(DISTINCT fingerprint) FROM alerts %s", whereClause),
+		args...).Scan(&stats.TotalAlerts, &stats.UniqueFingerprints)
+	if err != nil {
+		r.metrics.QueryErrors.WithLabelValues(operation, "total_count").Inc()
+		return nil, fmt.Errorf("failed to count total alerts: %w", err)
+	}
+
+	// Firing vs Resolved
+	err = r.queryCounts(ctx, fmt.Sprintf(`
+		SELECT status, COUNT(*)
+		FROM alerts %s
+		GROUP BY status`, whereClause), args, func(status string, count int64) {
+		stats.AlertsByStatus[status] = count
+		switch status {
+		case string(core.StatusFiring):
+			stats.FiringAlerts = count
+		case string(core.StatusResolved):
+			stats.ResolvedAlerts = count
+		}
+	})
+	if err != nil {
+		r.metrics.QueryErrors.WithLabelValues(operation, "status").Inc()
+		return nil, fmt.Errorf("failed to query status stats: %w", err)
+	}
+
+	// Severity distribution (JSON1)
+	err = r.queryCounts(ctx, fmt.Sprintf(`
+		SELECT json_extract(labels, '$.severity') AS severity, COUNT(*)
+		FROM alerts %s
+		AND json_extract(labels, '$.severity') IS NOT NULL
+		GROUP BY severity`, whereClause), args, func(severity string, count int64) {
+		stats.AlertsBySeverity[severity] = count
+	})
+	if err != nil {
+		r.metrics.QueryErrors.WithLabelValues(operation, "severity").Inc()
+		return nil, fmt.Errorf("failed to query severity stats: %w", err)
+	}
+
+	// Namespace distribution (top 10)
+	err = r.queryCounts(ctx, fmt.Sprintf(`
+		SELECT json_extract(labels, '$.namespace') AS namespace, COUNT(*)
+		FROM alerts %s
+		AND json_extract(labels, '$.namespace') IS NOT NULL
+		GROUP BY namespace
+		ORDER BY COUNT(*) DESC
+		LIMIT 10`, whereClause), args, func(namespace string, count int64) {
+		stats.AlertsByNamespace[namespace] = count
+	})
+	if err != nil {
+		r.metrics.QueryErrors.WithLabelValues(operation, "namespace").Inc()
+		return nil, fmt.Errorf("failed to query namespace stats: %w", err)
+	}
+
+	// Average resolution time for resolved alerts (milliseconds → seconds)
+	var avgSeconds sql.NullFloat64
+	err = r.db.QueryRowContext(ctx, fmt.Sprintf(`
+		SELECT AVG((ends_at - starts_at) / 1000.0)
+		FROM alerts
+		%s AND status = 'resolved' AND ends_at IS NOT NULL`, whereClause), args...).Scan(&avgSeconds)
+	if err != nil {
+		r.logger.Warn("Failed to calculate avg resolution time", "error", err)
+	}
+	if avgSeconds.Valid && avgSeconds.Float64 > 0 {
+		duration := time.Duration(avgSeconds.Float64 * float64(time.Second))
+		stats.AvgResolutionTime = &duration
+	}
+
+	return stats, nil
+}
+
+// GetTopAlerts returns the most frequently firing alerts
+func (r *SQLiteHistoryRepository) GetTopAlerts(ctx context.Context, timeRange *core.TimeRange, limit int) ([]*core.TopAlert, error) {
+	start := time.Now()
+	operation := "get_top_alerts"
+
+	defer func() {
+		r.metrics.QueryDuration.WithLabelValues(operation, "success").Observe(time.Since(start).Seconds())
+	}()
+
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	// NOW() is passed as a parameter (Unix milliseconds)
+	args := []interface{}{time.Now().UnixMilli()}
+	whereClause, args := sqliteTimeRangeClause("WHERE status = 'firing'", timeRange, args...)
+	args = append(args, limit)
+
+	rows, err := r.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT
+			fingerprint,
+			alert_name,
+			json_extract(labels, '$.namespace') AS namespace,
+			COUNT(*) AS fire_count,
+			MAX(starts_at) AS last_fired_at,
+			AVG((COALESCE(ends_at, ?) - starts_at) / 1000.0) AS avg_duration
+		FROM alerts
+		%s
+		GROUP BY fingerprint, alert_name, namespace
+		ORDER BY fire_count DESC, last_fired_at DESC, fingerprint
+		LIMIT ?`, whereClause), args...)
+	if err != nil {
+		r.metrics.QueryErrors.WithLabelValues(operation, "database").Inc()
+		return nil, fmt.Errorf("failed to query top alerts: %w", err)
+	}
+	defer rows.Close()
+
+	var topAlerts []*core.TopAlert
+	for rows.Next() {
+		alert := &core.TopAlert{}
+		var namespace sql.NullString
+		var lastFiredAt int64
+		var avgDuration sql.NullFloat64
+
+		if err := rows.Scan(
+			&alert.Fingerprint,
+			&alert.AlertName,
+			&namespace,
+			&alert.FireCount,
+			&lastFiredAt,
+			&avgDuration,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan top alert: %w", err)
+		}
+
+		alert.LastFiredAt = time.UnixMilli(lastFiredAt)
+		if namespace.Valid {
+			ns := namespace.String
+			alert.Namespace = &ns
+		}
+		if avgDuration.Valid {
+			avg := avgDuration.Float64
+			alert.AvgDuration = &avg
+		}
+
+		topAlerts = append(topAlerts, alert)
+	}
+	if err := rows.Err(); err != nil {
+		r.metrics.QueryErrors.WithLabelValues(operation, "database").Inc()
+		return nil, fmt.Errorf("failed to iterate top alerts: %w", err)
+	}
+
+	r.metrics.QueryResults.WithLabelValues(operation).Observe(float64(len(topAlerts)))
+
+	return topAlerts, nil
+}
+
+// GetFlappingAlerts detects alerts that frequently transition between states
+func (r *SQLiteHistoryRepository) GetFlappingAlerts(ctx context.Context, timeRange *core.TimeRange, threshold int) ([]*core.FlappingAlert, error) {
+	start := time.Now()
+	operation := "get_flapping_alerts"
+
+	defer func() {
+		r.metrics.QueryDuration.WithLabelValues(operation, "success").Observe(time.Since(start).Seconds())
+	}()
+
+	if threshold <= 0 {
+		threshold = 3 // Default: at least 3 state transitions
+	}
+
+	// NOW() is passed as a parameter (Unix milliseconds), after the time range
+	whereClause, args := sqliteTimeRangeClause("WHERE 1=1", timeRange)
+	args = append(args, time.Now().UnixMilli(), threshold)
+
+	rows, err := r.db.QueryContext(ctx, fmt.Sprintf(`
+		WITH state_changes AS (
+			SELECT
+				fingerprint,
+				alert_name,
+				json_extract(labels, '$.namespace') AS namespace,
+				status,
+				starts_at,
+				LAG(status) OVER (PARTITION BY fingerprint ORDER BY starts_at) AS prev_status
+			FROM alerts
+			%s
+		),
+		transition_counts AS (
+			SELECT
+				fingerprint,
+				alert_name,
+				namespace,
+				COUNT(*) FILTER (WHERE status != prev_status) AS transition_count,
+				MAX(starts_at) AS last_transition_at
+			FROM state_changes
+			WHERE prev_status IS NOT NULL
+			GROUP BY fingerprint, alert_name, namespace
+		)
+		SELECT
+			fingerprint,
+			alert_name,
+			namespace,
+			transition_count,
+			CAST(transition_count AS REAL) / ((? - last_transition_at) / 1000.0) * 3600 AS flapping_score,
+			last_transition_at
+		FROM transition_counts
+		WHERE transition_count >= ?
+		ORDER BY flapping_score DESC
+		LIMIT 50`, whereClause), args...)
+	if err != nil {
+		r.metrics.QueryErrors.WithLabelValues(operation, "database").Inc()
+		return nil, fmt.Errorf("failed to query flapping alerts: %w", err)
+	}
+	defer rows.Close()
+
+	var flappingAlerts []*core.FlappingAlert
+	for rows.Next() {
+		alert := &core.FlappingAlert{}
+		var namespace sql.NullString
+		var score sql.NullFloat64
+		var lastTransitionAt int64
+
+		if err := rows.Scan(
+			&alert.Fingerprint,
+			&alert.AlertName,
+			&namespace,
+			&alert.TransitionCount,
+			&score,
+			&lastTransitionAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan flapping alert: %w", err)
+		}
+
+		alert.FlappingScore = score.Float64 // NULL when the last transition is "now"
+		alert.LastTransitionAt = time.UnixMilli(lastTransitionAt)
+		if namespace.Valid {
+			ns := namespace.String
+			alert.Namespace = &ns
+		}
+
+		flappingAlerts = append(flappingAlerts, alert)
+	}
+	if err := rows.Err(); err != nil {
+		r.metrics.QueryErrors.WithLabelValues(operation, "database").Inc()
+		return nil, fmt.Errorf("failed to iterate flapping alerts: %w", err)
+	}
+
+	r.metrics.QueryResults.WithLabelValues(operation).Observe(float64(len(flappingAlerts)))
+
+	return flappingAlerts, nil
+}
+
+// queryCounts runs a "SELECT key, COUNT(*)" query and calls fn for every row.
+func (r *SQLiteHistoryRepository) queryCounts(ctx context.Context, query string, args []interface{}, fn func(key string, count int64)) error {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var key string
+		var count int64
+		if err := rows.Scan(&key, &count); err != nil {
+			return fmt.Errorf("failed to scan row: %w", err)
+		}
+		fn(key, count)
+	}
+	return rows.Err()
+}
+
+// scanSQLiteHistoryAlert scans a row of fingerprint, alert_name, status,
+// labels, annotations, starts_at, ends_at, generator_url, silenced_by,
+// inhibited_by.
+func scanSQLiteHistoryAlert(rows *sql.Rows) (*core.Alert, error) {
+	alert := &core.Alert{}
+	var labelsJSON, annotationsJSON, silencedByJSON, inhibitedByJSON string
+	var startsAt int64
+	var endsAt sql.NullInt64
+	var generatorURL sql.NullString
+
+	if err := rows.Scan(
+		&alert.Fingerprint,
+		&alert.AlertName,
+		&alert.Status,
+		&labelsJSON,
+		&annotationsJSON,
+		&startsAt,
+		&endsAt,
+		&generatorURL,
+		&silencedByJSON,
+		&inhibitedByJSON,
+	); err != nil {
+		return nil, fmt.Errorf("failed to scan alert: %w", err)
+	}
+
+	if err := json.Unmarshal([]byte(labelsJSON), &alert.Labels); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal labels: %w", err)
+	}
+	if err := json.Unmarshal([]byte(annotationsJSON), &alert.Annotations); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal annotations: %w", err)
+	}
+	if err := json.Unmarshal([]byte(silencedByJSON), &alert.SilencedBy); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal silenced_by: %w", err)
+	}
+	if err := json.Unmarshal([]byte(inhibitedByJSON), &alert.InhibitedBy); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal inhibited_by: %w", err)
+	}
+
+	alert.StartsAt = time.UnixMilli(startsAt)
+	if endsAt.Valid {
+		t := time.UnixMilli(endsAt.Int64)
+		alert.EndsAt = &t
+	}
+	if generatorURL.Valid {
+		s := generatorURL.String
+		alert.GeneratorURL = &s
+	}
+
+	return alert, nil
+}
+
+// sqliteTimeRangeClause appends the starts_at time range conditions
+// (Unix milliseconds) to whereClause.
+func sqliteTimeRangeClause(whereClause string, timeRange *core.TimeRange, args ...interface{}) (string, []interface{}) {
+	if timeRange == nil {
+		return whereClause, args
+	}
+	if timeRange.From != nil {
+		whereClause += " AND starts_at >= ?"
+		args = append(args, timeRange.From.UnixMilli())
+	}
+	if timeRange.To != nil {
+		whereClause += " AND starts_at <= ?"
+		args = append(args, timeRange.To.UnixMilli())
+	}
+	return whereClause, args
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vitaliisemenov/alert-history/internal/infrastructure"
+	"github.com/vitaliisemenov/alert-history/internal/storage/sqlite"
+)
+
+func TestSQLiteHistoryRepository_Conformance(t *testing.T) {
+	runHistoryConformance(t, func(t *testing.T) historyBackend {
+		logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+
+		storage, err := sqlite.NewSQLiteStorage(context.Background(), filepath.Join(t.TempDir(), "history.db"), logger)
+		if err != nil {
+			t.Fatalf("Failed to create SQLite storage: %v", err)
+		}
+		t.Cleanup(func() { storage.Close() })
+
+		return historyBackend{
+			repo:    NewSQLiteHistoryRepository(storage.DB(), storage, logger),
+			storage: storage,
+		}
+	})
+}
+
+// TestPostgresHistoryRepository_Conformance runs the same suite against PostgreSQL.
+//
+// Requires a database (the alerts table is truncated):
+//
+//	TEST_DATABASE_DSN="postgres://..." go test -run Conformance ./internal/infrastructure/repository/
+func TestPostgresHistoryRepository_Conformance(t *testing.T) {
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		t.Skip("Skipping integration test: TEST_DATABASE_DSN not set")
+	}
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+	ctx := context.Background()
+
+	storage, err := infrastructure.NewPostgresDatabase(&infrastructure.Config{
+		DSN:             dsn,
+		MaxOpenConns:    5,
+		MaxIdleConns:    2,
+		ConnMaxLifetime: 30 * time.Minute,
+		ConnMaxIdleTime: 5 * time.Minute,
+		Logger:          logger,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create PostgreSQL storage: %v", err)
+	}
+	if err := storage.Connect(ctx); err != nil {
+		t.Fatalf("Failed to connect to PostgreSQL: %v", err)
+	}
+	t.Cleanup(func() { storage.Disconnect(ctx) })
+	if err := storage.MigrateUp(ctx); err != nil {
+		t.Fatalf("Failed to migrate: %v", err)
+	}
+
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		t.Fatalf("Failed to create pool: %v", err)
+	}
+	t.Cleanup(pool.Close)
+
+	runHistoryConformance(t, func(t *testing.T) historyBackend {
+		if _, err := pool.Exec(ctx, "TRUNCATE alerts"); err != nil {
+			t.Fatalf("Failed to truncate alerts: %v", err)
+		}
+		return historyBackend{
+			repo:    NewPostgresHistoryRepository(pool, storage, logger),
+			storage: storage,
+		}
+	})
+}
@@ -335,3 +335,86 @@ func TestSlackConfig_Defaults(t *testing.T) {
 	require.NotNil(t, config.SendResolved)
 	assert.True(t, *config.SendResolved)
 }
+
+func TestOpsgenieConfig_DefaultsAndSanitize(t *testing.T) {
+	config := &OpsgenieConfig{
+		APIKey:     "og-secret-key",
+		Responders: []*OpsgenieResponder{{Type: "team", Name: "sre"}},
+	}
+
+	config.Defaults()
+
+	assert.Equal(t, "https://api.opsgenie.com/", config.APIURL)
+	assert.Equal(t, "alert-history-service", config.Source)
+	require.NotNil(t, config.SendResolved)
+	assert.True(t, *config.SendResolved)
+
+	receiver := &Receiver{Name: "opsgenie", OpsgenieConfigs: []*OpsgenieConfig{config}}
+	require.NoError(t, receiver.Validate())
+	assert.Equal(t, 1, receiver.GetConfigCount())
+
+	sanitized := receiver.Sanitize()
+	assert.Equal(t, "[REDACTED]", sanitized.OpsgenieConfigs[0].APIKey)
+	assert.Equal(t, "og-secret-key", receiver.OpsgenieConfigs[0].APIKey)
+
+	// Clone is deep
+	sanitized.OpsgenieConfigs[0].Responders[0].Name = "changed"
+	assert.Equal(t, "sre", receiver.OpsgenieConfigs[0].Responders[0].Name)
+}
+
+func TestEmailConfig_InheritGlobalAndSanitize(t *testing.T) {
+	global := &GlobalConfig{
+		SMTPFrom:         "alerts@example.com",
+		SMTPSmartHost:    "smtp.example.com:587",
+		SMTPAuthUsername: "alerts",
+		SMTPAuthPassword: "smtp-secret",
+	}
+
+	config := &EmailConfig{To: "oncall@example.com"}
+	config.InheritGlobal(global)
+	config.Defaults()
+
+	assert.Equal(t, "alerts@example.com", config.From)
+	assert.Equal(t, "smtp.example.com:587", config.Smarthost)
+	assert.Equal(t, "alerts", config.AuthUsername)
+	require.NotNil(t, config.RequireTLS)
+	assert.False(t, *config.RequireTLS, "smtp_require_tls: false is inherited")
+	require.NotNil(t, config.SendResolved)
+	assert.True(t, *config.SendResolved)
+
+	// Receiver-level credentials win over global ones
+	override := &EmailConfig{To: "team@example.com", Smarthost: "mail.team.example.com:465", AuthUsername: "team"}
+	override.InheritGlobal(global)
+	override.Defaults()
+	assert.Equal(t, "mail.team.example.com:465", override.Smarthost)
+	assert.Equal(t, "team", override.AuthUsername)
+	assert.Empty(t, override.AuthPassword)
+
+	sanitized := config.Sanitize()
+	assert.Equal(t, "[REDACTED]", sanitized.AuthPassword)
+	assert.Equal(t, "on***@example.com", sanitized.To)
+	assert.Equal(t, "smtp-secret", config.AuthPassword)
+}
+
+func TestChatOpsConfigs_CloneAndSanitize(t *testing.T) {
+	teams := &MSTeamsConfig{WebhookURL: "https://prod-01.westeurope.logic.azure.com:443/workflows/abc/triggers/manual/paths/invoke?sig=secret"}
+	telegram := &TelegramConfig{BotToken: "123456:secret", ChatID: -100}
+	teams.Defaults()
+	telegram.Defaults()
+
+	receiver := &Receiver{
+		Name:            "chatops",
+		MSTeamsConfigs:  []*MSTeamsConfig{teams},
+		TelegramConfigs: []*TelegramConfig{telegram},
+	}
+	require.NoError(t, receiver.Validate())
+	assert.Equal(t, 2, receiver.GetConfigCount())
+
+	sanitized := receiver.Sanitize()
+	assert.Equal(t, "https://prod-01.westeurope.logic.azure.com:443/[REDACTED]", sanitized.MSTeamsConfigs[0].WebhookURL)
+	assert.Equal(t, "[REDACTED]", sanitized.TelegramConfigs[0].BotToken)
+	assert.Equal(t, "123456:secret", receiver.TelegramConfigs[0].BotToken)
+
+	*sanitized.TelegramConfigs[0].SendResolved = false
+	assert.True(t, *receiver.TelegramConfigs[0].SendResolved, "clone must not share pointers")
+}
@@ -21,7 +21,7 @@ type GlobalConfig struct {
 	// Used when route doesn't specify repeat_interval
 	ResolveTimeout *Duration `yaml:"resolve_timeout,omitempty"`
 
-	// SMTP configuration (FUTURE - TN-154)
+	// SMTP configuration (defaults for email_configs)
 	SMTPFrom         string `yaml:"smtp_from,omitempty" validate:"omitempty,email"`
 	SMTPSmartHost    string `yaml:"smtp_smarthost,omitempty"`
 	SMTPAuthUsername string `yaml:"smtp_auth_username,omitempty"`
@@ -198,7 +198,17 @@ func (p *RouteConfigParser) applyDefaults(config *RouteConfig) {
 		for _, cfg := range receiver.SlackConfigs {
 			cfg.Defaults()
 		}
+		for _, cfg := range receiver.OpsgenieConfigs {
+			cfg.Defaults()
+		}
 		for _, cfg := range receiver.EmailConfigs {
+			cfg.InheritGlobal(config.Global)
+			cfg.Defaults()
+		}
+		for _, cfg := range receiver.MSTeamsConfigs {
+			cfg.Defaults()
+		}
+		for _, cfg := range receiver.TelegramConfigs {
 			cfg.Defaults()
 		}
 	}
@@ -226,7 +236,7 @@ func (p *RouteConfigParser) validateSemantics(config *RouteConfig) error {
 			errors.Add(
 				fmt.Sprintf("receivers[%d]", i),
 				err.Error(),
-				"Add at least one config: webhook_configs, pagerduty_configs, or slack_configs",
+				"Add at least one config: webhook_configs, pagerduty_configs, slack_configs, opsgenie_configs, email_configs, msteams_configs, or telegram_configs",
 			)
 		}
 	}
@@ -176,6 +176,9 @@ route:
     - receiver: slack
       match:
         severity: warning
+    - receiver: opsgenie
+      match:
+        severity: info
 
 receivers:
   - name: default
@@ -188,6 +191,13 @@ receivers:
     slack_configs:
       - api_url: https://hooks.slack.com/xxx
         channel: "#alerts"
+  - name: opsgenie
+    opsgenie_configs:
+      - api_key: "${OPSGENIE_API_KEY}"
+        priority: P2
+        responders:
+          - type: team
+            name: sre
 `
 
 	parser := NewRouteConfigParser()
@@ -195,7 +205,7 @@ receivers:
 
 	require.NoError(t, err)
 	require.NotNil(t, config)
-	assert.Len(t, config.Route.Routes, 2)
+	assert.Len(t, config.Route.Routes, 3)
 
 	// Verify child routes
 	assert.Equal(t, "pagerduty", config.Route.Routes[0].Receiver)
@@ -208,6 +218,67 @@ receivers:
 	assert.True(t, ok)
 	_, ok = config.GetReceiver("slack")
 	assert.True(t, ok)
+	opsgenie, ok := config.GetReceiver("opsgenie")
+	require.True(t, ok)
+	require.Len(t, opsgenie.OpsgenieConfigs, 1)
+	assert.Equal(t, "P2", opsgenie.OpsgenieConfigs[0].Priority)
+	assert.Equal(t, "https://api.opsgenie.com/", opsgenie.OpsgenieConfigs[0].APIURL)
+}
+
+func TestRouteConfigParser_Parse_ChatOpsReceivers(t *testing.T) {
+	yamlConfig := `
+route:
+  receiver: teams
+  group_by: [alertname]
+  routes:
+    - receiver: telegram
+      match:
+        team: mobile
+
+receivers:
+  - name: teams
+    msteams_configs:
+      - webhook_url: https://example.webhook.office.com/webhookb2/abc/IncomingWebhook/def
+        title: "{{ .GroupLabels.alertname }}"
+  - name: telegram
+    telegram_configs:
+      - bot_token: "123456:ABC"
+        chat_id: -1001234567890
+        message_thread_id: 42
+        send_resolved: false
+`
+
+	parser := NewRouteConfigParser()
+	config, err := parser.Parse([]byte(yamlConfig))
+	require.NoError(t, err)
+
+	teams, ok := config.GetReceiver("teams")
+	require.True(t, ok)
+	require.Len(t, teams.MSTeamsConfigs, 1)
+	require.NotNil(t, teams.MSTeamsConfigs[0].SendResolved)
+	assert.True(t, *teams.MSTeamsConfigs[0].SendResolved)
+
+	telegram, ok := config.GetReceiver("telegram")
+	require.True(t, ok)
+	require.Len(t, telegram.TelegramConfigs, 1)
+	tg := telegram.TelegramConfigs[0]
+	assert.Equal(t, int64(-1001234567890), tg.ChatID)
+	assert.Equal(t, int64(42), tg.MessageThreadID)
+	assert.Equal(t, "HTML", tg.ParseMode)
+	assert.Equal(t, "https://api.telegram.org", tg.APIURL)
+	assert.False(t, *tg.SendResolved)
+
+	_, err = parser.Parse([]byte(`
+route:
+  receiver: telegram
+receivers:
+  - name: telegram
+    telegram_configs:
+      - bot_token: "123456:ABC"
+        chat_id: 1
+        parse_mode: BBCode
+`))
+	assert.Error(t, err, "unsupported parse_mode must be rejected")
 }
 
 func TestRouteConfigParser_Parse_RegexCompilation(t *testing.T) {
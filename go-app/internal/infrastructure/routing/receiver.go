@@ -2,6 +2,7 @@ package routing
 
 import (
 	"fmt"
+	"net/url"
 	"strings"
 )
 
@@ -12,7 +13,10 @@ import (
 //   - WebhookConfigs (generic HTTP webhook)
 //   - PagerDutyConfigs (PagerDuty Events API v2)
 //   - SlackConfigs (Slack Incoming Webhooks or API)
-//   - EmailConfigs (SMTP email, FUTURE - TN-154)
+//   - OpsgenieConfigs (Opsgenie Alert API v2)
+//   - EmailConfigs (SMTP email)
+//   - MSTeamsConfigs (Microsoft Teams Workflows / Incoming Webhooks)
+//   - TelegramConfigs (Telegram Bot API)
 //
 // Example YAML:
 //
@@ -47,10 +51,22 @@ type Receiver struct {
 	// Integrates with TN-054 (Slack Publisher)
 	SlackConfigs []*SlackConfig `yaml:"slack_configs,omitempty" validate:"dive"`
 
-	// EmailConfigs defines SMTP email receivers (FUTURE - TN-154)
-	// Uses global SMTP settings from GlobalConfig
+	// OpsgenieConfigs defines Opsgenie receivers
+	// Uses Opsgenie Alert API v2 (create/close by alias)
+	OpsgenieConfigs []*OpsgenieConfig `yaml:"opsgenie_configs,omitempty" validate:"dive"`
+
+	// EmailConfigs defines SMTP email receivers
+	// Unset SMTP settings are inherited from GlobalConfig
 	EmailConfigs []*EmailConfig `yaml:"email_configs,omitempty" validate:"dive"`
 
+	// MSTeamsConfigs defines Microsoft Teams receivers
+	// Posts Adaptive Cards to Workflows or legacy Incoming Webhook URLs
+	MSTeamsConfigs []*MSTeamsConfig `yaml:"msteams_configs,omitempty" validate:"dive"`
+
+	// TelegramConfigs defines Telegram receivers
+	// Uses Bot API sendMessage (resolved notifications reply to the firing message)
+	TelegramConfigs []*TelegramConfig `yaml:"telegram_configs,omitempty" validate:"dive"`
+
 	// Internal: Referenced tracks if receiver is used by any route
 	// Set to true during validation if route references this receiver
 	// Used to detect unused receivers (warning only)
@@ -65,7 +81,10 @@ func (r *Receiver) Validate() error {
 	if len(r.WebhookConfigs) == 0 &&
 		len(r.PagerDutyConfigs) == 0 &&
 		len(r.SlackConfigs) == 0 &&
-		len(r.EmailConfigs) == 0 {
+		len(r.OpsgenieConfigs) == 0 &&
+		len(r.EmailConfigs) == 0 &&
+		len(r.MSTeamsConfigs) == 0 &&
+		len(r.TelegramConfigs) == 0 {
 		return fmt.Errorf("receiver '%s' must have at least one config type defined", r.Name)
 	}
 	return nil
@@ -77,7 +96,10 @@ func (r *Receiver) GetConfigCount() int {
 	return len(r.WebhookConfigs) +
 		len(r.PagerDutyConfigs) +
 		len(r.SlackConfigs) +
-		len(r.EmailConfigs)
+		len(r.OpsgenieConfigs) +
+		len(r.EmailConfigs) +
+		len(r.MSTeamsConfigs) +
+		len(r.TelegramConfigs)
 }
 
 // Clone creates a deep copy of the receiver.
@@ -87,7 +109,10 @@ func (r *Receiver) Clone() *Receiver {
 		WebhookConfigs:   make([]*WebhookConfig, len(r.WebhookConfigs)),
 		PagerDutyConfigs: make([]*PagerDutyConfig, len(r.PagerDutyConfigs)),
 		SlackConfigs:     make([]*SlackConfig, len(r.SlackConfigs)),
+		OpsgenieConfigs:  make([]*OpsgenieConfig, len(r.OpsgenieConfigs)),
 		EmailConfigs:     make([]*EmailConfig, len(r.EmailConfigs)),
+		MSTeamsConfigs:   make([]*MSTeamsConfig, len(r.MSTeamsConfigs)),
+		TelegramConfigs:  make([]*TelegramConfig, len(r.TelegramConfigs)),
 		Referenced:       r.Referenced,
 	}
 
@@ -100,9 +125,18 @@ func (r *Receiver) Clone() *Receiver {
 	for i, cfg := range r.SlackConfigs {
 		clone.SlackConfigs[i] = cfg.Clone()
 	}
+	for i, cfg := range r.OpsgenieConfigs {
+		clone.OpsgenieConfigs[i] = cfg.Clone()
+	}
 	for i, cfg := range r.EmailConfigs {
 		clone.EmailConfigs[i] = cfg.Clone()
 	}
+	for i, cfg := range r.MSTeamsConfigs {
+		clone.MSTeamsConfigs[i] = cfg.Clone()
+	}
+	for i, cfg := range r.TelegramConfigs {
+		clone.TelegramConfigs[i] = cfg.Clone()
+	}
 
 	return clone
 }
@@ -126,9 +160,18 @@ func (r *Receiver) Sanitize() *Receiver {
 	for i, cfg := range clone.SlackConfigs {
 		clone.SlackConfigs[i] = cfg.Sanitize()
 	}
+	for i, cfg := range clone.OpsgenieConfigs {
+		clone.OpsgenieConfigs[i] = cfg.Sanitize()
+	}
 	for i, cfg := range clone.EmailConfigs {
 		clone.EmailConfigs[i] = cfg.Sanitize()
 	}
+	for i, cfg := range clone.MSTeamsConfigs {
+		clone.MSTeamsConfigs[i] = cfg.Sanitize()
+	}
+	for i, cfg := range clone.TelegramConfigs {
+		clone.TelegramConfigs[i] = cfg.Sanitize()
+	}
 
 	return clone
 }
@@ -489,10 +532,144 @@ func (a *SlackAction) Clone() *SlackAction {
 	}
 }
 
-// EmailConfig represents an SMTP email receiver (FUTURE - TN-154).
+// OpsgenieConfig represents an Opsgenie receiver configuration.
+// Alerts are created with alias = fingerprint and closed by alias on resolve.
+//
+// Example:
+//
+//	opsgenie_configs:
+//	  - api_key: "${OPSGENIE_API_KEY}"
+//	    message: "{{ .GroupLabels.alertname }}"
+//	    priority: P2
+//	    responders:
+//	      - type: team
+//	        name: sre
+//	    tags: "k8s,prod"
+type OpsgenieConfig struct {
+	// APIKey is the Opsgenie API integration key (required)
+	// Should use secret reference: ${OPSGENIE_API_KEY}
+	APIKey string `yaml:"api_key" validate:"required"`
+
+	// APIURL is the Opsgenie API base URL
+	// Default: https://api.opsgenie.com/ (EU: https://api.eu.opsgenie.com/)
+	APIURL string `yaml:"api_url,omitempty" validate:"omitempty,url,https_production"`
+
+	// Message is the alert message (max 130 chars, default: alert summary)
+	Message string `yaml:"message,omitempty"`
+
+	// Description is the alert description
+	Description string `yaml:"description,omitempty"`
+
+	// Source is the alert source (default: alert-history-service)
+	Source string `yaml:"source,omitempty"`
+
+	// Details are custom key-value properties
+	Details map[string]string `yaml:"details,omitempty"`
+
+	// Entity is the alert entity
+	Entity string `yaml:"entity,omitempty"`
+
+	// Responders are notified for the alert
+	Responders []*OpsgenieResponder `yaml:"responders,omitempty" validate:"dive"`
+
+	// Tags is a comma-separated list of tags
+	Tags string `yaml:"tags,omitempty"`
+
+	// Priority overrides severity-based priority
+	// Values: P1, P2, P3, P4, P5 (default: derived from classification severity)
+	Priority string `yaml:"priority,omitempty" validate:"omitempty,oneof=P1 P2 P3 P4 P5"`
+
+	// SendResolved determines if resolved notifications (alert close) are sent
+	SendResolved *bool `yaml:"send_resolved,omitempty"`
+
+	// HTTPConfig specifies HTTP client configuration
+	HTTPConfig *HTTPConfig `yaml:"http_config,omitempty"`
+}
+
+// OpsgenieResponder identifies an Opsgenie team, user, escalation or schedule.
+// One of ID, Name or Username is required.
+type OpsgenieResponder struct {
+	Type     string `yaml:"type" validate:"required,oneof=team user escalation schedule"`
+	ID       string `yaml:"id,omitempty"`
+	Name     string `yaml:"name,omitempty"`
+	Username string `yaml:"username,omitempty"`
+}
+
+// Defaults applies defaults.
+func (o *OpsgenieConfig) Defaults() {
+	if o.APIURL == "" {
+		o.APIURL = "https://api.opsgenie.com/"
+	}
+	if o.Source == "" {
+		o.Source = "alert-history-service"
+	}
+	if o.SendResolved == nil {
+		sendResolved := true
+		o.SendResolved = &sendResolved
+	}
+	if o.HTTPConfig != nil {
+		o.HTTPConfig.Defaults()
+	}
+}
+
+// Clone creates deep copy.
+func (o *OpsgenieConfig) Clone() *OpsgenieConfig {
+	clone := &OpsgenieConfig{
+		APIKey:      o.APIKey,
+		APIURL:      o.APIURL,
+		Message:     o.Message,
+		Description: o.Description,
+		Source:      o.Source,
+		Entity:      o.Entity,
+		Tags:        o.Tags,
+		Priority:    o.Priority,
+	}
+
+	if o.Details != nil {
+		clone.Details = make(map[string]string, len(o.Details))
+		for k, v := range o.Details {
+			clone.Details[k] = v
+		}
+	}
+	if o.Responders != nil {
+		clone.Responders = make([]*OpsgenieResponder, len(o.Responders))
+		for i, r := range o.Responders {
+			responder := *r
+			clone.Responders[i] = &responder
+		}
+	}
+	if o.HTTPConfig != nil {
+		clone.HTTPConfig = o.HTTPConfig.Clone()
+	}
+	if o.SendResolved != nil {
+		sendResolved := *o.SendResolved
+		clone.SendResolved = &sendResolved
+	}
+
+	return clone
+}
+
+// Sanitize redacts API key.
+func (o *OpsgenieConfig) Sanitize() *OpsgenieConfig {
+	clone := o.Clone()
+	if clone.APIKey != "" {
+		clone.APIKey = "[REDACTED]"
+	}
+	return clone
+}
+
+// EmailConfig represents an SMTP email receiver.
+// Smarthost, sender and auth settings default to the global smtp_* values.
 type EmailConfig struct {
 	To           string            `yaml:"to" validate:"required,email"`
 	From         string            `yaml:"from,omitempty"`
+	Smarthost    string            `yaml:"smarthost,omitempty" validate:"omitempty,hostname_port"`
+	Hello        string            `yaml:"hello,omitempty"`
+	AuthUsername string            `yaml:"auth_username,omitempty"`
+	AuthPassword string            `yaml:"auth_password,omitempty"`
+	AuthSecret   string            `yaml:"auth_secret,omitempty"`
+	AuthIdentity string            `yaml:"auth_identity,omitempty"`
+	RequireTLS   *bool             `yaml:"require_tls,omitempty"`
 	Subject      string            `yaml:"subject,omitempty"`
 	HTML         string            `yaml:"html,omitempty"`
 	Text         string            `yaml:"text,omitempty"`
@@ -502,20 +679,52 @@ type EmailConfig struct {
 
 // Defaults applies defaults.
 func (e *EmailConfig) Defaults() {
+	if e.RequireTLS == nil {
+		requireTLS := true
+		e.RequireTLS = &requireTLS
+	}
 	if e.SendResolved == nil {
 		sendResolved := true
 		e.SendResolved = &sendResolved
 	}
 }
 
+// InheritGlobal fills unset SMTP settings from global configuration.
+// Must be called before Defaults so smtp_require_tls is honoured.
+func (e *EmailConfig) InheritGlobal(global *GlobalConfig) {
+	if global == nil {
+		return
+	}
+	if e.From == "" {
+		e.From = global.SMTPFrom
+	}
+	if e.Smarthost == "" {
+		e.Smarthost = global.SMTPSmartHost
+	}
+	if e.AuthUsername == "" && e.AuthPassword == "" {
+		e.AuthUsername = global.SMTPAuthUsername
+		e.AuthPassword = global.SMTPAuthPassword
+	}
+	if e.RequireTLS == nil && global.SMTPSmartHost != "" {
+		requireTLS := global.SMTPRequireTLS
+		e.RequireTLS = &requireTLS
+	}
+}
+
 // Clone creates deep copy.
 func (e *EmailConfig) Clone() *EmailConfig {
 	clone := &EmailConfig{
-		To:      e.To,
-		From:    e.From,
-		Subject: e.Subject,
-		HTML:    e.HTML,
-		Text:    e.Text,
+		To:           e.To,
+		From:         e.From,
+		Smarthost:    e.Smarthost,
+		Hello:        e.Hello,
+		AuthUsername: e.AuthUsername,
+		AuthPassword: e.AuthPassword,
+		AuthSecret:   e.AuthSecret,
+		AuthIdentity: e.AuthIdentity,
+		Subject:      e.Subject,
+		HTML:         e.HTML,
+		Text:         e.Text,
 	}
 
 	if e.Headers != nil {
@@ -524,6 +733,10 @@ func (e *EmailConfig) Clone() *EmailConfig {
 			clone.Headers[k] = v
 		}
 	}
+	if e.RequireTLS != nil {
+		requireTLS := *e.RequireTLS
+		clone.RequireTLS = &requireTLS
+	}
 	if e.SendResolved != nil {
 		sendResolved := *e.SendResolved
 		clone.SendResolved = &sendResolved
@@ -532,11 +745,177 @@ func (e *EmailConfig) Clone() *EmailConfig {
 	return clone
 }
 
-// Sanitize redacts email addresses.
+// Sanitize redacts email addresses and SMTP credentials.
 func (e *EmailConfig) Sanitize() *EmailConfig {
 	clone := e.Clone()
 	clone.To = maskEmail(clone.To)
 	clone.From = maskEmail(clone.From)
+	if clone.AuthPassword != "" {
+		clone.AuthPassword = "[REDACTED]"
+	}
+	if clone.AuthSecret != "" {
+		clone.AuthSecret = "[REDACTED]"
+	}
+	return clone
+}
+
+// MSTeamsConfig represents a Microsoft Teams receiver configuration.
+// Title, Summary and Text are templates; the Adaptive Card layout is fixed.
+//
+// Example:
+//
+//	msteams_configs:
+//	  - webhook_url: "${TEAMS_WORKFLOW_URL}"
+//	    title: "{{ .GroupLabels.alertname }}"
+type MSTeamsConfig struct {
+	// WebhookURL is the Workflows or Incoming Webhook URL (required)
+	WebhookURL string `yaml:"webhook_url" validate:"required,url,https_production"`
+
+	// Title is the card title template
+	Title string `yaml:"title,omitempty"`
+
+	// Summary is the notification summary template (activity feed, mobile push)
+	Summary string `yaml:"summary,omitempty"`
+
+	// Text is the card body template
+	Text string `yaml:"text,omitempty"`
+
+	// SendResolved determines if resolved notifications are sent
+	SendResolved *bool `yaml:"send_resolved,omitempty"`
+
+	// HTTPConfig specifies HTTP client configuration
+	HTTPConfig *HTTPConfig `yaml:"http_config,omitempty"`
+}
+
+// Defaults applies defaults.
+func (m *MSTeamsConfig) Defaults() {
+	if m.SendResolved == nil {
+		sendResolved := true
+		m.SendResolved = &sendResolved
+	}
+	if m.HTTPConfig != nil {
+		m.HTTPConfig.Defaults()
+	}
+}
+
+// Clone creates deep copy.
+func (m *MSTeamsConfig) Clone() *MSTeamsConfig {
+	clone := &MSTeamsConfig{
+		WebhookURL: m.WebhookURL,
+		Title:      m.Title,
+		Summary:    m.Summary,
+		Text:       m.Text,
+	}
+
+	if m.HTTPConfig != nil {
+		clone.HTTPConfig = m.HTTPConfig.Clone()
+	}
+	if m.SendResolved != nil {
+		sendResolved := *m.SendResolved
+		clone.SendResolved = &sendResolved
+	}
+
+	return clone
+}
+
+// Sanitize redacts the webhook URL.
+// Teams webhook URLs carry their credential in the path or sig query parameter.
+func (m *MSTeamsConfig) Sanitize() *MSTeamsConfig {
+	clone := m.Clone()
+	if u, err := url.Parse(clone.WebhookURL); err == nil && u.Host != "" {
+		clone.WebhookURL = u.Scheme + "://" + u.Host + "/[REDACTED]"
+	} else if clone.WebhookURL != "" {
+		clone.WebhookURL = "[INVALID_URL]"
+	}
+	return clone
+}
+
+// TelegramConfig represents a Telegram receiver configuration.
+//
+// Example:
+//
+//	telegram_configs:
+//	  - bot_token: "${TELEGRAM_BOT_TOKEN}"
+//	    chat_id: -1001234567890
+//	    message_thread_id: 42
+//	    parse_mode: HTML
+type TelegramConfig struct {
+	// APIURL is the Bot API root URL
+	// Default: https://api.telegram.org
+	APIURL string `yaml:"api_url,omitempty" validate:"omitempty,url"`
+
+	// BotToken is the bot token from @BotFather (required)
+	BotToken string `yaml:"bot_token" validate:"required"`
+
+	// ChatID is the target chat ID (required, negative for groups and channels)
+	ChatID int64 `yaml:"chat_id" validate:"required"`
+
+	// MessageThreadID targets a forum topic in supergroups with topics enabled
+	MessageThreadID int64 `yaml:"message_thread_id,omitempty" validate:"omitempty,min=1"`
+
+	// Message is the message text template
+	Message string `yaml:"message,omitempty"`
+
+	// ParseMode is the Telegram parse mode for Message
+	// Values: HTML (default), Markdown, MarkdownV2
+	ParseMode string `yaml:"parse_mode,omitempty" validate:"omitempty,oneof=HTML Markdown MarkdownV2"`
+
+	// DisableNotifications sends messages silently
+	DisableNotifications bool `yaml:"disable_notifications,omitempty"`
+
+	// SendResolved determines if resolved notifications are sent
+	SendResolved *bool `yaml:"send_resolved,omitempty"`
+
+	// HTTPConfig specifies HTTP client configuration
+	HTTPConfig *HTTPConfig `yaml:"http_config,omitempty"`
+}
+
+// Defaults applies defaults.
+func (t *TelegramConfig) Defaults() {
+	if t.APIURL == "" {
+		t.APIURL = "https://api.telegram.org"
+	}
+	if t.ParseMode == "" {
+		t.ParseMode = "HTML"
+	}
+	if t.SendResolved == nil {
+		sendResolved := true
+		t.SendResolved = &sendResolved
+	}
+	if t.HTTPConfig != nil {
+		t.HTTPConfig.Defaults()
+	}
+}
+
+// Clone creates deep copy.
+func (t *TelegramConfig) Clone() *TelegramConfig {
+	clone := &TelegramConfig{
+		APIURL:               t.APIURL,
+		BotToken:             t.BotToken,
+		ChatID:               t.ChatID,
+		MessageThreadID:      t.MessageThreadID,
+		Message:              t.Message,
+		ParseMode:            t.ParseMode,
+		DisableNotifications: t.DisableNotifications,
+	}
+
+	if t.HTTPConfig != nil {
+		clone.HTTPConfig = t.HTTPConfig.Clone()
+	}
+	if t.SendResolved != nil {
+		sendResolved := *t.SendResolved
+		clone.SendResolved = &sendResolved
+	}
+
+	return clone
+}
+
+// Sanitize redacts bot token.
+func (t *TelegramConfig) Sanitize() *TelegramConfig {
+	clone := t.Clone()
+	if clone.BotToken != "" {
+		clone.BotToken = "[REDACTED]"
+	}
 	return clone
 }
 
@@ -0,0 +1,182 @@
+package silencing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// SilenceChangeType is the kind of change announced on a SilenceChangeFeed.
+type SilenceChangeType string
+
+const (
+	// SilenceChangeCreated is published after a silence was created.
+	SilenceChangeCreated SilenceChangeType = "created"
+
+	// SilenceChangeUpdated is published after a silence was updated.
+	SilenceChangeUpdated SilenceChangeType = "updated"
+
+	// SilenceChangeDeleted is published after a silence was deleted.
+	SilenceChangeDeleted SilenceChangeType = "deleted"
+
+	// SilenceChangeExpired is published after a silence was expired.
+	SilenceChangeExpired SilenceChangeType = "expired"
+
+	// SilenceChangeResync is emitted locally by a feed after it recovered
+	// from a connection loss: events may have been missed and subscribers
+	// should rebuild their state from the repository.
+	SilenceChangeResync SilenceChangeType = "resync"
+)
+
+// silenceChangeChannel is the Postgres NOTIFY channel / Redis pub/sub channel
+// carrying silence change events.
+const silenceChangeChannel = "silence_changes"
+
+// ErrChangeFeedClosed is returned when publishing to or subscribing on a closed feed.
+var ErrChangeFeedClosed = errors.New("silence change feed closed")
+
+// SilenceChangeEvent announces a silence change to other replicas.
+//
+// Events only carry the silence ID: receivers reload the silence from the
+// repository, which stays the source of truth (and keeps payloads well
+// below the 8000 byte Postgres NOTIFY limit).
+type SilenceChangeEvent struct {
+	// Type is the kind of change
+	Type SilenceChangeType `json:"type"`
+
+	// SilenceID is the changed silence (empty for resync)
+	SilenceID string `json:"silence_id,omitempty"`
+
+	// Origin identifies the publishing replica (used to skip own events)
+	Origin string `json:"origin"`
+
+	// Timestamp is when the change happened
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// SilenceChangeFeed pushes silence change events between replicas so that
+// silence caches are updated immediately instead of on the next periodic sync.
+//
+// Delivery is best-effort (at-most-once): subscribers must keep a periodic
+// full sync as a safety net.
+//
+// Implementations:
+//   - PostgresChangeFeed: LISTEN/NOTIFY on the silences database
+//   - RedisChangeFeed: Redis pub/sub
+//   - MemoryChangeFeed: in-process fan-out (single instance, tests)
+type SilenceChangeFeed interface {
+	// Publish announces a change to all subscribers (including other replicas).
+	Publish(ctx context.Context, event SilenceChangeEvent) error
+
+	// Subscribe returns a channel receiving change events. The channel is
+	// closed when ctx is cancelled or the feed is closed.
+	Subscribe(ctx context.Context) (<-chan SilenceChangeEvent, error)
+
+	// Close stops the feed and closes all subscription channels.
+	Close() error
+}
+
+// encodeChangeEvent serializes an event for the wire.
+func encodeChangeEvent(event SilenceChangeEvent) (string, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return "", fmt.Errorf("marshal silence change event: %w", err)
+	}
+	return string(payload), nil
+}
+
+// decodeChangeEvent parses an event received from the wire.
+func decodeChangeEvent(payload string) (SilenceChangeEvent, error) {
+	var event SilenceChangeEvent
+	if err := json.Unmarshal([]byte(payload), &event); err != nil {
+		return event, fmt.Errorf("unmarshal silence change event: %w", err)
+	}
+	return event, nil
+}
+
+// changeFeedBufferSize is the per-subscriber event buffer.
+const changeFeedBufferSize = 256
+
+// MemoryChangeFeed is an in-process SilenceChangeFeed.
+//
+// Events published to the feed are delivered to every subscriber of the same
+// instance. Slow subscribers whose buffer is full miss events (the periodic
+// sync repairs them).
+type MemoryChangeFeed struct {
+	mu          sync.Mutex
+	subscribers map[chan SilenceChangeEvent]struct{}
+	closed      bool
+}
+
+// NewMemoryChangeFeed creates a new in-process change feed.
+func NewMemoryChangeFeed() *MemoryChangeFeed {
+	return &MemoryChangeFeed{
+		subscribers: make(map[chan SilenceChangeEvent]struct{}),
+	}
+}
+
+// Publish delivers the event to all subscribers.
+func (f *MemoryChangeFeed) Publish(ctx context.Context, event SilenceChangeEvent) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return ErrChangeFeedClosed
+	}
+	for ch := range f.subscribers {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+	return nil
+}
+
+// Subscribe registers a new subscriber.
+func (f *MemoryChangeFeed) Subscribe(ctx context.Context) (<-chan SilenceChangeEvent, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return nil, ErrChangeFeedClosed
+	}
+
+	ch := make(chan SilenceChangeEvent, changeFeedBufferSize)
+	f.subscribers[ch] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+		f.unsubscribe(ch)
+	}()
+
+	return ch, nil
+}
+
+// Close closes all subscription channels.
+func (f *MemoryChangeFeed) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return nil
+	}
+	f.closed = true
+	for ch := range f.subscribers {
+		delete(f.subscribers, ch)
+		close(ch)
+	}
+	return nil
+}
+
+func (f *MemoryChangeFeed) unsubscribe(ch chan SilenceChangeEvent) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.subscribers[ch]; ok {
+		delete(f.subscribers, ch)
+		close(ch)
+	}
+}
@@ -0,0 +1,187 @@
+package silencing
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Reconnect backoff of change feed subscriptions.
+const (
+	changeFeedMinBackoff = 1 * time.Second
+	changeFeedMaxBackoff = 30 * time.Second
+)
+
+// PostgresChangeFeed implements SilenceChangeFeed with PostgreSQL LISTEN/NOTIFY.
+//
+// Publish sends pg_notify('silence_changes', <json>) through the pool; every
+// subscription holds a dedicated pool connection in LISTEN mode. Lost
+// connections are re-established with exponential backoff, after which a
+// SilenceChangeResync event is emitted (notifications sent meanwhile are lost).
+type PostgresChangeFeed struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewPostgresChangeFeed creates a new LISTEN/NOTIFY change feed.
+func NewPostgresChangeFeed(pool *pgxpool.Pool, logger *slog.Logger) *PostgresChangeFeed {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &PostgresChangeFeed{
+		pool:   pool,
+		logger: logger,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Publish sends the event with pg_notify.
+func (f *PostgresChangeFeed) Publish(ctx context.Context, event SilenceChangeEvent) error {
+	if f.ctx.Err() != nil {
+		return ErrChangeFeedClosed
+	}
+
+	payload, err := encodeChangeEvent(event)
+	if err != nil {
+		return err
+	}
+	if _, err := f.pool.Exec(ctx, "SELECT pg_notify($1, $2)", silenceChangeChannel, payload); err != nil {
+		return fmt.Errorf("notify silence change: %w", err)
+	}
+	return nil
+}
+
+// Subscribe starts listening on the silence_changes channel.
+//
+// The first LISTEN is performed synchronously so that connection errors are
+// reported to the caller.
+func (f *PostgresChangeFeed) Subscribe(ctx context.Context) (<-chan SilenceChangeEvent, error) {
+	if f.ctx.Err() != nil {
+		return nil, ErrChangeFeedClosed
+	}
+
+	conn, err := f.listen(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan SilenceChangeEvent, changeFeedBufferSize)
+	subCtx, cancel := context.WithCancel(ctx)
+
+	f.wg.Add(1)
+	go func() {
+		defer f.wg.Done()
+		defer cancel()
+		defer close(events)
+
+		// Stop with the feed as well as with the subscriber
+		go func() {
+			select {
+			case <-f.ctx.Done():
+				cancel()
+			case <-subCtx.Done():
+			}
+		}()
+
+		f.receive(subCtx, conn, events)
+	}()
+
+	return events, nil
+}
+
+// Close stops all subscriptions.
+func (f *PostgresChangeFeed) Close() error {
+	f.cancel()
+	f.wg.Wait()
+	return nil
+}
+
+// listen acquires a dedicated connection and issues LISTEN.
+func (f *PostgresChangeFeed) listen(ctx context.Context) (*pgxpool.Conn, error) {
+	conn, err := f.pool.Acquire(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("acquire listen connection: %w", err)
+	}
+	if _, err := conn.Exec(ctx, "LISTEN "+silenceChangeChannel); err != nil {
+		conn.Release()
+		return nil, fmt.Errorf("listen %s: %w", silenceChangeChannel, err)
+	}
+	return conn, nil
+}
+
+// receive forwards notifications until ctx is done, reconnecting on errors.
+func (f *PostgresChangeFeed) receive(ctx context.Context, conn *pgxpool.Conn, events chan<- SilenceChangeEvent) {
+	backoff := changeFeedMinBackoff
+
+	for {
+		err := f.forward(ctx, conn, events)
+		// The connection may be in LISTEN state or broken: never return it to the pool
+		_ = conn.Conn().Close(context.Background())
+		conn.Release()
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		f.logger.Warn("Silence change feed connection lost, reconnecting",
+			"error", err,
+			"backoff", backoff)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+
+			conn, err = f.listen(ctx)
+			if err == nil {
+				break
+			}
+			backoff = min(backoff*2, changeFeedMaxBackoff)
+			f.logger.Warn("Silence change feed reconnect failed",
+				"error", err,
+				"backoff", backoff)
+		}
+
+		backoff = changeFeedMinBackoff
+		f.logger.Info("Silence change feed reconnected")
+		select {
+		case events <- SilenceChangeEvent{Type: SilenceChangeResync, Timestamp: time.Now()}:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// forward delivers notifications of a listening connection.
+func (f *PostgresChangeFeed) forward(ctx context.Context, conn *pgxpool.Conn, events chan<- SilenceChangeEvent) error {
+	for {
+		notification, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		event, err := decodeChangeEvent(notification.Payload)
+		if err != nil {
+			f.logger.Warn("Ignoring malformed silence change notification", "error", err)
+			continue
+		}
+
+		select {
+		case events <- event:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
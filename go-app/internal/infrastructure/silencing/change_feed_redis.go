@@ -0,0 +1,133 @@
+package silencing
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisChangeFeed implements SilenceChangeFeed with Redis pub/sub.
+//
+// go-redis re-subscribes automatically after connection loss; every
+// re-subscription after the first one emits a SilenceChangeResync event
+// because messages published meanwhile are lost.
+type RedisChangeFeed struct {
+	client *redis.Client
+	logger *slog.Logger
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewRedisChangeFeed creates a new Redis pub/sub change feed.
+func NewRedisChangeFeed(client *redis.Client, logger *slog.Logger) *RedisChangeFeed {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &RedisChangeFeed{
+		client: client,
+		logger: logger,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Publish publishes the event on the silence_changes channel.
+func (f *RedisChangeFeed) Publish(ctx context.Context, event SilenceChangeEvent) error {
+	if f.ctx.Err() != nil {
+		return ErrChangeFeedClosed
+	}
+
+	payload, err := encodeChangeEvent(event)
+	if err != nil {
+		return err
+	}
+	if err := f.client.Publish(ctx, silenceChangeChannel, payload).Err(); err != nil {
+		return fmt.Errorf("publish silence change: %w", err)
+	}
+	return nil
+}
+
+// Subscribe subscribes to the silence_changes channel.
+func (f *RedisChangeFeed) Subscribe(ctx context.Context) (<-chan SilenceChangeEvent, error) {
+	if f.ctx.Err() != nil {
+		return nil, ErrChangeFeedClosed
+	}
+
+	pubsub := f.client.Subscribe(ctx, silenceChangeChannel)
+	// Wait for the subscription confirmation so errors reach the caller
+	if _, err := pubsub.Receive(ctx); err != nil {
+		_ = pubsub.Close()
+		return nil, fmt.Errorf("subscribe %s: %w", silenceChangeChannel, err)
+	}
+
+	events := make(chan SilenceChangeEvent, changeFeedBufferSize)
+	messages := pubsub.ChannelWithSubscriptions(redis.WithChannelSize(changeFeedBufferSize))
+
+	f.wg.Add(1)
+	go func() {
+		defer f.wg.Done()
+		defer close(events)
+		defer pubsub.Close()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-f.ctx.Done():
+				return
+			case msg, ok := <-messages:
+				if !ok {
+					return
+				}
+				event, ok := f.toEvent(msg)
+				if !ok {
+					continue
+				}
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				case <-f.ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// Close stops all subscriptions.
+func (f *RedisChangeFeed) Close() error {
+	f.cancel()
+	f.wg.Wait()
+	return nil
+}
+
+// toEvent converts a pub/sub message; re-subscriptions become resync events.
+func (f *RedisChangeFeed) toEvent(msg interface{}) (SilenceChangeEvent, bool) {
+	switch m := msg.(type) {
+	case *redis.Subscription:
+		if m.Kind != "subscribe" {
+			return SilenceChangeEvent{}, false
+		}
+		f.logger.Info("Silence change feed re-subscribed")
+		return SilenceChangeEvent{Type: SilenceChangeResync, Timestamp: time.Now()}, true
+	case *redis.Message:
+		event, err := decodeChangeEvent(m.Payload)
+		if err != nil {
+			f.logger.Warn("Ignoring malformed silence change message", "error", err)
+			return SilenceChangeEvent{}, false
+		}
+		return event, true
+	default:
+		return SilenceChangeEvent{}, false
+	}
+}
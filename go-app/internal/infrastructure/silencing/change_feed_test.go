@@ -0,0 +1,118 @@
+package silencing
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func receiveChangeEvent(t *testing.T, events <-chan SilenceChangeEvent) SilenceChangeEvent {
+	t.Helper()
+	select {
+	case event, ok := <-events:
+		require.True(t, ok, "events channel closed")
+		return event
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for silence change event")
+		return SilenceChangeEvent{}
+	}
+}
+
+func TestMemoryChangeFeed_FanOut(t *testing.T) {
+	feed := NewMemoryChangeFeed()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	first, err := feed.Subscribe(ctx)
+	require.NoError(t, err)
+	second, err := feed.Subscribe(ctx)
+	require.NoError(t, err)
+
+	event := SilenceChangeEvent{Type: SilenceChangeCreated, SilenceID: "id-1", Origin: "pod-a", Timestamp: time.Now()}
+	require.NoError(t, feed.Publish(ctx, event))
+
+	assert.Equal(t, event, receiveChangeEvent(t, first))
+	assert.Equal(t, event, receiveChangeEvent(t, second))
+
+	require.NoError(t, feed.Close())
+	_, ok := <-first
+	assert.False(t, ok, "Close must close subscriptions")
+	assert.ErrorIs(t, feed.Publish(ctx, event), ErrChangeFeedClosed)
+	_, err = feed.Subscribe(ctx)
+	assert.ErrorIs(t, err, ErrChangeFeedClosed)
+}
+
+func TestMemoryChangeFeed_UnsubscribeOnContextDone(t *testing.T) {
+	feed := NewMemoryChangeFeed()
+	defer feed.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, err := feed.Subscribe(ctx)
+	require.NoError(t, err)
+
+	cancel()
+	require.Eventually(t, func() bool {
+		select {
+		case _, ok := <-events:
+			return !ok
+		default:
+			return false
+		}
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestRedisChangeFeed_PublishSubscribe(t *testing.T) {
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	defer mr.Close()
+
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer client.Close()
+
+	podA := NewRedisChangeFeed(client, slog.Default())
+	podB := NewRedisChangeFeed(client, slog.Default())
+	defer podA.Close()
+	defer podB.Close()
+
+	ctx := context.Background()
+	events, err := podB.Subscribe(ctx)
+	require.NoError(t, err)
+
+	event := SilenceChangeEvent{
+		Type:      SilenceChangeExpired,
+		SilenceID: "id-1",
+		Origin:    "pod-a",
+		Timestamp: time.Now().UTC().Truncate(time.Millisecond),
+	}
+	require.NoError(t, podA.Publish(ctx, event))
+
+	received := receiveChangeEvent(t, events)
+	assert.Equal(t, event.Type, received.Type)
+	assert.Equal(t, event.SilenceID, received.SilenceID)
+	assert.Equal(t, event.Origin, received.Origin)
+	assert.True(t, event.Timestamp.Equal(received.Timestamp))
+
+	require.NoError(t, podB.Close())
+	_, ok := <-events
+	assert.False(t, ok, "Close must close subscriptions")
+}
+
+func TestChangeEventEncoding(t *testing.T) {
+	event := SilenceChangeEvent{Type: SilenceChangeDeleted, SilenceID: "id-1", Origin: "pod-a"}
+	payload, err := encodeChangeEvent(event)
+	require.NoError(t, err)
+
+	decoded, err := decodeChangeEvent(payload)
+	require.NoError(t, err)
+	assert.Equal(t, event.Type, decoded.Type)
+	assert.Equal(t, event.SilenceID, decoded.SilenceID)
+
+	_, err = decodeChangeEvent("{not json")
+	assert.Error(t, err)
+}
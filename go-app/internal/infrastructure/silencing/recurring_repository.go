@@ -0,0 +1,342 @@
+package silencing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/vitaliisemenov/alert-history/internal/core/silencing"
+)
+
+// RecurringSilenceRepository persists recurring silence series and their
+// materialised occurrences.
+//
+// Occurrences are keyed by (series, start time): ClaimOccurrence succeeds for
+// exactly one caller, so several replicas can materialise the same series
+// without creating duplicate silences.
+type RecurringSilenceRepository interface {
+	// CreateRecurringSilence stores a new series (generates the ID if empty).
+	CreateRecurringSilence(ctx context.Context, series *silencing.RecurringSilence) (*silencing.RecurringSilence, error)
+
+	// GetRecurringSilence returns a series by ID.
+	//
+	// Errors:
+	//   - ErrRecurringSilenceNotFound if the series does not exist
+	//   - ErrInvalidUUID if id is not a valid UUID
+	GetRecurringSilence(ctx context.Context, id string) (*silencing.RecurringSilence, error)
+
+	// ListRecurringSilences returns all series, newest first.
+	// Cancelled series are only included if includeCancelled is set.
+	ListRecurringSilences(ctx context.Context, includeCancelled bool) ([]*silencing.RecurringSilence, error)
+
+	// UpdateRecurringSilence updates the definition of a series and sets UpdatedAt.
+	//
+	// Errors:
+	//   - ErrRecurringSilenceNotFound if the series does not exist or is cancelled
+	UpdateRecurringSilence(ctx context.Context, series *silencing.RecurringSilence) error
+
+	// CancelRecurringSilence marks a series as cancelled at the given time.
+	//
+	// Errors:
+	//   - ErrRecurringSilenceNotFound if the series does not exist or is already cancelled
+	CancelRecurringSilence(ctx context.Context, id string, at time.Time) error
+
+	// ClaimOccurrence reserves the occurrence of a series starting at startsAt.
+	// Returns false if the occurrence was already claimed.
+	ClaimOccurrence(ctx context.Context, seriesID string, startsAt time.Time) (bool, error)
+
+	// SetOccurrenceSilence records the silence created for a claimed occurrence.
+	SetOccurrenceSilence(ctx context.Context, seriesID string, startsAt time.Time, silenceID string) error
+
+	// ReleaseOccurrence removes an occurrence, so it is materialised again.
+	ReleaseOccurrence(ctx context.Context, seriesID string, startsAt time.Time) error
+
+	// ListOccurrences returns the occurrences of a series, oldest first.
+	ListOccurrences(ctx context.Context, seriesID string) ([]*silencing.RecurringOccurrence, error)
+
+	// PruneOccurrences deletes occurrences starting before the given time.
+	// Returns the number of deleted occurrences.
+	PruneOccurrences(ctx context.Context, before time.Time) (int64, error)
+}
+
+// PostgresRecurringSilenceRepository implements RecurringSilenceRepository
+// with the recurring_silences and recurring_silence_occurrences tables.
+type PostgresRecurringSilenceRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewPostgresRecurringSilenceRepository creates a new PostgreSQL recurring silence repository.
+//
+// Example:
+//
+//	repo := NewPostgresRecurringSilenceRepository(pool, slog.Default())
+func NewPostgresRecurringSilenceRepository(pool *pgxpool.Pool, logger *slog.Logger) *PostgresRecurringSilenceRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &PostgresRecurringSilenceRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+const recurringSilenceColumns = `id, created_by, comment, matchers, schedule, timezone, duration_seconds,
+	starts_at, ends_at, cancelled_at, created_at, updated_at`
+
+// CreateRecurringSilence implements RecurringSilenceRepository.CreateRecurringSilence.
+func (r *PostgresRecurringSilenceRepository) CreateRecurringSilence(ctx context.Context, series *silencing.RecurringSilence) (*silencing.RecurringSilence, error) {
+	if err := series.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrValidation, err)
+	}
+	if series.ID == "" {
+		series.ID = uuid.New().String()
+	}
+	if series.Timezone == "" {
+		series.Timezone = "UTC"
+	}
+
+	matchersJSON, err := json.Marshal(series.Matchers)
+	if err != nil {
+		return nil, fmt.Errorf("marshal matchers: %w", err)
+	}
+
+	query := `
+		INSERT INTO recurring_silences (id, created_by, comment, matchers, schedule, timezone,
+			duration_seconds, starts_at, ends_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW())
+		RETURNING created_at
+	`
+	err = r.pool.QueryRow(ctx, query,
+		series.ID,
+		series.CreatedBy,
+		series.Comment,
+		matchersJSON,
+		series.Schedule,
+		series.Timezone,
+		int64(series.Duration/time.Second),
+		series.StartsAt,
+		series.EndsAt,
+	).Scan(&series.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("insert recurring silence: %w", err)
+	}
+
+	r.logger.Debug("recurring silence created", "id", series.ID, "schedule", series.Schedule)
+	return series, nil
+}
+
+// GetRecurringSilence implements RecurringSilenceRepository.GetRecurringSilence.
+func (r *PostgresRecurringSilenceRepository) GetRecurringSilence(ctx context.Context, id string) (*silencing.RecurringSilence, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidUUID, err)
+	}
+
+	query := `SELECT ` + recurringSilenceColumns + ` FROM recurring_silences WHERE id = $1`
+	series, err := scanRecurringSilence(r.pool.QueryRow(ctx, query, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %s", ErrRecurringSilenceNotFound, id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("query recurring silence: %w", err)
+	}
+	return series, nil
+}
+
+// ListRecurringSilences implements RecurringSilenceRepository.ListRecurringSilences.
+func (r *PostgresRecurringSilenceRepository) ListRecurringSilences(ctx context.Context, includeCancelled bool) ([]*silencing.RecurringSilence, error) {
+	query := `SELECT ` + recurringSilenceColumns + ` FROM recurring_silences`
+	if !includeCancelled {
+		query += ` WHERE cancelled_at IS NULL`
+	}
+	query += ` ORDER BY created_at DESC`
+
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("query recurring silences: %w", err)
+	}
+	defer rows.Close()
+
+	result := make([]*silencing.RecurringSilence, 0)
+	for rows.Next() {
+		series, err := scanRecurringSilence(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan recurring silence: %w", err)
+		}
+		result = append(result, series)
+	}
+	return result, rows.Err()
+}
+
+// UpdateRecurringSilence implements RecurringSilenceRepository.UpdateRecurringSilence.
+func (r *PostgresRecurringSilenceRepository) UpdateRecurringSilence(ctx context.Context, series *silencing.RecurringSilence) error {
+	if err := series.Validate(); err != nil {
+		return fmt.Errorf("%w: %s", ErrValidation, err)
+	}
+	if series.Timezone == "" {
+		series.Timezone = "UTC"
+	}
+
+	matchersJSON, err := json.Marshal(series.Matchers)
+	if err != nil {
+		return fmt.Errorf("marshal matchers: %w", err)
+	}
+
+	query := `
+		UPDATE recurring_silences
+		SET created_by = $2, comment = $3, matchers = $4, schedule = $5, timezone = $6,
+			duration_seconds = $7, starts_at = $8, ends_at = $9, updated_at = NOW()
+		WHERE id = $1 AND cancelled_at IS NULL
+		RETURNING updated_at
+	`
+	var updatedAt time.Time
+	err = r.pool.QueryRow(ctx, query,
+		series.ID,
+		series.CreatedBy,
+		series.Comment,
+		matchersJSON,
+		series.Schedule,
+		series.Timezone,
+		int64(series.Duration/time.Second),
+		series.StartsAt,
+		series.EndsAt,
+	).Scan(&updatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("%w: %s", ErrRecurringSilenceNotFound, series.ID)
+	}
+	if err != nil {
+		return fmt.Errorf("update recurring silence: %w", err)
+	}
+
+	series.UpdatedAt = &updatedAt
+	return nil
+}
+
+// CancelRecurringSilence implements RecurringSilenceRepository.CancelRecurringSilence.
+func (r *PostgresRecurringSilenceRepository) CancelRecurringSilence(ctx context.Context, id string, at time.Time) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidUUID, err)
+	}
+
+	tag, err := r.pool.Exec(ctx, `
+		UPDATE recurring_silences
+		SET cancelled_at = $2, updated_at = NOW()
+		WHERE id = $1 AND cancelled_at IS NULL
+	`, id, at)
+	if err != nil {
+		return fmt.Errorf("cancel recurring silence: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%w: %s", ErrRecurringSilenceNotFound, id)
+	}
+	return nil
+}
+
+// ClaimOccurrence implements RecurringSilenceRepository.ClaimOccurrence.
+func (r *PostgresRecurringSilenceRepository) ClaimOccurrence(ctx context.Context, seriesID string, startsAt time.Time) (bool, error) {
+	tag, err := r.pool.Exec(ctx, `
+		INSERT INTO recurring_silence_occurrences (series_id, starts_at)
+		VALUES ($1, $2)
+		ON CONFLICT (series_id, starts_at) DO NOTHING
+	`, seriesID, startsAt)
+	if err != nil {
+		return false, fmt.Errorf("claim occurrence: %w", err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+// SetOccurrenceSilence implements RecurringSilenceRepository.SetOccurrenceSilence.
+func (r *PostgresRecurringSilenceRepository) SetOccurrenceSilence(ctx context.Context, seriesID string, startsAt time.Time, silenceID string) error {
+	_, err := r.pool.Exec(ctx, `
+		UPDATE recurring_silence_occurrences
+		SET silence_id = $3
+		WHERE series_id = $1 AND starts_at = $2
+	`, seriesID, startsAt, silenceID)
+	if err != nil {
+		return fmt.Errorf("set occurrence silence: %w", err)
+	}
+	return nil
+}
+
+// ReleaseOccurrence implements RecurringSilenceRepository.ReleaseOccurrence.
+func (r *PostgresRecurringSilenceRepository) ReleaseOccurrence(ctx context.Context, seriesID string, startsAt time.Time) error {
+	_, err := r.pool.Exec(ctx, `
+		DELETE FROM recurring_silence_occurrences
+		WHERE series_id = $1 AND starts_at = $2
+	`, seriesID, startsAt)
+	if err != nil {
+		return fmt.Errorf("release occurrence: %w", err)
+	}
+	return nil
+}
+
+// ListOccurrences implements RecurringSilenceRepository.ListOccurrences.
+func (r *PostgresRecurringSilenceRepository) ListOccurrences(ctx context.Context, seriesID string) ([]*silencing.RecurringOccurrence, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT series_id, starts_at, COALESCE(silence_id::text, ''), created_at
+		FROM recurring_silence_occurrences
+		WHERE series_id = $1
+		ORDER BY starts_at
+	`, seriesID)
+	if err != nil {
+		return nil, fmt.Errorf("query occurrences: %w", err)
+	}
+	defer rows.Close()
+
+	result := make([]*silencing.RecurringOccurrence, 0)
+	for rows.Next() {
+		var occurrence silencing.RecurringOccurrence
+		if err := rows.Scan(&occurrence.SeriesID, &occurrence.StartsAt, &occurrence.SilenceID, &occurrence.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan occurrence: %w", err)
+		}
+		result = append(result, &occurrence)
+	}
+	return result, rows.Err()
+}
+
+// PruneOccurrences implements RecurringSilenceRepository.PruneOccurrences.
+func (r *PostgresRecurringSilenceRepository) PruneOccurrences(ctx context.Context, before time.Time) (int64, error) {
+	tag, err := r.pool.Exec(ctx, `DELETE FROM recurring_silence_occurrences WHERE starts_at < $1`, before)
+	if err != nil {
+		return 0, fmt.Errorf("prune occurrences: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// scanRecurringSilence scans a row selected with recurringSilenceColumns.
+func scanRecurringSilence(row pgx.Row) (*silencing.RecurringSilence, error) {
+	var series silencing.RecurringSilence
+	var matchersJSON []byte
+	var durationSeconds int64
+
+	err := row.Scan(
+		&series.ID,
+		&series.CreatedBy,
+		&series.Comment,
+		&matchersJSON,
+		&series.Schedule,
+		&series.Timezone,
+		&durationSeconds,
+		&series.StartsAt,
+		&series.EndsAt,
+		&series.CancelledAt,
+		&series.CreatedAt,
+		&series.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(matchersJSON, &series.Matchers); err != nil {
+		return nil, fmt.Errorf("unmarshal matchers: %w", err)
+	}
+	series.Duration = time.Duration(durationSeconds) * time.Second
+	return &series, nil
+}
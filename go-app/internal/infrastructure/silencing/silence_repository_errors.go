@@ -42,4 +42,8 @@ var (
 	// This wraps validation errors from silencing.Silence.Validate().
 	// This typically maps to HTTP 400 Bad Request.
 	ErrValidation = errors.New("validation failed")
+
+	// ErrRecurringSilenceNotFound is returned when a recurring silence series does not exist.
+	// This typically maps to HTTP 404 Not Found.
+	ErrRecurringSilenceNotFound = errors.New("recurring silence not found")
 )
@@ -0,0 +1,233 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+)
+
+// ErrUnknownSource is returned for a webhook source without parser or adapter.
+var ErrUnknownSource = errors.New("unknown webhook source")
+
+// SourceAdapter converts the webhook payloads of a monitoring system into
+// domain alerts. Adapters are selected by name (POST /webhook/{source}) or
+// auto-detected on POST requests handled by UniversalWebhookHandler.
+type SourceAdapter interface {
+	// Name returns the source name (the {source} path segment, the webhook_type metric label).
+	Name() string
+
+	// Detect reports whether the payload was sent by this source.
+	// Adapters that are only reachable by name return false.
+	Detect(payload []byte) bool
+
+	// Parse converts the payload into alerts with stable fingerprints:
+	// the same source alert yields the same fingerprint when firing and resolved.
+	Parse(payload []byte) ([]*core.Alert, error)
+}
+
+// SubscriptionConfirmer is implemented by adapters whose source requires
+// the endpoint to confirm a subscription before sending alerts (AWS SNS).
+type SubscriptionConfirmer interface {
+	// ConfirmSubscription handles subscription control messages, reporting
+	// whether payload was one (and then carries no alerts).
+	ConfirmSubscription(ctx context.Context, payload []byte) (bool, error)
+}
+
+// AdapterRegistry holds the source adapters of a webhook handler.
+type AdapterRegistry struct {
+	mu       sync.RWMutex
+	adapters []SourceAdapter
+}
+
+// NewAdapterRegistry creates a registry with the given adapters.
+func NewAdapterRegistry(adapters ...SourceAdapter) *AdapterRegistry {
+	registry := &AdapterRegistry{}
+	for _, adapter := range adapters {
+		registry.Register(adapter)
+	}
+	return registry
+}
+
+// DefaultAdapterRegistry creates a registry with the built-in adapters
+// (Grafana, Datadog, CloudWatch via SNS).
+func DefaultAdapterRegistry() *AdapterRegistry {
+	return NewAdapterRegistry(
+		NewGrafanaAdapter(),
+		NewDatadogAdapter(),
+		NewCloudWatchAdapter(SNSConfig{}, nil),
+	)
+}
+
+// Register adds an adapter, replacing the adapter with the same name.
+func (r *AdapterRegistry) Register(adapter SourceAdapter) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, existing := range r.adapters {
+		if existing.Name() == adapter.Name() {
+			r.adapters[i] = adapter
+			return
+		}
+	}
+	r.adapters = append(r.adapters, adapter)
+}
+
+// Get returns the adapter with the given name.
+func (r *AdapterRegistry) Get(name string) (SourceAdapter, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, adapter := range r.adapters {
+		if adapter.Name() == name {
+			return adapter, true
+		}
+	}
+	return nil, false
+}
+
+// Detect returns the first adapter (in registration order) detecting payload.
+func (r *AdapterRegistry) Detect(payload []byte) (SourceAdapter, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, adapter := range r.adapters {
+		if adapter.Detect(payload) {
+			return adapter, true
+		}
+	}
+	return nil, false
+}
+
+// Names returns the registered source names.
+func (r *AdapterRegistry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, len(r.adapters))
+	for i, adapter := range r.adapters {
+		names[i] = adapter.Name()
+	}
+	return names
+}
+
+// validateAdapterAlert checks the fields required to store and route an alert.
+func validateAdapterAlert(alert *core.Alert) error {
+	if alert.AlertName == "" {
+		return errors.New("alertname is required")
+	}
+	if alert.Fingerprint == "" {
+		return errors.New("fingerprint is required")
+	}
+	if alert.Status != core.StatusFiring && alert.Status != core.StatusResolved {
+		return fmt.Errorf("invalid status %q", alert.Status)
+	}
+	if alert.StartsAt.IsZero() {
+		return errors.New("startsAt is required")
+	}
+	return nil
+}
+
+// newAdapterAlert builds a domain alert from source fields. The source label
+// is added and alertname is set; the fingerprint is derived from identity
+// (stable source identifiers), or from all labels if identity is empty.
+func newAdapterAlert(source, alertName string, status core.AlertStatus, labels, annotations, identity map[string]string, startsAt time.Time, endsAt *time.Time, generatorURL string) *core.Alert {
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	labels["alertname"] = alertName
+	if _, ok := labels["source"]; !ok {
+		labels["source"] = source
+	}
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+
+	var fingerprint string
+	if len(identity) > 0 {
+		fingerprint = generateFingerprint(source+"/"+alertName, identity)
+	} else {
+		fingerprint = generateFingerprint(alertName, labels)
+	}
+
+	now := time.Now()
+	alert := &core.Alert{
+		Fingerprint: fingerprint,
+		AlertName:   alertName,
+		Status:      status,
+		Labels:      labels,
+		Annotations: annotations,
+		StartsAt:    startsAt,
+		EndsAt:      endsAt,
+		Timestamp:   &now,
+	}
+	if generatorURL != "" {
+		alert.GeneratorURL = &generatorURL
+	}
+	return alert
+}
+
+// invalidLabelChars matches characters not allowed in Prometheus label names.
+var invalidLabelChars = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+// sanitizeLabelName converts a source key (tag, dimension) into a label name.
+func sanitizeLabelName(name string) string {
+	name = invalidLabelChars.ReplaceAllString(strings.TrimSpace(name), "_")
+	if name != "" && name[0] >= '0' && name[0] <= '9' {
+		name = "_" + name
+	}
+	return name
+}
+
+// parseSourceTime parses RFC 3339 timestamps and Unix epochs in seconds or
+// milliseconds.
+func parseSourceTime(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if epoch, err := strconv.ParseFloat(value, 64); err == nil {
+		if epoch > 1e12 {
+			return time.UnixMilli(int64(epoch)).UTC(), nil
+		}
+		seconds := int64(epoch)
+		return time.Unix(seconds, int64((epoch-float64(seconds))*1e9)).UTC(), nil
+	}
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.000-0700", "2006-01-02T15:04:05-0700"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid timestamp %q", value)
+}
+
+// jsonString returns a decoded JSON scalar as string ("" for null, JSON for objects).
+func jsonString(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		data, _ := json.Marshal(v)
+		return string(data)
+	}
+}
+
+// firstNonEmpty returns the first non-empty value.
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,425 @@
+package webhook
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+)
+
+// SNSConfig configures the AWS SNS HTTP(S) subscription of the CloudWatch adapter.
+type SNSConfig struct {
+	// AllowedTopicARNs restricts accepted topics (empty accepts any topic).
+	// When set, alarms posted directly (not through SNS) are rejected.
+	AllowedTopicARNs []string `yaml:"allowed_topic_arns"`
+
+	// DisableAutoConfirm leaves subscriptions pending (confirm them in the AWS console)
+	DisableAutoConfirm bool `yaml:"disable_auto_confirm"`
+}
+
+// snsMessage is an AWS SNS HTTP(S) endpoint message.
+type snsMessage struct {
+	Type         string `json:"Type"`
+	MessageID    string `json:"MessageId"`
+	TopicArn     string `json:"TopicArn"`
+	Subject      string `json:"Subject"`
+	Message      string `json:"Message"`
+	Timestamp    string `json:"Timestamp"`
+	SubscribeURL string `json:"SubscribeURL"`
+	Token        string `json:"Token"`
+
+	Signature        string `json:"Signature"`
+	SignatureVersion string `json:"SignatureVersion"`
+	SigningCertURL   string `json:"SigningCertURL"`
+}
+
+// stringToSign returns the canonical message AWS signs (see "Verifying the
+// signatures of Amazon SNS messages").
+func (m *snsMessage) stringToSign() string {
+	fields := [][2]string{{"Message", m.Message}, {"MessageId", m.MessageID}}
+	if m.Type == snsTypeNotification {
+		if m.Subject != "" {
+			fields = append(fields, [2]string{"Subject", m.Subject})
+		}
+		fields = append(fields, [2]string{"Timestamp", m.Timestamp})
+	} else {
+		fields = append(fields,
+			[2]string{"SubscribeURL", m.SubscribeURL},
+			[2]string{"Timestamp", m.Timestamp},
+			[2]string{"Token", m.Token})
+	}
+	fields = append(fields, [2]string{"TopicArn", m.TopicArn}, [2]string{"Type", m.Type})
+
+	var b strings.Builder
+	for _, field := range fields {
+		b.WriteString(field[0] + "\n" + field[1] + "\n")
+	}
+	return b.String()
+}
+
+// SNS message types
+const (
+	snsTypeNotification             = "Notification"
+	snsTypeSubscriptionConfirmation = "SubscriptionConfirmation"
+	snsTypeUnsubscribeConfirmation  = "UnsubscribeConfirmation"
+)
+
+// cloudWatchAlarm is a CloudWatch alarm state change notification.
+type cloudWatchAlarm struct {
+	AlarmName        string `json:"AlarmName"`
+	AlarmDescription string `json:"AlarmDescription"`
+	AWSAccountID     string `json:"AWSAccountId"`
+	NewStateValue    string `json:"NewStateValue"`
+	NewStateReason   string `json:"NewStateReason"`
+	StateChangeTime  string `json:"StateChangeTime"`
+	Region           string `json:"Region"`
+	AlarmArn         string `json:"AlarmArn"`
+	OldStateValue    string `json:"OldStateValue"`
+	Trigger          struct {
+		MetricName string `json:"MetricName"`
+		Namespace  string `json:"Namespace"`
+		Dimensions []struct {
+			Name  string `json:"name"`
+			Value string `json:"value"`
+		} `json:"Dimensions"`
+	} `json:"Trigger"`
+}
+
+// snsHost matches the hosts SNS subscription and signing certificate URLs
+// may point to (sns.<region>.amazonaws.com).
+var snsHost = regexp.MustCompile(`^sns\.[a-z0-9-]+\.amazonaws\.com(\.cn)?$`)
+
+// cloudWatchAdapter parses CloudWatch alarms delivered by an AWS SNS HTTP(S)
+// subscription (or posted directly as alarm JSON).
+//
+// SNS messages must carry a valid signature (SignatureVersion 1 or 2) made
+// with the certificate at SigningCertURL, an HTTPS SNS endpoint; unsigned or
+// forged messages are rejected before confirming or parsing. Alarms posted
+// directly are not signed and rely on the webhook authentication (rejected
+// when AllowedTopicARNs is set).
+//
+// SNS subscription confirmations are confirmed by requesting their
+// SubscribeURL, which must be an HTTPS SNS endpoint. Alarms in ALARM state
+// are firing, OK alarms are resolved. The fingerprint is derived from the
+// alarm ARN.
+type cloudWatchAdapter struct {
+	config  SNSConfig
+	client  *http.Client
+	snsHost *regexp.Regexp
+	logger  *slog.Logger
+
+	certsMu sync.RWMutex
+	certs   map[string]*x509.Certificate // signing certificates by URL
+}
+
+// NewCloudWatchAdapter creates the CloudWatch (SNS) adapter.
+func NewCloudWatchAdapter(config SNSConfig, logger *slog.Logger) SourceAdapter {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &cloudWatchAdapter{
+		config:  config,
+		client:  &http.Client{Timeout: 10 * time.Second},
+		snsHost: snsHost,
+		logger:  logger,
+		certs:   make(map[string]*x509.Certificate),
+	}
+}
+
+// Name returns "cloudwatch".
+func (a *cloudWatchAdapter) Name() string {
+	return "cloudwatch"
+}
+
+// Detect reports whether payload is an SNS message or a CloudWatch alarm.
+func (a *cloudWatchAdapter) Detect(payload []byte) bool {
+	var data map[string]any
+	if err := json.Unmarshal(payload, &data); err != nil {
+		return false
+	}
+	_, hasType := data["Type"]
+	_, hasTopic := data["TopicArn"]
+	_, hasAlarm := data["AlarmName"]
+	_, hasState := data["NewStateValue"]
+	return (hasType && hasTopic) || (hasAlarm && hasState)
+}
+
+// ConfirmSubscription confirms SNS subscriptions and acknowledges unsubscribes.
+func (a *cloudWatchAdapter) ConfirmSubscription(ctx context.Context, payload []byte) (bool, error) {
+	var message snsMessage
+	if err := json.Unmarshal(payload, &message); err != nil {
+		return false, nil // Not an SNS message, Parse reports the error
+	}
+
+	switch message.Type {
+	case snsTypeSubscriptionConfirmation:
+		if err := a.checkTopic(message.TopicArn); err != nil {
+			return true, err
+		}
+		if err := a.verify(ctx, &message); err != nil {
+			return true, err
+		}
+		if a.config.DisableAutoConfirm {
+			a.logger.Info("SNS subscription confirmation received, auto-confirm disabled",
+				"topic_arn", message.TopicArn, "subscribe_url", message.SubscribeURL)
+			return true, nil
+		}
+		if err := a.confirm(ctx, message.SubscribeURL); err != nil {
+			return true, err
+		}
+		a.logger.Info("SNS subscription confirmed", "topic_arn", message.TopicArn)
+		return true, nil
+	case snsTypeUnsubscribeConfirmation:
+		if err := a.verify(ctx, &message); err != nil {
+			return true, err
+		}
+		a.logger.Info("SNS subscription removed", "topic_arn", message.TopicArn)
+		return true, nil
+	}
+	return false, nil
+}
+
+// confirm requests the subscription URL of an SNS confirmation.
+func (a *cloudWatchAdapter) confirm(ctx context.Context, subscribeURL string) error {
+	parsed, err := a.parseSNSURL(subscribeURL)
+	if err != nil {
+		return fmt.Errorf("invalid SNS SubscribeURL %q", subscribeURL)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, parsed.String(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("confirm SNS subscription: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("confirm SNS subscription: HTTP %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// parseSNSURL parses an HTTPS URL on an SNS endpoint host.
+func (a *cloudWatchAdapter) parseSNSURL(rawURL string) (*url.URL, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if parsed.Scheme != "https" || !a.snsHost.MatchString(parsed.Hostname()) {
+		return nil, fmt.Errorf("not an HTTPS SNS endpoint")
+	}
+	return parsed, nil
+}
+
+// verify checks the SNS message signature against its signing certificate.
+func (a *cloudWatchAdapter) verify(ctx context.Context, message *snsMessage) error {
+	var hash crypto.Hash
+	switch message.SignatureVersion {
+	case "1":
+		hash = crypto.SHA1
+	case "2":
+		hash = crypto.SHA256
+	default:
+		return fmt.Errorf("unsupported SNS SignatureVersion %q", message.SignatureVersion)
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(message.Signature)
+	if err != nil || len(signature) == 0 {
+		return fmt.Errorf("invalid SNS Signature")
+	}
+
+	cert, err := a.signingCert(ctx, message.SigningCertURL)
+	if err != nil {
+		return err
+	}
+	publicKey, ok := cert.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return fmt.Errorf("SNS signing certificate has no RSA public key")
+	}
+
+	digest := hash.New()
+	digest.Write([]byte(message.stringToSign()))
+	if err := rsa.VerifyPKCS1v15(publicKey, hash, digest.Sum(nil), signature); err != nil {
+		return fmt.Errorf("SNS signature verification failed: %w", err)
+	}
+	return nil
+}
+
+// signingCert fetches (and caches) the SNS signing certificate.
+func (a *cloudWatchAdapter) signingCert(ctx context.Context, certURL string) (*x509.Certificate, error) {
+	parsed, err := a.parseSNSURL(certURL)
+	if err != nil || !strings.HasSuffix(parsed.Path, ".pem") {
+		return nil, fmt.Errorf("invalid SNS SigningCertURL %q", certURL)
+	}
+
+	a.certsMu.RLock()
+	cert, ok := a.certs[parsed.String()]
+	a.certsMu.RUnlock()
+	if ok {
+		return cert, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, parsed.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch SNS signing certificate: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch SNS signing certificate: HTTP %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if err != nil {
+		return nil, fmt.Errorf("fetch SNS signing certificate: %w", err)
+	}
+
+	block, _ := pem.Decode(body)
+	if block == nil {
+		return nil, fmt.Errorf("SNS signing certificate is not PEM encoded")
+	}
+	cert, err = x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parse SNS signing certificate: %w", err)
+	}
+
+	a.certsMu.Lock()
+	a.certs[parsed.String()] = cert
+	a.certsMu.Unlock()
+	return cert, nil
+}
+
+// checkTopic rejects topics outside AllowedTopicARNs.
+func (a *cloudWatchAdapter) checkTopic(topicArn string) error {
+	if len(a.config.AllowedTopicARNs) > 0 && !slices.Contains(a.config.AllowedTopicARNs, topicArn) {
+		return fmt.Errorf("SNS topic %q is not allowed", topicArn)
+	}
+	return nil
+}
+
+// Parse converts the CloudWatch alarm of an SNS notification.
+func (a *cloudWatchAdapter) Parse(payload []byte) ([]*core.Alert, error) {
+	var message snsMessage
+	if err := json.Unmarshal(payload, &message); err != nil {
+		return nil, fmt.Errorf("invalid CloudWatch payload: %w", err)
+	}
+
+	alarmPayload := payload
+	if message.Type != "" {
+		if message.Type != snsTypeNotification {
+			return nil, fmt.Errorf("unexpected SNS message type %q", message.Type)
+		}
+		if err := a.checkTopic(message.TopicArn); err != nil {
+			return nil, err
+		}
+		if err := a.verify(context.Background(), &message); err != nil {
+			return nil, err
+		}
+		alarmPayload = []byte(message.Message)
+	} else if len(a.config.AllowedTopicARNs) > 0 {
+		return nil, fmt.Errorf("CloudWatch alarm was not delivered by an allowed SNS topic")
+	}
+
+	var alarm cloudWatchAlarm
+	if err := json.Unmarshal(alarmPayload, &alarm); err != nil {
+		return nil, fmt.Errorf("SNS message is not a CloudWatch alarm: %w", err)
+	}
+	if alarm.AlarmName == "" {
+		return nil, fmt.Errorf("CloudWatch alarm has no AlarmName")
+	}
+
+	var status core.AlertStatus
+	severity := "critical"
+	switch alarm.NewStateValue {
+	case "ALARM":
+		status = core.StatusFiring
+	case "INSUFFICIENT_DATA":
+		status = core.StatusFiring
+		severity = "warning"
+	case "OK":
+		status = core.StatusResolved
+	default:
+		return nil, fmt.Errorf("invalid CloudWatch alarm state %q", alarm.NewStateValue)
+	}
+
+	changedAt := time.Now().UTC()
+	if alarm.StateChangeTime != "" {
+		parsed, err := parseSourceTime(alarm.StateChangeTime)
+		if err != nil {
+			return nil, fmt.Errorf("CloudWatch StateChangeTime: %w", err)
+		}
+		changedAt = parsed
+	}
+	var endsAt *time.Time
+	if status == core.StatusResolved {
+		endsAt = &changedAt
+	}
+
+	// arn:aws:cloudwatch:<region>:<account>:alarm:<name>
+	region := ""
+	if parts := strings.SplitN(alarm.AlarmArn, ":", 6); len(parts) == 6 {
+		region = parts[3]
+	}
+
+	labels := map[string]string{"severity": severity}
+	for key, value := range map[string]string{
+		"aws_account_id": alarm.AWSAccountID,
+		"aws_region":     region,
+		"aws_namespace":  alarm.Trigger.Namespace,
+		"metric_name":    alarm.Trigger.MetricName,
+	} {
+		if value != "" {
+			labels[key] = value
+		}
+	}
+	for _, dimension := range alarm.Trigger.Dimensions {
+		if name := sanitizeLabelName(dimension.Name); name != "" {
+			labels["dimension_"+name] = dimension.Value
+		}
+	}
+
+	annotations := map[string]string{
+		"summary":     firstNonEmpty(alarm.AlarmDescription, message.Subject, alarm.AlarmName),
+		"description": alarm.NewStateReason,
+		"state":       alarm.NewStateValue,
+	}
+	if alarm.AlarmArn != "" {
+		annotations["alarm_arn"] = alarm.AlarmArn
+	}
+
+	generatorURL := ""
+	if region != "" {
+		generatorURL = fmt.Sprintf("https://console.aws.amazon.com/cloudwatch/home?region=%s#alarmsV2:alarm/%s",
+			region, url.PathEscape(alarm.AlarmName))
+	}
+
+	identity := map[string]string{"alarm_arn": alarm.AlarmArn}
+	if alarm.AlarmArn == "" {
+		identity = map[string]string{"account": alarm.AWSAccountID, "region": alarm.Region}
+	}
+
+	return []*core.Alert{
+		newAdapterAlert(a.Name(), alarm.AlarmName, status, labels, annotations, identity, changedAt, endsAt, generatorURL),
+	}, nil
+}
@@ -0,0 +1,158 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+)
+
+// datadogAdapter parses Datadog monitor notifications sent by the Datadog
+// webhooks integration. Datadog payloads are templates defined in the
+// integration; the adapter expects the Datadog variables under these keys:
+//
+//	{
+//	  "id": "$ID",
+//	  "title": "$EVENT_TITLE",
+//	  "body": "$EVENT_MSG",
+//	  "alert_id": "$ALERT_ID",
+//	  "alert_title": "$ALERT_TITLE",
+//	  "alert_transition": "$ALERT_TRANSITION",
+//	  "alert_type": "$ALERT_TYPE",
+//	  "alert_priority": "$ALERT_PRIORITY",
+//	  "alert_scope": "$ALERT_SCOPE",
+//	  "alert_query": "$ALERT_QUERY",
+//	  "date": "$DATE",
+//	  "last_updated": "$LAST_UPDATED",
+//	  "hostname": "$HOSTNAME",
+//	  "tags": "$TAGS",
+//	  "link": "$LINK"
+//	}
+//
+// The fingerprint is derived from the monitor (alert_id) and the monitor
+// group (alert_scope), so a recovery resolves the alert its trigger created.
+type datadogAdapter struct{}
+
+// NewDatadogAdapter creates the Datadog monitor adapter.
+func NewDatadogAdapter() SourceAdapter {
+	return &datadogAdapter{}
+}
+
+// Name returns "datadog".
+func (a *datadogAdapter) Name() string {
+	return "datadog"
+}
+
+// Detect reports whether payload is a Datadog monitor notification.
+func (a *datadogAdapter) Detect(payload []byte) bool {
+	var data map[string]any
+	if err := json.Unmarshal(payload, &data); err != nil {
+		return false
+	}
+	_, hasTransition := data["alert_transition"]
+	_, hasID := data["alert_id"]
+	_, hasType := data["alert_type"]
+	return hasTransition || (hasID && hasType)
+}
+
+// datadogTitlePrefix matches the transition prefix of Datadog event titles
+// ("[Triggered on {host:web-1}] ").
+var datadogTitlePrefix = regexp.MustCompile(`^\[[^\]]*\]\s*`)
+
+// Parse converts a Datadog notification into a single alert.
+func (a *datadogAdapter) Parse(payload []byte) ([]*core.Alert, error) {
+	var data map[string]any
+	if err := json.Unmarshal(payload, &data); err != nil {
+		return nil, fmt.Errorf("invalid Datadog payload: %w", err)
+	}
+	field := func(key string) string {
+		return jsonString(data[key])
+	}
+
+	alertName := datadogTitlePrefix.ReplaceAllString(firstNonEmpty(field("alert_title"), field("title")), "")
+	if alertName == "" {
+		return nil, fmt.Errorf("datadog payload has no alert_title or title")
+	}
+	monitorID := field("alert_id")
+	if monitorID == "" {
+		return nil, fmt.Errorf("datadog payload has no alert_id")
+	}
+
+	transition := field("alert_transition")
+	status := core.StatusFiring
+	if strings.EqualFold(transition, "Recovered") || strings.EqualFold(field("alert_type"), "success") {
+		status = core.StatusResolved
+	}
+
+	labels := make(map[string]string)
+	for _, tag := range strings.Split(field("tags"), ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(tag), ":")
+		if !ok || value == "" {
+			continue
+		}
+		if name := sanitizeLabelName(key); name != "" {
+			labels[name] = value
+		}
+	}
+	labels["monitor_id"] = monitorID
+	if severity := datadogSeverity(transition, field("alert_type")); severity != "" {
+		labels["severity"] = severity
+	}
+	if priority := field("alert_priority"); priority != "" {
+		labels["priority"] = strings.ToLower(priority)
+	}
+	if hostname := field("hostname"); hostname != "" {
+		labels["host"] = hostname
+	}
+
+	annotations := map[string]string{
+		"summary":     field("title"),
+		"description": field("body"),
+	}
+	for key, value := range map[string]string{
+		"transition": transition,
+		"scope":      field("alert_scope"),
+		"query":      field("alert_query"),
+		"event_id":   field("id"),
+	} {
+		if value != "" {
+			annotations[key] = value
+		}
+	}
+
+	eventTime := time.Now().UTC()
+	if date := firstNonEmpty(field("date"), field("last_updated")); date != "" {
+		parsed, err := parseSourceTime(date)
+		if err != nil {
+			return nil, fmt.Errorf("datadog date: %w", err)
+		}
+		eventTime = parsed
+	}
+	var endsAt *time.Time
+	if status == core.StatusResolved {
+		endsAt = &eventTime
+	}
+
+	identity := map[string]string{"monitor_id": monitorID, "scope": field("alert_scope")}
+	return []*core.Alert{
+		newAdapterAlert(a.Name(), alertName, status, labels, annotations, identity, eventTime, endsAt, field("link")),
+	}, nil
+}
+
+// datadogSeverity maps the alert transition and type to a severity label.
+func datadogSeverity(transition, alertType string) string {
+	switch {
+	case strings.Contains(transition, "Warn"), strings.Contains(transition, "No Data"):
+		return "warning"
+	case alertType == "error", strings.Contains(transition, "Triggered"):
+		return "critical"
+	case alertType == "warning":
+		return "warning"
+	case alertType == "info":
+		return "info"
+	}
+	return ""
+}
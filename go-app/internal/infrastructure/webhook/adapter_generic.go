@@ -0,0 +1,308 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+)
+
+// GenericSourceConfig maps the JSON payloads of a source without a dedicated
+// adapter (in-house scripts, other tools) to alerts. It is served at
+// POST /webhook/{name}.
+//
+// Field values are JSONPath expressions ($.host, $.tags[0], $['app.name'])
+// evaluated on the alert object, Go templates ({{ .check }}-{{ .host }}) with
+// the alert object as data, or literal strings.
+type GenericSourceConfig struct {
+	// Name is the source name (the /webhook/{name} path segment)
+	Name string `yaml:"name"`
+
+	// Alerts is a JSONPath selecting the alert objects in the payload
+	// (default: the payload itself, or each element of a top-level array)
+	Alerts string `yaml:"alerts,omitempty"`
+
+	// AlertName is required
+	AlertName string `yaml:"alertname"`
+
+	// Status value; resolved if it maps to resolved, firing otherwise
+	Status string `yaml:"status,omitempty"`
+
+	// StatusMap maps source status values to firing or resolved
+	// (default: "resolved" is resolved)
+	StatusMap map[string]string `yaml:"status_map,omitempty"`
+
+	// StartsAt and EndsAt accept RFC 3339 and Unix epochs (seconds or
+	// milliseconds); StartsAt defaults to the receive time
+	StartsAt string `yaml:"starts_at,omitempty"`
+	EndsAt   string `yaml:"ends_at,omitempty"`
+
+	GeneratorURL string `yaml:"generator_url,omitempty"`
+
+	// Fingerprint identifies the alert across firing/resolved notifications
+	// (default: derived from alertname and labels)
+	Fingerprint string `yaml:"fingerprint,omitempty"`
+
+	Labels      map[string]string `yaml:"labels,omitempty"`
+	Annotations map[string]string `yaml:"annotations,omitempty"`
+}
+
+// genericValue is a compiled GenericSourceConfig field value.
+type genericValue struct {
+	path     jsonPath
+	template *template.Template
+	literal  string
+}
+
+// genericTemplateFuncs are the functions available to generic value templates.
+var genericTemplateFuncs = template.FuncMap{
+	"lower": func(value any) string { return strings.ToLower(jsonString(value)) },
+	"upper": func(value any) string { return strings.ToUpper(jsonString(value)) },
+	"trim":  func(value any) string { return strings.TrimSpace(jsonString(value)) },
+	"default": func(fallback string, value any) string {
+		if s := jsonString(value); s != "" {
+			return s
+		}
+		return fallback
+	},
+	"toString": jsonString,
+}
+
+// compileGenericValue compiles a JSONPath, template or literal field value.
+func compileGenericValue(field, expr string) (*genericValue, error) {
+	switch {
+	case expr == "":
+		return nil, nil
+	case strings.HasPrefix(expr, "$"):
+		path, err := compileJSONPath(expr)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", field, err)
+		}
+		return &genericValue{path: path}, nil
+	case strings.Contains(expr, "{{"):
+		tmpl, err := template.New(field).Funcs(genericTemplateFuncs).Option("missingkey=zero").Parse(expr)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", field, err)
+		}
+		return &genericValue{template: tmpl}, nil
+	}
+	return &genericValue{literal: expr}, nil
+}
+
+// eval evaluates the value on an alert object, "" for a nil value.
+func (v *genericValue) eval(object any) (string, error) {
+	switch {
+	case v == nil:
+		return "", nil
+	case v.path != nil:
+		return jsonString(v.path.First(object)), nil
+	case v.template != nil:
+		var out bytes.Buffer
+		if err := v.template.Execute(&out, object); err != nil {
+			return "", err
+		}
+		return strings.ReplaceAll(out.String(), "<no value>", ""), nil
+	}
+	return v.literal, nil
+}
+
+// genericAdapter maps JSON payloads with a GenericSourceConfig.
+type genericAdapter struct {
+	name         string
+	alerts       jsonPath
+	alertName    *genericValue
+	status       *genericValue
+	statusMap    map[string]string
+	startsAt     *genericValue
+	endsAt       *genericValue
+	generatorURL *genericValue
+	fingerprint  *genericValue
+	labels       map[string]*genericValue
+	annotations  map[string]*genericValue
+}
+
+// sourceNamePattern matches valid source names (URL path segments).
+var sourceNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// NewGenericAdapter compiles a generic JSON source mapping.
+func NewGenericAdapter(config GenericSourceConfig) (SourceAdapter, error) {
+	if !sourceNamePattern.MatchString(config.Name) {
+		return nil, fmt.Errorf("invalid source name %q (lowercase letters, digits, - and _)", config.Name)
+	}
+	if config.AlertName == "" {
+		return nil, fmt.Errorf("source %q: alertname is required", config.Name)
+	}
+
+	adapter := &genericAdapter{
+		name:        config.Name,
+		statusMap:   make(map[string]string, len(config.StatusMap)),
+		labels:      make(map[string]*genericValue, len(config.Labels)),
+		annotations: make(map[string]*genericValue, len(config.Annotations)),
+	}
+	for value, status := range config.StatusMap {
+		if status != string(core.StatusFiring) && status != string(core.StatusResolved) {
+			return nil, fmt.Errorf("source %q: status_map[%s] must be firing or resolved", config.Name, value)
+		}
+		adapter.statusMap[strings.ToLower(value)] = status
+	}
+
+	var err error
+	if config.Alerts != "" {
+		if adapter.alerts, err = compileJSONPath(config.Alerts); err != nil {
+			return nil, fmt.Errorf("source %q: alerts: %w", config.Name, err)
+		}
+	}
+	fields := []struct {
+		name   string
+		expr   string
+		target **genericValue
+	}{
+		{"alertname", config.AlertName, &adapter.alertName},
+		{"status", config.Status, &adapter.status},
+		{"starts_at", config.StartsAt, &adapter.startsAt},
+		{"ends_at", config.EndsAt, &adapter.endsAt},
+		{"generator_url", config.GeneratorURL, &adapter.generatorURL},
+		{"fingerprint", config.Fingerprint, &adapter.fingerprint},
+	}
+	for _, field := range fields {
+		if *field.target, err = compileGenericValue(field.name, field.expr); err != nil {
+			return nil, fmt.Errorf("source %q: %w", config.Name, err)
+		}
+	}
+	for key, expr := range config.Labels {
+		if adapter.labels[key], err = compileGenericValue("labels."+key, expr); err != nil {
+			return nil, fmt.Errorf("source %q: %w", config.Name, err)
+		}
+	}
+	for key, expr := range config.Annotations {
+		if adapter.annotations[key], err = compileGenericValue("annotations."+key, expr); err != nil {
+			return nil, fmt.Errorf("source %q: %w", config.Name, err)
+		}
+	}
+
+	return adapter, nil
+}
+
+// Name returns the configured source name.
+func (a *genericAdapter) Name() string {
+	return a.name
+}
+
+// Detect returns false: generic sources are only reachable by name.
+func (a *genericAdapter) Detect(payload []byte) bool {
+	return false
+}
+
+// Parse maps each alert object of the payload to an alert.
+func (a *genericAdapter) Parse(payload []byte) ([]*core.Alert, error) {
+	var data any
+	if err := json.Unmarshal(payload, &data); err != nil {
+		return nil, fmt.Errorf("invalid JSON payload: %w", err)
+	}
+
+	var objects []any
+	switch {
+	case a.alerts != nil:
+		objects = a.alerts.Select(data)
+	default:
+		if array, ok := data.([]any); ok {
+			objects = array
+		} else {
+			objects = []any{data}
+		}
+	}
+	if len(objects) == 0 {
+		return nil, fmt.Errorf("source %q: payload has no alerts", a.name)
+	}
+
+	alerts := make([]*core.Alert, 0, len(objects))
+	for i, object := range objects {
+		alert, err := a.parseAlert(object)
+		if err != nil {
+			return nil, fmt.Errorf("alert[%d]: %w", i, err)
+		}
+		alerts = append(alerts, alert)
+	}
+	return alerts, nil
+}
+
+// parseAlert maps a single alert object.
+func (a *genericAdapter) parseAlert(object any) (*core.Alert, error) {
+	values := make(map[string]string)
+	for name, value := range map[string]*genericValue{
+		"alertname":     a.alertName,
+		"status":        a.status,
+		"starts_at":     a.startsAt,
+		"ends_at":       a.endsAt,
+		"generator_url": a.generatorURL,
+		"fingerprint":   a.fingerprint,
+	} {
+		result, err := value.eval(object)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		values[name] = strings.TrimSpace(result)
+	}
+	if values["alertname"] == "" {
+		return nil, fmt.Errorf("alertname is empty")
+	}
+
+	labels := make(map[string]string, len(a.labels)+2)
+	for key, value := range a.labels {
+		result, err := value.eval(object)
+		if err != nil {
+			return nil, fmt.Errorf("labels.%s: %w", key, err)
+		}
+		if result != "" {
+			labels[key] = result
+		}
+	}
+	annotations := make(map[string]string, len(a.annotations))
+	for key, value := range a.annotations {
+		result, err := value.eval(object)
+		if err != nil {
+			return nil, fmt.Errorf("annotations.%s: %w", key, err)
+		}
+		if result != "" {
+			annotations[key] = result
+		}
+	}
+
+	status := core.StatusFiring
+	sourceStatus := strings.ToLower(values["status"])
+	if mapped, ok := a.statusMap[sourceStatus]; ok {
+		status = core.AlertStatus(mapped)
+	} else if sourceStatus == string(core.StatusResolved) {
+		status = core.StatusResolved
+	}
+
+	startsAt := time.Now().UTC()
+	if values["starts_at"] != "" {
+		parsed, err := parseSourceTime(values["starts_at"])
+		if err != nil {
+			return nil, fmt.Errorf("starts_at: %w", err)
+		}
+		startsAt = parsed
+	}
+	var endsAt *time.Time
+	if values["ends_at"] != "" {
+		parsed, err := parseSourceTime(values["ends_at"])
+		if err != nil {
+			return nil, fmt.Errorf("ends_at: %w", err)
+		}
+		endsAt = &parsed
+	}
+
+	var identity map[string]string
+	if values["fingerprint"] != "" {
+		identity = map[string]string{"id": values["fingerprint"]}
+	}
+
+	return newAdapterAlert(a.name, values["alertname"], status, labels, annotations, identity,
+		startsAt, endsAt, values["generator_url"]), nil
+}
@@ -0,0 +1,111 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+)
+
+// grafanaWebhook is the Grafana Unified Alerting webhook contact point payload.
+type grafanaWebhook struct {
+	Receiver string         `json:"receiver"`
+	Status   string         `json:"status"`
+	OrgID    *int64         `json:"orgId"`
+	Alerts   []grafanaAlert `json:"alerts"`
+	Title    string         `json:"title"`
+}
+
+// grafanaAlert is an alert of a Grafana webhook (Alertmanager alert + Grafana links).
+type grafanaAlert struct {
+	Status       string            `json:"status"`
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations"`
+	StartsAt     time.Time         `json:"startsAt"`
+	EndsAt       time.Time         `json:"endsAt"`
+	GeneratorURL string            `json:"generatorURL"`
+	SilenceURL   string            `json:"silenceURL"`
+	DashboardURL string            `json:"dashboardURL"`
+	PanelURL     string            `json:"panelURL"`
+	ValueString  string            `json:"valueString"`
+}
+
+// grafanaAdapter parses Grafana Unified Alerting webhooks.
+//
+// The payload is Alertmanager-shaped, so it is detected by the Grafana-only
+// fields (orgId, alert valueString/dashboardURL/panelURL) before Alertmanager
+// detection. Grafana links are kept as annotations.
+type grafanaAdapter struct{}
+
+// NewGrafanaAdapter creates the Grafana Unified Alerting adapter.
+func NewGrafanaAdapter() SourceAdapter {
+	return &grafanaAdapter{}
+}
+
+// Name returns "grafana".
+func (a *grafanaAdapter) Name() string {
+	return "grafana"
+}
+
+// Detect reports whether payload is a Grafana webhook.
+func (a *grafanaAdapter) Detect(payload []byte) bool {
+	var webhook grafanaWebhook
+	if err := json.Unmarshal(payload, &webhook); err != nil || len(webhook.Alerts) == 0 {
+		return false
+	}
+	first := webhook.Alerts[0]
+	return webhook.OrgID != nil || first.ValueString != "" || first.DashboardURL != "" || first.PanelURL != ""
+}
+
+// Parse converts the Grafana alerts; fingerprints are derived from labels.
+func (a *grafanaAdapter) Parse(payload []byte) ([]*core.Alert, error) {
+	var webhook grafanaWebhook
+	if err := json.Unmarshal(payload, &webhook); err != nil {
+		return nil, fmt.Errorf("invalid Grafana payload: %w", err)
+	}
+	if len(webhook.Alerts) == 0 {
+		return nil, fmt.Errorf("grafana payload has no alerts")
+	}
+
+	alerts := make([]*core.Alert, 0, len(webhook.Alerts))
+	for i, grafanaAlert := range webhook.Alerts {
+		alertName := grafanaAlert.Labels["alertname"]
+		if alertName == "" {
+			return nil, fmt.Errorf("alert[%d]: missing required label 'alertname'", i)
+		}
+		status, err := mapAlertStatus(grafanaAlert.Status)
+		if err != nil {
+			return nil, fmt.Errorf("alert[%d]: %w", i, err)
+		}
+
+		labels := make(map[string]string, len(grafanaAlert.Labels)+1)
+		for key, value := range grafanaAlert.Labels {
+			labels[key] = value
+		}
+		annotations := make(map[string]string, len(grafanaAlert.Annotations)+4)
+		for key, value := range grafanaAlert.Annotations {
+			annotations[key] = value
+		}
+		for key, value := range map[string]string{
+			"dashboard_url": grafanaAlert.DashboardURL,
+			"panel_url":     grafanaAlert.PanelURL,
+			"silence_url":   grafanaAlert.SilenceURL,
+			"value_string":  grafanaAlert.ValueString,
+		} {
+			if _, ok := annotations[key]; !ok && value != "" {
+				annotations[key] = value
+			}
+		}
+
+		var endsAt *time.Time
+		if !grafanaAlert.EndsAt.IsZero() {
+			endsAt = &grafanaAlert.EndsAt
+		}
+
+		alerts = append(alerts, newAdapterAlert(a.Name(), alertName, status, labels, annotations, nil,
+			grafanaAlert.StartsAt, endsAt, grafanaAlert.GeneratorURL))
+	}
+
+	return alerts, nil
+}
@@ -0,0 +1,512 @@
+package webhook
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vitaliisemenov/alert-history/internal/core"
+)
+
+const grafanaPayload = `{
+	"receiver": "alert-history",
+	"status": "firing",
+	"orgId": 1,
+	"title": "[FIRING:1] HighCPU",
+	"alerts": [{
+		"status": "%s",
+		"labels": {"alertname": "HighCPU", "instance": "web-1", "severity": "warning"},
+		"annotations": {"summary": "CPU above 90%%"},
+		"startsAt": "2026-10-16T10:00:00Z",
+		"endsAt": "0001-01-01T00:00:00Z",
+		"generatorURL": "https://grafana.example.com/alerting/grafana/abc/view",
+		"dashboardURL": "https://grafana.example.com/d/xyz",
+		"valueString": "[ var='A' value=93 ]"
+	}]
+}`
+
+const datadogPayload = `{
+	"id": "7311234567",
+	"title": "[%s on {host:web-1}] Disk usage high",
+	"body": "Disk usage is above 90%%",
+	"alert_id": "12345",
+	"alert_title": "Disk usage high",
+	"alert_transition": "%s",
+	"alert_type": "%s",
+	"alert_priority": "P2",
+	"alert_scope": "host:web-1",
+	"date": "1792144800000",
+	"hostname": "web-1",
+	"tags": "env:prod,team:storage,monitor",
+	"link": "https://app.datadoghq.com/monitors/12345"
+}`
+
+const cloudWatchAlarmPayload = `{
+	"AlarmName": "api-5xx-rate",
+	"AlarmDescription": "API 5xx rate above threshold",
+	"AWSAccountId": "123456789012",
+	"NewStateValue": "%s",
+	"NewStateReason": "Threshold Crossed",
+	"StateChangeTime": "2026-10-16T10:00:00.000+0000",
+	"Region": "EU (Ireland)",
+	"AlarmArn": "arn:aws:cloudwatch:eu-west-1:123456789012:alarm:api-5xx-rate",
+	"OldStateValue": "OK",
+	"Trigger": {
+		"MetricName": "HTTPCode_Target_5XX_Count",
+		"Namespace": "AWS/ApplicationELB",
+		"Dimensions": [{"name": "LoadBalancer", "value": "app/api/abc"}]
+	}
+}`
+
+// snsTestEndpoint is a fake SNS endpoint: it serves the signing certificate
+// and subscription confirmations, and signs the messages it creates.
+type snsTestEndpoint struct {
+	server    *httptest.Server
+	key       *rsa.PrivateKey
+	confirmed int
+}
+
+func newSNSTestEndpoint(t *testing.T) *snsTestEndpoint {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "sns.amazonaws.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+
+	endpoint := &snsTestEndpoint{key: key}
+	endpoint.server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/SimpleNotificationService.pem" {
+			_, _ = w.Write(certPEM)
+			return
+		}
+		assert.Equal(t, "ConfirmSubscription", r.URL.Query().Get("Action"))
+		endpoint.confirmed++
+	}))
+	t.Cleanup(endpoint.server.Close)
+	return endpoint
+}
+
+// adapter returns a CloudWatch adapter trusting the fake endpoint's host.
+func (e *snsTestEndpoint) adapter(config SNSConfig) *cloudWatchAdapter {
+	adapter := NewCloudWatchAdapter(config, nil).(*cloudWatchAdapter)
+	adapter.client = e.server.Client()
+	adapter.snsHost = regexp.MustCompile(`^127\.0\.0\.1$`)
+	return adapter
+}
+
+// message returns an SNS message signed with SignatureVersion 2.
+func (e *snsTestEndpoint) message(t *testing.T, messageType, topicArn, message, subscribeURL string) []byte {
+	t.Helper()
+	return e.sign(t, "2", &snsMessage{
+		Type:         messageType,
+		MessageID:    "b1c2",
+		TopicArn:     topicArn,
+		Subject:      "ALARM: api-5xx-rate",
+		Message:      message,
+		Timestamp:    "2026-10-16T10:00:01.000Z",
+		SubscribeURL: subscribeURL,
+		Token:        "abc",
+	})
+}
+
+func (e *snsTestEndpoint) sign(t *testing.T, version string, message *snsMessage) []byte {
+	t.Helper()
+	hash := crypto.SHA256
+	if version == "1" {
+		hash = crypto.SHA1
+	}
+	digest := hash.New()
+	digest.Write([]byte(message.stringToSign()))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, e.key, hash, digest.Sum(nil))
+	require.NoError(t, err)
+
+	message.SignatureVersion = version
+	message.Signature = base64.StdEncoding.EncodeToString(signature)
+	message.SigningCertURL = e.server.URL + "/SimpleNotificationService.pem"
+	payload, err := json.Marshal(message)
+	require.NoError(t, err)
+	return payload
+}
+
+func payloadf(format string, args ...any) []byte {
+	return []byte(fmt.Sprintf(format, args...))
+}
+
+func TestGrafanaAdapter(t *testing.T) {
+	adapter := NewGrafanaAdapter()
+	firing := payloadf(grafanaPayload, "firing")
+
+	assert.True(t, adapter.Detect(firing))
+	assert.False(t, adapter.Detect([]byte(`{"version":"4","alerts":[{"labels":{"alertname":"A"}}]}`)))
+
+	alerts, err := adapter.Parse(firing)
+	require.NoError(t, err)
+	require.Len(t, alerts, 1)
+	alert := alerts[0]
+	assert.Equal(t, "HighCPU", alert.AlertName)
+	assert.Equal(t, core.StatusFiring, alert.Status)
+	assert.Equal(t, "grafana", alert.Labels["source"])
+	assert.Equal(t, "https://grafana.example.com/d/xyz", alert.Annotations["dashboard_url"])
+	assert.Equal(t, "[ var='A' value=93 ]", alert.Annotations["value_string"])
+	assert.Nil(t, alert.EndsAt)
+	require.NotNil(t, alert.GeneratorURL)
+	require.NoError(t, validateAdapterAlert(alert))
+
+	resolved, err := adapter.Parse(payloadf(grafanaPayload, "resolved"))
+	require.NoError(t, err)
+	assert.Equal(t, core.StatusResolved, resolved[0].Status)
+	assert.Equal(t, alert.Fingerprint, resolved[0].Fingerprint)
+}
+
+func TestDatadogAdapter(t *testing.T) {
+	adapter := NewDatadogAdapter()
+	triggered := payloadf(datadogPayload, "Triggered", "Triggered", "error")
+
+	assert.True(t, adapter.Detect(triggered))
+	assert.False(t, adapter.Detect(payloadf(grafanaPayload, "firing")))
+
+	alerts, err := adapter.Parse(triggered)
+	require.NoError(t, err)
+	require.Len(t, alerts, 1)
+	alert := alerts[0]
+	assert.Equal(t, "Disk usage high", alert.AlertName)
+	assert.Equal(t, core.StatusFiring, alert.Status)
+	assert.Equal(t, map[string]string{
+		"alertname":  "Disk usage high",
+		"source":     "datadog",
+		"env":        "prod",
+		"team":       "storage",
+		"monitor_id": "12345",
+		"severity":   "critical",
+		"priority":   "p2",
+		"host":       "web-1",
+	}, alert.Labels)
+	assert.Equal(t, "host:web-1", alert.Annotations["scope"])
+	assert.Equal(t, int64(1792144800000), alert.StartsAt.UnixMilli())
+
+	recovered, err := adapter.Parse(payloadf(datadogPayload, "Recovered", "Recovered", "success"))
+	require.NoError(t, err)
+	assert.Equal(t, core.StatusResolved, recovered[0].Status)
+	require.NotNil(t, recovered[0].EndsAt)
+	assert.Equal(t, alert.Fingerprint, recovered[0].Fingerprint, "recovery must resolve the triggered alert")
+
+	_, err = adapter.Parse([]byte(`{"alert_transition": "Triggered", "title": "x"}`))
+	assert.Error(t, err)
+}
+
+func TestCloudWatchAdapter_Parse(t *testing.T) {
+	sns := newSNSTestEndpoint(t)
+	adapter := sns.adapter(SNSConfig{})
+	topic := "arn:aws:sns:eu-west-1:123456789012:cloudwatch-alarms"
+	alarm := sns.message(t, "Notification", topic, string(payloadf(cloudWatchAlarmPayload, "ALARM")), "")
+
+	assert.True(t, adapter.Detect(alarm))
+	assert.True(t, adapter.Detect(payloadf(cloudWatchAlarmPayload, "ALARM")))
+	assert.False(t, adapter.Detect(payloadf(datadogPayload, "Triggered", "Triggered", "error")))
+
+	alerts, err := adapter.Parse(alarm)
+	require.NoError(t, err)
+	require.Len(t, alerts, 1)
+	alert := alerts[0]
+	assert.Equal(t, "api-5xx-rate", alert.AlertName)
+	assert.Equal(t, core.StatusFiring, alert.Status)
+	assert.Equal(t, "critical", alert.Labels["severity"])
+	assert.Equal(t, "eu-west-1", alert.Labels["aws_region"])
+	assert.Equal(t, "AWS/ApplicationELB", alert.Labels["aws_namespace"])
+	assert.Equal(t, "app/api/abc", alert.Labels["dimension_LoadBalancer"])
+	assert.Equal(t, "API 5xx rate above threshold", alert.Annotations["summary"])
+	require.NotNil(t, alert.GeneratorURL)
+	assert.Contains(t, *alert.GeneratorURL, "region=eu-west-1")
+
+	ok, err := adapter.Parse(sns.message(t, "Notification", topic, string(payloadf(cloudWatchAlarmPayload, "OK")), ""))
+	require.NoError(t, err)
+	assert.Equal(t, core.StatusResolved, ok[0].Status)
+	assert.Equal(t, alert.Fingerprint, ok[0].Fingerprint)
+
+	raw, err := adapter.Parse(payloadf(cloudWatchAlarmPayload, "ALARM"))
+	require.NoError(t, err)
+	assert.Equal(t, alert.Fingerprint, raw[0].Fingerprint)
+
+	_, err = adapter.Parse(sns.message(t, "Notification", topic, `{"hello": "world"}`, ""))
+	assert.Error(t, err)
+}
+
+func TestCloudWatchAdapter_VerifySignature(t *testing.T) {
+	sns := newSNSTestEndpoint(t)
+	adapter := sns.adapter(SNSConfig{})
+	topic := "arn:aws:sns:eu-west-1:123456789012:cloudwatch-alarms"
+	alarm := string(payloadf(cloudWatchAlarmPayload, "ALARM"))
+	notification := func() *snsMessage {
+		return &snsMessage{Type: "Notification", MessageID: "b1c2", TopicArn: topic, Message: alarm, Timestamp: "2026-10-16T10:00:01.000Z"}
+	}
+
+	// SignatureVersion 1 (SHA1) and 2 (SHA256), Subject is optional
+	_, err := adapter.Parse(sns.sign(t, "1", notification()))
+	require.NoError(t, err)
+	_, err = adapter.Parse(sns.sign(t, "2", notification()))
+	require.NoError(t, err)
+
+	tampered := notification()
+	signed := sns.sign(t, "2", tampered)
+	require.NoError(t, json.Unmarshal(signed, tampered))
+	tampered.Message = string(payloadf(cloudWatchAlarmPayload, "OK"))
+	forged, err := json.Marshal(tampered)
+	require.NoError(t, err)
+	_, err = adapter.Parse(forged)
+	assert.ErrorContains(t, err, "signature verification failed")
+
+	unsigned, err := json.Marshal(notification())
+	require.NoError(t, err)
+	_, err = adapter.Parse(unsigned)
+	assert.ErrorContains(t, err, "unsupported SNS SignatureVersion")
+
+	// Signing certificates must come from an HTTPS SNS endpoint
+	_, err = NewCloudWatchAdapter(SNSConfig{}, nil).Parse(sns.sign(t, "2", notification()))
+	assert.ErrorContains(t, err, "invalid SNS SigningCertURL")
+	offHost := notification()
+	signed = sns.sign(t, "2", offHost)
+	require.NoError(t, json.Unmarshal(signed, offHost))
+	offHost.SigningCertURL = "https://sns.eu-west-1.amazonaws.com.evil.example.com/cert.pem"
+	payload, err := json.Marshal(offHost)
+	require.NoError(t, err)
+	_, err = NewCloudWatchAdapter(SNSConfig{}, nil).Parse(payload)
+	assert.ErrorContains(t, err, "invalid SNS SigningCertURL")
+}
+
+func TestCloudWatchAdapter_AllowedTopics(t *testing.T) {
+	sns := newSNSTestEndpoint(t)
+	adapter := sns.adapter(SNSConfig{
+		AllowedTopicARNs: []string{"arn:aws:sns:eu-west-1:123456789012:allowed"},
+	})
+
+	_, err := adapter.Parse(sns.message(t, "Notification",
+		"arn:aws:sns:eu-west-1:123456789012:other", string(payloadf(cloudWatchAlarmPayload, "ALARM")), ""))
+	assert.ErrorContains(t, err, "not allowed")
+
+	// Alarms posted directly have no topic
+	_, err = adapter.Parse(payloadf(cloudWatchAlarmPayload, "ALARM"))
+	assert.ErrorContains(t, err, "not delivered by an allowed SNS topic")
+}
+
+func TestCloudWatchAdapter_ConfirmSubscription(t *testing.T) {
+	sns := newSNSTestEndpoint(t)
+	topic := "arn:aws:sns:eu-west-1:123456789012:cloudwatch-alarms"
+	subscribeURL := sns.server.URL + "/?Action=ConfirmSubscription&Token=abc"
+	ctx := context.Background()
+
+	handled, err := sns.adapter(SNSConfig{}).ConfirmSubscription(ctx,
+		sns.message(t, "SubscriptionConfirmation", topic, "confirm", subscribeURL))
+	require.NoError(t, err)
+	assert.True(t, handled)
+	assert.Equal(t, 1, sns.confirmed)
+
+	handled, err = sns.adapter(SNSConfig{DisableAutoConfirm: true}).ConfirmSubscription(ctx,
+		sns.message(t, "SubscriptionConfirmation", topic, "confirm", subscribeURL))
+	require.NoError(t, err)
+	assert.True(t, handled)
+	assert.Equal(t, 1, sns.confirmed, "auto-confirm disabled")
+
+	_, err = sns.adapter(SNSConfig{}).ConfirmSubscription(ctx,
+		sns.message(t, "SubscriptionConfirmation", topic, "confirm", "https://evil.example.com/confirm"))
+	assert.ErrorContains(t, err, "invalid SNS SubscribeURL")
+
+	_, err = sns.adapter(SNSConfig{AllowedTopicARNs: []string{"arn:aws:sns:eu-west-1:1:other"}}).ConfirmSubscription(ctx,
+		sns.message(t, "SubscriptionConfirmation", topic, "confirm", subscribeURL))
+	assert.ErrorContains(t, err, "not allowed")
+	assert.Equal(t, 1, sns.confirmed)
+
+	// Forged confirmations are not confirmed
+	var forged snsMessage
+	require.NoError(t, json.Unmarshal(sns.message(t, "SubscriptionConfirmation", topic, "confirm", subscribeURL), &forged))
+	forged.SubscribeURL += "&Forged=1"
+	payload, err := json.Marshal(&forged)
+	require.NoError(t, err)
+	handled, err = sns.adapter(SNSConfig{}).ConfirmSubscription(ctx, payload)
+	assert.ErrorContains(t, err, "signature verification failed")
+	assert.True(t, handled)
+	assert.Equal(t, 1, sns.confirmed)
+
+	handled, err = sns.adapter(SNSConfig{}).ConfirmSubscription(ctx,
+		sns.message(t, "Notification", topic, string(payloadf(cloudWatchAlarmPayload, "ALARM")), ""))
+	require.NoError(t, err)
+	assert.False(t, handled, "notifications carry alerts")
+}
+
+func TestGenericAdapter(t *testing.T) {
+	adapter, err := NewGenericAdapter(GenericSourceConfig{
+		Name:        "backup-checks",
+		Alerts:      "$.results[*]",
+		AlertName:   "BackupFailed",
+		Status:      "$.state",
+		StatusMap:   map[string]string{"OK": "resolved"},
+		StartsAt:    "$.started_at",
+		Fingerprint: "$.job_id",
+		Labels: map[string]string{
+			"job":      "$.job",
+			"severity": `{{ .level | default "warning" }}`,
+			"team":     "storage",
+		},
+		Annotations: map[string]string{
+			"summary": "Backup {{ .job }} on {{ .host | upper }} {{ .state }}",
+			"missing": "{{ .nothing }}",
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "backup-checks", adapter.Name())
+	assert.False(t, adapter.Detect([]byte(`{"results": []}`)))
+
+	alerts, err := adapter.Parse([]byte(`{"results": [
+		{"job_id": 42, "job": "db", "host": "db-1", "state": "failed", "level": "critical", "started_at": 1792144800},
+		{"job_id": 43, "job": "files", "host": "fs-1", "state": "ok", "started_at": "2026-10-16T10:00:00Z"}
+	]}`))
+	require.NoError(t, err)
+	require.Len(t, alerts, 2)
+
+	assert.Equal(t, "BackupFailed", alerts[0].AlertName)
+	assert.Equal(t, core.StatusFiring, alerts[0].Status)
+	assert.Equal(t, map[string]string{
+		"alertname": "BackupFailed",
+		"source":    "backup-checks",
+		"job":       "db",
+		"severity":  "critical",
+		"team":      "storage",
+	}, alerts[0].Labels)
+	assert.Equal(t, map[string]string{"summary": "Backup db on DB-1 failed"}, alerts[0].Annotations)
+	assert.Equal(t, int64(1792144800), alerts[0].StartsAt.Unix())
+
+	assert.Equal(t, core.StatusResolved, alerts[1].Status)
+	assert.Equal(t, "warning", alerts[1].Labels["severity"])
+	assert.NotEqual(t, alerts[0].Fingerprint, alerts[1].Fingerprint)
+
+	again, err := adapter.Parse([]byte(`{"results": [{"job_id": 42, "job": "db", "state": "ok", "level": "info"}]}`))
+	require.NoError(t, err)
+	assert.Equal(t, alerts[0].Fingerprint, again[0].Fingerprint, "fingerprint follows the configured identity")
+
+	_, err = adapter.Parse([]byte(`{"results": []}`))
+	assert.Error(t, err)
+	_, err = adapter.Parse([]byte(`not json`))
+	assert.Error(t, err)
+}
+
+func TestNewGenericAdapter_Invalid(t *testing.T) {
+	tests := map[string]GenericSourceConfig{
+		"invalid name":       {Name: "Backup Checks", AlertName: "A"},
+		"missing alertname":  {Name: "backup"},
+		"invalid status map": {Name: "backup", AlertName: "A", StatusMap: map[string]string{"ok": "done"}},
+		"invalid jsonpath":   {Name: "backup", AlertName: "$.a[", Status: "$.state"},
+		"invalid template":   {Name: "backup", AlertName: "{{ .a "},
+	}
+	for name, config := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewGenericAdapter(config)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestAdapterRegistry(t *testing.T) {
+	registry := DefaultAdapterRegistry()
+	assert.Equal(t, []string{"grafana", "datadog", "cloudwatch"}, registry.Names())
+
+	adapter, ok := registry.Detect(payloadf(datadogPayload, "Triggered", "Triggered", "error"))
+	require.True(t, ok)
+	assert.Equal(t, "datadog", adapter.Name())
+
+	_, ok = registry.Detect([]byte(`{"version":"4","groupKey":"g","alerts":[{"labels":{"alertname":"A"}}]}`))
+	assert.False(t, ok, "Alertmanager payloads are left to the built-in parser")
+
+	registry.Register(NewCloudWatchAdapter(SNSConfig{DisableAutoConfirm: true}, nil))
+	assert.Equal(t, []string{"grafana", "datadog", "cloudwatch"}, registry.Names())
+	cloudWatch, ok := registry.Get("cloudwatch")
+	require.True(t, ok)
+	assert.True(t, cloudWatch.(*cloudWatchAdapter).config.DisableAutoConfirm)
+
+	_, ok = registry.Get("unknown")
+	assert.False(t, ok)
+}
+
+func TestHandleWebhook_Source(t *testing.T) {
+	processor := &mockAlertProcessor{}
+	handler := NewUniversalWebhookHandler(processor, slog.Default())
+	ctx := context.Background()
+
+	resp, err := handler.HandleWebhook(ctx, &HandleWebhookRequest{
+		Payload: payloadf(datadogPayload, "Triggered", "Triggered", "error"),
+		Source:  "datadog",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "success", resp.Status)
+	assert.Equal(t, "datadog", resp.WebhookType)
+	assert.Equal(t, 1, resp.AlertsProcessed)
+
+	// Auto-detected before the Alertmanager format
+	resp, err = handler.HandleWebhook(ctx, &HandleWebhookRequest{Payload: payloadf(grafanaPayload, "firing")})
+	require.NoError(t, err)
+	assert.Equal(t, "grafana", resp.WebhookType)
+	require.Len(t, processor.processedAlerts, 2)
+	assert.Equal(t, "grafana", processor.processedAlerts[1].Labels["source"])
+
+	// Built-in formats by name
+	resp, err = handler.HandleWebhook(ctx, &HandleWebhookRequest{
+		Payload: []byte(`{"version":"4","groupKey":"g","status":"firing","receiver":"r","alerts":[{"status":"firing","labels":{"alertname":"A"},"startsAt":"2026-10-16T10:00:00Z"}]}`),
+		Source:  "alertmanager",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "alertmanager", resp.WebhookType)
+
+	_, err = handler.HandleWebhook(ctx, &HandleWebhookRequest{Payload: []byte(`{}`), Source: "nagios"})
+	assert.True(t, errors.Is(err, ErrUnknownSource))
+
+	_, err = handler.HandleWebhook(ctx, &HandleWebhookRequest{Payload: []byte(`{"title": "x"}`), Source: "datadog"})
+	assert.Error(t, err)
+
+	generic, err := NewGenericAdapter(GenericSourceConfig{Name: "cron", AlertName: "$.check", Status: "$.status"})
+	require.NoError(t, err)
+	handler.Adapters().Register(generic)
+	resp, err = handler.HandleWebhook(ctx, &HandleWebhookRequest{
+		Payload: []byte(`[{"check": "Nightly", "status": "resolved"}, {"check": "Hourly"}]`),
+		Source:  "cron",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, resp.AlertsProcessed)
+	assert.Equal(t, core.StatusResolved, processor.processedAlerts[len(processor.processedAlerts)-2].Status)
+}
+
+func TestHandleWebhook_SNSSubscription(t *testing.T) {
+	processor := &mockAlertProcessor{}
+	handler := NewUniversalWebhookHandler(processor, slog.Default())
+	sns := newSNSTestEndpoint(t)
+	handler.Adapters().Register(sns.adapter(SNSConfig{DisableAutoConfirm: true}))
+
+	resp, err := handler.HandleWebhook(context.Background(), &HandleWebhookRequest{
+		Payload: sns.message(t, "SubscriptionConfirmation", "arn:aws:sns:eu-west-1:1:alarms", "confirm", "https://sns.eu-west-1.amazonaws.com/"),
+		Source:  "cloudwatch",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "subscription_confirmed", resp.Status)
+	assert.Empty(t, processor.processedAlerts)
+}
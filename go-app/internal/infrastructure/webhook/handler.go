@@ -21,6 +21,8 @@ type AlertProcessor interface {
 //
 // This handler provides:
 //   - Auto-detection of webhook format (Alertmanager, Prometheus, Generic)
+//   - Source adapters (Grafana, Datadog, CloudWatch/SNS, generic JSON) selected
+//     by name or detected before the Alertmanager/Prometheus formats
 //   - Dynamic parser selection using Strategy pattern
 //   - Parsing using appropriate parser based on detected type
 //   - Validation of parsed webhook
@@ -32,6 +34,7 @@ type AlertProcessor interface {
 type UniversalWebhookHandler struct {
 	detector  WebhookDetector
 	parsers   map[WebhookType]WebhookParser // Strategy pattern: dynamic parser selection
+	adapters  *AdapterRegistry              // Source adapters (POST /webhook/{source})
 	validator WebhookValidator
 	processor AlertProcessor
 	metrics   *metrics.WebhookMetrics
@@ -65,6 +68,7 @@ func NewUniversalWebhookHandler(processor AlertProcessor, logger *slog.Logger) *
 			WebhookTypeAlertmanager: NewAlertmanagerParser(),
 			WebhookTypePrometheus:   NewPrometheusParser(), // TN-146: Prometheus support
 		},
+		adapters:  DefaultAdapterRegistry(),
 		validator: NewWebhookValidator(),
 		processor: processor,
 		metrics:   metrics.NewWebhookMetrics(),
@@ -72,6 +76,11 @@ func NewUniversalWebhookHandler(processor AlertProcessor, logger *slog.Logger) *
 	}
 }
 
+// Adapters returns the source adapter registry (register configured adapters at startup).
+func (h *UniversalWebhookHandler) Adapters() *AdapterRegistry {
+	return h.adapters
+}
+
 // Health checks the health of the webhook handler.
 func (h *UniversalWebhookHandler) Health(ctx context.Context) error {
 	// Delegate to processor if available
@@ -95,6 +104,7 @@ type HandleWebhookRequest struct {
 	Payload     []byte
 	ContentType string
 	UserAgent   string
+	Source      string // Webhook source (POST /webhook/{source}), empty auto-detects
 }
 
 // HandleWebhookResponse represents the webhook processing response.
@@ -132,8 +142,26 @@ func (h *UniversalWebhookHandler) HandleWebhook(ctx context.Context, req *Handle
 	// Record payload size
 	h.metrics.RecordPayloadSize("unknown", len(req.Payload))
 
-	// Step 1: Detect webhook type
-	webhookType, err := h.detector.Detect(req.Payload)
+	// Source adapters: selected by name or detected before the built-in formats
+	if req.Source != "" {
+		if _, ok := h.parsers[WebhookType(req.Source)]; !ok {
+			adapter, ok := h.adapters.Get(req.Source)
+			if !ok {
+				h.metrics.RecordError("unknown", "unknown_source")
+				return nil, fmt.Errorf("%w: %s", ErrUnknownSource, req.Source)
+			}
+			return h.handleAdapter(ctx, adapter, req, startTime)
+		}
+	} else if adapter, ok := h.adapters.Detect(req.Payload); ok {
+		return h.handleAdapter(ctx, adapter, req, startTime)
+	}
+
+	// Step 1: Detect webhook type (unless the source names a built-in format)
+	webhookType := WebhookType(req.Source)
+	var err error
+	if webhookType == "" {
+		webhookType, err = h.detector.Detect(req.Payload)
+	}
 	if err != nil {
 		h.logger.Error("Failed to detect webhook type",
 			"error", err,
@@ -216,6 +244,12 @@ func (h *UniversalWebhookHandler) HandleWebhook(ctx context.Context, req *Handle
 		return nil, fmt.Errorf("domain conversion failed: %w", err)
 	}
 
+	// Steps 5-7: Process alerts, record metrics, build response
+	return h.processAlerts(ctx, webhookType, alerts, startTime), nil
+}
+
+// processAlerts processes converted alerts and builds the webhook response.
+func (h *UniversalWebhookHandler) processAlerts(ctx context.Context, webhookType WebhookType, alerts []*core.Alert, startTime time.Time) *HandleWebhookResponse {
 	// Step 5: Process alerts
 	processStart := time.Now()
 	processedCount := 0
@@ -272,7 +306,73 @@ func (h *UniversalWebhookHandler) HandleWebhook(ctx context.Context, req *Handle
 		AlertsProcessed: processedCount,
 		Errors:          processingErrors,
 		ProcessingTime:  time.Since(startTime).String(),
-	}, nil
+	}
+}
+
+// handleAdapter parses, validates and processes a payload with a source adapter.
+func (h *UniversalWebhookHandler) handleAdapter(ctx context.Context, adapter SourceAdapter, req *HandleWebhookRequest, startTime time.Time) (*HandleWebhookResponse, error) {
+	webhookType := WebhookType(adapter.Name())
+	h.logger.Info("Webhook detected",
+		"type", webhookType,
+		"payload_size", len(req.Payload))
+
+	// Subscription handshakes (AWS SNS) carry no alerts
+	if confirmer, ok := adapter.(SubscriptionConfirmer); ok {
+		handled, err := confirmer.ConfirmSubscription(ctx, req.Payload)
+		if err != nil {
+			h.logger.Error("Failed to confirm webhook subscription",
+				"error", err,
+				"webhook_type", webhookType)
+			h.metrics.RecordError(string(webhookType), "subscription_error")
+			h.metrics.RecordRequest(string(webhookType), "failure", time.Since(startTime).Seconds())
+			return nil, fmt.Errorf("subscription confirmation failed: %w", err)
+		}
+		if handled {
+			h.metrics.RecordRequest(string(webhookType), "success", time.Since(startTime).Seconds())
+			return &HandleWebhookResponse{
+				Status:         "subscription_confirmed",
+				Message:        "Subscription message handled",
+				WebhookType:    string(webhookType),
+				ProcessingTime: time.Since(startTime).String(),
+			}, nil
+		}
+	}
+
+	parseStart := time.Now()
+	alerts, err := adapter.Parse(req.Payload)
+	h.metrics.RecordProcessingStage(string(webhookType), "parse", time.Since(parseStart).Seconds())
+	if err != nil {
+		h.logger.Error("Failed to parse webhook",
+			"error", err,
+			"webhook_type", webhookType)
+		h.metrics.RecordError(string(webhookType), "parse_error")
+		h.metrics.RecordRequest(string(webhookType), "failure", time.Since(startTime).Seconds())
+		return nil, fmt.Errorf("webhook parsing failed: %w", err)
+	}
+
+	var errorMessages []string
+	for i, alert := range alerts {
+		if err := validateAdapterAlert(alert); err != nil {
+			errorMessages = append(errorMessages, fmt.Sprintf("alerts[%d]: %s", i, err))
+		}
+	}
+	if len(errorMessages) > 0 {
+		h.logger.Warn("Webhook validation failed",
+			"webhook_type", webhookType,
+			"errors", errorMessages)
+		h.metrics.RecordError(string(webhookType), "validation_error")
+		h.metrics.RecordRequest(string(webhookType), "failure", time.Since(startTime).Seconds())
+		return &HandleWebhookResponse{
+			Status:         "validation_failed",
+			Message:        "Webhook validation failed",
+			WebhookType:    string(webhookType),
+			AlertsReceived: len(alerts),
+			Errors:         errorMessages,
+			ProcessingTime: time.Since(startTime).String(),
+		}, fmt.Errorf("validation failed: %d errors", len(errorMessages))
+	}
+
+	return h.processAlerts(ctx, webhookType, alerts, startTime), nil
 }
 
 // HandleWebhookSync is a convenience method for synchronous webhook processing.
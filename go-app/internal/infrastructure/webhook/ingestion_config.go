@@ -0,0 +1,89 @@
+package webhook
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// reservedSourceNames are the built-in sources and /webhook/* routes.
+var reservedSourceNames = map[string]bool{
+	string(WebhookTypeAlertmanager): true,
+	string(WebhookTypePrometheus):   true,
+	"grafana":                       true,
+	"datadog":                       true,
+	"cloudwatch":                    true,
+	"proxy":                         true,
+}
+
+// IngestionConfig is the ingestion adapter configuration file, e.g.
+//
+//	sns:
+//	  allowed_topic_arns: ["arn:aws:sns:eu-west-1:123456789012:alerts"]
+//	generic_sources:
+//	  - name: backup-checks
+//	    alerts: "$.results[*]"
+//	    alertname: "BackupFailed"
+//	    status: "$.state"
+//	    status_map: {ok: resolved}
+//	    fingerprint: "$.job_id"
+//	    labels:
+//	      job: "$.job"
+//	      severity: "{{ .level | default \"warning\" }}"
+//	    annotations:
+//	      summary: "Backup {{ .job }} {{ .state }}"
+type IngestionConfig struct {
+	// SNS configures the CloudWatch adapter subscription handling
+	SNS SNSConfig `yaml:"sns"`
+
+	// GenericSources are served at POST /webhook/{name}
+	GenericSources []GenericSourceConfig `yaml:"generic_sources"`
+}
+
+// LoadIngestionConfig reads and validates an ingestion configuration file.
+func LoadIngestionConfig(path string) (*IngestionConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read ingestion config: %w", err)
+	}
+	return ParseIngestionConfig(data)
+}
+
+// ParseIngestionConfig parses and validates an ingestion configuration.
+func ParseIngestionConfig(data []byte) (*IngestionConfig, error) {
+	var config IngestionConfig
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("parse ingestion config: %w", err)
+	}
+	if _, err := config.Adapters(nil); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// Adapters builds the configured adapters: the CloudWatch adapter with the
+// SNS settings and one adapter per generic source.
+func (c *IngestionConfig) Adapters(logger *slog.Logger) ([]SourceAdapter, error) {
+	adapters := []SourceAdapter{NewCloudWatchAdapter(c.SNS, logger)}
+
+	names := make(map[string]bool, len(c.GenericSources))
+	for _, source := range c.GenericSources {
+		if reservedSourceNames[source.Name] {
+			return nil, fmt.Errorf("generic source %q: name is reserved", source.Name)
+		}
+		if names[source.Name] {
+			return nil, fmt.Errorf("generic source %q: duplicate name", source.Name)
+		}
+		names[source.Name] = true
+
+		adapter, err := NewGenericAdapter(source)
+		if err != nil {
+			return nil, fmt.Errorf("generic source: %w", err)
+		}
+		adapters = append(adapters, adapter)
+	}
+
+	return adapters, nil
+}
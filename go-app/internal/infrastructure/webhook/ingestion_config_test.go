@@ -0,0 +1,46 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIngestionConfig(t *testing.T) {
+	config, err := ParseIngestionConfig([]byte(`
+sns:
+  allowed_topic_arns: ["arn:aws:sns:eu-west-1:123456789012:alarms"]
+generic_sources:
+  - name: backup-checks
+    alerts: "$.results[*]"
+    alertname: BackupFailed
+    status: "$.state"
+    status_map: {ok: resolved}
+    labels:
+      job: "$.job"
+`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"arn:aws:sns:eu-west-1:123456789012:alarms"}, config.SNS.AllowedTopicARNs)
+
+	adapters, err := config.Adapters(nil)
+	require.NoError(t, err)
+	require.Len(t, adapters, 2)
+	assert.Equal(t, "cloudwatch", adapters[0].Name())
+	assert.Equal(t, "backup-checks", adapters[1].Name())
+}
+
+func TestParseIngestionConfig_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"reserved name":     "generic_sources: [{name: grafana, alertname: A}]",
+		"duplicate name":    "generic_sources: [{name: cron, alertname: A}, {name: cron, alertname: B}]",
+		"missing alertname": "generic_sources: [{name: cron}]",
+		"invalid yaml":      "generic_sources: {",
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseIngestionConfig([]byte(data))
+			assert.Error(t, err)
+		})
+	}
+}
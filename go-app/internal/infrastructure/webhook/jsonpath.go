@@ -0,0 +1,112 @@
+package webhook
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// jsonPath is a compiled JSONPath subset selecting values of decoded JSON:
+// $ (root), .key, ['key'], [index] and [*] (all elements or values).
+type jsonPath []jsonPathStep
+
+// jsonPathStep is a single child selector.
+type jsonPathStep struct {
+	key      string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+// compileJSONPath compiles a JSONPath expression such as $.alerts[*].labels['app.kubernetes.io/name'].
+func compileJSONPath(expr string) (jsonPath, error) {
+	if !strings.HasPrefix(expr, "$") {
+		return nil, fmt.Errorf("JSONPath %q must start with $", expr)
+	}
+
+	var path jsonPath
+	rest := expr[1:]
+	for rest != "" {
+		switch {
+		case rest[0] == '.':
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end == -1 {
+				end = len(rest)
+			}
+			key := rest[:end]
+			if key == "" {
+				return nil, fmt.Errorf("JSONPath %q: empty key", expr)
+			}
+			path = append(path, jsonPathStep{key: key, wildcard: key == "*"})
+			rest = rest[end:]
+		case rest[0] == '[':
+			end := strings.IndexByte(rest, ']')
+			if end == -1 {
+				return nil, fmt.Errorf("JSONPath %q: unclosed [", expr)
+			}
+			selector := strings.TrimSpace(rest[1:end])
+			rest = rest[end+1:]
+
+			switch {
+			case selector == "*":
+				path = append(path, jsonPathStep{wildcard: true})
+			case len(selector) >= 2 && (selector[0] == '\'' || selector[0] == '"') && selector[len(selector)-1] == selector[0]:
+				path = append(path, jsonPathStep{key: selector[1 : len(selector)-1]})
+			default:
+				index, err := strconv.Atoi(selector)
+				if err != nil {
+					return nil, fmt.Errorf("JSONPath %q: invalid selector [%s]", expr, selector)
+				}
+				path = append(path, jsonPathStep{index: index, isIndex: true})
+			}
+		default:
+			return nil, fmt.Errorf("JSONPath %q: unexpected %q", expr, rest[:1])
+		}
+	}
+
+	return path, nil
+}
+
+// Select returns the values matched in data (decoded JSON).
+func (p jsonPath) Select(data any) []any {
+	current := []any{data}
+	for _, step := range p {
+		var next []any
+		for _, value := range current {
+			switch v := value.(type) {
+			case map[string]any:
+				if step.wildcard {
+					for _, child := range v {
+						next = append(next, child)
+					}
+				} else if child, ok := v[step.key]; ok && !step.isIndex {
+					next = append(next, child)
+				}
+			case []any:
+				switch {
+				case step.wildcard:
+					next = append(next, v...)
+				case step.isIndex:
+					index := step.index
+					if index < 0 {
+						index += len(v)
+					}
+					if index >= 0 && index < len(v) {
+						next = append(next, v[index])
+					}
+				}
+			}
+		}
+		current = next
+	}
+	return current
+}
+
+// First returns the first value matched in data, nil if none.
+func (p jsonPath) First(data any) any {
+	if values := p.Select(data); len(values) > 0 {
+		return values[0]
+	}
+	return nil
+}
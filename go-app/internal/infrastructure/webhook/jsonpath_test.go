@@ -0,0 +1,54 @@
+package webhook
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONPath_Select(t *testing.T) {
+	var data any
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"alerts": [
+			{"name": "a", "labels": {"app.kubernetes.io/name": "api"}},
+			{"name": "b", "labels": {"app.kubernetes.io/name": "web"}}
+		],
+		"tags": {"env": "prod"}
+	}`), &data))
+
+	tests := []struct {
+		expr string
+		want []any
+	}{
+		{"$.alerts[0].name", []any{"a"}},
+		{"$.alerts[-1].name", []any{"b"}},
+		{"$.alerts[*].name", []any{"a", "b"}},
+		{"$['alerts'][1]['labels']['app.kubernetes.io/name']", []any{"web"}},
+		{"$.tags.*", []any{"prod"}},
+		{"$.alerts[5].name", nil},
+		{"$.missing.name", nil},
+		{"$.alerts.name", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			path, err := compileJSONPath(tt.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, path.Select(data))
+		})
+	}
+
+	path, err := compileJSONPath("$")
+	require.NoError(t, err)
+	assert.Equal(t, data, path.First(data))
+}
+
+func TestCompileJSONPath_Invalid(t *testing.T) {
+	for _, expr := range []string{"alerts", "$.", "$.alerts[", "$.alerts[x]", "$alerts"} {
+		t.Run(expr, func(t *testing.T) {
+			_, err := compileJSONPath(expr)
+			assert.Error(t, err)
+		})
+	}
+}
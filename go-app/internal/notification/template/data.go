@@ -91,6 +91,11 @@ type TemplateData struct {
 	// Example: "alertname=HighCPU,cluster=prod"
 	GroupKey string
 
+	// GroupSummary is the incident summary of the alert group
+	// nil if the group was not summarised
+	// Example: {{ with .GroupSummary }}{{ .Summary }} Root cause: {{ .RootCause }}{{ end }}
+	GroupSummary *GroupSummary
+
 	// ===================================================================
 	// External URLs
 	// ===================================================================
@@ -111,10 +116,28 @@ type TemplateData struct {
 	// Example: "slack-oncall"
 	Receiver string
 
-	// ReceiverType is receiver type: "slack", "pagerduty", "email", "webhook"
+	// ReceiverType is receiver type: "slack", "pagerduty", "email", "webhook", "msteams", "telegram"
 	ReceiverType string
 }
 
+// GroupSummary is the incident summary of an alert group (e.g. an alert storm)
+type GroupSummary struct {
+	// Summary is a concise description of the incident
+	Summary string
+
+	// RootCause is the probable root cause (may be empty)
+	RootCause string
+
+	// Recommendations is the deduplicated list of recommended actions
+	Recommendations []string
+
+	// Confidence is the summary confidence (0.0-1.0)
+	Confidence float64
+
+	// Source is how the summary was produced: "llm" or "fallback"
+	Source string
+}
+
 // NewTemplateData creates TemplateData with required fields
 //
 // Parameters:
@@ -288,6 +311,12 @@ func (d *TemplateData) WithGeneratorURL(url string) *TemplateData {
 	return d
 }
 
+// WithGroupSummary sets the group incident summary
+func (d *TemplateData) WithGroupSummary(summary *GroupSummary) *TemplateData {
+	d.GroupSummary = summary
+	return d
+}
+
 // WithFingerprint sets alert fingerprint
 func (d *TemplateData) WithFingerprint(fingerprint string) *TemplateData {
 	d.Fingerprint = fingerprint
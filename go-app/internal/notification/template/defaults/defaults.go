@@ -16,7 +16,7 @@ package defaults
 // Date: 2025-11-22
 
 // TemplateRegistry holds all default templates for all receiver types.
-// Provides centralized access to Slack, PagerDuty, and Email templates.
+// Provides centralized access to Slack, PagerDuty, Email, Webhook, Microsoft Teams and Telegram templates.
 //
 // Usage:
 //
@@ -36,6 +36,12 @@ type TemplateRegistry struct {
 
 	// Webhook holds all WebHook default templates
 	Webhook *WebhookTemplates
+
+	// MSTeams holds all Microsoft Teams default templates
+	MSTeams *MSTeamsTemplates
+
+	// Telegram holds all Telegram default templates
+	Telegram *TelegramTemplates
 }
 
 // GetDefaultTemplates returns the complete default template registry.
@@ -65,6 +71,8 @@ func GetDefaultTemplates() *TemplateRegistry {
 		PagerDuty: GetDefaultPagerDutyTemplates(),
 		Email:     GetDefaultEmailTemplates(),
 		Webhook:   GetDefaultWebhookTemplates(),
+		MSTeams:   GetDefaultMSTeamsTemplates(),
+		Telegram:  GetDefaultTelegramTemplates(),
 	}
 }
 
@@ -78,6 +86,7 @@ func GetDefaultTemplates() *TemplateRegistry {
 //   - Slack message size < 3000 chars
 //   - PagerDuty description < 1024 chars
 //   - Email HTML < 100KB
+//   - Telegram message < 4096 chars
 //   - All templates non-empty
 func ValidateAllTemplates() error {
 	registry := GetDefaultTemplates()
@@ -211,6 +220,34 @@ func ValidateAllTemplates() error {
 		}
 	}
 
+	// Validate Microsoft Teams templates
+	if registry.MSTeams.Title == "" {
+		return &TemplateValidationError{
+			Template: "MSTeams.Title",
+			Reason:   "template is empty",
+		}
+	}
+	if registry.MSTeams.Text == "" {
+		return &TemplateValidationError{
+			Template: "MSTeams.Text",
+			Reason:   "template is empty",
+		}
+	}
+
+	// Validate Telegram templates
+	if registry.Telegram.Message == "" {
+		return &TemplateValidationError{
+			Template: "Telegram.Message",
+			Reason:   "template is empty",
+		}
+	}
+	if !ValidateTelegramMessageSize(registry.Telegram.Message) {
+		return &TemplateValidationError{
+			Template: "Telegram.Message",
+			Reason:   "template exceeds 4096 char limit",
+		}
+	}
+
 	return nil
 }
 
@@ -243,6 +280,12 @@ type TemplateStats struct {
 	// WebhookTemplateCount is the number of Webhook templates
 	WebhookTemplateCount int
 
+	// MSTeamsTemplateCount is the number of Microsoft Teams templates
+	MSTeamsTemplateCount int
+
+	// TelegramTemplateCount is the number of Telegram templates
+	TelegramTemplateCount int
+
 	// TotalSize is the total size of all templates in bytes
 	TotalSize int
 
@@ -257,6 +300,12 @@ type TemplateStats struct {
 
 	// WebhookSize is the total size of Webhook templates
 	WebhookSize int
+
+	// MSTeamsSize is the total size of Microsoft Teams templates
+	MSTeamsSize int
+
+	// TelegramSize is the total size of Telegram templates
+	TelegramSize int
 }
 
 // GetTemplateStats returns statistics about all default templates.
@@ -281,15 +330,25 @@ func GetTemplateStats() *TemplateStats {
 		len(registry.Webhook.MicrosoftTeams) +
 		len(registry.Webhook.Discord)
 
+	msteamsSize := len(registry.MSTeams.Title) +
+		len(registry.MSTeams.Summary) +
+		len(registry.MSTeams.Text)
+
+	telegramSize := len(registry.Telegram.Message)
+
 	return &TemplateStats{
 		SlackTemplateCount:     5, // Title, Text, Pretext, FieldsSingle, FieldsMulti
 		PagerDutyTemplateCount: 3, // Description, DetailsSingle, DetailsMulti
 		EmailTemplateCount:     3, // Subject, HTML, Text
 		WebhookTemplateCount:   3, // Payload, MicrosoftTeams, Discord
-		TotalSize:              slackSize + pagerdutySize + emailSize + webhookSize,
+		MSTeamsTemplateCount:   3, // Title, Summary, Text
+		TelegramTemplateCount:  1, // Message
+		TotalSize:              slackSize + pagerdutySize + emailSize + webhookSize + msteamsSize + telegramSize,
 		SlackSize:              slackSize,
 		PagerDutySize:          pagerdutySize,
 		EmailSize:              emailSize,
 		WebhookSize:            webhookSize,
+		MSTeamsSize:            msteamsSize,
+		TelegramSize:           telegramSize,
 	}
 }
@@ -19,6 +19,8 @@ func TestGetDefaultTemplates(t *testing.T) {
 	require.NotNil(t, registry.PagerDuty)
 	require.NotNil(t, registry.Email)
 	require.NotNil(t, registry.Webhook)
+	require.NotNil(t, registry.MSTeams)
+	require.NotNil(t, registry.Telegram)
 
 	// Verify Slack templates
 	assert.NotEmpty(t, registry.Slack.Title)
@@ -62,6 +64,8 @@ func TestGetTemplateStats(t *testing.T) {
 	assert.Equal(t, 3, stats.PagerDutyTemplateCount)
 	assert.Equal(t, 3, stats.EmailTemplateCount)
 	assert.Equal(t, 3, stats.WebhookTemplateCount)
+	assert.Equal(t, 3, stats.MSTeamsTemplateCount)
+	assert.Equal(t, 1, stats.TelegramTemplateCount)
 
 	// Verify sizes are reasonable
 	assert.Greater(t, stats.SlackSize, 0)
@@ -71,7 +75,7 @@ func TestGetTemplateStats(t *testing.T) {
 	assert.Greater(t, stats.TotalSize, 0)
 
 	// Verify total is sum of parts
-	assert.Equal(t, stats.SlackSize+stats.PagerDutySize+stats.EmailSize+stats.WebhookSize, stats.TotalSize)
+	assert.Equal(t, stats.SlackSize+stats.PagerDutySize+stats.EmailSize+stats.WebhookSize+stats.MSTeamsSize+stats.TelegramSize, stats.TotalSize)
 
 	// Verify sizes are reasonable (not too large)
 	assert.Less(t, stats.SlackSize, 10*1024, "Slack templates should be < 10KB")
@@ -0,0 +1,82 @@
+package defaults
+
+// ================================================================================
+// Default Templates - Microsoft Teams Templates
+// ================================================================================
+// Default templates for Microsoft Teams notifications (Adaptive Cards posted via
+// Workflows or legacy Incoming Webhooks).
+//
+// Templates render plain text fragments only; the publisher assembles the
+// Adaptive Card JSON itself, so template output never needs JSON escaping.
+//
+// Features:
+// - Status-based emojis and card colors
+// - Single and multi-alert support
+// - Teams Markdown subset (bold, lists, links)
+
+import "strings"
+
+// DefaultMSTeamsTitle is the default template for the card title.
+//
+// Example outputs:
+// - "🔥 ALERT: HighCPU"
+// - "✅ RESOLVED: HighCPU"
+const DefaultMSTeamsTitle = `{{ if eq .Status "resolved" }}✅ RESOLVED{{ else }}🔥 ALERT{{ end }}: {{ .GroupLabels.alertname }}`
+
+// DefaultMSTeamsSummary is the default template for the notification summary
+// (shown in Teams activity feed and mobile push notifications).
+const DefaultMSTeamsSummary = `{{ .GroupLabels.alertname }}{{ if .CommonAnnotations.summary }}: {{ .CommonAnnotations.summary | truncate 150 }}{{ end }}`
+
+// DefaultMSTeamsText is the default template for the card body.
+// Uses Teams Markdown subset (**bold**, - lists, [links](url)).
+const DefaultMSTeamsText = `{{ if gt (len .Alerts) 1 }}**{{ len .Alerts }} alerts** in this group
+{{ range .Alerts }}
+- {{ .Labels.alertname }}{{ if .Labels.instance }} on {{ .Labels.instance }}{{ end }}{{ if .Annotations.summary }}: {{ .Annotations.summary }}{{ end }}
+{{ end }}{{ else }}{{ .CommonAnnotations.summary | default "Alert triggered" }}
+{{ if .CommonAnnotations.description }}
+{{ .CommonAnnotations.description }}{{ end }}{{ end }}`
+
+// MSTeamsTemplates holds all Microsoft Teams default templates.
+type MSTeamsTemplates struct {
+	// Title is the card title template
+	Title string
+
+	// Summary is the notification summary template
+	Summary string
+
+	// Text is the card body template
+	Text string
+
+	// ColorFunc maps severity to Adaptive Card text color
+	ColorFunc func(severity string) string
+}
+
+// GetMSTeamsColor returns the Adaptive Card color for a given severity.
+//
+// Mapping:
+// - critical, error → Attention (red)
+// - warning → Warning (yellow)
+// - info → Accent (blue)
+// - default → Default
+func GetMSTeamsColor(severity string) string {
+	switch strings.ToLower(severity) {
+	case "critical", "error":
+		return "Attention"
+	case "warning":
+		return "Warning"
+	case "info":
+		return "Accent"
+	default:
+		return "Default"
+	}
+}
+
+// GetDefaultMSTeamsTemplates returns the default Microsoft Teams template set.
+func GetDefaultMSTeamsTemplates() *MSTeamsTemplates {
+	return &MSTeamsTemplates{
+		Title:     DefaultMSTeamsTitle,
+		Summary:   DefaultMSTeamsSummary,
+		Text:      DefaultMSTeamsText,
+		ColorFunc: GetMSTeamsColor,
+	}
+}
@@ -0,0 +1,69 @@
+package defaults
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ================================================================================
+// Default Templates - Microsoft Teams Template Tests
+// ================================================================================
+
+func TestGetDefaultMSTeamsTemplates(t *testing.T) {
+	templates := GetDefaultMSTeamsTemplates()
+
+	require.NotNil(t, templates)
+	assert.NotEmpty(t, templates.Title)
+	assert.NotEmpty(t, templates.Summary)
+	assert.NotEmpty(t, templates.Text)
+	require.NotNil(t, templates.ColorFunc)
+}
+
+func TestGetMSTeamsColor(t *testing.T) {
+	tests := []struct {
+		severity string
+		expected string
+	}{
+		{"critical", "Attention"},
+		{"ERROR", "Attention"},
+		{"warning", "Warning"},
+		{"info", "Accent"},
+		{"", "Default"},
+		{"unknown", "Default"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.severity, func(t *testing.T) {
+			assert.Equal(t, tt.expected, GetMSTeamsColor(tt.severity))
+		})
+	}
+}
+
+func TestMSTeamsTemplatesIntegration(t *testing.T) {
+	engine := createTestTemplateEngine(t)
+	templates := GetDefaultMSTeamsTemplates()
+
+	title, err := engine.Execute(context.Background(), templates.Title, createTestTemplateData("firing", 1))
+	require.NoError(t, err)
+	assert.Equal(t, "🔥 ALERT: HighCPU", title)
+
+	title, err = engine.Execute(context.Background(), templates.Title, createTestTemplateData("resolved", 1))
+	require.NoError(t, err)
+	assert.Equal(t, "✅ RESOLVED: HighCPU", title)
+
+	summary, err := engine.Execute(context.Background(), templates.Summary, createTestTemplateData("firing", 1))
+	require.NoError(t, err)
+	assert.Equal(t, "HighCPU: CPU usage is 95%", summary)
+
+	text, err := engine.Execute(context.Background(), templates.Text, createTestTemplateData("firing", 1))
+	require.NoError(t, err)
+	assert.Contains(t, text, "CPU usage is 95%")
+	assert.Contains(t, text, "above 90% for 5 minutes")
+
+	text, err = engine.Execute(context.Background(), templates.Text, createTestTemplateData("firing", 3))
+	require.NoError(t, err)
+	assert.Contains(t, text, "**3 alerts** in this group")
+}
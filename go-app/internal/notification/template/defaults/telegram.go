@@ -0,0 +1,58 @@
+package defaults
+
+// ================================================================================
+// Default Templates - Telegram Templates
+// ================================================================================
+// Default templates for Telegram Bot API notifications.
+//
+// Features:
+// - HTML parse mode (label values escaped with the html function)
+// - Status-based emojis
+// - Single and multi-alert support
+// - < 4096 chars per message (Telegram limit)
+
+// DefaultTelegramParseMode is the default Telegram parse mode for DefaultTelegramMessage.
+const DefaultTelegramParseMode = "HTML"
+
+// DefaultTelegramMessage is the default template for Telegram messages (HTML parse mode).
+//
+// Example output:
+//
+//	🔥 <b>ALERT: HighCPU</b>
+//	CPU usage above 90%
+//
+//	<b>Severity:</b> critical
+//	<b>Instance:</b> prod-1
+const DefaultTelegramMessage = `{{ if eq .Status "resolved" }}✅ <b>RESOLVED: {{ .GroupLabels.alertname | html }}</b>{{ else }}🔥 <b>ALERT: {{ .GroupLabels.alertname | html }}</b>{{ end }}
+{{ if gt (len .Alerts) 1 }}<b>{{ len .Alerts }} alerts</b> in this group
+{{ range .Alerts }}• {{ .Labels.alertname | html }}{{ if .Labels.instance }} on {{ .Labels.instance | html }}{{ end }}{{ if .Annotations.summary }}: {{ .Annotations.summary | html }}{{ end }}
+{{ end }}{{ else }}{{ if .CommonAnnotations.summary }}{{ .CommonAnnotations.summary | html }}
+{{ end }}{{ if .CommonAnnotations.description }}{{ .CommonAnnotations.description | html }}
+{{ end }}
+<b>Severity:</b> {{ .CommonLabels.severity | default "unknown" | html }}{{ if .CommonLabels.instance }}
+<b>Instance:</b> {{ .CommonLabels.instance | html }}{{ end }}{{ if .CommonLabels.namespace }}
+<b>Namespace:</b> {{ .CommonLabels.namespace | html }}{{ end }}{{ end }}{{ if .CommonAnnotations.runbook_url }}
+<a href="{{ .CommonAnnotations.runbook_url }}">📖 Runbook</a>{{ end }}`
+
+// TelegramTemplates holds all Telegram default templates.
+type TelegramTemplates struct {
+	// Message is the message text template
+	Message string
+
+	// ParseMode is the parse mode the Message template is written for
+	ParseMode string
+}
+
+// GetDefaultTelegramTemplates returns the default Telegram template set.
+func GetDefaultTelegramTemplates() *TelegramTemplates {
+	return &TelegramTemplates{
+		Message:   DefaultTelegramMessage,
+		ParseMode: DefaultTelegramParseMode,
+	}
+}
+
+// ValidateTelegramMessageSize checks if the message is within Telegram limits.
+// Telegram limits message text to 4096 characters (after entity parsing).
+func ValidateTelegramMessageSize(message string) bool {
+	return len([]rune(message)) <= 4096
+}
@@ -0,0 +1,49 @@
+package defaults
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ================================================================================
+// Default Templates - Telegram Template Tests
+// ================================================================================
+
+func TestGetDefaultTelegramTemplates(t *testing.T) {
+	templates := GetDefaultTelegramTemplates()
+
+	require.NotNil(t, templates)
+	assert.NotEmpty(t, templates.Message)
+	assert.Equal(t, "HTML", templates.ParseMode)
+	assert.True(t, ValidateTelegramMessageSize(templates.Message))
+}
+
+func TestValidateTelegramMessageSize(t *testing.T) {
+	assert.True(t, ValidateTelegramMessageSize(strings.Repeat("a", 4096)))
+	assert.False(t, ValidateTelegramMessageSize(strings.Repeat("a", 4097)))
+	// Limit is in characters, not bytes
+	assert.True(t, ValidateTelegramMessageSize(strings.Repeat("🔥", 4096)))
+}
+
+func TestTelegramMessageIntegration(t *testing.T) {
+	engine := createTestTemplateEngine(t)
+	templates := GetDefaultTelegramTemplates()
+
+	data := createTestTemplateData("firing", 1)
+	data.CommonAnnotations["summary"] = "CPU <95%> & rising"
+	result, err := engine.Execute(context.Background(), templates.Message, data)
+	require.NoError(t, err)
+	assert.Contains(t, result, "🔥 <b>ALERT: HighCPU</b>")
+	assert.Contains(t, result, "CPU &lt;95%&gt; &amp; rising", "label values must be HTML-escaped")
+	assert.Contains(t, result, "<b>Severity:</b> critical")
+	assert.Contains(t, result, `<a href="https://runbook.example.com/cpu">`)
+
+	result, err = engine.Execute(context.Background(), templates.Message, createTestTemplateData("resolved", 3))
+	require.NoError(t, err)
+	assert.Contains(t, result, "✅ <b>RESOLVED: HighCPU</b>")
+	assert.Contains(t, result, "<b>3 alerts</b> in this group")
+}
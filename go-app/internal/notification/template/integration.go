@@ -13,7 +13,7 @@ import (
 // Supported Receivers:
 // - Slack: Title, Text, Pretext, Fields
 // - PagerDuty: Summary, Details
-// - Email: Subject, Body (FUTURE - TN-154)
+// - Email: Subject, Body, HTML
 // - Webhook: Custom fields
 //
 // Features:
@@ -49,10 +49,11 @@ type PagerDutyConfig struct {
 	Details map[string]string
 }
 
-// EmailConfig represents Email receiver configuration (FUTURE - TN-154)
+// EmailConfig represents Email receiver configuration
 type EmailConfig struct {
 	Subject string
-	Body    string
+	Body    string // Plain text body
+	HTML    string // HTML body (optional, sent as multipart/alternative)
 	To      []string
 }
 
@@ -224,7 +225,8 @@ func ProcessPagerDutyConfig(
 //
 // Processes:
 // - Subject: Email subject line
-// - Body: Email body (plain text or HTML)
+// - Body: Plain text email body
+// - HTML: HTML email body
 //
 // Parameters:
 //   - ctx: Context with timeout
@@ -267,6 +269,9 @@ func ProcessEmailConfig(
 	if config.Body != "" {
 		templates["body"] = config.Body
 	}
+	if config.HTML != "" {
+		templates["html"] = config.HTML
+	}
 
 	// Execute templates in parallel
 	if len(templates) > 0 {
@@ -277,6 +282,7 @@ func ProcessEmailConfig(
 
 		processed.Subject = results["subject"]
 		processed.Body = results["body"]
+		processed.HTML = results["html"]
 	}
 
 	return processed, nil
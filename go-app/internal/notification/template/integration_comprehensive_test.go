@@ -368,6 +368,24 @@ Summary: {{ .Annotations.summary }}`,
 	assert.Contains(t, result.Body, "Summary: CPU usage is high")
 }
 
+func TestProcessEmailConfig_HTML(t *testing.T) {
+	engine, err := NewNotificationTemplateEngine(DefaultTemplateEngineOptions())
+	require.NoError(t, err)
+
+	data := NewTemplateData("resolved", map[string]string{"alertname": "HighCPU"}, nil, time.Now())
+	config := &EmailConfig{
+		Body: "{{ .Labels.alertname }} {{ .Status }}",
+		HTML: "<b>{{ .Labels.alertname }}</b>",
+	}
+
+	result, err := ProcessEmailConfig(context.Background(), engine, config, data)
+	require.NoError(t, err)
+
+	assert.Equal(t, "HighCPU resolved", result.Body)
+	assert.Equal(t, "<b>HighCPU</b>", result.HTML)
+	assert.Empty(t, result.Subject)
+}
+
 func TestProcessEmailConfig_NoTemplate(t *testing.T) {
 	engine, err := NewNotificationTemplateEngine(DefaultTemplateEngineOptions())
 	require.NoError(t, err)
@@ -0,0 +1,206 @@
+// Package realtime provides real-time event broadcasting system for dashboard updates.
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// realtimeEventChannel is the Redis pub/sub channel / Postgres NOTIFY channel
+// carrying dashboard events between replicas.
+const realtimeEventChannel = "realtime_events"
+
+// backendBufferSize is the buffer of backend subscription channels.
+const backendBufferSize = 1000
+
+// EventBusBackend fans events out across replicas.
+//
+// A DefaultEventBus with a backend publishes every local event to it and
+// broadcasts events received from other replicas to its local subscribers.
+// Delivery is best-effort (pub/sub): events published while a replica is
+// disconnected are lost for that replica.
+type EventBusBackend interface {
+	// Publish sends the event to all replicas (including the sender).
+	Publish(ctx context.Context, event Event) error
+
+	// Subscribe returns events published by all replicas.
+	// The channel is closed when ctx is done or the backend is closed.
+	Subscribe(ctx context.Context) (<-chan Event, error)
+
+	// Close stops all subscriptions.
+	Close() error
+}
+
+// MemoryBusBackend is an in-process EventBusBackend.
+// It connects several event buses of the same process (tests, embedded setups).
+type MemoryBusBackend struct {
+	mu          sync.RWMutex
+	subscribers map[chan Event]struct{}
+	closed      bool
+}
+
+// NewMemoryBusBackend creates a new in-process backend.
+func NewMemoryBusBackend() *MemoryBusBackend {
+	return &MemoryBusBackend{subscribers: make(map[chan Event]struct{})}
+}
+
+// Publish delivers the event to all subscriptions (dropped for full subscribers).
+func (m *MemoryBusBackend) Publish(ctx context.Context, event Event) error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if m.closed {
+		return ErrBackendClosed
+	}
+	for ch := range m.subscribers {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+	return nil
+}
+
+// Subscribe registers a subscription until ctx is done.
+func (m *MemoryBusBackend) Subscribe(ctx context.Context) (<-chan Event, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.closed {
+		return nil, ErrBackendClosed
+	}
+
+	ch := make(chan Event, backendBufferSize)
+	m.subscribers[ch] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if _, ok := m.subscribers[ch]; ok {
+			delete(m.subscribers, ch)
+			close(ch)
+		}
+	}()
+
+	return ch, nil
+}
+
+// Close closes all subscriptions.
+func (m *MemoryBusBackend) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.closed {
+		return nil
+	}
+	m.closed = true
+	for ch := range m.subscribers {
+		delete(m.subscribers, ch)
+		close(ch)
+	}
+	return nil
+}
+
+// encodeEvent serializes an event for the wire.
+func encodeEvent(event Event) (string, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return "", fmt.Errorf("encode realtime event: %w", err)
+	}
+	return string(payload), nil
+}
+
+// decodeEvent parses an event received from the wire.
+func decodeEvent(payload string) (Event, error) {
+	var event Event
+	if err := json.Unmarshal([]byte(payload), &event); err != nil {
+		return Event{}, fmt.Errorf("decode realtime event: %w", err)
+	}
+	if event.ID == "" || event.Origin == "" {
+		return Event{}, ErrInvalidEvent
+	}
+	return event, nil
+}
+
+// remoteEventTTL is how long event IDs and origin sequences are remembered.
+const remoteEventTTL = 10 * time.Minute
+
+// remoteEventFilter drops duplicate and out-of-order events received from
+// other replicas.
+//
+// Events are de-duplicated by ID; per origin only events with a higher
+// Sequence than the last delivered one pass, so the per-origin order of
+// Sequence seen by subscribers is strictly increasing. Origins are unique
+// per process start, so a restarted replica begins a new sequence.
+type remoteEventFilter struct {
+	mu        sync.Mutex
+	seen      map[string]time.Time // event ID -> first seen
+	lastSeq   map[string]originSeq // origin -> last delivered sequence
+	lastPrune time.Time
+	now       func() time.Time
+}
+
+type originSeq struct {
+	sequence int64
+	seenAt   time.Time
+}
+
+// remoteFilterResult is the outcome of remoteEventFilter.accept.
+type remoteFilterResult string
+
+const (
+	remoteAccepted  remoteFilterResult = "accepted"
+	remoteDuplicate remoteFilterResult = "duplicate"
+	remoteStale     remoteFilterResult = "out_of_order"
+)
+
+func newRemoteEventFilter() *remoteEventFilter {
+	return &remoteEventFilter{
+		seen:    make(map[string]time.Time),
+		lastSeq: make(map[string]originSeq),
+		now:     time.Now,
+	}
+}
+
+// accept reports whether the event should be delivered and remembers it.
+func (f *remoteEventFilter) accept(event Event) remoteFilterResult {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := f.now()
+	f.pruneLocked(now)
+
+	if _, ok := f.seen[event.ID]; ok {
+		return remoteDuplicate
+	}
+	if last, ok := f.lastSeq[event.Origin]; ok && event.Sequence <= last.sequence {
+		return remoteStale
+	}
+
+	f.seen[event.ID] = now
+	f.lastSeq[event.Origin] = originSeq{sequence: event.Sequence, seenAt: now}
+	return remoteAccepted
+}
+
+// pruneLocked forgets entries older than remoteEventTTL (at most once a minute).
+func (f *remoteEventFilter) pruneLocked(now time.Time) {
+	if now.Sub(f.lastPrune) < time.Minute {
+		return
+	}
+	f.lastPrune = now
+
+	for id, seenAt := range f.seen {
+		if now.Sub(seenAt) > remoteEventTTL {
+			delete(f.seen, id)
+		}
+	}
+	for origin, last := range f.lastSeq {
+		if now.Sub(last.seenAt) > remoteEventTTL {
+			delete(f.lastSeq, origin)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+// Package realtime provides real-time event broadcasting system for dashboard updates.
+package realtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Reconnect backoff of LISTEN connections.
+const (
+	backendMinBackoff = 1 * time.Second
+	backendMaxBackoff = 30 * time.Second
+)
+
+// postgresNotifyLimit is the maximum NOTIFY payload size (bytes) of PostgreSQL.
+const postgresNotifyLimit = 8000
+
+// ErrEventTooLarge is returned when an event does not fit into a NOTIFY payload.
+var ErrEventTooLarge = errors.New("event exceeds postgres notify payload limit")
+
+// PostgresBusBackend implements EventBusBackend with PostgreSQL LISTEN/NOTIFY.
+//
+// Publish sends pg_notify('realtime_events', <json>) through the pool; every
+// subscription holds a dedicated pool connection in LISTEN mode and is
+// re-established with exponential backoff after connection loss.
+// Events larger than 8000 bytes (e.g. alerts with very large label sets)
+// are rejected with ErrEventTooLarge and only delivered locally.
+type PostgresBusBackend struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewPostgresBusBackend creates a new LISTEN/NOTIFY backend.
+func NewPostgresBusBackend(pool *pgxpool.Pool, logger *slog.Logger) *PostgresBusBackend {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &PostgresBusBackend{
+		pool:   pool,
+		logger: logger.With("component", "event_bus_postgres"),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Publish sends the event with pg_notify.
+func (p *PostgresBusBackend) Publish(ctx context.Context, event Event) error {
+	if p.ctx.Err() != nil {
+		return ErrBackendClosed
+	}
+
+	payload, err := encodeEvent(event)
+	if err != nil {
+		return err
+	}
+	if len(payload) >= postgresNotifyLimit {
+		return fmt.Errorf("%w: %d bytes", ErrEventTooLarge, len(payload))
+	}
+	if _, err := p.pool.Exec(ctx, "SELECT pg_notify($1, $2)", realtimeEventChannel, payload); err != nil {
+		return fmt.Errorf("notify realtime event: %w", err)
+	}
+	return nil
+}
+
+// Subscribe starts listening on the realtime_events channel.
+//
+// The first LISTEN is performed synchronously so that connection errors are
+// reported to the caller.
+func (p *PostgresBusBackend) Subscribe(ctx context.Context) (<-chan Event, error) {
+	if p.ctx.Err() != nil {
+		return nil, ErrBackendClosed
+	}
+
+	conn, err := p.listen(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan Event, backendBufferSize)
+	subCtx, cancel := context.WithCancel(ctx)
+
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		defer cancel()
+		defer close(events)
+
+		// Stop with the backend as well as with the subscriber
+		go func() {
+			select {
+			case <-p.ctx.Done():
+				cancel()
+			case <-subCtx.Done():
+			}
+		}()
+
+		p.receive(subCtx, conn, events)
+	}()
+
+	return events, nil
+}
+
+// Close stops all subscriptions.
+func (p *PostgresBusBackend) Close() error {
+	p.cancel()
+	p.wg.Wait()
+	return nil
+}
+
+// listen acquires a dedicated connection and issues LISTEN.
+func (p *PostgresBusBackend) listen(ctx context.Context) (*pgxpool.Conn, error) {
+	conn, err := p.pool.Acquire(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("acquire listen connection: %w", err)
+	}
+	if _, err := conn.Exec(ctx, "LISTEN "+realtimeEventChannel); err != nil {
+		conn.Release()
+		return nil, fmt.Errorf("listen %s: %w", realtimeEventChannel, err)
+	}
+	return conn, nil
+}
+
+// receive forwards notifications until ctx is done, reconnecting on errors.
+func (p *PostgresBusBackend) receive(ctx context.Context, conn *pgxpool.Conn, events chan<- Event) {
+	backoff := backendMinBackoff
+
+	for {
+		err := p.forward(ctx, conn, events)
+		// The connection may be in LISTEN state or broken: never return it to the pool
+		_ = conn.Conn().Close(context.Background())
+		conn.Release()
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		p.logger.Warn("Realtime event bus connection lost, reconnecting",
+			"error", err,
+			"backoff", backoff)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+
+			conn, err = p.listen(ctx)
+			if err == nil {
+				break
+			}
+			backoff = min(backoff*2, backendMaxBackoff)
+			p.logger.Warn("Realtime event bus reconnect failed",
+				"error", err,
+				"backoff", backoff)
+		}
+
+		backoff = backendMinBackoff
+		p.logger.Info("Realtime event bus reconnected")
+	}
+}
+
+// forward delivers notifications of a listening connection.
+func (p *PostgresBusBackend) forward(ctx context.Context, conn *pgxpool.Conn, events chan<- Event) error {
+	for {
+		notification, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		event, err := decodeEvent(notification.Payload)
+		if err != nil {
+			p.logger.Warn("Ignoring malformed realtime event notification", "error", err)
+			continue
+		}
+
+		select {
+		case events <- event:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
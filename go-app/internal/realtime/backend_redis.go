@@ -0,0 +1,112 @@
+// Package realtime provides real-time event broadcasting system for dashboard updates.
+package realtime
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisBusBackend implements EventBusBackend with Redis pub/sub.
+//
+// go-redis re-subscribes automatically after connection loss; events
+// published meanwhile are lost for this replica.
+type RedisBusBackend struct {
+	client *redis.Client
+	logger *slog.Logger
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewRedisBusBackend creates a new Redis pub/sub backend.
+func NewRedisBusBackend(client *redis.Client, logger *slog.Logger) *RedisBusBackend {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &RedisBusBackend{
+		client: client,
+		logger: logger.With("component", "event_bus_redis"),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Publish publishes the event on the realtime_events channel.
+func (r *RedisBusBackend) Publish(ctx context.Context, event Event) error {
+	if r.ctx.Err() != nil {
+		return ErrBackendClosed
+	}
+
+	payload, err := encodeEvent(event)
+	if err != nil {
+		return err
+	}
+	if err := r.client.Publish(ctx, realtimeEventChannel, payload).Err(); err != nil {
+		return fmt.Errorf("publish realtime event: %w", err)
+	}
+	return nil
+}
+
+// Subscribe subscribes to the realtime_events channel.
+func (r *RedisBusBackend) Subscribe(ctx context.Context) (<-chan Event, error) {
+	if r.ctx.Err() != nil {
+		return nil, ErrBackendClosed
+	}
+
+	pubsub := r.client.Subscribe(ctx, realtimeEventChannel)
+	// Wait for the subscription confirmation so errors reach the caller
+	if _, err := pubsub.Receive(ctx); err != nil {
+		_ = pubsub.Close()
+		return nil, fmt.Errorf("subscribe %s: %w", realtimeEventChannel, err)
+	}
+
+	events := make(chan Event, backendBufferSize)
+	messages := pubsub.Channel(redis.WithChannelSize(backendBufferSize))
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		defer close(events)
+		defer pubsub.Close()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-r.ctx.Done():
+				return
+			case msg, ok := <-messages:
+				if !ok {
+					return
+				}
+				event, err := decodeEvent(msg.Payload)
+				if err != nil {
+					r.logger.Warn("Ignoring malformed realtime event", "error", err)
+					continue
+				}
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				case <-r.ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// Close stops all subscriptions.
+func (r *RedisBusBackend) Close() error {
+	r.cancel()
+	r.wg.Wait()
+	return nil
+}
@@ -0,0 +1,150 @@
+package realtime
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// startReplica starts a distributed bus with a subscriber.
+func startReplica(t *testing.T, backend EventBusBackend, id string) (*DefaultEventBus, *mockSubscriber) {
+	t.Helper()
+
+	bus := NewDistributedEventBus(slog.Default(), nil, backend)
+	require.NoError(t, bus.Start(context.Background()))
+	t.Cleanup(func() { bus.Stop(context.Background()) })
+
+	subscriber := newMockSubscriber(id)
+	require.NoError(t, bus.Subscribe(subscriber))
+	return bus, subscriber
+}
+
+func TestDistributedEventBus_CrossReplica(t *testing.T) {
+	backend := NewMemoryBusBackend()
+	defer backend.Close()
+
+	replicaA, subscriberA := startReplica(t, backend, "sub-a")
+	_, subscriberB := startReplica(t, backend, "sub-b")
+
+	for i := 0; i < 20; i++ {
+		event := NewEvent(EventTypeAlertCreated, map[string]interface{}{"index": i}, EventSourceAlertProcessor)
+		require.NoError(t, replicaA.Publish(*event))
+	}
+
+	require.Eventually(t, func() bool {
+		return subscriberB.GetEventCount() == 20
+	}, 2*time.Second, 10*time.Millisecond)
+
+	// Own events echoed by the backend are not delivered twice
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, 20, subscriberA.GetEventCount())
+
+	events := subscriberB.GetEvents()
+	for i, event := range events {
+		assert.Equal(t, replicaA.Origin(), event.Origin)
+		assert.Equal(t, int64(i+1), event.Sequence, "per-origin sequence order must be kept")
+	}
+}
+
+func TestDistributedEventBus_DeduplicatesRemoteEvents(t *testing.T) {
+	backend := NewMemoryBusBackend()
+	defer backend.Close()
+
+	_, subscriber := startReplica(t, backend, "sub-b")
+
+	event := Event{ID: "event-1", Type: EventTypeSilenceCreated, Origin: "replica-a", Sequence: 2, Timestamp: time.Now()}
+	require.NoError(t, backend.Publish(context.Background(), event))
+	require.NoError(t, backend.Publish(context.Background(), event))
+
+	// Older sequence of the same origin arrives late
+	stale := Event{ID: "event-0", Type: EventTypeSilenceCreated, Origin: "replica-a", Sequence: 1, Timestamp: time.Now()}
+	require.NoError(t, backend.Publish(context.Background(), stale))
+
+	next := Event{ID: "event-2", Type: EventTypeSilenceDeleted, Origin: "replica-a", Sequence: 3, Timestamp: time.Now()}
+	require.NoError(t, backend.Publish(context.Background(), next))
+
+	require.Eventually(t, func() bool {
+		return subscriber.GetEventCount() == 2
+	}, 2*time.Second, 10*time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+
+	events := subscriber.GetEvents()
+	require.Len(t, events, 2)
+	assert.Equal(t, "event-1", events[0].ID)
+	assert.Equal(t, "event-2", events[1].ID)
+}
+
+func TestRemoteEventFilter(t *testing.T) {
+	filter := newRemoteEventFilter()
+	now := time.Now()
+	filter.now = func() time.Time { return now }
+
+	assert.Equal(t, remoteAccepted, filter.accept(Event{ID: "a", Origin: "r1", Sequence: 5}))
+	assert.Equal(t, remoteDuplicate, filter.accept(Event{ID: "a", Origin: "r1", Sequence: 5}))
+	assert.Equal(t, remoteStale, filter.accept(Event{ID: "b", Origin: "r1", Sequence: 4}))
+	assert.Equal(t, remoteAccepted, filter.accept(Event{ID: "c", Origin: "r2", Sequence: 1}))
+	assert.Equal(t, remoteAccepted, filter.accept(Event{ID: "d", Origin: "r1", Sequence: 6}))
+
+	// Entries are forgotten after the TTL
+	now = now.Add(remoteEventTTL + 2*time.Minute)
+	assert.Equal(t, remoteAccepted, filter.accept(Event{ID: "a", Origin: "r1", Sequence: 1}))
+	assert.Len(t, filter.seen, 1)
+	assert.Len(t, filter.lastSeq, 1)
+}
+
+func TestRedisBusBackend_PublishSubscribe(t *testing.T) {
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	defer mr.Close()
+
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer client.Close()
+
+	replicaA := NewRedisBusBackend(client, slog.Default())
+	replicaB := NewRedisBusBackend(client, slog.Default())
+	defer replicaA.Close()
+
+	events, err := replicaB.Subscribe(context.Background())
+	require.NoError(t, err)
+
+	event := *NewEvent(EventTypeAlertCreated, map[string]interface{}{"fingerprint": "abc"}, EventSourceAlertProcessor)
+	event.Origin = "replica-a"
+	event.Sequence = 7
+	require.NoError(t, replicaA.Publish(context.Background(), event))
+
+	select {
+	case received := <-events:
+		assert.Equal(t, event.ID, received.ID)
+		assert.Equal(t, event.Origin, received.Origin)
+		assert.Equal(t, event.Sequence, received.Sequence)
+		assert.Equal(t, "abc", received.Data["fingerprint"])
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+
+	require.NoError(t, replicaB.Close())
+	_, ok := <-events
+	assert.False(t, ok, "Close must close subscriptions")
+	assert.ErrorIs(t, replicaB.Publish(context.Background(), event), ErrBackendClosed)
+}
+
+func TestDecodeEvent(t *testing.T) {
+	payload, err := encodeEvent(Event{ID: "id-1", Type: EventTypeStatsUpdated, Origin: "replica-a", Sequence: 1})
+	require.NoError(t, err)
+
+	event, err := decodeEvent(payload)
+	require.NoError(t, err)
+	assert.Equal(t, "id-1", event.ID)
+
+	_, err = decodeEvent(`{"id":"id-1"}`)
+	assert.ErrorIs(t, err, ErrInvalidEvent, "events without origin are rejected")
+
+	_, err = decodeEvent("{not json")
+	assert.Error(t, err)
+}
@@ -3,6 +3,7 @@ package realtime
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"sync"
 	"sync/atomic"
@@ -14,6 +15,9 @@ type EventBus interface {
 	// Subscribe adds a subscriber to the event bus.
 	Subscribe(subscriber EventSubscriber) error
 
+	// SubscribeFrom adds a subscriber and returns the buffered events after lastEventID.
+	SubscribeFrom(subscriber EventSubscriber, lastEventID string) ([]Event, bool, error)
+
 	// Unsubscribe removes a subscriber from the event bus.
 	Unsubscribe(subscriber EventSubscriber) error
 
@@ -30,7 +34,16 @@ type EventBus interface {
 	Stop(ctx context.Context) error
 }
 
+// backendPublishTimeout bounds a single publish to the EventBusBackend.
+const backendPublishTimeout = 5 * time.Second
+
 // DefaultEventBus is the default implementation of EventBus.
+//
+// Without a backend events are broadcast in-process only (Lite profile,
+// single replica). With an EventBusBackend (NewDistributedEventBus) local
+// events are additionally sent to the other replicas, and their events are
+// broadcast to the local subscribers, de-duplicated by event ID and in
+// Sequence order per origin.
 type DefaultEventBus struct {
 	// subscribers is a map of active subscribers
 	subscribers map[EventSubscriber]bool
@@ -44,6 +57,28 @@ type DefaultEventBus struct {
 	// sequence is a monotonically increasing sequence number for events
 	sequence int64
 
+	// publishMu keeps sequence assignment and queueing in the same order
+	publishMu sync.Mutex
+
+	// origin identifies this bus instance (replica) in distributed events
+	origin string
+
+	// backend fans events out to other replicas (nil: in-process only)
+	backend EventBusBackend
+
+	// outbound queues local events for the backend
+	outbound chan Event
+
+	// filter drops duplicate and out-of-order events of other replicas
+	filter *remoteEventFilter
+
+	// stopRemote cancels the backend subscription
+	stopRemote context.CancelFunc
+
+	// replay keeps recent events for resuming subscribers (guarded by mu
+	// together with subscribers, so an event is either replayed or delivered)
+	replay *ReplayBuffer
+
 	// logger for structured logging
 	logger *slog.Logger
 
@@ -62,13 +97,30 @@ func NewEventBus(logger *slog.Logger, metrics *RealtimeMetrics) *DefaultEventBus
 	return &DefaultEventBus{
 		subscribers: make(map[EventSubscriber]bool),
 		eventChan:   make(chan Event, 1000), // Buffered channel
-		sequence:   0,
-		logger:     logger.With("component", "event_bus"),
-		metrics:    metrics,
-		stopChan:   make(chan struct{}),
+		sequence:    0,
+		origin:      generateEventID(),
+		replay:      NewReplayBuffer(DefaultReplayBufferSize),
+		logger:      logger.With("component", "event_bus"),
+		metrics:     metrics,
+		stopChan:    make(chan struct{}),
 	}
 }
 
+// NewDistributedEventBus creates an EventBus that shares events with other
+// replicas through backend (Redis pub/sub or Postgres LISTEN/NOTIFY).
+func NewDistributedEventBus(logger *slog.Logger, metrics *RealtimeMetrics, backend EventBusBackend) *DefaultEventBus {
+	b := NewEventBus(logger, metrics)
+	b.backend = backend
+	b.outbound = make(chan Event, 1000)
+	b.filter = newRemoteEventFilter()
+	return b
+}
+
+// Origin returns the ID of this bus instance, set as Event.Origin of local events.
+func (b *DefaultEventBus) Origin() string {
+	return b.origin
+}
+
 // Subscribe adds a subscriber to the event bus.
 func (b *DefaultEventBus) Subscribe(subscriber EventSubscriber) error {
 	b.mu.Lock()
@@ -92,6 +144,48 @@ func (b *DefaultEventBus) Subscribe(subscriber EventSubscriber) error {
 	return nil
 }
 
+// SubscribeFrom adds a subscriber resuming after lastEventID (an EventCursor,
+// e.g. the SSE Last-Event-ID header).
+//
+// Returns:
+//   - []Event: buffered events broadcast after lastEventID, to be sent
+//     before any event received through Send
+//   - bool: false if lastEventID is no longer buffered (events were missed)
+//   - error: invalid lastEventID
+func (b *DefaultEventBus) SubscribeFrom(subscriber EventSubscriber, lastEventID string) ([]Event, bool, error) {
+	if lastEventID == "" {
+		return nil, true, b.Subscribe(subscriber)
+	}
+
+	origin, sequence, err := ParseEventCursor(lastEventID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	b.mu.Lock()
+	replay, complete := b.replay.Since(origin, sequence)
+	b.subscribers[subscriber] = true
+	total := len(b.subscribers)
+	b.mu.Unlock()
+
+	b.logger.Info("Subscriber resumed",
+		"subscriber_id", subscriber.ID(),
+		"last_event_id", lastEventID,
+		"replayed", len(replay),
+		"complete", complete,
+		"total_subscribers", total,
+	)
+
+	if b.metrics != nil {
+		b.metrics.ConnectionsActive.Set(float64(total))
+		if !complete {
+			b.metrics.ErrorsTotal.WithLabelValues("replay_gap").Inc()
+		}
+	}
+
+	return replay, complete, nil
+}
+
 // Unsubscribe removes a subscriber from the event bus.
 func (b *DefaultEventBus) Unsubscribe(subscriber EventSubscriber) error {
 	b.mu.Lock()
@@ -116,7 +210,12 @@ func (b *DefaultEventBus) Unsubscribe(subscriber EventSubscriber) error {
 
 // Publish broadcasts an event to all subscribers.
 func (b *DefaultEventBus) Publish(event Event) error {
-	// Set sequence number
+	// Assign sequence and queue under one lock so the queue stays in sequence order
+	b.publishMu.Lock()
+	defer b.publishMu.Unlock()
+
+	// Set origin and sequence number
+	event.Origin = b.origin
 	event.Sequence = atomic.AddInt64(&b.sequence, 1)
 
 	// Non-blocking send to event channel
@@ -150,9 +249,25 @@ func (b *DefaultEventBus) GetActiveSubscribers() int {
 
 // Start starts the event bus broadcast worker (run in goroutine).
 func (b *DefaultEventBus) Start(ctx context.Context) error {
+	if b.backend != nil {
+		remoteCtx, cancel := context.WithCancel(ctx)
+		remote, err := b.backend.Subscribe(remoteCtx)
+		if err != nil {
+			cancel()
+			return fmt.Errorf("subscribe to event bus backend: %w", err)
+		}
+		b.stopRemote = cancel
+
+		b.wg.Add(2)
+		go b.receiveWorker(ctx, remote)
+		go b.forwardWorker(ctx)
+	}
+
 	b.wg.Add(1)
 	go b.broadcastWorker(ctx)
-	b.logger.Info("Event bus started")
+	b.logger.Info("Event bus started",
+		"origin", b.origin,
+		"distributed", b.backend != nil)
 	return nil
 }
 
@@ -162,6 +277,9 @@ func (b *DefaultEventBus) Stop(ctx context.Context) error {
 
 	// Signal broadcast worker to stop
 	close(b.stopChan)
+	if b.stopRemote != nil {
+		b.stopRemote()
+	}
 
 	// Wait for broadcast worker to finish (with timeout)
 	done := make(chan struct{})
@@ -195,22 +313,132 @@ func (b *DefaultEventBus) broadcastWorker(ctx context.Context) {
 			return
 
 		case event := <-b.eventChan:
+			if b.outbound != nil && event.Origin == b.origin {
+				b.queueOutbound(event)
+			}
 			b.broadcastEvent(event)
 		}
 	}
 }
 
+// queueOutbound queues a local event for the other replicas (non-blocking).
+func (b *DefaultEventBus) queueOutbound(event Event) {
+	select {
+	case b.outbound <- event:
+	default:
+		b.logger.Warn("Outbound event queue full, event not sent to other replicas",
+			"event_type", event.Type,
+			"event_id", event.ID,
+		)
+		if b.metrics != nil {
+			b.metrics.ErrorsTotal.WithLabelValues("outbound_full").Inc()
+		}
+	}
+}
+
+// forwardWorker publishes local events to the backend in sequence order.
+func (b *DefaultEventBus) forwardWorker(ctx context.Context) {
+	defer b.wg.Done()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-b.stopChan:
+			return
+		case event := <-b.outbound:
+			publishCtx, cancel := context.WithTimeout(ctx, backendPublishTimeout)
+			err := b.backend.Publish(publishCtx, event)
+			cancel()
+			if err != nil {
+				b.logger.Warn("Failed to publish event to other replicas",
+					"event_type", event.Type,
+					"event_id", event.ID,
+					"error", err,
+				)
+				if b.metrics != nil {
+					b.metrics.ErrorsTotal.WithLabelValues("backend_publish").Inc()
+				}
+			}
+		}
+	}
+}
+
+// receiveWorker queues events of other replicas for local broadcast.
+func (b *DefaultEventBus) receiveWorker(ctx context.Context, remote <-chan Event) {
+	defer b.wg.Done()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-b.stopChan:
+			return
+		case event, ok := <-remote:
+			if !ok {
+				b.logger.Warn("Event bus backend subscription closed, receiving local events only")
+				return
+			}
+			b.deliverRemote(event)
+		}
+	}
+}
+
+// deliverRemote filters an event received from the backend and queues it
+// for broadcast, keeping its origin and sequence.
+func (b *DefaultEventBus) deliverRemote(event Event) {
+	// Own events come back from the backend: already broadcast locally
+	if event.Origin == b.origin {
+		return
+	}
+
+	result := b.filter.accept(event)
+	if result != remoteAccepted {
+		b.logger.Debug("Dropping remote event",
+			"event_id", event.ID,
+			"origin", event.Origin,
+			"sequence", event.Sequence,
+			"reason", result,
+		)
+		b.recordRemote(string(result))
+		return
+	}
+
+	select {
+	case b.eventChan <- event:
+		b.recordRemote(string(remoteAccepted))
+	default:
+		b.logger.Warn("Event channel full, dropping remote event",
+			"event_type", event.Type,
+			"event_id", event.ID,
+			"origin", event.Origin,
+		)
+		b.recordRemote("dropped")
+		if b.metrics != nil {
+			b.metrics.ErrorsTotal.WithLabelValues("channel_full").Inc()
+		}
+	}
+}
+
+// recordRemote counts a received remote event by result.
+func (b *DefaultEventBus) recordRemote(result string) {
+	if b.metrics != nil && b.metrics.RemoteEventsTotal != nil {
+		b.metrics.RemoteEventsTotal.WithLabelValues(result).Inc()
+	}
+}
+
 // broadcastEvent broadcasts an event to all subscribers concurrently.
 func (b *DefaultEventBus) broadcastEvent(event Event) {
 	start := time.Now()
 
-	// Get snapshot of subscribers
-	b.mu.RLock()
+	// Record for replay and get snapshot of subscribers atomically
+	b.mu.Lock()
+	b.replay.Add(event)
 	subscribers := make([]EventSubscriber, 0, len(b.subscribers))
 	for sub := range b.subscribers {
 		subscribers = append(subscribers, sub)
 	}
-	b.mu.RUnlock()
+	b.mu.Unlock()
 
 	if len(subscribers) == 0 {
 		b.logger.Debug("No subscribers to broadcast event",
@@ -12,4 +12,7 @@ var (
 
 	// ErrInvalidEvent is returned when an event is invalid.
 	ErrInvalidEvent = errors.New("invalid event")
+
+	// ErrBackendClosed is returned when publishing to or subscribing on a closed backend.
+	ErrBackendClosed = errors.New("event bus backend closed")
 )
@@ -25,8 +25,11 @@ type Event struct {
 	// Source is the event source (alert_processor, silence_manager, stats_collector, etc.)
 	Source string `json:"source"`
 
-	// Sequence is a sequence number for event ordering (monotonically increasing)
+	// Sequence is a sequence number for event ordering (monotonically increasing per Origin)
 	Sequence int64 `json:"sequence"`
+
+	// Origin identifies the publishing replica (event bus instance)
+	Origin string `json:"origin,omitempty"`
 }
 
 // EventType constants for dashboard events.
@@ -49,6 +52,14 @@ const (
 	// Health Events
 	EventTypeHealthChanged = "health_changed"
 
+	// Escalation Events (state: active/completed/acknowledged/resolved)
+	EventTypeEscalationStarted  = "escalation_started"
+	EventTypeEscalationNotified = "escalation_notified"
+	EventTypeEscalationStopped  = "escalation_stopped"
+
+	// Publishing Events
+	EventTypePublishingTargetsChanged = "publishing_targets_changed"
+
 	// System Events
 	EventTypeSystemNotification = "system_notification"
 )
@@ -59,6 +70,8 @@ const (
 	EventSourceSilenceManager  = "silence_manager"
 	EventSourceStatsCollector   = "stats_collector"
 	EventSourceHealthMonitor    = "health_monitor"
+	EventSourceTargetDiscovery  = "target_discovery"
+	EventSourceEscalation       = "escalation_manager"
 	EventSourceSystem           = "system"
 )
 
@@ -0,0 +1,103 @@
+// Package realtime provides real-time event broadcasting system for dashboard updates.
+package realtime
+
+// LabelMatcher matches a label set (e.g. a parsed team="db" matcher).
+type LabelMatcher interface {
+	Matches(labels map[string]string) bool
+}
+
+// EventFilter selects the events delivered to a subscriber.
+//
+// All non-empty criteria must match. Types restricts every event; the
+// label criteria (Namespaces, Severities, Matchers) only restrict events
+// that carry alert labels, so stats, health and system events still reach
+// a filtered dashboard. A nil filter matches all events.
+type EventFilter struct {
+	// Types are the accepted event types (e.g. alert_created)
+	Types []string
+
+	// Namespaces are the accepted values of the namespace label
+	Namespaces []string
+
+	// Severities are the accepted values of the severity label
+	Severities []string
+
+	// Matchers must all match the alert labels
+	Matchers []LabelMatcher
+}
+
+// Matches reports whether the event passes the filter.
+func (f *EventFilter) Matches(event Event) bool {
+	if f == nil {
+		return true
+	}
+	if len(f.Types) > 0 && !contains(f.Types, event.Type) {
+		return false
+	}
+	if len(f.Namespaces) == 0 && len(f.Severities) == 0 && len(f.Matchers) == 0 {
+		return true
+	}
+
+	labels, ok := EventLabels(event)
+	if !ok {
+		return true // Not an alert event
+	}
+	if len(f.Namespaces) > 0 && !contains(f.Namespaces, labels["namespace"]) {
+		return false
+	}
+	if len(f.Severities) > 0 && !contains(f.Severities, labels["severity"]) {
+		return false
+	}
+	for _, m := range f.Matchers {
+		if !m.Matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsEmpty reports whether the filter has no criteria.
+func (f *EventFilter) IsEmpty() bool {
+	return f == nil ||
+		len(f.Types) == 0 && len(f.Namespaces) == 0 && len(f.Severities) == 0 && len(f.Matchers) == 0
+}
+
+// EventLabels returns the alert labels of an event (Data["labels"]).
+//
+// Labels are a map[string]string for local events and a
+// map[string]interface{} for events decoded from another replica.
+// A top-level Data["severity"] fills in a missing severity label.
+func EventLabels(event Event) (map[string]string, bool) {
+	var labels map[string]string
+
+	switch raw := event.Data["labels"].(type) {
+	case map[string]string:
+		labels = make(map[string]string, len(raw)+1)
+		for k, v := range raw {
+			labels[k] = v
+		}
+	case map[string]interface{}:
+		labels = make(map[string]string, len(raw)+1)
+		for k, v := range raw {
+			if s, ok := v.(string); ok {
+				labels[k] = s
+			}
+		}
+	default:
+		return nil, false
+	}
+
+	if severity, ok := event.Data["severity"].(string); ok && labels["severity"] == "" {
+		labels["severity"] = severity
+	}
+	return labels, true
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
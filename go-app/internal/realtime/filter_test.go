@@ -0,0 +1,59 @@
+package realtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// labelEquals is a LabelMatcher for tests (name="value").
+type labelEquals struct{ name, value string }
+
+func (m labelEquals) Matches(labels map[string]string) bool {
+	return labels[m.name] == m.value
+}
+
+func TestEventFilter_Matches(t *testing.T) {
+	dbAlert := Event{Type: EventTypeAlertCreated, Data: map[string]interface{}{
+		"severity": "critical",
+		"labels":   map[string]string{"namespace": "db", "team": "dba"},
+	}}
+	// Labels decoded from another replica
+	webAlert := Event{Type: EventTypeAlertResolved, Data: map[string]interface{}{
+		"labels": map[string]interface{}{"namespace": "web", "severity": "warning", "team": "frontend"},
+	}}
+	stats := Event{Type: EventTypeStatsUpdated, Data: map[string]interface{}{"firing_alerts": 3}}
+
+	tests := []struct {
+		name   string
+		filter *EventFilter
+		want   []bool // dbAlert, webAlert, stats
+	}{
+		{"nil filter", nil, []bool{true, true, true}},
+		{"types", &EventFilter{Types: []string{EventTypeAlertCreated}}, []bool{true, false, false}},
+		{"namespace", &EventFilter{Namespaces: []string{"db"}}, []bool{true, false, true}},
+		{"severity from data", &EventFilter{Severities: []string{"critical"}}, []bool{true, false, true}},
+		{"severity from labels", &EventFilter{Severities: []string{"warning"}}, []bool{false, true, true}},
+		{"matchers", &EventFilter{Matchers: []LabelMatcher{labelEquals{"team", "frontend"}}}, []bool{false, true, true}},
+		{"combined", &EventFilter{
+			Types:      []string{EventTypeAlertCreated, EventTypeStatsUpdated},
+			Namespaces: []string{"db", "web"},
+			Matchers:   []LabelMatcher{labelEquals{"team", "dba"}},
+		}, []bool{true, false, true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i, event := range []Event{dbAlert, webAlert, stats} {
+				assert.Equal(t, tt.want[i], tt.filter.Matches(event), "event %d", i)
+			}
+		})
+	}
+}
+
+func TestEventFilter_IsEmpty(t *testing.T) {
+	var nilFilter *EventFilter
+	assert.True(t, nilFilter.IsEmpty())
+	assert.True(t, (&EventFilter{}).IsEmpty())
+	assert.False(t, (&EventFilter{Severities: []string{"critical"}}).IsEmpty())
+}
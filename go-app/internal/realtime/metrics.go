@@ -25,6 +25,9 @@ type RealtimeMetrics struct {
 
 	// BroadcastDuration is the duration of broadcast operations (histogram)
 	BroadcastDuration prometheus.Histogram
+
+	// RemoteEventsTotal is the total number of events received from other replicas (by result)
+	RemoteEventsTotal *prometheus.CounterVec
 }
 
 // NewRealtimeMetrics creates a new RealtimeMetrics instance.
@@ -73,5 +76,12 @@ func NewRealtimeMetrics(namespace string) *RealtimeMetrics {
 			Help:      "Duration of broadcast operations (seconds)",
 			Buckets:   prometheus.ExponentialBuckets(0.001, 2, 10), // 1ms to 1s
 		}),
+
+		RemoteEventsTotal: promauto.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "realtime",
+			Name:      "remote_events_total",
+			Help:      "Total number of events received from other replicas (by result: accepted, duplicate, out_of_order, dropped)",
+		}, []string{"result"}),
 	}
 }
@@ -47,6 +47,35 @@ func (p *EventPublisher) PublishAlertEvent(eventType string, alert *core.Alert)
 	return p.eventBus.Publish(*event)
 }
 
+// PublishEscalationEvent publishes an alert escalation event. receivers are
+// the receivers notified by the event (escalation_notified only).
+func (p *EventPublisher) PublishEscalationEvent(eventType string, escalation *core.AlertEscalation, receivers []string) error {
+	if p.eventBus == nil {
+		return nil // EventBus not initialized, skip
+	}
+
+	data := map[string]interface{}{
+		"fingerprint": escalation.Fingerprint,
+		"alertname":   escalation.AlertName,
+		"severity":    escalation.Labels["severity"],
+		"labels":      escalation.Labels,
+		"policy":      escalation.Policy,
+		"state":       string(escalation.State),
+		"level":       escalation.Level,
+		"started_at":  escalation.StartedAt.Format(time.RFC3339),
+	}
+
+	if escalation.NextAt != nil {
+		data["next_at"] = escalation.NextAt.Format(time.RFC3339)
+	}
+	if len(receivers) > 0 {
+		data["receivers"] = receivers
+	}
+
+	event := NewEvent(eventType, data, EventSourceEscalation)
+	return p.eventBus.Publish(*event)
+}
+
 // DashboardStats represents dashboard statistics.
 type DashboardStats struct {
 	FiringAlerts    int `json:"firing_alerts"`
@@ -92,6 +121,27 @@ func (p *EventPublisher) PublishHealthEvent(component string, status string, lat
 	return p.eventBus.Publish(*event)
 }
 
+// PublishTargetsChangedEvent publishes a publishing target change event.
+// action is one of added, updated, deleted or resynced (full relist).
+func (p *EventPublisher) PublishTargetsChangedEvent(action string, targetName string, targetType string, totalTargets int) error {
+	if p.eventBus == nil {
+		return nil // EventBus not initialized, skip
+	}
+
+	data := map[string]interface{}{
+		"action":        action,
+		"total_targets": totalTargets,
+	}
+
+	if targetName != "" {
+		data["target"] = targetName
+		data["target_type"] = targetType
+	}
+
+	event := NewEvent(EventTypePublishingTargetsChanged, data, EventSourceTargetDiscovery)
+	return p.eventBus.Publish(*event)
+}
+
 // PublishSystemNotification publishes a system notification event.
 func (p *EventPublisher) PublishSystemNotification(level string, message string) error {
 	if p.eventBus == nil {
@@ -107,3 +107,47 @@ func TestEventPublisher_NilEventBus(t *testing.T) {
 	err := publisher.PublishAlertEvent(EventTypeAlertCreated, alert)
 	assert.NoError(t, err) // Returns nil when EventBus is nil
 }
+
+func TestEventPublisher_PublishTargetsChangedEvent(t *testing.T) {
+	// Use nil metrics to avoid Prometheus registration issues in tests
+	eventBus := NewEventBus(slog.Default(), nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	err := eventBus.Start(ctx)
+	require.NoError(t, err)
+	defer eventBus.Stop(context.Background())
+
+	publisher := NewEventPublisher(eventBus, slog.Default(), nil)
+
+	err = publisher.PublishTargetsChangedEvent("added", "slack-ops", "slack", 3)
+	assert.NoError(t, err)
+}
+
+func TestEventPublisher_PublishEscalationEvent(t *testing.T) {
+	// Use nil metrics to avoid Prometheus registration issues in tests
+	eventBus := NewEventBus(slog.Default(), nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	err := eventBus.Start(ctx)
+	require.NoError(t, err)
+	defer eventBus.Stop(context.Background())
+
+	publisher := NewEventPublisher(eventBus, slog.Default(), nil)
+
+	nextAt := time.Now().Add(10 * time.Minute)
+	escalation := &core.AlertEscalation{
+		Fingerprint: "test-fingerprint",
+		AlertName:   "TestAlert",
+		Labels:      map[string]string{"severity": "critical"},
+		Policy:      "critical-oncall",
+		State:       core.EscalationActive,
+		Level:       1,
+		StartedAt:   time.Now(),
+		NextAt:      &nextAt,
+	}
+
+	err = publisher.PublishEscalationEvent(EventTypeEscalationNotified, escalation, []string{"slack-team"})
+	assert.NoError(t, err)
+}
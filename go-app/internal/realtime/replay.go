@@ -0,0 +1,112 @@
+// Package realtime provides real-time event broadcasting system for dashboard updates.
+package realtime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultReplayBufferSize is the number of recent events kept for resuming streams.
+const DefaultReplayBufferSize = 1000
+
+// EventTypeStreamReset tells a resuming client that the events it missed are
+// no longer available (Last-Event-ID evicted or unknown): it must reload its
+// state instead of relying on the replayed events.
+const EventTypeStreamReset = "stream_reset"
+
+// EventCursor returns the resume cursor of an event ("<origin>:<sequence>").
+// SSE streams send it as the event "id", clients return it as Last-Event-ID.
+func EventCursor(event Event) string {
+	if event.Origin == "" {
+		return strconv.FormatInt(event.Sequence, 10)
+	}
+	return event.Origin + ":" + strconv.FormatInt(event.Sequence, 10)
+}
+
+// ParseEventCursor parses a resume cursor created by EventCursor.
+// A bare sequence number has an empty origin (events of the local bus).
+func ParseEventCursor(cursor string) (origin string, sequence int64, err error) {
+	cursor = strings.TrimSpace(cursor)
+	seqPart := cursor
+	if i := strings.LastIndex(cursor, ":"); i >= 0 {
+		origin, seqPart = cursor[:i], cursor[i+1:]
+	}
+
+	sequence, err = strconv.ParseInt(seqPart, 10, 64)
+	if err != nil || sequence < 0 {
+		return "", 0, fmt.Errorf("invalid event cursor %q", cursor)
+	}
+	return origin, sequence, nil
+}
+
+// ReplayBuffer is a bounded ring buffer of recently broadcast events.
+//
+// Events are kept in broadcast order and looked up by origin and Sequence,
+// so a reconnecting client gets everything broadcast after the last event
+// it received. Once full, the oldest events are evicted.
+type ReplayBuffer struct {
+	mu     sync.RWMutex
+	events []Event
+	start  int // index of the oldest event
+	size   int
+}
+
+// NewReplayBuffer creates a replay buffer holding up to capacity events.
+func NewReplayBuffer(capacity int) *ReplayBuffer {
+	if capacity <= 0 {
+		capacity = DefaultReplayBufferSize
+	}
+	return &ReplayBuffer{events: make([]Event, capacity)}
+}
+
+// Add appends an event, evicting the oldest one if the buffer is full.
+func (r *ReplayBuffer) Add(event Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	capacity := len(r.events)
+	if r.size < capacity {
+		r.events[(r.start+r.size)%capacity] = event
+		r.size++
+		return
+	}
+	r.events[r.start] = event
+	r.start = (r.start + 1) % capacity
+}
+
+// Since returns the events broadcast after the event identified by origin
+// and sequence. An empty origin matches any origin.
+//
+// Returns:
+//   - []Event: events after the given one (oldest first)
+//   - bool: false if the event is not in the buffer (evicted or unknown);
+//     the returned events are then empty and the client missed events
+func (r *ReplayBuffer) Since(origin string, sequence int64) ([]Event, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	capacity := len(r.events)
+	// Search newest first: resuming clients are usually only a few events behind
+	for i := r.size - 1; i >= 0; i-- {
+		event := r.events[(r.start+i)%capacity]
+		if event.Sequence != sequence || (origin != "" && event.Origin != origin) {
+			continue
+		}
+
+		replay := make([]Event, 0, r.size-i-1)
+		for j := i + 1; j < r.size; j++ {
+			replay = append(replay, r.events[(r.start+j)%capacity])
+		}
+		return replay, true
+	}
+	return nil, false
+}
+
+// Len returns the number of buffered events.
+func (r *ReplayBuffer) Len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.size
+}
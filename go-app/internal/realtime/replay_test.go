@@ -0,0 +1,95 @@
+package realtime
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplayBuffer_SinceAndEviction(t *testing.T) {
+	buffer := NewReplayBuffer(3)
+	for seq := int64(1); seq <= 4; seq++ {
+		buffer.Add(Event{ID: "e", Origin: "replica-a", Sequence: seq})
+	}
+	assert.Equal(t, 3, buffer.Len())
+
+	replay, ok := buffer.Since("replica-a", 2)
+	require.True(t, ok)
+	require.Len(t, replay, 2)
+	assert.Equal(t, int64(3), replay[0].Sequence)
+	assert.Equal(t, int64(4), replay[1].Sequence)
+
+	replay, ok = buffer.Since("replica-a", 4)
+	assert.True(t, ok)
+	assert.Empty(t, replay, "client is up to date")
+
+	_, ok = buffer.Since("replica-a", 1)
+	assert.False(t, ok, "evicted event")
+
+	_, ok = buffer.Since("replica-b", 3)
+	assert.False(t, ok, "unknown origin")
+
+	replay, ok = buffer.Since("", 3)
+	assert.True(t, ok, "bare sequence matches any origin")
+	assert.Len(t, replay, 1)
+}
+
+func TestEventCursor(t *testing.T) {
+	cursor := EventCursor(Event{Origin: "5b14b4e4-dfb7-42db-a9ab-b6a8848f05be", Sequence: 42})
+	assert.Equal(t, "5b14b4e4-dfb7-42db-a9ab-b6a8848f05be:42", cursor)
+
+	origin, sequence, err := ParseEventCursor(cursor)
+	require.NoError(t, err)
+	assert.Equal(t, "5b14b4e4-dfb7-42db-a9ab-b6a8848f05be", origin)
+	assert.Equal(t, int64(42), sequence)
+
+	origin, sequence, err = ParseEventCursor("17")
+	require.NoError(t, err)
+	assert.Empty(t, origin)
+	assert.Equal(t, int64(17), sequence)
+
+	for _, invalid := range []string{"", "abc", "origin:", "origin:-1", "origin:x"} {
+		_, _, err := ParseEventCursor(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestDefaultEventBus_SubscribeFrom(t *testing.T) {
+	bus := NewEventBus(slog.Default(), nil)
+	require.NoError(t, bus.Start(context.Background()))
+	defer bus.Stop(context.Background())
+
+	first := newMockSubscriber("first")
+	require.NoError(t, bus.Subscribe(first))
+	for i := 0; i < 3; i++ {
+		require.NoError(t, bus.Publish(*NewEvent("test_event", nil, "test_source")))
+	}
+	require.Eventually(t, func() bool { return first.GetEventCount() == 3 }, time.Second, 5*time.Millisecond)
+
+	// Reconnect after the first event
+	resumed := newMockSubscriber("resumed")
+	replay, complete, err := bus.SubscribeFrom(resumed, EventCursor(first.GetEvents()[0]))
+	require.NoError(t, err)
+	assert.True(t, complete)
+	require.Len(t, replay, 2)
+	assert.Equal(t, int64(2), replay[0].Sequence)
+	assert.Equal(t, int64(3), replay[1].Sequence)
+
+	// Live events follow without duplicates
+	require.NoError(t, bus.Publish(*NewEvent("test_event", nil, "test_source")))
+	require.Eventually(t, func() bool { return resumed.GetEventCount() == 1 }, time.Second, 5*time.Millisecond)
+	assert.Equal(t, int64(4), resumed.GetEvents()[0].Sequence)
+
+	// Unknown cursor: events were missed
+	replay, complete, err = bus.SubscribeFrom(newMockSubscriber("stale"), "other-origin:1")
+	require.NoError(t, err)
+	assert.False(t, complete)
+	assert.Empty(t, replay)
+
+	_, _, err = bus.SubscribeFrom(newMockSubscriber("invalid"), "not-a-cursor")
+	assert.Error(t, err)
+}
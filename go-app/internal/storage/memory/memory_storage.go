@@ -25,6 +25,7 @@ package memory
 import (
 	"context"
 	"log/slog"
+	"slices"
 	"sync"
 
 	"github.com/vitaliisemenov/alert-history/internal/core"
@@ -103,6 +104,8 @@ func (m *MemoryStorage) SaveAlert(ctx context.Context, alert *core.Alert) error
 			alertCopy.Annotations[k] = v
 		}
 	}
+	alertCopy.SilencedBy = slices.Clone(alert.SilencedBy)
+	alertCopy.InhibitedBy = slices.Clone(alert.InhibitedBy)
 
 	m.alerts[alert.Fingerprint] = &alertCopy
 
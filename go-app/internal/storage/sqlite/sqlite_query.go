@@ -114,7 +114,7 @@ func (s *SQLiteStorage) buildListQuery(filters *core.AlertFilters) (string, []in
 	query := `
 SELECT fingerprint, status, severity, namespace, alert_name,
        labels, annotations, starts_at, ends_at, generator_url,
-       created_at, updated_at
+       silenced_by, inhibited_by, created_at, updated_at
 FROM alerts
 WHERE 1=1
 `
@@ -176,11 +176,11 @@ func (s *SQLiteStorage) applyFilters(query string, args []interface{}, filters *
 	}
 
 	// Filter by labels (map field)
-	// Simple implementation: match ALL labels (AND logic)
-	// Uses SQL LIKE for simple matching (more efficient than JSON extraction)
+	// Match ALL labels (AND logic) via JSON1 json_extract().
+	// LIKE on the raw JSON text matched substrings and depended on encoding.
 	for key, value := range filters.Labels {
-		query += " AND labels LIKE ?"
-		args = append(args, "%\""+key+"\":\""+value+"\"%")
+		query += " AND json_extract(labels, ?) = ?"
+		args = append(args, labelJSONPath(key), value)
 	}
 
 	// Filter by time range (pointer field)
@@ -222,6 +222,7 @@ func (s *SQLiteStorage) scanAlert(rows *sql.Rows) (*core.Alert, error) {
 	var startsAt, createdAt, updatedAt int64
 	var endsAtMs sql.NullInt64
 	var generatorURL sql.NullString
+	var silencedBy, inhibitedBy string
 
 	// Scan all columns
 	if err := rows.Scan(
@@ -235,6 +236,8 @@ func (s *SQLiteStorage) scanAlert(rows *sql.Rows) (*core.Alert, error) {
 		&startsAt,
 		&endsAtMs,
 		&generatorURL,
+		&silencedBy,
+		&inhibitedBy,
 		&createdAt,
 		&updatedAt,
 	); err != nil {
@@ -250,6 +253,14 @@ func (s *SQLiteStorage) scanAlert(rows *sql.Rows) (*core.Alert, error) {
 		return nil, fmt.Errorf("failed to unmarshal annotations: %w", err)
 	}
 
+	var err error
+	if alert.SilencedBy, err = unmarshalIDs(silencedBy); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal silenced_by: %w", err)
+	}
+	if alert.InhibitedBy, err = unmarshalIDs(inhibitedBy); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal inhibited_by: %w", err)
+	}
+
 	// Set severity and namespace in labels (where they're stored)
 	if alert.Labels == nil {
 		alert.Labels = make(map[string]string)
@@ -276,3 +287,9 @@ func (s *SQLiteStorage) scanAlert(rows *sql.Rows) (*core.Alert, error) {
 
 	return &alert, nil
 }
+
+// labelJSONPath returns the JSON1 path of a label key.
+// The key is quoted so that dots and dashes are not treated as path syntax.
+func labelJSONPath(key string) string {
+	return `$."` + key + `"`
+}
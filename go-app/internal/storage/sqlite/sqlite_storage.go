@@ -182,6 +182,8 @@ CREATE TABLE IF NOT EXISTS alerts (
     starts_at INTEGER NOT NULL,  -- Unix timestamp in milliseconds
     ends_at INTEGER,              -- Unix timestamp in milliseconds (nullable)
     generator_url TEXT,          -- Source URL (nullable)
+    silenced_by TEXT NOT NULL DEFAULT '[]',  -- JSON array of silence IDs
+    inhibited_by TEXT NOT NULL DEFAULT '[]', -- JSON array of inhibiting alert fingerprints
     created_at INTEGER NOT NULL DEFAULT (strftime('%s', 'now') * 1000),
     updated_at INTEGER NOT NULL DEFAULT (strftime('%s', 'now') * 1000)
 );
@@ -200,6 +202,13 @@ CREATE INDEX IF NOT EXISTS idx_alerts_starts_at ON alerts(starts_at);
 		return fmt.Errorf("failed to initialize schema: %w", err)
 	}
 
+	// Suppression columns were added after the initial schema
+	for _, column := range []string{"silenced_by", "inhibited_by"} {
+		if err := s.addColumnIfMissing(ctx, "alerts", column, "TEXT NOT NULL DEFAULT '[]'"); err != nil {
+			return fmt.Errorf("failed to initialize schema: %w", err)
+		}
+	}
+
 	s.logger.Debug("SQLite schema initialized",
 		"tables", 1,
 		"indexes", 6,
@@ -208,6 +217,60 @@ CREATE INDEX IF NOT EXISTS idx_alerts_starts_at ON alerts(starts_at);
 	return nil
 }
 
+// addColumnIfMissing adds a column to a table of an existing database.
+func (s *SQLiteStorage) addColumnIfMissing(ctx context.Context, table, column, definition string) error {
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf("PRAGMA table_info(%s)", table))
+	if err != nil {
+		return fmt.Errorf("failed to read %s columns: %w", table, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var cid, notNull, pk int
+		var name, columnType string
+		var defaultValue sql.NullString
+		if err := rows.Scan(&cid, &name, &columnType, &notNull, &defaultValue, &pk); err != nil {
+			return fmt.Errorf("failed to scan %s columns: %w", table, err)
+		}
+		if name == column {
+			return nil
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read %s columns: %w", table, err)
+	}
+	rows.Close() // Release the connection before ALTER TABLE
+
+	if _, err := s.db.ExecContext(ctx, fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, definition)); err != nil {
+		return fmt.Errorf("failed to add column %s.%s: %w", table, column, err)
+	}
+	return nil
+}
+
+// marshalIDs serializes silence IDs or fingerprints as a JSON array ("[]" if empty).
+func marshalIDs(ids []string) (string, error) {
+	if len(ids) == 0 {
+		return "[]", nil
+	}
+	data, err := json.Marshal(ids)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// unmarshalIDs deserializes a JSON array written by marshalIDs (nil if empty).
+func unmarshalIDs(data string) ([]string, error) {
+	var ids []string
+	if err := json.Unmarshal([]byte(data), &ids); err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return ids, nil
+}
+
 // SaveAlert implements core.AlertStorage.SaveAlert.
 // Uses UPSERT logic (INSERT ... ON CONFLICT DO UPDATE) for idempotency.
 // If fingerprint already exists, updates status, severity, timestamps.
@@ -231,6 +294,15 @@ func (s *SQLiteStorage) SaveAlert(ctx context.Context, alert *core.Alert) error
 		return fmt.Errorf("failed to marshal annotations: %w", err)
 	}
 
+	silencedBy, err := marshalIDs(alert.SilencedBy)
+	if err != nil {
+		return fmt.Errorf("failed to marshal silenced_by: %w", err)
+	}
+	inhibitedBy, err := marshalIDs(alert.InhibitedBy)
+	if err != nil {
+		return fmt.Errorf("failed to marshal inhibited_by: %w", err)
+	}
+
 	// Convert timestamps to Unix milliseconds
 	startsAt := alert.StartsAt.UnixMilli()
 	var endsAt *int64
@@ -255,14 +327,17 @@ func (s *SQLiteStorage) SaveAlert(ctx context.Context, alert *core.Alert) error
 	query := `
 INSERT INTO alerts (
     fingerprint, status, severity, namespace, alert_name,
-    labels, annotations, starts_at, ends_at, generator_url
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    labels, annotations, starts_at, ends_at, generator_url,
+    silenced_by, inhibited_by
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT(fingerprint) DO UPDATE SET
     status = excluded.status,
     severity = excluded.severity,
     labels = excluded.labels,
     annotations = excluded.annotations,
     ends_at = excluded.ends_at,
+    silenced_by = excluded.silenced_by,
+    inhibited_by = excluded.inhibited_by,
     updated_at = strftime('%s', 'now') * 1000
 `
 
@@ -277,6 +352,8 @@ ON CONFLICT(fingerprint) DO UPDATE SET
 		startsAt,
 		endsAt,
 		alert.GeneratorURL,
+		silencedBy,
+		inhibitedBy,
 	)
 
 	if err != nil {
@@ -309,7 +386,7 @@ func (s *SQLiteStorage) GetAlertByFingerprint(ctx context.Context, fingerprint s
 	query := `
 SELECT fingerprint, status, severity, namespace, alert_name,
        labels, annotations, starts_at, ends_at, generator_url,
-       created_at, updated_at
+       silenced_by, inhibited_by, created_at, updated_at
 FROM alerts
 WHERE fingerprint = ?
 `
@@ -320,6 +397,7 @@ WHERE fingerprint = ?
 	var startsAt, createdAt, updatedAt int64
 	var endsAtMs sql.NullInt64
 	var generatorURL sql.NullString
+	var silencedBy, inhibitedBy string
 
 	err := s.db.QueryRowContext(ctx, query, fingerprint).Scan(
 		&alert.Fingerprint,
@@ -332,6 +410,8 @@ WHERE fingerprint = ?
 		&startsAt,
 		&endsAtMs,
 		&generatorURL,
+		&silencedBy,
+		&inhibitedBy,
 		&createdAt,
 		&updatedAt,
 	)
@@ -355,6 +435,13 @@ WHERE fingerprint = ?
 		return nil, fmt.Errorf("failed to unmarshal annotations: %w", err)
 	}
 
+	if alert.SilencedBy, err = unmarshalIDs(silencedBy); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal silenced_by: %w", err)
+	}
+	if alert.InhibitedBy, err = unmarshalIDs(inhibitedBy); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal inhibited_by: %w", err)
+	}
+
 	// Set severity and namespace in labels (where they're stored)
 	if alert.Labels == nil {
 		alert.Labels = make(map[string]string)
@@ -587,3 +674,9 @@ func (s *SQLiteStorage) GetFileSize() int64 {
 func (s *SQLiteStorage) GetPath() string {
 	return s.path
 }
+
+// DB returns the underlying database connection (shared with components
+// that keep their own tables in the same file, e.g. the notification log).
+func (s *SQLiteStorage) DB() *sql.DB {
+	return s.db
+}
@@ -2,6 +2,7 @@ package sqlite_test
 
 import (
 	"context"
+	"database/sql"
 	"log/slog"
 	"os"
 	"testing"
@@ -127,6 +128,82 @@ func TestUpdateAlert(t *testing.T) {
 	assert.Equal(t, "warning", *severity)
 }
 
+// TestUpdateAlert_Suppression tests that silenced/inhibited state is stored and cleared.
+func TestUpdateAlert_Suppression(t *testing.T) {
+	storage := newTestStorage(t)
+	ctx := context.Background()
+
+	alert := newTestAlert("test-fp-suppressed")
+	require.NoError(t, storage.SaveAlert(ctx, alert))
+
+	alert.SilencedBy = []string{"silence-1", "silence-2"}
+	alert.InhibitedBy = []string{"source-fp"}
+	require.NoError(t, storage.UpdateAlert(ctx, alert))
+
+	retrieved, err := storage.GetAlertByFingerprint(ctx, "test-fp-suppressed")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"silence-1", "silence-2"}, retrieved.SilencedBy)
+	assert.Equal(t, []string{"source-fp"}, retrieved.InhibitedBy)
+
+	list, err := storage.ListAlerts(ctx, &core.AlertFilters{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, list.Alerts, 1)
+	assert.Equal(t, []string{"source-fp"}, list.Alerts[0].InhibitedBy)
+
+	alert.SilencedBy, alert.InhibitedBy = nil, nil
+	require.NoError(t, storage.UpdateAlert(ctx, alert))
+
+	retrieved, err = storage.GetAlertByFingerprint(ctx, "test-fp-suppressed")
+	require.NoError(t, err)
+	assert.Nil(t, retrieved.SilencedBy)
+	assert.Nil(t, retrieved.InhibitedBy)
+}
+
+// TestNewSQLiteStorage_AddsSuppressionColumns tests the upgrade of a database
+// created before the suppression columns.
+func TestNewSQLiteStorage_AddsSuppressionColumns(t *testing.T) {
+	ctx := context.Background()
+	dbPath := t.TempDir() + "/old.db"
+
+	db, err := sql.Open("sqlite", dbPath)
+	require.NoError(t, err)
+	_, err = db.ExecContext(ctx, `
+CREATE TABLE alerts (
+    fingerprint TEXT PRIMARY KEY,
+    status TEXT NOT NULL,
+    severity TEXT NOT NULL,
+    namespace TEXT NOT NULL,
+    alert_name TEXT NOT NULL,
+    labels TEXT NOT NULL,
+    annotations TEXT NOT NULL,
+    starts_at INTEGER NOT NULL,
+    ends_at INTEGER,
+    generator_url TEXT,
+    created_at INTEGER NOT NULL DEFAULT (strftime('%s', 'now') * 1000),
+    updated_at INTEGER NOT NULL DEFAULT (strftime('%s', 'now') * 1000)
+);
+INSERT INTO alerts (fingerprint, status, severity, namespace, alert_name, labels, annotations, starts_at)
+VALUES ('old-fp', 'firing', 'critical', 'default', 'OldAlert', '{}', '{}', 0);`)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+	storage, err := sqlite.NewSQLiteStorage(ctx, dbPath, logger)
+	require.NoError(t, err)
+	defer storage.Close()
+
+	retrieved, err := storage.GetAlertByFingerprint(ctx, "old-fp")
+	require.NoError(t, err)
+	assert.Nil(t, retrieved.SilencedBy)
+
+	alert := newTestAlert("old-fp")
+	alert.SilencedBy = []string{"silence-1"}
+	require.NoError(t, storage.UpdateAlert(ctx, alert))
+	retrieved, err = storage.GetAlertByFingerprint(ctx, "old-fp")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"silence-1"}, retrieved.SilencedBy)
+}
+
 // TestUpdateAlert_NotFound tests UpdateAlert with non-existent fingerprint.
 func TestUpdateAlert_NotFound(t *testing.T) {
 	storage := newTestStorage(t)
@@ -0,0 +1,41 @@
+package ui
+
+import (
+	"time"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+)
+
+// AckDisplayData represents acknowledgement and ownership state formatted
+// for template display.
+type AckDisplayData struct {
+	Acknowledged bool       `json:"acknowledged"` // Acknowledgement in effect
+	AckedBy      string     `json:"acked_by,omitempty"`
+	AckedAt      *time.Time `json:"acked_at,omitempty"`
+	ExpiresAt    *time.Time `json:"expires_at,omitempty"`
+	Owner        string     `json:"owner,omitempty"`
+}
+
+// ToAckDisplayData converts an acknowledgement to display data at now.
+// Returns nil when there is nothing to display (no active ack, no owner).
+func ToAckDisplayData(ack *core.AlertAck, now time.Time) *AckDisplayData {
+	if ack == nil {
+		return nil
+	}
+
+	active := ack.IsActive(now)
+	if !active && ack.Owner == "" {
+		return nil
+	}
+
+	data := &AckDisplayData{
+		Acknowledged: active,
+		Owner:        ack.Owner,
+	}
+	if active {
+		data.AckedBy = ack.AckedBy
+		data.AckedAt = ack.AckedAt
+		data.ExpiresAt = ack.ExpiresAt
+	}
+	return data
+}
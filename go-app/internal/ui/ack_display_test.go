@@ -0,0 +1,35 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/vitaliisemenov/alert-history/internal/core"
+)
+
+func TestToAckDisplayData(t *testing.T) {
+	now := time.Now()
+	ackedAt := now.Add(-time.Hour)
+	expired := now.Add(-time.Minute)
+
+	if got := ToAckDisplayData(nil, now); got != nil {
+		t.Errorf("Expected nil for nil ack, got %+v", got)
+	}
+
+	active := &core.AlertAck{Acknowledged: true, AckedBy: "alice", AckedAt: &ackedAt, Owner: "bob"}
+	got := ToAckDisplayData(active, now)
+	if got == nil || !got.Acknowledged || got.AckedBy != "alice" || got.Owner != "bob" {
+		t.Errorf("Unexpected display data for active ack: %+v", got)
+	}
+
+	// Expired acknowledgement without owner: nothing to display
+	if got := ToAckDisplayData(&core.AlertAck{Acknowledged: true, AckedBy: "alice", ExpiresAt: &expired}, now); got != nil {
+		t.Errorf("Expected nil for expired ack, got %+v", got)
+	}
+
+	// Expired acknowledgement with owner: owner only
+	got = ToAckDisplayData(&core.AlertAck{Acknowledged: true, AckedBy: "alice", ExpiresAt: &expired, Owner: "bob"}, now)
+	if got == nil || got.Acknowledged || got.AckedBy != "" || got.Owner != "bob" {
+		t.Errorf("Unexpected display data for expired ack with owner: %+v", got)
+	}
+}
@@ -32,6 +32,9 @@ type AlertCardData struct {
 
 	// Classification fields (optional)
 	Classification *ClassificationDisplayData
+
+	// Acknowledgement and owner (optional)
+	Ack *AckDisplayData
 }
 
 // ToAlertCardData converts EnrichedAlert to AlertCardData for template rendering.
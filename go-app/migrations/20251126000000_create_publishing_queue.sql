@@ -0,0 +1,47 @@
+-- Create publishing_queue table for durable publishing jobs
+-- Migration: 20251126000000_create_publishing_queue
+-- Description: Durable backend for PublishingQueue (lease-based, at-least-once delivery)
+-- Workers lease rows with FOR UPDATE SKIP LOCKED; rows are deleted on ack.
+
+-- +goose Up
+CREATE TABLE IF NOT EXISTS publishing_queue (
+    -- Primary key (PublishingJob.ID)
+    id UUID PRIMARY KEY,
+
+    -- Job routing
+    priority SMALLINT NOT NULL DEFAULT 1, -- 0=high, 1=medium, 2=low
+    fingerprint VARCHAR(255) NOT NULL,
+    target_name VARCHAR(255) NOT NULL,
+
+    -- Job payload (JSONB snapshots at submit time)
+    enriched_alert JSONB NOT NULL,
+    target_config JSONB NOT NULL,
+
+    -- Leasing
+    visible_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    lease_token UUID,
+    lease_owner VARCHAR(255),
+    deliveries INTEGER NOT NULL DEFAULT 0,
+
+    -- Timestamps
+    submitted_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_queue_priority CHECK (priority IN (0, 1, 2))
+);
+
+-- Dequeue order: visible jobs by priority, oldest first
+CREATE INDEX IF NOT EXISTS idx_publishing_queue_dequeue ON publishing_queue(priority, submitted_at, visible_at);
+CREATE INDEX IF NOT EXISTS idx_publishing_queue_lease_owner ON publishing_queue(lease_owner) WHERE lease_owner IS NOT NULL;
+
+-- Comment
+COMMENT ON TABLE publishing_queue IS 'Durable publishing queue jobs (PostgresQueueBackend)';
+COMMENT ON COLUMN publishing_queue.visible_at IS 'Job can be leased when visible_at <= NOW(); leasing pushes it by the visibility timeout';
+COMMENT ON COLUMN publishing_queue.lease_token IS 'Token of the current lease; ack/extend require a matching token';
+COMMENT ON COLUMN publishing_queue.lease_owner IS 'Instance holding the current lease (released by startup recovery)';
+COMMENT ON COLUMN publishing_queue.deliveries IS 'Number of times the job was leased (redeliveries after lease expiry)';
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_publishing_queue_lease_owner;
+DROP INDEX IF EXISTS idx_publishing_queue_dequeue;
+DROP TABLE IF EXISTS publishing_queue;
@@ -0,0 +1,37 @@
+-- Create notification_log table for exactly-once group notifications
+-- Migration: 20251127000000_create_notification_log
+-- Description: Alertmanager-compatible notification log (PostgresLog)
+-- One row per (group key, receiver, integration index): the firing/resolved
+-- fingerprint hashes of the last notification and when it was sent.
+
+-- +goose Up
+CREATE TABLE IF NOT EXISTS notification_log (
+    -- Key
+    group_key TEXT NOT NULL,
+    receiver VARCHAR(255) NOT NULL,
+    integration VARCHAR(64) NOT NULL,
+    integration_index INTEGER NOT NULL DEFAULT 0,
+
+    -- Last notification (FNV-1a fingerprint hashes, sorted)
+    firing_alerts BIGINT[] NOT NULL DEFAULT '{}',
+    resolved_alerts BIGINT[] NOT NULL DEFAULT '{}',
+
+    -- Timestamps
+    notified_at TIMESTAMPTZ NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+
+    PRIMARY KEY (group_key, receiver, integration, integration_index)
+);
+
+-- GC of expired entries
+CREATE INDEX IF NOT EXISTS idx_notification_log_expires_at ON notification_log(expires_at);
+
+-- Comment
+COMMENT ON TABLE notification_log IS 'Notification log: last notification per alert group and receiver integration (PostgresLog)';
+COMMENT ON COLUMN notification_log.firing_alerts IS 'Fingerprint hashes notified as firing (uint64 stored as BIGINT)';
+COMMENT ON COLUMN notification_log.resolved_alerts IS 'Fingerprint hashes notified as resolved (uint64 stored as BIGINT)';
+COMMENT ON COLUMN notification_log.expires_at IS 'Entry is garbage collected after expires_at (retention)';
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_notification_log_expires_at;
+DROP TABLE IF EXISTS notification_log;
@@ -0,0 +1,71 @@
+-- Create recurring silence tables
+-- Migration: 20251128000000_create_recurring_silences
+-- Description: Recurring silence series (cron expression or RRULE) and the
+-- occurrences materialised as concrete silences. The occurrence primary key
+-- makes materialisation idempotent across replicas.
+
+-- +goose Up
+CREATE TABLE IF NOT EXISTS recurring_silences (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    -- Copied to every materialised silence
+    created_by VARCHAR(255) NOT NULL,
+    comment TEXT NOT NULL,
+    matchers JSONB NOT NULL,
+
+    -- Schedule: 5-field cron expression or RRULE, evaluated in timezone
+    schedule TEXT NOT NULL,
+    timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    duration_seconds BIGINT NOT NULL,
+
+    -- Series bounds
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ,
+    cancelled_at TIMESTAMPTZ,
+
+    -- Audit timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ,
+
+    CONSTRAINT recurring_silences_valid_comment CHECK (
+        length(comment) >= 3 AND length(comment) <= 1024
+    ),
+    CONSTRAINT recurring_silences_valid_duration CHECK (
+        duration_seconds >= 60 AND duration_seconds <= 2592000
+    ),
+    CONSTRAINT recurring_silences_valid_range CHECK (
+        ends_at IS NULL OR ends_at > starts_at
+    )
+);
+
+CREATE TABLE IF NOT EXISTS recurring_silence_occurrences (
+    series_id UUID NOT NULL REFERENCES recurring_silences(id) ON DELETE CASCADE,
+    starts_at TIMESTAMPTZ NOT NULL,
+
+    -- Materialised silence (NULL while being created)
+    silence_id UUID,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (series_id, starts_at)
+);
+
+-- Materialiser: active series
+CREATE INDEX IF NOT EXISTS idx_recurring_silences_active
+    ON recurring_silences(created_at)
+    WHERE cancelled_at IS NULL;
+
+-- Pruning of old occurrences
+CREATE INDEX IF NOT EXISTS idx_recurring_silence_occurrences_starts_at
+    ON recurring_silence_occurrences(starts_at);
+
+COMMENT ON TABLE recurring_silences IS 'Recurring silence series materialised ahead of time into silences';
+COMMENT ON COLUMN recurring_silences.schedule IS '5-field cron expression or RRULE (FREQ=DAILY|WEEKLY|MONTHLY)';
+COMMENT ON COLUMN recurring_silences.duration_seconds IS 'Length of every silence window';
+COMMENT ON TABLE recurring_silence_occurrences IS 'Materialised occurrences of recurring silences (one row per window)';
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_recurring_silence_occurrences_starts_at;
+DROP INDEX IF EXISTS idx_recurring_silences_active;
+DROP TABLE IF EXISTS recurring_silence_occurrences;
+DROP TABLE IF EXISTS recurring_silences;
@@ -0,0 +1,61 @@
+-- Create alert acknowledgement tables
+-- Migration: 20251129000000_create_alert_acks
+-- Description: Acknowledgement and ownership state of alerts (fingerprint) and
+-- notification groups (group key), with an append-only audit trail of
+-- ack/unack/assign/comment actions.
+
+-- +goose Up
+CREATE TABLE IF NOT EXISTS alert_acks (
+    -- Target: 'alert' (fingerprint) or 'group' (group key)
+    target_type VARCHAR(16) NOT NULL,
+    target TEXT NOT NULL,
+
+    -- Acknowledgement
+    acknowledged BOOLEAN NOT NULL DEFAULT FALSE,
+    acked_by VARCHAR(255),
+    acked_at TIMESTAMPTZ,
+    expires_at TIMESTAMPTZ,
+
+    -- Ownership
+    owner VARCHAR(255),
+    assigned_by VARCHAR(255),
+    assigned_at TIMESTAMPTZ,
+
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (target_type, target),
+    CONSTRAINT alert_acks_valid_target_type CHECK (target_type IN ('alert', 'group'))
+);
+
+CREATE TABLE IF NOT EXISTS alert_ack_events (
+    id BIGSERIAL PRIMARY KEY,
+    target_type VARCHAR(16) NOT NULL,
+    target TEXT NOT NULL,
+    action VARCHAR(16) NOT NULL,
+    actor VARCHAR(255) NOT NULL,
+    comment TEXT,
+    owner VARCHAR(255),
+    expires_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT alert_ack_events_valid_action CHECK (action IN ('ack', 'unack', 'assign', 'comment'))
+);
+
+-- Dispatcher / dashboard: acknowledgements in effect
+CREATE INDEX IF NOT EXISTS idx_alert_acks_active
+    ON alert_acks(expires_at)
+    WHERE acknowledged;
+
+-- Audit trail per target, newest first
+CREATE INDEX IF NOT EXISTS idx_alert_ack_events_target
+    ON alert_ack_events(target_type, target, created_at DESC);
+
+COMMENT ON TABLE alert_acks IS 'Current acknowledgement and ownership state per alert fingerprint or group key';
+COMMENT ON COLUMN alert_acks.expires_at IS 'Acknowledgement expiry (NULL: until unacknowledged); repeat notifications resume afterwards';
+COMMENT ON TABLE alert_ack_events IS 'Audit trail of ack/unack/assign/comment actions';
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_alert_ack_events_target;
+DROP INDEX IF EXISTS idx_alert_acks_active;
+DROP TABLE IF EXISTS alert_ack_events;
+DROP TABLE IF EXISTS alert_acks;
@@ -0,0 +1,37 @@
+-- Create alert escalation table
+-- Migration: 20251130000000_create_alert_escalations
+-- Description: Escalation state of firing alerts under an escalation policy
+-- (levels of receivers notified after a delay), persisted so pending levels
+-- are restored after a restart.
+
+-- +goose Up
+CREATE TABLE IF NOT EXISTS alert_escalations (
+    fingerprint TEXT PRIMARY KEY,
+    alert_name VARCHAR(255) NOT NULL,
+    labels JSONB NOT NULL DEFAULT '{}',
+    policy VARCHAR(255) NOT NULL,
+
+    state VARCHAR(16) NOT NULL,
+    level INTEGER NOT NULL DEFAULT 0,
+    started_at TIMESTAMPTZ NOT NULL,
+    next_at TIMESTAMPTZ,
+    last_notified_at TIMESTAMPTZ,
+    stopped_at TIMESTAMPTZ,
+
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT alert_escalations_valid_state CHECK (state IN ('active', 'completed', 'acknowledged', 'resolved')),
+    CONSTRAINT alert_escalations_valid_level CHECK (level >= 0)
+);
+
+-- Restore on startup / active escalation listing
+CREATE INDEX IF NOT EXISTS idx_alert_escalations_state
+    ON alert_escalations(state, started_at);
+
+COMMENT ON TABLE alert_escalations IS 'Escalation state per alert fingerprint (escalation policies)';
+COMMENT ON COLUMN alert_escalations.level IS 'Number of policy levels notified so far (index of the next level)';
+COMMENT ON COLUMN alert_escalations.next_at IS 'When the next level is due (NULL once no longer active)';
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_alert_escalations_state;
+DROP TABLE IF EXISTS alert_escalations;
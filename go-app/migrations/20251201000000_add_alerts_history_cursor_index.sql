@@ -0,0 +1,13 @@
+-- Add keyset pagination index for alert history
+-- Migration: 20251201000000_add_alerts_history_cursor_index
+-- Description: Cursor pages of GET /history and GET /history/export read
+-- alerts in (starts_at DESC, fingerprint DESC) order and continue with
+-- WHERE (starts_at, fingerprint) < (cursor), which this index serves
+-- without an OFFSET scan however deep the page is.
+
+-- +goose Up
+CREATE INDEX IF NOT EXISTS idx_alerts_starts_at_fingerprint
+    ON alerts(starts_at DESC, fingerprint DESC);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_alerts_starts_at_fingerprint;
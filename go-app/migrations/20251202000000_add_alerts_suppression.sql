@@ -0,0 +1,15 @@
+-- Add suppression state to alerts
+-- Migration: 20251202000000_add_alerts_suppression
+-- Description: Silenced and inhibited alerts are not published; the silences
+-- (IDs) and inhibiting alerts (fingerprints) that suppressed them are kept
+-- on the alert history record.
+
+-- +goose Up
+ALTER TABLE alerts
+    ADD COLUMN IF NOT EXISTS silenced_by JSONB NOT NULL DEFAULT '[]',
+    ADD COLUMN IF NOT EXISTS inhibited_by JSONB NOT NULL DEFAULT '[]';
+
+-- +goose Down
+ALTER TABLE alerts
+    DROP COLUMN IF EXISTS inhibited_by,
+    DROP COLUMN IF EXISTS silenced_by;
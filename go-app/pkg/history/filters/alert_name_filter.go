@@ -3,6 +3,7 @@ package filters
 import (
 	"fmt"
 
+	"github.com/vitaliisemenov/alert-history/internal/core"
 	"github.com/vitaliisemenov/alert-history/pkg/history/query"
 )
 
@@ -49,6 +50,10 @@ func (f *AlertNameFilter) ApplyToQuery(qb *query.Builder) error {
 	return nil
 }
 
+func (f *AlertNameFilter) Matches(alert *core.Alert) bool {
+	return alert.AlertName == f.value
+}
+
 func (f *AlertNameFilter) CacheKey() string {
 	return fmt.Sprintf("alert_name:%s", f.value)
 }
@@ -2,13 +2,17 @@ package filters
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 
+	"github.com/vitaliisemenov/alert-history/internal/core"
 	"github.com/vitaliisemenov/alert-history/pkg/history/query"
 )
 
 // AlertNamePatternFilter filters alerts by alert name pattern (LIKE)
 type AlertNamePatternFilter struct {
 	pattern string
+	like    *regexp.Regexp // pattern as a regex, for Matches
 }
 
 // NewAlertNamePatternFilter creates a new alert name pattern filter
@@ -26,7 +30,7 @@ func NewAlertNamePatternFilter(params map[string]interface{}) (Filter, error) {
 		return nil, fmt.Errorf("alert_name_pattern too long: max 255 characters")
 	}
 
-	return &AlertNamePatternFilter{pattern: pattern}, nil
+	return &AlertNamePatternFilter{pattern: pattern, like: likeRegexp(pattern, false)}, nil
 }
 
 func (f *AlertNamePatternFilter) Type() FilterType {
@@ -50,6 +54,36 @@ func (f *AlertNamePatternFilter) ApplyToQuery(qb *query.Builder) error {
 	return nil
 }
 
+func (f *AlertNamePatternFilter) Matches(alert *core.Alert) bool {
+	like := f.like
+	if like == nil {
+		like = likeRegexp(f.pattern, false)
+	}
+	return like.MatchString(alert.AlertName)
+}
+
 func (f *AlertNamePatternFilter) CacheKey() string {
 	return fmt.Sprintf("alert_name_pattern:%s", f.pattern)
 }
+
+// likeRegexp converts a SQL LIKE pattern ("%" any run, "_" any single
+// character) into an anchored regex, case-insensitive for ILIKE.
+func likeRegexp(pattern string, caseInsensitive bool) *regexp.Regexp {
+	var b strings.Builder
+	if caseInsensitive {
+		b.WriteString("(?i)")
+	}
+	b.WriteString("(?s)^")
+	for _, r := range pattern {
+		switch r {
+		case '%':
+			b.WriteString(".*")
+		case '_':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+	return regexp.MustCompile(b.String())
+}
@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"time"
 
+	"github.com/vitaliisemenov/alert-history/internal/core"
 	"github.com/vitaliisemenov/alert-history/pkg/history/query"
 )
 
@@ -76,6 +77,10 @@ func (f *AlertNameRegexFilter) ApplyToQuery(qb *query.Builder) error {
 	return nil
 }
 
+func (f *AlertNameRegexFilter) Matches(alert *core.Alert) bool {
+	return f.pattern.MatchString(alert.AlertName)
+}
+
 func (f *AlertNameRegexFilter) CacheKey() string {
 	return fmt.Sprintf("alert_name_regex:%s", f.patternStr)
 }
@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/vitaliisemenov/alert-history/internal/core"
 	"github.com/vitaliisemenov/alert-history/pkg/history/query"
 )
 
@@ -97,6 +98,18 @@ func (f *DurationFilter) ApplyToQuery(qb *query.Builder) error {
 	return nil
 }
 
+func (f *DurationFilter) Matches(alert *core.Alert) bool {
+	endsAt := time.Now()
+	if alert.EndsAt != nil {
+		endsAt = *alert.EndsAt
+	}
+	duration := endsAt.Sub(alert.StartsAt)
+	if f.min != nil && duration < *f.min {
+		return false
+	}
+	return f.max == nil || duration <= *f.max
+}
+
 func (f *DurationFilter) CacheKey() string {
 	var parts []string
 	if f.min != nil {
@@ -1,9 +1,11 @@
 package filters
 
 import (
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/vitaliisemenov/alert-history/internal/core"
 	"github.com/vitaliisemenov/alert-history/pkg/history/query"
 )
 
@@ -336,3 +338,76 @@ func TestAlertNameFilter(t *testing.T) {
 		})
 	}
 }
+
+// TestFilterMatches tests in-memory evaluation of query parameter filters
+func TestFilterMatches(t *testing.T) {
+	registry := NewRegistry(nil)
+	startsAt := time.Date(2026, 10, 16, 10, 0, 0, 0, time.UTC)
+	endsAt := startsAt.Add(10 * time.Minute)
+	generatorURL := "http://prometheus:9090/graph"
+	fingerprint := strings.Repeat("a", 64)
+	alert := &core.Alert{
+		Fingerprint:  fingerprint,
+		AlertName:    "HighCPUUsage",
+		Status:       core.StatusResolved,
+		Labels:       map[string]string{"severity": "critical", "namespace": "prod", "pod": "api-1"},
+		Annotations:  map[string]string{"summary": "CPU above 90% on api-1"},
+		StartsAt:     startsAt,
+		EndsAt:       &endsAt,
+		GeneratorURL: &generatorURL,
+	}
+
+	tests := []struct {
+		name    string
+		params  map[string][]string
+		matches bool
+	}{
+		{"status", map[string][]string{"status": {"resolved"}}, true},
+		{"status_other", map[string][]string{"status": {"firing"}}, false},
+		{"severity_and_namespace", map[string][]string{"severity": {"critical"}, "namespace": {"prod"}}, true},
+		{"namespace_other", map[string][]string{"namespace": {"preprod"}}, false},
+		{"fingerprints", map[string][]string{"fingerprints": {fingerprint, strings.Repeat("b", 64)}}, true},
+		{"fingerprints_other", map[string][]string{"fingerprints": {strings.Repeat("b", 64)}}, false},
+		{"alert_name", map[string][]string{"alert_name": {"HighCPUUsage"}}, true},
+		{"alert_name_pattern", map[string][]string{"alert_name_pattern": {"High%Us_ge"}}, true},
+		{"alert_name_pattern_anchored", map[string][]string{"alert_name_pattern": {"CPU%"}}, false},
+		{"alert_name_regex", map[string][]string{"alert_name_regex": {"CPU"}}, true},
+		{"labels_exact", map[string][]string{"labels_exact[pod]": {"api-1"}}, true},
+		{"labels_ne", map[string][]string{"labels_ne[pod]": {"api-1"}}, false},
+		{"labels_ne_missing", map[string][]string{"labels_ne[team]": {"db"}}, true},
+		{"labels_regex", map[string][]string{"labels_regex[pod]": {"^api-"}}, true},
+		{"labels_regex_missing", map[string][]string{"labels_regex[team]": {".*"}}, false},
+		{"labels_not_regex", map[string][]string{"labels_not_regex[pod]": {"^web-"}}, true},
+		{"labels_not_regex_missing", map[string][]string{"labels_not_regex[team]": {"db"}}, false},
+		{"labels_exists", map[string][]string{"labels_exists": {"pod"}}, true},
+		{"labels_not_exists", map[string][]string{"labels_not_exists": {"pod"}}, false},
+		{"time_range", map[string][]string{"from": {"2026-10-16T09:00:00Z"}, "to": {"2026-10-16T10:00:00Z"}}, true},
+		{"time_range_before", map[string][]string{"to": {"2026-10-16T09:59:59Z"}}, false},
+		{"search_case_insensitive", map[string][]string{"search": {"cpu ABOVE"}}, true},
+		{"search_other", map[string][]string{"search": {"memory"}}, false},
+		{"duration", map[string][]string{"duration_min": {"5m"}, "duration_max": {"15m"}}, true},
+		{"duration_too_short", map[string][]string{"duration_min": {"1h"}}, false},
+		{"generator_url", map[string][]string{"generator_url": {generatorURL}}, true},
+		{"is_resolved", map[string][]string{"is_resolved": {"true"}}, true},
+		{"is_resolved_false", map[string][]string{"is_resolved": {"false"}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			historyFilters, err := registry.CreateFromQueryParams(tt.params)
+			if err != nil {
+				t.Fatalf("CreateFromQueryParams() error = %v", err)
+			}
+			if len(historyFilters) == 0 {
+				t.Fatalf("CreateFromQueryParams() created no filters")
+			}
+			matches := true
+			for _, filter := range historyFilters {
+				matches = matches && filter.Matches(alert)
+			}
+			if matches != tt.matches {
+				t.Errorf("Matches() = %v, want %v", matches, tt.matches)
+			}
+		})
+	}
+}
@@ -2,9 +2,11 @@ package filters
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 
+	"github.com/vitaliisemenov/alert-history/internal/core"
 	"github.com/vitaliisemenov/alert-history/pkg/history/query"
 )
 
@@ -81,6 +83,10 @@ func (f *FingerprintFilter) ApplyToQuery(qb *query.Builder) error {
 	return nil
 }
 
+func (f *FingerprintFilter) Matches(alert *core.Alert) bool {
+	return slices.Contains(f.values, alert.Fingerprint)
+}
+
 func (f *FingerprintFilter) CacheKey() string {
 	values := make([]string, len(f.values))
 	copy(values, f.values)
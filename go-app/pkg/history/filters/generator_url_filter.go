@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/url"
 
+	"github.com/vitaliisemenov/alert-history/internal/core"
 	"github.com/vitaliisemenov/alert-history/pkg/history/query"
 )
 
@@ -56,6 +57,10 @@ func (f *GeneratorURLFilter) ApplyToQuery(qb *query.Builder) error {
 	return nil
 }
 
+func (f *GeneratorURLFilter) Matches(alert *core.Alert) bool {
+	return alert.GeneratorURL != nil && *alert.GeneratorURL == f.url
+}
+
 func (f *GeneratorURLFilter) CacheKey() string {
 	return fmt.Sprintf("generator_url:%s", f.url)
 }
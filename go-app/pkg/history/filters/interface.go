@@ -1,6 +1,7 @@
 package filters
 
 import (
+	"github.com/vitaliisemenov/alert-history/internal/core"
 	"github.com/vitaliisemenov/alert-history/pkg/history/query"
 )
 
@@ -15,6 +16,10 @@ type Filter interface {
 	// ApplyToQuery applies the filter to a query builder
 	ApplyToQuery(qb *query.Builder) error
 
+	// Matches reports whether an alert satisfies the filter, with the same
+	// semantics as ApplyToQuery (for backends without the PostgreSQL query)
+	Matches(alert *core.Alert) bool
+
 	// CacheKey returns a cache key representation of the filter
 	// This is used for generating cache keys for query results
 	CacheKey() string
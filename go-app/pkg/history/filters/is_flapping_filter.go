@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/vitaliisemenov/alert-history/internal/core"
 	"github.com/vitaliisemenov/alert-history/pkg/history/query"
 )
 
@@ -55,6 +56,10 @@ func (f *IsFlappingFilter) ApplyToQuery(qb *query.Builder) error {
 	return nil
 }
 
+func (f *IsFlappingFilter) Matches(alert *core.Alert) bool {
+	return true // Not evaluated yet, see ApplyToQuery
+}
+
 func (f *IsFlappingFilter) CacheKey() string {
 	return fmt.Sprintf("is_flapping:%v", f.value)
 }
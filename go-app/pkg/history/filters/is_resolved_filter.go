@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/vitaliisemenov/alert-history/internal/core"
 	"github.com/vitaliisemenov/alert-history/pkg/history/query"
 )
 
@@ -47,6 +48,10 @@ func (f *IsResolvedFilter) ApplyToQuery(qb *query.Builder) error {
 	return nil
 }
 
+func (f *IsResolvedFilter) Matches(alert *core.Alert) bool {
+	return (alert.EndsAt != nil) == f.value
+}
+
 func (f *IsResolvedFilter) CacheKey() string {
 	return fmt.Sprintf("is_resolved:%v", f.value)
 }
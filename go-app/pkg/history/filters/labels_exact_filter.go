@@ -5,6 +5,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/vitaliisemenov/alert-history/internal/core"
 	"github.com/vitaliisemenov/alert-history/pkg/history/query"
 )
 
@@ -85,6 +86,15 @@ func (f *LabelsExactFilter) ApplyToQuery(qb *query.Builder) error {
 	return nil
 }
 
+func (f *LabelsExactFilter) Matches(alert *core.Alert) bool {
+	for key, value := range f.labels {
+		if actual, ok := alert.Labels[key]; !ok || actual != value {
+			return false
+		}
+	}
+	return true
+}
+
 func (f *LabelsExactFilter) CacheKey() string {
 	// Sort keys for consistent cache keys
 	keys := make([]string, 0, len(f.labels))
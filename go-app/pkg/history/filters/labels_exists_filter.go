@@ -5,6 +5,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/vitaliisemenov/alert-history/internal/core"
 	"github.com/vitaliisemenov/alert-history/pkg/history/query"
 )
 
@@ -78,6 +79,15 @@ func (f *LabelsExistsFilter) ApplyToQuery(qb *query.Builder) error {
 	return nil
 }
 
+func (f *LabelsExistsFilter) Matches(alert *core.Alert) bool {
+	for _, key := range f.keys {
+		if _, ok := alert.Labels[key]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
 func (f *LabelsExistsFilter) CacheKey() string {
 	keys := make([]string, len(f.keys))
 	copy(keys, f.keys)
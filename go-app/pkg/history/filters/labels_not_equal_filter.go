@@ -5,6 +5,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/vitaliisemenov/alert-history/internal/core"
 	"github.com/vitaliisemenov/alert-history/pkg/history/query"
 )
 
@@ -84,6 +85,15 @@ func (f *LabelsNotEqualFilter) ApplyToQuery(qb *query.Builder) error {
 	return nil
 }
 
+func (f *LabelsNotEqualFilter) Matches(alert *core.Alert) bool {
+	for key, value := range f.labels {
+		if actual, ok := alert.Labels[key]; ok && actual == value {
+			return false
+		}
+	}
+	return true
+}
+
 func (f *LabelsNotEqualFilter) CacheKey() string {
 	// Sort keys for consistent cache keys
 	keys := make([]string, 0, len(f.labels))
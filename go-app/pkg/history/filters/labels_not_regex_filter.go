@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/vitaliisemenov/alert-history/internal/core"
 	"github.com/vitaliisemenov/alert-history/pkg/history/query"
 )
 
@@ -112,6 +113,16 @@ func (f *LabelsNotRegexFilter) ApplyToQuery(qb *query.Builder) error {
 	return nil
 }
 
+func (f *LabelsNotRegexFilter) Matches(alert *core.Alert) bool {
+	// Like labels->>key in SQL, a missing label matches neither =~ nor !~
+	for key, re := range f.labels {
+		if value, ok := alert.Labels[key]; !ok || re.MatchString(value) {
+			return false
+		}
+	}
+	return true
+}
+
 func (f *LabelsNotRegexFilter) CacheKey() string {
 	// Sort keys for consistent cache keys
 	keys := make([]string, 0, len(f.patterns))
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/vitaliisemenov/alert-history/internal/core"
 	"github.com/vitaliisemenov/alert-history/pkg/history/query"
 )
 
@@ -112,6 +113,15 @@ func (f *LabelsRegexFilter) ApplyToQuery(qb *query.Builder) error {
 	return nil
 }
 
+func (f *LabelsRegexFilter) Matches(alert *core.Alert) bool {
+	for key, re := range f.labels {
+		if value, ok := alert.Labels[key]; !ok || !re.MatchString(value) {
+			return false
+		}
+	}
+	return true
+}
+
 func (f *LabelsRegexFilter) CacheKey() string {
 	// Sort keys for consistent cache keys
 	keys := make([]string, 0, len(f.patterns))
@@ -2,9 +2,11 @@ package filters
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 
+	"github.com/vitaliisemenov/alert-history/internal/core"
 	"github.com/vitaliisemenov/alert-history/pkg/history/query"
 )
 
@@ -83,6 +85,11 @@ func (f *NamespaceFilter) ApplyToQuery(qb *query.Builder) error {
 	return nil
 }
 
+func (f *NamespaceFilter) Matches(alert *core.Alert) bool {
+	namespace, ok := alert.Labels["namespace"]
+	return ok && slices.Contains(f.values, namespace)
+}
+
 func (f *NamespaceFilter) CacheKey() string {
 	values := make([]string, len(f.values))
 	copy(values, f.values)
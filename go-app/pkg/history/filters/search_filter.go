@@ -2,13 +2,16 @@ package filters
 
 import (
 	"fmt"
+	"regexp"
 
+	"github.com/vitaliisemenov/alert-history/internal/core"
 	"github.com/vitaliisemenov/alert-history/pkg/history/query"
 )
 
 // SearchFilter filters alerts by full-text search across alert_name, annotations
 type SearchFilter struct {
-	query string
+	query  string
+	search *regexp.Regexp // ILIKE '%query%' as a regex, for Matches
 }
 
 // NewSearchFilter creates a new search filter
@@ -26,7 +29,7 @@ func NewSearchFilter(params map[string]interface{}) (Filter, error) {
 		return nil, fmt.Errorf("search query too long: max 500 characters")
 	}
 
-	return &SearchFilter{query: queryStr}, nil
+	return &SearchFilter{query: queryStr, search: likeRegexp("%"+queryStr+"%", true)}, nil
 }
 
 func (f *SearchFilter) Type() FilterType {
@@ -55,6 +58,16 @@ func (f *SearchFilter) ApplyToQuery(qb *query.Builder) error {
 	return nil
 }
 
+func (f *SearchFilter) Matches(alert *core.Alert) bool {
+	search := f.search
+	if search == nil {
+		search = likeRegexp("%"+f.query+"%", true)
+	}
+	return search.MatchString(alert.AlertName) ||
+		search.MatchString(alert.Annotations["summary"]) ||
+		search.MatchString(alert.Annotations["description"])
+}
+
 func (f *SearchFilter) CacheKey() string {
 	return fmt.Sprintf("search:%s", f.query)
 }
@@ -2,9 +2,11 @@ package filters
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 
+	"github.com/vitaliisemenov/alert-history/internal/core"
 	"github.com/vitaliisemenov/alert-history/pkg/history/query"
 )
 
@@ -90,6 +92,11 @@ func (f *SeverityFilter) ApplyToQuery(qb *query.Builder) error {
 	return nil
 }
 
+func (f *SeverityFilter) Matches(alert *core.Alert) bool {
+	severity, ok := alert.Labels["severity"]
+	return ok && slices.Contains(f.values, severity)
+}
+
 func (f *SeverityFilter) CacheKey() string {
 	values := make([]string, len(f.values))
 	copy(values, f.values)
@@ -2,6 +2,7 @@ package filters
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 
@@ -79,6 +80,10 @@ func (f *StatusFilter) ApplyToQuery(qb *query.Builder) error {
 	return nil
 }
 
+func (f *StatusFilter) Matches(alert *core.Alert) bool {
+	return slices.Contains(f.values, alert.Status)
+}
+
 func (f *StatusFilter) CacheKey() string {
 	values := make([]string, len(f.values))
 	for i, v := range f.values {
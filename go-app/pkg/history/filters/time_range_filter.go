@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/vitaliisemenov/alert-history/internal/core"
 	"github.com/vitaliisemenov/alert-history/pkg/history/query"
 )
 
@@ -95,6 +96,13 @@ func (f *TimeRangeFilter) ApplyToQuery(qb *query.Builder) error {
 	return nil
 }
 
+func (f *TimeRangeFilter) Matches(alert *core.Alert) bool {
+	if f.from != nil && alert.StartsAt.Before(*f.from) {
+		return false
+	}
+	return f.to == nil || !alert.StartsAt.After(*f.to)
+}
+
 func (f *TimeRangeFilter) CacheKey() string {
 	var parts []string
 	if f.from != nil {
@@ -33,6 +33,13 @@ func NewBuilder() *Builder {
 	}
 }
 
+// SetColumns selects the given columns instead of all columns
+func (qb *Builder) SetColumns(columns ...string) {
+	if len(columns) > 0 {
+		qb.baseQuery = "SELECT " + strings.Join(columns, ", ") + " FROM alerts"
+	}
+}
+
 // AddWhere adds a WHERE clause with arguments
 // Placeholders '?' will be replaced with PostgreSQL placeholders '$N'
 func (qb *Builder) AddWhere(clause string, args ...interface{}) {
@@ -120,6 +120,18 @@ func TestBuilder_SetLimitOffset(t *testing.T) {
 	}
 }
 
+// TestBuilder_SetColumns tests SetColumns functionality
+func TestBuilder_SetColumns(t *testing.T) {
+	qb := NewBuilder()
+	qb.SetColumns("fingerprint", "starts_at")
+
+	sql, _ := qb.Build()
+
+	if !contains(sql, "SELECT fingerprint, starts_at FROM alerts") {
+		t.Errorf("SetColumns() SQL = %v, want selected columns", sql)
+	}
+}
+
 // TestBuilder_AddOrderBy tests AddOrderBy functionality
 func TestBuilder_AddOrderBy(t *testing.T) {
 	qb := NewBuilder()
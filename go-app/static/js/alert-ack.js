@@ -0,0 +1,62 @@
+// Alert acknowledgement workflow: Ack / Unack / Assign buttons on alert cards
+// (partials/alert-card), used by the alert list and the dashboard.
+function ackActor() {
+  let actor = localStorage.getItem('ackActor');
+  if (!actor) {
+    actor = prompt('Your name or email (recorded in the audit trail):');
+    if (!actor) return null;
+    localStorage.setItem('ackActor', actor.trim());
+  }
+  return actor;
+}
+
+async function handleAckAction(button) {
+  const actor = ackActor();
+  if (!actor) return;
+
+  const fingerprint = button.dataset.fingerprint;
+  const action = button.dataset.ackAction;
+  const base = '/api/v2/alerts/' + encodeURIComponent(fingerprint);
+  let method = 'POST';
+  let path = base + '/ack';
+  const body = { actor: actor };
+
+  if (action === 'ack') {
+    const duration = prompt('Acknowledge for (e.g. 4h, empty = until unacknowledged):', '4h');
+    if (duration === null) return;
+    if (duration.trim()) body.duration = duration.trim();
+    const comment = prompt('Comment (optional):', '');
+    if (comment) body.comment = comment;
+  } else if (action === 'unack') {
+    method = 'DELETE';
+  } else if (action === 'assign') {
+    const owner = prompt('Assign to (empty = unassign):', actor);
+    if (owner === null) return;
+    path = base + '/assign';
+    body.owner = owner.trim();
+  }
+
+  try {
+    const response = await fetch(path, {
+      method: method,
+      headers: { 'Content-Type': 'application/json' },
+      body: JSON.stringify(body),
+    });
+    if (!response.ok) {
+      const error = await response.json().catch(() => ({}));
+      throw new Error(error.error || response.statusText);
+    }
+    if (typeof announceUpdate === 'function') announceUpdate('Alert updated');
+    window.location.reload();
+  } catch (err) {
+    alert('Failed to ' + action + ' alert: ' + err.message);
+  }
+}
+
+document.addEventListener('click', function(e) {
+  const button = e.target.closest('[data-ack-action]');
+  if (button) {
+    e.preventDefault();
+    handleAckAction(button);
+  }
+});